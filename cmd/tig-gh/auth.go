@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/infra/auth"
+	"github.com/a1yama/tig-gh/internal/infra/browser"
+)
+
+// authenticator は auth サブコマンドと初回起動時のログインを担う
+type authenticator struct {
+	resolver *auth.Resolver
+	clientID string
+}
+
+// newAuthenticator はプロファイルを適用した設定の認証を扱う authenticator を作成する
+func newAuthenticator(cfg *models.Config) *authenticator {
+	return &authenticator{
+		resolver: auth.NewResolver(cfg.GitHub).WithProfile(cfg.Profile),
+		clientID: cfg.GitHub.OAuthClientID,
+	}
+}
+
+// resolveToken はトークンを探し、見つからなければ端末からブラウザでのログインを試みる
+// トークンがない場合は読み取り専用のゲストモードになるため、warn が true なら案内を表示する
+func resolveToken(ctx context.Context, a *authenticator, prompt, warn bool) auth.Token {
+	token := a.resolver.Resolve(ctx)
+
+	// 初回起動でトークンがなければブラウザでログインする
+	if token.Value == "" && prompt && a.clientID != "" && canPrompt() {
+		fmt.Fprintf(os.Stderr, "GitHub token not found; logging in to %s.\n", a.resolver.Host())
+		if loggedIn, err := a.login(ctx, os.Stderr); err != nil {
+			fmt.Fprintf(os.Stderr, "Login failed: %v\n", err)
+		} else {
+			token = loggedIn
+		}
+	}
+
+	if token.Value == "" && warn {
+		fmt.Fprintf(os.Stderr, "GitHub token not found; running in read-only guest mode (public repositories, 60 requests/hour).\n")
+		fmt.Fprintf(os.Stderr, "Set GITHUB_TOKEN, run `gh auth login`, or set github.oauth_client_id and run `tig-gh auth login` to enable write actions.\n")
+	}
+	return token
+}
+
+// Status は現在のトークンの取得元を返す
+func (a *authenticator) Status(ctx context.Context) (string, bool) {
+	token := a.resolver.Resolve(ctx)
+	return string(token.Source), token.Value != ""
+}
+
+// Login はデバイスフローでログインし、トークンをキーリングに保存する
+func (a *authenticator) Login(ctx context.Context, out io.Writer) error {
+	_, err := a.login(ctx, out)
+	return err
+}
+
+// Logout はキーリングに保存したトークンを削除する
+func (a *authenticator) Logout() error {
+	return a.resolver.Forget()
+}
+
+// login はデバイスフローでログインして取得したトークンを返す
+func (a *authenticator) login(ctx context.Context, out io.Writer) (auth.Token, error) {
+	if a.clientID == "" {
+		return auth.Token{}, fmt.Errorf("github.oauth_client_id is not set; set it to the client ID of an OAuth app with device flow enabled")
+	}
+	return auth.Login(ctx, auth.NewDeviceFlow(a.clientID, a.resolver.Host()), a.resolver, out, browser.Open)
+}
+
+// canPrompt はユーザーが端末から操作しているかどうかを返す
+func canPrompt() bool {
+	info, err := os.Stdin.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
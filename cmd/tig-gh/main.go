@@ -6,200 +6,417 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/a1yama/tig-gh/internal/app"
 	"github.com/a1yama/tig-gh/internal/app/usecase"
-	"github.com/a1yama/tig-gh/internal/domain/repository"
-	"github.com/a1yama/tig-gh/internal/infra/cache"
+	"github.com/a1yama/tig-gh/internal/cli"
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/infra/clock"
 	"github.com/a1yama/tig-gh/internal/infra/config"
 	"github.com/a1yama/tig-gh/internal/infra/git"
-	"github.com/a1yama/tig-gh/internal/infra/github"
+	"github.com/a1yama/tig-gh/internal/infra/paths"
+	"github.com/a1yama/tig-gh/internal/infra/stats"
+	"github.com/a1yama/tig-gh/internal/infra/update"
 	"github.com/a1yama/tig-gh/internal/ui"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 var Version = "dev"
 
+// authCheckTimeout は起動後のトークン確認にかける時間の上限
+const authCheckTimeout = 15 * time.Second
+
+// updateCheckTimeout は起動後の新バージョン確認にかける時間の上限
+const updateCheckTimeout = 10 * time.Second
+
 func main() {
 	if len(os.Args) > 1 && (os.Args[1] == "--version" || os.Args[1] == "-v") {
 		fmt.Printf("tig-gh version %s\n", Version)
 		os.Exit(0)
 	}
 
-	// 設定を読み込む
-	if err := config.Load(); err != nil {
-		fmt.Fprintf(os.Stderr, "Warning: Could not load config: %v\n", err)
-		fmt.Fprintf(os.Stderr, "Using default configuration...\n")
+	profile, args, err := parseProfileFlag(os.Args[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
 	}
 
-	cfg := config.Get()
-
-	// GitHub トークンを取得
-	token := config.GetGitHubToken()
-	if token == "" {
-		fmt.Fprintf(os.Stderr, "Error: GitHub token not found.\n")
-		fmt.Fprintf(os.Stderr, "Please set GITHUB_TOKEN environment variable or configure it in ~/.config/tig-gh/config.yaml\n")
-		fmt.Fprintf(os.Stderr, "\nExample:\n")
-		fmt.Fprintf(os.Stderr, "  export GITHUB_TOKEN=ghp_xxxxxxxxxxxx\n")
-		fmt.Fprintf(os.Stderr, "\nOr create ~/.config/tig-gh/config.yaml with:\n")
-		fmt.Fprintf(os.Stderr, "  github:\n")
-		fmt.Fprintf(os.Stderr, "    token: ghp_xxxxxxxxxxxx\n")
-		os.Exit(1)
+	// TIG_GH_NOW が設定されていれば現在時刻を固定する
+	// 相対時刻・週次比較・滞留判定が毎回同じになるため、テストやデモの出力を再現できる
+	if value := os.Getenv(clock.EnvVar); value != "" {
+		now, err := clock.Parse(value)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(2)
+		}
+		clock.Set(clock.Fixed(now))
 	}
 
-	var owner, repo string
-	var err error
+	ctx := context.Background()
+	headless := len(args) > 0 && cli.IsCommand(args[0])
 
-	// コマンドライン引数からowner/repoを取得
-	if len(os.Args) > 1 && os.Args[1] != "--version" && os.Args[1] != "-v" {
-		// owner/repo形式のパース
-		arg := os.Args[1]
-		parts := strings.Split(arg, "/")
-		if len(parts) == 2 {
-			owner = parts[0]
-			repo = parts[1]
-		} else {
-			fmt.Fprintf(os.Stderr, "Error: Invalid repository format.\n")
-			fmt.Fprintf(os.Stderr, "Usage: tig-gh [owner/repo]\n")
-			fmt.Fprintf(os.Stderr, "\nExample:\n")
-			fmt.Fprintf(os.Stderr, "  tig-gh charmbracelet/bubbletea\n")
-			os.Exit(1)
+	// 設定を読み込む
+	// TUI では最初の描画を待たせないよう検証を起動後に回し、エラーはバナーに表示する
+	var configErr error
+	if headless {
+		if err := config.Load(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: Could not load config: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Using default configuration...\n")
 		}
 	} else {
-		// 引数がない場合は現在のGitリポジトリから取得
-		if !git.IsGitRepository() {
-			fmt.Fprintf(os.Stderr, "Error: Not a git repository.\n")
-			fmt.Fprintf(os.Stderr, "Please run tig-gh from within a git repository or specify a repository:\n")
-			fmt.Fprintf(os.Stderr, "\nUsage:\n")
-			fmt.Fprintf(os.Stderr, "  tig-gh [owner/repo]\n")
-			fmt.Fprintf(os.Stderr, "\nExample:\n")
-			fmt.Fprintf(os.Stderr, "  tig-gh charmbracelet/bubbletea\n")
-			os.Exit(1)
-		}
+		configErr = config.LoadUnvalidated()
+	}
+
+	cfg, err := loadProfile(profile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	authn := newAuthenticator(cfg)
+	token := resolveToken(ctx, authn, !headless, !headless || cli.NeedsToken(args))
 
-		owner, repo, err = git.GetCurrentRepository()
+	// ヘッドレスモード（サブコマンド）
+	if headless {
+		container, err := app.New(cfg, token.Value, app.WithStateDir(stateDir(cfg)))
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error: Failed to get repository information: %v\n", err)
-			fmt.Fprintf(os.Stderr, "\nMake sure the current directory is a GitHub repository with a valid remote 'origin'.\n")
-			fmt.Fprintf(os.Stderr, "Or specify a repository manually:\n")
-			fmt.Fprintf(os.Stderr, "\nUsage:\n")
-			fmt.Fprintf(os.Stderr, "  tig-gh [owner/repo]\n")
-			fmt.Fprintf(os.Stderr, "\nExample:\n")
-			fmt.Fprintf(os.Stderr, "  tig-gh charmbracelet/bubbletea\n")
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
+		// github.repositories が空の場合はカレントのGitリポジトリのメトリクスを出す
+		if owner, repo, err := resolveRepository("", cfg); err == nil {
+			container.OpenRepository(owner, repo)
+		}
+		deps := cli.Dependencies{
+			FetchIssues: container.FetchIssues,
+			FetchPRs:    container.FetchPRs,
+			ViewMetrics: func(ctx context.Context, path string) error {
+				return viewMetricsSnapshot(ctx, cfg, path)
+			},
+			Auth:    authn,
+			Upgrade: update.NewUpdater(update.NewClient(), Version),
+			Stats:   usageStatsStore(),
+			ResolveRepo: func(arg string) (string, string, error) {
+				return resolveRepository(arg, cfg)
+			},
+			Paths:       resolvedPaths(cfg),
+			KeyBindings: cfg.UI.KeyBindings,
+			Stdout:      os.Stdout,
+			Stderr:      os.Stderr,
+		}
+		// features.metrics が無効な場合は metrics・report コマンドが使えない
+		if container.FetchMetrics != nil {
+			deps.FetchMetrics = container.FetchMetrics
+		}
+		os.Exit(cli.Run(ctx, args, deps))
 	}
 
-	// owner/repoが取得できなかった場合（設定ファイルからのフォールバック）
-	if owner == "" || repo == "" {
-		owner = cfg.GitHub.DefaultOwner
-		repo = cfg.GitHub.DefaultRepo
+	// コマンドライン引数からowner/repoを取得
+	var arg string
+	if len(args) > 0 {
+		arg = args[0]
 	}
 
-	// それでもowner/repoが設定されていない場合はエラー
-	if owner == "" || repo == "" {
-		fmt.Fprintf(os.Stderr, "Error: Repository not specified.\n")
-		fmt.Fprintf(os.Stderr, "Please run tig-gh from within a git repository or specify a repository:\n")
+	owner, repo, err := resolveRepository(arg, cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		fmt.Fprintf(os.Stderr, "\nUsage:\n")
-		fmt.Fprintf(os.Stderr, "  tig-gh [owner/repo]\n")
+		fmt.Fprintf(os.Stderr, "  tig-gh [--profile NAME] [owner/repo | GitHub URL]\n")
+		fmt.Fprintf(os.Stderr, "  tig-gh issues list [--state=open|closed|all] [--json] [owner/repo]\n")
+		fmt.Fprintf(os.Stderr, "  tig-gh prs list [--state=open|closed|all] [--json] [owner/repo]\n")
+		fmt.Fprintf(os.Stderr, "  tig-gh metrics [--json] [--resume]\n")
+		fmt.Fprintf(os.Stderr, "  tig-gh metrics view FILE.json\n")
+		fmt.Fprintf(os.Stderr, "  tig-gh metrics check [--resume] [--max-lead-time=72h] [--max-stagnant=N]\n")
+		fmt.Fprintf(os.Stderr, "  tig-gh auth status|login|logout\n")
+		fmt.Fprintf(os.Stderr, "  tig-gh doctor\n")
+		fmt.Fprintf(os.Stderr, "  tig-gh upgrade [--check]\n")
+		fmt.Fprintf(os.Stderr, "  tig-gh stats [--json] [--reset]\n")
+		fmt.Fprintf(os.Stderr, "  tig-gh keys [--output=FILE]\n")
+		fmt.Fprintf(os.Stderr, "  tig-gh report [--format=markdown|html] [--output=FILE] [--resume] [--input=FILE.json]\n")
 		fmt.Fprintf(os.Stderr, "\nExample:\n")
 		fmt.Fprintf(os.Stderr, "  tig-gh charmbracelet/bubbletea\n")
 		os.Exit(1)
 	}
 
-	// GitHub クライアントの初期化
-	githubClient := github.NewClient(token)
-
-	// キャッシュの初期化
-	var cacheService repository.CacheService
-	if cfg.Cache.Enabled {
-		cacheConfig := cache.DefaultConfig()
-		if cfg.Cache.TTL > 0 {
-			cacheConfig.MemoryTTL = cfg.Cache.TTL
-			cacheConfig.FileTTL = cfg.Cache.TTL
+	// プロファイルピッカー・スター済みリポジトリで切り替えた場合は、新しいプロファイル・リポジトリで起動し直す
+	for {
+		next, err := runTUI(ctx, cfg, token.Value, owner, repo, configErr)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if next.owner != "" {
+			owner, repo = next.owner, next.repo
+			continue
 		}
-		if dir := strings.TrimSpace(cfg.Cache.Dir); dir != "" {
-			cacheConfig.FileDir = expandPath(dir)
+		if next.profile == "" {
+			return
 		}
-		if !cfg.Cache.UseFileCache {
-			cacheConfig.FileEnabled = false
+
+		if cfg, err = loadProfile(next.profile); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		// 切り替え先のプロファイルにデフォルトのリポジトリがあればそれを開く
+		if cfg.GitHub.DefaultOwner != "" && cfg.GitHub.DefaultRepo != "" {
+			owner, repo = cfg.GitHub.DefaultOwner, cfg.GitHub.DefaultRepo
 		}
+		authn = newAuthenticator(cfg)
+		token = resolveToken(ctx, authn, true, true)
+	}
+}
+
+// stateFiles は状態ディレクトリに記録するファイル（閲覧済みファイル・ウォッチ一覧）
+var stateFiles = []string{"viewed", "watched.json"}
 
-		cacheService, err = cache.NewCacheWithConfig(cacheConfig)
+// stateDir は閲覧済みファイルやウォッチ一覧を記録するディレクトリを返す（決められなければ空文字）
+// 以前はキャッシュディレクトリに記録していたため、残っていれば移す
+func stateDir(cfg *models.Config) string {
+	dir, err := paths.StateDir()
+	if err != nil {
+		return ""
+	}
+	if cfg.Profile != "" {
+		dir = filepath.Join(dir, "profiles", cfg.Profile)
+	}
+
+	if moved, err := paths.MigrateState(legacyStateDir(cfg), dir, stateFiles...); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	} else if len(moved) > 0 {
+		fmt.Fprintf(os.Stderr, "Moved %s to %s\n", strings.Join(moved, ", "), dir)
+	}
+	return dir
+}
+
+// resolvedPaths は tig-gh doctor で表示する設定・キャッシュ・状態の場所を返す
+func resolvedPaths(cfg *models.Config) []cli.PathInfo {
+	configDir, _ := paths.ConfigDir()
+	cacheDir := paths.ExpandPath(strings.TrimSpace(cfg.Cache.Dir))
+	if cacheDir == "" {
+		cacheDir, _ = paths.CacheDir()
+	}
+	return []cli.PathInfo{
+		{Name: "config file", Path: config.GetManager().GetConfigPath()},
+		{Name: "config dir", Path: configDir},
+		{Name: "cache dir", Path: cacheDir},
+		{Name: "state dir", Path: stateDir(cfg)},
+	}
+}
+
+// checkForUpdate は実行中より新しいリリースのバージョンを返す（無い・確認できない場合は空文字）
+// 結果はプロファイルに関係なく状態ディレクトリに記録し、update.interval の間は API を呼ばない
+func checkForUpdate(ctx context.Context, cfg *models.Config) string {
+	path := ""
+	if dir, err := paths.StateDir(); err == nil {
+		path = filepath.Join(dir, "update.json")
+	}
+	version, err := update.NewChecker(update.NewClient(), path, cfg.Update.Interval).Check(ctx, Version)
+	if err != nil {
+		return ""
+	}
+	return version
+}
+
+// usageStatsStore は利用状況の記録先を返す
+// プロファイルに関係なく状態ディレクトリに記録し、どこにも送信しない
+func usageStatsStore() *stats.Store {
+	path := ""
+	if dir, err := paths.StateDir(); err == nil {
+		path = filepath.Join(dir, "stats.json")
+	}
+	return stats.NewStore(path)
+}
+
+// legacyStateDir は以前の記録先（キャッシュディレクトリ）を返す
+func legacyStateDir(cfg *models.Config) string {
+	dir := paths.ExpandPath(strings.TrimSpace(cfg.Cache.Dir))
+	if dir == "" {
+		cacheDir, err := paths.CacheDir()
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error: Failed to initialize cache: %v\n", err)
-			fmt.Fprintf(os.Stderr, "Continuing without cache...\n")
-			cacheService = nil
+			return ""
 		}
+		dir = cacheDir
+	}
+	if cfg.Profile != "" {
+		dir = filepath.Join(dir, "profiles", cfg.Profile)
 	}
+	return dir
+}
 
-	// リポジトリの初期化（キャッシュあり）
-	baseIssueRepo := github.NewIssueRepository(githubClient)
-	basePRRepo := github.NewPullRequestRepository(githubClient)
-	commitRepo := github.NewCommitRepository(githubClient)
-	searchRepo := github.NewSearchRepository(githubClient)
-	metricsRepo := github.NewMetricsRepository(githubClient)
+// sessionSwitch は TUI の終了時に選ばれた切り替え先（どちらも空なら終了）
+type sessionSwitch struct {
+	// profile はプロファイルピッカーで選ばれたプロファイル名
+	profile string
+	// owner / repo はスター済みリポジトリの一覧で選ばれたリポジトリ
+	owner string
+	repo  string
+}
 
-	// キャッシュでラップ
-	var issueRepo repository.IssueRepository
-	var prRepo repository.PullRequestRepository
+// runTUI はTUIを起動し、プロファイルピッカー・スター済みリポジトリの一覧で選ばれた切り替え先を返す
+// configErr は読み込み時のエラーで、なければ起動後に設定を検証する
+func runTUI(ctx context.Context, cfg *models.Config, token, owner, repo string, configErr error) (sessionSwitch, error) {
+	container, err := app.New(cfg, token, app.WithStateDir(stateDir(cfg)))
+	if err != nil {
+		return sessionSwitch{}, err
+	}
 
-	if cacheService != nil {
-		c := cacheService.(*cache.Cache)
-		issueRepo = cache.NewCachedIssueRepository(baseIssueRepo, c)
-		prRepo = cache.NewCachedPullRequestRepository(basePRRepo, c)
-	} else {
-		issueRepo = baseIssueRepo
-		prRepo = basePRRepo
-	}
-
-	// UseCaseの初期化
-	fetchIssuesUseCase := usecase.NewFetchIssuesUseCase(issueRepo)
-	fetchPRsUseCase := usecase.NewFetchPRsUseCase(prRepo)
-	fetchCommitsUseCase := usecase.NewFetchCommitsUseCase(commitRepo)
-	searchUseCase := usecase.NewSearchUseCase(searchRepo)
-	fetchMetricsUseCase := usecase.NewFetchLeadTimeMetricsUseCase(metricsRepo, cfg)
-
-	// TUIアプリケーションの初期化
-	app := ui.NewAppWithUseCases(
-		fetchIssuesUseCase,
-		fetchPRsUseCase,
-		fetchCommitsUseCase,
-		searchUseCase,
-		fetchMetricsUseCase,
-		owner,
-		repo,
-		cfg.UI.DefaultView,
-		&cfg.Metrics,
-	)
+	// TUIアプリケーションの初期化（無効な機能のビューは開けない）
+	tui := container.NewTUI(owner, repo)
+	// 終了時（プロファイル切り替えを含む）に実行中の API 呼び出しをキャンセルする
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	tui.SetContext(ctx)
+	tui.SetProfiles(cfg.ProfileNames(), profileName(cfg))
+	tui.SetConfigCheck(func() error {
+		if configErr != nil {
+			return configErr
+		}
+		return config.Validate()
+	})
+	// トークンの有効期限・スコープ・SAML SSO を起動後に確認し、問題があれば対処方法を表示する
+	if token != "" {
+		tui.SetAuthCheck(func() error {
+			ctx, cancel := context.WithTimeout(ctx, authCheckTimeout)
+			defer cancel()
+			_, err := container.Client.CheckToken(ctx, owner, repo)
+			return err
+		})
+	}
+	// update.check が有効な場合は新しいリリースを確認し、あればステータスバーに表示する
+	if cfg.Update.Check {
+		tui.SetUpdateCheck(func() string {
+			ctx, cancel := context.WithTimeout(ctx, updateCheckTimeout)
+			defer cancel()
+			return checkForUpdate(ctx, cfg)
+		})
+	}
+
+	// stats.enabled が有効な場合は開いたビュー・使った操作・セッションの長さを記録する
+	if cfg.Stats.Enabled {
+		recorder := stats.NewRecorder(usageStatsStore())
+		tui.SetUsageRecorder(recorder)
+		defer func() {
+			if err := recorder.Close(); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+			}
+		}()
+	}
 
 	// bubbletea プログラムの起動
 	p := tea.NewProgram(
-		app,
+		tui,
 		tea.WithAltScreen(),
+		tea.WithFPS(ui.DefaultFPS),
+		tea.WithContext(ctx),
 		// tea.WithMouseCellMotion(), // Disabled: may cause rendering issues
 	)
 
 	// アプリケーション起動メッセージ
-	fmt.Fprintf(os.Stderr, "Starting tig-gh for %s/%s...\n", owner, repo)
-
-	// 実行
-	ctx := context.Background()
-	_ = ctx // 将来的にコンテキストを使う
+	fmt.Fprintf(os.Stderr, "Starting tig-gh for %s/%s (profile %s)...\n", owner, repo, profileName(cfg))
 
+	// 実行（終了・パニック・SIGTERM 時の端末の復元は bubbletea が行う）
+	stopHangup := quitOnHangup(p)
+	defer stopHangup()
 	if _, err := p.Run(); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		return sessionSwitch{}, err
+	}
+	if owner, repo, ok := tui.RepositorySwitch(); ok {
+		return sessionSwitch{owner: owner, repo: repo}, nil
 	}
+	return sessionSwitch{profile: tui.ProfileSwitch()}, nil
 }
 
-func expandPath(path string) string {
-	if path == "" {
-		return path
+// viewMetricsSnapshot は `tig-gh metrics --json` で書き出したメトリクスを Metrics ビューで表示する
+// API を呼ばないため、トークンを持たない人もレポートを閲覧できる
+func viewMetricsSnapshot(ctx context.Context, cfg *models.Config, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
 	}
-	if strings.HasPrefix(path, "~") {
-		if home, err := os.UserHomeDir(); err == nil {
-			return filepath.Join(home, strings.TrimPrefix(path, "~"))
+	defer file.Close()
+
+	snapshot, err := usecase.LoadMetricsSnapshot(file, filepath.Base(path))
+	if err != nil {
+		return err
+	}
+
+	p := tea.NewProgram(
+		ui.NewMetricsSnapshotApp(snapshot, &cfg.Metrics),
+		tea.WithAltScreen(),
+		tea.WithFPS(ui.DefaultFPS),
+		tea.WithContext(ctx),
+	)
+	_, err = p.Run()
+	return err
+}
+
+// parseProfileFlag は引数から --profile NAME / --profile=NAME を取り出す
+func parseProfileFlag(args []string) (string, []string, error) {
+	var profile string
+	rest := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == "--profile":
+			if i+1 >= len(args) || args[i+1] == "" {
+				return "", nil, fmt.Errorf("--profile requires a profile name")
+			}
+			profile = args[i+1]
+			i++
+		case strings.HasPrefix(args[i], "--profile="):
+			profile = strings.TrimPrefix(args[i], "--profile=")
+			if profile == "" {
+				return "", nil, fmt.Errorf("--profile requires a profile name")
+			}
+		default:
+			rest = append(rest, args[i])
+		}
+	}
+	return profile, rest, nil
+}
+
+// loadProfile は読み込んだ設定にプロファイルを適用する
+// name が空の場合は設定ファイルの profile を使い、存在しなければ github セクションのまま起動する
+// （存在しないことは設定の検証で報告される）
+func loadProfile(name string) (*models.Config, error) {
+	cfg := config.Get()
+	if name == "" {
+		if err := cfg.ApplyProfile(cfg.Profile); err != nil {
+			cfg.Profile = ""
 		}
+		return cfg, nil
+	}
+	if err := cfg.ApplyProfile(name); err != nil {
+		return nil, err
 	}
-	return path
+	return cfg, nil
+}
+
+// profileName は使用中のプロファイルの表示名を返す
+func profileName(cfg *models.Config) string {
+	if cfg.Profile == "" {
+		return models.DefaultProfileName
+	}
+	return cfg.Profile
+}
+
+// resolveRepository は owner/repo を引数・カレントのGitリポジトリ・設定ファイルの順に解決する
+// 引数は owner/repo のほか、貼り付けられた GitHub の URL・SSH リモート（末尾の .git 付きも可）を受け付ける
+func resolveRepository(arg string, cfg *models.Config) (string, string, error) {
+	if arg != "" {
+		return git.ParseRepositoryArg(arg)
+	}
+
+	// 引数がない場合は現在のGitリポジトリから取得
+	if git.IsGitRepository() {
+		if owner, repo, err := git.GetCurrentRepository(); err == nil {
+			return owner, repo, nil
+		}
+	}
+
+	// 設定ファイルからのフォールバック
+	if cfg.GitHub.DefaultOwner != "" && cfg.GitHub.DefaultRepo != "" {
+		return cfg.GitHub.DefaultOwner, cfg.GitHub.DefaultRepo, nil
+	}
+
+	return "", "", fmt.Errorf("repository not specified; run tig-gh from within a GitHub repository with a valid remote 'origin' or specify owner/repo")
 }
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// quitOnHangup は端末が閉じられたとき（SIGHUP）にプログラムを通常どおり終了させる
+// bubbletea は SIGINT / SIGTERM しか扱わないため、そのままでは端末の状態を戻さずに終了してしまう
+// 戻り値の関数で監視を止める
+func quitOnHangup(p *tea.Program) func() {
+	sig := make(chan os.Signal, 1)
+	done := make(chan struct{})
+	signal.Notify(sig, syscall.SIGHUP)
+
+	go func() {
+		select {
+		case <-sig:
+			p.Quit()
+		case <-done:
+		}
+	}()
+
+	return func() {
+		signal.Stop(sig)
+		close(done)
+	}
+}
@@ -0,0 +1,305 @@
+// Package app は GitHub クライアント・リポジトリ・ユースケースを組み立て、TUI と CLI に渡す
+package app
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/app/usecase"
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/infra/cache"
+	"github.com/a1yama/tig-gh/internal/infra/github"
+	"github.com/a1yama/tig-gh/internal/infra/notify"
+	"github.com/a1yama/tig-gh/internal/infra/paths"
+	"github.com/a1yama/tig-gh/internal/infra/readonly"
+	"github.com/a1yama/tig-gh/internal/infra/viewed"
+	"github.com/a1yama/tig-gh/internal/infra/watch"
+	"github.com/a1yama/tig-gh/internal/ui"
+)
+
+// Container は TUI と CLI で共有するクライアント・リポジトリ・ユースケースをまとめたもの
+// 機能（Feature）のユースケースは有効な機能だけが組み立て、無効な機能の分は nil のまま
+type Container struct {
+	Config *models.Config
+	Token  string
+
+	// StateDir は閲覧済みファイル・ウォッチ一覧などを記録するディレクトリ（空なら記録しない）
+	StateDir string
+
+	Client *github.Client
+
+	// Cache は cache.enabled が無効、または初期化に失敗した場合は nil
+	Cache *cache.Cache
+
+	IssueRepo    repository.IssueRepository
+	PRRepo       repository.PullRequestRepository
+	CommitRepo   repository.CommitRepository
+	SearchRepo   repository.SearchRepository
+	ReleaseRepo  repository.ReleaseRepository
+	GistRepo     repository.GistRepository
+	StarRepo     repository.StarRepository
+	WorkflowRepo repository.WorkflowRepository
+
+	FetchIssues   *usecase.FetchIssuesUseCase
+	FetchPRs      *usecase.FetchPRsUseCase
+	FetchCommits  *usecase.FetchCommitsUseCase
+	Search        *usecase.SearchUseCase
+	FetchReleases *usecase.FetchReleasesUseCase
+	FetchGists    *usecase.FetchGistsUseCase
+	FetchStarred  *usecase.FetchStarredUseCase
+	ReleaseTrain  *usecase.ReleaseTrainUseCase
+	MyWork        *usecase.FetchMyWorkUseCase
+
+	// 機能ごとのユースケース（機能が無効なら nil）
+	FetchRuns    *usecase.FetchWorkflowRunsUseCase
+	FetchMetrics *usecase.FetchLeadTimeMetricsUseCase
+	LiveUpdates  *usecase.LiveUpdatesUseCase
+
+	enabled  []Feature // 組み立てた機能（登録順）
+	disabled []Feature // 設定で無効な機能、または WithFeatures で選ばれなかった機能
+	warnings io.Writer
+}
+
+// Option は New の組み立て方を変える
+type Option func(*options)
+
+type options struct {
+	stateDir string
+	features []Feature
+	selected bool
+	warnings io.Writer
+}
+
+// WithStateDir は状態を記録するディレクトリを指定する（指定しなければ記録しない）
+func WithStateDir(dir string) Option {
+	return func(o *options) {
+		o.stateDir = dir
+	}
+}
+
+// WithFeatures は登録済みの機能の代わりに組み立てる機能を指定する
+// テストで一部の機能だけを持つアプリを組み立てるために使う（指定しなかった機能は無効になる）
+func WithFeatures(features ...Feature) Option {
+	return func(o *options) {
+		o.features = features
+		o.selected = true
+	}
+}
+
+// WithWarnings は組み立て中の警告（キャッシュの初期化失敗など）の出力先を指定する（既定は標準エラー出力）
+func WithWarnings(w io.Writer) Option {
+	return func(o *options) {
+		o.warnings = w
+	}
+}
+
+// New は設定からクライアント・キャッシュ・リポジトリを組み立て、有効な機能のユースケースを生成する
+// token が空の場合はゲストモードとして書き込み操作をすべて無効にする
+func New(cfg *models.Config, token string, opts ...Option) (*Container, error) {
+	o := options{warnings: os.Stderr}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	// GitHub クライアントの初期化（GitHub Enterprise の場合は api_base_url を使う）
+	client, err := github.NewClientForHost(token, cfg.GitHub.APIBaseURL, cfg.GitHub.UploadBaseURL)
+	if err != nil {
+		return nil, err
+	}
+	client.SetRetries(cfg.GitHub.Retries)
+	client.SetTimeouts(cfg.GitHub.RequestTimeouts())
+	github.SetDefaultPageSize(cfg.UI.PageSize)
+
+	c := &Container{
+		Config:   cfg,
+		Token:    token,
+		StateDir: o.stateDir,
+		Client:   client,
+		warnings: o.warnings,
+	}
+	c.Cache = c.newCache()
+	c.buildRepositories()
+
+	// UseCase の初期化
+	c.FetchIssues = usecase.NewFetchIssuesUseCase(c.IssueRepo)
+	c.FetchPRs = usecase.NewFetchPRsUseCase(c.PRRepo)
+	c.FetchCommits = usecase.NewFetchCommitsUseCase(c.CommitRepo)
+	c.Search = usecase.NewSearchUseCase(c.SearchRepo)
+	c.FetchReleases = usecase.NewFetchReleasesUseCase(c.ReleaseRepo)
+	c.FetchGists = usecase.NewFetchGistsUseCase(c.GistRepo)
+	c.FetchStarred = usecase.NewFetchStarredUseCase(c.StarRepo)
+	c.ReleaseTrain = usecase.NewReleaseTrainUseCase(c.ReleaseRepo, c.CommitRepo, c.SearchRepo, cfg.Release)
+	c.MyWork = usecase.NewFetchMyWorkUseCase(c.SearchRepo)
+
+	// 有効な機能を組み立てる
+	candidates := Features()
+	if o.selected {
+		candidates = o.features
+	}
+	for _, feature := range mergeFeatures(Features(), candidates) {
+		if !containsFeature(candidates, feature.Name) || !feature.enabledIn(cfg) {
+			c.disabled = append(c.disabled, feature)
+			continue
+		}
+		if feature.Build != nil {
+			if err := feature.Build(c); err != nil {
+				return nil, fmt.Errorf("%s: %w", feature.Name, err)
+			}
+		}
+		c.enabled = append(c.enabled, feature)
+	}
+	return c, nil
+}
+
+// newCache はキャッシュを初期化する（無効・失敗した場合は nil）
+func (c *Container) newCache() *cache.Cache {
+	cfg := c.Config
+	if !cfg.Cache.Enabled {
+		return nil
+	}
+	cacheConfig := cache.DefaultConfig()
+	if cfg.Cache.TTL > 0 {
+		cacheConfig.MemoryTTL = cfg.Cache.TTL
+		cacheConfig.FileTTL = cfg.Cache.TTL
+	}
+	if dir := strings.TrimSpace(cfg.Cache.Dir); dir != "" {
+		cacheConfig.FileDir = paths.ExpandPath(dir)
+	}
+	// プロファイルごとにファイルキャッシュを分ける（別ホストの同名リポジトリと混ざらないように）
+	if cfg.Profile != "" {
+		cacheConfig.FileDir = filepath.Join(cacheConfig.FileDir, "profiles", cfg.Profile)
+	}
+	// ディレクトリの作成は初回アクセスまで遅らせる
+	cacheConfig.LazyFileInit = true
+	if !cfg.Cache.UseFileCache {
+		cacheConfig.FileEnabled = false
+	}
+
+	cacheService, err := cache.NewCacheWithConfig(cacheConfig)
+	if err != nil {
+		fmt.Fprintf(c.warnings, "Error: Failed to initialize cache: %v\n", err)
+		fmt.Fprintf(c.warnings, "Continuing without cache...\n")
+		return nil
+	}
+	return cacheService.(*cache.Cache)
+}
+
+// buildRepositories はリポジトリを初期化し、キャッシュ・ゲストモードのラッパーをかける
+func (c *Container) buildRepositories() {
+	c.IssueRepo = github.NewIssueRepository(c.Client)
+	c.PRRepo = github.NewPullRequestRepository(c.Client)
+	c.CommitRepo = github.NewCommitRepository(c.Client)
+	c.SearchRepo = github.NewSearchRepository(c.Client)
+	c.ReleaseRepo = github.NewReleaseRepository(c.Client)
+	c.GistRepo = github.NewGistRepository(c.Client)
+	c.StarRepo = github.NewStarRepository(c.Client)
+	c.WorkflowRepo = github.NewWorkflowRepository(c.Client)
+
+	// キャッシュでラップ
+	if c.Cache != nil {
+		c.IssueRepo = cache.NewCachedIssueRepository(c.IssueRepo, c.Cache)
+		c.PRRepo = cache.NewCachedPullRequestRepository(c.PRRepo, c.Cache)
+	}
+
+	// ゲストモードでは書き込み操作をすべて無効化
+	if c.Token == "" {
+		c.IssueRepo = readonly.NewIssueRepository(c.IssueRepo)
+		c.PRRepo = readonly.NewPullRequestRepository(c.PRRepo)
+		c.ReleaseRepo = readonly.NewReleaseRepository(c.ReleaseRepo)
+		c.GistRepo = readonly.NewGistRepository(c.GistRepo)
+		c.WorkflowRepo = readonly.NewWorkflowRepository(c.WorkflowRepo)
+	}
+}
+
+// Enabled は機能が組み立てられているかを返す
+func (c *Container) Enabled(name string) bool {
+	return containsFeature(c.enabled, name)
+}
+
+// Warn は組み立て後の処理（機能の Build など）で起きた、起動を止めない問題を出力する
+func (c *Container) Warn(err error) {
+	fmt.Fprintf(c.warnings, "Warning: %v\n", err)
+}
+
+// OpenRepository は開いたリポジトリを有効な機能に伝える
+func (c *Container) OpenRepository(owner, repo string) {
+	for _, feature := range c.enabled {
+		if feature.OpenRepository != nil {
+			feature.OpenRepository(c, owner, repo)
+		}
+	}
+}
+
+// NewTUI は owner/repo を開く TUI を組み立てる
+// 無効な機能のビューはキーで開けなくし、有効な機能はそれぞれ TUI に組み込む
+func (c *Container) NewTUI(owner, repo string) *ui.App {
+	cfg := c.Config
+	c.OpenRepository(owner, repo)
+
+	tui := ui.NewAppWithUseCases(
+		c.FetchIssues,
+		c.FetchPRs,
+		c.FetchCommits,
+		c.Search,
+		c.FetchReleases,
+		c.FetchGists,
+		c.FetchRuns,
+		c.FetchMetrics,
+		owner,
+		repo,
+		cfg.UI.DefaultView,
+		&cfg.Metrics,
+	)
+	for _, feature := range c.disabled {
+		for _, view := range feature.Views {
+			tui.DisableView(view)
+		}
+	}
+
+	tui.SetListLimits(cfg.UI.PageSize, cfg.UI.MaxItems)
+	if c.StateDir != "" {
+		// PR の差分で閲覧済みにしたファイルは、状態ディレクトリに PR ごとに記録する
+		tui.SetViewedFilesStore(viewed.NewStore(filepath.Join(c.StateDir, "viewed")))
+
+		// ウォッチ中の Issue / PR は一定間隔で確認し、新しい動きをデスクトップ通知する
+		var notifier usecase.Notifier
+		if cfg.Watch.DesktopNotifications {
+			notifier = notify.NewDesktop()
+		}
+		watchList := usecase.NewWatchUseCase(
+			watch.NewStore(filepath.Join(c.StateDir, "watched.json")),
+			c.IssueRepo,
+			c.PRRepo,
+			notifier,
+		)
+		tui.SetWatchList(watchList, cfg.Watch.PollInterval)
+	}
+	tui.SetASCIIIcons(cfg.UI.ASCIIIcons)
+	// 状態の色分けのパレット（colorblind は赤と緑に頼らず、状態に記号を添える）。ビューごとに指定できる
+	tui.SetPalettes(cfg.UI.Palette, cfg.UI.ViewPalettes)
+	// 表示中の一覧を一定間隔で再取得し、スタンドアップ中も最新の状態を表示する
+	tui.SetAutoRefresh(cfg.UI.AutoRefresh)
+	tui.SetGuestMode(c.Token == "")
+	tui.SetProtectedPaths(cfg.Review.ProtectedPaths)
+	tui.SetFreezeWindows(cfg.Review.FreezeWindows)
+	// PR 詳細ビューの ctrl+t で PR をワークツリーにチェックアウトし、review.test_command を実行する
+	tui.SetReviewCheckout(cfg.Review.TestCommand, paths.ExpandPath(cfg.Review.WorktreeDir))
+	tui.SetReleaseTrainUseCase(c.ReleaseTrain)
+	// 自分担当の Issue・自分の PR・レビュー依頼・メンションを M でまとめて表示する（a で github.repositories 全体に切り替え）
+	tui.SetMyWorkUseCase(c.MyWork, cfg.GitHub.Repositories)
+	// スターしたリポジトリを * で一覧し、Enter でそのリポジトリを開き直す
+	tui.SetStarredUseCase(c.FetchStarred)
+	// ビューごとの API 呼び出し数と残りのレート制限をステータスバーに表示する（U で内訳）
+	tui.SetAPIUsage(c.Client.Usage())
+
+	for _, feature := range c.enabled {
+		if feature.ConfigureTUI != nil {
+			feature.ConfigureTUI(c, tui)
+		}
+	}
+	return tui
+}
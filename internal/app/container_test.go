@@ -0,0 +1,138 @@
+package app_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/app"
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui"
+)
+
+// testConfig はキャッシュを使わない既定の設定を返す
+func testConfig() *models.Config {
+	cfg := models.DefaultConfig()
+	cfg.Cache.Enabled = false
+	return cfg
+}
+
+func TestNew_AllFeaturesByDefault(t *testing.T) {
+	c, err := app.New(testConfig(), "token")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	for _, name := range []string{"metrics", "queue", "actions"} {
+		if !c.Enabled(name) {
+			t.Errorf("%s: 既定では有効なはず", name)
+		}
+	}
+	if c.FetchMetrics == nil || c.FetchRuns == nil || c.FetchIssues == nil {
+		t.Fatal("有効な機能のユースケースが組み立てられていない")
+	}
+	// ライブ更新は既定では無効
+	if c.Enabled("live") || c.LiveUpdates != nil {
+		t.Error("ライブ更新は features.live で有効にした場合だけ組み立てるはず")
+	}
+
+	tui := c.NewTUI("owner", "repo")
+	for _, view := range []ui.ViewType{ui.MetricsView, ui.ReviewQueueView, ui.ActionsView} {
+		if tui.IsViewDisabled(view) {
+			t.Errorf("view %v: 有効な機能のビューが無効になっている", view)
+		}
+	}
+	if tui.IsGuestMode() {
+		t.Error("トークンがあるのにゲストモードになっている")
+	}
+}
+
+func TestNew_DisabledFeatures(t *testing.T) {
+	cfg := testConfig()
+	cfg.Features.Metrics = false
+	cfg.Features.Actions = false
+	cfg.UI.DefaultView = "actions"
+
+	c, err := app.New(cfg, "")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if c.Enabled("metrics") || c.Enabled("actions") || !c.Enabled("queue") {
+		t.Fatal("features.* の設定どおりに組み立てられていない")
+	}
+	if c.FetchMetrics != nil || c.FetchRuns != nil {
+		t.Error("無効な機能のユースケースは組み立てないはず")
+	}
+	// 無効な機能にリポジトリを伝えても落ちない
+	c.OpenRepository("owner", "repo")
+
+	tui := c.NewTUI("owner", "repo")
+	if !tui.IsViewDisabled(ui.MetricsView) || !tui.IsViewDisabled(ui.ActionsView) || tui.IsViewDisabled(ui.ReviewQueueView) {
+		t.Error("無効な機能のビューだけが開けなくなるはず")
+	}
+	if tui.GetCurrentView() != ui.IssueListView {
+		t.Errorf("初期ビューが無効な場合は Issue 一覧になるはず: got %v", tui.GetCurrentView())
+	}
+	if !tui.IsGuestMode() {
+		t.Error("トークンがない場合はゲストモードになるはず")
+	}
+}
+
+func TestNew_LiveUpdates(t *testing.T) {
+	cfg := testConfig()
+	cfg.Features.Live = true
+
+	c, err := app.New(cfg, "token")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if !c.Enabled("live") || c.LiveUpdates == nil {
+		t.Fatal("features.live を有効にしたのにライブ更新が組み立てられていない")
+	}
+	c.NewTUI("owner", "repo")
+}
+
+func TestNew_WithFeatures(t *testing.T) {
+	var built, opened, configured bool
+	custom := app.Feature{
+		Name: "custom",
+		Build: func(c *app.Container) error {
+			built = c.FetchIssues != nil
+			return nil
+		},
+		OpenRepository: func(c *app.Container, owner, repo string) {
+			opened = owner == "owner" && repo == "repo"
+		},
+		ConfigureTUI: func(c *app.Container, tui *ui.App) {
+			configured = true
+		},
+	}
+
+	// 指定した機能だけを持つアプリを組み立てる
+	c, err := app.New(testConfig(), "token", app.WithFeatures(app.QueueFeature, custom))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if !c.Enabled("custom") || !c.Enabled("queue") || c.Enabled("metrics") || c.Enabled("actions") {
+		t.Fatal("指定した機能だけが有効になるはず")
+	}
+	if !built {
+		t.Error("Build は共通のユースケースを組み立てた後に呼ばれるはず")
+	}
+
+	tui := c.NewTUI("owner", "repo")
+	if !opened || !configured {
+		t.Errorf("opened = %v, configured = %v; 開いたリポジトリと TUI が機能に渡されるはず", opened, configured)
+	}
+	if !tui.IsViewDisabled(ui.MetricsView) || !tui.IsViewDisabled(ui.ActionsView) {
+		t.Error("指定しなかった機能のビューは開けないはず")
+	}
+}
+
+func TestNew_BuildError(t *testing.T) {
+	failing := app.Feature{
+		Name:  "failing",
+		Build: func(c *app.Container) error { return errors.New("boom") },
+	}
+	if _, err := app.New(testConfig(), "token", app.WithFeatures(failing)); err == nil {
+		t.Fatal("機能の組み立てに失敗した場合はエラーを返すはず")
+	}
+}
@@ -0,0 +1,150 @@
+package app
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/a1yama/tig-gh/internal/app/usecase"
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/infra/config"
+	"github.com/a1yama/tig-gh/internal/infra/github"
+	"github.com/a1yama/tig-gh/internal/ui"
+)
+
+// Feature は設定で有効・無効を切り替えられるサブシステム
+// Register で登録すれば、main を変更せずにコンテナと TUI に組み込まれる
+type Feature struct {
+	// Name は機能の名前（features.<name> の設定名に合わせる）
+	Name string
+
+	// Enabled は設定でこの機能が有効かを返す（nil なら常に有効）
+	Enabled func(cfg *models.Config) bool
+
+	// Views はこの機能の TUI のビュー。機能が無効ならキーで開けなくなる
+	Views []ui.ViewType
+
+	// Build はコンテナのクライアント・リポジトリからこの機能のユースケースを組み立てる
+	Build func(c *Container) error
+
+	// OpenRepository は TUI・CLI で開いたリポジトリを受け取る
+	OpenRepository func(c *Container, owner, repo string)
+
+	// ConfigureTUI は組み立てた TUI にこの機能を組み込む
+	ConfigureTUI func(c *Container, tui *ui.App)
+}
+
+// enabledIn は設定でこの機能が有効かを返す
+func (f Feature) enabledIn(cfg *models.Config) bool {
+	return f.Enabled == nil || f.Enabled(cfg)
+}
+
+// registered は登録済みの機能（登録順）
+var registered []Feature
+
+// Register は機能を登録する。パッケージの init から呼ぶ
+func Register(feature Feature) {
+	if containsFeature(registered, feature.Name) {
+		panic(fmt.Sprintf("app: feature %q registered twice", feature.Name))
+	}
+	registered = append(registered, feature)
+}
+
+// Features は登録済みの機能を登録順に返す
+func Features() []Feature {
+	return append([]Feature(nil), registered...)
+}
+
+// containsFeature は features に name の機能があるかを返す
+func containsFeature(features []Feature, name string) bool {
+	for _, feature := range features {
+		if feature.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeFeatures は base に extra のうち base にない機能を加えたものを返す
+func mergeFeatures(base, extra []Feature) []Feature {
+	merged := append([]Feature(nil), base...)
+	for _, feature := range extra {
+		if !containsFeature(merged, feature.Name) {
+			merged = append(merged, feature)
+		}
+	}
+	return merged
+}
+
+// 組み込みの機能
+func init() {
+	Register(MetricsFeature)
+	Register(QueueFeature)
+	Register(ActionsFeature)
+	Register(LiveFeature)
+}
+
+// MetricsFeature はメトリクスビュー（m）と metrics・report コマンド
+var MetricsFeature = Feature{
+	Name:    "metrics",
+	Enabled: func(cfg *models.Config) bool { return cfg.Features.Metrics },
+	Views:   []ui.ViewType{ui.MetricsView},
+	Build: func(c *Container) error {
+		cfg := c.Config
+		metricsRepo := github.NewMetricsRepository(c.Client, cfg.Review.ProtectedPaths)
+		impl := metricsRepo.(*github.MetricsRepositoryImpl)
+		impl.SetLeadTimePercentiles(cfg.Metrics.LeadTimePercentiles)
+		impl.SetExcludedAuthors(cfg.Metrics.ExcludeAuthors, cfg.Metrics.ExcludeBots)
+		// 取得を終えたリポジトリのサンプルを状態ディレクトリに記録し、途中で終わった取得を次回も再開できるようにする
+		if c.StateDir != "" {
+			if err := impl.SetStashPath(filepath.Join(c.StateDir, "metrics-stash.json")); err != nil {
+				c.Warn(err)
+			}
+		}
+		c.FetchMetrics = usecase.NewFetchLeadTimeMetricsUseCase(metricsRepo, cfg)
+		return nil
+	},
+	// github.repositories が空の場合は開いたリポジトリを計測する
+	OpenRepository: func(c *Container, owner, repo string) {
+		c.FetchMetrics.SetCurrentRepository(owner, repo)
+	},
+	// Metrics ビューの p で追加したリポジトリを設定ファイルに保存する
+	ConfigureTUI: func(c *Container, tui *ui.App) {
+		c.FetchMetrics.SetRepositorySaver(func(repos []string) error {
+			return config.SaveRepositories(c.Config.Profile, repos)
+		})
+	},
+}
+
+// QueueFeature はレビューキュー（R）
+var QueueFeature = Feature{
+	Name:    "queue",
+	Enabled: func(cfg *models.Config) bool { return cfg.Features.Queue },
+	Views:   []ui.ViewType{ui.ReviewQueueView},
+}
+
+// ActionsFeature は GitHub Actions ビュー（A）
+var ActionsFeature = Feature{
+	Name:    "actions",
+	Enabled: func(cfg *models.Config) bool { return cfg.Features.Actions },
+	Views:   []ui.ViewType{ui.ActionsView},
+	Build: func(c *Container) error {
+		c.FetchRuns = usecase.NewFetchWorkflowRunsUseCase(c.WorkflowRepo)
+		return nil
+	},
+}
+
+// LiveFeature は開いているリポジトリのイベントを確認し、表示中の一覧・詳細に変更を反映するライブ更新
+var LiveFeature = Feature{
+	Name:    "live",
+	Enabled: func(cfg *models.Config) bool { return cfg.Features.Live },
+	Build: func(c *Container) error {
+		c.LiveUpdates = usecase.NewLiveUpdatesUseCase(github.NewEventRepository(c.Client))
+		return nil
+	},
+	OpenRepository: func(c *Container, owner, repo string) {
+		c.LiveUpdates.SetRepository(owner, repo)
+	},
+	ConfigureTUI: func(c *Container, tui *ui.App) {
+		tui.SetLiveUpdates(c.LiveUpdates, c.Config.Live.PollInterval)
+	},
+}
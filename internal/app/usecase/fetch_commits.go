@@ -40,3 +40,8 @@ func (uc *FetchCommitsUseCase) Execute(ctx context.Context, owner, repo string,
 
 	return commits, nil
 }
+
+// GetRepository returns the underlying commit repository
+func (uc *FetchCommitsUseCase) GetRepository() repository.CommitRepository {
+	return uc.repo
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+)
+
+// FetchDiffUseCase is the use case for fetching the diff of a pull request
+// page by page, one patch per file
+type FetchDiffUseCase struct {
+	repo repository.PullRequestRepository
+}
+
+// NewFetchDiffUseCase creates a new FetchDiffUseCase
+func NewFetchDiffUseCase(repo repository.PullRequestRepository) *FetchDiffUseCase {
+	return &FetchDiffUseCase{
+		repo: repo,
+	}
+}
+
+// Execute executes the use case to fetch one page of the changed files
+func (uc *FetchDiffUseCase) Execute(ctx context.Context, owner, repo string, number, page, perPage int) ([]*models.DiffFile, error) {
+	// バリデーション
+	if owner == "" {
+		return nil, errors.New("owner is required")
+	}
+
+	if repo == "" {
+		return nil, errors.New("repo is required")
+	}
+
+	if number <= 0 {
+		return nil, errors.New("invalid pull request number")
+	}
+
+	// リポジトリから取得
+	files, err := uc.repo.ListFilesPage(ctx, owner, repo, number, page, perPage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch diff: %w", err)
+	}
+
+	return files, nil
+}
@@ -0,0 +1,83 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/app/usecase"
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFetchDiffUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name      string
+		owner     string
+		number    int
+		mockSetup func(*mock.MockPullRequestRepository)
+		wantFiles int
+		errMsg    string
+	}{
+		{
+			name:   "正常系: 指定ページのファイルを取得",
+			owner:  "test-owner",
+			number: 12,
+			mockSetup: func(m *mock.MockPullRequestRepository) {
+				m.EXPECT().
+					ListFilesPage(gomock.Any(), "test-owner", "test-repo", 12, 2, 100).
+					Return([]*models.DiffFile{{Filename: "main.go", Patch: "@@ -1 +1 @@\n-a\n+b"}}, nil)
+			},
+			wantFiles: 1,
+		},
+		{
+			name:      "異常系: ownerが空",
+			number:    12,
+			mockSetup: func(m *mock.MockPullRequestRepository) {},
+			errMsg:    "owner is required",
+		},
+		{
+			name:      "異常系: PR番号が不正",
+			owner:     "test-owner",
+			mockSetup: func(m *mock.MockPullRequestRepository) {},
+			errMsg:    "invalid pull request number",
+		},
+		{
+			name:   "異常系: APIエラー",
+			owner:  "test-owner",
+			number: 12,
+			mockSetup: func(m *mock.MockPullRequestRepository) {
+				m.EXPECT().
+					ListFilesPage(gomock.Any(), "test-owner", "test-repo", 12, 2, 100).
+					Return(nil, errors.New("not found"))
+			},
+			errMsg: "failed to fetch diff",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mock.NewMockPullRequestRepository(ctrl)
+			tt.mockSetup(repo)
+
+			files, err := usecase.NewFetchDiffUseCase(repo).Execute(context.Background(), tt.owner, "test-repo", tt.number, 2, 100)
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("Execute() error = %v, want %q", err, tt.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() unexpected error = %v", err)
+			}
+			if len(files) != tt.wantFiles {
+				t.Errorf("Execute() returned %d files, want %d", len(files), tt.wantFiles)
+			}
+		})
+	}
+}
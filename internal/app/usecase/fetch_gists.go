@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+)
+
+// FetchGistsUseCase is the use case for fetching the authenticated user's gists
+type FetchGistsUseCase struct {
+	repo repository.GistRepository
+}
+
+// NewFetchGistsUseCase creates a new FetchGistsUseCase
+func NewFetchGistsUseCase(repo repository.GistRepository) *FetchGistsUseCase {
+	return &FetchGistsUseCase{
+		repo: repo,
+	}
+}
+
+// Execute executes the use case to fetch gists
+func (uc *FetchGistsUseCase) Execute(ctx context.Context, opts *models.GistOptions) ([]*models.Gist, error) {
+	// リポジトリから取得
+	gists, err := uc.repo.List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch gists: %w", err)
+	}
+
+	return gists, nil
+}
+
+// GetRepository returns the underlying gist repository
+func (uc *FetchGistsUseCase) GetRepository() repository.GistRepository {
+	return uc.repo
+}
@@ -9,7 +9,7 @@ import (
 
 	"github.com/a1yama/tig-gh/internal/domain/models"
 	"github.com/a1yama/tig-gh/internal/domain/repository"
-	"github.com/google/go-github/v57/github"
+	"github.com/a1yama/tig-gh/internal/infra/clock"
 )
 
 var (
@@ -26,6 +26,14 @@ type FetchLeadTimeMetricsUseCase struct {
 	repo repository.MetricsRepository
 	cfg  *models.Config
 	now  func() time.Time
+
+	// current は起動時に開いたリポジトリ（owner/repo形式）
+	// github.repositories が空の場合はこれを計測対象にする
+	current string
+	// saveRepositories は追加したリポジトリを設定ファイルに保存する
+	saveRepositories func(repos []string) error
+	// lastSince は直前の取得の集計開始日時（失敗したリポジトリの再取得で同じ期間を使う）
+	lastSince time.Time
 }
 
 // NewFetchLeadTimeMetricsUseCase はユースケースを生成する
@@ -33,31 +41,104 @@ func NewFetchLeadTimeMetricsUseCase(repo repository.MetricsRepository, cfg *mode
 	return &FetchLeadTimeMetricsUseCase{
 		repo: repo,
 		cfg:  cfg,
-		now:  time.Now,
+		now:  clock.Now,
 	}
 }
 
-// Execute は設定に基づきリードタイムメトリクスを取得する
-func (uc *FetchLeadTimeMetricsUseCase) Execute(ctx context.Context, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error) {
+// SetCurrentRepository は起動時に開いたリポジトリを設定する
+func (uc *FetchLeadTimeMetricsUseCase) SetCurrentRepository(owner, repo string) {
+	owner = strings.TrimSpace(owner)
+	repo = strings.TrimSpace(repo)
+	if owner == "" || repo == "" {
+		uc.current = ""
+		return
+	}
+	uc.current = owner + "/" + repo
+}
+
+// SetRepositorySaver は AddRepositories で追加したリポジトリの保存先を設定する
+func (uc *FetchLeadTimeMetricsUseCase) SetRepositorySaver(save func(repos []string) error) {
+	uc.saveRepositories = save
+}
+
+// UsingDetectedRepositories は github.repositories が空で、
+// 開いているリポジトリなどを代わりに計測しているかどうかを返す
+func (uc *FetchLeadTimeMetricsUseCase) UsingDetectedRepositories() bool {
+	return uc.cfg != nil && len(uc.configuredRepositories()) == 0
+}
+
+// Repositories は計測対象のリポジトリを返す
+func (uc *FetchLeadTimeMetricsUseCase) Repositories() []string {
+	return uc.resolveRepositories()
+}
+
+// SuggestRepositories は計測対象に追加できるリポジトリの候補を主要言語・トピックとともに返す
+// 開いているリポジトリのオーナー（組織）と認証ユーザーの最近更新されたリポジトリから、計測中のものを除く
+func (uc *FetchLeadTimeMetricsUseCase) SuggestRepositories(ctx context.Context) ([]*models.RepositorySummary, error) {
 	if uc.repo == nil {
 		return nil, fmt.Errorf("metrics repository is required")
 	}
 
+	owner := ""
+	if repos := uc.resolveRepositories(); len(repos) > 0 {
+		owner, _, _ = strings.Cut(repos[0], "/")
+	}
+
+	candidates, err := uc.repo.ListRecentRepositories(ctx, owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repositories: %w", err)
+	}
+
+	current := make(map[string]struct{})
+	for _, repo := range uc.resolveRepositories() {
+		current[strings.ToLower(repo)] = struct{}{}
+	}
+	suggestions := make([]*models.RepositorySummary, 0, len(candidates))
+	for _, repo := range candidates {
+		if _, ok := current[strings.ToLower(repo.FullName)]; ok {
+			continue
+		}
+		suggestions = append(suggestions, repo)
+	}
+	return suggestions, nil
+}
+
+// AddRepositories は現在の計測対象に repos を加えて github.repositories に保存する
+// 自動で選んだリポジトリも保存するため、以降は設定どおりに計測される
+func (uc *FetchLeadTimeMetricsUseCase) AddRepositories(repos []string) error {
 	if uc.cfg == nil {
-		return nil, fmt.Errorf("config is required")
+		return fmt.Errorf("config is required")
 	}
 
-	if !uc.cfg.Metrics.Enabled {
-		return nil, ErrMetricsDisabled
+	merged := uc.resolveRepositories()
+	seen := make(map[string]struct{}, len(merged))
+	for _, repo := range merged {
+		seen[strings.ToLower(repo)] = struct{}{}
+	}
+	for _, repo := range repos {
+		repo = strings.TrimSpace(repo)
+		if _, ok := seen[strings.ToLower(repo)]; ok || repo == "" {
+			continue
+		}
+		seen[strings.ToLower(repo)] = struct{}{}
+		merged = append(merged, repo)
 	}
 
-	if !uc.cfg.Metrics.LeadTimeEnabled {
-		return nil, ErrLeadTimeMetricsDisabled
+	if uc.saveRepositories != nil {
+		if err := uc.saveRepositories(merged); err != nil {
+			return fmt.Errorf("failed to save repositories: %w", err)
+		}
 	}
+	uc.cfg.GitHub.Repositories = merged
+	return nil
+}
 
-	repos := uc.resolveRepositories()
-	if len(repos) == 0 {
-		return nil, ErrNoRepositoriesConfigured
+// Execute は設定に基づきリードタイムメトリクスを取得する。
+// 一部のリポジトリの取得に失敗した場合は残りで計算し、失敗は RepositoryStatuses に記録される
+func (uc *FetchLeadTimeMetricsUseCase) Execute(ctx context.Context, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error) {
+	repos, err := uc.repositoriesToMeasure()
+	if err != nil {
+		return nil, err
 	}
 
 	period := uc.cfg.Metrics.CalculationPeriod
@@ -70,23 +151,196 @@ func (uc *FetchLeadTimeMetricsUseCase) Execute(ctx context.Context, progressFn f
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch lead time metrics: %w", err)
 	}
+	uc.lastSince = since
+
+	// Issue数の推移とラベルの使われ方は補助的な情報のため、取得に失敗してもリードタイムは表示する
+	if uc.cfg.Metrics.ShowIssueBacklog || uc.cfg.Metrics.ShowLabelInsights {
+		backlog, err := uc.repo.FetchIssueBacklog(ctx, repos, uc.cfg.Metrics.IssueBacklogWeeks, uc.cfg.Metrics.IssueLabelBuckets, uc.now())
+		if err == nil && backlog != nil {
+			metrics.IssueBacklog = *backlog
+		}
+	}
+
+	return metrics, nil
+}
+
+// RetryFailedRepositories は previous で取得に失敗したリポジトリだけを取得し直し、
+// 成功していたリポジトリは前回の結果を使って全体を計算し直す
+func (uc *FetchLeadTimeMetricsUseCase) RetryFailedRepositories(ctx context.Context, previous *models.LeadTimeMetrics, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error) {
+	if previous == nil || len(previous.FailedRepositories()) == 0 || uc.lastSince.IsZero() {
+		return uc.Execute(ctx, progressFn)
+	}
+
+	repos, err := uc.repositoriesToMeasure()
+	if err != nil {
+		return nil, err
+	}
+
+	failed := previous.FailedRepositories()
+	metrics, err := uc.repo.RefetchLeadTimeMetrics(ctx, repos, failed, uc.lastSince, progressFn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch lead time metrics: %w", err)
+	}
+
+	// Issue数の推移も失敗したリポジトリの分だけ取得し、前回と同じ集計時点で合算する
+	if uc.cfg.Metrics.ShowIssueBacklog || uc.cfg.Metrics.ShowLabelInsights {
+		metrics.IssueBacklog = previous.IssueBacklog
+		fetch, now := repos, uc.now()
+		if weeks := previous.IssueBacklog.Weeks; len(weeks) > 0 {
+			fetch, now = failed, weeks[len(weeks)-1].End
+		}
+		backlog, err := uc.repo.FetchIssueBacklog(ctx, fetch, uc.cfg.Metrics.IssueBacklogWeeks, uc.cfg.Metrics.IssueLabelBuckets, now)
+		if err == nil && backlog != nil {
+			metrics.IssueBacklog = mergeIssueBacklog(previous.IssueBacklog, *backlog, repos)
+		}
+	}
+
+	return metrics, nil
+}
+
+// InterruptedRun は計測対象の直前の取得が途中で終わっていればその記録を返す（なければ nil）
+// アプリを終了する前の取得も、記録が残っていれば返す
+func (uc *FetchLeadTimeMetricsUseCase) InterruptedRun() *models.InterruptedMetricsRun {
+	repos, err := uc.repositoriesToMeasure()
+	if err != nil {
+		return nil
+	}
+	return uc.repo.InterruptedRun(repos)
+}
+
+// ResumeInterruptedRun は途中で終わった取得のうち、済んでいないリポジトリだけを取得して
+// 全体を計算する。集計期間は中断した取得のものを使う。再開するものがなければすべて取得する
+func (uc *FetchLeadTimeMetricsUseCase) ResumeInterruptedRun(ctx context.Context, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error) {
+	repos, err := uc.repositoriesToMeasure()
+	if err != nil {
+		return nil, err
+	}
+	run := uc.repo.InterruptedRun(repos)
+	if run == nil {
+		return uc.Execute(ctx, progressFn)
+	}
+
+	metrics, err := uc.repo.RefetchLeadTimeMetrics(ctx, repos, nil, run.Since, progressFn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch lead time metrics: %w", err)
+	}
+	uc.lastSince = run.Since
+
+	// Issue数の推移は記録していないため、すべてのリポジトリを取得し直す
+	if uc.cfg.Metrics.ShowIssueBacklog || uc.cfg.Metrics.ShowLabelInsights {
+		backlog, err := uc.repo.FetchIssueBacklog(ctx, repos, uc.cfg.Metrics.IssueBacklogWeeks, uc.cfg.Metrics.IssueLabelBuckets, uc.now())
+		if err == nil && backlog != nil {
+			metrics.IssueBacklog = *backlog
+		}
+	}
 
 	return metrics, nil
 }
 
+// mergeIssueBacklog は前回の推移に再取得したリポジトリの推移を加え、repos の合計を計算し直す
+func mergeIssueBacklog(previous, refetched models.IssueBacklogMetrics, repos []string) models.IssueBacklogMetrics {
+	merged := models.IssueBacklogMetrics{
+		Buckets:            refetched.Buckets,
+		ByRepository:       make(map[string][]models.IssueBacklogWeek),
+		LabelsByRepository: make(map[string]models.LabelInsights),
+	}
+	for _, repo := range repos {
+		if weeks, ok := refetched.ByRepository[repo]; ok {
+			merged.ByRepository[repo] = weeks
+		} else if weeks, ok := previous.ByRepository[repo]; ok {
+			merged.ByRepository[repo] = weeks
+		}
+		if labels, ok := refetched.LabelsByRepository[repo]; ok {
+			merged.LabelsByRepository[repo] = labels
+		} else if labels, ok := previous.LabelsByRepository[repo]; ok {
+			merged.LabelsByRepository[repo] = labels
+		}
+	}
+	for _, labels := range merged.LabelsByRepository {
+		merged.Labels.Merge(labels)
+	}
+
+	for _, weeks := range merged.ByRepository {
+		if merged.Weeks == nil {
+			merged.Weeks = make([]models.IssueBacklogWeek, len(weeks))
+			for i, week := range weeks {
+				merged.Weeks[i] = models.IssueBacklogWeek{End: week.End, Open: make(map[string]int)}
+			}
+		}
+		for i, week := range weeks {
+			if i >= len(merged.Weeks) {
+				break
+			}
+			for bucket, n := range week.Open {
+				merged.Weeks[i].Open[bucket] += n
+			}
+		}
+	}
+	if merged.Weeks == nil {
+		merged.Weeks = refetched.Weeks
+	}
+	return merged
+}
+
+// repositoriesToMeasure は設定を検証し、計測対象のリポジトリを返す
+func (uc *FetchLeadTimeMetricsUseCase) repositoriesToMeasure() ([]string, error) {
+	if uc.repo == nil {
+		return nil, fmt.Errorf("metrics repository is required")
+	}
+
+	if uc.cfg == nil {
+		return nil, fmt.Errorf("config is required")
+	}
+
+	if !uc.cfg.Metrics.Enabled {
+		return nil, ErrMetricsDisabled
+	}
+
+	if !uc.cfg.Metrics.LeadTimeEnabled {
+		return nil, ErrLeadTimeMetricsDisabled
+	}
+
+	repos := uc.resolveRepositories()
+	if len(repos) == 0 {
+		return nil, ErrNoRepositoriesConfigured
+	}
+	return repos, nil
+}
+
 // GetRateLimit returns current GitHub API rate limit
-func (uc *FetchLeadTimeMetricsUseCase) GetRateLimit(ctx context.Context) (*github.Rate, error) {
+func (uc *FetchLeadTimeMetricsUseCase) GetRateLimit(ctx context.Context) (*models.RateLimit, error) {
 	if uc.repo == nil {
 		return nil, fmt.Errorf("repository is not initialized")
 	}
 	return uc.repo.GetRateLimit(ctx)
 }
 
+// resolveRepositories は計測対象を github.repositories・開いているリポジトリ・
+// default_owner/default_repo の順に決める
 func (uc *FetchLeadTimeMetricsUseCase) resolveRepositories() []string {
 	if uc.cfg == nil {
 		return nil
 	}
 
+	if repos := uc.configuredRepositories(); len(repos) > 0 {
+		return repos
+	}
+
+	if uc.current != "" {
+		return []string{uc.current}
+	}
+
+	owner := strings.TrimSpace(uc.cfg.GitHub.DefaultOwner)
+	repo := strings.TrimSpace(uc.cfg.GitHub.DefaultRepo)
+	if owner != "" && repo != "" {
+		return []string{fmt.Sprintf("%s/%s", owner, repo)}
+	}
+
+	return nil
+}
+
+// configuredRepositories は github.repositories の空白と重複を除いたものを返す
+func (uc *FetchLeadTimeMetricsUseCase) configuredRepositories() []string {
 	repos := make([]string, 0, len(uc.cfg.GitHub.Repositories))
 	seen := make(map[string]struct{})
 	for _, repo := range uc.cfg.GitHub.Repositories {
@@ -100,16 +354,5 @@ func (uc *FetchLeadTimeMetricsUseCase) resolveRepositories() []string {
 		seen[repo] = struct{}{}
 		repos = append(repos, repo)
 	}
-
-	if len(repos) > 0 {
-		return repos
-	}
-
-	owner := strings.TrimSpace(uc.cfg.GitHub.DefaultOwner)
-	repo := strings.TrimSpace(uc.cfg.GitHub.DefaultRepo)
-	if owner != "" && repo != "" {
-		return []string{fmt.Sprintf("%s/%s", owner, repo)}
-	}
-
-	return nil
+	return repos
 }
@@ -8,8 +8,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/google/go-github/v57/github"
-
 	"github.com/a1yama/tig-gh/internal/domain/models"
 )
 
@@ -17,9 +15,21 @@ type stubMetricsRepository struct {
 	metrics *models.LeadTimeMetrics
 	err     error
 
-	called bool
-	repos  []string
-	since  time.Time
+	backlog    *models.IssueBacklogMetrics
+	backlogErr error
+	recent     []*models.RepositorySummary
+	recentErr  error
+	run        *models.InterruptedMetricsRun
+
+	called        bool
+	repos         []string
+	since         time.Time
+	backlogWeeks  int
+	backlogLabels []string
+	recentOwner   string
+	failed        []string
+	backlogRepos  []string
+	backlogNow    time.Time
 }
 
 func (s *stubMetricsRepository) FetchLeadTimeMetrics(ctx context.Context, repos []string, since time.Time, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error) {
@@ -57,11 +67,37 @@ func (s *stubMetricsRepository) FetchLeadTimeMetrics(ctx context.Context, repos
 	return s.metrics, nil
 }
 
-func (s *stubMetricsRepository) GetRateLimit(ctx context.Context) (*github.Rate, error) {
-	return &github.Rate{
+func (s *stubMetricsRepository) RefetchLeadTimeMetrics(ctx context.Context, repos, failed []string, since time.Time, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error) {
+	s.failed = append([]string{}, failed...)
+	return s.FetchLeadTimeMetrics(ctx, repos, since, progressFn)
+}
+
+func (s *stubMetricsRepository) InterruptedRun(repos []string) *models.InterruptedMetricsRun {
+	return s.run
+}
+
+func (s *stubMetricsRepository) FetchIssueBacklog(ctx context.Context, repos []string, weeks int, buckets []string, now time.Time) (*models.IssueBacklogMetrics, error) {
+	s.backlogRepos = append([]string{}, repos...)
+	s.backlogNow = now
+	s.backlogWeeks = weeks
+	s.backlogLabels = append([]string{}, buckets...)
+	if s.backlogErr != nil {
+		return nil, s.backlogErr
+	}
+	return s.backlog, nil
+}
+
+func (s *stubMetricsRepository) ListRecentRepositories(ctx context.Context, owner string) ([]*models.RepositorySummary, error) {
+	s.recentOwner = owner
+	return s.recent, s.recentErr
+}
+
+func (s *stubMetricsRepository) GetRateLimit(ctx context.Context) (*models.RateLimit, error) {
+	return &models.RateLimit{
+		Known:     true,
 		Limit:     5000,
 		Remaining: 4500,
-		Reset:     github.Timestamp{Time: time.Now().Add(time.Hour)},
+		Reset:     time.Now().Add(time.Hour),
 	}, nil
 }
 
@@ -106,6 +142,62 @@ func TestFetchLeadTimeMetricsUseCase_ExecuteSuccess(t *testing.T) {
 	}
 }
 
+func TestFetchLeadTimeMetricsUseCase_IssueBacklog(t *testing.T) {
+	cfg := models.DefaultConfig()
+	cfg.Metrics.Enabled = true
+	cfg.Metrics.LeadTimeEnabled = true
+	cfg.Metrics.IssueBacklogWeeks = 4
+	cfg.GitHub.Repositories = []string{"owner/repo"}
+
+	backlog := &models.IssueBacklogMetrics{Buckets: []string{"bug", "enhancement", "other"}}
+	repo := &stubMetricsRepository{backlog: backlog}
+	uc := NewFetchLeadTimeMetricsUseCase(repo, cfg)
+
+	result, err := uc.Execute(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Execute() returned error: %v", err)
+	}
+	if !reflect.DeepEqual(result.IssueBacklog, *backlog) {
+		t.Fatalf("unexpected issue backlog: %+v", result.IssueBacklog)
+	}
+	if repo.backlogWeeks != 4 || !reflect.DeepEqual(repo.backlogLabels, []string{"bug", "enhancement"}) {
+		t.Fatalf("unexpected backlog options: %d %v", repo.backlogWeeks, repo.backlogLabels)
+	}
+
+	// 失敗してもリードタイムは返す
+	repo = &stubMetricsRepository{backlogErr: errors.New("boom")}
+	uc = NewFetchLeadTimeMetricsUseCase(repo, cfg)
+	result, err = uc.Execute(context.Background(), nil)
+	if err != nil || result == nil {
+		t.Fatalf("backlog failure should not fail metrics: %v", err)
+	}
+	if len(result.IssueBacklog.Weeks) != 0 {
+		t.Fatalf("expected empty backlog, got %+v", result.IssueBacklog)
+	}
+
+	// ラベルの使われ方だけ表示する場合も取得する
+	cfg.Metrics.ShowIssueBacklog = false
+	repo = &stubMetricsRepository{backlog: backlog}
+	uc = NewFetchLeadTimeMetricsUseCase(repo, cfg)
+	if _, err := uc.Execute(context.Background(), nil); err != nil {
+		t.Fatalf("Execute() returned error: %v", err)
+	}
+	if repo.backlogWeeks != 4 {
+		t.Fatalf("backlog should be fetched for label insights")
+	}
+
+	// どちらも非表示なら取得しない
+	cfg.Metrics.ShowLabelInsights = false
+	repo = &stubMetricsRepository{backlog: backlog}
+	uc = NewFetchLeadTimeMetricsUseCase(repo, cfg)
+	if _, err := uc.Execute(context.Background(), nil); err != nil {
+		t.Fatalf("Execute() returned error: %v", err)
+	}
+	if repo.backlogWeeks != 0 {
+		t.Fatalf("backlog should not be fetched when hidden")
+	}
+}
+
 func TestFetchLeadTimeMetricsUseCase_Disabled(t *testing.T) {
 	cfg := models.DefaultConfig()
 	cfg.Metrics.Enabled = false
@@ -176,6 +268,81 @@ func TestFetchLeadTimeMetricsUseCase_RepositoryError(t *testing.T) {
 	}
 }
 
+func TestFetchLeadTimeMetricsUseCase_FallbackCurrentRepo(t *testing.T) {
+	cfg := models.DefaultConfig()
+	cfg.Metrics.Enabled = true
+	cfg.Metrics.LeadTimeEnabled = true
+	cfg.GitHub.DefaultOwner = "default-owner"
+	cfg.GitHub.DefaultRepo = "default-repo"
+	cfg.GitHub.Repositories = nil
+
+	repo := &stubMetricsRepository{}
+	uc := NewFetchLeadTimeMetricsUseCase(repo, cfg)
+	uc.SetCurrentRepository("acme", "app")
+
+	if _, err := uc.Execute(context.Background(), nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(repo.repos, []string{"acme/app"}) {
+		t.Fatalf("expected the current repo, got %+v", repo.repos)
+	}
+	if !uc.UsingDetectedRepositories() {
+		t.Fatal("expected detected repositories")
+	}
+
+	// 設定があればそちらを使う
+	cfg.GitHub.Repositories = []string{"acme/api"}
+	if uc.UsingDetectedRepositories() || !reflect.DeepEqual(uc.Repositories(), []string{"acme/api"}) {
+		t.Fatalf("expected configured repositories, got %+v", uc.Repositories())
+	}
+}
+
+func TestFetchLeadTimeMetricsUseCase_SuggestAndAddRepositories(t *testing.T) {
+	cfg := models.DefaultConfig()
+	cfg.GitHub.Repositories = nil
+
+	repo := &stubMetricsRepository{recent: []*models.RepositorySummary{
+		{FullName: "acme/api", Language: "Go", Topics: []string{"backend"}},
+		{FullName: "Acme/App"},
+		{FullName: "me/dotfiles", Language: "Shell"},
+	}}
+	uc := NewFetchLeadTimeMetricsUseCase(repo, cfg)
+	uc.SetCurrentRepository("acme", "app")
+
+	suggestions, err := uc.SuggestRepositories(context.Background())
+	if err != nil {
+		t.Fatalf("SuggestRepositories() error = %v", err)
+	}
+	if repo.recentOwner != "acme" || !reflect.DeepEqual(suggestions, []*models.RepositorySummary{repo.recent[0], repo.recent[2]}) {
+		t.Fatalf("unexpected suggestions for %q: %+v", repo.recentOwner, suggestions)
+	}
+
+	var saved []string
+	uc.SetRepositorySaver(func(repos []string) error {
+		saved = repos
+		return nil
+	})
+	if err := uc.AddRepositories([]string{"acme/api", " acme/api ", "ACME/APP"}); err != nil {
+		t.Fatalf("AddRepositories() error = %v", err)
+	}
+	want := []string{"acme/app", "acme/api"}
+	if !reflect.DeepEqual(saved, want) || !reflect.DeepEqual(cfg.GitHub.Repositories, want) {
+		t.Fatalf("saved %v, config %v; want %v", saved, cfg.GitHub.Repositories, want)
+	}
+	if uc.UsingDetectedRepositories() {
+		t.Fatal("saved repositories should be used from now on")
+	}
+
+	// 保存に失敗したら設定は変えない
+	uc.SetRepositorySaver(func([]string) error { return errors.New("read-only") })
+	if err := uc.AddRepositories([]string{"me/dotfiles"}); err == nil || !strings.Contains(err.Error(), "read-only") {
+		t.Fatalf("expected save error, got %v", err)
+	}
+	if !reflect.DeepEqual(cfg.GitHub.Repositories, want) {
+		t.Fatalf("config changed after failed save: %v", cfg.GitHub.Repositories)
+	}
+}
+
 func TestFetchLeadTimeMetricsUseCase_FallbackDefaultRepo(t *testing.T) {
 	cfg := models.DefaultConfig()
 	cfg.Metrics.Enabled = true
@@ -196,3 +363,126 @@ func TestFetchLeadTimeMetricsUseCase_FallbackDefaultRepo(t *testing.T) {
 		t.Fatalf("expected default repo fallback, got %+v", repo.repos)
 	}
 }
+
+func TestFetchLeadTimeMetricsUseCase_RetryFailedRepositories(t *testing.T) {
+	cfg := models.DefaultConfig()
+	cfg.Metrics.Enabled = true
+	cfg.Metrics.LeadTimeEnabled = true
+	cfg.Metrics.CalculationPeriod = 72 * time.Hour
+	cfg.GitHub.Repositories = []string{"owner/ok", "owner/gone"}
+
+	firstNow := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	week := func(bug, other int) []models.IssueBacklogWeek {
+		return []models.IssueBacklogWeek{{End: firstNow, Open: map[string]int{"bug": bug, "other": other}}}
+	}
+	previous := &models.LeadTimeMetrics{
+		RepositoryStatuses: []models.MetricsRepositoryStatus{
+			{Repository: "owner/ok"},
+			{Repository: "owner/gone", Error: "resource not found (404)"},
+		},
+		IssueBacklog: models.IssueBacklogMetrics{
+			Buckets:      []string{"bug", "other"},
+			Weeks:        week(1, 2),
+			ByRepository: map[string][]models.IssueBacklogWeek{"owner/ok": week(1, 2)},
+			LabelsByRepository: map[string]models.LabelInsights{
+				"owner/ok": {Issues: 3, Unlabeled: 2, Labels: map[string]int{"bug": 1}},
+			},
+		},
+	}
+
+	repo := &stubMetricsRepository{
+		metrics: &models.LeadTimeMetrics{ByRepository: map[string]models.LeadTimeStat{}},
+		backlog: &models.IssueBacklogMetrics{
+			Buckets:      []string{"bug", "other"},
+			Weeks:        week(3, 0),
+			ByRepository: map[string][]models.IssueBacklogWeek{"owner/gone": week(3, 0)},
+			LabelsByRepository: map[string]models.LabelInsights{
+				"owner/gone": {Issues: 3, Labels: map[string]int{"bug": 3}},
+			},
+		},
+	}
+	uc := NewFetchLeadTimeMetricsUseCase(repo, cfg)
+	uc.now = func() time.Time { return firstNow }
+	if _, err := uc.Execute(context.Background(), nil); err != nil {
+		t.Fatalf("Execute() returned error: %v", err)
+	}
+
+	// The retry measures the same period as the run it retries
+	uc.now = func() time.Time { return firstNow.Add(time.Hour) }
+	result, err := uc.RetryFailedRepositories(context.Background(), previous, nil)
+	if err != nil {
+		t.Fatalf("RetryFailedRepositories() returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(repo.failed, []string{"owner/gone"}) {
+		t.Errorf("refetched %v, want only the failed repository", repo.failed)
+	}
+	if !repo.since.Equal(firstNow.Add(-72 * time.Hour)) {
+		t.Errorf("since = %v, want the previous run's", repo.since)
+	}
+	if !reflect.DeepEqual(repo.backlogRepos, []string{"owner/gone"}) || !repo.backlogNow.Equal(firstNow) {
+		t.Errorf("backlog fetched for %v at %v, want the failed repository at the previous weeks", repo.backlogRepos, repo.backlogNow)
+	}
+	if got := result.IssueBacklog.Weeks[0].Open; got["bug"] != 4 || got["other"] != 2 {
+		t.Errorf("merged backlog = %v, want bug 4, other 2", got)
+	}
+	if len(result.IssueBacklog.ByRepository) != 2 {
+		t.Errorf("ByRepository = %v, want both repositories", result.IssueBacklog.ByRepository)
+	}
+	if labels := result.IssueBacklog.Labels; labels.Issues != 6 || labels.Unlabeled != 2 || labels.Labels["bug"] != 4 {
+		t.Errorf("merged labels = %+v, want both repositories summed", labels)
+	}
+}
+
+func TestFetchLeadTimeMetricsUseCase_RetryWithoutFailuresRefetchesAll(t *testing.T) {
+	cfg := models.DefaultConfig()
+	cfg.Metrics.Enabled = true
+	cfg.Metrics.LeadTimeEnabled = true
+	cfg.GitHub.Repositories = []string{"owner/repo"}
+
+	repo := &stubMetricsRepository{}
+	uc := NewFetchLeadTimeMetricsUseCase(repo, cfg)
+	if _, err := uc.RetryFailedRepositories(context.Background(), &models.LeadTimeMetrics{}, nil); err != nil {
+		t.Fatalf("RetryFailedRepositories() returned error: %v", err)
+	}
+	if !repo.called || repo.failed != nil {
+		t.Error("expected a full fetch when nothing failed")
+	}
+}
+
+func TestFetchLeadTimeMetricsUseCase_ResumeInterruptedRun(t *testing.T) {
+	cfg := models.DefaultConfig()
+	cfg.Metrics.Enabled = true
+	cfg.Metrics.LeadTimeEnabled = true
+	cfg.GitHub.Repositories = []string{"owner/done", "owner/left"}
+
+	started := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	run := &models.InterruptedMetricsRun{Since: started, Completed: []string{"owner/done"}, Remaining: []string{"owner/left"}}
+	repo := &stubMetricsRepository{run: run}
+	uc := NewFetchLeadTimeMetricsUseCase(repo, cfg)
+	if got := uc.InterruptedRun(); got != run {
+		t.Fatalf("InterruptedRun() = %+v, want the repository's record", got)
+	}
+
+	if _, err := uc.ResumeInterruptedRun(context.Background(), nil); err != nil {
+		t.Fatalf("ResumeInterruptedRun() returned error: %v", err)
+	}
+	// 中断した取得と同じ期間で、記録にないリポジトリだけを取得する
+	if !repo.since.Equal(started) || len(repo.failed) != 0 {
+		t.Errorf("resumed since %v with failed %v, want the interrupted run's period", repo.since, repo.failed)
+	}
+	if !uc.lastSince.Equal(started) {
+		t.Errorf("lastSince = %v, want the resumed period for later retries", uc.lastSince)
+	}
+
+	// 再開するものがなければすべて取得する
+	repo = &stubMetricsRepository{}
+	uc = NewFetchLeadTimeMetricsUseCase(repo, cfg)
+	uc.now = func() time.Time { return started.Add(24 * time.Hour) }
+	if _, err := uc.ResumeInterruptedRun(context.Background(), nil); err != nil {
+		t.Fatalf("ResumeInterruptedRun() returned error: %v", err)
+	}
+	if !repo.called || repo.since.Equal(started) {
+		t.Errorf("expected a full fetch for the current period, got since %v", repo.since)
+	}
+}
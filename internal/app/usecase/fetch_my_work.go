@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+)
+
+// myWorkPerSection is the number of items fetched for each section
+const myWorkPerSection = 50
+
+// myWorkQueries are the searches behind the sections, in display order.
+// @me stands for the authenticated user, so no user lookup is needed.
+var myWorkQueries = []struct {
+	category models.MyWorkCategory
+	title    string
+	typ      models.SearchType
+	query    string
+}{
+	{models.MyWorkAssigned, "Assigned to me", models.SearchTypeIssue, "assignee:@me"},
+	{models.MyWorkAuthored, "My pull requests", models.SearchTypePR, "author:@me"},
+	{models.MyWorkReviewRequested, "Review requested", models.SearchTypePR, "review-requested:@me"},
+	{models.MyWorkMentioned, "Mentioned", models.SearchTypeBoth, "mentions:@me"},
+}
+
+// FetchMyWorkUseCase gathers the open issues assigned to the user, the pull
+// requests they authored or were asked to review and the items mentioning
+// them, using the search API
+type FetchMyWorkUseCase struct {
+	searchRepo repository.SearchRepository
+}
+
+// NewFetchMyWorkUseCase creates a new FetchMyWorkUseCase
+func NewFetchMyWorkUseCase(searchRepo repository.SearchRepository) *FetchMyWorkUseCase {
+	return &FetchMyWorkUseCase{searchRepo: searchRepo}
+}
+
+// Execute runs the searches over the repositories (owner/repo). With none,
+// every repository the user can see is searched.
+func (uc *FetchMyWorkUseCase) Execute(ctx context.Context, repositories []string) (*models.MyWork, error) {
+	work := &models.MyWork{Sections: make([]models.MyWorkSection, len(myWorkQueries))}
+	errs := make([]error, len(myWorkQueries))
+
+	var wg sync.WaitGroup
+	for i, q := range myWorkQueries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results, err := uc.searchRepo.Search(ctx, "", "", &models.SearchOptions{
+				Query:        q.query,
+				Type:         q.typ,
+				State:        models.IssueStateOpen,
+				Sort:         models.SearchSortUpdated,
+				Direction:    models.SortDirectionDesc,
+				Page:         1,
+				PerPage:      myWorkPerSection,
+				Repositories: repositories,
+			})
+			if err != nil {
+				errs[i] = fmt.Errorf("failed to search %s: %w", q.title, err)
+				return
+			}
+			work.Sections[i] = models.MyWorkSection{
+				Category:   q.category,
+				Title:      q.title,
+				Items:      results.Items,
+				TotalCount: results.TotalCount,
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return work, nil
+}
@@ -0,0 +1,92 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/app/usecase"
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// queryRecordingSearch returns canned results keyed by the search query and
+// records the options it was called with
+type queryRecordingSearch struct {
+	mu      sync.Mutex
+	results map[string][]models.SearchResult
+	errs    map[string]error
+	opts    []*models.SearchOptions
+}
+
+func (s *queryRecordingSearch) Search(ctx context.Context, owner, repo string, opts *models.SearchOptions) (*models.SearchResults, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.opts = append(s.opts, opts)
+	if err := s.errs[opts.Query]; err != nil {
+		return nil, err
+	}
+	items := s.results[opts.Query]
+	return &models.SearchResults{TotalCount: len(items), Items: items}, nil
+}
+
+func TestFetchMyWorkUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name         string
+		search       *queryRecordingSearch
+		repositories []string
+		wantCounts   []int
+		wantErr      string
+	}{
+		{
+			name: "正常系: 4つのセクションを表示順に返す",
+			search: &queryRecordingSearch{results: map[string][]models.SearchResult{
+				"assignee:@me":         {{Type: models.SearchTypeIssue, Issue: &models.Issue{Number: 1}}},
+				"review-requested:@me": {{Type: models.SearchTypePR}, {Type: models.SearchTypePR}},
+				"mentions:@me":         {{Type: models.SearchTypeIssue}},
+			}},
+			repositories: []string{"acme/api", "acme/web"},
+			wantCounts:   []int{1, 0, 2, 1},
+		},
+		{
+			name: "異常系: 検索の失敗はエラーにする",
+			search: &queryRecordingSearch{errs: map[string]error{
+				"author:@me": errors.New("validation failed"),
+			}},
+			wantErr: "failed to search My pull requests",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := usecase.NewFetchMyWorkUseCase(tt.search)
+			work, err := uc.Execute(context.Background(), tt.repositories)
+
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			wantCategories := []models.MyWorkCategory{models.MyWorkAssigned, models.MyWorkAuthored, models.MyWorkReviewRequested, models.MyWorkMentioned}
+			if len(work.Sections) != len(wantCategories) {
+				t.Fatalf("expected %d sections, got %d", len(wantCategories), len(work.Sections))
+			}
+			for i, section := range work.Sections {
+				if section.Category != wantCategories[i] || len(section.Items) != tt.wantCounts[i] {
+					t.Errorf("section %d = %s with %d items, want %s with %d", i, section.Category, len(section.Items), wantCategories[i], tt.wantCounts[i])
+				}
+			}
+			for _, opts := range tt.search.opts {
+				if opts.State != models.IssueStateOpen || len(opts.Repositories) != len(tt.repositories) {
+					t.Errorf("unexpected search options %+v", opts)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+)
+
+// FetchReleasesUseCase is the use case for fetching releases
+type FetchReleasesUseCase struct {
+	repo repository.ReleaseRepository
+}
+
+// NewFetchReleasesUseCase creates a new FetchReleasesUseCase
+func NewFetchReleasesUseCase(repo repository.ReleaseRepository) *FetchReleasesUseCase {
+	return &FetchReleasesUseCase{
+		repo: repo,
+	}
+}
+
+// Execute executes the use case to fetch releases
+func (uc *FetchReleasesUseCase) Execute(ctx context.Context, owner, repo string, opts *models.ReleaseOptions) ([]*models.Release, error) {
+	// バリデーション
+	if owner == "" {
+		return nil, errors.New("owner is required")
+	}
+
+	if repo == "" {
+		return nil, errors.New("repo is required")
+	}
+
+	// リポジトリから取得
+	releases, err := uc.repo.List(ctx, owner, repo, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch releases: %w", err)
+	}
+
+	return releases, nil
+}
+
+// GetRepository returns the underlying release repository
+func (uc *FetchReleasesUseCase) GetRepository() repository.ReleaseRepository {
+	return uc.repo
+}
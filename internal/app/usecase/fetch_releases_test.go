@@ -0,0 +1,101 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/app/usecase"
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFetchReleasesUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name      string
+		owner     string
+		repo      string
+		mockSetup func(*mock.MockReleaseRepository)
+		want      int
+		wantErr   bool
+		errMsg    string
+	}{
+		{
+			name:  "正常系: リリース一覧取得成功",
+			owner: "test-owner",
+			repo:  "test-repo",
+			mockSetup: func(m *mock.MockReleaseRepository) {
+				m.EXPECT().
+					List(gomock.Any(), "test-owner", "test-repo", gomock.Any()).
+					Return([]*models.Release{
+						{ID: 2, TagName: "v1.1.0", Name: "v1.1.0"},
+						{ID: 1, TagName: "v1.0.0", Name: "First release"},
+					}, nil)
+			},
+			want:    2,
+			wantErr: false,
+		},
+		{
+			name:  "異常系: ownerが空",
+			owner: "",
+			repo:  "test-repo",
+			mockSetup: func(m *mock.MockReleaseRepository) {
+				// モックは呼ばれない
+			},
+			wantErr: true,
+			errMsg:  "owner is required",
+		},
+		{
+			name:  "異常系: repoが空",
+			owner: "test-owner",
+			repo:  "",
+			mockSetup: func(m *mock.MockReleaseRepository) {
+				// モックは呼ばれない
+			},
+			wantErr: true,
+			errMsg:  "repo is required",
+		},
+		{
+			name:  "異常系: リポジトリエラー",
+			owner: "test-owner",
+			repo:  "test-repo",
+			mockSetup: func(m *mock.MockReleaseRepository) {
+				m.EXPECT().
+					List(gomock.Any(), "test-owner", "test-repo", gomock.Any()).
+					Return(nil, errors.New("repository error"))
+			},
+			wantErr: true,
+			errMsg:  "failed to fetch releases",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockReleaseRepository(ctrl)
+			tt.mockSetup(mockRepo)
+
+			uc := usecase.NewFetchReleasesUseCase(mockRepo)
+			got, err := uc.Execute(context.Background(), tt.owner, tt.repo, nil)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Execute() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errMsg != "" {
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("Execute() error message = %v, want to contain %v", err.Error(), tt.errMsg)
+				}
+			}
+
+			if !tt.wantErr && len(got) != tt.want {
+				t.Errorf("Execute() got %d releases, want %d", len(got), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+)
+
+// FetchStarredUseCase is the use case for fetching the authenticated user's
+// starred repositories
+type FetchStarredUseCase struct {
+	repo repository.StarRepository
+}
+
+// NewFetchStarredUseCase creates a new FetchStarredUseCase
+func NewFetchStarredUseCase(repo repository.StarRepository) *FetchStarredUseCase {
+	return &FetchStarredUseCase{
+		repo: repo,
+	}
+}
+
+// Execute executes the use case to fetch starred repositories
+func (uc *FetchStarredUseCase) Execute(ctx context.Context, opts *models.StarredOptions) ([]*models.StarredRepository, error) {
+	// リポジトリから取得
+	starred, err := uc.repo.ListStarred(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch starred repositories: %w", err)
+	}
+
+	return starred, nil
+}
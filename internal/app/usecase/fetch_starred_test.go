@@ -0,0 +1,75 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/app/usecase"
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFetchStarredUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name      string
+		mockSetup func(*mock.MockStarRepository)
+		want      int
+		wantErr   bool
+		errMsg    string
+	}{
+		{
+			name: "正常系: スター済みリポジトリ一覧取得成功",
+			mockSetup: func(m *mock.MockStarRepository) {
+				m.EXPECT().
+					ListStarred(gomock.Any(), gomock.Any()).
+					Return([]*models.StarredRepository{
+						{Owner: "charmbracelet", Name: "bubbletea"},
+						{Owner: "google", Name: "go-github"},
+					}, nil)
+			},
+			want:    2,
+			wantErr: false,
+		},
+		{
+			name: "異常系: リポジトリエラー",
+			mockSetup: func(m *mock.MockStarRepository) {
+				m.EXPECT().
+					ListStarred(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("repository error"))
+			},
+			wantErr: true,
+			errMsg:  "failed to fetch starred repositories",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockStarRepository(ctrl)
+			tt.mockSetup(mockRepo)
+
+			uc := usecase.NewFetchStarredUseCase(mockRepo)
+			got, err := uc.Execute(context.Background(), nil)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Execute() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errMsg != "" {
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("Execute() error message = %v, want to contain %v", err.Error(), tt.errMsg)
+				}
+			}
+
+			if !tt.wantErr && len(got) != tt.want {
+				t.Errorf("Execute() got %d repositories, want %d", len(got), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+)
+
+// FetchWorkflowRunsUseCase is the use case for fetching workflow runs
+type FetchWorkflowRunsUseCase struct {
+	repo repository.WorkflowRepository
+}
+
+// NewFetchWorkflowRunsUseCase creates a new FetchWorkflowRunsUseCase
+func NewFetchWorkflowRunsUseCase(repo repository.WorkflowRepository) *FetchWorkflowRunsUseCase {
+	return &FetchWorkflowRunsUseCase{
+		repo: repo,
+	}
+}
+
+// Execute executes the use case to fetch workflow runs
+func (uc *FetchWorkflowRunsUseCase) Execute(ctx context.Context, owner, repo string, opts *models.WorkflowRunOptions) ([]*models.WorkflowRun, error) {
+	// バリデーション
+	if owner == "" {
+		return nil, errors.New("owner is required")
+	}
+
+	if repo == "" {
+		return nil, errors.New("repo is required")
+	}
+
+	// リポジトリから取得
+	runs, err := uc.repo.ListRuns(ctx, owner, repo, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch workflow runs: %w", err)
+	}
+
+	return runs, nil
+}
+
+// GetRepository returns the underlying workflow repository
+func (uc *FetchWorkflowRunsUseCase) GetRepository() repository.WorkflowRepository {
+	return uc.repo
+}
@@ -0,0 +1,101 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/app/usecase"
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFetchWorkflowRunsUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name      string
+		owner     string
+		repo      string
+		mockSetup func(*mock.MockWorkflowRepository)
+		want      int
+		wantErr   bool
+		errMsg    string
+	}{
+		{
+			name:  "正常系: ワークフロー実行一覧取得成功",
+			owner: "test-owner",
+			repo:  "test-repo",
+			mockSetup: func(m *mock.MockWorkflowRepository) {
+				m.EXPECT().
+					ListRuns(gomock.Any(), "test-owner", "test-repo", gomock.Any()).
+					Return([]*models.WorkflowRun{
+						{ID: 2, Name: "CI", RunNumber: 12},
+						{ID: 1, Name: "CI", RunNumber: 11},
+					}, nil)
+			},
+			want:    2,
+			wantErr: false,
+		},
+		{
+			name:  "異常系: ownerが空",
+			owner: "",
+			repo:  "test-repo",
+			mockSetup: func(m *mock.MockWorkflowRepository) {
+				// モックは呼ばれない
+			},
+			wantErr: true,
+			errMsg:  "owner is required",
+		},
+		{
+			name:  "異常系: repoが空",
+			owner: "test-owner",
+			repo:  "",
+			mockSetup: func(m *mock.MockWorkflowRepository) {
+				// モックは呼ばれない
+			},
+			wantErr: true,
+			errMsg:  "repo is required",
+		},
+		{
+			name:  "異常系: リポジトリエラー",
+			owner: "test-owner",
+			repo:  "test-repo",
+			mockSetup: func(m *mock.MockWorkflowRepository) {
+				m.EXPECT().
+					ListRuns(gomock.Any(), "test-owner", "test-repo", gomock.Any()).
+					Return(nil, errors.New("repository error"))
+			},
+			wantErr: true,
+			errMsg:  "failed to fetch workflow runs",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockWorkflowRepository(ctrl)
+			tt.mockSetup(mockRepo)
+
+			uc := usecase.NewFetchWorkflowRunsUseCase(mockRepo)
+			got, err := uc.Execute(context.Background(), tt.owner, tt.repo, nil)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Execute() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errMsg != "" {
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("Execute() error message = %v, want to contain %v", err.Error(), tt.errMsg)
+				}
+			}
+
+			if !tt.wantErr && len(got) != tt.want {
+				t.Errorf("Execute() got %d runs, want %d", len(got), tt.want)
+			}
+		})
+	}
+}
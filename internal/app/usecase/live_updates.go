@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+)
+
+// LiveUpdatesUseCase polls the events of the repository on screen and
+// returns the changes to its issues and pull requests since the last poll
+type LiveUpdatesUseCase struct {
+	repo repository.EventRepository
+
+	mu      sync.Mutex
+	owner   string
+	name    string
+	seen    map[string]bool // the events returned by the last poll
+	started bool            // the first poll of the repository recorded its events
+}
+
+// NewLiveUpdatesUseCase creates a new LiveUpdatesUseCase
+func NewLiveUpdatesUseCase(repo repository.EventRepository) *LiveUpdatesUseCase {
+	return &LiveUpdatesUseCase{repo: repo}
+}
+
+// SetRepository sets the repository to poll. Switching to another one
+// starts over, so its past events are not reported as new.
+func (uc *LiveUpdatesUseCase) SetRepository(owner, repo string) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	if strings.EqualFold(uc.owner, owner) && strings.EqualFold(uc.name, repo) {
+		return
+	}
+	uc.owner = owner
+	uc.name = repo
+	uc.seen = nil
+	uc.started = false
+}
+
+// Poll returns the events since the last poll, oldest first. The first poll
+// only records the events already there.
+func (uc *LiveUpdatesUseCase) Poll(ctx context.Context) ([]*models.RepositoryEvent, error) {
+	uc.mu.Lock()
+	owner, name := uc.owner, uc.name
+	uc.mu.Unlock()
+	if owner == "" || name == "" {
+		return nil, nil
+	}
+
+	latest, err := uc.repo.ListRepositoryEvents(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	if owner != uc.owner || name != uc.name {
+		// The repository was switched while polling
+		return nil, nil
+	}
+
+	var fresh []*models.RepositoryEvent
+	seen := make(map[string]bool, len(latest))
+	for i := len(latest) - 1; i >= 0; i-- {
+		event := latest[i]
+		seen[event.ID] = true
+		if uc.started && !uc.seen[event.ID] {
+			fresh = append(fresh, event)
+		}
+	}
+	uc.seen = seen
+	uc.started = true
+	return fresh, nil
+}
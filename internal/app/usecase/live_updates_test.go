@@ -0,0 +1,94 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/app/usecase"
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// pagedEventRepo は呼ばれるたびに次のページ（新しい順）を返す
+type pagedEventRepo struct {
+	pages [][]*models.RepositoryEvent
+	err   error
+	calls []string
+}
+
+func (r *pagedEventRepo) ListRepositoryEvents(ctx context.Context, owner, repo string) ([]*models.RepositoryEvent, error) {
+	r.calls = append(r.calls, owner+"/"+repo)
+	if r.err != nil {
+		return nil, r.err
+	}
+	page := r.pages[0]
+	if len(r.pages) > 1 {
+		r.pages = r.pages[1:]
+	}
+	return page, nil
+}
+
+func repoEvent(id string, number int) *models.RepositoryEvent {
+	return &models.RepositoryEvent{ID: id, Kind: models.RepositoryEventComment, Owner: "owner", Repo: "repo", Number: number}
+}
+
+func TestLiveUpdatesUseCase_Poll(t *testing.T) {
+	repo := &pagedEventRepo{pages: [][]*models.RepositoryEvent{
+		{repoEvent("2", 1), repoEvent("1", 1)},
+		{repoEvent("4", 3), repoEvent("3", 2), repoEvent("2", 1)},
+		{repoEvent("4", 3), repoEvent("3", 2)},
+	}}
+	uc := usecase.NewLiveUpdatesUseCase(repo)
+
+	// リポジトリが決まるまではポーリングしない
+	if events, err := uc.Poll(context.Background()); err != nil || len(events) != 0 || len(repo.calls) != 0 {
+		t.Fatalf("リポジトリ未設定でポーリングした: events=%v err=%v calls=%v", events, err, repo.calls)
+	}
+
+	uc.SetRepository("owner", "repo")
+	// 初回は既存のイベントを記録するだけ
+	if events, _ := uc.Poll(context.Background()); len(events) != 0 {
+		t.Fatalf("初回のポーリングでイベントを返した: %v", events)
+	}
+
+	// 2回目は新しいイベントだけを古い順に返す
+	events, err := uc.Poll(context.Background())
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	if len(events) != 2 || events[0].ID != "3" || events[1].ID != "4" {
+		t.Fatalf("新しいイベントが古い順に返されていない: %+v", events)
+	}
+
+	// 変化がなければ何も返さない
+	if events, _ := uc.Poll(context.Background()); len(events) != 0 {
+		t.Errorf("変化がないのにイベントを返した: %v", events)
+	}
+}
+
+func TestLiveUpdatesUseCase_SwitchRepositoryStartsOver(t *testing.T) {
+	repo := &pagedEventRepo{pages: [][]*models.RepositoryEvent{
+		{repoEvent("1", 1)},
+		{repoEvent("9", 5)},
+	}}
+	uc := usecase.NewLiveUpdatesUseCase(repo)
+	uc.SetRepository("owner", "repo")
+	uc.Poll(context.Background())
+
+	// 別のリポジトリに切り替えた直後は過去のイベントを新しいものとして返さない
+	uc.SetRepository("owner", "other")
+	if events, _ := uc.Poll(context.Background()); len(events) != 0 {
+		t.Errorf("切り替え直後にイベントを返した: %v", events)
+	}
+	if repo.calls[1] != "owner/other" {
+		t.Errorf("切り替え先をポーリングしていない: %v", repo.calls)
+	}
+}
+
+func TestLiveUpdatesUseCase_PollError(t *testing.T) {
+	uc := usecase.NewLiveUpdatesUseCase(&pagedEventRepo{err: errors.New("rate limited")})
+	uc.SetRepository("owner", "repo")
+	if _, err := uc.Poll(context.Background()); err == nil {
+		t.Fatal("エラーが返されていない")
+	}
+}
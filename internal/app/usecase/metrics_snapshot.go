@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// ErrNotMetricsSnapshot は読み込んだ JSON が `tig-gh metrics --json` の出力でない場合に返される
+var ErrNotMetricsSnapshot = errors.New("not a metrics snapshot (expected the output of `tig-gh metrics --json`)")
+
+// MetricsSnapshotUseCase はエクスポート済みのメトリクス JSON を返すユースケース
+// API を呼ばないため、トークンなしで Metrics ビューを閲覧できる
+type MetricsSnapshotUseCase struct {
+	name    string
+	metrics *models.LeadTimeMetrics
+}
+
+// LoadMetricsSnapshot は r から `tig-gh metrics --json` の出力を読み込む
+// name は表示用のファイル名
+func LoadMetricsSnapshot(r io.Reader, name string) (*MetricsSnapshotUseCase, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", name, err)
+	}
+
+	// 別の JSON を誤って開いた場合に空のレポートを表示しないよう、必須のキーを確認する
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, ErrNotMetricsSnapshot)
+	}
+	if _, ok := keys["overall"]; !ok {
+		return nil, fmt.Errorf("%s: %w", name, ErrNotMetricsSnapshot)
+	}
+
+	var metrics models.LeadTimeMetrics
+	if err := json.Unmarshal(data, &metrics); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+	}
+	return &MetricsSnapshotUseCase{name: name, metrics: &metrics}, nil
+}
+
+// Execute は読み込んだメトリクスを返す
+func (uc *MetricsSnapshotUseCase) Execute(ctx context.Context, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error) {
+	return uc.metrics, nil
+}
+
+// GetRateLimit はスナップショットでは API を使わないため常にエラーを返す
+func (uc *MetricsSnapshotUseCase) GetRateLimit(ctx context.Context) (*models.RateLimit, error) {
+	return nil, errors.New("rate limit is not available for a metrics snapshot")
+}
+
+// SnapshotName は読み込んだファイル名を返す
+func (uc *MetricsSnapshotUseCase) SnapshotName() string {
+	return uc.name
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func TestLoadMetricsSnapshot_RoundTrip(t *testing.T) {
+	exported := &models.LeadTimeMetrics{
+		Overall:      models.LeadTimeStat{Average: 36 * time.Hour, Median: 24 * time.Hour, Count: 12},
+		ByRepository: map[string]models.LeadTimeStat{"owner/repo": {Average: 36 * time.Hour, Count: 12}},
+		ByDayOfWeek:  map[time.Weekday]models.DayOfWeekStats{time.Monday: {ReviewCount: 5, MergeCount: 3}},
+		RepositoryStatuses: []models.MetricsRepositoryStatus{
+			{Repository: "owner/repo"},
+			{Repository: "owner/private", Error: "not found"},
+		},
+	}
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(exported); err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot, err := LoadMetricsSnapshot(&buf, "report.json")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	metrics, err := snapshot.Execute(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(metrics.Overall, exported.Overall) || !reflect.DeepEqual(metrics.ByDayOfWeek, exported.ByDayOfWeek) {
+		t.Errorf("metrics changed in the round trip: %+v", metrics)
+	}
+	if failed := metrics.FailedRepositories(); len(failed) != 1 || failed[0] != "owner/private" {
+		t.Errorf("failed repositories = %v", failed)
+	}
+	if snapshot.SnapshotName() != "report.json" {
+		t.Errorf("name = %q", snapshot.SnapshotName())
+	}
+	if _, err := snapshot.GetRateLimit(context.Background()); err == nil {
+		t.Error("a snapshot has no rate limit")
+	}
+}
+
+func TestLoadMetricsSnapshot_RejectsOtherFiles(t *testing.T) {
+	for _, content := range []string{`[{"number": 1}]`, `{"issues": []}`, `not json`} {
+		_, err := LoadMetricsSnapshot(strings.NewReader(content), "other.json")
+		if !errors.Is(err, ErrNotMetricsSnapshot) {
+			t.Errorf("%s: err = %v, want ErrNotMetricsSnapshot", content, err)
+		}
+	}
+}
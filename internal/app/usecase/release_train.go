@@ -0,0 +1,187 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+)
+
+// ReleaseTrainUseCase tracks what will go into the next release and cuts it
+type ReleaseTrainUseCase struct {
+	releaseRepo repository.ReleaseRepository
+	commitRepo  repository.CommitRepository
+	searchRepo  repository.SearchRepository
+	config      models.ReleaseConfig
+}
+
+// NewReleaseTrainUseCase creates a new ReleaseTrainUseCase
+func NewReleaseTrainUseCase(
+	releaseRepo repository.ReleaseRepository,
+	commitRepo repository.CommitRepository,
+	searchRepo repository.SearchRepository,
+	config models.ReleaseConfig,
+) *ReleaseTrainUseCase {
+	return &ReleaseTrainUseCase{
+		releaseRepo: releaseRepo,
+		commitRepo:  commitRepo,
+		searchRepo:  searchRepo,
+		config:      config,
+	}
+}
+
+// Execute collects the state of the next release
+func (uc *ReleaseTrainUseCase) Execute(ctx context.Context, owner, repo string) (*models.ReleaseTrain, error) {
+	// バリデーション
+	if owner == "" {
+		return nil, errors.New("owner is required")
+	}
+
+	if repo == "" {
+		return nil, errors.New("repo is required")
+	}
+
+	train := &models.ReleaseTrain{}
+
+	branch, err := uc.commitRepo.GetDefaultBranch(ctx, owner, repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch default branch: %w", err)
+	}
+	train.Branch = branch
+
+	head, err := uc.commitRepo.GetBranch(ctx, owner, repo, branch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch branch %s: %w", branch, err)
+	}
+	train.HeadSHA = head.SHA
+
+	train.LastTag, err = uc.lastTag(ctx, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	// 前回のタグ以降のコミット
+	var since *models.Commit
+	if train.LastTag != "" {
+		comparison, err := uc.commitRepo.Compare(ctx, owner, repo, train.LastTag, train.HeadSHA)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compare %s...%s: %w", train.LastTag, branch, err)
+		}
+		train.Commits = reverseCommits(comparison.Commits)
+		train.TotalCommits = comparison.TotalCommits
+		since = comparison.BaseCommit
+	} else {
+		commits, err := uc.commitRepo.List(ctx, owner, repo, &models.CommitOptions{SHA: train.HeadSHA, PerPage: 100})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch commits: %w", err)
+		}
+		train.Commits = commits
+		train.TotalCommits = len(commits)
+	}
+
+	train.CheckState, err = uc.commitRepo.GetCombinedStatus(ctx, owner, repo, train.HeadSHA)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch CI status: %w", err)
+	}
+
+	// リリース対象ラベルの PR
+	prs, err := uc.searchRepo.Search(ctx, owner, repo, &models.SearchOptions{
+		Type:    models.SearchTypePR,
+		State:   models.IssueStateAll,
+		Labels:  []string{uc.config.Label},
+		PerPage: 100,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch release pull requests: %w", err)
+	}
+	for _, item := range prs.Items {
+		pr := item.PullRequest
+		if pr == nil {
+			continue
+		}
+		// クローズ済みのものは前回のリリース以降のものだけ
+		if pr.State != models.PRStateOpen && since != nil && (pr.ClosedAt == nil || pr.ClosedAt.Before(since.CreatedAt)) {
+			continue
+		}
+		train.PullRequests = append(train.PullRequests, pr)
+	}
+
+	// ブロッカー
+	blockers, err := uc.searchRepo.Search(ctx, owner, repo, &models.SearchOptions{
+		Type:    models.SearchTypeBoth,
+		State:   models.IssueStateOpen,
+		Labels:  []string{uc.config.BlockerLabel},
+		PerPage: 100,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch release blockers: %w", err)
+	}
+	train.Blockers = blockers.Items
+
+	return train, nil
+}
+
+// lastTag returns the tag of the latest published release, falling back to the newest tag
+func (uc *ReleaseTrainUseCase) lastTag(ctx context.Context, owner, repo string) (string, error) {
+	releases, err := uc.releaseRepo.List(ctx, owner, repo, &models.ReleaseOptions{PerPage: 30})
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch releases: %w", err)
+	}
+	for _, release := range releases {
+		if !release.Draft && release.TagName != "" {
+			return release.TagName, nil
+		}
+	}
+
+	tags, err := uc.releaseRepo.ListTags(ctx, owner, repo, &models.ReleaseOptions{PerPage: 1})
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch tags: %w", err)
+	}
+	if len(tags) > 0 {
+		return tags[0].Name, nil
+	}
+	return "", nil
+}
+
+// CutRelease tags the train's head commit and creates a release with generated notes.
+// It refuses unless everything is green.
+func (uc *ReleaseTrainUseCase) CutRelease(ctx context.Context, owner, repo string, train *models.ReleaseTrain, tag string, draft bool) (*models.Release, error) {
+	if train == nil {
+		return nil, errors.New("release train not loaded")
+	}
+	if problems := train.Problems(); len(problems) > 0 {
+		return nil, fmt.Errorf("release train is not green: %s", strings.Join(problems, ", "))
+	}
+	if tag == "" {
+		return nil, errors.New("tag is required")
+	}
+
+	release, err := uc.releaseRepo.Create(ctx, owner, repo, &models.CreateReleaseInput{
+		TagName:       tag,
+		Target:        train.HeadSHA,
+		GenerateNotes: true,
+		Draft:         draft,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create release: %w", err)
+	}
+
+	return release, nil
+}
+
+// GetRepository returns the underlying release repository
+func (uc *ReleaseTrainUseCase) GetRepository() repository.ReleaseRepository {
+	return uc.releaseRepo
+}
+
+// reverseCommits returns the commits newest first; comparisons list them oldest first
+func reverseCommits(commits []*models.Commit) []*models.Commit {
+	reversed := make([]*models.Commit, len(commits))
+	for i, commit := range commits {
+		reversed[len(commits)-1-i] = commit
+	}
+	return reversed
+}
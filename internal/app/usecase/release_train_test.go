@@ -0,0 +1,200 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/app/usecase"
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/mock"
+	"go.uber.org/mock/gomock"
+)
+
+// stubSearchRepository returns canned results keyed by the first label searched for
+type stubSearchRepository struct {
+	results map[string][]models.SearchResult
+	err     error
+}
+
+func (s *stubSearchRepository) Search(ctx context.Context, owner, repo string, opts *models.SearchOptions) (*models.SearchResults, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	items := s.results[opts.Labels[0]]
+	return &models.SearchResults{TotalCount: len(items), Items: items}, nil
+}
+
+var releaseTrainConfig = models.ReleaseConfig{Label: "release", BlockerLabel: "release-blocker"}
+
+func TestReleaseTrainUseCase_Execute(t *testing.T) {
+	tagDate := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	before := tagDate.Add(-time.Hour)
+	after := tagDate.Add(time.Hour)
+
+	tests := []struct {
+		name        string
+		releaseMock func(*mock.MockReleaseRepository)
+		commitMock  func(*mock.MockCommitRepository)
+		search      *stubSearchRepository
+		wantTag     string
+		wantCommits int
+		wantPRs     int
+		wantReady   bool
+		wantErr     bool
+		errMsg      string
+	}{
+		{
+			name: "正常系: 前回のリリース以降の状態を集計",
+			releaseMock: func(m *mock.MockReleaseRepository) {
+				m.EXPECT().List(gomock.Any(), "owner", "repo", gomock.Any()).
+					Return([]*models.Release{{TagName: "v1.3.0", Draft: true}, {TagName: "v1.2.0"}}, nil)
+			},
+			commitMock: func(m *mock.MockCommitRepository) {
+				m.EXPECT().GetDefaultBranch(gomock.Any(), "owner", "repo").Return("main", nil)
+				m.EXPECT().GetBranch(gomock.Any(), "owner", "repo", "main").Return(&models.Branch{Name: "main", SHA: "head"}, nil)
+				m.EXPECT().Compare(gomock.Any(), "owner", "repo", "v1.2.0", "head").
+					Return(&models.Comparison{
+						BaseCommit:   &models.Commit{SHA: "tag", CreatedAt: tagDate},
+						TotalCommits: 2,
+						Commits:      []*models.Commit{{SHA: "old"}, {SHA: "head"}},
+					}, nil)
+				m.EXPECT().GetCombinedStatus(gomock.Any(), "owner", "repo", "head").Return(models.CheckStateSuccess, nil)
+			},
+			search: &stubSearchRepository{results: map[string][]models.SearchResult{
+				"release": {
+					{Type: models.SearchTypePR, PullRequest: &models.PullRequest{Number: 1, State: models.PRStateClosed, ClosedAt: &after}},
+					{Type: models.SearchTypePR, PullRequest: &models.PullRequest{Number: 2, State: models.PRStateClosed, ClosedAt: &before}},
+				},
+			}},
+			wantTag:     "v1.2.0",
+			wantCommits: 2,
+			wantPRs:     1,
+			wantReady:   true,
+		},
+		{
+			name: "正常系: タグがなければブランチのコミットを使う",
+			releaseMock: func(m *mock.MockReleaseRepository) {
+				m.EXPECT().List(gomock.Any(), "owner", "repo", gomock.Any()).Return(nil, nil)
+				m.EXPECT().ListTags(gomock.Any(), "owner", "repo", gomock.Any()).Return(nil, nil)
+			},
+			commitMock: func(m *mock.MockCommitRepository) {
+				m.EXPECT().GetDefaultBranch(gomock.Any(), "owner", "repo").Return("main", nil)
+				m.EXPECT().GetBranch(gomock.Any(), "owner", "repo", "main").Return(&models.Branch{Name: "main", SHA: "head"}, nil)
+				m.EXPECT().List(gomock.Any(), "owner", "repo", gomock.Any()).Return([]*models.Commit{{SHA: "head"}}, nil)
+				m.EXPECT().GetCombinedStatus(gomock.Any(), "owner", "repo", "head").Return(models.CheckStatePending, nil)
+			},
+			search: &stubSearchRepository{results: map[string][]models.SearchResult{
+				"release-blocker": {{Type: models.SearchTypeIssue, Issue: &models.Issue{Number: 9}}},
+			}},
+			wantTag:     "",
+			wantCommits: 1,
+			wantReady:   false,
+		},
+		{
+			name:        "異常系: デフォルトブランチ取得エラー",
+			releaseMock: func(m *mock.MockReleaseRepository) {},
+			commitMock: func(m *mock.MockCommitRepository) {
+				m.EXPECT().GetDefaultBranch(gomock.Any(), "owner", "repo").Return("", errors.New("api error"))
+			},
+			search:  &stubSearchRepository{},
+			wantErr: true,
+			errMsg:  "failed to fetch default branch",
+		},
+		{
+			name: "異常系: 検索エラー",
+			releaseMock: func(m *mock.MockReleaseRepository) {
+				m.EXPECT().List(gomock.Any(), "owner", "repo", gomock.Any()).Return(nil, nil)
+				m.EXPECT().ListTags(gomock.Any(), "owner", "repo", gomock.Any()).Return([]*models.Tag{{Name: "v0.1.0"}}, nil)
+			},
+			commitMock: func(m *mock.MockCommitRepository) {
+				m.EXPECT().GetDefaultBranch(gomock.Any(), "owner", "repo").Return("main", nil)
+				m.EXPECT().GetBranch(gomock.Any(), "owner", "repo", "main").Return(&models.Branch{Name: "main", SHA: "head"}, nil)
+				m.EXPECT().Compare(gomock.Any(), "owner", "repo", "v0.1.0", "head").Return(&models.Comparison{}, nil)
+				m.EXPECT().GetCombinedStatus(gomock.Any(), "owner", "repo", "head").Return(models.CheckStateSuccess, nil)
+			},
+			search:  &stubSearchRepository{err: errors.New("rate limited")},
+			wantErr: true,
+			errMsg:  "failed to fetch release pull requests",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			releaseRepo := mock.NewMockReleaseRepository(ctrl)
+			commitRepo := mock.NewMockCommitRepository(ctrl)
+			tt.releaseMock(releaseRepo)
+			tt.commitMock(commitRepo)
+
+			uc := usecase.NewReleaseTrainUseCase(releaseRepo, commitRepo, tt.search, releaseTrainConfig)
+			got, err := uc.Execute(context.Background(), "owner", "repo")
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Execute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("Execute() error message = %v, want to contain %v", err.Error(), tt.errMsg)
+				}
+				return
+			}
+
+			if got.LastTag != tt.wantTag {
+				t.Errorf("LastTag = %q, want %q", got.LastTag, tt.wantTag)
+			}
+			if len(got.Commits) != tt.wantCommits {
+				t.Errorf("got %d commits, want %d", len(got.Commits), tt.wantCommits)
+			}
+			if len(got.PullRequests) != tt.wantPRs {
+				t.Errorf("got %d release PRs, want %d", len(got.PullRequests), tt.wantPRs)
+			}
+			if got.Ready() != tt.wantReady {
+				t.Errorf("Ready() = %v, want %v (problems: %v)", got.Ready(), tt.wantReady, got.Problems())
+			}
+			if len(got.Commits) > 0 && got.Commits[0].SHA != "head" {
+				t.Errorf("commits should be newest first, got %s first", got.Commits[0].SHA)
+			}
+		})
+	}
+}
+
+func TestReleaseTrainUseCase_CutRelease(t *testing.T) {
+	green := &models.ReleaseTrain{Branch: "main", HeadSHA: "head", TotalCommits: 1, CheckState: models.CheckStateSuccess}
+	red := &models.ReleaseTrain{Branch: "main", HeadSHA: "head", TotalCommits: 1, CheckState: models.CheckStateFailure}
+
+	t.Run("正常系: グリーンならヘッドにタグを打つ", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		releaseRepo := mock.NewMockReleaseRepository(ctrl)
+		releaseRepo.EXPECT().
+			Create(gomock.Any(), "owner", "repo", &models.CreateReleaseInput{TagName: "v1.0.1", Target: "head", GenerateNotes: true, Draft: true}).
+			Return(&models.Release{TagName: "v1.0.1", Draft: true}, nil)
+
+		uc := usecase.NewReleaseTrainUseCase(releaseRepo, mock.NewMockCommitRepository(ctrl), &stubSearchRepository{}, releaseTrainConfig)
+		release, err := uc.CutRelease(context.Background(), "owner", "repo", green, "v1.0.1", true)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if release.TagName != "v1.0.1" {
+			t.Errorf("TagName = %q", release.TagName)
+		}
+	})
+
+	t.Run("異常系: グリーンでなければ作成しない", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		uc := usecase.NewReleaseTrainUseCase(mock.NewMockReleaseRepository(ctrl), mock.NewMockCommitRepository(ctrl), &stubSearchRepository{}, releaseTrainConfig)
+		_, err := uc.CutRelease(context.Background(), "owner", "repo", red, "v1.0.1", true)
+		if err == nil || !strings.Contains(err.Error(), "CI is failing") {
+			t.Errorf("expected a CI failure error, got %v", err)
+		}
+	})
+}
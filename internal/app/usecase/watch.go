@@ -0,0 +1,381 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+)
+
+// WatchStore keeps the watch list between sessions
+type WatchStore interface {
+	Load() ([]*models.WatchedItem, error)
+	Save(items []*models.WatchedItem) error
+}
+
+// Notifier shows desktop notifications
+type Notifier interface {
+	Notify(title, message string) error
+}
+
+// WatchUseCase keeps the watch list and polls the watched issues and pull
+// requests for new comments, reviews, CI results and merges or closes
+type WatchUseCase struct {
+	store     WatchStore
+	issueRepo repository.IssueRepository
+	prRepo    repository.PullRequestRepository
+	notifier  Notifier // nil when desktop notifications are off
+	now       func() time.Time
+
+	mu     sync.Mutex
+	items  []*models.WatchedItem
+	loaded bool
+}
+
+// NewWatchUseCase creates a new WatchUseCase. notifier may be nil, in which
+// case activity only shows up in the watch list.
+func NewWatchUseCase(
+	store WatchStore,
+	issueRepo repository.IssueRepository,
+	prRepo repository.PullRequestRepository,
+	notifier Notifier,
+) *WatchUseCase {
+	return &WatchUseCase{
+		store:     store,
+		issueRepo: issueRepo,
+		prRepo:    prRepo,
+		notifier:  notifier,
+		now:       time.Now,
+	}
+}
+
+// load reads the watch list on first use; the caller holds uc.mu
+func (uc *WatchUseCase) load() error {
+	if uc.loaded {
+		return nil
+	}
+	items, err := uc.store.Load()
+	if err != nil {
+		return err
+	}
+	uc.items = items
+	uc.loaded = true
+	return nil
+}
+
+// List returns copies of the watched items, most recently watched first
+func (uc *WatchUseCase) List() ([]*models.WatchedItem, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if err := uc.load(); err != nil {
+		return nil, err
+	}
+	items := make([]*models.WatchedItem, 0, len(uc.items))
+	for i := len(uc.items) - 1; i >= 0; i-- {
+		item := *uc.items[i]
+		items = append(items, &item)
+	}
+	return items, nil
+}
+
+// IsWatched reports whether an issue or pull request is watched
+func (uc *WatchUseCase) IsWatched(owner, repo string, number int) bool {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if err := uc.load(); err != nil {
+		return false
+	}
+	return uc.indexOf(models.WatchKey(owner, repo, number)) >= 0
+}
+
+// Toggle starts watching the item, or stops watching it when it already is,
+// and reports whether it is watched now. Activity is noticed from the first
+// poll after it was added.
+func (uc *WatchUseCase) Toggle(item *models.WatchedItem) (bool, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if err := uc.load(); err != nil {
+		return false, err
+	}
+	if i := uc.indexOf(item.Key()); i >= 0 {
+		uc.items = append(uc.items[:i], uc.items[i+1:]...)
+		return false, uc.store.Save(uc.items)
+	}
+
+	added := *item
+	added.Snapshot = nil
+	added.LastEvent = nil
+	added.Seen = nil
+	uc.items = append(uc.items, &added)
+	return true, uc.store.Save(uc.items)
+}
+
+// Unwatch stops watching an issue or pull request
+func (uc *WatchUseCase) Unwatch(owner, repo string, number int) error {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if err := uc.load(); err != nil {
+		return err
+	}
+	i := uc.indexOf(models.WatchKey(owner, repo, number))
+	if i < 0 {
+		return nil
+	}
+	uc.items = append(uc.items[:i], uc.items[i+1:]...)
+	return uc.store.Save(uc.items)
+}
+
+// MarkSeen records the current state of an item as visited, so the watch
+// list stops highlighting its changes
+func (uc *WatchUseCase) MarkSeen(owner, repo string, number int) error {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if err := uc.load(); err != nil {
+		return err
+	}
+	i := uc.indexOf(models.WatchKey(owner, repo, number))
+	if i < 0 || uc.items[i].Snapshot == nil {
+		return nil
+	}
+	seen := *uc.items[i].Snapshot
+	uc.items[i].Seen = &seen
+	return uc.store.Save(uc.items)
+}
+
+// indexOf returns the index of the item with the key, or -1; the caller holds uc.mu
+func (uc *WatchUseCase) indexOf(key string) int {
+	for i, item := range uc.items {
+		if item.Key() == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// Poll fetches the state of every watched item and returns the activity since
+// the last poll, sending a desktop notification for each. The first poll of
+// an item only records its state. Items that fail to load are retried at the
+// next poll; their errors are returned with the events of the others.
+func (uc *WatchUseCase) Poll(ctx context.Context) ([]models.WatchEvent, error) {
+	uc.mu.Lock()
+	if err := uc.load(); err != nil {
+		uc.mu.Unlock()
+		return nil, err
+	}
+	watched := make([]models.WatchedItem, len(uc.items))
+	for i, item := range uc.items {
+		watched[i] = *item
+	}
+	uc.mu.Unlock()
+
+	// The API calls run without the lock so the list stays usable meanwhile
+	type polled struct {
+		title    string
+		snapshot *models.WatchSnapshot
+		review   *models.Review
+	}
+	results := make(map[string]polled, len(watched))
+	var errs []error
+	for _, item := range watched {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		title, snapshot, review, err := uc.snapshot(ctx, &item)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", item.Key(), err))
+			continue
+		}
+		results[item.Key()] = polled{title: title, snapshot: snapshot, review: review}
+	}
+
+	uc.mu.Lock()
+	var events []models.WatchEvent
+	type notification struct{ title, message string }
+	var notifications []notification
+	for _, item := range uc.items {
+		result, ok := results[item.Key()]
+		if !ok {
+			// Unwatched meanwhile, or failed to load
+			continue
+		}
+		itemEvents := watchEvents(item.Key(), item.Snapshot, result.snapshot, result.review, uc.now())
+		if item.Seen == nil {
+			// Changes are highlighted from the first poll, or from the last
+			// poll for items watched before visits were recorded
+			seen := *result.snapshot
+			if item.Snapshot != nil {
+				seen = *item.Snapshot
+			}
+			item.Seen = &seen
+		}
+		item.Title = result.title
+		item.Snapshot = result.snapshot
+		for i := range itemEvents {
+			item.LastEvent = &itemEvents[i]
+			notifications = append(notifications, notification{watchNotificationTitle(item), itemEvents[i].Text})
+		}
+		events = append(events, itemEvents...)
+	}
+	if err := uc.store.Save(uc.items); err != nil {
+		errs = append(errs, err)
+	}
+	uc.mu.Unlock()
+
+	if uc.notifier != nil {
+		for _, n := range notifications {
+			if err := uc.notifier.Notify(n.title, n.message); err != nil {
+				// Without a notification service every notification fails the same way
+				errs = append(errs, fmt.Errorf("desktop notification failed: %w", err))
+				break
+			}
+		}
+	}
+
+	return events, errors.Join(errs...)
+}
+
+// snapshot fetches the current state of a watched item, with the latest
+// submitted review of a pull request
+func (uc *WatchUseCase) snapshot(ctx context.Context, item *models.WatchedItem) (string, *models.WatchSnapshot, *models.Review, error) {
+	if !item.IsPullRequest {
+		issue, err := uc.issueRepo.Get(ctx, item.Owner, item.Repo, item.Number)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		return issue.Title, &models.WatchSnapshot{
+			State:     string(issue.State),
+			Comments:  issue.Comments,
+			UpdatedAt: issue.UpdatedAt,
+		}, nil, nil
+	}
+
+	pr, err := uc.prRepo.Get(ctx, item.Owner, item.Repo, item.Number)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	snapshot := &models.WatchSnapshot{
+		State:     string(pr.State),
+		Comments:  pr.Comments,
+		UpdatedAt: pr.UpdatedAt,
+	}
+	if pr.Merged {
+		snapshot.State = "merged"
+	}
+
+	reviews, err := uc.prRepo.ListReviews(ctx, item.Owner, item.Repo, item.Number)
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("failed to fetch reviews: %w", err)
+	}
+	var latest *models.Review
+	for _, review := range reviews {
+		if review.State == models.ReviewStatePending {
+			// Not submitted yet, so nobody else can see it
+			continue
+		}
+		snapshot.Reviews++
+		latest = review
+	}
+
+	requirements, err := uc.prRepo.GetMergeRequirements(ctx, item.Owner, item.Repo, item.Number)
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("failed to fetch checks: %w", err)
+	}
+	snapshot.CheckState = combinedCheckState(requirements.Checks)
+
+	return pr.Title, snapshot, latest, nil
+}
+
+// combinedCheckState folds the checks of a commit into one state: failed
+// when any failed, pending while any runs, and empty without checks
+func combinedCheckState(checks []models.CheckStatus) models.CheckState {
+	if len(checks) == 0 {
+		return ""
+	}
+	state := models.CheckStateSuccess
+	for _, check := range checks {
+		switch check.State {
+		case models.CheckStateFailure:
+			return models.CheckStateFailure
+		case models.CheckStatePending:
+			state = models.CheckStatePending
+		}
+	}
+	return state
+}
+
+// watchEvents compares two snapshots of an item. Without a previous snapshot
+// nothing has happened yet.
+func watchEvents(key string, before, after *models.WatchSnapshot, review *models.Review, at time.Time) []models.WatchEvent {
+	if before == nil {
+		return nil
+	}
+
+	var events []models.WatchEvent
+	add := func(kind models.WatchEventKind, text string) {
+		events = append(events, models.WatchEvent{Key: key, Kind: kind, Text: text, At: at})
+	}
+
+	if n := after.Comments - before.Comments; n > 0 {
+		add(models.WatchEventComment, plural(n, "new comment", "new comments"))
+	}
+	if after.Reviews > before.Reviews {
+		add(models.WatchEventReview, reviewText(review))
+	}
+	if after.CheckState != before.CheckState {
+		switch after.CheckState {
+		case models.CheckStateSuccess:
+			add(models.WatchEventCI, "CI passed")
+		case models.CheckStateFailure:
+			add(models.WatchEventCI, "CI failed")
+		}
+	}
+	if after.State != before.State {
+		switch after.State {
+		case "merged":
+			add(models.WatchEventMerged, "Merged")
+		case string(models.IssueStateClosed):
+			add(models.WatchEventClosed, "Closed")
+		}
+	}
+	return events
+}
+
+// reviewText describes the latest review, e.g. "alice approved"
+func reviewText(review *models.Review) string {
+	if review == nil || review.User.Login == "" {
+		return "New review"
+	}
+	switch review.State {
+	case models.ReviewStateApproved:
+		return review.User.Login + " approved"
+	case models.ReviewStateChangesRequested:
+		return review.User.Login + " requested changes"
+	default:
+		return review.User.Login + " reviewed"
+	}
+}
+
+// plural formats a count with the singular or plural noun
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", one)
+	}
+	return fmt.Sprintf("%d %s", n, many)
+}
+
+// watchNotificationTitle is the title of a notification, e.g. "octo/hello#42 Fix the login"
+func watchNotificationTitle(item *models.WatchedItem) string {
+	if item.Title == "" {
+		return item.Key()
+	}
+	return item.Key() + " " + item.Title
+}
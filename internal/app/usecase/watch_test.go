@@ -0,0 +1,183 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/app/usecase"
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/mock"
+	"go.uber.org/mock/gomock"
+)
+
+// memoryWatchStore keeps the watch list in memory
+type memoryWatchStore struct {
+	items []*models.WatchedItem
+	saves int
+}
+
+func (s *memoryWatchStore) Load() ([]*models.WatchedItem, error) {
+	return s.items, nil
+}
+
+func (s *memoryWatchStore) Save(items []*models.WatchedItem) error {
+	s.items = items
+	s.saves++
+	return nil
+}
+
+// recordingNotifier records the notifications sent
+type recordingNotifier struct {
+	sent []string
+	err  error
+}
+
+func (n *recordingNotifier) Notify(title, message string) error {
+	n.sent = append(n.sent, title+": "+message)
+	return n.err
+}
+
+func TestWatchUseCase_Toggle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := &memoryWatchStore{}
+	uc := usecase.NewWatchUseCase(store, mock.NewMockIssueRepository(ctrl), mock.NewMockPullRequestRepository(ctrl), nil)
+	item := &models.WatchedItem{Owner: "owner", Repo: "repo", Number: 1, Title: "Bug"}
+
+	watched, err := uc.Toggle(item)
+	if err != nil || !watched {
+		t.Fatalf("Toggle() = %v, %v; want watched", watched, err)
+	}
+	if !uc.IsWatched("owner", "repo", 1) || len(store.items) != 1 {
+		t.Errorf("expected #1 watched and saved, store = %+v", store.items)
+	}
+
+	watched, err = uc.Toggle(item)
+	if err != nil || watched {
+		t.Fatalf("Toggle() = %v, %v; want unwatched", watched, err)
+	}
+	if uc.IsWatched("owner", "repo", 1) || len(store.items) != 0 {
+		t.Errorf("expected #1 unwatched, store = %+v", store.items)
+	}
+}
+
+func TestWatchUseCase_Poll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	issueRepo := mock.NewMockIssueRepository(ctrl)
+	prRepo := mock.NewMockPullRequestRepository(ctrl)
+	store := &memoryWatchStore{items: []*models.WatchedItem{
+		{Owner: "owner", Repo: "repo", Number: 1},
+		{Owner: "owner", Repo: "repo", Number: 2, IsPullRequest: true},
+	}}
+	notifier := &recordingNotifier{}
+	uc := usecase.NewWatchUseCase(store, issueRepo, prRepo, notifier)
+
+	// 1回目: 現在の状態を記録するだけで通知しない
+	issueRepo.EXPECT().Get(gomock.Any(), "owner", "repo", 1).
+		Return(&models.Issue{Number: 1, Title: "Bug", State: models.IssueStateOpen, Comments: 1}, nil)
+	prRepo.EXPECT().Get(gomock.Any(), "owner", "repo", 2).
+		Return(&models.PullRequest{Number: 2, Title: "Fix", State: models.PRStateOpen}, nil)
+	prRepo.EXPECT().ListReviews(gomock.Any(), "owner", "repo", 2).Return(nil, nil)
+	prRepo.EXPECT().GetMergeRequirements(gomock.Any(), "owner", "repo", 2).
+		Return(&models.MergeRequirements{Checks: []models.CheckStatus{{Name: "test", State: models.CheckStatePending}}}, nil)
+
+	events, err := uc.Poll(context.Background())
+	if err != nil {
+		t.Fatalf("Poll() error = %v", err)
+	}
+	if len(events) != 0 || len(notifier.sent) != 0 {
+		t.Errorf("first poll: events = %+v, notifications = %v; want none", events, notifier.sent)
+	}
+
+	// 2回目: コメント・レビュー・CI・マージを検知して通知する
+	issueRepo.EXPECT().Get(gomock.Any(), "owner", "repo", 1).
+		Return(&models.Issue{Number: 1, Title: "Bug", State: models.IssueStateClosed, Comments: 3}, nil)
+	prRepo.EXPECT().Get(gomock.Any(), "owner", "repo", 2).
+		Return(&models.PullRequest{Number: 2, Title: "Fix", State: models.PRStateClosed, Merged: true}, nil)
+	prRepo.EXPECT().ListReviews(gomock.Any(), "owner", "repo", 2).Return([]*models.Review{
+		{User: models.User{Login: "alice"}, State: models.ReviewStateApproved},
+		{User: models.User{Login: "me"}, State: models.ReviewStatePending},
+	}, nil)
+	prRepo.EXPECT().GetMergeRequirements(gomock.Any(), "owner", "repo", 2).
+		Return(&models.MergeRequirements{Checks: []models.CheckStatus{{Name: "test", State: models.CheckStateFailure}}}, nil)
+
+	events, err = uc.Poll(context.Background())
+	if err != nil {
+		t.Fatalf("Poll() error = %v", err)
+	}
+	var texts []string
+	for _, event := range events {
+		texts = append(texts, event.Key+" "+event.Text)
+	}
+	want := []string{
+		"owner/repo#1 2 new comments",
+		"owner/repo#1 Closed",
+		"owner/repo#2 alice approved",
+		"owner/repo#2 CI failed",
+		"owner/repo#2 Merged",
+	}
+	if strings.Join(texts, "\n") != strings.Join(want, "\n") {
+		t.Errorf("events =\n%s\nwant\n%s", strings.Join(texts, "\n"), strings.Join(want, "\n"))
+	}
+	if len(notifier.sent) != 5 || notifier.sent[0] != "owner/repo#1 Bug: 2 new comments" {
+		t.Errorf("notifications = %v", notifier.sent)
+	}
+
+	items, _ := uc.List()
+	if items[0].LastEvent == nil || items[0].LastEvent.Kind != models.WatchEventMerged {
+		t.Errorf("expected the PR's last event to be the merge, got %+v", items[0].LastEvent)
+	}
+
+	// 前回訪問（最初の確認）からの変化をまとめ、訪問済みにすると消える
+	want = []string{"+1 review", "CI pending → failure", "open → merged"}
+	if got := items[0].Changes(); strings.Join(got, ", ") != strings.Join(want, ", ") {
+		t.Errorf("PR changes = %v, want %v", got, want)
+	}
+	if got := items[1].Changes(); strings.Join(got, ", ") != "+2 comments, open → closed" {
+		t.Errorf("issue changes = %v", got)
+	}
+	if err := uc.MarkSeen("owner", "repo", 2); err != nil {
+		t.Fatalf("MarkSeen() error = %v", err)
+	}
+	items, _ = uc.List()
+	if got := items[0].Changes(); len(got) != 0 {
+		t.Errorf("expected no changes after a visit, got %v", got)
+	}
+	if store.items[1].Seen == nil || store.items[1].Seen.State != "merged" {
+		t.Errorf("expected the visit saved, got %+v", store.items[1].Seen)
+	}
+}
+
+func TestWatchUseCase_PollKeepsGoingOnErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	issueRepo := mock.NewMockIssueRepository(ctrl)
+	store := &memoryWatchStore{items: []*models.WatchedItem{
+		{Owner: "owner", Repo: "repo", Number: 1, Snapshot: &models.WatchSnapshot{State: "open"}},
+		{Owner: "owner", Repo: "repo", Number: 2, Snapshot: &models.WatchSnapshot{State: "open"}},
+	}}
+	notifier := &recordingNotifier{err: errors.New("no notification service")}
+	uc := usecase.NewWatchUseCase(store, issueRepo, mock.NewMockPullRequestRepository(ctrl), notifier)
+
+	issueRepo.EXPECT().Get(gomock.Any(), "owner", "repo", 1).Return(nil, errors.New("not found"))
+	issueRepo.EXPECT().Get(gomock.Any(), "owner", "repo", 2).
+		Return(&models.Issue{Number: 2, State: models.IssueStateOpen, Comments: 1}, nil)
+
+	events, err := uc.Poll(context.Background())
+	if len(events) != 1 || events[0].Kind != models.WatchEventComment {
+		t.Errorf("events = %+v, want the comment on #2", events)
+	}
+	if err == nil || !strings.Contains(err.Error(), "owner/repo#1: not found") ||
+		!strings.Contains(err.Error(), "desktop notification failed") {
+		t.Errorf("error = %v, want the failed item and the failed notification", err)
+	}
+	if store.items[0].Snapshot.State != "open" || store.items[1].Snapshot.Comments != 1 {
+		t.Errorf("expected #1 kept for the next poll and #2 updated, got %+v %+v", store.items[0].Snapshot, store.items[1].Snapshot)
+	}
+}
@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+)
+
+func runAuth(ctx context.Context, args []string, deps Dependencies) error {
+	if len(args) != 1 {
+		fmt.Fprintf(deps.Stderr, "Usage: tig-gh auth status|login|logout\n")
+		return errUsage
+	}
+	if deps.Auth == nil {
+		return fmt.Errorf("auth manager not initialized")
+	}
+
+	switch args[0] {
+	case "status":
+		source, ok := deps.Auth.Status(ctx)
+		if !ok {
+			fmt.Fprintf(deps.Stdout, "Not logged in; tig-gh runs in read-only guest mode.\n")
+			return nil
+		}
+		fmt.Fprintf(deps.Stdout, "Logged in with a token from %s.\n", source)
+		return nil
+	case "login":
+		return deps.Auth.Login(ctx, deps.Stderr)
+	case "logout":
+		if err := deps.Auth.Logout(); err != nil {
+			return err
+		}
+		fmt.Fprintf(deps.Stdout, "Removed the token stored in the keyring.\n")
+		return nil
+	default:
+		fmt.Fprintf(deps.Stderr, "Usage: tig-gh auth status|login|logout\n")
+		return errUsage
+	}
+}
@@ -0,0 +1,225 @@
+// Package cli implements the non-interactive subcommands of tig-gh.
+// They reuse the same use cases as the TUI and print plain or JSON output,
+// so they can be used from scripts, CI jobs and cron.
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// FetchIssuesUseCase lists issues of a repository
+type FetchIssuesUseCase interface {
+	Execute(ctx context.Context, owner, repo string, opts *models.IssueOptions) ([]*models.Issue, error)
+}
+
+// FetchPRsUseCase lists pull requests of a repository
+type FetchPRsUseCase interface {
+	Execute(ctx context.Context, owner, repo string, opts *models.PROptions) ([]*models.PullRequest, error)
+}
+
+// FetchMetricsUseCase computes lead time metrics for the configured repositories
+type FetchMetricsUseCase interface {
+	Execute(ctx context.Context, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error)
+}
+
+// MetricsRunResumer is implemented by metrics use cases that can resume an
+// interrupted run, fetching only the repositories it did not finish
+type MetricsRunResumer interface {
+	ResumeInterruptedRun(ctx context.Context, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error)
+}
+
+// AuthManager manages the GitHub login of tig-gh
+type AuthManager interface {
+	// Status returns where the current token comes from, or ok=false when there is none
+	Status(ctx context.Context) (source string, ok bool)
+	// Login runs the device flow login and stores the token in the OS keyring
+	Login(ctx context.Context, out io.Writer) error
+	// Logout removes the token stored in the OS keyring
+	Logout() error
+}
+
+// Upgrader replaces the running tig-gh with the newest release
+type Upgrader interface {
+	// Latest returns the newest release version and whether it is newer than the running one
+	Latest(ctx context.Context) (version string, newer bool, err error)
+	// Upgrade downloads the newest release, verifies its checksum and installs it
+	Upgrade(ctx context.Context) (version string, err error)
+}
+
+// UsageStatsStore keeps the local usage counters
+type UsageStatsStore interface {
+	// Load returns the counters, or nil when nothing was counted yet
+	Load() (*models.UsageStats, error)
+	// Reset deletes the counters
+	Reset() error
+}
+
+// MetricsViewer opens an exported metrics report (the output of
+// `tig-gh metrics --json`) in the interactive metrics view
+type MetricsViewer func(ctx context.Context, path string) error
+
+// RepositoryResolver resolves "owner/repo" from an optional argument,
+// falling back to the current git repository or the configured default.
+type RepositoryResolver func(arg string) (owner, repo string, err error)
+
+// Dependencies holds everything the subcommands need
+type Dependencies struct {
+	FetchIssues  FetchIssuesUseCase
+	FetchPRs     FetchPRsUseCase
+	FetchMetrics FetchMetricsUseCase
+	ViewMetrics  MetricsViewer
+	Auth         AuthManager
+	Upgrade      Upgrader
+	Stats        UsageStatsStore
+	ResolveRepo  RepositoryResolver
+	Paths        []PathInfo        // the config, cache and state locations doctor lists
+	KeyBindings  map[string]string // ui.key_bindings, applied over the defaults by keys
+	Stdout       io.Writer
+	Stderr       io.Writer
+}
+
+// errUsage signals that usage has already been printed
+var errUsage = errors.New("usage error")
+
+// errChecksFailed signals that a check command found a breached threshold
+var errChecksFailed = errors.New("checks failed")
+
+// Exit codes of Run
+const (
+	exitOK           = 0
+	exitError        = 1
+	exitUsage        = 2
+	exitChecksFailed = 3
+)
+
+type command struct {
+	name    string
+	summary string
+	run     func(ctx context.Context, args []string, deps Dependencies) error
+}
+
+var commands = []command{
+	{name: "issues", summary: "issues list [--state=open|closed|all] [--limit=N] [--json] [owner/repo]", run: runIssues},
+	{name: "prs", summary: "prs list [--state=open|closed|all] [--limit=N] [--json] [owner/repo]", run: runPRs},
+	{name: "metrics", summary: "metrics [--json] [--resume] | metrics view FILE.json | metrics check [--resume] [--max-lead-time=72h] [--max-stagnant=N]", run: runMetrics},
+	{name: "auth", summary: "auth status|login|logout", run: runAuth},
+	{name: "doctor", summary: "doctor", run: runDoctor},
+	{name: "upgrade", summary: "upgrade [--check]", run: runUpgrade},
+	{name: "stats", summary: "stats [--json] [--reset]", run: runStats},
+	{name: "keys", summary: "keys [--output=FILE]", run: runKeys},
+	{name: "report", summary: "report [--format=markdown|html] [--output=FILE] [--resume] [--input=FILE.json]", run: runReport},
+}
+
+// IsCommand reports whether name is a headless subcommand
+func IsCommand(name string) bool {
+	for _, c := range commands {
+		if c.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// NeedsToken reports whether the subcommand in args calls the GitHub API.
+// auth manages the token itself, metrics view reads an exported file,
+// doctor only reports where the token comes from, upgrade talks to the
+// project's public releases, stats reads a local file, keys only reads
+// the config and report --input renders an exported file.
+func NeedsToken(args []string) bool {
+	if len(args) == 0 {
+		return true
+	}
+	switch {
+	case args[0] == "auth", args[0] == "doctor", args[0] == "upgrade", args[0] == "stats", args[0] == "keys":
+		return false
+	case args[0] == "metrics" && len(args) > 1 && args[1] == "view":
+		return false
+	case args[0] == "report":
+		for _, arg := range args[1:] {
+			if arg == "--input" || arg == "-input" || strings.HasPrefix(arg, "--input=") || strings.HasPrefix(arg, "-input=") {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Run executes the subcommand in args[0] and returns the process exit code
+func Run(ctx context.Context, args []string, deps Dependencies) int {
+	if len(args) == 0 {
+		printUsage(deps.Stderr)
+		return exitUsage
+	}
+
+	for _, c := range commands {
+		if c.name != args[0] {
+			continue
+		}
+		if err := c.run(ctx, args[1:], deps); err != nil {
+			if errors.Is(err, errUsage) {
+				return exitUsage
+			}
+			fmt.Fprintf(deps.Stderr, "Error: %v\n", err)
+			if errors.Is(err, errChecksFailed) {
+				return exitChecksFailed
+			}
+			return exitError
+		}
+		return exitOK
+	}
+
+	fmt.Fprintf(deps.Stderr, "Error: unknown command %q\n", args[0])
+	printUsage(deps.Stderr)
+	return exitUsage
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintf(w, "Usage:\n")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  tig-gh %s\n", c.summary)
+	}
+}
+
+// newFlagSet creates a flag set that reports errors to stderr without exiting
+func newFlagSet(name string, deps Dependencies) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(deps.Stderr)
+	return fs
+}
+
+// parseFlags parses flags and converts parse failures into errUsage
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	return nil
+}
+
+// writeJSON writes v as indented JSON
+func writeJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("failed to encode JSON: %w", err)
+	}
+	return nil
+}
+
+// resolveRepo resolves the repository from the remaining positional arguments
+func resolveRepo(fs *flag.FlagSet, deps Dependencies) (string, string, error) {
+	if fs.NArg() > 1 {
+		return "", "", fmt.Errorf("too many arguments: %v", fs.Args())
+	}
+	if deps.ResolveRepo == nil {
+		return "", "", fmt.Errorf("repository resolver not configured")
+	}
+	return deps.ResolveRepo(fs.Arg(0))
+}
@@ -0,0 +1,721 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/app/usecase"
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/infra/clock"
+	"github.com/a1yama/tig-gh/internal/infra/github"
+)
+
+// stubIssues serves issues as the first page, or pages when set
+type stubIssues struct {
+	issues   []*models.Issue
+	pages    [][]*models.Issue
+	err      error
+	gotOpts  *models.IssueOptions
+	gotPages []int
+	gotOwner string
+	gotRepo  string
+}
+
+func (s *stubIssues) Execute(ctx context.Context, owner, repo string, opts *models.IssueOptions) ([]*models.Issue, error) {
+	s.gotOwner, s.gotRepo, s.gotOpts = owner, repo, opts
+	s.gotPages = append(s.gotPages, opts.Page)
+	pages := s.pages
+	if pages == nil {
+		pages = [][]*models.Issue{s.issues}
+	}
+	if opts.Page > len(pages) {
+		return nil, s.err
+	}
+	return pages[opts.Page-1], s.err
+}
+
+type stubPRs struct {
+	prs     []*models.PullRequest
+	gotOpts *models.PROptions
+}
+
+func (s *stubPRs) Execute(ctx context.Context, owner, repo string, opts *models.PROptions) ([]*models.PullRequest, error) {
+	s.gotOpts = opts
+	return s.prs, nil
+}
+
+type stubMetrics struct {
+	metrics *models.LeadTimeMetrics
+	err     error
+}
+
+func (s *stubMetrics) Execute(ctx context.Context, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error) {
+	return s.metrics, s.err
+}
+
+func newTestDeps() (Dependencies, *bytes.Buffer, *bytes.Buffer) {
+	var stdout, stderr bytes.Buffer
+	return Dependencies{
+		ResolveRepo: func(arg string) (string, string, error) {
+			if arg == "" {
+				return "default", "repo", nil
+			}
+			parts := strings.Split(arg, "/")
+			if len(parts) != 2 {
+				return "", "", errors.New("invalid repository format")
+			}
+			return parts[0], parts[1], nil
+		},
+		Stdout: &stdout,
+		Stderr: &stderr,
+	}, &stdout, &stderr
+}
+
+func TestIsCommand(t *testing.T) {
+	for _, name := range []string{"issues", "prs", "metrics", "auth"} {
+		if !IsCommand(name) {
+			t.Errorf("expected %s to be a command", name)
+		}
+	}
+	if IsCommand("owner/repo") {
+		t.Error("owner/repo should not be a command")
+	}
+}
+
+func TestRun_IssuesListJSON(t *testing.T) {
+	deps, stdout, _ := newTestDeps()
+	issues := &stubIssues{issues: []*models.Issue{
+		{Number: 1, Title: "Bug", State: models.IssueStateOpen, Author: models.User{Login: "alice"}, Labels: []models.Label{{Name: "bug"}}, HTMLURL: "https://github.com/o/r/issues/1", CreatedAt: time.Unix(0, 0).UTC()},
+		{Number: 2, Title: "PR as issue", HTMLURL: "https://github.com/o/r/pull/2"},
+	}}
+	deps.FetchIssues = issues
+
+	code := Run(context.Background(), []string{"issues", "list", "--state=closed", "--limit=5", "--json", "o/r"}, deps)
+	if code != 0 {
+		t.Fatalf("expected exit code 0, got %d", code)
+	}
+
+	if issues.gotOwner != "o" || issues.gotRepo != "r" {
+		t.Errorf("unexpected repository %s/%s", issues.gotOwner, issues.gotRepo)
+	}
+	if issues.gotOpts.State != models.IssueStateClosed || issues.gotOpts.PerPage != 5 {
+		t.Errorf("unexpected options %+v", issues.gotOpts)
+	}
+
+	var items []listItem
+	if err := json.Unmarshal(stdout.Bytes(), &items); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, stdout.String())
+	}
+	if len(items) != 1 || items[0].Number != 1 || items[0].Author != "alice" || items[0].Labels[0] != "bug" {
+		t.Fatalf("unexpected items %+v", items)
+	}
+}
+
+func TestRun_IssuesListPagesPastPullRequests(t *testing.T) {
+	issue := func(number int, kind string) *models.Issue {
+		return &models.Issue{Number: number, HTMLURL: fmt.Sprintf("https://github.com/o/r/%s/%d", kind, number)}
+	}
+	deps, stdout, _ := newTestDeps()
+	issues := &stubIssues{pages: [][]*models.Issue{
+		{issue(1, "issues"), issue(2, "pull"), issue(3, "pull")},
+		{issue(4, "pull"), issue(5, "issues"), issue(6, "issues")},
+		{issue(7, "issues")},
+	}}
+	deps.FetchIssues = issues
+
+	if code := Run(context.Background(), []string{"issues", "list", "--limit=3", "--json", "o/r"}, deps); code != 0 {
+		t.Fatalf("expected exit code 0, got %d", code)
+	}
+
+	var items []listItem
+	if err := json.Unmarshal(stdout.Bytes(), &items); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, stdout.String())
+	}
+	if len(items) != 3 || items[0].Number != 1 || items[1].Number != 5 || items[2].Number != 6 {
+		t.Fatalf("expected issues 1, 5 and 6, got %+v", items)
+	}
+	if len(issues.gotPages) != 2 {
+		t.Errorf("expected to stop once there were enough issues, fetched pages %v", issues.gotPages)
+	}
+
+	// The last page runs out before the limit
+	stdout.Reset()
+	issues.gotPages = nil
+	if code := Run(context.Background(), []string{"issues", "list", "--limit=10", "--json", "o/r"}, deps); code != 0 {
+		t.Fatalf("expected exit code 0, got %d", code)
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &items); err != nil || len(items) != 4 {
+		t.Fatalf("expected all 4 issues, got %+v (%v)", items, err)
+	}
+	if len(issues.gotPages) != 4 {
+		t.Errorf("expected to stop at the empty page, fetched pages %v", issues.gotPages)
+	}
+}
+
+func TestRun_PRsListPlain(t *testing.T) {
+	deps, stdout, _ := newTestDeps()
+	prs := &stubPRs{prs: []*models.PullRequest{
+		{Number: 7, Title: "Add feature", State: models.PRStateOpen, Author: models.User{Login: "bob"}},
+	}}
+	deps.FetchPRs = prs
+
+	if code := Run(context.Background(), []string{"prs", "list"}, deps); code != 0 {
+		t.Fatalf("expected exit code 0, got %d", code)
+	}
+	if prs.gotOpts.State != models.PRStateOpen {
+		t.Errorf("expected default state open, got %s", prs.gotOpts.State)
+	}
+	if !strings.Contains(stdout.String(), "#7") || !strings.Contains(stdout.String(), "@bob") {
+		t.Errorf("unexpected output %q", stdout.String())
+	}
+}
+
+func TestRun_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{name: "no args", args: nil, want: 2},
+		{name: "unknown command", args: []string{"bogus"}, want: 2},
+		{name: "missing list", args: []string{"issues"}, want: 2},
+		{name: "unknown flag", args: []string{"prs", "list", "--nope"}, want: 2},
+		{name: "bad state", args: []string{"prs", "list", "--state=merged"}, want: 1},
+		{name: "bad repo", args: []string{"issues", "list", "invalid"}, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps, _, _ := newTestDeps()
+			deps.FetchIssues = &stubIssues{}
+			deps.FetchPRs = &stubPRs{}
+			if got := Run(context.Background(), tt.args, deps); got != tt.want {
+				t.Errorf("expected exit code %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRun_MetricsJSON(t *testing.T) {
+	deps, stdout, stderr := newTestDeps()
+	deps.FetchMetrics = &stubMetrics{
+		metrics: &models.LeadTimeMetrics{Overall: models.LeadTimeStat{Average: time.Hour, Count: 3}},
+		err:     errors.New("owner/broken: not found"),
+	}
+
+	if code := Run(context.Background(), []string{"metrics", "--json"}, deps); code != 0 {
+		t.Fatalf("expected exit code 0 for partial results, got %d", code)
+	}
+
+	var metrics models.LeadTimeMetrics
+	if err := json.Unmarshal(stdout.Bytes(), &metrics); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if metrics.Overall.Count != 3 {
+		t.Errorf("unexpected overall %+v", metrics.Overall)
+	}
+	if !strings.Contains(stderr.String(), "owner/broken") {
+		t.Errorf("expected partial failure warning, got %q", stderr.String())
+	}
+}
+
+func TestRun_MetricsWarnsAboutFailedRepositories(t *testing.T) {
+	deps, _, stderr := newTestDeps()
+	deps.FetchMetrics = &stubMetrics{
+		metrics: &models.LeadTimeMetrics{RepositoryStatuses: []models.MetricsRepositoryStatus{
+			{Repository: "owner/ok"},
+			{Repository: "owner/private", Error: "forbidden - insufficient permissions (403)"},
+		}},
+	}
+
+	if code := Run(context.Background(), []string{"metrics"}, deps); code != 0 {
+		t.Fatalf("expected exit code 0 for partial results, got %d", code)
+	}
+	if got := stderr.String(); got != "Warning: owner/private: forbidden - insufficient permissions (403)\n" {
+		t.Errorf("stderr = %q", got)
+	}
+}
+
+// TestRun_MetricsJSONWithFailedAnalyses runs the real metrics against a server
+// where the open pull requests and the reviews cannot be listed: the warnings
+// go to stderr and stdout stays valid JSON
+func TestRun_MetricsJSONWithFailedAnalyses(t *testing.T) {
+	merged := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/api/v3")
+		switch {
+		case path == "/repos/owner/repo":
+			fmt.Fprint(w, `{"default_branch":"main"}`)
+		case path == "/repos/owner/repo/pulls" && r.URL.Query().Get("state") == "closed":
+			fmt.Fprintf(w, `[{"number":1,"created_at":"2020-01-01T00:00:00Z","merged_at":%q,"base":{"ref":"main"},"user":{"login":"alice"}}]`, merged)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message":"Not Found"}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := github.NewClientForHost("", server.URL+"/", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := models.DefaultConfig()
+	cfg.Metrics.Enabled, cfg.Metrics.LeadTimeEnabled = true, true
+	cfg.GitHub.Repositories = []string{"owner/repo"}
+	deps, stdout, stderr := newTestDeps()
+	deps.FetchMetrics = usecase.NewFetchLeadTimeMetricsUseCase(github.NewMetricsRepository(client, nil), cfg)
+
+	if code := Run(context.Background(), []string{"metrics", "--json"}, deps); code != 0 {
+		t.Fatalf("expected exit code 0, got %d (stderr %q)", code, stderr.String())
+	}
+
+	var metrics models.LeadTimeMetrics
+	if err := json.Unmarshal(stdout.Bytes(), &metrics); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, stdout.String())
+	}
+	if metrics.Overall.Count != 1 {
+		t.Errorf("expected the merged pull request to be counted, got %+v", metrics.Overall)
+	}
+	for _, want := range []string{"failed to analyze PR quality", "failed to fetch stagnant PR metrics for owner/repo", "owner/repo: failed to fetch reviews of 1 PRs"} {
+		if !strings.Contains(stderr.String(), want) {
+			t.Errorf("expected the warning %q on stderr, got %q", want, stderr.String())
+		}
+	}
+}
+
+type resumingMetrics struct {
+	stubMetrics
+	resumed bool
+}
+
+func (s *resumingMetrics) ResumeInterruptedRun(ctx context.Context, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error) {
+	s.resumed = true
+	return s.metrics, s.err
+}
+
+func TestRun_MetricsResume(t *testing.T) {
+	deps, _, _ := newTestDeps()
+	useCase := &resumingMetrics{stubMetrics: stubMetrics{metrics: &models.LeadTimeMetrics{}}}
+	deps.FetchMetrics = useCase
+
+	if code := Run(context.Background(), []string{"metrics"}, deps); code != 0 || useCase.resumed {
+		t.Fatalf("expected a full run without --resume (code %d)", code)
+	}
+	if code := Run(context.Background(), []string{"metrics", "--resume", "--json"}, deps); code != 0 || !useCase.resumed {
+		t.Fatalf("expected --resume to resume the interrupted run (code %d)", code)
+	}
+}
+
+func TestRun_Keys(t *testing.T) {
+	deps, stdout, _ := newTestDeps()
+	deps.KeyBindings = map[string]string{"quit": "Q"}
+
+	if code := Run(context.Background(), []string{"keys"}, deps); code != 0 {
+		t.Fatalf("expected exit code 0, got %d", code)
+	}
+	if !strings.Contains(stdout.String(), "| `Q` * | quit |") {
+		t.Errorf("expected the remapped quit key, got:\n%s", stdout.String())
+	}
+
+	path := filepath.Join(t.TempDir(), "keys.md")
+	stdout.Reset()
+	if code := Run(context.Background(), []string{"keys", "--output", path}, deps); code != 0 {
+		t.Fatalf("expected exit code 0, got %d", code)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || !strings.HasPrefix(string(data), "# tig-gh key bindings") || stdout.Len() != 0 {
+		t.Errorf("expected the cheat sheet in %s only, got %q (%v), stdout %q", path, data, err, stdout.String())
+	}
+
+	deps.KeyBindings = map[string]string{"quit": ""}
+	if code := Run(context.Background(), []string{"keys"}, deps); code != 1 {
+		t.Errorf("expected exit code 1 for an empty binding, got %d", code)
+	}
+}
+
+func TestRun_Report(t *testing.T) {
+	t.Cleanup(clock.Set(clock.Fixed(time.Date(2025, 1, 22, 12, 0, 0, 0, time.UTC))))
+	metrics := &models.LeadTimeMetrics{
+		Overall: models.LeadTimeStat{Average: 26 * time.Hour, Median: 5 * time.Hour, Count: 4,
+			Percentiles: []models.LeadTimePercentile{{Percentile: 90, Value: 50 * time.Hour}}},
+		ByRepository:   map[string]models.LeadTimeStat{"owner/repo": {Average: 26 * time.Hour, Median: 5 * time.Hour, Count: 4}},
+		Trend:          []models.TrendPoint{{Period: "2025-W03", AverageLeadTime: 2 * time.Hour, PRCount: 1}, {Period: "2025-W04", AverageLeadTime: 4 * time.Hour, PRCount: 3}},
+		PhaseBreakdown: models.ReviewPhaseMetrics{CreatedToFirstReview: 10 * time.Hour, FirstReviewToApproval: 5 * time.Hour, ApprovalToMerge: 40 * time.Minute},
+		StagnantPRs: models.StagnantPRMetrics{Threshold: 72 * time.Hour, TotalStagnant: 1, AverageAge: 100 * time.Hour,
+			LongestWaiting: []models.StagnantPRInfo{{Repository: "owner/repo", Number: 7, Title: "Fix <script> | pipes", Age: 100 * time.Hour}}},
+		RepositoryStatuses: []models.MetricsRepositoryStatus{{Repository: "owner/repo"}, {Repository: "owner/broken", Error: "not found"}},
+	}
+	deps, stdout, _ := newTestDeps()
+	deps.FetchMetrics = &stubMetrics{metrics: metrics}
+
+	if code := Run(context.Background(), []string{"report"}, deps); code != 0 {
+		t.Fatalf("expected exit code 0, got %d", code)
+	}
+	for _, want := range []string{
+		"# Lead time report",
+		"Generated 2025-01-22 12:00 UTC from 4 merged pull requests.",
+		"| Repository | Average | Median | p90 | PRs |",
+		"| **All repositories** | 1d 2h | 5h 0m | 2d 2h | 4 |",
+		"| owner/repo | 1d 2h | 5h 0m | - | 4 |",
+		"| Created → first review | 10h 0m | `████████████████████` |",
+		"| Approval → merge | 40m | `█░░░░░░░░░░░░░░░░░░░` |",
+		"| 2025-W03 | 2h 0m | 1 | `██████████░░░░░░░░░░` |",
+		"| owner/repo#7 | Fix <script> \\| pipes | 4d 4h |",
+		"- owner/broken: not found",
+	} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Errorf("expected %q in the Markdown report, got:\n%s", want, stdout.String())
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "report.html")
+	stdout.Reset()
+	if code := Run(context.Background(), []string{"report", "--format=html", "--output", path}, deps); code != 0 {
+		t.Fatalf("expected exit code 0, got %d", code)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || stdout.Len() != 0 {
+		t.Fatalf("expected the report in %s only, got %v, stdout %q", path, err, stdout.String())
+	}
+	html := string(data)
+	for _, want := range []string{"<!DOCTYPE html>", "<style>", `<div style="width: 50%">`, "Fix &lt;script&gt; | pipes"} {
+		if !strings.Contains(html, want) {
+			t.Errorf("expected %q in the HTML report, got:\n%s", want, html)
+		}
+	}
+
+	if code := Run(context.Background(), []string{"report", "--format=pdf"}, deps); code != 2 {
+		t.Errorf("expected exit code 2 for an unknown format, got %d", code)
+	}
+}
+
+func TestRun_ReportFromExportedMetrics(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.json")
+	data, _ := json.Marshal(models.LeadTimeMetrics{Overall: models.LeadTimeStat{Average: time.Hour, Count: 2}})
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	// No FetchMetrics: the exported file is rendered without calling the API
+	deps, stdout, _ := newTestDeps()
+
+	if code := Run(context.Background(), []string{"report", "--input", path}, deps); code != 0 {
+		t.Fatalf("expected exit code 0, got %d", code)
+	}
+	if !strings.Contains(stdout.String(), "| **All repositories** | 1h 0m | - | 2 |") {
+		t.Errorf("expected the exported overall, got:\n%s", stdout.String())
+	}
+}
+
+func TestRun_MetricsError(t *testing.T) {
+	deps, _, stderr := newTestDeps()
+	deps.FetchMetrics = &stubMetrics{err: errors.New("metrics disabled")}
+
+	if code := Run(context.Background(), []string{"metrics"}, deps); code != 1 {
+		t.Fatalf("expected exit code 1, got %d", code)
+	}
+	if !strings.Contains(stderr.String(), "metrics disabled") {
+		t.Errorf("unexpected stderr %q", stderr.String())
+	}
+}
+
+func TestRun_MetricsView(t *testing.T) {
+	deps, _, _ := newTestDeps()
+	var opened string
+	deps.ViewMetrics = func(ctx context.Context, path string) error {
+		opened = path
+		return nil
+	}
+
+	if code := Run(context.Background(), []string{"metrics", "view", "report.json"}, deps); code != 0 {
+		t.Fatalf("expected exit code 0, got %d", code)
+	}
+	if opened != "report.json" {
+		t.Errorf("opened %q, want report.json", opened)
+	}
+}
+
+func TestRun_MetricsViewNeedsFile(t *testing.T) {
+	deps, _, stderr := newTestDeps()
+	deps.ViewMetrics = func(ctx context.Context, path string) error {
+		t.Error("viewer should not be called without a file")
+		return nil
+	}
+
+	if code := Run(context.Background(), []string{"metrics", "view"}, deps); code != 2 {
+		t.Fatalf("expected exit code 2, got %d", code)
+	}
+	if !strings.Contains(stderr.String(), "metrics view FILE.json") {
+		t.Errorf("unexpected stderr %q", stderr.String())
+	}
+}
+
+func TestRun_MetricsCheck(t *testing.T) {
+	metrics := &models.LeadTimeMetrics{
+		Overall:     models.LeadTimeStat{Average: 80 * time.Hour, Count: 4},
+		StagnantPRs: models.StagnantPRMetrics{TotalStagnant: 3},
+	}
+	tests := []struct {
+		name     string
+		args     []string
+		wantCode int
+		wantOut  []string
+	}{
+		{
+			name:     "within thresholds",
+			args:     []string{"--max-lead-time=96h", "--max-stagnant=3"},
+			wantCode: 0,
+			wantOut:  []string{"PASS lead time (avg): 80h0m0s (max 96h0m0s)", "PASS stagnant PRs: 3 (max 3)"},
+		},
+		{
+			name:     "lead time breached",
+			args:     []string{"--max-lead-time", "72h", "--max-stagnant", "5"},
+			wantCode: 3,
+			wantOut:  []string{"FAIL lead time (avg): 80h0m0s (max 72h0m0s)", "PASS stagnant PRs"},
+		},
+		{
+			name:     "only stagnant checked",
+			args:     []string{"--max-stagnant=0"},
+			wantCode: 3,
+			wantOut:  []string{"FAIL stagnant PRs: 3 (max 0)"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps, stdout, _ := newTestDeps()
+			deps.FetchMetrics = &stubMetrics{metrics: metrics}
+
+			if code := Run(context.Background(), append([]string{"metrics", "check"}, tt.args...), deps); code != tt.wantCode {
+				t.Fatalf("expected exit code %d, got %d", tt.wantCode, code)
+			}
+			for _, want := range tt.wantOut {
+				if !strings.Contains(stdout.String(), want) {
+					t.Errorf("stdout %q does not contain %q", stdout.String(), want)
+				}
+			}
+			if strings.Contains(stdout.String(), "lead time") != strings.Contains(strings.Join(tt.args, " "), "lead-time") {
+				t.Errorf("only the requested thresholds should be checked: %q", stdout.String())
+			}
+		})
+	}
+}
+
+func TestRun_MetricsCheckNeedsThreshold(t *testing.T) {
+	deps, _, stderr := newTestDeps()
+	deps.FetchMetrics = &stubMetrics{metrics: &models.LeadTimeMetrics{}}
+
+	if code := Run(context.Background(), []string{"metrics", "check"}, deps); code != 2 {
+		t.Fatalf("expected exit code 2, got %d", code)
+	}
+	if !strings.Contains(stderr.String(), "--max-lead-time") {
+		t.Errorf("unexpected stderr %q", stderr.String())
+	}
+}
+
+func TestRun_MetricsCheckFailsOnIncompleteMetrics(t *testing.T) {
+	deps, _, stderr := newTestDeps()
+	deps.FetchMetrics = &stubMetrics{metrics: &models.LeadTimeMetrics{
+		RepositoryStatuses: []models.MetricsRepositoryStatus{
+			{Repository: "owner/a"},
+			{Repository: "owner/b", Error: "not found"},
+		},
+	}}
+
+	if code := Run(context.Background(), []string{"metrics", "check", "--max-stagnant=5"}, deps); code != 1 {
+		t.Fatalf("expected exit code 1, got %d", code)
+	}
+	if !strings.Contains(stderr.String(), "incomplete") {
+		t.Errorf("unexpected stderr %q", stderr.String())
+	}
+}
+
+func TestNeedsToken(t *testing.T) {
+	tests := []struct {
+		args []string
+		want bool
+	}{
+		{args: []string{"issues", "list"}, want: true},
+		{args: []string{"metrics", "--json"}, want: true},
+		{args: []string{"metrics", "view", "report.json"}, want: false},
+		{args: []string{"auth", "status"}, want: false},
+		{args: []string{"doctor"}, want: false},
+		{args: []string{"stats"}, want: false},
+		{args: []string{"keys"}, want: false},
+		{args: []string{"report"}, want: true},
+		{args: []string{"report", "--input=metrics.json"}, want: false},
+	}
+	for _, tt := range tests {
+		if got := NeedsToken(tt.args); got != tt.want {
+			t.Errorf("NeedsToken(%v) = %v, want %v", tt.args, got, tt.want)
+		}
+	}
+}
+
+type stubAuth struct {
+	source    string
+	loggedOut bool
+}
+
+func (s *stubAuth) Status(ctx context.Context) (string, bool) { return s.source, s.source != "" }
+func (s *stubAuth) Login(ctx context.Context, out io.Writer) error {
+	s.source = "keyring"
+	return nil
+}
+func (s *stubAuth) Logout() error {
+	s.loggedOut = true
+	return nil
+}
+
+func TestRun_Auth(t *testing.T) {
+	deps, stdout, _ := newTestDeps()
+	stub := &stubAuth{source: "gh"}
+	deps.Auth = stub
+
+	if code := Run(context.Background(), []string{"auth", "status"}, deps); code != 0 {
+		t.Fatalf("exit code = %d", code)
+	}
+	if !strings.Contains(stdout.String(), "token from gh") {
+		t.Errorf("unexpected status output: %q", stdout.String())
+	}
+
+	if code := Run(context.Background(), []string{"auth", "logout"}, deps); code != 0 || !stub.loggedOut {
+		t.Errorf("exit code = %d, loggedOut = %v", code, stub.loggedOut)
+	}
+	if code := Run(context.Background(), []string{"auth", "whoami"}, deps); code != 2 {
+		t.Errorf("exit code for an unknown subcommand = %d, want 2", code)
+	}
+}
+
+func TestRun_Doctor(t *testing.T) {
+	deps, stdout, _ := newTestDeps()
+	dir := t.TempDir()
+	deps.Paths = []PathInfo{
+		{Name: "config file", Path: ""},
+		{Name: "cache dir", Path: dir},
+		{Name: "state dir", Path: filepath.Join(dir, "missing")},
+	}
+	deps.Auth = &stubAuth{source: "keyring"}
+
+	if code := Run(context.Background(), []string{"doctor"}, deps); code != 0 {
+		t.Fatalf("exit code = %d", code)
+	}
+	out := stdout.String()
+	for _, want := range []string{"config file  -", "cache dir    " + dir, filepath.Join(dir, "missing") + "  (not created yet)", "token from keyring"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, dir+"  (not created yet)") {
+		t.Errorf("expected an existing directory not marked missing\n%s", out)
+	}
+}
+
+type stubUpgrader struct {
+	latest   string
+	newer    bool
+	upgraded bool
+}
+
+func (s *stubUpgrader) Latest(ctx context.Context) (string, bool, error) {
+	return s.latest, s.newer, nil
+}
+
+func (s *stubUpgrader) Upgrade(ctx context.Context) (string, error) {
+	s.upgraded = true
+	return s.latest, nil
+}
+
+func TestRun_Upgrade(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		newer        bool
+		wantOutput   string
+		wantUpgraded bool
+	}{
+		{name: "up to date", args: []string{"upgrade"}, wantOutput: "up to date"},
+		{name: "check only", args: []string{"upgrade", "--check"}, newer: true, wantOutput: "v1.2.0 is available"},
+		{name: "upgrade", args: []string{"upgrade"}, newer: true, wantOutput: "Upgraded tig-gh to v1.2.0", wantUpgraded: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps, stdout, _ := newTestDeps()
+			stub := &stubUpgrader{latest: "v1.2.0", newer: tt.newer}
+			deps.Upgrade = stub
+
+			if code := Run(context.Background(), tt.args, deps); code != 0 {
+				t.Fatalf("exit code = %d", code)
+			}
+			if !strings.Contains(stdout.String(), tt.wantOutput) {
+				t.Errorf("expected %q in %q", tt.wantOutput, stdout.String())
+			}
+			if stub.upgraded != tt.wantUpgraded {
+				t.Errorf("upgraded = %v, want %v", stub.upgraded, tt.wantUpgraded)
+			}
+		})
+	}
+}
+
+type stubStats struct {
+	stats *models.UsageStats
+	reset bool
+}
+
+func (s *stubStats) Load() (*models.UsageStats, error) { return s.stats, nil }
+func (s *stubStats) Reset() error {
+	s.reset = true
+	return nil
+}
+
+func TestRun_Stats(t *testing.T) {
+	stats := models.NewUsageStats(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	stats.Sessions = 4
+	stats.SessionSeconds = 4 * 600
+	stats.Views = map[string]int{"Issues": 3, "Pull requests": 9}
+	stats.Actions = map[string]int{"pull request merged": 2}
+
+	deps, stdout, stderr := newTestDeps()
+	deps.Stats = &stubStats{stats: stats}
+	if code := Run(context.Background(), []string{"stats"}, deps); code != 0 {
+		t.Fatalf("exit code = %d, stderr = %s", code, stderr.String())
+	}
+	out := stdout.String()
+	for _, want := range []string{"4 sessions, 10m0s on average", "pull request merged  2"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in\n%s", want, out)
+		}
+	}
+	if strings.Index(out, "Pull requests") > strings.Index(out, "Issues") {
+		t.Errorf("expected the most opened view first\n%s", out)
+	}
+	if !strings.Contains(stderr.String(), "never sent") {
+		t.Errorf("expected a note that nothing is sent, got %q", stderr.String())
+	}
+
+	deps, stdout, _ = newTestDeps()
+	deps.Stats = &stubStats{stats: stats}
+	if code := Run(context.Background(), []string{"stats", "--json"}, deps); code != 0 {
+		t.Fatalf("exit code = %d", code)
+	}
+	var decoded models.UsageStats
+	if err := json.Unmarshal(stdout.Bytes(), &decoded); err != nil || decoded.Views["Pull requests"] != 9 {
+		t.Errorf("JSON output = %s, %v", stdout.String(), err)
+	}
+
+	stub := &stubStats{}
+	deps, stdout, _ = newTestDeps()
+	deps.Stats = stub
+	if code := Run(context.Background(), []string{"stats", "--reset"}, deps); code != 0 || !stub.reset {
+		t.Errorf("expected the counters reset, exit code = %d", code)
+	}
+}
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+)
+
+// PathInfo is a file or directory tig-gh reads or writes, listed by doctor
+type PathInfo struct {
+	Name string
+	Path string
+}
+
+func runDoctor(ctx context.Context, args []string, deps Dependencies) error {
+	if len(args) != 0 {
+		fmt.Fprintf(deps.Stderr, "Usage: tig-gh doctor\n")
+		return errUsage
+	}
+
+	fmt.Fprintf(deps.Stdout, "Paths:\n")
+	w := tabwriter.NewWriter(deps.Stdout, 0, 0, 2, ' ', 0)
+	for _, p := range deps.Paths {
+		path, note := p.Path, ""
+		if path == "" {
+			path = "-"
+		} else if _, err := os.Stat(path); os.IsNotExist(err) {
+			note = "(not created yet)"
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", p.Name, path, note)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if deps.Auth != nil {
+		fmt.Fprintf(deps.Stdout, "\nAuth:\n")
+		if source, ok := deps.Auth.Status(ctx); ok {
+			fmt.Fprintf(deps.Stdout, "  Logged in with a token from %s.\n", source)
+		} else {
+			fmt.Fprintf(deps.Stdout, "  Not logged in; tig-gh runs in read-only guest mode.\n")
+		}
+	}
+	return nil
+}
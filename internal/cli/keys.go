@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/a1yama/tig-gh/internal/ui/keybindings"
+)
+
+// runKeys prints the effective key bindings, the defaults with ui.key_bindings
+// applied, as a Markdown cheat sheet teams can share
+func runKeys(ctx context.Context, args []string, deps Dependencies) error {
+	var output string
+	fs := newFlagSet("keys", deps)
+	fs.StringVar(&output, "output", "", "write the cheat sheet to this file instead of stdout")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(deps.Stderr, "Usage: tig-gh keys [--output=FILE]\n")
+		return errUsage
+	}
+
+	bindings, err := keybindings.EffectiveKeyBindings(deps.KeyBindings)
+	if err != nil {
+		return fmt.Errorf("invalid ui.key_bindings: %w", err)
+	}
+	sheet := keybindings.CheatSheetMarkdown(bindings)
+
+	if output == "" {
+		_, err := fmt.Fprint(deps.Stdout, sheet)
+		return err
+	}
+	if err := os.WriteFile(output, []byte(sheet), 0644); err != nil {
+		return fmt.Errorf("failed to write cheat sheet: %w", err)
+	}
+	fmt.Fprintf(deps.Stderr, "Wrote %d key bindings to %s\n", len(bindings), output)
+	return nil
+}
@@ -0,0 +1,179 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// listItem is the machine-readable representation of an issue or pull request
+type listItem struct {
+	Number    int       `json:"number"`
+	Title     string    `json:"title"`
+	State     string    `json:"state"`
+	Author    string    `json:"author"`
+	Labels    []string  `json:"labels"`
+	Draft     bool      `json:"draft,omitempty"`
+	Merged    bool      `json:"merged,omitempty"`
+	Head      string    `json:"head,omitempty"`
+	Base      string    `json:"base,omitempty"`
+	Comments  int       `json:"comments"`
+	URL       string    `json:"url"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type listFlags struct {
+	state  string
+	limit  int
+	asJSON bool
+}
+
+func parseListFlags(name string, args []string, deps Dependencies) (*listFlags, string, string, error) {
+	if len(args) == 0 || args[0] != "list" {
+		fmt.Fprintf(deps.Stderr, "Usage: tig-gh %s list [--state=open|closed|all] [--limit=N] [--json] [owner/repo]\n", name)
+		return nil, "", "", errUsage
+	}
+
+	flags := &listFlags{}
+	fs := newFlagSet(name+" list", deps)
+	fs.StringVar(&flags.state, "state", "open", "filter by state: open, closed or all")
+	fs.IntVar(&flags.limit, "limit", 30, "maximum number of results (1-100)")
+	fs.BoolVar(&flags.asJSON, "json", false, "print JSON output")
+	if err := parseFlags(fs, args[1:]); err != nil {
+		return nil, "", "", err
+	}
+
+	switch flags.state {
+	case "open", "closed", "all":
+	default:
+		return nil, "", "", fmt.Errorf("invalid --state %q (expected open, closed or all)", flags.state)
+	}
+	if flags.limit < 1 || flags.limit > 100 {
+		return nil, "", "", fmt.Errorf("invalid --limit %d (expected 1-100)", flags.limit)
+	}
+
+	owner, repo, err := resolveRepo(fs, deps)
+	if err != nil {
+		return nil, "", "", err
+	}
+	return flags, owner, repo, nil
+}
+
+func runIssues(ctx context.Context, args []string, deps Dependencies) error {
+	flags, owner, repo, err := parseListFlags("issues", args, deps)
+	if err != nil {
+		return err
+	}
+	if deps.FetchIssues == nil {
+		return fmt.Errorf("fetch issues use case not initialized")
+	}
+
+	// The issues API also returns pull requests, which are left out, so a page
+	// can hold fewer issues than asked for before the last one: pages are read
+	// until there are enough issues or one comes back empty
+	items := make([]listItem, 0, flags.limit)
+	for page := 1; len(items) < flags.limit; page++ {
+		issues, err := deps.FetchIssues.Execute(ctx, owner, repo, &models.IssueOptions{
+			State:   models.IssueState(flags.state),
+			PerPage: flags.limit,
+			Page:    page,
+		})
+		if err != nil {
+			return err
+		}
+		if len(issues) == 0 {
+			break
+		}
+		items = appendIssueItems(items, issues, flags.limit)
+	}
+
+	return printItems(deps, items, flags.asJSON)
+}
+
+// appendIssueItems appends the issues that are not pull requests, up to limit items
+func appendIssueItems(items []listItem, issues []*models.Issue, limit int) []listItem {
+	for _, issue := range issues {
+		if len(items) == limit {
+			break
+		}
+		if strings.Contains(issue.HTMLURL, "/pull/") {
+			continue
+		}
+		items = append(items, listItem{
+			Number:    issue.Number,
+			Title:     issue.Title,
+			State:     string(issue.State),
+			Author:    issue.Author.Login,
+			Labels:    labelNames(issue.Labels),
+			Comments:  issue.Comments,
+			URL:       issue.HTMLURL,
+			CreatedAt: issue.CreatedAt,
+			UpdatedAt: issue.UpdatedAt,
+		})
+	}
+	return items
+}
+
+func runPRs(ctx context.Context, args []string, deps Dependencies) error {
+	flags, owner, repo, err := parseListFlags("prs", args, deps)
+	if err != nil {
+		return err
+	}
+	if deps.FetchPRs == nil {
+		return fmt.Errorf("fetch PRs use case not initialized")
+	}
+
+	prs, err := deps.FetchPRs.Execute(ctx, owner, repo, &models.PROptions{
+		State:   models.PRState(flags.state),
+		PerPage: flags.limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	items := make([]listItem, 0, len(prs))
+	for _, pr := range prs {
+		items = append(items, listItem{
+			Number:    pr.Number,
+			Title:     pr.Title,
+			State:     string(pr.State),
+			Author:    pr.Author.Login,
+			Labels:    labelNames(pr.Labels),
+			Draft:     pr.Draft,
+			Merged:    pr.Merged,
+			Head:      pr.Head.Name,
+			Base:      pr.Base.Name,
+			Comments:  pr.Comments,
+			URL:       pr.HTMLURL,
+			CreatedAt: pr.CreatedAt,
+			UpdatedAt: pr.UpdatedAt,
+		})
+	}
+
+	return printItems(deps, items, flags.asJSON)
+}
+
+func printItems(deps Dependencies, items []listItem, asJSON bool) error {
+	if asJSON {
+		return writeJSON(deps.Stdout, items)
+	}
+
+	tw := tabwriter.NewWriter(deps.Stdout, 0, 4, 2, ' ', 0)
+	for _, item := range items {
+		fmt.Fprintf(tw, "#%d\t%s\t%s\t@%s\n", item.Number, item.State, item.Title, item.Author)
+	}
+	return tw.Flush()
+}
+
+func labelNames(labels []models.Label) []string {
+	names := make([]string, 0, len(labels))
+	for _, label := range labels {
+		names = append(names, label.Name)
+	}
+	return names
+}
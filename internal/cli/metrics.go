@@ -0,0 +1,195 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func runMetrics(ctx context.Context, args []string, deps Dependencies) error {
+	if len(args) > 0 {
+		switch args[0] {
+		case "view":
+			return runMetricsView(ctx, args[1:], deps)
+		case "check":
+			return runMetricsCheck(ctx, args[1:], deps)
+		}
+	}
+
+	var asJSON, resume bool
+	fs := newFlagSet("metrics", deps)
+	fs.BoolVar(&asJSON, "json", false, "print JSON output")
+	fs.BoolVar(&resume, "resume", false, "resume an interrupted run, fetching only the repositories it did not finish")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %v", fs.Args())
+	}
+
+	metrics, err := fetchMetrics(ctx, deps, resume)
+	if err != nil {
+		return err
+	}
+
+	if asJSON {
+		return writeJSON(deps.Stdout, metrics)
+	}
+	printMetricsSummary(deps, metrics)
+	return nil
+}
+
+// fetchMetrics computes the metrics, warning on stderr about repositories and
+// analyses that failed.
+// With resume, the repositories an interrupted run finished are not fetched again.
+func fetchMetrics(ctx context.Context, deps Dependencies, resume bool) (*models.LeadTimeMetrics, error) {
+	if deps.FetchMetrics == nil {
+		return nil, fmt.Errorf("metrics are disabled (features.metrics)")
+	}
+
+	execute := deps.FetchMetrics.Execute
+	if resumer, ok := deps.FetchMetrics.(MetricsRunResumer); ok && resume {
+		execute = resumer.ResumeInterruptedRun
+	}
+	metrics, err := execute(ctx, nil)
+	if err != nil && metrics == nil {
+		return nil, err
+	}
+	// Partial results: report failed repositories but still use what we have
+	if err != nil {
+		fmt.Fprintf(deps.Stderr, "Warning: %v\n", err)
+	}
+	for _, status := range metrics.RepositoryStatuses {
+		if !status.OK() {
+			fmt.Fprintf(deps.Stderr, "Warning: %s: %s\n", status.Repository, status.Error)
+		}
+	}
+	for _, warning := range metrics.Warnings {
+		fmt.Fprintf(deps.Stderr, "Warning: %s\n", warning)
+	}
+	return metrics, nil
+}
+
+// metricsCheck is the result of comparing one metric with its threshold
+type metricsCheck struct {
+	name   string
+	value  string
+	limit  string
+	passed bool
+}
+
+// runMetricsCheck fails when the metrics breach the given thresholds, for use as a CI gate
+func runMetricsCheck(ctx context.Context, args []string, deps Dependencies) error {
+	var maxLeadTime time.Duration
+	var maxStagnant int
+	var resume bool
+	fs := newFlagSet("metrics check", deps)
+	fs.BoolVar(&resume, "resume", false, "resume an interrupted run, fetching only the repositories it did not finish")
+	fs.DurationVar(&maxLeadTime, "max-lead-time", 0, "fail when the average lead time exceeds this duration (e.g. 72h)")
+	fs.IntVar(&maxStagnant, "max-stagnant", 0, "fail when more pull requests than this are stagnant")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %v", fs.Args())
+	}
+	set := map[string]bool{}
+	fs.Visit(func(f *flag.Flag) { set[f.Name] = true })
+	if !set["max-lead-time"] && !set["max-stagnant"] {
+		fmt.Fprintf(deps.Stderr, "Usage: tig-gh metrics check [--max-lead-time=72h] [--max-stagnant=N]\n")
+		return errUsage
+	}
+
+	metrics, err := fetchMetrics(ctx, deps, resume)
+	if err != nil {
+		return err
+	}
+
+	var checks []metricsCheck
+	if set["max-lead-time"] {
+		checks = append(checks, metricsCheck{
+			name:   "lead time (avg)",
+			value:  metrics.Overall.Average.String(),
+			limit:  maxLeadTime.String(),
+			passed: metrics.Overall.Average <= maxLeadTime,
+		})
+	}
+	if set["max-stagnant"] {
+		checks = append(checks, metricsCheck{
+			name:   "stagnant PRs",
+			value:  strconv.Itoa(metrics.StagnantPRs.TotalStagnant),
+			limit:  strconv.Itoa(maxStagnant),
+			passed: metrics.StagnantPRs.TotalStagnant <= maxStagnant,
+		})
+	}
+
+	failed := 0
+	for _, check := range checks {
+		result := "PASS"
+		if !check.passed {
+			result = "FAIL"
+			failed++
+		}
+		fmt.Fprintf(deps.Stdout, "%s %s: %s (max %s)\n", result, check.name, check.value, check.limit)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%w: %d of %d thresholds breached", errChecksFailed, failed, len(checks))
+	}
+	// A gate must not pass on metrics that are missing repositories
+	if repos := metrics.FailedRepositories(); len(repos) > 0 {
+		return fmt.Errorf("metrics are incomplete: %d repositories could not be fetched", len(repos))
+	}
+	return nil
+}
+
+// runMetricsView browses a report exported with --json, without a token
+func runMetricsView(ctx context.Context, args []string, deps Dependencies) error {
+	fs := newFlagSet("metrics view", deps)
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fmt.Fprintf(deps.Stderr, "Usage: tig-gh metrics view FILE.json\n")
+		return errUsage
+	}
+	if deps.ViewMetrics == nil {
+		return fmt.Errorf("metrics viewer not initialized")
+	}
+	return deps.ViewMetrics(ctx, fs.Arg(0))
+}
+
+func printMetricsSummary(deps Dependencies, metrics *models.LeadTimeMetrics) {
+	w := deps.Stdout
+	fmt.Fprintf(w, "Overall: avg %s, median %s, %s%d PRs\n",
+		metrics.Overall.Average, metrics.Overall.Median, formatPercentiles(metrics.Overall), metrics.Overall.Count)
+	if metrics.ExcludedPRs > 0 {
+		fmt.Fprintf(w, "Excluded: %d merged PRs by bots or metrics.exclude_authors\n", metrics.ExcludedPRs)
+	}
+
+	repos := make([]string, 0, len(metrics.ByRepository))
+	for repo := range metrics.ByRepository {
+		repos = append(repos, repo)
+	}
+	sort.Strings(repos)
+
+	for _, repo := range repos {
+		stat := metrics.ByRepository[repo]
+		fmt.Fprintf(w, "%s: avg %s, median %s, %s%d PRs\n", repo, stat.Average, stat.Median, formatPercentiles(stat), stat.Count)
+	}
+}
+
+// formatPercentiles renders the lead time percentiles as "p90 36h0m0s, " for the summary lines.
+func formatPercentiles(stat models.LeadTimeStat) string {
+	var b strings.Builder
+	for _, p := range stat.Percentiles {
+		fmt.Fprintf(&b, "p%d %s, ", p.Percentile, p.Value)
+	}
+	return b.String()
+}
@@ -0,0 +1,404 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/infra/clock"
+)
+
+// reportBarWidth is the number of cells of the longest bar in Markdown charts
+const reportBarWidth = 20
+
+// reportUsage is printed when the report flags are invalid
+const reportUsage = "Usage: tig-gh report [--format=markdown|html] [--output=FILE] [--resume] [--input=FILE.json]\n"
+
+// runReport renders the lead time metrics into a Markdown or self-contained
+// HTML report to paste into a team wiki or chat
+func runReport(ctx context.Context, args []string, deps Dependencies) error {
+	var format, output, input string
+	var resume bool
+	fs := newFlagSet("report", deps)
+	fs.StringVar(&format, "format", "markdown", "report format: markdown or html")
+	fs.StringVar(&output, "output", "", "write the report to this file instead of stdout")
+	fs.StringVar(&input, "input", "", "render a report exported with `tig-gh metrics --json` instead of fetching the metrics")
+	fs.BoolVar(&resume, "resume", false, "resume an interrupted run, fetching only the repositories it did not finish")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 || (format != "markdown" && format != "html") {
+		fmt.Fprint(deps.Stderr, reportUsage)
+		return errUsage
+	}
+
+	var metrics *models.LeadTimeMetrics
+	var err error
+	if input != "" {
+		metrics, err = readMetricsFile(input)
+	} else {
+		metrics, err = fetchMetrics(ctx, deps, resume)
+	}
+	if err != nil {
+		return err
+	}
+
+	report := newMetricsReport(metrics, clock.Now())
+	var buf bytes.Buffer
+	if format == "html" {
+		err = writeReportHTML(&buf, report)
+	} else {
+		err = writeReportMarkdown(&buf, report)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to render report: %w", err)
+	}
+
+	if output == "" {
+		_, err := deps.Stdout.Write(buf.Bytes())
+		return err
+	}
+	if err := os.WriteFile(output, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	fmt.Fprintf(deps.Stderr, "Wrote %s report to %s\n", format, output)
+	return nil
+}
+
+// readMetricsFile loads a report exported with `tig-gh metrics --json`
+func readMetricsFile(path string) (*models.LeadTimeMetrics, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metrics: %w", err)
+	}
+	var metrics models.LeadTimeMetrics
+	if err := json.Unmarshal(data, &metrics); err != nil {
+		return nil, fmt.Errorf("invalid metrics file %s: %w", path, err)
+	}
+	return &metrics, nil
+}
+
+// metricsReport is the lead time metrics formatted for a report. Both
+// renderers use it so that Markdown and HTML show the same numbers.
+type metricsReport struct {
+	GeneratedAt  string
+	Excluded     int
+	Percentiles  []string // the percentile column headers, e.g. "p90"
+	Overall      reportStatRow
+	Repositories []reportStatRow
+	Phases       []reportBar
+	Trend        []reportBar
+	Stagnant     reportStagnant
+	Alerts       []string
+	Failed       []models.MetricsRepositoryStatus
+}
+
+// reportStatRow is one row of the lead time table
+type reportStatRow struct {
+	Name        string
+	Average     string
+	Median      string
+	Percentiles []string
+	Count       int
+}
+
+// reportBar is one bar of a chart; Ratio is the length relative to the longest bar
+type reportBar struct {
+	Label string
+	Value string
+	Note  string
+	Ratio float64
+}
+
+// Percent is the bar length for the HTML chart
+func (b reportBar) Percent() int {
+	return int(b.Ratio*100 + 0.5)
+}
+
+// reportStagnant summarizes the pull requests open longer than the threshold
+type reportStagnant struct {
+	Threshold  string
+	Total      int
+	AverageAge string
+	PRs        []reportStagnantPR
+}
+
+// reportStagnantPR is one of the longest waiting pull requests
+type reportStagnantPR struct {
+	Ref   string
+	Title string
+	Age   string
+}
+
+func newMetricsReport(metrics *models.LeadTimeMetrics, now time.Time) metricsReport {
+	report := metricsReport{
+		GeneratedAt: now.Format("2006-01-02 15:04 MST"),
+		Excluded:    metrics.ExcludedPRs,
+		Overall:     newReportStatRow("All repositories", metrics.Overall),
+	}
+	for _, status := range metrics.RepositoryStatuses {
+		if !status.OK() {
+			report.Failed = append(report.Failed, status)
+		}
+	}
+	for _, p := range metrics.Overall.Percentiles {
+		report.Percentiles = append(report.Percentiles, fmt.Sprintf("p%d", p.Percentile))
+	}
+
+	repos := make([]string, 0, len(metrics.ByRepository))
+	for repo := range metrics.ByRepository {
+		repos = append(repos, repo)
+	}
+	sort.Strings(repos)
+	for _, repo := range repos {
+		report.Repositories = append(report.Repositories, newReportStatRow(repo, metrics.ByRepository[repo]))
+	}
+
+	phases := metrics.PhaseBreakdown
+	report.Phases = newReportBars([]reportBar{
+		{Label: "Created → first review"},
+		{Label: "First review → approval"},
+		{Label: "Approval → merge"},
+	}, []time.Duration{phases.CreatedToFirstReview, phases.FirstReviewToApproval, phases.ApprovalToMerge})
+
+	trend := make([]reportBar, len(metrics.Trend))
+	values := make([]time.Duration, len(metrics.Trend))
+	for i, point := range metrics.Trend {
+		trend[i] = reportBar{Label: point.Period, Note: fmt.Sprintf("%d PRs", point.PRCount)}
+		values[i] = point.AverageLeadTime
+	}
+	report.Trend = newReportBars(trend, values)
+
+	stagnant := metrics.StagnantPRs
+	report.Stagnant = reportStagnant{
+		Threshold:  formatReportDuration(stagnant.Threshold),
+		Total:      stagnant.TotalStagnant,
+		AverageAge: formatReportDuration(stagnant.AverageAge),
+	}
+	for _, pr := range stagnant.LongestWaiting {
+		report.Stagnant.PRs = append(report.Stagnant.PRs, reportStagnantPR{
+			Ref:   fmt.Sprintf("%s#%d", pr.Repository, pr.Number),
+			Title: pr.Title,
+			Age:   formatReportDuration(pr.Age),
+		})
+	}
+
+	for _, alert := range metrics.Alerts.Alerts {
+		report.Alerts = append(report.Alerts, alert.Message)
+	}
+	return report
+}
+
+func newReportStatRow(name string, stat models.LeadTimeStat) reportStatRow {
+	row := reportStatRow{
+		Name:    name,
+		Average: formatReportDuration(stat.Average),
+		Median:  formatReportDuration(stat.Median),
+		Count:   stat.Count,
+	}
+	for _, p := range stat.Percentiles {
+		row.Percentiles = append(row.Percentiles, formatReportDuration(p.Value))
+	}
+	return row
+}
+
+// newReportBars fills in the values of bars, scaled to the largest one
+func newReportBars(bars []reportBar, values []time.Duration) []reportBar {
+	var longest time.Duration
+	for _, v := range values {
+		if v > longest {
+			longest = v
+		}
+	}
+	for i, v := range values {
+		bars[i].Value = formatReportDuration(v)
+		if longest > 0 {
+			bars[i].Ratio = float64(v) / float64(longest)
+		}
+	}
+	return bars
+}
+
+// formatReportDuration renders a duration as "2d 3h", "5h 12m" or "40m"
+func formatReportDuration(d time.Duration) string {
+	if d <= 0 {
+		return "-"
+	}
+	d = d.Round(time.Minute)
+	days := int(d / (24 * time.Hour))
+	hours := int(d % (24 * time.Hour) / time.Hour)
+	minutes := int(d % time.Hour / time.Minute)
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
+
+// markdownBar renders a bar of the Markdown charts
+func markdownBar(ratio float64) string {
+	filled := int(ratio*reportBarWidth + 0.5)
+	return "`" + strings.Repeat("█", filled) + strings.Repeat("░", reportBarWidth-filled) + "`"
+}
+
+// markdownCell escapes text for a Markdown table cell
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func writeReportMarkdown(w io.Writer, report metricsReport) error {
+	var b strings.Builder
+	b.WriteString("# Lead time report\n\n")
+	fmt.Fprintf(&b, "Generated %s from %d merged pull requests", report.GeneratedAt, report.Overall.Count)
+	if report.Excluded > 0 {
+		fmt.Fprintf(&b, " (%d by bots or excluded authors not counted)", report.Excluded)
+	}
+	b.WriteString(".\n")
+
+	b.WriteString("\n## Lead time\n\n")
+	b.WriteString("| Repository | Average | Median |")
+	for _, p := range report.Percentiles {
+		fmt.Fprintf(&b, " %s |", p)
+	}
+	b.WriteString(" PRs |\n|---|---:|---:|")
+	b.WriteString(strings.Repeat("---:|", len(report.Percentiles)))
+	b.WriteString("---:|\n")
+	for i, row := range append([]reportStatRow{report.Overall}, report.Repositories...) {
+		name := markdownCell(row.Name)
+		if i == 0 {
+			name = "**" + name + "**"
+		}
+		fmt.Fprintf(&b, "| %s | %s | %s |", name, row.Average, row.Median)
+		for j := range report.Percentiles {
+			value := "-"
+			if j < len(row.Percentiles) {
+				value = row.Percentiles[j]
+			}
+			fmt.Fprintf(&b, " %s |", value)
+		}
+		fmt.Fprintf(&b, " %d |\n", row.Count)
+	}
+
+	b.WriteString("\n## Review phases\n\n| Phase | Average | |\n|---|---:|---|\n")
+	for _, bar := range report.Phases {
+		fmt.Fprintf(&b, "| %s | %s | %s |\n", bar.Label, bar.Value, markdownBar(bar.Ratio))
+	}
+
+	if len(report.Trend) > 0 {
+		b.WriteString("\n## Trend\n\n| Period | Average lead time | PRs | |\n|---|---:|---:|---|\n")
+		for _, bar := range report.Trend {
+			fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", markdownCell(bar.Label), bar.Value, strings.TrimSuffix(bar.Note, " PRs"), markdownBar(bar.Ratio))
+		}
+	}
+
+	stagnant := report.Stagnant
+	fmt.Fprintf(&b, "\n## Stagnant pull requests\n\n%d open longer than %s", stagnant.Total, stagnant.Threshold)
+	if stagnant.Total > 0 {
+		fmt.Fprintf(&b, ", waiting %s on average", stagnant.AverageAge)
+	}
+	b.WriteString(".\n")
+	if len(stagnant.PRs) > 0 {
+		b.WriteString("\n| Pull request | Title | Age |\n|---|---|---:|\n")
+		for _, pr := range stagnant.PRs {
+			fmt.Fprintf(&b, "| %s | %s | %s |\n", markdownCell(pr.Ref), markdownCell(pr.Title), pr.Age)
+		}
+	}
+
+	if len(report.Alerts) > 0 {
+		b.WriteString("\n## Alerts\n\n")
+		for _, alert := range report.Alerts {
+			fmt.Fprintf(&b, "- %s\n", strings.Join(strings.Fields(alert), " "))
+		}
+	}
+
+	if len(report.Failed) > 0 {
+		b.WriteString("\n## Missing repositories\n\nThese repositories could not be fetched and are not included:\n\n")
+		for _, status := range report.Failed {
+			fmt.Fprintf(&b, "- %s: %s\n", status.Repository, strings.Join(strings.Fields(status.Error), " "))
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// reportHTMLTemplate is a single page with inline styles and CSS bar charts,
+// so that the file can be shared without any other assets
+var reportHTMLTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Lead time report</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; color: #1f2328; max-width: 960px; margin: 2rem auto; padding: 0 1rem; }
+h1 { margin-bottom: 0.25rem; }
+.meta { color: #656d76; }
+table { border-collapse: collapse; width: 100%; margin: 0.5rem 0 1.5rem; }
+th, td { border-bottom: 1px solid #d0d7de; padding: 0.35rem 0.6rem; text-align: left; }
+th.num, td.num { text-align: right; white-space: nowrap; }
+tr.total td { font-weight: 600; }
+.bar { background: #eaeef2; border-radius: 3px; height: 0.9rem; min-width: 12rem; }
+.bar > div { background: #2f81f7; border-radius: 3px; height: 100%; }
+.alert { color: #9a6700; }
+.error { color: #cf222e; }
+</style>
+</head>
+<body>
+<h1>Lead time report</h1>
+<p class="meta">Generated {{.GeneratedAt}} from {{.Overall.Count}} merged pull requests{{if .Excluded}} ({{.Excluded}} by bots or excluded authors not counted){{end}}.</p>
+
+<h2>Lead time</h2>
+<table>
+<tr><th>Repository</th><th class="num">Average</th><th class="num">Median</th>{{range .Percentiles}}<th class="num">{{.}}</th>{{end}}<th class="num">PRs</th></tr>
+<tr class="total"><td>{{.Overall.Name}}</td><td class="num">{{.Overall.Average}}</td><td class="num">{{.Overall.Median}}</td>{{range .Overall.Percentiles}}<td class="num">{{.}}</td>{{end}}<td class="num">{{.Overall.Count}}</td></tr>
+{{range .Repositories}}<tr><td>{{.Name}}</td><td class="num">{{.Average}}</td><td class="num">{{.Median}}</td>{{range .Percentiles}}<td class="num">{{.}}</td>{{end}}<td class="num">{{.Count}}</td></tr>
+{{end}}</table>
+
+<h2>Review phases</h2>
+<table>
+<tr><th>Phase</th><th class="num">Average</th><th></th></tr>
+{{range .Phases}}<tr><td>{{.Label}}</td><td class="num">{{.Value}}</td><td><div class="bar"><div style="width: {{.Percent}}%"></div></div></td></tr>
+{{end}}</table>
+{{if .Trend}}
+<h2>Trend</h2>
+<table>
+<tr><th>Period</th><th class="num">Average lead time</th><th class="num">PRs</th><th></th></tr>
+{{range .Trend}}<tr><td>{{.Label}}</td><td class="num">{{.Value}}</td><td class="num">{{.Note}}</td><td><div class="bar"><div style="width: {{.Percent}}%"></div></div></td></tr>
+{{end}}</table>
+{{end}}
+<h2>Stagnant pull requests</h2>
+<p>{{.Stagnant.Total}} open longer than {{.Stagnant.Threshold}}{{if .Stagnant.Total}}, waiting {{.Stagnant.AverageAge}} on average{{end}}.</p>
+{{if .Stagnant.PRs}}<table>
+<tr><th>Pull request</th><th>Title</th><th class="num">Age</th></tr>
+{{range .Stagnant.PRs}}<tr><td>{{.Ref}}</td><td>{{.Title}}</td><td class="num">{{.Age}}</td></tr>
+{{end}}</table>
+{{end}}{{if .Alerts}}
+<h2>Alerts</h2>
+<ul>
+{{range .Alerts}}<li class="alert">{{.}}</li>
+{{end}}</ul>
+{{end}}{{if .Failed}}
+<h2>Missing repositories</h2>
+<p>These repositories could not be fetched and are not included:</p>
+<ul>
+{{range .Failed}}<li class="error">{{.Repository}}: {{.Error}}</li>
+{{end}}</ul>
+{{end}}</body>
+</html>
+`))
+
+func writeReportHTML(w io.Writer, report metricsReport) error {
+	return reportHTMLTemplate.Execute(w, report)
+}
@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func runStats(ctx context.Context, args []string, deps Dependencies) error {
+	var asJSON, reset bool
+	fs := newFlagSet("stats", deps)
+	fs.BoolVar(&asJSON, "json", false, "print the counters as JSON, e.g. to share them in an issue")
+	fs.BoolVar(&reset, "reset", false, "delete the counters")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(deps.Stderr, "Usage: tig-gh stats [--json] [--reset]\n")
+		return errUsage
+	}
+	if deps.Stats == nil {
+		return fmt.Errorf("usage stats not initialized")
+	}
+
+	if reset {
+		if err := deps.Stats.Reset(); err != nil {
+			return err
+		}
+		fmt.Fprintln(deps.Stdout, "Usage stats deleted.")
+		return nil
+	}
+
+	stats, err := deps.Stats.Load()
+	if err != nil {
+		return err
+	}
+	if stats == nil {
+		stats = models.NewUsageStats(time.Time{})
+	}
+	if asJSON {
+		return writeJSON(deps.Stdout, stats)
+	}
+
+	if stats.Sessions == 0 {
+		fmt.Fprintln(deps.Stdout, "No usage recorded yet.")
+	} else {
+		fmt.Fprintf(deps.Stdout, "Since %s: %d sessions, %s on average\n",
+			stats.Since.Local().Format("2006-01-02"), stats.Sessions, stats.AverageSession())
+		tw := tabwriter.NewWriter(deps.Stdout, 0, 4, 2, ' ', 0)
+		writeCounts(tw, "Views opened:", stats.Views)
+		writeCounts(tw, "Actions used:", stats.Actions)
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintln(deps.Stderr, "These counters are only kept on this machine and never sent anywhere. Share `tig-gh stats --json` in an issue if you want to help.")
+	return nil
+}
+
+// writeCounts lists the counters, most used first
+func writeCounts(tw *tabwriter.Writer, title string, counts map[string]int) {
+	if len(counts) == 0 {
+		return
+	}
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if counts[names[i]] != counts[names[j]] {
+			return counts[names[i]] > counts[names[j]]
+		}
+		return names[i] < names[j]
+	})
+
+	fmt.Fprintf(tw, "\n%s\n", title)
+	for _, name := range names {
+		fmt.Fprintf(tw, "  %s\t%d\n", name, counts[name])
+	}
+}
@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+)
+
+func runUpgrade(ctx context.Context, args []string, deps Dependencies) error {
+	var check bool
+	fs := newFlagSet("upgrade", deps)
+	fs.BoolVar(&check, "check", false, "only report whether a newer release exists")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(deps.Stderr, "Usage: tig-gh upgrade [--check]\n")
+		return errUsage
+	}
+	if deps.Upgrade == nil {
+		return fmt.Errorf("upgrader not initialized")
+	}
+
+	latest, newer, err := deps.Upgrade.Latest(ctx)
+	if err != nil {
+		return err
+	}
+	if !newer {
+		fmt.Fprintf(deps.Stdout, "tig-gh is up to date (latest release: %s).\n", latest)
+		return nil
+	}
+	if check {
+		fmt.Fprintf(deps.Stdout, "tig-gh %s is available; run `tig-gh upgrade` to install it.\n", latest)
+		return nil
+	}
+
+	fmt.Fprintf(deps.Stderr, "Downloading tig-gh %s...\n", latest)
+	installed, err := deps.Upgrade.Upgrade(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(deps.Stdout, "Upgraded tig-gh to %s.\n", installed)
+	return nil
+}
@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// APIErrorKind classifies API failures the UI reports the same way in every view
+type APIErrorKind string
+
+const (
+	// APIErrorRateLimit means a primary or secondary rate limit was hit
+	APIErrorRateLimit APIErrorKind = "rate_limit"
+	// APIErrorNotFound means the resource does not exist or is not visible to the token
+	APIErrorNotFound APIErrorKind = "not_found"
+	// APIErrorNetwork means the server could not be reached
+	APIErrorNetwork APIErrorKind = "network"
+	// APIErrorServer means the server failed (5xx)
+	APIErrorServer APIErrorKind = "server"
+	// APIErrorTimeout means the server did not answer in time (github.timeouts)
+	APIErrorTimeout APIErrorKind = "timeout"
+)
+
+// APIError is an API failure with its kind. The message is that of the
+// wrapped error, so wrapping one does not change what is displayed.
+type APIError struct {
+	Kind APIErrorKind
+	// Reset is when a rate limit resets, if known
+	Reset time.Time
+	Err   error
+}
+
+// Error returns the message of the wrapped error
+func (e *APIError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the wrapped error
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
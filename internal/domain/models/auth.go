@@ -0,0 +1,55 @@
+package models
+
+import "strings"
+
+// AuthProblemKind classifies why a token cannot be used
+type AuthProblemKind string
+
+const (
+	// AuthProblemInvalidToken means the token was rejected: expired, revoked or mistyped
+	AuthProblemInvalidToken AuthProblemKind = "invalid_token"
+	// AuthProblemMissingScope means the token lacks an OAuth scope tig-gh needs
+	AuthProblemMissingScope AuthProblemKind = "missing_scope"
+	// AuthProblemSAMLSSO means the organization enforces SAML SSO and the token
+	// has not been authorized for it
+	AuthProblemSAMLSSO AuthProblemKind = "saml_sso"
+)
+
+// TokenInfo describes the account and scopes of a token
+type TokenInfo struct {
+	Login string
+	// Scopes are the OAuth scopes granted to a classic token
+	Scopes []string
+	// FineGrained is true when the server does not report scopes, as for
+	// fine-grained personal access tokens and GitHub App tokens
+	FineGrained bool
+}
+
+// AuthProblem is a token failure together with what the user can do about it
+type AuthProblem struct {
+	Kind AuthProblemKind
+	// Message says what went wrong
+	Message string
+	// Hint says how to fix it
+	Hint string
+	// Scopes lists the missing scopes for AuthProblemMissingScope
+	Scopes []string
+	// URL is the page that fixes the problem (token settings or SSO authorization)
+	URL string
+	// Err is the underlying API error
+	Err error
+}
+
+// Error returns the message and the hint on one line
+func (p *AuthProblem) Error() string {
+	parts := []string{p.Message}
+	if p.Hint != "" {
+		parts = append(parts, p.Hint)
+	}
+	return strings.Join(parts, ": ")
+}
+
+// Unwrap returns the underlying API error
+func (p *AuthProblem) Unwrap() error {
+	return p.Err
+}
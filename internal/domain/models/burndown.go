@@ -0,0 +1,86 @@
+package models
+
+import "time"
+
+// BurndownPoint is the number of issues of a milestone still open at the end of a day
+type BurndownPoint struct {
+	Day  time.Time
+	Open int
+}
+
+// Burndown tracks the open issues of a milestone day by day against its due date
+type Burndown struct {
+	Milestone *Milestone
+	// Points run from the day the milestone started through today
+	Points []BurndownPoint
+	// Total is the number of issues in the milestone, open and closed
+	Total int
+}
+
+// NewBurndown counts the open issues of a milestone for each day since it was
+// created. Issues count from the day they were opened (or the milestone's
+// first day when older) until the day they were last closed.
+func NewBurndown(milestone *Milestone, issues []*Issue, now time.Time) *Burndown {
+	burndown := &Burndown{Milestone: milestone}
+
+	start := milestone.CreatedAt
+	for _, issue := range issues {
+		if issue == nil {
+			continue
+		}
+		burndown.Total++
+		// Without the milestone's creation date the oldest issue marks the start
+		if milestone.CreatedAt.IsZero() && (start.IsZero() || issue.CreatedAt.Before(start)) {
+			start = issue.CreatedAt
+		}
+	}
+	if start.IsZero() {
+		start = now
+	}
+
+	today := startOfDay(now)
+	for day := startOfDay(start.In(now.Location())); !day.After(today); day = day.AddDate(0, 0, 1) {
+		end := day.AddDate(0, 0, 1)
+		open := 0
+		for _, issue := range issues {
+			if issue == nil || !issue.CreatedAt.Before(end) {
+				continue
+			}
+			if issue.ClosedAt == nil || !issue.ClosedAt.Before(end) {
+				open++
+			}
+		}
+		burndown.Points = append(burndown.Points, BurndownPoint{Day: day, Open: open})
+	}
+	return burndown
+}
+
+// Open returns the number of issues open today
+func (b *Burndown) Open() int {
+	if len(b.Points) == 0 {
+		return 0
+	}
+	return b.Points[len(b.Points)-1].Open
+}
+
+// Ideal returns the open issues on the day for a steady burn from the first
+// day's count down to zero at the due date. Milestones without a due date
+// have no ideal line.
+func (b *Burndown) Ideal(day time.Time) (float64, bool) {
+	if b.Milestone == nil || b.Milestone.DueOn == nil || len(b.Points) == 0 {
+		return 0, false
+	}
+	start := b.Points[0].Day
+	due := startOfDay(b.Milestone.DueOn.In(start.Location()))
+	span := due.Sub(start).Hours()
+	if span <= 0 || day.After(due) {
+		return 0, true
+	}
+	return float64(b.Points[0].Open) * (1 - day.Sub(start).Hours()/span), true
+}
+
+// startOfDay returns midnight of the day in t's location
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,118 @@
+package models
+
+import (
+	"strings"
+)
+
+// CodeOwnerRule は CODEOWNERS の1行（パターンとそのオーナー）
+type CodeOwnerRule struct {
+	Pattern string
+	// Owners は "@user"・"@org/team"・メールアドレス
+	Owners []string
+}
+
+// CodeOwners は CODEOWNERS の規則を書かれた順に並べたもの
+// 同じファイルに一致する規則が複数あれば、後に書かれた規則が優先される
+type CodeOwners []CodeOwnerRule
+
+// ParseCodeOwners は CODEOWNERS の内容を解析する
+// コメント・空行は読み飛ばし、オーナーのない行はそのパターンのオーナーを外す規則として残す
+func ParseCodeOwners(content string) CodeOwners {
+	var rules CodeOwners
+	for _, line := range strings.Split(content, "\n") {
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		rules = append(rules, CodeOwnerRule{Pattern: fields[0], Owners: fields[1:]})
+	}
+	return rules
+}
+
+// Owners はファイルのオーナーを返す（一致する最後の規則のオーナー）
+func (c CodeOwners) Owners(file string) []string {
+	file = strings.TrimPrefix(file, "/")
+	for i := len(c) - 1; i >= 0; i-- {
+		if matchCodeOwnerPattern(c[i].Pattern, file) {
+			return c[i].Owners
+		}
+	}
+	return nil
+}
+
+// Suggest はファイル群のオーナーを最初に現れた順に重複なく返す
+// "@" を外した "user"・"org/team" の形で返し、メールアドレスのオーナーは除く
+func (c CodeOwners) Suggest(files []string) []string {
+	seen := make(map[string]bool)
+	var owners []string
+	for _, file := range files {
+		for _, owner := range c.Owners(file) {
+			name, ok := strings.CutPrefix(owner, "@")
+			if !ok || seen[strings.ToLower(name)] {
+				continue
+			}
+			seen[strings.ToLower(name)] = true
+			owners = append(owners, name)
+		}
+	}
+	return owners
+}
+
+// matchCodeOwnerPattern は CODEOWNERS のパターンとファイルパスを照合する
+// ProtectedPaths と同じ .gitignore に近い解釈に、"**" と "/" で終わらないディレクトリ指定を加える
+func matchCodeOwnerPattern(pattern, file string) bool {
+	// 先頭の "**/" は任意の階層、末尾の "/**" はディレクトリ配下のすべて
+	pattern = strings.TrimPrefix(pattern, "**/")
+	if dir, ok := strings.CutSuffix(pattern, "/**"); ok {
+		pattern = dir + "/"
+	}
+	if matchProtectedPath(pattern, file) {
+		return true
+	}
+	// "/docs" や "apps/web" はディレクトリ配下にも一致する
+	return !strings.HasSuffix(pattern, "/") && matchProtectedPath(pattern+"/", file)
+}
+
+// CodeOwnerGroup は同じオーナーを持つ変更ファイルのまとまり
+type CodeOwnerGroup struct {
+	// Owners は "@" を外した "user"・"org/team"（メールアドレスはそのまま）
+	Owners []string
+	Files  []string
+	// Approved はオーナーのいずれかが承認済みか
+	Approved bool
+}
+
+// Groups はオーナーのいるファイルをオーナーの組み合わせごとにまとめ、最初に現れた順に返す
+// GitHub と同じく、ファイルごとにオーナーの誰か1人が承認していれば承認済みとする
+func (c CodeOwners) Groups(files []string, approvals *Approvals) []CodeOwnerGroup {
+	index := make(map[string]int)
+	var groups []CodeOwnerGroup
+	for _, file := range files {
+		owners := c.Owners(file)
+		if len(owners) == 0 {
+			continue
+		}
+		names := make([]string, len(owners))
+		for i, owner := range owners {
+			names[i] = strings.TrimPrefix(owner, "@")
+		}
+		key := strings.ToLower(strings.Join(names, " "))
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			group := CodeOwnerGroup{Owners: names}
+			for _, name := range names {
+				if approvals.Approved(name) {
+					group.Approved = true
+				}
+			}
+			groups = append(groups, group)
+		}
+		groups[i].Files = append(groups[i].Files, file)
+	}
+	return groups
+}
@@ -10,8 +10,39 @@ type Comment struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	HTMLURL   string
+	Reactions Reactions
+	// Pending is set on review comments of a review not submitted yet, which
+	// only their author can see
+	Pending bool
 }
 
+// Reactions represents reaction counts on a comment
+type Reactions struct {
+	TotalCount int
+	PlusOne    int
+	MinusOne   int
+	Laugh      int
+	Confused   int
+	Heart      int
+	Hooray     int
+	Rocket     int
+	Eyes       int
+}
+
+// ReactionContent represents the type of a reaction
+type ReactionContent string
+
+const (
+	ReactionPlusOne  ReactionContent = "+1"
+	ReactionMinusOne ReactionContent = "-1"
+	ReactionLaugh    ReactionContent = "laugh"
+	ReactionConfused ReactionContent = "confused"
+	ReactionHeart    ReactionContent = "heart"
+	ReactionHooray   ReactionContent = "hooray"
+	ReactionRocket   ReactionContent = "rocket"
+	ReactionEyes     ReactionContent = "eyes"
+)
+
 // CommentOptions represents options for listing comments
 type CommentOptions struct {
 	// Sort order (created, updated)
@@ -98,12 +98,13 @@ const (
 
 // DiffFile represents a file in a diff
 type DiffFile struct {
-	Filename  string
-	Status    FileStatus
-	Additions int
-	Deletions int
-	Changes   int
-	Patch     string
+	Filename         string
+	PreviousFilename string // set for renamed files
+	Status           FileStatus
+	Additions        int
+	Deletions        int
+	Changes          int
+	Patch            string // empty for binary files and for diffs too large for the API
 }
 
 // FileStatus represents the status of a file in a diff
@@ -115,3 +116,13 @@ const (
 	FileStatusRemoved  FileStatus = "removed"
 	FileStatusRenamed  FileStatus = "renamed"
 )
+
+// RateLimit represents the API rate limit status.
+// Known is false when the server does not report rate limits
+// (e.g. GitHub Enterprise Server with rate limiting disabled).
+type RateLimit struct {
+	Known     bool
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
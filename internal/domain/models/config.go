@@ -1,6 +1,11 @@
 package models
 
-import "time"
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
 
 // Config はアプリケーション全体の設定を表す
 type Config struct {
@@ -8,6 +13,21 @@ type Config struct {
 	UI      UIConfig      `mapstructure:"ui" yaml:"ui"`
 	Cache   CacheConfig   `mapstructure:"cache" yaml:"cache"`
 	Metrics MetricsConfig `mapstructure:"metrics" yaml:"metrics"`
+	Review  ReviewConfig  `mapstructure:"review" yaml:"review"`
+	Release ReleaseConfig `mapstructure:"release" yaml:"release"`
+	Watch   WatchConfig   `mapstructure:"watch" yaml:"watch"`
+	Live    LiveConfig    `mapstructure:"live" yaml:"live"`
+	Update  UpdateConfig  `mapstructure:"update" yaml:"update"`
+	Stats   StatsConfig   `mapstructure:"stats" yaml:"stats"`
+
+	// Features は機能ごとの有効・無効
+	Features FeaturesConfig `mapstructure:"features" yaml:"features"`
+
+	// Profile は起動時に使うプロファイル名（空の場合は github セクションをそのまま使う）
+	Profile string `mapstructure:"profile" yaml:"profile"`
+
+	// Profiles は名前付きのGitHub接続設定（仕事用・個人用・GHEなど）
+	Profiles map[string]GitHubConfig `mapstructure:"profiles" yaml:"profiles"`
 }
 
 // GitHubConfig はGitHub関連の設定を表す
@@ -28,14 +48,59 @@ type GitHubConfig struct {
 	// UploadBaseURL はGitHub UploadのベースURL
 	UploadBaseURL string `mapstructure:"upload_base_url" yaml:"upload_base_url"`
 
-	// RequestTimeout はAPIリクエストのタイムアウト
+	// RequestTimeout はAPIリクエストのタイムアウト（Timeouts で種類ごとに指定しなかったリクエストに使う）
 	RequestTimeout time.Duration `mapstructure:"request_timeout" yaml:"request_timeout"`
 
+	// Timeouts は接続とリクエストの種類（一覧・詳細・メトリクス）ごとのタイムアウト
+	// 応答のない接続で「Loading...」のまま止まらず、再試行できるエラーにする
+	Timeouts TimeoutsConfig `mapstructure:"timeouts" yaml:"timeouts"`
+
 	// RateLimitBuffer はレート制限のバッファ（残りリクエスト数がこれ以下の場合は待機）
 	RateLimitBuffer int `mapstructure:"rate_limit_buffer" yaml:"rate_limit_buffer"`
 
+	// Retries は5xxやセカンダリレート制限で失敗したリクエストを再試行する回数（0で再試行しない）
+	// 指数バックオフ（ジッターあり）で待機し、Retry-After があればそれに従う
+	Retries int `mapstructure:"retries" yaml:"retries"`
+
 	// Repositories はメトリクス計算対象となるリポジトリ一覧（owner/repo形式）
 	Repositories []string `mapstructure:"repositories" yaml:"repositories"`
+
+	// AuthSources はトークンを探す順序（config / env / gh / keyring）
+	AuthSources []string `mapstructure:"auth_sources" yaml:"auth_sources"`
+
+	// OAuthClientID はデバイスフローでログインする OAuth App のクライアントID
+	// 空の場合は初回起動時のログインを行わない
+	OAuthClientID string `mapstructure:"oauth_client_id" yaml:"oauth_client_id"`
+}
+
+// TimeoutsConfig はリクエストの種類ごとのタイムアウトを表す
+// 0 の項目は RequestTimeout を使う（Connect は 0 で制限しない）
+type TimeoutsConfig struct {
+	// Connect は接続（TLS ハンドシェイクを含む）のタイムアウト
+	Connect time.Duration `mapstructure:"connect" yaml:"connect"`
+
+	// List は一覧・検索の 1 ページの取得のタイムアウト
+	List time.Duration `mapstructure:"list" yaml:"list"`
+
+	// Detail は Issue / PR 単体の取得や更新のタイムアウト
+	Detail time.Duration `mapstructure:"detail" yaml:"detail"`
+
+	// Metrics はメトリクス計算のための 1 リクエストのタイムアウト（計算全体ではない）
+	Metrics time.Duration `mapstructure:"metrics" yaml:"metrics"`
+}
+
+// RequestTimeouts は種類ごとのタイムアウトを返す（未指定の種類には RequestTimeout を使う）
+func (g GitHubConfig) RequestTimeouts() TimeoutsConfig {
+	timeouts := g.Timeouts
+	for _, d := range []*time.Duration{&timeouts.List, &timeouts.Detail, &timeouts.Metrics} {
+		if *d <= 0 {
+			*d = g.RequestTimeout
+		}
+	}
+	if timeouts.Connect < 0 {
+		timeouts.Connect = 0
+	}
+	return timeouts
 }
 
 // MetricsConfig はメトリクス関連の設定を表す
@@ -66,8 +131,121 @@ type MetricsConfig struct {
 
 	// ShowRepositoryStats はリポジトリごとの統計の表示/非表示
 	ShowRepositoryStats bool `mapstructure:"show_repository_stats" yaml:"show_repository_stats"`
+
+	// ShowTrend はリードタイム推移チャートの表示/非表示
+	ShowTrend bool `mapstructure:"show_trend" yaml:"show_trend"`
+
+	// ShowIssueBacklog はオープンIssue数の推移チャートの表示/非表示
+	ShowIssueBacklog bool `mapstructure:"show_issue_backlog" yaml:"show_issue_backlog"`
+
+	// IssueBacklogWeeks はオープンIssue数の推移を表示する週数
+	IssueBacklogWeeks int `mapstructure:"issue_backlog_weeks" yaml:"issue_backlog_weeks"`
+
+	// IssueLabelBuckets はオープンIssue数を分けるラベル（先に書いたものが優先、該当しないものは other）
+	IssueLabelBuckets []string `mapstructure:"issue_label_buckets" yaml:"issue_label_buckets"`
+
+	// ShowLabelInsights はラベルの使われ方（よく使われるラベル・一緒に付くラベル・ラベルなしの割合）の表示/非表示
+	ShowLabelInsights bool `mapstructure:"show_label_insights" yaml:"show_label_insights"`
+
+	// LeadTimePercentiles はリードタイムの表に列として表示するパーセンタイル（1〜100）
+	// 平均や中央値では見えにくい、時間のかかったPRを把握するため
+	LeadTimePercentiles []int `mapstructure:"lead_time_percentiles" yaml:"lead_time_percentiles"`
+
+	// ExcludeAuthors はメトリクス（リードタイム・曜日別・品質チェック・滞留PR）から除くPRの作成者
+	// ログイン名で指定し、大文字小文字は区別しない
+	ExcludeAuthors []string `mapstructure:"exclude_authors" yaml:"exclude_authors"`
+
+	// ExcludeBots はログイン名が [bot] で終わる作成者（dependabot[bot], renovate[bot] など）のPRをメトリクスから除く
+	ExcludeBots bool `mapstructure:"exclude_bots" yaml:"exclude_bots"`
 }
 
+// ReviewConfig はレビュー・マージ関連の設定を表す
+type ReviewConfig struct {
+	// ProtectedPaths は変更時に注意が必要なパスのパターン（"infra/", "migrations/", ".github/workflows/" など）
+	// 該当するPRは一覧・レビューキュー・品質メトリクスで警告され、マージ前に追加の確認が必要になる
+	ProtectedPaths []string `mapstructure:"protected_paths" yaml:"protected_paths"`
+
+	// FreezeWindows はマージを控える期間（週末やリリース週など）
+	// 期間中は Review Queue にバナーを表示し、マージ時に警告または追加の確認を求める
+	FreezeWindows FreezeWindows `mapstructure:"freeze_windows" yaml:"freeze_windows"`
+
+	// TestCommand は PR 詳細ビューの ctrl+t で PR をワークツリーにチェックアウトした後、そこで実行するコマンド（"make test" など）
+	// 空の場合はチェックアウトのみ行う
+	TestCommand string `mapstructure:"test_command" yaml:"test_command"`
+
+	// WorktreeDir は ctrl+t で作成するワークツリーを置くディレクトリ
+	// 空の場合は clone と同じ階層に <リポジトリ名>-pr-<番号> として作成する
+	WorktreeDir string `mapstructure:"worktree_dir" yaml:"worktree_dir"`
+}
+
+// ReleaseConfig はリリーストレイン関連の設定を表す
+type ReleaseConfig struct {
+	// Label は次のリリースに含める PR に付けるラベル
+	Label string `mapstructure:"label" yaml:"label"`
+
+	// BlockerLabel はリリースを止める Issue / PR に付けるラベル
+	BlockerLabel string `mapstructure:"blocker_label" yaml:"blocker_label"`
+}
+
+// WatchConfig はウォッチ中の Issue / PR の通知に関する設定を表す
+type WatchConfig struct {
+	// PollInterval はウォッチ中の Issue / PR の更新を確認する間隔
+	PollInterval time.Duration `mapstructure:"poll_interval" yaml:"poll_interval"`
+
+	// DesktopNotifications は新しいコメント・レビュー・CI 結果・マージ／クローズをデスクトップ通知する
+	// 無効の場合はウォッチ一覧（w）にだけ表示する
+	DesktopNotifications bool `mapstructure:"desktop_notifications" yaml:"desktop_notifications"`
+}
+
+// UpdateConfig は新しいバージョンの確認に関する設定を表す
+type UpdateConfig struct {
+	// Check は起動時に新しいリリースを確認し、あればステータスバーに表示する（既定は無効）
+	Check bool `mapstructure:"check" yaml:"check"`
+
+	// Interval は確認する間隔。前回の結果を状態ディレクトリに記録し、間隔内は API を呼ばない
+	Interval time.Duration `mapstructure:"interval" yaml:"interval"`
+}
+
+// StatsConfig は利用状況の記録に関する設定を表す
+type StatsConfig struct {
+	// Enabled は開いたビュー・使った操作・セッションの長さを状態ディレクトリに記録する
+	// 記録はローカルにだけ保存され、送信されることはない（tig-gh stats で確認）
+	Enabled bool `mapstructure:"enabled" yaml:"enabled"`
+}
+
+// FeaturesConfig は機能ごとの有効・無効を表す
+// 無効にした機能はユースケースを組み立てず、TUI ではビューを開くキーも効かなくなる
+type FeaturesConfig struct {
+	// Metrics はメトリクスビュー（m）と metrics・report コマンド
+	Metrics bool `mapstructure:"metrics" yaml:"metrics"`
+
+	// Queue はレビューキュー（R）
+	Queue bool `mapstructure:"queue" yaml:"queue"`
+
+	// Actions は GitHub Actions ビュー（A）
+	Actions bool `mapstructure:"actions" yaml:"actions"`
+
+	// Live は開いているリポジトリの変更を取得し、表示中の一覧・詳細を自動で更新する（既定は無効）
+	Live bool `mapstructure:"live" yaml:"live"`
+}
+
+// LiveConfig はリポジトリの変更を表示に反映するライブ更新（features.live）の設定を表す
+type LiveConfig struct {
+	// PollInterval はリポジトリのイベントを確認する間隔
+	PollInterval time.Duration `mapstructure:"poll_interval" yaml:"poll_interval"`
+}
+
+// カラーパレット（ui.palette / ui.view_palettes）
+const (
+	// PaletteDefault は既定の配色（承認・追加は緑、変更要求・削除は赤）
+	PaletteDefault = "default"
+	// PaletteColorBlind は色覚特性に配慮した配色（青とオレンジで区別し、状態には必ず記号を添える）
+	PaletteColorBlind = "colorblind"
+)
+
+// ViewNames はビューごとの設定（ui.view_palettes）で使えるビュー名
+var ViewNames = []string{"issues", "prs", "commits", "search", "queue", "metrics", "releases", "gists", "actions", "watch", "my_work", "starred"}
+
 // UIConfig はUI関連の設定を表す
 type UIConfig struct {
 	// Theme はカラーテーマ（"light", "dark", "auto"）
@@ -79,14 +257,29 @@ type UIConfig struct {
 	// KeyBindings はカスタムキーバインディング
 	KeyBindings map[string]string `mapstructure:"key_bindings" yaml:"key_bindings"`
 
-	// PageSize は一度に表示するアイテム数
+	// PageSize は一覧を取得するときの 1 リクエストあたりの件数（1〜100）
 	PageSize int `mapstructure:"page_size" yaml:"page_size"`
 
+	// MaxItems は各ビューの一覧に読み込む最大件数。PageSize を超える場合は複数ページを取得する
+	MaxItems int `mapstructure:"max_items" yaml:"max_items"`
+
 	// ShowIcons はアイコン表示の有効/無効
 	ShowIcons bool `mapstructure:"show_icons" yaml:"show_icons"`
 
+	// ASCIIIcons は絵文字・記号のアイコンを ASCII 文字に置き換える（絵文字が 2 文字幅で描画され桁がずれるフォント向け）
+	ASCIIIcons bool `mapstructure:"ascii_icons" yaml:"ascii_icons"`
+
+	// Palette は状態の色分けに使うパレット（"default"、または赤と緑に頼らない "colorblind"）
+	Palette string `mapstructure:"palette" yaml:"palette"`
+
+	// ViewPalettes はビューごとのパレット（キーは ViewNames のビュー名。指定のないビューは Palette を使う）
+	ViewPalettes map[string]string `mapstructure:"view_palettes" yaml:"view_palettes"`
+
 	// DateFormat は日付のフォーマット
 	DateFormat string `mapstructure:"date_format" yaml:"date_format"`
+
+	// AutoRefresh は表示中の一覧（Issue / PR / コミット）を自動で再取得する間隔。0 の場合は自動更新しない
+	AutoRefresh time.Duration `mapstructure:"auto_refresh" yaml:"auto_refresh"`
 }
 
 // CacheConfig はキャッシュ関連の設定を表す
@@ -118,8 +311,17 @@ func DefaultConfig() *Config {
 			UploadBaseURL:   "https://uploads.github.com/",
 			RequestTimeout:  30 * time.Second,
 			RateLimitBuffer: 10,
+			Retries:         3,
 			Repositories:    []string{},
+			AuthSources:     []string{"config", "env", "gh", "keyring"},
+			OAuthClientID:   "",
+			Timeouts: TimeoutsConfig{
+				Connect: 10 * time.Second,
+				Detail:  20 * time.Second,
+				Metrics: time.Minute,
+			},
 		},
+		Profiles: map[string]GitHubConfig{},
 		UI: UIConfig{
 			Theme:       "auto",
 			DefaultView: "issues",
@@ -147,9 +349,12 @@ func DefaultConfig() *Config {
 				"close":      "x",
 				"open":       "o",
 			},
-			PageSize:   50,
-			ShowIcons:  true,
-			DateFormat: "2006-01-02 15:04",
+			PageSize:     100,
+			MaxItems:     100,
+			ShowIcons:    true,
+			Palette:      PaletteDefault,
+			ViewPalettes: map[string]string{},
+			DateFormat:   "2006-01-02 15:04",
 		},
 		Cache: CacheConfig{
 			Enabled:      true,
@@ -168,18 +373,97 @@ func DefaultConfig() *Config {
 			ShowQualityIssues:    true,
 			ShowStagnantPRs:      true,
 			ShowRepositoryStats:  true,
+			ShowTrend:            true,
+			ShowIssueBacklog:     true,
+			IssueBacklogWeeks:    8,
+			IssueLabelBuckets:    []string{"bug", "enhancement"},
+			ShowLabelInsights:    true,
+			LeadTimePercentiles:  []int{50, 75, 90, 95},
+			ExcludeAuthors:       []string{},
+			ExcludeBots:          true,
+		},
+		Review: ReviewConfig{
+			ProtectedPaths: []string{},
+			FreezeWindows:  FreezeWindows{},
+		},
+		Release: ReleaseConfig{
+			Label:        "release",
+			BlockerLabel: "release-blocker",
+		},
+		Watch: WatchConfig{
+			PollInterval:         2 * time.Minute,
+			DesktopNotifications: true,
+		},
+		Live: LiveConfig{
+			PollInterval: time.Minute,
+		},
+		Update: UpdateConfig{
+			Check:    false,
+			Interval: 24 * time.Hour,
+		},
+		Stats: StatsConfig{
+			Enabled: true,
+		},
+		Features: FeaturesConfig{
+			Metrics: true,
+			Queue:   true,
+			Actions: true,
 		},
 	}
 }
 
 // Validate は設定の妥当性を検証する
+// 未設定の項目にはデフォルト値を補ってから検証する
 func (c *Config) Validate() error {
-	// GitHub設定の検証
-	if c.GitHub.Token == "" {
-		// トークンが空の場合は警告レベル（後で環境変数から取得される可能性がある）
-		// 実際の使用時にチェックされるべき
+	c.ApplyDefaults()
+
+	if err := validateAuthSources(c.GitHub.AuthSources); err != nil {
+		return err
+	}
+	if err := c.validateProfiles(); err != nil {
+		return err
+	}
+	if err := c.Review.FreezeWindows.Validate(); err != nil {
+		return err
+	}
+	for _, p := range c.Metrics.LeadTimePercentiles {
+		if p < 1 || p > 100 {
+			return fmt.Errorf("invalid metrics.lead_time_percentiles value %d (expected 1-100)", p)
+		}
+	}
+	if err := c.UI.validatePalettes(); err != nil {
+		return err
 	}
 
+	return nil
+}
+
+// validatePalettes は ui.palette と ui.view_palettes のパレット名・ビュー名を検証する
+func (u UIConfig) validatePalettes() error {
+	if !isPalette(u.Palette) {
+		return fmt.Errorf("invalid ui.palette %q (expected %s or %s)", u.Palette, PaletteDefault, PaletteColorBlind)
+	}
+	for view, palette := range u.ViewPalettes {
+		if !slices.Contains(ViewNames, view) {
+			return fmt.Errorf("invalid ui.view_palettes view %q (expected one of %s)", view, strings.Join(ViewNames, ", "))
+		}
+		if !isPalette(palette) {
+			return fmt.Errorf("invalid ui.view_palettes.%s palette %q (expected %s or %s)", view, palette, PaletteDefault, PaletteColorBlind)
+		}
+	}
+	return nil
+}
+
+// isPalette はパレット名が有効かどうかを返す
+func isPalette(name string) bool {
+	return name == PaletteDefault || name == PaletteColorBlind
+}
+
+// ApplyDefaults は未設定・不正な値の項目にデフォルト値を補う
+// 起動を速くするため、読み込み時はこれだけを行い Validate の検証は後から実行できる
+func (c *Config) ApplyDefaults() {
+	// GitHub設定
+	// トークンが空でも gh CLI やキーリングから取得される可能性があるため、ここでは扱わない
 	if c.GitHub.RequestTimeout <= 0 {
 		c.GitHub.RequestTimeout = 30 * time.Second
 	}
@@ -187,11 +471,20 @@ func (c *Config) Validate() error {
 	if c.GitHub.RateLimitBuffer < 0 {
 		c.GitHub.RateLimitBuffer = 10
 	}
+	if c.GitHub.Retries < 0 {
+		c.GitHub.Retries = 3
+	}
 	if c.GitHub.Repositories == nil {
 		c.GitHub.Repositories = []string{}
 	}
+	if len(c.GitHub.AuthSources) == 0 {
+		c.GitHub.AuthSources = []string{"config", "env", "gh", "keyring"}
+	}
+	if c.Profiles == nil {
+		c.Profiles = map[string]GitHubConfig{}
+	}
 
-	// UI設定の検証
+	// UI設定
 	if c.UI.Theme == "" {
 		c.UI.Theme = "auto"
 	}
@@ -200,15 +493,38 @@ func (c *Config) Validate() error {
 		c.UI.DefaultView = "issues"
 	}
 
+	if c.UI.Palette == "" {
+		c.UI.Palette = PaletteDefault
+	}
+	if c.UI.ViewPalettes == nil {
+		c.UI.ViewPalettes = map[string]string{}
+	}
+
 	if c.UI.PageSize <= 0 {
-		c.UI.PageSize = 50
+		c.UI.PageSize = 100
+	}
+	if c.UI.PageSize > 100 {
+		// GitHub API の per_page の上限
+		c.UI.PageSize = 100
+	}
+
+	if c.UI.MaxItems <= 0 {
+		c.UI.MaxItems = 100
 	}
 
 	if c.UI.DateFormat == "" {
 		c.UI.DateFormat = "2006-01-02 15:04"
 	}
 
-	// Cache設定の検証
+	if c.UI.AutoRefresh < 0 {
+		c.UI.AutoRefresh = 0
+	}
+	if c.UI.AutoRefresh > 0 && c.UI.AutoRefresh < 15*time.Second {
+		// レート制限を使い切らないための下限
+		c.UI.AutoRefresh = 15 * time.Second
+	}
+
+	// Cache設定
 	if c.Cache.TTL <= 0 {
 		c.Cache.TTL = 15 * time.Minute
 	}
@@ -217,10 +533,62 @@ func (c *Config) Validate() error {
 		c.Cache.MaxSize = 100 * 1024 * 1024 // 100MB
 	}
 
-	// Metrics 設定の検証
+	// Metrics 設定
 	if c.Metrics.CalculationPeriod <= 0 {
 		c.Metrics.CalculationPeriod = 30 * 24 * time.Hour
 	}
+	if c.Metrics.IssueBacklogWeeks <= 0 {
+		c.Metrics.IssueBacklogWeeks = 8
+	}
+	if c.Metrics.IssueLabelBuckets == nil {
+		c.Metrics.IssueLabelBuckets = []string{"bug", "enhancement"}
+	}
+	if c.Metrics.LeadTimePercentiles == nil {
+		c.Metrics.LeadTimePercentiles = []int{50, 75, 90, 95}
+	}
+	if c.Metrics.ExcludeAuthors == nil {
+		c.Metrics.ExcludeAuthors = []string{}
+	}
 
-	return nil
+	// Review 設定
+	if c.Review.ProtectedPaths == nil {
+		c.Review.ProtectedPaths = []string{}
+	}
+	if c.Review.FreezeWindows == nil {
+		c.Review.FreezeWindows = FreezeWindows{}
+	}
+
+	// Release 設定
+	if c.Release.Label == "" {
+		c.Release.Label = "release"
+	}
+	if c.Release.BlockerLabel == "" {
+		c.Release.BlockerLabel = "release-blocker"
+	}
+
+	// Watch 設定
+	if c.Watch.PollInterval <= 0 {
+		c.Watch.PollInterval = 2 * time.Minute
+	}
+	if c.Watch.PollInterval < 30*time.Second {
+		// レート制限を使い切らないための下限
+		c.Watch.PollInterval = 30 * time.Second
+	}
+
+	// Live 設定
+	if c.Live.PollInterval <= 0 {
+		c.Live.PollInterval = time.Minute
+	}
+	if c.Live.PollInterval < 30*time.Second {
+		// レート制限を使い切らないための下限
+		c.Live.PollInterval = 30 * time.Second
+	}
+
+	// Update 設定
+	if c.Update.Interval <= 0 {
+		c.Update.Interval = 24 * time.Hour
+	}
+	if c.Update.Interval < time.Hour {
+		c.Update.Interval = time.Hour
+	}
 }
@@ -0,0 +1,11 @@
+package models
+
+// ConflictFiles are the files both a pull request and its base branch
+// changed since the head branched off. GitHub does not say which files
+// conflict, so these are the files the conflicts can be in.
+type ConflictFiles struct {
+	BaseRef string
+	// BehindBy is the number of base commits the head is missing
+	BehindBy int
+	Files    []string
+}
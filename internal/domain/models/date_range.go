@@ -0,0 +1,141 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateRange narrows a list to the items dated in [Since, Until). Either end
+// may be open.
+type DateRange struct {
+	Since *time.Time
+	Until *time.Time
+}
+
+// IsZero reports whether the range is open on both ends
+func (r DateRange) IsZero() bool {
+	return r.Since == nil && r.Until == nil
+}
+
+// Contains reports whether t is in the range
+func (r DateRange) Contains(t time.Time) bool {
+	if r.Since != nil && t.Before(*r.Since) {
+		return false
+	}
+	if r.Until != nil && !t.Before(*r.Until) {
+		return false
+	}
+	return true
+}
+
+// String describes the range, e.g. "2026-10-01 to 2026-10-03 14:00"
+func (r DateRange) String() string {
+	switch {
+	case r.IsZero():
+		return ""
+	case r.Until == nil:
+		return "since " + formatRangeTime(*r.Since, false)
+	case r.Since == nil:
+		return "until " + formatRangeTime(*r.Until, true)
+	default:
+		return formatRangeTime(*r.Since, false) + " to " + formatRangeTime(*r.Until, true)
+	}
+}
+
+// SinceText returns the start of the range as it can be typed back in
+func (r DateRange) SinceText() string {
+	if r.Since == nil {
+		return ""
+	}
+	return formatRangeTime(*r.Since, false)
+}
+
+// UntilText returns the end of the range as it can be typed back in
+func (r DateRange) UntilText() string {
+	if r.Until == nil {
+		return ""
+	}
+	return formatRangeTime(*r.Until, true)
+}
+
+// formatRangeTime formats an end of a range in local time. A date-only end
+// of the range stands for the whole day, so it is shown as that day.
+func formatRangeTime(t time.Time, end bool) string {
+	t = t.Local()
+	if t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 {
+		if end {
+			t = t.AddDate(0, 0, -1)
+		}
+		return t.Format("2006-01-02")
+	}
+	return t.Format("2006-01-02 15:04")
+}
+
+// ParseDateRange parses the ends of a range typed by the user. Each end is a
+// date ("2026-10-01"), a date and time ("2026-10-01 14:00", local time) or a
+// time ago ("3d", "12h", "2w"); an empty end leaves the range open. A date
+// as the end of the range includes that whole day.
+func ParseDateRange(since, until string, now time.Time) (DateRange, error) {
+	var r DateRange
+	if strings.TrimSpace(since) != "" {
+		t, err := parseRangeTime(since, false, now)
+		if err != nil {
+			return DateRange{}, fmt.Errorf("since: %w", err)
+		}
+		r.Since = &t
+	}
+	if strings.TrimSpace(until) != "" {
+		t, err := parseRangeTime(until, true, now)
+		if err != nil {
+			return DateRange{}, fmt.Errorf("until: %w", err)
+		}
+		r.Until = &t
+	}
+	if r.Since != nil && r.Until != nil && !r.Since.Before(*r.Until) {
+		return DateRange{}, fmt.Errorf("since must be before until")
+	}
+	return r, nil
+}
+
+// parseRangeTime parses one end of a range
+func parseRangeTime(value string, end bool, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if t, err := time.ParseInLocation("2006-01-02 15:04", value, time.Local); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		if end {
+			t = t.AddDate(0, 0, 1)
+		}
+		return t, nil
+	}
+	if ago, ok := parseAgo(value); ok {
+		return now.Add(-ago), nil
+	}
+	return time.Time{}, fmt.Errorf("%q is not a date (2026-10-01, 2026-10-01 14:00 or 3d)", value)
+}
+
+// parseAgo parses a time ago such as "90m", "12h", "3d" or "2w"
+func parseAgo(value string) (time.Duration, bool) {
+	if len(value) < 2 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(value[:len(value)-1])
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	switch value[len(value)-1] {
+	case 'm':
+		return time.Duration(n) * time.Minute, true
+	case 'h':
+		return time.Duration(n) * time.Hour, true
+	case 'd':
+		return time.Duration(n) * 24 * time.Hour, true
+	case 'w':
+		return time.Duration(n) * 7 * 24 * time.Hour, true
+	default:
+		return 0, false
+	}
+}
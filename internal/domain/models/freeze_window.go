@@ -0,0 +1,197 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FreezeMode はマージフリーズ中のマージの扱い
+const (
+	// FreezeModeBlock は "override" と入力して確認するまでマージさせない
+	FreezeModeBlock = "block"
+	// FreezeModeWarn はマージ確認に警告を表示するだけ
+	FreezeModeWarn = "warn"
+)
+
+// FreezeWindow はマージを控える期間を表す
+//
+// Start / End は次のどちらかの形式で、両方を同じ形式で指定する:
+//   - 毎週繰り返す期間: "Fri 16:00" → "Mon 09:00"（週をまたいでもよい）
+//   - 特定の期間: "2026-12-01" や "2026-12-01 09:00"。日付のみの End はその日の終わりまで
+//
+// 時刻はローカルタイムで解釈する
+type FreezeWindow struct {
+	Name  string `mapstructure:"name" yaml:"name"`
+	Start string `mapstructure:"start" yaml:"start"`
+	End   string `mapstructure:"end" yaml:"end"`
+	// Mode は "block"（デフォルト）または "warn"
+	Mode string `mapstructure:"mode" yaml:"mode"`
+}
+
+// FreezeWindows は設定されたマージフリーズ期間の一覧
+type FreezeWindows []FreezeWindow
+
+// Active は now に有効なフリーズ期間と終了時刻を返す
+// 複数が重なる場合は block のものを優先する
+func (w FreezeWindows) Active(now time.Time) (*FreezeWindow, time.Time, bool) {
+	var found *FreezeWindow
+	var foundEnd time.Time
+	for i := range w {
+		end, ok := w[i].activeUntil(now)
+		if !ok {
+			continue
+		}
+		if found == nil || (!found.Blocks() && w[i].Blocks()) {
+			found = &w[i]
+			foundEnd = end
+		}
+	}
+	return found, foundEnd, found != nil
+}
+
+// Validate はすべての期間が解釈できることを確認する
+func (w FreezeWindows) Validate() error {
+	for _, window := range w {
+		if err := window.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Blocks はオーバーライドの確認なしにマージできない期間かを返す
+func (f FreezeWindow) Blocks() bool {
+	return f.Mode != FreezeModeWarn
+}
+
+// Label は表示用の名前を返す
+func (f FreezeWindow) Label() string {
+	if f.Name != "" {
+		return f.Name
+	}
+	return f.Start + " → " + f.End
+}
+
+// Validate は Start / End と Mode を検証する
+func (f FreezeWindow) Validate() error {
+	switch f.Mode {
+	case "", FreezeModeBlock, FreezeModeWarn:
+	default:
+		return fmt.Errorf("freeze window %q: mode must be %q or %q", f.Label(), FreezeModeBlock, FreezeModeWarn)
+	}
+
+	if _, _, err := parseWeeklyTime(f.Start); err == nil {
+		if _, _, err := parseWeeklyTime(f.End); err != nil {
+			return fmt.Errorf("freeze window %q: end must be a weekday and time like \"Mon 09:00\": %w", f.Label(), err)
+		}
+		return nil
+	}
+
+	start, _, err := parseFreezeDate(f.Start)
+	if err != nil {
+		return fmt.Errorf("freeze window %q: start must be like \"Fri 16:00\" or \"2026-12-01 09:00\"", f.Label())
+	}
+	end, _, err := parseFreezeDate(f.End)
+	if err != nil {
+		return fmt.Errorf("freeze window %q: end must be a date like \"2026-12-08\" or \"2026-12-08 09:00\"", f.Label())
+	}
+	if !end.After(start) {
+		return fmt.Errorf("freeze window %q: end must be after start", f.Label())
+	}
+	return nil
+}
+
+// activeUntil は now が期間内であれば期間の終了時刻を返す
+func (f FreezeWindow) activeUntil(now time.Time) (time.Time, bool) {
+	if startDay, startMin, err := parseWeeklyTime(f.Start); err == nil {
+		endDay, endMin, err := parseWeeklyTime(f.End)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return weeklyActiveUntil(now, startDay, startMin, endDay, endMin)
+	}
+
+	start, _, err := parseFreezeDate(f.Start)
+	if err != nil {
+		return time.Time{}, false
+	}
+	end, dateOnly, err := parseFreezeDate(f.End)
+	if err != nil {
+		return time.Time{}, false
+	}
+	if dateOnly {
+		end = end.AddDate(0, 0, 1)
+	}
+	now = now.In(time.Local)
+	if !now.Before(start) && now.Before(end) {
+		return end, true
+	}
+	return time.Time{}, false
+}
+
+// weeklyActiveUntil は毎週繰り返す期間に now が含まれるかを判定する
+func weeklyActiveUntil(now time.Time, startDay time.Weekday, startMin int, endDay time.Weekday, endMin int) (time.Time, bool) {
+	const week = 7 * 24 * 60
+	now = now.In(time.Local)
+	current := int(now.Weekday())*24*60 + now.Hour()*60 + now.Minute()
+	start := int(startDay)*24*60 + startMin
+	end := int(endDay)*24*60 + endMin
+
+	length := (end - start + week) % week
+	elapsed := (current - start + week) % week
+	if length == 0 || elapsed >= length {
+		return time.Time{}, false
+	}
+
+	remaining := time.Duration(length-elapsed) * time.Minute
+	return now.Truncate(time.Minute).Add(remaining), true
+}
+
+// parseWeeklyTime は "Fri 16:00" 形式（時刻は省略可）を曜日と0時からの分に変換する
+func parseWeeklyTime(value string) (time.Weekday, int, error) {
+	fields := strings.Fields(value)
+	if len(fields) == 0 || len(fields) > 2 {
+		return 0, 0, fmt.Errorf("invalid weekly time %q", value)
+	}
+
+	day, ok := parseWeekday(fields[0])
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid weekday %q", fields[0])
+	}
+
+	minutes := 0
+	if len(fields) == 2 {
+		t, err := time.Parse("15:04", fields[1])
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid time %q", fields[1])
+		}
+		minutes = t.Hour()*60 + t.Minute()
+	}
+	return day, minutes, nil
+}
+
+// parseWeekday は "Mon" や "monday" を曜日に変換する
+func parseWeekday(value string) (time.Weekday, bool) {
+	value = strings.ToLower(value)
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		if value == name || value == name[:3] {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+// parseFreezeDate は "2006-01-02" または "2006-01-02 15:04" をローカルタイムで解釈する
+func parseFreezeDate(value string) (time.Time, bool, error) {
+	value = strings.TrimSpace(value)
+	if t, err := time.ParseInLocation("2006-01-02 15:04", value, time.Local); err == nil {
+		return t, false, nil
+	}
+	t, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return t, true, nil
+}
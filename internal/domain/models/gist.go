@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+// Gist represents a GitHub gist
+type Gist struct {
+	ID          string
+	Description string
+	Public      bool
+	Owner       User
+	Files       []*GistFile
+	HTMLURL     string
+	Comments    int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// GistFile represents a file in a gist. Content is only filled in when the
+// gist is fetched individually.
+type GistFile struct {
+	Filename string
+	Language string
+	Size     int
+	RawURL   string
+	Content  string
+}
+
+// GistOptions represents options for listing gists
+type GistOptions struct {
+	Page    int
+	PerPage int
+}
+
+// CreateGistInput represents input for creating a gist
+type CreateGistInput struct {
+	Description string
+	Public      bool
+	// Files maps file names to their contents
+	Files map[string]string
+}
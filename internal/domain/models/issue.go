@@ -23,6 +23,7 @@ type Issue struct {
 	Labels    []Label
 	Milestone *Milestone
 	Comments  int
+	Reactions Reactions
 	Locked    bool
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -53,6 +54,9 @@ const (
 	IssueSortCreated  IssueSort = "created"
 	IssueSortUpdated  IssueSort = "updated"
 	IssueSortComments IssueSort = "comments"
+	// IssueSortReactions sorts by 👍 count. The issues API cannot sort by
+	// reactions, so the loaded issues are sorted locally.
+	IssueSortReactions IssueSort = "reactions"
 )
 
 // SortDirection represents the direction of sorting
@@ -72,6 +76,17 @@ type CreateIssueInput struct {
 	Milestone int
 }
 
+// IssueTemplate represents an issue template from .github/ISSUE_TEMPLATE.
+// Issue forms (YAML) are converted to a Markdown body with a section per field.
+type IssueTemplate struct {
+	Name      string
+	About     string
+	Title     string
+	Labels    []string
+	Assignees []string
+	Body      string
+}
+
 // UpdateIssueInput represents input for updating an issue
 type UpdateIssueInput struct {
 	Title     *string
@@ -0,0 +1,107 @@
+package models
+
+import "strings"
+
+// IssueRelationKind is how an issue or pull request is connected to the focused one
+type IssueRelationKind string
+
+const (
+	IssueRelationTrackedBy    IssueRelationKind = "tracked_by"    // the parent issue of a sub-issue
+	IssueRelationTracks       IssueRelationKind = "tracks"        // the sub-issues of an issue
+	IssueRelationDuplicateOf  IssueRelationKind = "duplicate_of"  // the issue this one was marked a duplicate of
+	IssueRelationDuplicatedBy IssueRelationKind = "duplicated_by" // the issues marked as duplicates of this one
+	IssueRelationClosedBy     IssueRelationKind = "closed_by"     // the pull requests that close this issue
+	IssueRelationCloses       IssueRelationKind = "closes"        // the issues a pull request closes
+	IssueRelationMentionedIn  IssueRelationKind = "mentioned_in"  // the issues and pull requests that mention this one
+	IssueRelationReferences   IssueRelationKind = "references"    // the issues and pull requests the body mentions
+)
+
+// IssueRelationKinds lists the kinds in the order they are shown
+var IssueRelationKinds = []IssueRelationKind{
+	IssueRelationTrackedBy,
+	IssueRelationTracks,
+	IssueRelationDuplicateOf,
+	IssueRelationDuplicatedBy,
+	IssueRelationClosedBy,
+	IssueRelationCloses,
+	IssueRelationReferences,
+	IssueRelationMentionedIn,
+}
+
+// Label returns the edge label shown in the graph
+func (k IssueRelationKind) Label() string {
+	return strings.ReplaceAll(string(k), "_", " ")
+}
+
+// IssueGraphNode is an issue or pull request in an issue graph
+type IssueGraphNode struct {
+	Owner         string
+	Repo          string
+	Number        int
+	Title         string // empty when only known from a reference in the body
+	State         string // open, closed or merged; empty when only known from a reference in the body
+	IsPullRequest bool
+}
+
+// SameAs reports whether n and other are the same issue or pull request
+func (n IssueGraphNode) SameAs(other IssueGraphNode) bool {
+	return n.Number == other.Number && strings.EqualFold(n.Owner, other.Owner) && strings.EqualFold(n.Repo, other.Repo)
+}
+
+// IssueRelation is an edge from the focused issue to another one
+type IssueRelation struct {
+	Kind IssueRelationKind
+	Node IssueGraphNode
+}
+
+// IssueGraph is an issue or pull request with the issues it is directly connected to
+type IssueGraph struct {
+	Node      IssueGraphNode
+	Body      string // the body, from which the view takes the references
+	Relations []*IssueRelation
+}
+
+// AddRelation adds an edge unless it is to the focused issue itself or
+// already present with the same kind
+func (g *IssueGraph) AddRelation(kind IssueRelationKind, node IssueGraphNode) {
+	if node.Number <= 0 || node.SameAs(g.Node) {
+		return
+	}
+	for _, relation := range g.Relations {
+		if relation.Kind == kind && relation.Node.SameAs(node) {
+			return
+		}
+	}
+	g.Relations = append(g.Relations, &IssueRelation{Kind: kind, Node: node})
+}
+
+// Connected reports whether node is connected to the focused issue by any edge
+func (g *IssueGraph) Connected(node IssueGraphNode) bool {
+	for _, relation := range g.Relations {
+		if relation.Node.SameAs(node) {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveRelation removes an edge, e.g. when an issue is unmarked as a duplicate
+func (g *IssueGraph) RemoveRelation(kind IssueRelationKind, node IssueGraphNode) {
+	for i, relation := range g.Relations {
+		if relation.Kind == kind && relation.Node.SameAs(node) {
+			g.Relations = append(g.Relations[:i], g.Relations[i+1:]...)
+			return
+		}
+	}
+}
+
+// RelationsOf returns the edges of kind in the order they were added
+func (g *IssueGraph) RelationsOf(kind IssueRelationKind) []*IssueRelation {
+	var relations []*IssueRelation
+	for _, relation := range g.Relations {
+		if relation.Kind == kind {
+			relations = append(relations, relation)
+		}
+	}
+	return relations
+}
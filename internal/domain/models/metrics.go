@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+	"sort"
+	"strings"
+	"time"
+)
 
 // LeadTimeMetrics はリードタイムに関する統計データを表す
 type LeadTimeMetrics struct {
@@ -16,6 +20,50 @@ type LeadTimeMetrics struct {
 	WeeklyComparison           WeeklyComparison                           `json:"weekly_comparison"`
 	ByRepositoryWeekly         map[string]WeeklyComparison                `json:"by_repository_weekly"`
 	QualityIssues              PRQualityIssues                            `json:"quality_issues"`
+	IssueBacklog               IssueBacklogMetrics                        `json:"issue_backlog"`
+	RepositoryStatuses         []MetricsRepositoryStatus                  `json:"repository_statuses"` // 計測対象ごとの取得結果（設定順）
+
+	// Warnings は一部を取得できずに集計した項目の説明（品質・停滞PR・レビューの取得失敗など）
+	Warnings []string `json:"warnings,omitempty"`
+
+	// ByAuthor・ByReviewer は PR の作成者・レビュアー（ログイン名）ごとの統計。
+	// レビュアーは作成者以外でレビューを送信したユーザーで、1つのPRが複数のレビュアーに数えられる
+	ByAuthor                 map[string]LeadTimeStat       `json:"by_author,omitempty"`
+	ByAuthorPhaseBreakdown   map[string]ReviewPhaseMetrics `json:"by_author_phase_breakdown,omitempty"`
+	ByReviewer               map[string]LeadTimeStat       `json:"by_reviewer,omitempty"`
+	ByReviewerPhaseBreakdown map[string]ReviewPhaseMetrics `json:"by_reviewer_phase_breakdown,omitempty"`
+
+	// ExcludedPRs は作成者が bot または metrics.exclude_authors のため集計から除いたマージ済みPRの数
+	ExcludedPRs int `json:"excluded_prs,omitempty"`
+}
+
+// FailedRepositories は取得に失敗したリポジトリを返す
+func (m *LeadTimeMetrics) FailedRepositories() []string {
+	var failed []string
+	for _, status := range m.RepositoryStatuses {
+		if !status.OK() {
+			failed = append(failed, status.Repository)
+		}
+	}
+	return failed
+}
+
+// MetricsRepositoryStatus はリポジトリごとのメトリクス取得結果
+type MetricsRepositoryStatus struct {
+	Repository string `json:"repository"`      // owner/repo形式
+	Error      string `json:"error,omitempty"` // 失敗理由（成功した場合は空）
+}
+
+// OK は取得に成功したかどうかを返す
+func (s MetricsRepositoryStatus) OK() bool {
+	return s.Error == ""
+}
+
+// RepositorySummary はリポジトリ選択の候補（言語・トピックで絞り込める）
+type RepositorySummary struct {
+	FullName string   // owner/repo形式
+	Language string   // 主要言語（不明なら空）
+	Topics   []string // リポジトリのトピック
 }
 
 // LeadTimeStat は単一リポジトリまたは全体の統計値
@@ -23,6 +71,15 @@ type LeadTimeStat struct {
 	Average time.Duration `json:"average"`
 	Median  time.Duration `json:"median"`
 	Count   int           `json:"count"`
+
+	// Percentiles は metrics.lead_time_percentiles のパーセンタイル（設定の順）
+	Percentiles []LeadTimePercentile `json:"percentiles,omitempty"`
+}
+
+// LeadTimePercentile はリードタイムのパーセンタイル値（p90 なら Percentile は 90）
+type LeadTimePercentile struct {
+	Percentile int           `json:"percentile"`
+	Value      time.Duration `json:"value"`
 }
 
 // TrendPoint は期間ごとの平均リードタイムを表す
@@ -116,9 +173,156 @@ type WeeklyComparison struct {
 	MergeChangePercent  float64     `json:"merge_change_percent"`
 }
 
+// IssueBacklogOtherBucket はどのラベル区分にも当てはまらないIssueの区分名
+const IssueBacklogOtherBucket = "other"
+
+// IssueBacklogWeek は週末時点のラベル区分ごとのオープンIssue数
+type IssueBacklogWeek struct {
+	End  time.Time      `json:"end"`  // 集計時点（週の終わり）
+	Open map[string]int `json:"open"` // ラベル区分 → オープンIssue数
+}
+
+// Total は全区分のオープンIssue数を返す
+func (w IssueBacklogWeek) Total() int {
+	total := 0
+	for _, n := range w.Open {
+		total += n
+	}
+	return total
+}
+
+// IssueBacklogMetrics はオープンIssue数の週ごとの推移（累積フロー図用）と、
+// 集計したIssueのラベルの使われ方
+type IssueBacklogMetrics struct {
+	Buckets            []string                      `json:"buckets"`              // 表示順のラベル区分（最後は other）
+	Weeks              []IssueBacklogWeek            `json:"weeks"`                // 全リポジトリ合計（古い順）
+	ByRepository       map[string][]IssueBacklogWeek `json:"by_repository"`        // リポジトリごとの推移
+	Labels             LabelInsights                 `json:"labels"`               // 全リポジトリのラベルの使われ方
+	LabelsByRepository map[string]LabelInsights      `json:"labels_by_repository"` // リポジトリごとのラベルの使われ方
+}
+
+// LabelInsights はIssueに付いたラベルの集計（よく使われるラベル・一緒に付くラベル・ラベルなしの割合）
+type LabelInsights struct {
+	Issues    int                       `json:"issues"`    // 集計したIssue数
+	Unlabeled int                       `json:"unlabeled"` // ラベルのないIssue数
+	Labels    map[string]int            `json:"labels"`    // ラベル → 付いているIssue数
+	Pairs     map[string]map[string]int `json:"pairs"`     // ラベルの組（名前順の前 → 後）→ 両方が付いているIssue数
+}
+
+// LabelCount はラベル（共起の場合は2つのラベル）と件数
+type LabelCount struct {
+	Labels []string
+	Count  int
+}
+
+// Add は1件のIssueのラベルを集計に加える
+func (l *LabelInsights) Add(labels []string) {
+	l.Issues++
+	if len(labels) == 0 {
+		l.Unlabeled++
+		return
+	}
+	if l.Labels == nil {
+		l.Labels = make(map[string]int)
+	}
+	sorted := append([]string(nil), labels...)
+	sort.Strings(sorted)
+	unique := sorted[:0]
+	for i, label := range sorted {
+		if i == 0 || label != sorted[i-1] {
+			unique = append(unique, label)
+		}
+	}
+	for i, label := range unique {
+		l.Labels[label]++
+		for _, other := range unique[i+1:] {
+			l.addPair(label, other, 1)
+		}
+	}
+}
+
+// Merge は別の集計を加える
+func (l *LabelInsights) Merge(other LabelInsights) {
+	l.Issues += other.Issues
+	l.Unlabeled += other.Unlabeled
+	for label, n := range other.Labels {
+		if l.Labels == nil {
+			l.Labels = make(map[string]int)
+		}
+		l.Labels[label] += n
+	}
+	for a, counts := range other.Pairs {
+		for b, n := range counts {
+			l.addPair(a, b, n)
+		}
+	}
+}
+
+// addPair は名前順に並んだラベルの組の件数を加える
+func (l *LabelInsights) addPair(a, b string, n int) {
+	if l.Pairs == nil {
+		l.Pairs = make(map[string]map[string]int)
+	}
+	if l.Pairs[a] == nil {
+		l.Pairs[a] = make(map[string]int)
+	}
+	l.Pairs[a][b] += n
+}
+
+// UnlabeledRatio はラベルのないIssueの割合（0〜1）を返す
+func (l LabelInsights) UnlabeledRatio() float64 {
+	if l.Issues == 0 {
+		return 0
+	}
+	return float64(l.Unlabeled) / float64(l.Issues)
+}
+
+// TopLabels は付いているIssueの多いラベルを最大 n 件返す（同数は名前順）
+func (l LabelInsights) TopLabels(n int) []LabelCount {
+	counts := make([]LabelCount, 0, len(l.Labels))
+	for label, count := range l.Labels {
+		counts = append(counts, LabelCount{Labels: []string{label}, Count: count})
+	}
+	return topLabelCounts(counts, n)
+}
+
+// TopPairs は一緒に付いていることの多いラベルの組を最大 n 件返す（同数は名前順）
+func (l LabelInsights) TopPairs(n int) []LabelCount {
+	var counts []LabelCount
+	for a, pairs := range l.Pairs {
+		for b, count := range pairs {
+			counts = append(counts, LabelCount{Labels: []string{a, b}, Count: count})
+		}
+	}
+	return topLabelCounts(counts, n)
+}
+
+// topLabelCounts は件数の多い順（同数はラベル名順）に並べて先頭 n 件を返す
+func topLabelCounts(counts []LabelCount, n int) []LabelCount {
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return strings.Join(counts[i].Labels, " ") < strings.Join(counts[j].Labels, " ")
+	})
+	if len(counts) > n {
+		counts = counts[:n]
+	}
+	return counts
+}
+
 // MetricsProgress はメトリクス収集の進捗状況を表す
 type MetricsProgress struct {
 	TotalRepos     int    `json:"total_repos"`     // 総リポジトリ数
 	ProcessedRepos int    `json:"processed_repos"` // 処理済みリポジトリ数
 	CurrentRepo    string `json:"current_repo"`    // 現在処理中のリポジトリ
 }
+
+// InterruptedMetricsRun は途中で終わった（中断・一部失敗した）メトリクス取得の記録
+// 再開すると Remaining のリポジトリだけを取得し、Completed は記録したサンプルを使う
+type InterruptedMetricsRun struct {
+	Since     time.Time // 集計開始日時
+	UpdatedAt time.Time // 最後にリポジトリの取得を終えた日時
+	Completed []string  // 取得を終えたリポジトリ
+	Remaining []string  // 失敗したか、まだ取得していないリポジトリ
+}
@@ -0,0 +1,25 @@
+package models
+
+// MyWorkCategory is a way the user is involved with an issue or pull request
+type MyWorkCategory string
+
+const (
+	MyWorkAssigned        MyWorkCategory = "assigned"
+	MyWorkAuthored        MyWorkCategory = "authored"
+	MyWorkReviewRequested MyWorkCategory = "review_requested"
+	MyWorkMentioned       MyWorkCategory = "mentioned"
+)
+
+// MyWorkSection is the open items of one category
+type MyWorkSection struct {
+	Category MyWorkCategory
+	Title    string
+	Items    []SearchResult
+	// TotalCount is the number of matching items, which may exceed len(Items)
+	TotalCount int
+}
+
+// MyWork gathers the open issues and pull requests that need the user
+type MyWork struct {
+	Sections []MyWorkSection
+}
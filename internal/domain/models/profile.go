@@ -0,0 +1,111 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+)
+
+// DefaultProfileName はプロファイルを使わない（github セクションそのままの）接続の表示名
+const DefaultProfileName = "default"
+
+// ProfileNames はプロファイルピッカーに並べる名前を返す
+// 先頭は github セクションそのものを表す "default"、以降は名前順
+func (c *Config) ProfileNames() []string {
+	names := make([]string, 0, len(c.Profiles)+1)
+	names = append(names, DefaultProfileName)
+	for name := range c.Profiles {
+		if name != DefaultProfileName {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names[1:])
+	return names
+}
+
+// ApplyProfile は指定したプロファイルの設定を github セクションに重ねる
+//
+// プロファイルで指定した項目だけが上書きされる。ただしトークンは別アカウントに
+// 漏れないよう引き継がず、プロファイルの token（または auth_sources）から取得する。
+// 空文字または "default" の場合は github セクションをそのまま使う。
+func (c *Config) ApplyProfile(name string) error {
+	if name == "" || (name == DefaultProfileName && !c.hasProfile(name)) {
+		c.Profile = ""
+		return nil
+	}
+	profile, ok := c.Profiles[name]
+	if !ok {
+		return fmt.Errorf("unknown profile %q (available: %v)", name, c.ProfileNames())
+	}
+
+	base := c.GitHub
+	base.Token = profile.Token
+	if profile.DefaultOwner != "" || profile.DefaultRepo != "" {
+		base.DefaultOwner = profile.DefaultOwner
+		base.DefaultRepo = profile.DefaultRepo
+	}
+	if profile.APIBaseURL != "" {
+		base.APIBaseURL = profile.APIBaseURL
+	}
+	if profile.UploadBaseURL != "" {
+		base.UploadBaseURL = profile.UploadBaseURL
+	}
+	if profile.RequestTimeout > 0 {
+		base.RequestTimeout = profile.RequestTimeout
+	}
+	if profile.Timeouts != (TimeoutsConfig{}) {
+		base.Timeouts = profile.Timeouts
+	}
+	if profile.RateLimitBuffer > 0 {
+		base.RateLimitBuffer = profile.RateLimitBuffer
+	}
+	if profile.Retries > 0 {
+		base.Retries = profile.Retries
+	}
+	if len(profile.Repositories) > 0 {
+		base.Repositories = profile.Repositories
+	}
+	if len(profile.AuthSources) > 0 {
+		base.AuthSources = profile.AuthSources
+	}
+	if profile.OAuthClientID != "" {
+		base.OAuthClientID = profile.OAuthClientID
+	}
+
+	c.GitHub = base
+	c.Profile = name
+	return nil
+}
+
+// hasProfile はプロファイルが定義されているかどうかを返す
+func (c *Config) hasProfile(name string) bool {
+	_, ok := c.Profiles[name]
+	return ok
+}
+
+// validateProfiles はプロファイル設定を検証する
+func (c *Config) validateProfiles() error {
+	for name, profile := range c.Profiles {
+		if name == "" {
+			return fmt.Errorf("profile name must not be empty")
+		}
+		if err := validateAuthSources(profile.AuthSources); err != nil {
+			return fmt.Errorf("profile %q: %w", name, err)
+		}
+	}
+	if c.Profile != "" && c.Profile != DefaultProfileName && !c.hasProfile(c.Profile) {
+		return fmt.Errorf("unknown profile %q", c.Profile)
+	}
+	return nil
+}
+
+// validateAuthSources はトークンの取得元の指定を検証する
+func validateAuthSources(sources []string) error {
+	for _, source := range sources {
+		switch source {
+		case "config", "env", "gh", "keyring":
+		default:
+			return fmt.Errorf("unknown auth source %q (expected config, env, gh or keyring)", source)
+		}
+	}
+	return nil
+}
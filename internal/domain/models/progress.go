@@ -0,0 +1,25 @@
+package models
+
+// Progress reports how far a long-running operation has come. Total is zero
+// when the amount of work is not known in advance.
+type Progress struct {
+	Done    int64
+	Total   int64
+	Failed  int
+	Current string
+}
+
+// Fraction returns the completed share of the work between 0 and 1, or -1 when
+// the total is unknown
+func (p Progress) Fraction() float64 {
+	if p.Total <= 0 {
+		return -1
+	}
+	if p.Done >= p.Total {
+		return 1
+	}
+	if p.Done <= 0 {
+		return 0
+	}
+	return float64(p.Done) / float64(p.Total)
+}
@@ -0,0 +1,71 @@
+package models
+
+import (
+	"path"
+	"strings"
+)
+
+// ProtectedPaths は変更に注意が必要なパスのパターン一覧
+//
+// パターンは .gitignore に近い形式で解釈する:
+//   - "/" で終わるパターンはディレクトリ配下のすべてのファイルに一致する
+//     （"migrations/" のように他に "/" を含まなければ任意の階層のディレクトリに一致）
+//   - それ以外は path.Match のグロブとしてパス全体に、"/" を含まなければファイル名にも照合する
+type ProtectedPaths []string
+
+// Match はファイルに一致した最初のパターンを返す
+func (p ProtectedPaths) Match(file string) (string, bool) {
+	file = strings.TrimPrefix(file, "/")
+	for _, pattern := range p {
+		if matchProtectedPath(strings.TrimSpace(pattern), file) {
+			return pattern, true
+		}
+	}
+	return "", false
+}
+
+// Touched はファイル群が変更しているパターンを設定順に重複なく返す
+func (p ProtectedPaths) Touched(files []string) []string {
+	hit := make(map[string]bool)
+	for _, file := range files {
+		if pattern, ok := p.Match(file); ok {
+			hit[pattern] = true
+		}
+	}
+
+	var touched []string
+	for _, pattern := range p {
+		if hit[pattern] {
+			touched = append(touched, pattern)
+			delete(hit, pattern)
+		}
+	}
+	return touched
+}
+
+// matchProtectedPath は1つのパターンとファイルパスを照合する
+func matchProtectedPath(pattern, file string) bool {
+	if pattern == "" {
+		return false
+	}
+
+	if dir, isDir := strings.CutSuffix(pattern, "/"); isDir {
+		anchored := strings.Contains(dir, "/")
+		dir = strings.TrimPrefix(dir, "/")
+		if strings.HasPrefix(file, dir+"/") {
+			return true
+		}
+		// 先頭や途中に "/" がなければルート以外の階層にあるディレクトリにも一致させる
+		return !anchored && strings.Contains(file, "/"+dir+"/")
+	}
+
+	pattern = strings.TrimPrefix(pattern, "/")
+	if ok, _ := path.Match(pattern, file); ok {
+		return true
+	}
+	if !strings.Contains(pattern, "/") {
+		ok, _ := path.Match(pattern, path.Base(file))
+		return ok
+	}
+	return false
+}
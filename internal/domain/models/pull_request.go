@@ -22,6 +22,7 @@ type PullRequest struct {
 	Locked           bool
 	Reviews          []Review
 	RequestedReviewers []User
+	RequestedTeams   []Team
 	Assignees        []User
 	Labels           []Label
 	Milestone        *Milestone
@@ -35,6 +36,19 @@ type PullRequest struct {
 	ClosedAt         *time.Time
 }
 
+// ActivityTime is when the PR was merged, closed or, while open, created.
+// Date ranges are matched against it, so a range lists what merged in it.
+func (pr *PullRequest) ActivityTime() time.Time {
+	switch {
+	case pr.MergedAt != nil:
+		return *pr.MergedAt
+	case pr.ClosedAt != nil:
+		return *pr.ClosedAt
+	default:
+		return pr.CreatedAt
+	}
+}
+
 // PRState represents the state of a pull request
 type PRState string
 
@@ -53,6 +67,11 @@ type PROptions struct {
 	Direction SortDirection
 	Page      int
 	PerPage   int
+
+	// Since and Until list only the PRs whose ActivityTime is in the range.
+	// All matches are returned as the first page.
+	Since *time.Time
+	Until *time.Time
 }
 
 // PRSort represents the field to sort pull requests by
@@ -101,3 +120,120 @@ type UpdatePRInput struct {
 	Labels    *[]string
 	Milestone *int
 }
+
+// ReviewEvent represents the action taken when submitting a review
+type ReviewEvent string
+
+const (
+	ReviewEventApprove        ReviewEvent = "APPROVE"
+	ReviewEventRequestChanges ReviewEvent = "REQUEST_CHANGES"
+	ReviewEventComment        ReviewEvent = "COMMENT"
+)
+
+// CreateReviewInput represents the input for submitting a pull request review
+type CreateReviewInput struct {
+	Event ReviewEvent
+	Body  string
+}
+
+// ReviewThread represents a thread of review comments anchored to a file line
+type ReviewThread struct {
+	ID   string
+	Path string
+	Line int
+	// StartLine is the first line of a multi-line thread, 0 for a single line
+	StartLine int
+	// DiffSide is the side of the diff the thread is on: "RIGHT" (the head)
+	// or "LEFT" (the base)
+	DiffSide   string
+	IsResolved bool
+	IsOutdated bool
+	Comments   []*Comment
+}
+
+// SuggestionInput represents a suggested change of a review comment to commit
+// to the head branch of a pull request
+type SuggestionInput struct {
+	Path      string
+	StartLine int // first line replaced
+	Line      int // last line replaced
+	// Replacement is the content of the suggestion block; empty deletes the lines
+	Replacement string
+	Message     string
+	// ExpectedHeadSHA is the head commit the line numbers refer to; the commit
+	// is refused once the branch has moved on
+	ExpectedHeadSHA string
+}
+
+// LinkedIssue represents an issue a pull request closes when it is merged
+type LinkedIssue struct {
+	Owner  string
+	Repo   string
+	Number int
+	Title  string     // empty when only known from a closing keyword in the body
+	State  IssueState // empty when only known from a closing keyword in the body
+}
+
+// CheckState represents the outcome of a status check
+type CheckState string
+
+const (
+	CheckStatePending CheckState = "pending"
+	CheckStateSuccess CheckState = "success"
+	CheckStateFailure CheckState = "failure"
+)
+
+// CheckStatus represents a check run or commit status on the head commit
+type CheckStatus struct {
+	Name     string
+	State    CheckState
+	Required bool
+}
+
+// MergeRequirements represents the base branch protection rules and the
+// state of the checks and reviews they require
+type MergeRequirements struct {
+	Protected               bool
+	RequiredApprovals       int
+	RequireCodeOwnerReviews bool
+	// ReviewDecision is APPROVED, CHANGES_REQUESTED, REVIEW_REQUIRED or empty
+	// when the branch does not require reviews
+	ReviewDecision string
+	Checks         []CheckStatus
+}
+
+// MergeReadiness is what decides whether a pull request can be merged now
+type MergeReadiness struct {
+	Draft bool
+	// ReviewDecision is APPROVED, CHANGES_REQUESTED, REVIEW_REQUIRED or empty
+	// when the branch does not require reviews
+	ReviewDecision string
+	// Approvals and ChangesRequested count the latest review of each reviewer
+	Approvals        int
+	ChangesRequested bool
+	// ChecksState is the combined state of the head commit's checks, empty
+	// when it has none
+	ChecksState CheckState
+	// Mergeable is MERGEABLE, CONFLICTING or UNKNOWN (not computed yet)
+	Mergeable string
+}
+
+// Ready reports whether the pull request is approved, its checks are green
+// and it has no conflicts. Without required reviews an approval and no
+// change request stand in for the review decision.
+func (r *MergeReadiness) Ready() bool {
+	if r.Draft || r.Mergeable != "MERGEABLE" {
+		return false
+	}
+	if r.ChecksState != "" && r.ChecksState != CheckStateSuccess {
+		return false
+	}
+	switch r.ReviewDecision {
+	case "APPROVED":
+		return true
+	case "":
+		return r.Approvals > 0 && !r.ChangesRequested
+	default:
+		return false
+	}
+}
@@ -0,0 +1,55 @@
+package models
+
+import "time"
+
+// Release represents a GitHub release
+type Release struct {
+	ID              int64
+	TagName         string
+	TargetCommitish string
+	Name            string
+	Body            string
+	Draft           bool
+	Prerelease      bool
+	Author          User
+	Assets          []*ReleaseAsset
+	HTMLURL         string
+	CreatedAt       time.Time
+	PublishedAt     *time.Time
+}
+
+// ReleaseAsset represents a file attached to a release
+type ReleaseAsset struct {
+	ID                 int64
+	Name               string
+	ContentType        string
+	Size               int
+	DownloadCount      int
+	BrowserDownloadURL string
+	UpdatedAt          time.Time
+}
+
+// Tag represents a Git tag
+type Tag struct {
+	Name string
+	SHA  string
+}
+
+// ReleaseOptions represents options for listing releases and tags
+type ReleaseOptions struct {
+	Page    int
+	PerPage int
+}
+
+// CreateReleaseInput represents input for creating a release
+type CreateReleaseInput struct {
+	TagName string
+	// Target is the branch or commit the tag is created from when it does not exist yet
+	Target string
+	Name   string
+	Body   string
+	// GenerateNotes asks GitHub to generate the release notes from merged pull requests
+	GenerateNotes bool
+	Draft         bool
+	Prerelease    bool
+}
@@ -0,0 +1,97 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// ReleaseTrain は次のリリースの準備状況を表す
+type ReleaseTrain struct {
+	// Branch はリリース元のブランチ（デフォルトブランチ）
+	Branch string
+	// HeadSHA は Branch の先頭コミット
+	HeadSHA string
+	// LastTag は直近のリリースのタグ（まだなければ空）
+	LastTag string
+	// Commits は LastTag 以降に Branch へ入ったコミット（新しい順）
+	Commits []*Commit
+	// TotalCommits は LastTag 以降のコミット総数（Commits は途中で打ち切られることがある）
+	TotalCommits int
+	// PullRequests はリリース対象ラベルの付いた PR（未マージのものと LastTag 以降にクローズされたもの）
+	PullRequests []*PullRequest
+	// Blockers はブロッカーラベルの付いたオープンな Issue / PR
+	Blockers []SearchResult
+	// CheckState は HeadSHA の CI ステータス
+	CheckState CheckState
+}
+
+// Problems はリリースを切れない理由を返す。空ならすべてグリーン
+func (t *ReleaseTrain) Problems() []string {
+	var problems []string
+
+	if t.TotalCommits == 0 {
+		if t.LastTag != "" {
+			problems = append(problems, fmt.Sprintf("No commits on %s since %s", t.Branch, t.LastTag))
+		} else {
+			problems = append(problems, fmt.Sprintf("No commits on %s", t.Branch))
+		}
+	}
+
+	if len(t.Blockers) > 0 {
+		problems = append(problems, fmt.Sprintf("%d open blocker(s)", len(t.Blockers)))
+	}
+
+	if open := t.OpenPullRequests(); open > 0 {
+		problems = append(problems, fmt.Sprintf("%d release PR(s) not merged yet", open))
+	}
+
+	switch t.CheckState {
+	case CheckStateSuccess:
+	case CheckStateFailure:
+		problems = append(problems, fmt.Sprintf("CI is failing on %s", t.Branch))
+	case CheckStatePending:
+		problems = append(problems, fmt.Sprintf("CI is still running on %s", t.Branch))
+	default:
+		problems = append(problems, fmt.Sprintf("No CI status reported on %s", t.Branch))
+	}
+
+	return problems
+}
+
+// Ready はリリースを切れる状態かを返す
+func (t *ReleaseTrain) Ready() bool {
+	return len(t.Problems()) == 0
+}
+
+// OpenPullRequests はまだマージされていないリリース対象 PR の数を返す
+func (t *ReleaseTrain) OpenPullRequests() int {
+	open := 0
+	for _, pr := range t.PullRequests {
+		if pr.State == PRStateOpen {
+			open++
+		}
+	}
+	return open
+}
+
+// semverTag は "v1.2.3" や "1.2.3" 形式のタグに一致する
+var semverTag = regexp.MustCompile(`^(v?)(\d+)\.(\d+)\.(\d+)$`)
+
+// NextTag は LastTag のパッチバージョンを上げたタグを提案する
+// セマンティックバージョンでないタグの場合は空文字を返す
+func (t *ReleaseTrain) NextTag() string {
+	if t.LastTag == "" {
+		return "v0.1.0"
+	}
+
+	m := semverTag.FindStringSubmatch(t.LastTag)
+	if m == nil {
+		return ""
+	}
+	patch, err := strconv.Atoi(m[4])
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%s%s.%s.%d", m[1], m[2], m[3], patch+1)
+}
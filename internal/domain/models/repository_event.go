@@ -0,0 +1,63 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RepositoryEventKind is what a repository event is about
+type RepositoryEventKind string
+
+const (
+	RepositoryEventIssue       RepositoryEventKind = "issue"        // an issue was opened, closed, edited, labeled, ...
+	RepositoryEventPullRequest RepositoryEventKind = "pull_request" // a pull request was opened, closed, merged, ...
+	RepositoryEventComment     RepositoryEventKind = "comment"      // a comment on an issue or pull request
+	RepositoryEventReview      RepositoryEventKind = "review"       // a review or review comment on a pull request
+)
+
+// RepositoryEvent is a change to an issue or pull request, as reported by the
+// events of its repository
+type RepositoryEvent struct {
+	ID            string
+	Kind          RepositoryEventKind
+	Action        string // the action of the payload, e.g. "opened", "closed" or "created"
+	Owner         string
+	Repo          string
+	Number        int
+	IsPullRequest bool
+	Merged        bool // a closed pull request was merged
+	Actor         string
+	CreatedAt     time.Time
+}
+
+// Concerns reports whether the event is about owner/repo#number
+func (e *RepositoryEvent) Concerns(owner, repo string, number int) bool {
+	return e.Number == number && strings.EqualFold(e.Owner, owner) && strings.EqualFold(e.Repo, repo)
+}
+
+// Summary describes the event in a line, e.g. "@alice commented on #12"
+func (e *RepositoryEvent) Summary() string {
+	target := fmt.Sprintf("#%d", e.Number)
+	if e.IsPullRequest {
+		target = "PR " + target
+	}
+
+	var what string
+	switch e.Kind {
+	case RepositoryEventComment:
+		what = "commented on " + target
+	case RepositoryEventReview:
+		what = "reviewed " + target
+	default:
+		action := e.Action
+		if e.Merged {
+			action = "merged"
+		}
+		what = strings.ReplaceAll(action, "_", " ") + " " + target
+	}
+	if e.Actor == "" {
+		return what
+	}
+	return "@" + e.Actor + " " + what
+}
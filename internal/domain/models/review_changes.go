@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// ChangesSinceReview is what changed in a pull request since the viewer last
+// reviewed it: the diff between the reviewed commit and the current head
+type ChangesSinceReview struct {
+	// ReviewedSHA is the head commit the viewer's last review was submitted on;
+	// empty when the viewer has not reviewed the pull request
+	ReviewedSHA string
+	ReviewedAt  time.Time
+	HeadSHA     string
+	// Commits are the commits pushed since the review
+	Commits []*Commit
+	// Files are the files changed between ReviewedSHA and HeadSHA, with their patches
+	Files []*DiffFile
+	// Rewritten is set when the head branch was force-pushed and the reviewed
+	// commit is no longer part of it; Files then also contain the rewritten changes
+	Rewritten bool
+}
+
+// Reviewed reports whether the viewer has reviewed the pull request
+func (c *ChangesSinceReview) Reviewed() bool {
+	return c != nil && c.ReviewedSHA != ""
+}
+
+// UpToDate reports whether nothing was pushed since the review
+func (c *ChangesSinceReview) UpToDate() bool {
+	return c.Reviewed() && c.ReviewedSHA == c.HeadSHA
+}
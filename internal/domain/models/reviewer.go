@@ -0,0 +1,106 @@
+package models
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// Team is a team of an organization
+type Team struct {
+	Org  string
+	Slug string
+	Name string
+}
+
+// Key identifies the team the way CODEOWNERS and mentions do, e.g. "octo/backend"
+func (t Team) Key() string {
+	return t.Org + "/" + t.Slug
+}
+
+// ReviewerCandidates are the users and teams reviews of a pull request can
+// be requested from
+type ReviewerCandidates struct {
+	// Users are the users with write access (the repository's assignees)
+	Users []User
+	// Teams are the teams with access to the repository; empty for
+	// repositories owned by a user
+	Teams []Team
+}
+
+// ReviewRequest names the reviewers to request: users by login and teams by slug
+type ReviewRequest struct {
+	Users []string
+	Teams []string
+}
+
+// Approvals are who currently approve a pull request
+type Approvals struct {
+	// Users are the logins whose latest review approves
+	Users []string
+	// Teams are the teams ("org/slug") an approving review was submitted on behalf of
+	Teams []string
+}
+
+// Approved reports whether a user ("login") or team ("org/slug") approves
+func (a *Approvals) Approved(reviewer string) bool {
+	if a == nil {
+		return false
+	}
+	for _, name := range append(a.Users, a.Teams...) {
+		if strings.EqualFold(name, reviewer) {
+			return true
+		}
+	}
+	return false
+}
+
+// PendingReviewRequest is a review request of a pull request that is still waiting
+type PendingReviewRequest struct {
+	// Reviewer is the login of a user or the org/team of a team
+	Reviewer string
+	// RequestedAt is when the review was requested; a request made again after
+	// the reviewer reviewed or was removed starts over
+	RequestedAt time.Time
+}
+
+// Team reports whether the review was requested from a team
+func (r PendingReviewRequest) Team() bool {
+	return strings.Contains(r.Reviewer, "/")
+}
+
+// PendingReviewRequests derives the review requests still waiting from the
+// timeline of a pull request (oldest first), oldest request first. A request
+// is done once it is removed or the reviewer submits a review.
+func PendingReviewRequests(events []*TimelineEvent) []*PendingReviewRequest {
+	requested := make(map[string]time.Time)
+	for _, event := range events {
+		switch event.Type {
+		case TimelineReviewRequested:
+			// Asking again while still waiting keeps the original request
+			if _, waiting := requested[event.Reviewer]; !waiting && event.Reviewer != "" {
+				requested[event.Reviewer] = event.CreatedAt
+			}
+		case TimelineReviewRequestRemoved:
+			delete(requested, event.Reviewer)
+		case TimelineReviewed:
+			for reviewer := range requested {
+				if strings.EqualFold(reviewer, event.Actor.Login) {
+					delete(requested, reviewer)
+				}
+			}
+		}
+	}
+
+	pending := make([]*PendingReviewRequest, 0, len(requested))
+	for reviewer, at := range requested {
+		pending = append(pending, &PendingReviewRequest{Reviewer: reviewer, RequestedAt: at})
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		if !pending[i].RequestedAt.Equal(pending[j].RequestedAt) {
+			return pending[i].RequestedAt.Before(pending[j].RequestedAt)
+		}
+		return pending[i].Reviewer < pending[j].Reviewer
+	})
+	return pending
+}
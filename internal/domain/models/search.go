@@ -31,6 +31,9 @@ type SearchOptions struct {
 	Direction  SortDirection    // Sort direction (asc, desc)
 	Page       int              // Page number for pagination
 	PerPage    int              // Number of results per page
+	// Repositories (owner/repo) searched instead of the given repository;
+	// with neither, every repository the user can see is searched
+	Repositories []string
 }
 
 // SearchResult represents a single search result (can be Issue or PR)
@@ -38,6 +41,7 @@ type SearchResult struct {
 	Type        SearchType     // Type of the result (issue or pr)
 	Issue       *Issue         // Issue data (if Type == SearchTypeIssue)
 	PullRequest *PullRequest   // PR data (if Type == SearchTypePR)
+	Repository  string         // owner/repo the item belongs to
 }
 
 // SearchResults represents the result of a search query
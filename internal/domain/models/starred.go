@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+// StarredRepository represents a repository the authenticated user starred
+type StarredRepository struct {
+	Owner       string
+	Name        string
+	Description string
+	Language    string
+	Topics      []string
+	HTMLURL     string
+	Stars       int
+	// OpenIssues counts open issues and pull requests, as GitHub reports it
+	OpenIssues int
+	Fork       bool
+	Archived   bool
+	PushedAt   time.Time
+	StarredAt  time.Time
+}
+
+// FullName returns the "owner/name" of the repository
+func (r *StarredRepository) FullName() string {
+	return r.Owner + "/" + r.Name
+}
+
+// StarredOptions represents options for listing starred repositories
+type StarredOptions struct {
+	Page    int
+	PerPage int
+}
@@ -0,0 +1,67 @@
+package models
+
+import "time"
+
+// TimelineEventType is the kind of an event in the timeline of an issue or pull request
+type TimelineEventType string
+
+// Event types rendered specially; others are shown by name
+const (
+	TimelineCommented            TimelineEventType = "commented"
+	TimelineLabeled              TimelineEventType = "labeled"
+	TimelineUnlabeled            TimelineEventType = "unlabeled"
+	TimelineAssigned             TimelineEventType = "assigned"
+	TimelineUnassigned           TimelineEventType = "unassigned"
+	TimelineReferenced           TimelineEventType = "referenced"
+	TimelineCrossReferenced      TimelineEventType = "cross-referenced"
+	TimelineReviewRequested      TimelineEventType = "review_requested"
+	TimelineReviewRequestRemoved TimelineEventType = "review_request_removed"
+	TimelineReviewed             TimelineEventType = "reviewed"
+	TimelineForcePushed          TimelineEventType = "head_ref_force_pushed"
+	TimelineDeployed             TimelineEventType = "deployed"
+	TimelineCommitted            TimelineEventType = "committed"
+	TimelineMilestoned           TimelineEventType = "milestoned"
+	TimelineDemilestoned         TimelineEventType = "demilestoned"
+	TimelineRenamed              TimelineEventType = "renamed"
+	TimelineClosed               TimelineEventType = "closed"
+	TimelineReopened             TimelineEventType = "reopened"
+	TimelineMerged               TimelineEventType = "merged"
+)
+
+// TimelineEvent is one entry of the timeline of an issue or pull request.
+// Only the fields that belong to its Type are set.
+type TimelineEvent struct {
+	ID        int64
+	Type      TimelineEventType
+	Actor     User
+	CreatedAt time.Time
+
+	// Label is the label added or removed (labeled, unlabeled)
+	Label string
+	// Assignee is the user assigned or unassigned (assigned, unassigned)
+	Assignee string
+	// Reviewer is the user or team (org/team) whose review was requested
+	Reviewer string
+	// CommitID is the commit that referenced the issue, or the new head of a force push
+	CommitID string
+	// Source is the issue or pull request that mentioned this one (cross-referenced)
+	Source *TimelineSource
+	// Milestone is the milestone added or removed (milestoned, demilestoned)
+	Milestone string
+	// RenamedFrom and RenamedTo are the old and new titles (renamed)
+	RenamedFrom string
+	RenamedTo   string
+	// State is the state of a submitted review (reviewed)
+	State string
+	// Body is the text of a comment or review (commented, reviewed) or the message of a commit (committed)
+	Body string
+}
+
+// TimelineSource is the issue or pull request a cross-reference comes from
+type TimelineSource struct {
+	Owner         string
+	Repo          string
+	Number        int
+	Title         string
+	IsPullRequest bool
+}
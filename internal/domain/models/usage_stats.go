@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+// UsageStats counts how tig-gh is used. The counters are only kept in a
+// local file and never sent anywhere; `tig-gh stats` shows them.
+type UsageStats struct {
+	// Since is when counting started
+	Since    time.Time `json:"since"`
+	Sessions int       `json:"sessions"`
+	// SessionSeconds is the total length of the sessions
+	SessionSeconds int64 `json:"session_seconds"`
+	// Views counts how often each view was opened, by name
+	Views map[string]int `json:"views"`
+	// Actions counts the changes made, e.g. "pull request merged"
+	Actions map[string]int `json:"actions"`
+}
+
+// NewUsageStats returns empty stats counting from since
+func NewUsageStats(since time.Time) *UsageStats {
+	return &UsageStats{Since: since, Views: map[string]int{}, Actions: map[string]int{}}
+}
+
+// AverageSession returns the average length of a session
+func (s *UsageStats) AverageSession() time.Duration {
+	if s.Sessions == 0 {
+		return 0
+	}
+	return time.Duration(s.SessionSeconds/int64(s.Sessions)) * time.Second
+}
+
+// Merge adds the counters of other
+func (s *UsageStats) Merge(other *UsageStats) {
+	if s.Since.IsZero() || (!other.Since.IsZero() && other.Since.Before(s.Since)) {
+		s.Since = other.Since
+	}
+	s.Sessions += other.Sessions
+	s.SessionSeconds += other.SessionSeconds
+	if s.Views == nil {
+		s.Views = map[string]int{}
+	}
+	if s.Actions == nil {
+		s.Actions = map[string]int{}
+	}
+	for name, n := range other.Views {
+		s.Views[name] += n
+	}
+	for name, n := range other.Actions {
+		s.Actions[name] += n
+	}
+}
@@ -0,0 +1,11 @@
+package models
+
+// ViewedFiles is the set of files of a pull request marked as viewed, like
+// the "Viewed" checkbox on GitHub. Each file keeps a fingerprint of its diff
+// at the time it was marked, so that a commit changing the file unmarks it.
+type ViewedFiles struct {
+	// HeadSHA is the head commit the files were last marked at
+	HeadSHA string `json:"head_sha"`
+	// Files maps file paths to the fingerprint of their diff
+	Files map[string]string `json:"files"`
+}
@@ -0,0 +1,105 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// WatchedItem is an issue or pull request whose activity raises notifications
+type WatchedItem struct {
+	Owner         string `json:"owner"`
+	Repo          string `json:"repo"`
+	Number        int    `json:"number"`
+	IsPullRequest bool   `json:"is_pull_request"`
+	Title         string `json:"title"`
+	HTMLURL       string `json:"html_url"`
+	// Snapshot is the state seen at the last poll; nil until the first poll
+	Snapshot *WatchSnapshot `json:"snapshot,omitempty"`
+	// LastEvent is the latest activity noticed, shown in the watch list
+	LastEvent *WatchEvent `json:"last_event,omitempty"`
+	// Seen is the state at the last visit (opening the item from the watch
+	// list); the list highlights what changed since
+	Seen *WatchSnapshot `json:"seen,omitempty"`
+}
+
+// Changes describes what changed since the last visit, e.g. "+2 comments"
+// or "CI pending → failure". It is empty when nothing changed or the item
+// was not polled yet.
+func (w *WatchedItem) Changes() []string {
+	before, after := w.Seen, w.Snapshot
+	if before == nil || after == nil {
+		return nil
+	}
+
+	var changes []string
+	if n := after.Comments - before.Comments; n > 0 {
+		changes = append(changes, countChange(n, "comment", "comments"))
+	}
+	if n := after.Reviews - before.Reviews; n > 0 {
+		changes = append(changes, countChange(n, "review", "reviews"))
+	}
+	if after.CheckState != before.CheckState && after.CheckState != "" {
+		from := string(before.CheckState)
+		if from == "" {
+			from = "none"
+		}
+		changes = append(changes, fmt.Sprintf("CI %s → %s", from, after.CheckState))
+	}
+	if after.State != before.State {
+		changes = append(changes, fmt.Sprintf("%s → %s", before.State, after.State))
+	}
+	if len(changes) == 0 && after.UpdatedAt.After(before.UpdatedAt) {
+		// Edits, labels and the like only move the update time
+		changes = append(changes, "updated")
+	}
+	return changes
+}
+
+// countChange formats a count of new things, e.g. "+1 review"
+func countChange(n int, one, many string) string {
+	if n == 1 {
+		return "+1 " + one
+	}
+	return fmt.Sprintf("+%d %s", n, many)
+}
+
+// Key identifies the item, e.g. "octo/hello#42"
+func (w *WatchedItem) Key() string {
+	return WatchKey(w.Owner, w.Repo, w.Number)
+}
+
+// WatchKey returns the key of an issue or pull request, e.g. "octo/hello#42"
+func WatchKey(owner, repo string, number int) string {
+	return fmt.Sprintf("%s/%s#%d", owner, repo, number)
+}
+
+// WatchSnapshot is the state of a watched item that activity is detected against
+type WatchSnapshot struct {
+	// State is "open", "closed" or "merged"
+	State    string `json:"state"`
+	Comments int    `json:"comments"`
+	Reviews  int    `json:"reviews"`
+	// CheckState is the combined CI state of the head commit (pull requests only)
+	CheckState CheckState `json:"check_state,omitempty"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// WatchEventKind is the kind of activity on a watched item
+type WatchEventKind string
+
+const (
+	WatchEventComment WatchEventKind = "comment"
+	WatchEventReview  WatchEventKind = "review"
+	WatchEventCI      WatchEventKind = "ci"
+	WatchEventMerged  WatchEventKind = "merged"
+	WatchEventClosed  WatchEventKind = "closed"
+)
+
+// WatchEvent is activity noticed on a watched item
+type WatchEvent struct {
+	Key  string         `json:"key"`
+	Kind WatchEventKind `json:"kind"`
+	// Text describes the activity, e.g. "2 new comments" or "CI failed"
+	Text string    `json:"text"`
+	At   time.Time `json:"at"`
+}
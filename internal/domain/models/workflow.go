@@ -0,0 +1,118 @@
+package models
+
+import "time"
+
+// WorkflowStatus represents the status of a workflow run or job
+type WorkflowStatus string
+
+const (
+	WorkflowStatusQueued     WorkflowStatus = "queued"
+	WorkflowStatusInProgress WorkflowStatus = "in_progress"
+	WorkflowStatusWaiting    WorkflowStatus = "waiting"
+	WorkflowStatusCompleted  WorkflowStatus = "completed"
+)
+
+// WorkflowConclusion represents the result of a completed workflow run or job
+type WorkflowConclusion string
+
+const (
+	WorkflowConclusionSuccess   WorkflowConclusion = "success"
+	WorkflowConclusionFailure   WorkflowConclusion = "failure"
+	WorkflowConclusionCancelled WorkflowConclusion = "cancelled"
+	WorkflowConclusionSkipped   WorkflowConclusion = "skipped"
+	WorkflowConclusionTimedOut  WorkflowConclusion = "timed_out"
+)
+
+// WorkflowRun represents a GitHub Actions workflow run
+type WorkflowRun struct {
+	ID         int64
+	Name       string
+	Title      string
+	RunNumber  int
+	RunAttempt int
+	Event      string
+	Status     WorkflowStatus
+	Conclusion WorkflowConclusion
+	HeadBranch string
+	HeadSHA    string
+	Actor      User
+	HTMLURL    string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	StartedAt  *time.Time
+}
+
+// Completed returns true if the run has finished
+func (r *WorkflowRun) Completed() bool {
+	return r.Status == WorkflowStatusCompleted
+}
+
+// Failed returns true if the run finished with failed jobs that can be re-run
+func (r *WorkflowRun) Failed() bool {
+	return r.Completed() && (r.Conclusion == WorkflowConclusionFailure || r.Conclusion == WorkflowConclusionTimedOut || r.Conclusion == WorkflowConclusionCancelled)
+}
+
+// Duration returns how long the run took, or has been running until now
+func (r *WorkflowRun) Duration(now time.Time) time.Duration {
+	start := r.CreatedAt
+	if r.StartedAt != nil {
+		start = *r.StartedAt
+	}
+	end := now
+	if r.Completed() {
+		end = r.UpdatedAt
+	}
+	if end.Before(start) {
+		return 0
+	}
+	return end.Sub(start)
+}
+
+// WorkflowJob represents a job of a workflow run
+type WorkflowJob struct {
+	ID          int64
+	RunID       int64
+	Name        string
+	Status      WorkflowStatus
+	Conclusion  WorkflowConclusion
+	HTMLURL     string
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+	Steps       []*WorkflowStep
+}
+
+// Completed returns true if the job has finished
+func (j *WorkflowJob) Completed() bool {
+	return j.Status == WorkflowStatusCompleted
+}
+
+// Duration returns how long the job took, or has been running until now
+func (j *WorkflowJob) Duration(now time.Time) time.Duration {
+	if j.StartedAt == nil {
+		return 0
+	}
+	end := now
+	if j.CompletedAt != nil {
+		end = *j.CompletedAt
+	}
+	if end.Before(*j.StartedAt) {
+		return 0
+	}
+	return end.Sub(*j.StartedAt)
+}
+
+// WorkflowStep represents a step of a workflow job
+type WorkflowStep struct {
+	Number     int64
+	Name       string
+	Status     WorkflowStatus
+	Conclusion WorkflowConclusion
+}
+
+// WorkflowRunOptions represents options for listing workflow runs
+type WorkflowRunOptions struct {
+	Branch  string
+	Status  string
+	Page    int
+	PerPage int
+}
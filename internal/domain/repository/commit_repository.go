@@ -22,4 +22,14 @@ type CommitRepository interface {
 
 	// GetBranch retrieves a single branch by name
 	GetBranch(ctx context.Context, owner, repo, branch string) (*models.Branch, error)
+
+	// GetDefaultBranch retrieves the name of the repository's default branch
+	GetDefaultBranch(ctx context.Context, owner, repo string) (string, error)
+
+	// GetFileContent retrieves the content of a file at the given ref
+	GetFileContent(ctx context.Context, owner, repo, path, ref string) (string, error)
+
+	// GetCombinedStatus retrieves the combined commit status of a ref.
+	// It returns an empty state when no status has been reported.
+	GetCombinedStatus(ctx context.Context, owner, repo, ref string) (models.CheckState, error)
 }
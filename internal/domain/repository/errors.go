@@ -0,0 +1,21 @@
+package repository
+
+import "errors"
+
+// ErrReadOnly is returned by write operations when the session cannot modify data
+// (e.g. guest mode without a GitHub token)
+var ErrReadOnly = errors.New("read-only guest session: set GITHUB_TOKEN to enable write actions")
+
+// ReadOnlyReporter is implemented by repositories that may reject write operations
+type ReadOnlyReporter interface {
+	// ReadOnly reports whether write operations are disabled
+	ReadOnly() bool
+}
+
+// IsReadOnly reports whether the given repository rejects write operations
+func IsReadOnly(repo interface{}) bool {
+	if r, ok := repo.(ReadOnlyReporter); ok {
+		return r.ReadOnly()
+	}
+	return false
+}
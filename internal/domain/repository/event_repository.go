@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// EventRepository defines the interface for reading the activity of a repository
+type EventRepository interface {
+	// ListRepositoryEvents retrieves the latest changes to the issues and pull
+	// requests of a repository, newest first. Other events are left out.
+	ListRepositoryEvents(ctx context.Context, owner, repo string) ([]*models.RepositoryEvent, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// GistRepository defines the interface for gist operations
+type GistRepository interface {
+	// List retrieves the authenticated user's gists, most recently updated first
+	List(ctx context.Context, opts *models.GistOptions) ([]*models.Gist, error)
+
+	// Get retrieves a single gist including its file contents
+	Get(ctx context.Context, id string) (*models.Gist, error)
+
+	// Create creates a new gist
+	Create(ctx context.Context, input *models.CreateGistInput) (*models.Gist, error)
+}
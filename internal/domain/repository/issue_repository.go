@@ -34,4 +34,22 @@ type IssueRepository interface {
 
 	// ListComments retrieves comments for an issue
 	ListComments(ctx context.Context, owner, repo string, number int, opts *models.CommentOptions) ([]*models.Comment, error)
+
+	// ListTimeline retrieves the events of an issue or pull request, oldest first
+	ListTimeline(ctx context.Context, owner, repo string, number int) ([]*models.TimelineEvent, error)
+
+	// GetIssueGraph retrieves the issues and pull requests an issue or pull request is directly connected to
+	GetIssueGraph(ctx context.Context, owner, repo string, number int) (*models.IssueGraph, error)
+
+	// ListTemplates retrieves the issue templates of a repository (none when it has no templates)
+	ListTemplates(ctx context.Context, owner, repo string) ([]*models.IssueTemplate, error)
+
+	// ListMilestones retrieves the open milestones of a repository, nearest due date first
+	ListMilestones(ctx context.Context, owner, repo string) ([]*models.Milestone, error)
+
+	// ListMilestoneIssues retrieves every issue (open and closed) of a milestone
+	ListMilestoneIssues(ctx context.Context, owner, repo string, number int) ([]*models.Issue, error)
+
+	// AddReaction adds a reaction to a comment on an issue
+	AddReaction(ctx context.Context, owner, repo string, number int, commentID int64, content models.ReactionContent) error
 }
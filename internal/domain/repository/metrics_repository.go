@@ -5,11 +5,19 @@ import (
 	"time"
 
 	"github.com/a1yama/tig-gh/internal/domain/models"
-	"github.com/google/go-github/v57/github"
 )
 
 // MetricsRepository はメトリクス関連のデータ取得を担当する
 type MetricsRepository interface {
 	FetchLeadTimeMetrics(ctx context.Context, repos []string, since time.Time, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error)
-	GetRateLimit(ctx context.Context) (*github.Rate, error)
+	// RefetchLeadTimeMetrics は failed のリポジトリだけを取得し直し、残りは直前の取得結果を使って計算する
+	RefetchLeadTimeMetrics(ctx context.Context, repos, failed []string, since time.Time, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error)
+	// InterruptedRun は repos の直前の取得が途中で終わっていればその記録を返す（なければ nil）
+	// 残りのリポジトリは RefetchLeadTimeMetrics に記録の Since を渡して取得する
+	InterruptedRun(repos []string) *models.InterruptedMetricsRun
+	// FetchIssueBacklog は直近 weeks 週のオープンIssue数をラベル区分ごとに集計する
+	FetchIssueBacklog(ctx context.Context, repos []string, weeks int, buckets []string, now time.Time) (*models.IssueBacklogMetrics, error)
+	// ListRecentRepositories は owner と認証ユーザーの最近更新されたリポジトリを主要言語・トピックとともに返す
+	ListRecentRepositories(ctx context.Context, owner string) ([]*models.RepositorySummary, error)
+	GetRateLimit(ctx context.Context) (*models.RateLimit, error)
 }
@@ -40,4 +40,68 @@ type PullRequestRepository interface {
 
 	// ListComments retrieves comments for a pull request
 	ListComments(ctx context.Context, owner, repo string, number int, opts *models.CommentOptions) ([]*models.Comment, error)
+
+	// ListFiles retrieves the files changed by a pull request
+	ListFiles(ctx context.Context, owner, repo string, number int) ([]*models.DiffFile, error)
+
+	// ListFilesPage retrieves one page of the files changed by a pull request, with their patches
+	ListFilesPage(ctx context.Context, owner, repo string, number, page, perPage int) ([]*models.DiffFile, error)
+
+	// ListReviewThreads retrieves review comment threads (file/line anchored) for a pull request
+	ListReviewThreads(ctx context.Context, owner, repo string, number int) ([]*models.ReviewThread, error)
+
+	// ListLinkedIssues retrieves the issues a pull request closes, linked by closing keywords or manually
+	ListLinkedIssues(ctx context.Context, owner, repo string, number int) ([]*models.LinkedIssue, error)
+
+	// ListMergeReadiness retrieves whether each of the pull requests can be merged
+	// now (approved, checks green, no conflicts), by number
+	ListMergeReadiness(ctx context.Context, owner, repo string, numbers []int) (map[int]*models.MergeReadiness, error)
+
+	// GetMergeRequirements retrieves the base branch protection rules with the state of required reviews and checks
+	GetMergeRequirements(ctx context.Context, owner, repo string, number int) (*models.MergeRequirements, error)
+
+	// ListConflictFiles retrieves the files a pull request and its base branch both
+	// changed since the head branched off, where its merge conflicts can be
+	ListConflictFiles(ctx context.Context, owner, repo string, number int) (*models.ConflictFiles, error)
+
+	// UpdateBranch merges the base branch into the head branch of a pull request.
+	// GitHub does it in the background; expectedHeadSHA guards against newer pushes.
+	UpdateBranch(ctx context.Context, owner, repo string, number int, expectedHeadSHA string) error
+
+	// CommitSuggestion commits a suggested change of a review comment to the head
+	// branch of a pull request
+	CommitSuggestion(ctx context.Context, owner, repo string, number int, input *models.SuggestionInput) error
+
+	// SetLabels replaces the labels of a pull request
+	SetLabels(ctx context.Context, owner, repo string, number int, labels []string) ([]models.Label, error)
+
+	// CreateReview submits a review (approve, request changes or comment) on a pull request
+	CreateReview(ctx context.Context, owner, repo string, number int, input *models.CreateReviewInput) (*models.Review, error)
+
+	// ConvertDraft converts a pull request to a draft (draft=true) or marks it ready for review (draft=false)
+	ConvertDraft(ctx context.Context, owner, repo string, number int, draft bool) (*models.PullRequest, error)
+
+	// ListReviewerCandidates retrieves the users and teams reviews can be requested from
+	ListReviewerCandidates(ctx context.Context, owner, repo string) (*models.ReviewerCandidates, error)
+
+	// GetCodeOwners retrieves the CODEOWNERS rules at a ref (none when the repository has no CODEOWNERS)
+	GetCodeOwners(ctx context.Context, owner, repo, ref string) (models.CodeOwners, error)
+
+	// GetChangesSinceReview retrieves the commits and files changed since the authenticated
+	// user's last submitted review of a pull request
+	GetChangesSinceReview(ctx context.Context, owner, repo string, number int) (*models.ChangesSinceReview, error)
+
+	// ListPendingReviewRequests retrieves the review requests of a pull request still
+	// waiting, with when each was made, oldest first
+	ListPendingReviewRequests(ctx context.Context, owner, repo string, number int) ([]*models.PendingReviewRequest, error)
+
+	// ListApprovals retrieves the users and teams currently approving a pull request
+	ListApprovals(ctx context.Context, owner, repo string, number int) (*models.Approvals, error)
+
+	// RequestReviewers requests reviews of a pull request from users and teams
+	RequestReviewers(ctx context.Context, owner, repo string, number int, request *models.ReviewRequest) (*models.PullRequest, error)
+
+	// ListForCommit retrieves the pull requests a commit belongs to: the PRs that merged it
+	// into the default branch, or the open PRs containing it
+	ListForCommit(ctx context.Context, owner, repo, sha string) ([]*models.PullRequest, error)
 }
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"io"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// ReleaseRepository defines the interface for release and tag operations
+type ReleaseRepository interface {
+	// List retrieves a list of releases for a repository, newest first
+	List(ctx context.Context, owner, repo string, opts *models.ReleaseOptions) ([]*models.Release, error)
+
+	// ListTags retrieves a list of tags for a repository
+	ListTags(ctx context.Context, owner, repo string, opts *models.ReleaseOptions) ([]*models.Tag, error)
+
+	// Create creates a new release
+	Create(ctx context.Context, owner, repo string, input *models.CreateReleaseInput) (*models.Release, error)
+
+	// DownloadAsset opens the contents of a release asset. The caller must close the reader.
+	DownloadAsset(ctx context.Context, owner, repo string, assetID int64) (io.ReadCloser, error)
+}
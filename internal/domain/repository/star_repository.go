@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// StarRepository defines the interface for the authenticated user's stars
+type StarRepository interface {
+	// ListStarred retrieves the starred repositories, most recently pushed first
+	ListStarred(ctx context.Context, opts *models.StarredOptions) ([]*models.StarredRepository, error)
+}
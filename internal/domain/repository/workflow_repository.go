@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// WorkflowRepository defines the interface for GitHub Actions workflow run operations
+type WorkflowRepository interface {
+	// ListRuns retrieves the recent workflow runs of a repository, newest first
+	ListRuns(ctx context.Context, owner, repo string, opts *models.WorkflowRunOptions) ([]*models.WorkflowRun, error)
+
+	// ListJobs retrieves the jobs of the latest attempt of a workflow run
+	ListJobs(ctx context.Context, owner, repo string, runID int64) ([]*models.WorkflowJob, error)
+
+	// GetJobLogs retrieves the plain text log of a job
+	GetJobLogs(ctx context.Context, owner, repo string, jobID int64) (string, error)
+
+	// RerunFailedJobs re-runs the failed jobs of a workflow run and their dependents
+	RerunFailedJobs(ctx context.Context, owner, repo string, runID int64) error
+
+	// CancelRun cancels an in-progress workflow run
+	CancelRun(ctx context.Context, owner, repo string, runID int64) error
+}
@@ -0,0 +1,125 @@
+package apiusage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// OtherSource は呼び出し元が指定されていない API 呼び出しの集計先
+const OtherSource = "other"
+
+type sourceKey struct{}
+
+// WithSource は ctx で行う API 呼び出しを source（ビューやユースケースの名前）として数える
+func WithSource(ctx context.Context, source string) context.Context {
+	if source == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, sourceKey{}, source)
+}
+
+// Source は ctx に設定された呼び出し元を返す（未設定なら OtherSource）
+func Source(ctx context.Context) string {
+	if source, ok := ctx.Value(sourceKey{}).(string); ok && source != "" {
+		return source
+	}
+	return OtherSource
+}
+
+// Counter はセッション中の API 呼び出し数を呼び出し元ごとに数え、
+// 最後にレスポンスで報告されたレート制限を覚えておく。並行に使ってよい
+type Counter struct {
+	mu       sync.Mutex
+	total    int
+	bySource map[string]int
+	rate     models.RateLimit
+}
+
+// NewCounter は空のカウンターを作成する
+func NewCounter() *Counter {
+	return &Counter{bySource: make(map[string]int)}
+}
+
+// Record は source からの呼び出しを1回数える。rate が分かっていれば残り回数を更新する
+func (c *Counter) Record(source string, rate models.RateLimit) {
+	if source == "" {
+		source = OtherSource
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.total++
+	c.bySource[source]++
+	if rate.Known {
+		c.rate = rate
+	}
+}
+
+// SourceCount は呼び出し元ごとの呼び出し数
+type SourceCount struct {
+	Source string
+	Calls  int
+}
+
+// Snapshot はある時点の API 使用状況
+type Snapshot struct {
+	Total    int
+	BySource []SourceCount // 呼び出し数の多い順
+	Rate     models.RateLimit
+}
+
+// Snapshot は現在の使用状況を返す
+func (c *Counter) Snapshot() Snapshot {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	snap := Snapshot{Total: c.total, Rate: c.rate}
+	for source, calls := range c.bySource {
+		snap.BySource = append(snap.BySource, SourceCount{Source: source, Calls: calls})
+	}
+	sort.Slice(snap.BySource, func(i, j int) bool {
+		if snap.BySource[i].Calls != snap.BySource[j].Calls {
+			return snap.BySource[i].Calls > snap.BySource[j].Calls
+		}
+		return snap.BySource[i].Source < snap.BySource[j].Source
+	})
+	return snap
+}
+
+// Calls は source からの呼び出し数を返す
+func (s Snapshot) Calls(source string) int {
+	for _, count := range s.BySource {
+		if count.Source == source {
+			return count.Calls
+		}
+	}
+	return 0
+}
+
+// Remaining は now 時点で残っていると推定されるリクエスト数を返す。
+// 最後に報告されたリセット時刻を過ぎていれば上限まで回復しているとみなす
+func (s Snapshot) Remaining(now time.Time) (int, bool) {
+	if !s.Rate.Known {
+		return 0, false
+	}
+	if !s.Rate.Reset.IsZero() && !now.Before(s.Rate.Reset) {
+		return s.Rate.Limit, true
+	}
+	return s.Rate.Remaining, true
+}
+
+// Summary はステータスバーに表示する "API 12 calls · ~4988/5000 left" 形式の文字列を返す
+func (s Snapshot) Summary(now time.Time) string {
+	noun := "calls"
+	if s.Total == 1 {
+		noun = "call"
+	}
+	summary := fmt.Sprintf("%d %s", s.Total, noun)
+	if remaining, ok := s.Remaining(now); ok {
+		summary += fmt.Sprintf(" · ~%d/%d left", remaining, s.Rate.Limit)
+	}
+	return summary
+}
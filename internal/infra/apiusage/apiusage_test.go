@@ -0,0 +1,96 @@
+package apiusage
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func TestSource(t *testing.T) {
+	ctx := context.Background()
+	if got := Source(ctx); got != OtherSource {
+		t.Errorf("Source() without a source = %q, want %q", got, OtherSource)
+	}
+	if got := Source(WithSource(ctx, "Issues")); got != "Issues" {
+		t.Errorf("Source() = %q, want Issues", got)
+	}
+	if got := Source(WithSource(WithSource(ctx, "Issues"), "")); got != "Issues" {
+		t.Errorf("an empty source should keep the parent's, got %q", got)
+	}
+}
+
+func TestCounter(t *testing.T) {
+	counter := NewCounter()
+	reset := time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			source := "Issues"
+			if i%5 == 0 {
+				source = "Metrics"
+			}
+			counter.Record(source, models.RateLimit{})
+		}(i)
+	}
+	wg.Wait()
+	counter.Record("", models.RateLimit{Known: true, Limit: 5000, Remaining: 4989, Reset: reset})
+	counter.Record("Issues", models.RateLimit{})
+
+	snap := counter.Snapshot()
+	if snap.Total != 12 {
+		t.Errorf("Total = %d, want 12", snap.Total)
+	}
+	want := []SourceCount{{"Issues", 9}, {"Metrics", 2}, {OtherSource, 1}}
+	if len(snap.BySource) != len(want) {
+		t.Fatalf("BySource = %v, want %v", snap.BySource, want)
+	}
+	for i := range want {
+		if snap.BySource[i] != want[i] {
+			t.Errorf("BySource[%d] = %v, want %v", i, snap.BySource[i], want[i])
+		}
+	}
+	if snap.Calls("Metrics") != 2 || snap.Calls("Search") != 0 {
+		t.Errorf("Calls() = %d, %d", snap.Calls("Metrics"), snap.Calls("Search"))
+	}
+	// Responses without rate limit headers keep the last known budget
+	if snap.Rate.Remaining != 4989 {
+		t.Errorf("Rate.Remaining = %d, want 4989", snap.Rate.Remaining)
+	}
+}
+
+func TestSnapshotSummary(t *testing.T) {
+	reset := time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		snap Snapshot
+		now  time.Time
+		want string
+	}{
+		{name: "no rate limit", snap: Snapshot{Total: 1}, now: reset, want: "1 call"},
+		{
+			name: "before reset",
+			snap: Snapshot{Total: 11, Rate: models.RateLimit{Known: true, Limit: 5000, Remaining: 4989, Reset: reset}},
+			now:  reset.Add(-time.Minute),
+			want: "11 calls · ~4989/5000 left",
+		},
+		{
+			name: "after reset",
+			snap: Snapshot{Total: 11, Rate: models.RateLimit{Known: true, Limit: 5000, Remaining: 4989, Reset: reset}},
+			now:  reset,
+			want: "11 calls · ~5000/5000 left",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.snap.Summary(tt.now); got != tt.want {
+				t.Errorf("Summary() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+// Package auth resolves the GitHub token used by tig-gh. The token can come
+// from the config file, the environment, the gh CLI, the OS keyring or an
+// OAuth device flow login whose token is then kept in the keyring.
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/zalando/go-keyring"
+)
+
+// Source is where a token was found
+type Source string
+
+const (
+	// SourceConfig is github.token in the config file
+	SourceConfig Source = "config"
+	// SourceEnv is the GITHUB_TOKEN or GH_TOKEN environment variable
+	SourceEnv Source = "env"
+	// SourceGH is the output of `gh auth token`
+	SourceGH Source = "gh"
+	// SourceKeyring is the token stored by `tig-gh auth login`
+	SourceKeyring Source = "keyring"
+)
+
+// DefaultSources is the order in which sources are tried when none is configured
+var DefaultSources = []Source{SourceConfig, SourceEnv, SourceGH, SourceKeyring}
+
+// keyringService is the service name tokens are stored under in the OS keyring
+const keyringService = "tig-gh"
+
+// ghTimeout bounds how long `gh auth token` may take
+const ghTimeout = 5 * time.Second
+
+// Token is a resolved token together with where it came from
+type Token struct {
+	Value  string
+	Source Source
+}
+
+// Keyring stores secrets in the OS keychain
+type Keyring interface {
+	Get(service, user string) (string, error)
+	Set(service, user, secret string) error
+	Delete(service, user string) error
+}
+
+// osKeyring is the keyring of the current platform
+type osKeyring struct{}
+
+func (osKeyring) Get(service, user string) (string, error) { return keyring.Get(service, user) }
+func (osKeyring) Set(service, user, secret string) error   { return keyring.Set(service, user, secret) }
+func (osKeyring) Delete(service, user string) error        { return keyring.Delete(service, user) }
+
+// Resolver finds the token for a GitHub host by trying the configured
+// sources in order
+type Resolver struct {
+	configToken string
+	host        string
+	account     string
+	sources     []Source
+	getenv      func(string) string
+	runGH       func(ctx context.Context, host string) (string, error)
+	keyring     Keyring
+}
+
+// NewResolver creates a resolver for the GitHub settings of the config
+func NewResolver(cfg models.GitHubConfig) *Resolver {
+	sources := make([]Source, 0, len(cfg.AuthSources))
+	for _, name := range cfg.AuthSources {
+		sources = append(sources, Source(name))
+	}
+	if len(sources) == 0 {
+		sources = DefaultSources
+	}
+
+	host := HostFromAPIBaseURL(cfg.APIBaseURL)
+	return &Resolver{
+		configToken: cfg.Token,
+		host:        host,
+		account:     host,
+		sources:     sources,
+		getenv:      os.Getenv,
+		runGH:       ghAuthToken,
+		keyring:     osKeyring{},
+	}
+}
+
+// WithProfile keeps the keyring token of a named profile apart from other
+// profiles on the same host
+func (r *Resolver) WithProfile(name string) *Resolver {
+	if name != "" && name != models.DefaultProfileName {
+		r.account = name + "@" + r.host
+	}
+	return r
+}
+
+// Host returns the GitHub host the resolver looks up tokens for
+func (r *Resolver) Host() string {
+	return r.host
+}
+
+// Resolve returns the first token found, or an empty Token when no source has one.
+// Sources that fail (gh not installed, no keyring service) are skipped.
+func (r *Resolver) Resolve(ctx context.Context) Token {
+	for _, source := range r.sources {
+		if value := strings.TrimSpace(r.lookup(ctx, source)); value != "" {
+			return Token{Value: value, Source: source}
+		}
+	}
+	return Token{}
+}
+
+// lookup reads the token of a single source
+func (r *Resolver) lookup(ctx context.Context, source Source) string {
+	switch source {
+	case SourceConfig:
+		return r.configToken
+	case SourceEnv:
+		if token := r.getenv("GITHUB_TOKEN"); token != "" {
+			return token
+		}
+		return r.getenv("GH_TOKEN")
+	case SourceGH:
+		token, err := r.runGH(ctx, r.host)
+		if err != nil {
+			return ""
+		}
+		return token
+	case SourceKeyring:
+		token, err := r.keyring.Get(keyringService, r.account)
+		if err != nil {
+			return ""
+		}
+		return token
+	default:
+		return ""
+	}
+}
+
+// Store saves a token in the OS keyring
+func (r *Resolver) Store(token string) error {
+	if err := r.keyring.Set(keyringService, r.account, token); err != nil {
+		return fmt.Errorf("failed to store the token in the keyring: %w", err)
+	}
+	return nil
+}
+
+// Forget removes the token stored in the OS keyring. It is not an error
+// if no token is stored.
+func (r *Resolver) Forget() error {
+	err := r.keyring.Delete(keyringService, r.account)
+	if err != nil && !errors.Is(err, keyring.ErrNotFound) {
+		return fmt.Errorf("failed to remove the token from the keyring: %w", err)
+	}
+	return nil
+}
+
+// HostFromAPIBaseURL returns the web host of a GitHub API base URL:
+// "github.com" for api.github.com, or the host of a GitHub Enterprise server
+func HostFromAPIBaseURL(apiBaseURL string) string {
+	u, err := url.Parse(strings.TrimSpace(apiBaseURL))
+	if err != nil || u.Host == "" || u.Host == "api.github.com" {
+		return "github.com"
+	}
+	return u.Host
+}
+
+// ghAuthToken asks the gh CLI for the token it has stored for host
+func ghAuthToken(ctx context.Context, host string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, ghTimeout)
+	defer cancel()
+
+	out, err := exec.CommandContext(ctx, "gh", "auth", "token", "--hostname", host).Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
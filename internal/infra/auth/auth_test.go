@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/zalando/go-keyring"
+)
+
+// fakeKeyring is an in-memory keyring
+type fakeKeyring map[string]string
+
+func (k fakeKeyring) Get(service, user string) (string, error) {
+	if secret, ok := k[service+"/"+user]; ok {
+		return secret, nil
+	}
+	return "", keyring.ErrNotFound
+}
+
+func (k fakeKeyring) Set(service, user, secret string) error {
+	k[service+"/"+user] = secret
+	return nil
+}
+
+func (k fakeKeyring) Delete(service, user string) error {
+	if _, ok := k[service+"/"+user]; !ok {
+		return keyring.ErrNotFound
+	}
+	delete(k, service+"/"+user)
+	return nil
+}
+
+func newTestResolver(cfg models.GitHubConfig, env map[string]string, gh string, ring fakeKeyring) *Resolver {
+	r := NewResolver(cfg)
+	r.getenv = func(key string) string { return env[key] }
+	r.runGH = func(ctx context.Context, host string) (string, error) {
+		if gh == "" {
+			return "", errors.New("gh: not logged in")
+		}
+		return gh + "@" + host, nil
+	}
+	r.keyring = ring
+	return r
+}
+
+func TestResolver_Resolve(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  models.GitHubConfig
+		env  map[string]string
+		gh   string
+		ring fakeKeyring
+		want Token
+	}{
+		{
+			name: "config first",
+			cfg:  models.GitHubConfig{Token: "cfg"},
+			env:  map[string]string{"GITHUB_TOKEN": "env"},
+			want: Token{Value: "cfg", Source: SourceConfig},
+		},
+		{
+			name: "GH_TOKEN when GITHUB_TOKEN is unset",
+			env:  map[string]string{"GH_TOKEN": "gh-env"},
+			gh:   "cli",
+			want: Token{Value: "gh-env", Source: SourceEnv},
+		},
+		{
+			name: "gh CLI for the API host",
+			cfg:  models.GitHubConfig{APIBaseURL: "https://ghe.example.com/api/v3/"},
+			gh:   "cli",
+			ring: fakeKeyring{"tig-gh/ghe.example.com": "stored"},
+			want: Token{Value: "cli@ghe.example.com", Source: SourceGH},
+		},
+		{
+			name: "keyring when gh fails",
+			ring: fakeKeyring{"tig-gh/github.com": "stored"},
+			want: Token{Value: "stored", Source: SourceKeyring},
+		},
+		{
+			name: "configured order",
+			cfg:  models.GitHubConfig{Token: "cfg", AuthSources: []string{"keyring", "config"}},
+			ring: fakeKeyring{"tig-gh/github.com": "stored"},
+			want: Token{Value: "stored", Source: SourceKeyring},
+		},
+		{
+			name: "nothing found",
+			cfg:  models.GitHubConfig{Token: "cfg", AuthSources: []string{"env"}},
+			want: Token{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ring := tt.ring
+			if ring == nil {
+				ring = fakeKeyring{}
+			}
+			got := newTestResolver(tt.cfg, tt.env, tt.gh, ring).Resolve(context.Background())
+			if got != tt.want {
+				t.Errorf("Resolve() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolver_StoreAndForget(t *testing.T) {
+	ring := fakeKeyring{}
+	r := newTestResolver(models.GitHubConfig{}, nil, "", ring)
+
+	if err := r.Store("secret"); err != nil {
+		t.Fatalf("Store() error = %v", err)
+	}
+	if got := r.Resolve(context.Background()); got.Value != "secret" || got.Source != SourceKeyring {
+		t.Errorf("Resolve() = %+v, want the stored token", got)
+	}
+
+	if err := r.Forget(); err != nil {
+		t.Fatalf("Forget() error = %v", err)
+	}
+	if err := r.Forget(); err != nil {
+		t.Errorf("Forget() without a stored token error = %v", err)
+	}
+	if got := r.Resolve(context.Background()); got.Value != "" {
+		t.Errorf("Resolve() = %+v, want no token", got)
+	}
+}
+
+func TestHostFromAPIBaseURL(t *testing.T) {
+	for input, want := range map[string]string{
+		"https://api.github.com/":             "github.com",
+		"":                                    "github.com",
+		"https://ghe.example.com/api/v3/":     "ghe.example.com",
+		"https://ghe.example.com:8443/api/v3": "ghe.example.com:8443",
+	} {
+		if got := HostFromAPIBaseURL(input); got != want {
+			t.Errorf("HostFromAPIBaseURL(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultScopes are the OAuth scopes requested by the device flow login
+var DefaultScopes = []string{"repo", "read:org", "gist"}
+
+var (
+	// ErrAccessDenied is returned when the user declines the authorization
+	ErrAccessDenied = errors.New("authorization was denied")
+	// ErrCodeExpired is returned when the user code expires before it is entered
+	ErrCodeExpired = errors.New("the device code has expired")
+)
+
+// DeviceCode is the code the user enters on the verification page
+type DeviceCode struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURI string `json:"verification_uri"`
+	ExpiresIn       int    `json:"expires_in"`
+	Interval        int    `json:"interval"`
+}
+
+// DeviceFlow implements the OAuth device authorization flow of GitHub
+// (https://docs.github.com/apps/oauth-apps/building-oauth-apps/authorizing-oauth-apps#device-flow)
+type DeviceFlow struct {
+	clientID   string
+	baseURL    string
+	scopes     []string
+	httpClient *http.Client
+	sleep      func(ctx context.Context, d time.Duration) error
+}
+
+// NewDeviceFlow creates a device flow for the OAuth app clientID on host
+func NewDeviceFlow(clientID, host string) *DeviceFlow {
+	return &DeviceFlow{
+		clientID:   clientID,
+		baseURL:    "https://" + host,
+		scopes:     DefaultScopes,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		sleep:      sleepContext,
+	}
+}
+
+// RequestCode starts the flow and returns the code to show to the user
+func (f *DeviceFlow) RequestCode(ctx context.Context) (*DeviceCode, error) {
+	var code DeviceCode
+	err := f.post(ctx, "/login/device/code", url.Values{
+		"client_id": {f.clientID},
+		"scope":     {strings.Join(f.scopes, " ")},
+	}, &code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request a device code: %w", err)
+	}
+	if code.DeviceCode == "" || code.UserCode == "" {
+		return nil, fmt.Errorf("failed to request a device code: empty response")
+	}
+	return &code, nil
+}
+
+// PollToken waits until the user has entered the code and returns the access token
+func (f *DeviceFlow) PollToken(ctx context.Context, code *DeviceCode) (string, error) {
+	interval := time.Duration(code.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	for {
+		if err := f.sleep(ctx, interval); err != nil {
+			return "", err
+		}
+
+		var resp struct {
+			AccessToken string `json:"access_token"`
+			Error       string `json:"error"`
+			Description string `json:"error_description"`
+			Interval    int    `json:"interval"`
+		}
+		err := f.post(ctx, "/login/oauth/access_token", url.Values{
+			"client_id":   {f.clientID},
+			"device_code": {code.DeviceCode},
+			"grant_type":  {"urn:ietf:params:oauth:grant-type:device_code"},
+		}, &resp)
+		if err != nil {
+			return "", fmt.Errorf("failed to poll for the access token: %w", err)
+		}
+
+		switch resp.Error {
+		case "":
+			if resp.AccessToken == "" {
+				return "", fmt.Errorf("failed to poll for the access token: empty response")
+			}
+			return resp.AccessToken, nil
+		case "authorization_pending":
+		case "slow_down":
+			if resp.Interval > 0 {
+				interval = time.Duration(resp.Interval) * time.Second
+			} else {
+				interval += 5 * time.Second
+			}
+		case "expired_token":
+			return "", ErrCodeExpired
+		case "access_denied":
+			return "", ErrAccessDenied
+		default:
+			return "", fmt.Errorf("device flow failed: %s: %s", resp.Error, resp.Description)
+		}
+	}
+}
+
+// post sends a form to the GitHub web host and decodes the JSON response
+func (f *DeviceFlow) post(ctx context.Context, path string, form url.Values, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.baseURL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// Login runs the device flow: it prints the code, tries to open the
+// verification page, waits for the authorization and stores the token in
+// the keyring. A token that cannot be stored is still returned, with a warning.
+func Login(ctx context.Context, flow *DeviceFlow, resolver *Resolver, out io.Writer, open func(string) error) (Token, error) {
+	code, err := flow.RequestCode(ctx)
+	if err != nil {
+		return Token{}, err
+	}
+
+	fmt.Fprintf(out, "First copy your one-time code: %s\n", code.UserCode)
+	if open != nil && open(code.VerificationURI) == nil {
+		fmt.Fprintf(out, "Opened %s in your browser; paste the code there.\n", code.VerificationURI)
+	} else {
+		fmt.Fprintf(out, "Then open %s in your browser and paste the code.\n", code.VerificationURI)
+	}
+	fmt.Fprintf(out, "Waiting for authorization...\n")
+
+	token, err := flow.PollToken(ctx, code)
+	if err != nil {
+		return Token{}, err
+	}
+
+	if err := resolver.Store(token); err != nil {
+		fmt.Fprintf(out, "Warning: %v; the token is only used for this session.\n", err)
+	}
+	fmt.Fprintf(out, "Logged in to %s.\n", resolver.Host())
+	return Token{Value: token, Source: SourceKeyring}, nil
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// newTestDeviceFlow returns a device flow against a test server answering
+// the token polls with the given responses in order
+func newTestDeviceFlow(t *testing.T, polls ...string) (*DeviceFlow, *[]time.Duration) {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if r.Form.Get("client_id") != "client" {
+			t.Errorf("client_id = %q", r.Form.Get("client_id"))
+		}
+		switch r.URL.Path {
+		case "/login/device/code":
+			if r.Form.Get("scope") != "repo read:org gist" {
+				t.Errorf("scope = %q", r.Form.Get("scope"))
+			}
+			_, _ = w.Write([]byte(`{"device_code":"dev","user_code":"ABCD-1234","verification_uri":"https://github.com/login/device","expires_in":900,"interval":5}`))
+		case "/login/oauth/access_token":
+			if r.Form.Get("device_code") != "dev" {
+				t.Errorf("device_code = %q", r.Form.Get("device_code"))
+			}
+			if len(polls) == 0 {
+				t.Fatal("unexpected poll")
+			}
+			_, _ = w.Write([]byte(polls[0]))
+			polls = polls[1:]
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	var waits []time.Duration
+	flow := NewDeviceFlow("client", "github.com")
+	flow.baseURL = server.URL
+	flow.sleep = func(ctx context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+	return flow, &waits
+}
+
+func TestLogin_StoresToken(t *testing.T) {
+	flow, waits := newTestDeviceFlow(t,
+		`{"error":"authorization_pending"}`,
+		`{"error":"slow_down","interval":10}`,
+		`{"access_token":"gho_token","token_type":"bearer"}`,
+	)
+	ring := fakeKeyring{}
+	resolver := newTestResolver(models.GitHubConfig{}, nil, "", ring)
+
+	var out bytes.Buffer
+	var opened string
+	token, err := Login(context.Background(), flow, resolver, &out, func(url string) error {
+		opened = url
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+
+	if token.Value != "gho_token" || ring["tig-gh/github.com"] != "gho_token" {
+		t.Errorf("token = %+v, keyring = %v, want the token stored", token, ring)
+	}
+	if opened != "https://github.com/login/device" || !strings.Contains(out.String(), "ABCD-1234") {
+		t.Errorf("opened %q, output %q, want the code shown and the page opened", opened, out.String())
+	}
+	if want := []time.Duration{5 * time.Second, 5 * time.Second, 10 * time.Second}; !reflect.DeepEqual(*waits, want) {
+		t.Errorf("waits = %v, want %v", *waits, want)
+	}
+}
+
+func TestDeviceFlow_PollErrors(t *testing.T) {
+	tests := []struct {
+		response string
+		want     error
+	}{
+		{response: `{"error":"access_denied"}`, want: ErrAccessDenied},
+		{response: `{"error":"expired_token"}`, want: ErrCodeExpired},
+	}
+	for _, tt := range tests {
+		flow, _ := newTestDeviceFlow(t, tt.response)
+		code, err := flow.RequestCode(context.Background())
+		if err != nil {
+			t.Fatalf("RequestCode() error = %v", err)
+		}
+		if _, err := flow.PollToken(context.Background(), code); !errors.Is(err, tt.want) {
+			t.Errorf("PollToken() error = %v, want %v", err, tt.want)
+		}
+	}
+}
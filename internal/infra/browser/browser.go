@@ -0,0 +1,155 @@
+package browser
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// ErrNoBrowser is returned when no browser can be launched in the current
+// session (e.g. over SSH without $BROWSER). Callers should show the URL to
+// the user instead.
+var ErrNoBrowser = errors.New("no browser available in this session")
+
+// launchGrace is how long a launched opener is watched for an early failure.
+// xdg-open exits non-zero at once when no handler is configured; a browser
+// still running after this is assumed to have opened the URL.
+const launchGrace = 2 * time.Second
+
+// Launcher opens URLs using $BROWSER or the platform's default handler
+type Launcher struct {
+	goos     string
+	getenv   func(string) string
+	start    func(name string, args ...string) error
+	lookPath func(string) (string, error) // nil skips the check for the platform opener
+}
+
+// NewLauncher creates a launcher for the current platform and environment
+func NewLauncher() *Launcher {
+	return &Launcher{
+		goos:     runtime.GOOS,
+		getenv:   os.Getenv,
+		start:    startAndWatch,
+		lookPath: exec.LookPath,
+	}
+}
+
+// startAndWatch starts the command and reports a failure if it exits with an
+// error within launchGrace, so a missing URL handler is not silently ignored
+func startAndWatch(name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("%s failed: %w", name, err)
+		}
+		return nil
+	case <-time.After(launchGrace):
+		return nil
+	}
+}
+
+var defaultLauncher = NewLauncher()
+
+// Open opens the specified URL in the default browser
+func Open(url string) error {
+	return defaultLauncher.Open(url)
+}
+
+// Open opens the specified URL.
+// $BROWSER takes precedence; in SSH sessions or Linux sessions without a
+// display, ErrNoBrowser is returned so the caller can print the URL.
+func (l *Launcher) Open(url string) error {
+	if url == "" {
+		return fmt.Errorf("no URL to open")
+	}
+
+	if name, args, ok := l.browserEnvCommand(url); ok {
+		return l.start(name, args...)
+	}
+
+	if l.isHeadless() {
+		return ErrNoBrowser
+	}
+
+	name, args, err := openCommand(l.goos, url)
+	if err != nil {
+		return err
+	}
+	if l.lookPath != nil {
+		// e.g. a devcontainer or minimal image without xdg-utils
+		if _, err := l.lookPath(name); err != nil {
+			return ErrNoBrowser
+		}
+	}
+	return l.start(name, args...)
+}
+
+// browserEnvCommand builds a command from $BROWSER.
+// Like xdg-utils, the variable may list several commands separated by ':'
+// (only the first is used) and may contain "%s" as the URL placeholder.
+func (l *Launcher) browserEnvCommand(url string) (string, []string, bool) {
+	value := strings.TrimSpace(l.getenv("BROWSER"))
+	if value == "" {
+		return "", nil, false
+	}
+
+	if l.goos != "windows" {
+		value = strings.Split(value, ":")[0]
+	}
+
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return "", nil, false
+	}
+
+	replaced := false
+	for i, field := range fields {
+		if strings.Contains(field, "%s") {
+			fields[i] = strings.ReplaceAll(field, "%s", url)
+			replaced = true
+		}
+	}
+	if !replaced {
+		fields = append(fields, url)
+	}
+
+	return fields[0], fields[1:], true
+}
+
+// isHeadless reports whether the session cannot display a local browser
+func (l *Launcher) isHeadless() bool {
+	if l.getenv("SSH_CONNECTION") != "" || l.getenv("SSH_TTY") != "" {
+		return true
+	}
+	switch l.goos {
+	case "linux", "freebsd", "openbsd", "netbsd":
+		return l.getenv("DISPLAY") == "" && l.getenv("WAYLAND_DISPLAY") == ""
+	default:
+		return false
+	}
+}
+
+// openCommand returns the command used to open a URL on the given platform
+func openCommand(platform, url string) (string, []string, error) {
+	switch platform {
+	case "darwin":
+		return "open", []string{url}, nil
+	case "linux", "freebsd", "openbsd", "netbsd":
+		return "xdg-open", []string{url}, nil
+	case "windows":
+		// rundll32 avoids cmd.exe's interpretation of '&' in query strings
+		return "rundll32", []string{"url.dll,FileProtocolHandler", url}, nil
+	default:
+		return "", nil, fmt.Errorf("unsupported platform: %s", platform)
+	}
+}
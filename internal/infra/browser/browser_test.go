@@ -0,0 +1,148 @@
+package browser
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+const testURL = "https://github.com/owner/repo/pull/1?a=1&b=2"
+
+func TestOpenCommand(t *testing.T) {
+	tests := []struct {
+		platform string
+		wantName string
+		wantArgs []string
+		wantErr  bool
+	}{
+		{platform: "darwin", wantName: "open", wantArgs: []string{testURL}},
+		{platform: "linux", wantName: "xdg-open", wantArgs: []string{testURL}},
+		{platform: "windows", wantName: "rundll32", wantArgs: []string{"url.dll,FileProtocolHandler", testURL}},
+		{platform: "plan9", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.platform, func(t *testing.T) {
+			name, args, err := openCommand(tt.platform, testURL)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if name != tt.wantName || !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("openCommand() = %s %v, want %s %v", name, args, tt.wantName, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestLauncher_Open(t *testing.T) {
+	tests := []struct {
+		name     string
+		goos     string
+		env      map[string]string
+		wantName string
+		wantArgs []string
+		wantErr  error
+	}{
+		{
+			name:     "linux desktop",
+			goos:     "linux",
+			env:      map[string]string{"DISPLAY": ":0"},
+			wantName: "xdg-open",
+			wantArgs: []string{testURL},
+		},
+		{
+			name:    "linux without display",
+			goos:    "linux",
+			env:     map[string]string{},
+			wantErr: ErrNoBrowser,
+		},
+		{
+			name:    "ssh session",
+			goos:    "darwin",
+			env:     map[string]string{"SSH_CONNECTION": "1.2.3.4 22 5.6.7.8 22"},
+			wantErr: ErrNoBrowser,
+		},
+		{
+			name:     "BROWSER overrides ssh fallback",
+			goos:     "linux",
+			env:      map[string]string{"SSH_TTY": "/dev/pts/0", "BROWSER": "w3m"},
+			wantName: "w3m",
+			wantArgs: []string{testURL},
+		},
+		{
+			name:     "BROWSER with placeholder and list",
+			goos:     "linux",
+			env:      map[string]string{"BROWSER": "firefox --new-tab %s:chromium"},
+			wantName: "firefox",
+			wantArgs: []string{"--new-tab", testURL},
+		},
+		{
+			name:     "windows default",
+			goos:     "windows",
+			env:      map[string]string{},
+			wantName: "rundll32",
+			wantArgs: []string{"url.dll,FileProtocolHandler", testURL},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotName string
+			var gotArgs []string
+			l := &Launcher{
+				goos:   tt.goos,
+				getenv: func(key string) string { return tt.env[key] },
+				start: func(name string, args ...string) error {
+					gotName, gotArgs = name, args
+					return nil
+				},
+			}
+
+			err := l.Open(testURL)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				if gotName != "" {
+					t.Fatalf("expected no command to run, got %s", gotName)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gotName != tt.wantName || !reflect.DeepEqual(gotArgs, tt.wantArgs) {
+				t.Errorf("ran %s %v, want %s %v", gotName, gotArgs, tt.wantName, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestLauncher_OpenEmptyURL(t *testing.T) {
+	l := NewLauncher()
+	if err := l.Open(""); err == nil {
+		t.Fatal("expected error for empty URL")
+	}
+}
+
+func TestLauncher_OpenWithoutOpenerCommand(t *testing.T) {
+	l := &Launcher{
+		goos:     "linux",
+		getenv:   func(key string) string { return map[string]string{"DISPLAY": ":0"}[key] },
+		lookPath: func(string) (string, error) { return "", errors.New("not found") },
+		start: func(name string, args ...string) error {
+			t.Fatalf("expected %s not to run", name)
+			return nil
+		},
+	}
+
+	if err := l.Open(testURL); !errors.Is(err, ErrNoBrowser) {
+		t.Fatalf("expected ErrNoBrowser, got %v", err)
+	}
+}
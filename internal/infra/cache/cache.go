@@ -3,6 +3,7 @@ package cache
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/a1yama/tig-gh/internal/domain/repository"
@@ -27,6 +28,7 @@ type Cache struct {
 	file         repository.CacheService
 	config       *Config
 	keyGenerator KeyGenerator
+	fileOnce     sync.Once
 }
 
 // NewCache 新しいCacheを作成
@@ -80,8 +82,8 @@ func NewCacheWithConfig(config *Config) (repository.CacheService, error) {
 		}
 	}
 
-	// ファイルキャッシュの初期化
-	if config.FileEnabled {
+	// ファイルキャッシュの初期化（LazyFileInit の場合は初回アクセス時）
+	if config.FileEnabled && !config.LazyFileInit {
 		var err error
 		c.file, err = NewFileCache(config.FileDir)
 		if err != nil {
@@ -92,6 +94,19 @@ func NewCacheWithConfig(config *Config) (repository.CacheService, error) {
 	return c, nil
 }
 
+// fileLayer はファイルキャッシュを返す。LazyFileInit の場合は初回呼び出しで作成し、
+// ディレクトリを作成できなければメモリキャッシュのみで動作する
+func (c *Cache) fileLayer() repository.CacheService {
+	if c.config.FileEnabled && c.config.LazyFileInit {
+		c.fileOnce.Do(func() {
+			if file, err := NewFileCache(c.config.FileDir); err == nil {
+				c.file = file
+			}
+		})
+	}
+	return c.file
+}
+
 // Get キーに対応する値を取得
 // メモリキャッシュ → ファイルキャッシュの順で検索
 func (c *Cache) Get(key string) (interface{}, bool) {
@@ -103,8 +118,8 @@ func (c *Cache) Get(key string) (interface{}, bool) {
 	}
 
 	// ファイルキャッシュから取得を試みる
-	if c.file != nil {
-		if value, ok := c.file.Get(key); ok {
+	if file := c.fileLayer(); file != nil {
+		if value, ok := file.Get(key); ok {
 			// ファイルキャッシュから取得した場合、メモリキャッシュにも保存
 			if c.memory != nil && c.config.MemoryEnabled {
 				// メモリキャッシュのデフォルトTTLを使用
@@ -143,8 +158,8 @@ func (c *Cache) Set(key string, value interface{}, ttl time.Duration) error {
 	}
 
 	// ファイルキャッシュに保存
-	if c.file != nil {
-		if err := c.file.Set(key, value, ttl); err != nil {
+	if file := c.fileLayer(); file != nil {
+		if err := file.Set(key, value, ttl); err != nil {
 			lastErr = err
 		}
 	}
@@ -189,8 +204,8 @@ func (c *Cache) SetWithContext(ctx context.Context, key string, value interface{
 	}
 
 	// ファイルキャッシュに保存
-	if c.file != nil && c.config.FileEnabled {
-		if err := c.file.Set(key, value, fileTTL); err != nil {
+	if file := c.fileLayer(); file != nil && c.config.FileEnabled {
+		if err := file.Set(key, value, fileTTL); err != nil {
 			lastErr = err
 		}
 	}
@@ -216,8 +231,8 @@ func (c *Cache) Delete(key string) error {
 	}
 
 	// ファイルキャッシュから削除
-	if c.file != nil {
-		if err := c.file.Delete(key); err != nil {
+	if file := c.fileLayer(); file != nil {
+		if err := file.Delete(key); err != nil {
 			lastErr = err
 		}
 	}
@@ -237,8 +252,8 @@ func (c *Cache) Clear() error {
 	}
 
 	// ファイルキャッシュをクリア
-	if c.file != nil {
-		if err := c.file.Clear(); err != nil {
+	if file := c.fileLayer(); file != nil {
+		if err := file.Clear(); err != nil {
 			lastErr = err
 		}
 	}
@@ -1,9 +1,9 @@
 package cache
 
 import (
-	"os"
-	"path/filepath"
 	"time"
+
+	"github.com/a1yama/tig-gh/internal/infra/paths"
 )
 
 // Config キャッシュの設定
@@ -16,6 +16,9 @@ type Config struct {
 	FileEnabled bool
 	FileDir     string
 	FileTTL     time.Duration
+	// LazyFileInit はファイルキャッシュのディレクトリ作成を初回アクセスまで遅らせる
+	// （起動直後の描画を遅いディスクで待たせないため）
+	LazyFileInit bool
 
 	// Cleanup settings
 	CleanupInterval time.Duration
@@ -26,8 +29,7 @@ type Config struct {
 
 // DefaultConfig デフォルトのキャッシュ設定を返す
 func DefaultConfig() *Config {
-	homeDir, _ := os.UserHomeDir()
-	cacheDir := filepath.Join(homeDir, ".cache", "tig-gh")
+	cacheDir, _ := paths.CacheDir()
 
 	return &Config{
 		// Memory cache: 5分間有効
@@ -71,6 +73,12 @@ func (c *Config) WithCleanupInterval(interval time.Duration) *Config {
 	return c
 }
 
+// WithLazyFileInit ファイルキャッシュの初期化を初回アクセスまで遅延する
+func (c *Config) WithLazyFileInit() *Config {
+	c.LazyFileInit = true
+	return c
+}
+
 // DisableMemoryCache メモリキャッシュを無効化
 func (c *Config) DisableMemoryCache() *Config {
 	c.MemoryEnabled = false
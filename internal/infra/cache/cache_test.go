@@ -2,6 +2,8 @@ package cache
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -470,3 +472,35 @@ func TestCache_SetWithContext_CustomTTL(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, "test-value", value)
 }
+
+func TestNewCacheWithConfig_LazyFileInit(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "cache")
+	config := DefaultConfig().WithFileDir(dir).WithLazyFileInit()
+
+	cache, err := NewCacheWithConfig(config)
+	require.NoError(t, err)
+	_, err = os.Stat(dir)
+	assert.True(t, os.IsNotExist(err), "ディレクトリは初回アクセスまで作成されないべき")
+
+	require.NoError(t, cache.Set("test-key", "test-value", time.Minute))
+	_, err = os.Stat(dir)
+	require.NoError(t, err, "初回アクセスでディレクトリが作成されるべき")
+
+	value, ok := cache.(*Cache).file.Get("test-key")
+	require.True(t, ok)
+	assert.Equal(t, "test-value", value)
+}
+
+func TestNewCacheWithConfig_LazyFileInitFallsBackToMemory(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "not-a-dir")
+	require.NoError(t, os.WriteFile(file, nil, 0o644))
+	config := DefaultConfig().WithFileDir(filepath.Join(file, "cache")).WithLazyFileInit()
+
+	cache, err := NewCacheWithConfig(config)
+	require.NoError(t, err)
+
+	require.NoError(t, cache.Set("test-key", "test-value", time.Minute))
+	value, ok := cache.Get("test-key")
+	require.True(t, ok, "ディレクトリを作成できなくてもメモリキャッシュは使えるべき")
+	assert.Equal(t, "test-value", value)
+}
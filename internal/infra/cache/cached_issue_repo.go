@@ -186,3 +186,114 @@ func (r *CachedIssueRepository) ListComments(ctx context.Context, owner, repo st
 
 	return comments, nil
 }
+
+// ListTimeline retrieves the events of an issue or pull request with caching
+func (r *CachedIssueRepository) ListTimeline(ctx context.Context, owner, repo string, number int) ([]*models.TimelineEvent, error) {
+	key := r.cache.GenerateKey("issues:timeline", owner, repo, number)
+
+	if cached, ok := r.cache.GetWithContext(ctx, key); ok {
+		if events, ok := cached.([]*models.TimelineEvent); ok {
+			return events, nil
+		}
+	}
+
+	events, err := r.repo.ListTimeline(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	if events == nil {
+		events = []*models.TimelineEvent{}
+	}
+
+	_ = r.cache.SetWithContext(ctx, key, events, 0)
+
+	return events, nil
+}
+
+// GetIssueGraph retrieves the issues an issue is connected to with caching
+func (r *CachedIssueRepository) GetIssueGraph(ctx context.Context, owner, repo string, number int) (*models.IssueGraph, error) {
+	key := r.cache.GenerateKey("issues:graph", owner, repo, number)
+
+	if cached, ok := r.cache.GetWithContext(ctx, key); ok {
+		if graph, ok := cached.(*models.IssueGraph); ok {
+			return graph, nil
+		}
+	}
+
+	graph, err := r.repo.GetIssueGraph(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = r.cache.SetWithContext(ctx, key, graph, 0)
+
+	return graph, nil
+}
+
+// ListTemplates retrieves the issue templates (not cached: they are only read when creating an issue)
+func (r *CachedIssueRepository) ListTemplates(ctx context.Context, owner, repo string) ([]*models.IssueTemplate, error) {
+	return r.repo.ListTemplates(ctx, owner, repo)
+}
+
+// ListMilestones retrieves the open milestones with caching
+func (r *CachedIssueRepository) ListMilestones(ctx context.Context, owner, repo string) ([]*models.Milestone, error) {
+	key := r.cache.GenerateKey("issues:milestones", owner, repo)
+
+	if cached, ok := r.cache.GetWithContext(ctx, key); ok {
+		if milestones, ok := cached.([]*models.Milestone); ok {
+			return milestones, nil
+		}
+	}
+
+	milestones, err := r.repo.ListMilestones(ctx, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	if milestones == nil {
+		milestones = []*models.Milestone{}
+	}
+
+	_ = r.cache.SetWithContext(ctx, key, milestones, 0)
+
+	return milestones, nil
+}
+
+// ListMilestoneIssues retrieves the issues of a milestone with caching
+func (r *CachedIssueRepository) ListMilestoneIssues(ctx context.Context, owner, repo string, number int) ([]*models.Issue, error) {
+	key := r.cache.GenerateKey("issues:milestone", owner, repo, number)
+
+	if cached, ok := r.cache.GetWithContext(ctx, key); ok {
+		if issues, ok := cached.([]*models.Issue); ok {
+			return issues, nil
+		}
+	}
+
+	issues, err := r.repo.ListMilestoneIssues(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	if issues == nil {
+		issues = []*models.Issue{}
+	}
+
+	_ = r.cache.SetWithContext(ctx, key, issues, 0)
+
+	return issues, nil
+}
+
+// AddReaction adds a reaction to a comment (invalidates caches)
+func (r *CachedIssueRepository) AddReaction(ctx context.Context, owner, repo string, number int, commentID int64, content models.ReactionContent) error {
+	err := r.repo.AddReaction(ctx, owner, repo, number, commentID, content)
+	if err != nil {
+		return err
+	}
+
+	// Invalidate the comments cache for the default options
+	key := r.cache.GenerateKey("issues:comments", owner, repo, number, (*models.CommentOptions)(nil))
+	_ = r.cache.Delete(key)
+
+	return nil
+}
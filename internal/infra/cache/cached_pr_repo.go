@@ -2,6 +2,8 @@ package cache
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"github.com/a1yama/tig-gh/internal/domain/models"
 	"github.com/a1yama/tig-gh/internal/domain/repository"
@@ -145,6 +147,40 @@ func (r *CachedPullRequestRepository) Reopen(ctx context.Context, owner, repo st
 	return nil
 }
 
+// UpdateBranch merges the base branch into a pull request (invalidates caches)
+func (r *CachedPullRequestRepository) UpdateBranch(ctx context.Context, owner, repo string, number int, expectedHeadSHA string) error {
+	err := r.repo.UpdateBranch(ctx, owner, repo, number, expectedHeadSHA)
+	if err != nil {
+		return err
+	}
+
+	// Invalidate the PR, its files and its conflicts
+	_ = r.cache.Delete(r.cache.GenerateKey("prs:get", owner, repo, number))
+	_ = r.cache.Delete(r.cache.GenerateKey("prs:files", owner, repo, number))
+	r.invalidateFilesPages(owner, repo, number)
+	_ = r.cache.Delete(r.cache.GenerateKey("prs:conflicts", owner, repo, number))
+
+	return nil
+}
+
+// CommitSuggestion commits a suggested change to a pull request (invalidates caches)
+func (r *CachedPullRequestRepository) CommitSuggestion(ctx context.Context, owner, repo string, number int, input *models.SuggestionInput) error {
+	err := r.repo.CommitSuggestion(ctx, owner, repo, number, input)
+	if err != nil {
+		return err
+	}
+
+	// Invalidate the PR, its changes and its threads, which move with the new head
+	_ = r.cache.Delete(r.cache.GenerateKey("prs:get", owner, repo, number))
+	_ = r.cache.Delete(r.cache.GenerateKey("prs:files", owner, repo, number))
+	r.invalidateFilesPages(owner, repo, number)
+	_ = r.cache.Delete(r.cache.GenerateKey("prs:diff", owner, repo, number))
+	_ = r.cache.Delete(r.cache.GenerateKey("prs:threads", owner, repo, number))
+	_ = r.cache.Delete(r.cache.GenerateKey("prs:conflicts", owner, repo, number))
+
+	return nil
+}
+
 // GetDiff retrieves the diff for a pull request with caching
 func (r *CachedPullRequestRepository) GetDiff(ctx context.Context, owner, repo string, number int) (string, error) {
 	// Generate cache key
@@ -175,6 +211,12 @@ func (r *CachedPullRequestRepository) IsMergeable(ctx context.Context, owner, re
 	return r.repo.IsMergeable(ctx, owner, repo, number)
 }
 
+// GetChangesSinceReview retrieves the changes since the viewer's last review (no caching - always fresh)
+func (r *CachedPullRequestRepository) GetChangesSinceReview(ctx context.Context, owner, repo string, number int) (*models.ChangesSinceReview, error) {
+	// New commits and reviews move both ends of the comparison
+	return r.repo.GetChangesSinceReview(ctx, owner, repo, number)
+}
+
 // ListReviews retrieves reviews for a pull request with caching
 func (r *CachedPullRequestRepository) ListReviews(ctx context.Context, owner, repo string, number int) ([]*models.Review, error) {
 	// Generate cache key
@@ -230,3 +272,390 @@ func (r *CachedPullRequestRepository) ListComments(ctx context.Context, owner, r
 
 	return comments, nil
 }
+
+// ListFilesPage retrieves one page of the files changed by a pull request with caching
+func (r *CachedPullRequestRepository) ListFilesPage(ctx context.Context, owner, repo string, number, page, perPage int) ([]*models.DiffFile, error) {
+	// Generate cache key; the generation drops every page when the PR changes
+	key := r.cache.GenerateKey("prs:files:page", owner, repo, number, r.filesPagesGeneration(owner, repo, number), page, perPage)
+
+	// Try to get from cache
+	if cached, ok := r.cache.GetWithContext(ctx, key); ok {
+		if files, ok := cached.([]*models.DiffFile); ok {
+			return files, nil
+		}
+	}
+
+	// Cache miss - fetch from underlying repository
+	files, err := r.repo.ListFilesPage(ctx, owner, repo, number, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	if files == nil {
+		files = []*models.DiffFile{}
+	}
+
+	// Store in cache
+	_ = r.cache.SetWithContext(ctx, key, files, 0)
+
+	return files, nil
+}
+
+// filesPagesGeneration returns the generation of the cached pages of the
+// files of a pull request. The pages are cached per page and page size, so
+// they are dropped all at once by moving to a new generation.
+func (r *CachedPullRequestRepository) filesPagesGeneration(owner, repo string, number int) string {
+	if cached, ok := r.cache.Get(r.cache.GenerateKey("prs:files:page:gen", owner, repo, number)); ok {
+		if generation, ok := cached.(string); ok {
+			return generation
+		}
+	}
+	return ""
+}
+
+// invalidateFilesPages drops the cached pages of the files of a pull request
+func (r *CachedPullRequestRepository) invalidateFilesPages(owner, repo string, number int) {
+	// The generation never expires, so pages of an older one are never served again
+	generation := strconv.FormatInt(time.Now().UnixNano(), 36)
+	_ = r.cache.Set(r.cache.GenerateKey("prs:files:page:gen", owner, repo, number), generation, 0)
+}
+
+// ListFiles retrieves the files changed by a pull request with caching
+func (r *CachedPullRequestRepository) ListFiles(ctx context.Context, owner, repo string, number int) ([]*models.DiffFile, error) {
+	// Generate cache key
+	key := r.cache.GenerateKey("prs:files", owner, repo, number)
+
+	// Try to get from cache
+	if cached, ok := r.cache.GetWithContext(ctx, key); ok {
+		if files, ok := cached.([]*models.DiffFile); ok {
+			return files, nil
+		}
+	}
+
+	// Cache miss - fetch from underlying repository
+	files, err := r.repo.ListFiles(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	if files == nil {
+		files = []*models.DiffFile{}
+	}
+
+	// Store in cache
+	_ = r.cache.SetWithContext(ctx, key, files, 0)
+
+	return files, nil
+}
+
+// ListReviewThreads retrieves review comment threads with caching
+func (r *CachedPullRequestRepository) ListReviewThreads(ctx context.Context, owner, repo string, number int) ([]*models.ReviewThread, error) {
+	// Generate cache key
+	key := r.cache.GenerateKey("prs:threads", owner, repo, number)
+
+	// Try to get from cache
+	if cached, ok := r.cache.GetWithContext(ctx, key); ok {
+		if threads, ok := cached.([]*models.ReviewThread); ok {
+			return threads, nil
+		}
+	}
+
+	// Cache miss - fetch from underlying repository
+	threads, err := r.repo.ListReviewThreads(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	if threads == nil {
+		threads = []*models.ReviewThread{}
+	}
+
+	// Store in cache
+	_ = r.cache.SetWithContext(ctx, key, threads, 0)
+
+	return threads, nil
+}
+
+// ListForCommit retrieves the pull requests associated with a commit with caching
+func (r *CachedPullRequestRepository) ListForCommit(ctx context.Context, owner, repo, sha string) ([]*models.PullRequest, error) {
+	// Generate cache key
+	key := r.cache.GenerateKey("prs:commit", owner, repo, sha)
+
+	// Try to get from cache
+	if cached, ok := r.cache.GetWithContext(ctx, key); ok {
+		if prs, ok := cached.([]*models.PullRequest); ok {
+			return prs, nil
+		}
+	}
+
+	// Cache miss - fetch from underlying repository
+	prs, err := r.repo.ListForCommit(ctx, owner, repo, sha)
+	if err != nil {
+		return nil, err
+	}
+
+	if prs == nil {
+		prs = []*models.PullRequest{}
+	}
+
+	// Store in cache
+	_ = r.cache.SetWithContext(ctx, key, prs, 0)
+
+	return prs, nil
+}
+
+// ListLinkedIssues retrieves the issues a pull request closes with caching
+func (r *CachedPullRequestRepository) ListLinkedIssues(ctx context.Context, owner, repo string, number int) ([]*models.LinkedIssue, error) {
+	// Generate cache key
+	key := r.cache.GenerateKey("prs:linked", owner, repo, number)
+
+	// Try to get from cache
+	if cached, ok := r.cache.GetWithContext(ctx, key); ok {
+		if issues, ok := cached.([]*models.LinkedIssue); ok {
+			return issues, nil
+		}
+	}
+
+	// Cache miss - fetch from underlying repository
+	issues, err := r.repo.ListLinkedIssues(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	if issues == nil {
+		issues = []*models.LinkedIssue{}
+	}
+
+	// Store in cache
+	_ = r.cache.SetWithContext(ctx, key, issues, 0)
+
+	return issues, nil
+}
+
+// ListConflictFiles retrieves the files a pull request may conflict in with caching
+func (r *CachedPullRequestRepository) ListConflictFiles(ctx context.Context, owner, repo string, number int) (*models.ConflictFiles, error) {
+	// Generate cache key
+	key := r.cache.GenerateKey("prs:conflicts", owner, repo, number)
+
+	// Try to get from cache
+	if cached, ok := r.cache.GetWithContext(ctx, key); ok {
+		if conflicts, ok := cached.(*models.ConflictFiles); ok {
+			return conflicts, nil
+		}
+	}
+
+	// Cache miss - fetch from underlying repository
+	conflicts, err := r.repo.ListConflictFiles(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	// Store in cache
+	_ = r.cache.SetWithContext(ctx, key, conflicts, 0)
+
+	return conflicts, nil
+}
+
+// ListMergeReadiness retrieves whether pull requests can be merged now with caching
+func (r *CachedPullRequestRepository) ListMergeReadiness(ctx context.Context, owner, repo string, numbers []int) (map[int]*models.MergeReadiness, error) {
+	// Generate cache key
+	key := r.cache.GenerateKey("prs:readiness", owner, repo, numbers)
+
+	// Try to get from cache
+	if cached, ok := r.cache.GetWithContext(ctx, key); ok {
+		if readiness, ok := cached.(map[int]*models.MergeReadiness); ok {
+			return readiness, nil
+		}
+	}
+
+	// Cache miss - fetch from underlying repository
+	readiness, err := r.repo.ListMergeReadiness(ctx, owner, repo, numbers)
+	if err != nil {
+		return nil, err
+	}
+
+	// Store in cache
+	_ = r.cache.SetWithContext(ctx, key, readiness, 0)
+
+	return readiness, nil
+}
+
+// GetMergeRequirements retrieves the merge requirements of a pull request with caching
+func (r *CachedPullRequestRepository) GetMergeRequirements(ctx context.Context, owner, repo string, number int) (*models.MergeRequirements, error) {
+	// Generate cache key
+	key := r.cache.GenerateKey("prs:requirements", owner, repo, number)
+
+	// Try to get from cache
+	if cached, ok := r.cache.GetWithContext(ctx, key); ok {
+		if requirements, ok := cached.(*models.MergeRequirements); ok {
+			return requirements, nil
+		}
+	}
+
+	// Cache miss - fetch from underlying repository
+	requirements, err := r.repo.GetMergeRequirements(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	// Store in cache
+	_ = r.cache.SetWithContext(ctx, key, requirements, 0)
+
+	return requirements, nil
+}
+
+// SetLabels replaces the labels of a pull request (invalidates caches)
+func (r *CachedPullRequestRepository) SetLabels(ctx context.Context, owner, repo string, number int, labels []string) ([]models.Label, error) {
+	result, err := r.repo.SetLabels(ctx, owner, repo, number, labels)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate the specific PR cache
+	key := r.cache.GenerateKey("prs:get", owner, repo, number)
+	_ = r.cache.Delete(key)
+
+	return result, nil
+}
+
+// CreateReview submits a review on a pull request (invalidates caches)
+func (r *CachedPullRequestRepository) CreateReview(ctx context.Context, owner, repo string, number int, input *models.CreateReviewInput) (*models.Review, error) {
+	review, err := r.repo.CreateReview(ctx, owner, repo, number, input)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate the PR, its reviews and the review decision
+	_ = r.cache.Delete(r.cache.GenerateKey("prs:get", owner, repo, number))
+	_ = r.cache.Delete(r.cache.GenerateKey("prs:reviews", owner, repo, number))
+	_ = r.cache.Delete(r.cache.GenerateKey("prs:requirements", owner, repo, number))
+	_ = r.cache.Delete(r.cache.GenerateKey("prs:approvals", owner, repo, number))
+	_ = r.cache.Delete(r.cache.GenerateKey("prs:review_requests", owner, repo, number))
+
+	return review, nil
+}
+
+// ConvertDraft toggles the draft state of a pull request (invalidates caches)
+func (r *CachedPullRequestRepository) ConvertDraft(ctx context.Context, owner, repo string, number int, draft bool) (*models.PullRequest, error) {
+	pr, err := r.repo.ConvertDraft(ctx, owner, repo, number, draft)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate the specific PR cache
+	key := r.cache.GenerateKey("prs:get", owner, repo, number)
+	_ = r.cache.Delete(key)
+
+	return pr, nil
+}
+
+// ListReviewerCandidates retrieves the users and teams reviews can be requested from with caching
+func (r *CachedPullRequestRepository) ListReviewerCandidates(ctx context.Context, owner, repo string) (*models.ReviewerCandidates, error) {
+	// Generate cache key
+	key := r.cache.GenerateKey("prs:reviewers", owner, repo)
+
+	// Try to get from cache
+	if cached, ok := r.cache.GetWithContext(ctx, key); ok {
+		if candidates, ok := cached.(*models.ReviewerCandidates); ok {
+			return candidates, nil
+		}
+	}
+
+	// Cache miss - fetch from underlying repository
+	candidates, err := r.repo.ListReviewerCandidates(ctx, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	// Store in cache
+	_ = r.cache.SetWithContext(ctx, key, candidates, 0)
+
+	return candidates, nil
+}
+
+// GetCodeOwners retrieves the CODEOWNERS rules at a ref with caching
+func (r *CachedPullRequestRepository) GetCodeOwners(ctx context.Context, owner, repo, ref string) (models.CodeOwners, error) {
+	// Generate cache key
+	key := r.cache.GenerateKey("prs:codeowners", owner, repo, ref)
+
+	// Try to get from cache
+	if cached, ok := r.cache.GetWithContext(ctx, key); ok {
+		if rules, ok := cached.(models.CodeOwners); ok {
+			return rules, nil
+		}
+	}
+
+	// Cache miss - fetch from underlying repository
+	rules, err := r.repo.GetCodeOwners(ctx, owner, repo, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	if rules == nil {
+		rules = models.CodeOwners{}
+	}
+
+	// Store in cache
+	_ = r.cache.SetWithContext(ctx, key, rules, 0)
+
+	return rules, nil
+}
+
+// ListPendingReviewRequests retrieves the waiting review requests of a pull request with caching
+func (r *CachedPullRequestRepository) ListPendingReviewRequests(ctx context.Context, owner, repo string, number int) ([]*models.PendingReviewRequest, error) {
+	key := r.cache.GenerateKey("prs:review_requests", owner, repo, number)
+
+	if cached, ok := r.cache.GetWithContext(ctx, key); ok {
+		if requests, ok := cached.([]*models.PendingReviewRequest); ok {
+			return requests, nil
+		}
+	}
+
+	requests, err := r.repo.ListPendingReviewRequests(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = r.cache.SetWithContext(ctx, key, requests, 0)
+
+	return requests, nil
+}
+
+// ListApprovals retrieves the users and teams approving a pull request with caching
+func (r *CachedPullRequestRepository) ListApprovals(ctx context.Context, owner, repo string, number int) (*models.Approvals, error) {
+	// Generate cache key
+	key := r.cache.GenerateKey("prs:approvals", owner, repo, number)
+
+	// Try to get from cache
+	if cached, ok := r.cache.GetWithContext(ctx, key); ok {
+		if approvals, ok := cached.(*models.Approvals); ok {
+			return approvals, nil
+		}
+	}
+
+	// Cache miss - fetch from underlying repository
+	approvals, err := r.repo.ListApprovals(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	// Store in cache
+	_ = r.cache.SetWithContext(ctx, key, approvals, 0)
+
+	return approvals, nil
+}
+
+// RequestReviewers requests reviews of a pull request (invalidates caches)
+func (r *CachedPullRequestRepository) RequestReviewers(ctx context.Context, owner, repo string, number int, request *models.ReviewRequest) (*models.PullRequest, error) {
+	pr, err := r.repo.RequestReviewers(ctx, owner, repo, number, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate the PR, the review decision and the waiting requests
+	_ = r.cache.Delete(r.cache.GenerateKey("prs:get", owner, repo, number))
+	_ = r.cache.Delete(r.cache.GenerateKey("prs:requirements", owner, repo, number))
+	_ = r.cache.Delete(r.cache.GenerateKey("prs:review_requests", owner, repo, number))
+
+	return pr, nil
+}
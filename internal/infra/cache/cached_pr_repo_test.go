@@ -350,3 +350,43 @@ func TestCachedPullRequestRepository_CustomTTL(t *testing.T) {
 func stringPtrPR(s string) *string {
 	return &s
 }
+
+func TestCachedPullRequestRepository_WritesInvalidateFilesPages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockPullRequestRepository(ctrl)
+	cacheService, err := cache.NewCacheWithConfig(cache.DefaultConfig().DisableFileCache())
+	require.NoError(t, err)
+	cachedRepo := cache.NewCachedPullRequestRepository(mockRepo, cacheService.(*cache.Cache))
+
+	ctx := context.Background()
+	before := []*models.DiffFile{{Filename: "main.go", Patch: "@@ -1 +1 @@\n-a\n+b"}}
+	after := []*models.DiffFile{{Filename: "main.go", Patch: "@@ -1 +1 @@\n-a\n+c"}}
+	input := &models.SuggestionInput{Path: "main.go", Line: 1, Replacement: "c"}
+
+	gomock.InOrder(
+		mockRepo.EXPECT().ListFilesPage(gomock.Any(), "owner", "repo", 7, 1, 100).Return(before, nil),
+		mockRepo.EXPECT().CommitSuggestion(gomock.Any(), "owner", "repo", 7, input).Return(nil),
+		mockRepo.EXPECT().ListFilesPage(gomock.Any(), "owner", "repo", 7, 1, 100).Return(after, nil),
+		mockRepo.EXPECT().UpdateBranch(gomock.Any(), "owner", "repo", 7, "").Return(nil),
+		mockRepo.EXPECT().ListFilesPage(gomock.Any(), "owner", "repo", 7, 1, 100).Return(before, nil),
+	)
+
+	files, err := cachedRepo.ListFilesPage(ctx, "owner", "repo", 7, 1, 100)
+	require.NoError(t, err)
+	assert.Equal(t, before, files)
+	files, err = cachedRepo.ListFilesPage(ctx, "owner", "repo", 7, 1, 100)
+	require.NoError(t, err)
+	assert.Equal(t, before, files, "the second read should come from the cache")
+
+	require.NoError(t, cachedRepo.CommitSuggestion(ctx, "owner", "repo", 7, input))
+	files, err = cachedRepo.ListFilesPage(ctx, "owner", "repo", 7, 1, 100)
+	require.NoError(t, err)
+	assert.Equal(t, after, files, "the page should be fetched again after the commit")
+
+	require.NoError(t, cachedRepo.UpdateBranch(ctx, "owner", "repo", 7, ""))
+	files, err = cachedRepo.ListFilesPage(ctx, "owner", "repo", 7, 1, 100)
+	require.NoError(t, err)
+	assert.Equal(t, before, files, "the page should be fetched again after the branch update")
+}
@@ -0,0 +1,31 @@
+package clipboard
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/atotto/clipboard"
+)
+
+// ErrUnsupported is returned when no clipboard backend is available
+// (e.g. no xclip/xsel/wl-copy on Linux, or a headless CI environment).
+var ErrUnsupported = errors.New("clipboard is not available on this system")
+
+// Backend hooks, overridable in tests
+var (
+	unsupported = func() bool { return clipboard.Unsupported }
+	writeAll    = clipboard.WriteAll
+)
+
+// Copy writes text to the system clipboard.
+// The underlying implementation uses the native API on Windows and
+// pbcopy/xclip/xsel/wl-copy elsewhere.
+func Copy(text string) error {
+	if unsupported() {
+		return ErrUnsupported
+	}
+	if err := writeAll(text); err != nil {
+		return fmt.Errorf("failed to copy to clipboard: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package clipboard
+
+import (
+	"errors"
+	"testing"
+)
+
+func stubBackend(t *testing.T, isUnsupported bool, write func(string) error) {
+	t.Helper()
+	origUnsupported, origWrite := unsupported, writeAll
+	t.Cleanup(func() { unsupported, writeAll = origUnsupported, origWrite })
+
+	unsupported = func() bool { return isUnsupported }
+	writeAll = write
+}
+
+func TestCopy(t *testing.T) {
+	var copied string
+	stubBackend(t, false, func(text string) error {
+		copied = text
+		return nil
+	})
+
+	if err := Copy("abc123"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if copied != "abc123" {
+		t.Errorf("expected abc123, got %q", copied)
+	}
+}
+
+func TestCopy_Unsupported(t *testing.T) {
+	stubBackend(t, true, func(string) error {
+		t.Fatal("writeAll should not be called")
+		return nil
+	})
+
+	if err := Copy("x"); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected ErrUnsupported, got %v", err)
+	}
+}
+
+func TestCopy_WriteError(t *testing.T) {
+	stubBackend(t, false, func(string) error { return errors.New("exec: xclip not found") })
+
+	if err := Copy("x"); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,69 @@
+package clipboard
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Method describes how text was put on the clipboard
+type Method string
+
+const (
+	// MethodSystem is the clipboard of the machine tig-gh runs on
+	MethodSystem Method = "clipboard"
+	// MethodOSC52 asks the terminal to set its clipboard, which reaches the
+	// local machine over SSH and from devcontainers when the terminal supports it
+	MethodOSC52 Method = "OSC 52"
+)
+
+// OSC 52 hooks, overridable in tests
+var (
+	osc52Output io.Writer = os.Stderr // stdout belongs to the TUI renderer
+	getenv                = os.Getenv
+)
+
+// CopyWithFallback copies text to the clipboard the user can paste from.
+// In SSH sessions the remote system clipboard is useless, so OSC 52 is used
+// directly; elsewhere it is the fallback when no clipboard backend works.
+func CopyWithFallback(text string) (Method, error) {
+	if !isRemote() {
+		if err := Copy(text); err == nil {
+			return MethodSystem, nil
+		}
+	}
+	if err := CopyOSC52(text); err != nil {
+		return "", err
+	}
+	return MethodOSC52, nil
+}
+
+// CopyOSC52 writes the OSC 52 sequence setting the terminal's clipboard to text.
+// The terminal gives no answer, so success only means the sequence was sent.
+func CopyOSC52(text string) error {
+	if _, err := io.WriteString(osc52Output, osc52Sequence(text)); err != nil {
+		return fmt.Errorf("failed to copy to clipboard: %w", err)
+	}
+	return nil
+}
+
+// osc52Sequence returns the escape sequence, wrapped so tmux and screen pass it
+// on to the outer terminal
+func osc52Sequence(text string) string {
+	seq := "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte(text)) + "\a"
+	switch {
+	case getenv("TMUX") != "":
+		return "\x1bPtmux;" + strings.ReplaceAll(seq, "\x1b", "\x1b\x1b") + "\x1b\\"
+	case strings.HasPrefix(getenv("TERM"), "screen"):
+		return "\x1bP" + seq + "\x1b\\"
+	default:
+		return seq
+	}
+}
+
+// isRemote reports whether tig-gh runs in an SSH session
+func isRemote() bool {
+	return getenv("SSH_CONNECTION") != "" || getenv("SSH_TTY") != ""
+}
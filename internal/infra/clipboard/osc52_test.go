@@ -0,0 +1,77 @@
+package clipboard
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func stubTerminal(t *testing.T, env map[string]string) *bytes.Buffer {
+	t.Helper()
+	origOutput, origGetenv := osc52Output, getenv
+	t.Cleanup(func() { osc52Output, getenv = origOutput, origGetenv })
+
+	var out bytes.Buffer
+	osc52Output = &out
+	getenv = func(key string) string { return env[key] }
+	return &out
+}
+
+func TestOSC52Sequence(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want string
+	}{
+		{name: "plain terminal", env: map[string]string{}, want: "\x1b]52;c;aGk=\a"},
+		{name: "tmux", env: map[string]string{"TMUX": "/tmp/tmux-1000/default,1,0"}, want: "\x1bPtmux;\x1b\x1b]52;c;aGk=\a\x1b\\"},
+		{name: "screen", env: map[string]string{"TERM": "screen-256color"}, want: "\x1bP\x1b]52;c;aGk=\a\x1b\\"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stubTerminal(t, tt.env)
+			if got := osc52Sequence("hi"); got != tt.want {
+				t.Errorf("osc52Sequence() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCopyWithFallback(t *testing.T) {
+	t.Run("system clipboard", func(t *testing.T) {
+		out := stubTerminal(t, map[string]string{})
+		stubBackend(t, false, func(string) error { return nil })
+
+		method, err := CopyWithFallback("url")
+		if err != nil || method != MethodSystem {
+			t.Fatalf("got %q, %v", method, err)
+		}
+		if out.Len() != 0 {
+			t.Error("OSC 52 should not be used when the clipboard works")
+		}
+	})
+
+	t.Run("no clipboard backend", func(t *testing.T) {
+		out := stubTerminal(t, map[string]string{})
+		stubBackend(t, false, func(string) error { return errors.New("exec: xclip not found") })
+
+		method, err := CopyWithFallback("url")
+		if err != nil || method != MethodOSC52 || out.Len() == 0 {
+			t.Fatalf("got %q, %v, %q", method, err, out.String())
+		}
+	})
+
+	t.Run("ssh session", func(t *testing.T) {
+		out := stubTerminal(t, map[string]string{"SSH_TTY": "/dev/pts/1"})
+		stubBackend(t, false, func(string) error {
+			t.Fatal("the remote clipboard should not be used")
+			return nil
+		})
+
+		method, err := CopyWithFallback("url")
+		if err != nil || method != MethodOSC52 || out.Len() == 0 {
+			t.Fatalf("got %q, %v, %q", method, err, out.String())
+		}
+	})
+}
@@ -0,0 +1,67 @@
+// Package clock is the source of the current time for views and metrics
+// calculations (relative times, weekly comparisons, stagnant thresholds).
+// Tests and the fixed-time mode replace it so that the output is deterministic.
+package clock
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// EnvVar fixes the clock at startup when set (e.g. TIG_GH_NOW=2025-01-22T12:00:00Z)
+const EnvVar = "TIG_GH_NOW"
+
+// Clock returns the current time
+type Clock func() time.Time
+
+var (
+	mu      sync.RWMutex
+	current Clock = time.Now
+)
+
+// Now returns the current time of the installed clock
+func Now() time.Time {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current()
+}
+
+// Since returns the time elapsed since t according to the installed clock
+func Since(t time.Time) time.Duration {
+	return Now().Sub(t)
+}
+
+// Set installs c as the clock and returns a function that restores the previous one.
+// A nil clock restores the system clock.
+func Set(c Clock) (restore func()) {
+	if c == nil {
+		c = time.Now
+	}
+	mu.Lock()
+	previous := current
+	current = c
+	mu.Unlock()
+	return func() {
+		mu.Lock()
+		current = previous
+		mu.Unlock()
+	}
+}
+
+// Fixed returns a clock that always reports t
+func Fixed(t time.Time) Clock {
+	return func() time.Time { return t }
+}
+
+// Parse parses a fixed time given as RFC 3339 ("2025-01-22T12:00:00Z") or a
+// date ("2025-01-22", midnight in the local time zone)
+func Parse(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid %s %q (expected RFC 3339 or YYYY-MM-DD)", EnvVar, value)
+}
@@ -0,0 +1,39 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSetFixed(t *testing.T) {
+	fixed := time.Date(2025, 1, 22, 12, 0, 0, 0, time.UTC)
+	restore := Set(Fixed(fixed))
+
+	if got := Now(); !got.Equal(fixed) {
+		t.Fatalf("expected fixed time %v, got %v", fixed, got)
+	}
+	if got := Since(fixed.Add(-90 * time.Minute)); got != 90*time.Minute {
+		t.Fatalf("expected 90m since, got %v", got)
+	}
+
+	restore()
+	if Now().Equal(fixed) {
+		t.Fatal("expected the system clock after restore")
+	}
+}
+
+func TestParse(t *testing.T) {
+	got, err := Parse("2025-01-22T12:00:00Z")
+	if err != nil || !got.Equal(time.Date(2025, 1, 22, 12, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected RFC 3339 result %v (err %v)", got, err)
+	}
+
+	got, err = Parse("2025-01-22")
+	if err != nil || !got.Equal(time.Date(2025, 1, 22, 0, 0, 0, 0, time.Local)) {
+		t.Fatalf("unexpected date result %v (err %v)", got, err)
+	}
+
+	if _, err := Parse("yesterday"); err == nil {
+		t.Fatal("expected an error for an invalid time")
+	}
+}
@@ -1,11 +1,13 @@
 package config
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"sync"
 
 	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/infra/auth"
 )
 
 // Manager は設定管理を行う構造体
@@ -36,6 +38,28 @@ func (m *Manager) Load() error {
 	return nil
 }
 
+// LoadUnvalidated は設定を検証せずに読み込む。検証は Validate で後から行う
+func (m *Manager) LoadUnvalidated() error {
+	cfg, err := m.loader.LoadUnvalidated()
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	m.config = cfg
+	m.mu.Unlock()
+
+	return nil
+}
+
+// Validate は読み込んだ設定を検証する
+func (m *Manager) Validate() error {
+	if err := m.GetConfig().Validate(); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+	return nil
+}
+
 // LoadWithPath は指定されたパスから設定を読み込む
 func (m *Manager) LoadWithPath(path string) error {
 	cfg, err := m.loader.LoadWithPath(path)
@@ -95,6 +119,45 @@ func (m *Manager) Save() error {
 	return m.loader.Save(cfg, path)
 }
 
+// SaveRepositories はメトリクス対象のリポジトリを設定ファイルに保存する
+// profile が空でなければそのプロファイルの repositories を書き換える
+// 設定ファイルが無い場合はデフォルトのパスに作成する
+func (m *Manager) SaveRepositories(profile string, repos []string) error {
+	path := m.GetConfigPath()
+	if path == "" {
+		defaultPath, err := GetDefaultConfigPath()
+		if err != nil {
+			return err
+		}
+		path = defaultPath
+	}
+
+	if err := WriteRepositories(path, profile, repos); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.config == nil {
+		m.config = models.DefaultConfig()
+	}
+	saved := append([]string(nil), repos...)
+	if profile == "" {
+		m.config.GitHub.Repositories = saved
+		return nil
+	}
+	// Profiles はコピーと共有されているため、書き換える前に複製する
+	profiles := make(map[string]models.GitHubConfig, len(m.config.Profiles)+1)
+	for name, p := range m.config.Profiles {
+		profiles[name] = p
+	}
+	p := profiles[profile]
+	p.Repositories = saved
+	profiles[profile] = p
+	m.config.Profiles = profiles
+	return nil
+}
+
 // SaveTo は現在の設定を指定されたパスに保存する
 func (m *Manager) SaveTo(path string) error {
 	m.mu.RLock()
@@ -123,21 +186,25 @@ func (m *Manager) GetConfigPath() string {
 }
 
 // GetGitHubToken はGitHubトークンを取得する
-// 設定ファイルまたは環境変数から取得
+// 設定ファイル・環境変数・gh CLI・キーリングの順に探す（github.auth_sources で変更可能）
 func (m *Manager) GetGitHubToken() string {
-	m.mu.RLock()
-	defer m.mu.RUnlock()
+	return m.ResolveGitHubToken(context.Background()).Value
+}
 
-	if m.config != nil && m.config.GitHub.Token != "" {
-		return m.config.GitHub.Token
-	}
+// ResolveGitHubToken はGitHubトークンを取得元とあわせて返す
+func (m *Manager) ResolveGitHubToken(ctx context.Context) auth.Token {
+	return m.NewAuthResolver().Resolve(ctx)
+}
 
-	// 環境変数から取得を試みる
-	if token := os.Getenv("GITHUB_TOKEN"); token != "" {
-		return token
+// NewAuthResolver は現在の設定（profile で指定したプロファイルを適用済み）でトークンを探すResolverを作成する
+func (m *Manager) NewAuthResolver() *auth.Resolver {
+	cfg := m.GetConfig()
+	if err := cfg.ApplyProfile(cfg.Profile); err != nil {
+		// 存在しないプロファイルは読み込み時に弾かれるため、ここでは github セクションを使う
+		cfg = m.GetConfig()
+		cfg.Profile = ""
 	}
-
-	return ""
+	return auth.NewResolver(cfg.GitHub).WithProfile(cfg.Profile)
 }
 
 // InitializeConfig はデフォルト設定ファイルを作成する
@@ -189,6 +256,16 @@ func Load() error {
 	return GetManager().Load()
 }
 
+// LoadUnvalidated はグローバルマネージャーを使用して設定を検証せずに読み込む
+func LoadUnvalidated() error {
+	return GetManager().LoadUnvalidated()
+}
+
+// Validate はグローバルマネージャーの設定を検証する
+func Validate() error {
+	return GetManager().Validate()
+}
+
 // LoadWithPath はグローバルマネージャーを使用して指定されたパスから設定を読み込む
 func LoadWithPath(path string) error {
 	return GetManager().LoadWithPath(path)
@@ -204,6 +281,11 @@ func Save() error {
 	return GetManager().Save()
 }
 
+// SaveRepositories はグローバルマネージャーを使用してメトリクス対象のリポジトリを保存する
+func SaveRepositories(profile string, repos []string) error {
+	return GetManager().SaveRepositories(profile, repos)
+}
+
 // SaveTo はグローバルマネージャーを使用して現在の設定を指定されたパスに保存する
 func SaveTo(path string) error {
 	return GetManager().SaveTo(path)
@@ -219,6 +301,11 @@ func GetGitHubToken() string {
 	return GetManager().GetGitHubToken()
 }
 
+// NewAuthResolver はグローバルマネージャーの設定でトークンを探すResolverを作成する
+func NewAuthResolver() *auth.Resolver {
+	return GetManager().NewAuthResolver()
+}
+
 // InitializeConfig はグローバルマネージャーを使用してデフォルト設定ファイルを作成する
 func InitializeConfig() error {
 	return GetManager().InitializeConfig()
@@ -32,13 +32,23 @@ func TestDefaultConfig(t *testing.T) {
 		t.Errorf("unexpected RequestTimeout: %v", cfg.GitHub.RequestTimeout)
 	}
 
+	if cfg.GitHub.Retries != 3 {
+		t.Errorf("unexpected Retries: %d", cfg.GitHub.Retries)
+	}
+
+	// 一覧は request_timeout、詳細・メトリクスは個別の既定値を使う
+	want := models.TimeoutsConfig{Connect: 10 * time.Second, List: 30 * time.Second, Detail: 20 * time.Second, Metrics: time.Minute}
+	if got := cfg.GitHub.RequestTimeouts(); got != want {
+		t.Errorf("unexpected RequestTimeouts: %+v", got)
+	}
+
 	// UI設定の検証
 	if cfg.UI.Theme != "auto" {
 		t.Errorf("unexpected Theme: %s", cfg.UI.Theme)
 	}
 
-	if cfg.UI.PageSize != 50 {
-		t.Errorf("unexpected PageSize: %d", cfg.UI.PageSize)
+	if cfg.UI.PageSize != 100 || cfg.UI.MaxItems != 100 {
+		t.Errorf("unexpected PageSize / MaxItems: %d / %d", cfg.UI.PageSize, cfg.UI.MaxItems)
 	}
 
 	// Cache設定の検証
@@ -60,6 +70,7 @@ func TestConfigValidate(t *testing.T) {
 
 	// 無効な設定をテスト
 	cfg.GitHub.RequestTimeout = -1 * time.Second
+	cfg.GitHub.Retries = -1
 	cfg.UI.PageSize = -10
 	cfg.Cache.TTL = 0
 
@@ -72,13 +83,52 @@ func TestConfigValidate(t *testing.T) {
 		t.Error("RequestTimeout should be fixed to positive value")
 	}
 
+	if cfg.GitHub.Retries != 3 {
+		t.Errorf("Retries should be fixed to the default, got %d", cfg.GitHub.Retries)
+	}
+
 	if cfg.UI.PageSize <= 0 {
 		t.Error("PageSize should be fixed to positive value")
 	}
 
+	// GitHub は per_page を 100 までしか受け付けない
+	cfg.UI.PageSize = 500
+	cfg.UI.MaxItems = -1
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Validate should fix invalid values, got error: %v", err)
+	}
+	if cfg.UI.PageSize != 100 || cfg.UI.MaxItems != 100 {
+		t.Errorf("PageSize / MaxItems should be fixed, got %d / %d", cfg.UI.PageSize, cfg.UI.MaxItems)
+	}
+
 	if cfg.Cache.TTL <= 0 {
 		t.Error("Cache TTL should be fixed to positive value")
 	}
+
+	// パーセンタイルは 1〜100 のみ
+	cfg.Metrics.LeadTimePercentiles = []int{50, 101}
+	if err := cfg.Validate(); err == nil {
+		t.Error("Validate should reject percentiles over 100")
+	}
+	cfg.Metrics.LeadTimePercentiles = nil
+	if err := cfg.Validate(); err != nil || len(cfg.Metrics.LeadTimePercentiles) != 4 {
+		t.Errorf("LeadTimePercentiles should be fixed to the default, got %v (err %v)", cfg.Metrics.LeadTimePercentiles, err)
+	}
+
+	// パレットは default / colorblind のみ。ビュー名も検証する
+	cfg.UI.Palette = ""
+	cfg.UI.ViewPalettes = map[string]string{"prs": "colorblind"}
+	if err := cfg.Validate(); err != nil || cfg.UI.Palette != models.PaletteDefault {
+		t.Errorf("Palette should be fixed to the default, got %q (err %v)", cfg.UI.Palette, err)
+	}
+	cfg.UI.ViewPalettes = map[string]string{"prs": "deuteranopia"}
+	if err := cfg.Validate(); err == nil {
+		t.Error("Validate should reject unknown palettes")
+	}
+	cfg.UI.ViewPalettes = map[string]string{"pulls": "colorblind"}
+	if err := cfg.Validate(); err == nil {
+		t.Error("Validate should reject unknown views")
+	}
 }
 
 func TestManagerGetConfig(t *testing.T) {
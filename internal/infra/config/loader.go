@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 
 	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/infra/paths"
 	"github.com/fsnotify/fsnotify"
 	"github.com/spf13/viper"
 )
@@ -28,29 +30,87 @@ func NewLoader() *Loader {
 	// 1. カレントディレクトリの .tig-gh
 	v.AddConfigPath("./.tig-gh")
 
-	// 2. ホームディレクトリの .config/tig-gh
+	// 2. ユーザー設定ディレクトリ (~/.config/tig-gh, Windows では %AppData%\tig-gh)
+	if dir, err := paths.ConfigDir(); err == nil {
+		v.AddConfigPath(dir)
+	}
 	if home, err := os.UserHomeDir(); err == nil {
-		v.AddConfigPath(filepath.Join(home, ".config", "tig-gh"))
 		v.AddConfigPath(filepath.Join(home, ".tig-gh"))
 	}
 
-	// 3. /etc/tig-gh (システムワイド)
-	v.AddConfigPath("/etc/tig-gh")
+	// 3. /etc/tig-gh (システムワイド、Windows では使用しない)
+	if dir := paths.SystemConfigDir(); dir != "" {
+		v.AddConfigPath(dir)
+	}
 
 	// 環境変数の設定
 	v.SetEnvPrefix("TIG_GH")
 	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	v.AutomaticEnv()
 
-	// 特定の環境変数を明示的にバインド
-	v.BindEnv("github.token", "GITHUB_TOKEN")
-	v.BindEnv("github.api_base_url", "GITHUB_API_URL")
+	// 設定ファイルが無くても TIG_GH_UI_DEFAULT_VIEW などが反映されるよう、全ての設定項目をバインド
+	bindEnvKeys(v, reflect.TypeOf(models.Config{}), "")
+
+	// 特定の環境変数を明示的にバインド（TIG_GH_* が優先）
+	v.BindEnv("github.token", "TIG_GH_GITHUB_TOKEN", "GITHUB_TOKEN")
+	v.BindEnv("github.api_base_url", "TIG_GH_GITHUB_API_BASE_URL", "GITHUB_API_URL")
 
 	return &Loader{v: v}
 }
 
-// Load は設定ファイルを読み込み、Config構造体を返す
+// bindEnvKeys は設定構造体の各項目を TIG_GH_<SECTION>_<KEY> の環境変数にバインドする
+// リストはカンマ区切りで指定する。マップ（profiles, key_bindings）と構造体のリスト（freeze_windows）は対象外
+func bindEnvKeys(v *viper.Viper, t reflect.Type, prefix string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := field.Tag.Get("mapstructure")
+		if name == "" || name == "-" {
+			continue
+		}
+		key := prefix + name
+
+		switch field.Type.Kind() {
+		case reflect.Struct:
+			bindEnvKeys(v, field.Type, key+".")
+		case reflect.Map:
+		case reflect.Slice:
+			if field.Type.Elem().Kind() == reflect.String {
+				v.BindEnv(key)
+			}
+		default:
+			v.BindEnv(key)
+		}
+	}
+}
+
+// Load は設定ファイルを読み込み、検証したConfig構造体を返す
 func (l *Loader) Load() (*models.Config, error) {
+	cfg, err := l.read()
+	if err != nil {
+		return nil, err
+	}
+
+	// 設定の妥当性を検証
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// LoadUnvalidated は設定ファイルを読み込み、デフォルト値だけを補ったConfig構造体を返す
+// 検証は起動後に Validate で行う（最初の描画を待たせないため）
+func (l *Loader) LoadUnvalidated() (*models.Config, error) {
+	cfg, err := l.read()
+	if err != nil {
+		return nil, err
+	}
+	cfg.ApplyDefaults()
+	return cfg, nil
+}
+
+// read は設定ファイルと環境変数を読み込む
+func (l *Loader) read() (*models.Config, error) {
 	// デフォルト設定を取得
 	cfg := models.DefaultConfig()
 
@@ -73,16 +133,11 @@ func (l *Loader) Load() (*models.Config, error) {
 
 	// キャッシュディレクトリのデフォルト値を設定
 	if cfg.Cache.Dir == "" {
-		if home, err := os.UserHomeDir(); err == nil {
-			cfg.Cache.Dir = filepath.Join(home, ".cache", "tig-gh")
+		if dir, err := paths.CacheDir(); err == nil {
+			cfg.Cache.Dir = dir
 		}
 	}
 
-	// 設定の妥当性を検証
-	if err := cfg.Validate(); err != nil {
-		return nil, fmt.Errorf("invalid config: %w", err)
-	}
-
 	return cfg, nil
 }
 
@@ -167,10 +222,10 @@ func (l *Loader) Save(cfg *models.Config, path string) error {
 
 // GetDefaultConfigPath はデフォルトの設定ファイルパスを返す
 func GetDefaultConfigPath() (string, error) {
-	home, err := os.UserHomeDir()
+	dir, err := paths.ConfigDir()
 	if err != nil {
-		return "", fmt.Errorf("failed to get home directory: %w", err)
+		return "", fmt.Errorf("failed to get config directory: %w", err)
 	}
 
-	return filepath.Join(home, ".config", "tig-gh", "config.yaml"), nil
+	return filepath.Join(dir, "config.yaml"), nil
 }
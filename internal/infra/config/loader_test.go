@@ -43,3 +43,205 @@ metrics:
 		t.Fatalf("expected calculation period %v, got %v", expectedPeriod, cfg.Metrics.CalculationPeriod)
 	}
 }
+
+func TestLoaderLoadsProtectedPaths(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+
+	yamlContent := `
+github:
+  token: test-token
+review:
+  protected_paths:
+    - infra/
+    - .github/workflows/
+`
+
+	if err := os.WriteFile(configPath, []byte(yamlContent), 0o644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	loader := NewLoader()
+	cfg, err := loader.LoadWithPath(configPath)
+	if err != nil {
+		t.Fatalf("LoadWithPath returned error: %v", err)
+	}
+
+	paths := cfg.Review.ProtectedPaths
+	if len(paths) != 2 || paths[0] != "infra/" || paths[1] != ".github/workflows/" {
+		t.Fatalf("unexpected protected paths %v", paths)
+	}
+}
+
+func TestLoaderLoadsFreezeWindows(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+
+	yamlContent := `
+github:
+  token: test-token
+review:
+  freeze_windows:
+    - name: weekend
+      start: "Fri 16:00"
+      end: "Mon 09:00"
+    - name: release week
+      start: "2026-12-01"
+      end: "2026-12-07"
+      mode: warn
+`
+
+	if err := os.WriteFile(configPath, []byte(yamlContent), 0o644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	cfg, err := NewLoader().LoadWithPath(configPath)
+	if err != nil {
+		t.Fatalf("LoadWithPath returned error: %v", err)
+	}
+
+	windows := cfg.Review.FreezeWindows
+	if len(windows) != 2 || windows[0].Start != "Fri 16:00" || windows[1].Mode != "warn" {
+		t.Fatalf("unexpected freeze windows %+v", windows)
+	}
+
+	invalid := "review:\n  freeze_windows:\n    - start: \"Someday\"\n      end: \"Mon\"\n"
+	if err := os.WriteFile(configPath, []byte(invalid), 0o644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	if _, err := NewLoader().LoadWithPath(configPath); err == nil {
+		t.Error("expected an invalid freeze window to be rejected")
+	}
+}
+
+func TestLoaderLoadsProfiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+
+	yamlContent := `
+github:
+  token: personal-token
+  default_owner: me
+  default_repo: dotfiles
+profile: work
+profiles:
+  work:
+    auth_sources: [keyring]
+    default_owner: acme
+    default_repo: api
+  ghe:
+    token: ghe-token
+    api_base_url: https://ghe.example.com/api/v3/
+`
+
+	if err := os.WriteFile(configPath, []byte(yamlContent), 0o644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	cfg, err := NewLoader().LoadWithPath(configPath)
+	if err != nil {
+		t.Fatalf("LoadWithPath returned error: %v", err)
+	}
+	if got := cfg.ProfileNames(); len(got) != 3 || got[0] != "default" || got[1] != "ghe" || got[2] != "work" {
+		t.Fatalf("unexpected profile names %v", got)
+	}
+
+	if err := cfg.ApplyProfile(cfg.Profile); err != nil {
+		t.Fatalf("ApplyProfile returned error: %v", err)
+	}
+	gh := cfg.GitHub
+	if gh.Token != "" || gh.DefaultOwner != "acme" || gh.DefaultRepo != "api" || len(gh.AuthSources) != 1 {
+		t.Errorf("work profile not applied, or the personal token leaked: %+v", gh)
+	}
+	if gh.APIBaseURL != "https://api.github.com/" {
+		t.Errorf("expected the base API URL to be kept, got %s", gh.APIBaseURL)
+	}
+
+	if err := cfg.ApplyProfile("missing"); err == nil {
+		t.Error("expected an unknown profile to be rejected")
+	}
+
+	invalid := "profile: missing\n"
+	if err := os.WriteFile(configPath, []byte(invalid), 0o644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	if _, err := NewLoader().LoadWithPath(configPath); err == nil {
+		t.Error("expected an unknown default profile to be rejected")
+	}
+}
+
+func TestLoaderLoadUnvalidatedDefersValidation(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	invalid := "ui:\n  page_size: 0\nreview:\n  freeze_windows:\n    - start: \"Someday\"\n      end: \"Mon\"\n"
+	if err := os.WriteFile(configPath, []byte(invalid), 0o644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	loader := NewLoader()
+	loader.v.SetConfigFile(configPath)
+	cfg, err := loader.LoadUnvalidated()
+	if err != nil {
+		t.Fatalf("LoadUnvalidated returned error: %v", err)
+	}
+	if cfg.UI.PageSize != 100 {
+		t.Errorf("expected defaults to be applied, got page size %d", cfg.UI.PageSize)
+	}
+	if err := cfg.Validate(); err == nil {
+		t.Error("expected the invalid freeze window to be reported by Validate")
+	}
+}
+
+func TestLoaderLoadsEnvOverridesWithoutConfigFile(t *testing.T) {
+	t.Chdir(t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("TIG_GH_UI_DEFAULT_VIEW", "prs")
+	t.Setenv("TIG_GH_CACHE_ENABLED", "false")
+	t.Setenv("TIG_GH_UI_AUTO_REFRESH", "30s")
+	t.Setenv("TIG_GH_GITHUB_REPOSITORIES", "owner/a,owner/b")
+	t.Setenv("TIG_GH_GITHUB_TOKEN", "env-token")
+
+	cfg, err := NewLoader().LoadUnvalidated()
+	if err != nil {
+		t.Fatalf("LoadUnvalidated returned error: %v", err)
+	}
+
+	if cfg.UI.DefaultView != "prs" {
+		t.Errorf("expected default view from env, got %q", cfg.UI.DefaultView)
+	}
+	if cfg.Cache.Enabled {
+		t.Errorf("expected cache disabled from env")
+	}
+	if cfg.UI.AutoRefresh != 30*time.Second {
+		t.Errorf("expected auto refresh 30s, got %v", cfg.UI.AutoRefresh)
+	}
+	if len(cfg.GitHub.Repositories) != 2 || cfg.GitHub.Repositories[1] != "owner/b" {
+		t.Errorf("expected repositories from env, got %v", cfg.GitHub.Repositories)
+	}
+	if cfg.GitHub.Token != "env-token" {
+		t.Errorf("expected token from TIG_GH_GITHUB_TOKEN, got %q", cfg.GitHub.Token)
+	}
+}
+
+func TestLoaderEnvOverridesConfigFile(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	yamlContent := `
+github:
+  token: test-token
+ui:
+  theme: light
+  page_size: 30
+`
+	if err := os.WriteFile(configPath, []byte(yamlContent), 0o644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	t.Setenv("TIG_GH_UI_THEME", "dark")
+
+	cfg, err := NewLoader().LoadWithPath(configPath)
+	if err != nil {
+		t.Fatalf("LoadWithPath returned error: %v", err)
+	}
+	if cfg.UI.Theme != "dark" || cfg.UI.PageSize != 30 {
+		t.Errorf("expected theme from env and page size from the file, got %q, %d", cfg.UI.Theme, cfg.UI.PageSize)
+	}
+}
@@ -0,0 +1,87 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWriteRepositories(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+
+	yamlContent := `# 個人設定
+github:
+  # トークンは gh CLI から取得する
+  default_owner: acme
+  repositories:
+    - acme/old
+profiles:
+  work:
+    api_base_url: https://ghe.example.com/api/v3/
+metrics:
+  enabled: true
+`
+	if err := os.WriteFile(configPath, []byte(yamlContent), 0o600); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	// 環境変数のトークンは書き出さない
+	t.Setenv("GITHUB_TOKEN", "env-secret")
+
+	if err := WriteRepositories(configPath, "", []string{"acme/app", "acme/api"}); err != nil {
+		t.Fatalf("WriteRepositories() error = %v", err)
+	}
+	if err := WriteRepositories(configPath, "work", []string{"corp/service"}); err != nil {
+		t.Fatalf("WriteRepositories() for profile error = %v", err)
+	}
+
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := string(data)
+	for _, want := range []string{"# 個人設定", "# トークンは gh CLI から取得する", "default_owner: acme", "api_base_url: https://ghe.example.com/api/v3/"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("config lost %q:\n%s", want, content)
+		}
+	}
+	if strings.Contains(content, "acme/old") || strings.Contains(content, "env-secret") {
+		t.Errorf("unexpected content:\n%s", content)
+	}
+	if info, err := os.Stat(configPath); err != nil || info.Mode().Perm() != 0o600 {
+		t.Errorf("file mode changed: %v", info.Mode())
+	}
+
+	cfg, err := NewLoader().LoadWithPath(configPath)
+	if err != nil {
+		t.Fatalf("LoadWithPath returned error: %v", err)
+	}
+	if !reflect.DeepEqual(cfg.GitHub.Repositories, []string{"acme/app", "acme/api"}) {
+		t.Errorf("github.repositories = %v", cfg.GitHub.Repositories)
+	}
+	if got := cfg.Profiles["work"].Repositories; !reflect.DeepEqual(got, []string{"corp/service"}) {
+		t.Errorf("profiles.work.repositories = %v", got)
+	}
+	if !cfg.Metrics.Enabled {
+		t.Error("metrics settings were lost")
+	}
+}
+
+func TestWriteRepositories_CreatesFile(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "tig-gh", "config.yaml")
+
+	if err := WriteRepositories(configPath, "", []string{"acme/app"}); err != nil {
+		t.Fatalf("WriteRepositories() error = %v", err)
+	}
+
+	cfg, err := NewLoader().LoadWithPath(configPath)
+	if err != nil {
+		t.Fatalf("LoadWithPath returned error: %v", err)
+	}
+	if !reflect.DeepEqual(cfg.GitHub.Repositories, []string{"acme/app"}) {
+		t.Errorf("github.repositories = %v", cfg.GitHub.Repositories)
+	}
+}
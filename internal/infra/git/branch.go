@@ -0,0 +1,167 @@
+package git
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// BranchStatus describes the checked-out branch and how it relates to its upstream
+type BranchStatus struct {
+	Branch   string
+	HeadSHA  string
+	Upstream string
+	Ahead    int
+	Behind   int
+}
+
+// CurrentBranchStatus returns the checked-out branch and commit with the
+// branch's ahead/behind counts. Branch is empty when HEAD is detached;
+// Upstream is empty when no upstream is set.
+func CurrentBranchStatus() (*BranchStatus, error) {
+	branch, err := runGit("rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read current branch: %w", err)
+	}
+	sha, err := HeadSHA()
+	if err != nil {
+		return nil, err
+	}
+
+	status := &BranchStatus{HeadSHA: sha}
+	if branch == "HEAD" {
+		return status, nil
+	}
+	status.Branch = branch
+
+	upstream, err := runGit("rev-parse", "--abbrev-ref", "--symbolic-full-name", "@{u}")
+	if err != nil {
+		// No upstream configured
+		return status, nil
+	}
+	status.Upstream = upstream
+
+	counts, err := runGit("rev-list", "--left-right", "--count", branch+"..."+upstream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare with upstream: %w", err)
+	}
+	status.Ahead, status.Behind, err = parseLeftRightCount(counts)
+	if err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// HeadSHA returns the full SHA of the checked-out commit
+func HeadSHA() (string, error) {
+	sha, err := runGit("rev-parse", "--verify", "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("failed to read HEAD: %w", err)
+	}
+	return sha, nil
+}
+
+// LocalBranches returns the names of all local branches
+func LocalBranches() ([]string, error) {
+	output, err := runGit("for-each-ref", "--format=%(refname:short)", "refs/heads")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list local branches: %w", err)
+	}
+	if output == "" {
+		return []string{}, nil
+	}
+	return strings.Split(output, "\n"), nil
+}
+
+// PullRequestBranch returns the local branch a pull request is checked out into.
+// The head branch name is not used: fork PRs often come from a branch such as
+// main that means something else locally.
+func PullRequestBranch(number int) string {
+	return fmt.Sprintf("pr/%d", number)
+}
+
+// CheckoutPullRequest fetches the head of a pull request from origin and checks
+// it out in its own branch (see PullRequestBranch). An existing branch is
+// fast-forwarded to the fetched head; one that has diverged from it is left
+// alone and reported, so local work is never lost.
+func CheckoutPullRequest(number int) error {
+	if _, err := runGit("fetch", "origin", fmt.Sprintf("pull/%d/head", number)); err != nil {
+		return fmt.Errorf("failed to fetch pull request #%d: %w", number, err)
+	}
+	head, err := runGit("rev-parse", "--verify", "FETCH_HEAD^{commit}")
+	if err != nil {
+		return fmt.Errorf("failed to read the head of pull request #%d: %w", number, err)
+	}
+
+	branch := PullRequestBranch(number)
+	if _, err := runGit("rev-parse", "--verify", "--quiet", "refs/heads/"+branch); err != nil {
+		if _, err := runGit("checkout", "-b", branch, head); err != nil {
+			return fmt.Errorf("failed to checkout %s: %w", branch, err)
+		}
+		return nil
+	}
+
+	// The branch may be behind the PR (fast-forward) or ahead with local commits (kept)
+	_, behind := runGit("merge-base", "--is-ancestor", branch, head)
+	_, ahead := runGit("merge-base", "--is-ancestor", head, branch)
+	if behind != nil && ahead != nil {
+		return fmt.Errorf("%s has diverged from pull request #%d", branch, number)
+	}
+	if _, err := runGit("checkout", branch); err != nil {
+		return fmt.Errorf("failed to checkout %s: %w", branch, err)
+	}
+	if behind == nil {
+		if _, err := runGit("merge", "--ff-only", head); err != nil {
+			return fmt.Errorf("failed to update %s: %w", branch, err)
+		}
+	}
+	return nil
+}
+
+// CheckoutCommit checks out a commit as a detached HEAD,
+// fetching it from origin first when it is not available locally
+func CheckoutCommit(sha string) error {
+	if _, err := runGit("rev-parse", "--verify", "--quiet", sha+"^{commit}"); err != nil {
+		if _, err := runGit("fetch", "origin", sha); err != nil {
+			return fmt.Errorf("failed to fetch %s: %w", sha, err)
+		}
+	}
+
+	if _, err := runGit("checkout", "--detach", sha); err != nil {
+		return fmt.Errorf("failed to checkout %s: %w", sha, err)
+	}
+	return nil
+}
+
+// parseLeftRightCount parses the "<ahead>\t<behind>" output of git rev-list --left-right --count
+func parseLeftRightCount(output string) (ahead, behind int, err error) {
+	fields := strings.Fields(output)
+	if len(fields) != 2 {
+		return 0, 0, fmt.Errorf("unexpected rev-list output: %q", output)
+	}
+	if ahead, err = strconv.Atoi(fields[0]); err != nil {
+		return 0, 0, fmt.Errorf("unexpected rev-list output: %q", output)
+	}
+	if behind, err = strconv.Atoi(fields[1]); err != nil {
+		return 0, 0, fmt.Errorf("unexpected rev-list output: %q", output)
+	}
+	return ahead, behind, nil
+}
+
+// runGit runs a git command in the current directory and returns its trimmed output.
+// Errors include git's stderr so that failures such as a dirty worktree are readable.
+func runGit(args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	output, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			if stderr := strings.TrimSpace(string(exitErr.Stderr)); stderr != "" {
+				return "", fmt.Errorf("%s", stderr)
+			}
+		}
+		return "", err
+	}
+	return strings.TrimSpace(string(output)), nil
+}
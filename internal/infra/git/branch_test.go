@@ -0,0 +1,225 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// gitIn runs a git command in dir and fails the test on error
+func gitIn(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, output)
+	}
+}
+
+// commitFile writes a file and commits it
+func commitFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	gitIn(t, dir, "add", name)
+	gitIn(t, dir, "commit", "-q", "-m", name)
+}
+
+// revParse resolves a revision in dir
+func revParse(t *testing.T, dir, rev string) string {
+	t.Helper()
+	cmd := exec.Command("git", "rev-parse", rev)
+	cmd.Dir = dir
+	output, err := cmd.Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// setupClone creates an origin repository and a clone of it, and moves into the clone
+func setupClone(t *testing.T) (origin, clone string) {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	t.Setenv("GIT_CONFIG_GLOBAL", os.DevNull)
+	t.Setenv("GIT_AUTHOR_NAME", "tester")
+	t.Setenv("GIT_AUTHOR_EMAIL", "tester@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "tester")
+	t.Setenv("GIT_COMMITTER_EMAIL", "tester@example.com")
+
+	root := t.TempDir()
+	origin = filepath.Join(root, "origin")
+	clone = filepath.Join(root, "clone")
+
+	gitIn(t, root, "init", "-q", "-b", "main", origin)
+	commitFile(t, origin, "README.md", "hello\n")
+	gitIn(t, root, "clone", "-q", origin, clone)
+
+	t.Chdir(clone)
+	return origin, clone
+}
+
+func TestCurrentBranchStatus(t *testing.T) {
+	origin, clone := setupClone(t)
+
+	commitFile(t, clone, "local.txt", "local\n")
+	commitFile(t, clone, "local2.txt", "local\n")
+	commitFile(t, origin, "remote.txt", "remote\n")
+	gitIn(t, clone, "fetch", "-q", "origin")
+
+	status, err := CurrentBranchStatus()
+	if err != nil {
+		t.Fatalf("CurrentBranchStatus() error = %v", err)
+	}
+	if status.Branch != "main" || status.Upstream != "origin/main" {
+		t.Errorf("branch = %q, upstream = %q", status.Branch, status.Upstream)
+	}
+	if status.Ahead != 2 || status.Behind != 1 {
+		t.Errorf("ahead = %d, behind = %d, want 2 and 1", status.Ahead, status.Behind)
+	}
+}
+
+func TestCurrentBranchStatus_NoUpstream(t *testing.T) {
+	_, clone := setupClone(t)
+	gitIn(t, clone, "checkout", "-q", "-b", "feature")
+
+	status, err := CurrentBranchStatus()
+	if err != nil {
+		t.Fatalf("CurrentBranchStatus() error = %v", err)
+	}
+	if status.Branch != "feature" || status.Upstream != "" {
+		t.Errorf("branch = %q, upstream = %q", status.Branch, status.Upstream)
+	}
+}
+
+func TestCurrentBranchStatus_Detached(t *testing.T) {
+	_, clone := setupClone(t)
+	gitIn(t, clone, "checkout", "-q", "--detach")
+
+	status, err := CurrentBranchStatus()
+	if err != nil {
+		t.Fatalf("CurrentBranchStatus() error = %v", err)
+	}
+	if status.Branch != "" {
+		t.Errorf("branch = %q, want empty for detached HEAD", status.Branch)
+	}
+	if status.HeadSHA != revParse(t, clone, "HEAD") {
+		t.Errorf("HeadSHA = %q, want the detached commit", status.HeadSHA)
+	}
+}
+
+func TestHeadSHA(t *testing.T) {
+	_, clone := setupClone(t)
+	commitFile(t, clone, "local.txt", "local\n")
+
+	sha, err := HeadSHA()
+	if err != nil {
+		t.Fatalf("HeadSHA() error = %v", err)
+	}
+	if want := revParse(t, clone, "HEAD"); sha != want {
+		t.Errorf("HeadSHA() = %q, want %q", sha, want)
+	}
+}
+
+func TestLocalBranches(t *testing.T) {
+	_, clone := setupClone(t)
+	gitIn(t, clone, "branch", "feature")
+
+	branches, err := LocalBranches()
+	if err != nil {
+		t.Fatalf("LocalBranches() error = %v", err)
+	}
+	if len(branches) != 2 || branches[0] != "feature" || branches[1] != "main" {
+		t.Errorf("LocalBranches() = %v", branches)
+	}
+}
+
+func TestCheckoutPullRequest(t *testing.T) {
+	origin, clone := setupClone(t)
+
+	// GitHub exposes PR heads as refs/pull/<n>/head on the base repository
+	gitIn(t, origin, "checkout", "-q", "-b", "fix-typo")
+	commitFile(t, origin, "fix.txt", "fix\n")
+	gitIn(t, origin, "update-ref", "refs/pull/7/head", "HEAD")
+	gitIn(t, origin, "checkout", "-q", "main")
+
+	if err := CheckoutPullRequest(7); err != nil {
+		t.Fatalf("CheckoutPullRequest() error = %v", err)
+	}
+	status, err := CurrentBranchStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Branch != "pr/7" || status.HeadSHA != revParse(t, origin, "refs/pull/7/head") {
+		t.Errorf("checked out %q at %s, want pr/7 at the PR head", status.Branch, status.HeadSHA)
+	}
+
+	// A new push to the PR fast-forwards the existing branch
+	gitIn(t, clone, "checkout", "-q", "main")
+	gitIn(t, origin, "checkout", "-q", "fix-typo")
+	commitFile(t, origin, "fix2.txt", "fix\n")
+	gitIn(t, origin, "update-ref", "refs/pull/7/head", "HEAD")
+	gitIn(t, origin, "checkout", "-q", "main")
+	if err := CheckoutPullRequest(7); err != nil {
+		t.Fatalf("CheckoutPullRequest() update error = %v", err)
+	}
+	if head := revParse(t, clone, "HEAD"); head != revParse(t, origin, "refs/pull/7/head") {
+		t.Errorf("HEAD = %s, want the new PR head", head)
+	}
+
+	// A branch that has diverged from the PR is not switched to
+	commitFile(t, clone, "local.txt", "local\n")
+	gitIn(t, origin, "checkout", "-q", "fix-typo")
+	gitIn(t, origin, "commit", "-q", "--amend", "-m", "rewritten")
+	gitIn(t, origin, "update-ref", "refs/pull/7/head", "HEAD")
+	gitIn(t, origin, "checkout", "-q", "main")
+	gitIn(t, clone, "checkout", "-q", "main")
+	if err := CheckoutPullRequest(7); err == nil || !strings.Contains(err.Error(), "diverged") {
+		t.Errorf("CheckoutPullRequest() on a diverged branch = %v, want an error", err)
+	}
+	if status, err := CurrentBranchStatus(); err != nil || status.Branch != "main" {
+		t.Errorf("expected to stay on main, got %+v (%v)", status, err)
+	}
+
+	if err := CheckoutPullRequest(99); err == nil {
+		t.Error("CheckoutPullRequest() for an unknown PR should fail")
+	}
+}
+
+func TestCheckoutCommit(t *testing.T) {
+	origin, _ := setupClone(t)
+
+	// A commit that only exists on origin is fetched first
+	commitFile(t, origin, "later.txt", "later\n")
+	sha := revParse(t, origin, "HEAD")
+
+	if err := CheckoutCommit(sha); err != nil {
+		t.Fatalf("CheckoutCommit() error = %v", err)
+	}
+	status, err := CurrentBranchStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Branch != "" {
+		t.Errorf("expected a detached HEAD, got branch %q", status.Branch)
+	}
+	if head, err := runGit("rev-parse", "HEAD"); err != nil || head != sha {
+		t.Errorf("HEAD = %q, want %q (%v)", head, sha, err)
+	}
+}
+
+func TestParseLeftRightCount(t *testing.T) {
+	ahead, behind, err := parseLeftRightCount("3\t5")
+	if err != nil || ahead != 3 || behind != 5 {
+		t.Errorf("parseLeftRightCount() = %d, %d, %v", ahead, behind, err)
+	}
+	if _, _, err := parseLeftRightCount("garbage"); err == nil {
+		t.Error("parseLeftRightCount() should reject malformed output")
+	}
+}
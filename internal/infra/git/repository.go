@@ -70,6 +70,61 @@ func ParseGitHubURL(urlStr string) (owner, repo string, err error) {
 	return owner, repo, nil
 }
 
+// ParseRepositoryArg parses a repository given on the command line. Besides
+// owner/repo it accepts what users paste: web URLs of any page in the
+// repository, clone URLs and ssh remotes, with or without a trailing .git:
+//   - owner/repo, owner/repo.git
+//   - https://github.com/owner/repo/pull/12, github.com/owner/repo
+//   - git@github.com:owner/repo.git, ssh://git@github.com/owner/repo.git
+func ParseRepositoryArg(arg string) (owner, repo string, err error) {
+	arg = strings.TrimSpace(arg)
+
+	path, isURL := arg, true
+	switch {
+	case strings.HasPrefix(arg, "git@"):
+		host, rest, ok := strings.Cut(strings.TrimPrefix(arg, "git@"), ":")
+		if !ok || !isGitHubHost(host) {
+			return "", "", fmt.Errorf("not a GitHub remote: %s", arg)
+		}
+		path = rest
+	case strings.Contains(arg, "://"):
+		u, err := url.Parse(arg)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to parse URL: %w", err)
+		}
+		if !isGitHubHost(u.Hostname()) {
+			return "", "", fmt.Errorf("not a GitHub URL: %s", arg)
+		}
+		path = u.Path
+	default:
+		// A URL without the scheme, e.g. github.com/owner/repo, or a plain slug
+		host, rest, _ := strings.Cut(arg, "/")
+		if isGitHubHost(host) {
+			path = rest
+		} else {
+			isURL = false
+		}
+	}
+
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	// URLs may point below the repository (a PR, a file); a slug is just owner/repo
+	if len(parts) < 2 || (!isURL && len(parts) != 2) || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid repository format %q (expected owner/repo or a GitHub URL)", arg)
+	}
+	repo = strings.TrimSuffix(parts[1], ".git")
+	if repo == "" {
+		return "", "", fmt.Errorf("invalid repository format %q (expected owner/repo or a GitHub URL)", arg)
+	}
+
+	return parts[0], repo, nil
+}
+
+// isGitHubHost reports whether host serves github.com
+func isGitHubHost(host string) bool {
+	host = strings.ToLower(host)
+	return host == "github.com" || host == "www.github.com"
+}
+
 // IsGitRepository checks if the current directory is a Git repository
 func IsGitRepository() bool {
 	cmd := exec.Command("git", "rev-parse", "--git-dir")
@@ -121,3 +121,47 @@ func TestParseGitHubURL(t *testing.T) {
 		})
 	}
 }
+
+func TestParseRepositoryArg(t *testing.T) {
+	tests := []struct {
+		arg     string
+		want    string
+		wantErr bool
+	}{
+		{arg: "owner/repo", want: "owner/repo"},
+		{arg: "owner/repo.git", want: "owner/repo"},
+		{arg: "https://github.com/owner/repo", want: "owner/repo"},
+		{arg: "https://github.com/owner/repo/", want: "owner/repo"},
+		{arg: "https://github.com/owner/repo.git", want: "owner/repo"},
+		{arg: "https://github.com/owner/repo/pull/12/files", want: "owner/repo"},
+		{arg: "https://www.github.com/owner/repo/tree/main?tab=readme", want: "owner/repo"},
+		{arg: "github.com/owner/repo/issues/3", want: "owner/repo"},
+		{arg: "git@github.com:owner/repo.git", want: "owner/repo"},
+		{arg: "ssh://git@github.com/owner/repo.git", want: "owner/repo"},
+		{arg: "  owner/repo\n", want: "owner/repo"},
+		{arg: "owner", wantErr: true},
+		{arg: "owner/repo/extra", wantErr: true},
+		{arg: "/repo", wantErr: true},
+		{arg: "https://github.com/owner", wantErr: true},
+		{arg: "https://gitlab.com/owner/repo", wantErr: true},
+		{arg: "git@gitlab.com:owner/repo.git", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			owner, repo, err := ParseRepositoryArg(tt.arg)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseRepositoryArg(%q) = %s/%s, want an error", tt.arg, owner, repo)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRepositoryArg(%q) unexpected error = %v", tt.arg, err)
+			}
+			if got := owner + "/" + repo; got != tt.want {
+				t.Errorf("ParseRepositoryArg(%q) = %s, want %s", tt.arg, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// PullRequestWorktree fetches the head of a pull request from origin and checks
+// it out, detached, in a worktree of its own so the current checkout is left
+// untouched. The worktree is <parent>/<repository>-pr-<number>, next to the
+// clone when parent is empty. An existing worktree is moved to the fetched head.
+func PullRequestWorktree(number int, parent string) (string, error) {
+	top, err := runGit("rev-parse", "--show-toplevel")
+	if err != nil {
+		return "", fmt.Errorf("failed to find the repository root: %w", err)
+	}
+	path := pullRequestWorktreePath(top, parent, number)
+
+	ref := fmt.Sprintf("refs/tig-gh/pull/%d", number)
+	if _, err := runGit("fetch", "origin", fmt.Sprintf("+pull/%d/head:%s", number, ref)); err != nil {
+		return "", fmt.Errorf("failed to fetch pull request #%d: %w", number, err)
+	}
+
+	if _, err := os.Stat(path); err == nil {
+		if _, err := runGit("-C", path, "checkout", "--detach", ref); err != nil {
+			return "", fmt.Errorf("failed to update worktree %s: %w", path, err)
+		}
+		return path, nil
+	}
+	if _, err := runGit("worktree", "add", "--detach", path, ref); err != nil {
+		return "", fmt.Errorf("failed to create worktree %s: %w", path, err)
+	}
+	return path, nil
+}
+
+// pullRequestWorktreePath returns where the worktree of a pull request goes
+func pullRequestWorktreePath(top, parent string, number int) string {
+	if parent == "" {
+		parent = filepath.Dir(top)
+	}
+	return filepath.Join(parent, fmt.Sprintf("%s-pr-%d", filepath.Base(top), number))
+}
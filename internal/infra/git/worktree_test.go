@@ -0,0 +1,55 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPullRequestWorktree(t *testing.T) {
+	origin, clone := setupClone(t)
+
+	gitIn(t, origin, "checkout", "-q", "-b", "fix-typo")
+	commitFile(t, origin, "fix.txt", "fix\n")
+	gitIn(t, origin, "update-ref", "refs/pull/7/head", "HEAD")
+	gitIn(t, origin, "checkout", "-q", "main")
+
+	path, err := PullRequestWorktree(7, "")
+	if err != nil {
+		t.Fatalf("PullRequestWorktree() error = %v", err)
+	}
+	if want := filepath.Join(filepath.Dir(clone), "clone-pr-7"); path != want {
+		t.Errorf("path = %q, want %q", path, want)
+	}
+	if _, err := os.Stat(filepath.Join(path, "fix.txt")); err != nil {
+		t.Errorf("expected the PR head in the worktree: %v", err)
+	}
+	// The current checkout is left alone
+	if status, err := CurrentBranchStatus(); err != nil || status.Branch != "main" {
+		t.Errorf("current branch = %+v (%v), want main", status, err)
+	}
+
+	// A new push moves the existing worktree along
+	gitIn(t, origin, "checkout", "-q", "fix-typo")
+	commitFile(t, origin, "more.txt", "more\n")
+	gitIn(t, origin, "update-ref", "refs/pull/7/head", "HEAD")
+	if _, err := PullRequestWorktree(7, ""); err != nil {
+		t.Fatalf("PullRequestWorktree() again error = %v", err)
+	}
+	if got, want := revParse(t, path, "HEAD"), revParse(t, origin, "HEAD"); got != want {
+		t.Errorf("worktree HEAD = %q, want %q", got, want)
+	}
+
+	if _, err := PullRequestWorktree(99, ""); err == nil {
+		t.Error("PullRequestWorktree() for an unknown PR should fail")
+	}
+}
+
+func TestPullRequestWorktreePath(t *testing.T) {
+	if got, want := pullRequestWorktreePath("/src/app", "", 3), filepath.Join("/src", "app-pr-3"); got != want {
+		t.Errorf("pullRequestWorktreePath() = %q, want %q", got, want)
+	}
+	if got, want := pullRequestWorktreePath("/src/app", "/tmp/review", 3), filepath.Join("/tmp/review", "app-pr-3"); got != want {
+		t.Errorf("pullRequestWorktreePath() = %q, want %q", got, want)
+	}
+}
@@ -2,28 +2,75 @@ package github
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/infra/apiusage"
 	"github.com/google/go-github/v57/github"
 	"golang.org/x/oauth2"
 )
 
 // Client wraps the GitHub API client
 type Client struct {
-	client *github.Client
+	client  *github.Client
+	retry   *retryTransport
+	timeout *timeoutTransport
+	http    *http.Transport
+	usage   *apiusage.Counter
 }
 
-// NewClient creates a new GitHub API client with authentication
+// NewClient creates a new GitHub API client with authentication.
+// An empty token creates an unauthenticated client (public data only, lower rate limits).
+// Transient failures are retried DefaultRetries times; see SetRetries.
+// Requests time out after DefaultTimeouts; see SetTimeouts.
+// Every request is counted in Usage.
 func NewClient(token string) *Client {
-	ctx := context.Background()
-	ts := oauth2.StaticTokenSource(
-		&oauth2.Token{AccessToken: token},
-	)
-	tc := oauth2.NewClient(ctx, ts)
+	usage := apiusage.NewCounter()
+	transport := newHTTPTransport(DefaultTimeouts.Connect)
+	var base http.RoundTripper = transport
+	if token != "" {
+		ts := oauth2.StaticTokenSource(
+			&oauth2.Token{AccessToken: token},
+		)
+		base = &oauth2.Transport{Source: ts, Base: transport}
+	}
 
+	timeout := &timeoutTransport{base: &usageTransport{base: base, counter: usage}, timeouts: DefaultTimeouts}
+	retry := newRetryTransport(timeout, DefaultRetries)
 	return &Client{
-		client: github.NewClient(tc),
+		client:  github.NewClient(&http.Client{Transport: retry}),
+		retry:   retry,
+		timeout: timeout,
+		http:    transport,
+		usage:   usage,
+	}
+}
+
+// NewClientForHost creates a client for the API at apiBaseURL. An empty URL or
+// api.github.com connects to github.com; any other URL is treated as a GitHub
+// Enterprise Server, with uploadBaseURL defaulting to the server's upload API.
+func NewClientForHost(token, apiBaseURL, uploadBaseURL string) (*Client, error) {
+	c := NewClient(token)
+	apiBaseURL = strings.TrimSpace(apiBaseURL)
+	if apiBaseURL == "" || strings.HasPrefix(apiBaseURL, "https://api.github.com") {
+		return c, nil
+	}
+	if strings.TrimSpace(uploadBaseURL) == "" || strings.HasPrefix(uploadBaseURL, "https://uploads.github.com") {
+		u, err := url.Parse(apiBaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid GitHub Enterprise URL %q: %w", apiBaseURL, err)
+		}
+		uploadBaseURL = u.Scheme + "://" + u.Host + "/"
 	}
+
+	enterprise, err := c.client.WithEnterpriseURLs(apiBaseURL, uploadBaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid GitHub Enterprise URL %q: %w", apiBaseURL, err)
+	}
+	return &Client{client: enterprise, retry: c.retry, timeout: c.timeout, http: c.http, usage: c.usage}, nil
 }
 
 // NewClientWithHTTPClient creates a new GitHub API client with a custom HTTP client
@@ -33,6 +80,47 @@ func NewClientWithHTTPClient(httpClient *http.Client) *Client {
 	}
 }
 
+// SetRetries sets how many times a request is retried after a 5xx response
+// or a secondary rate limit. Zero disables retries. Clients created with a
+// custom HTTP client do not retry.
+func (c *Client) SetRetries(retries int) {
+	if c.retry == nil {
+		return
+	}
+	if retries < 0 {
+		retries = 0
+	}
+	c.retry.retries = retries
+}
+
+// SetTimeouts sets how long connecting may take and the deadline of each
+// kind of request (github.timeouts). Zero disables a limit. Clients created
+// with a custom HTTP client do not time out. Call it before any request.
+func (c *Client) SetTimeouts(timeouts models.TimeoutsConfig) {
+	if c.timeout == nil {
+		return
+	}
+	c.timeout.timeouts = timeouts
+	setConnectTimeout(c.http, timeouts.Connect)
+}
+
+// defaultPerPage is the page size of list requests that do not set one
+var defaultPerPage = 30
+
+// SetDefaultPageSize sets the page size of list requests that do not set one
+// (ui.page_size). Values outside 1-100 are ignored. Call it before any request.
+func SetDefaultPageSize(n int) {
+	if n > 0 && n <= 100 {
+		defaultPerPage = n
+	}
+}
+
+// Usage returns the API calls made by this client during the session.
+// Clients created with a custom HTTP client are not instrumented and return nil.
+func (c *Client) Usage() *apiusage.Counter {
+	return c.usage
+}
+
 // GetClient returns the underlying GitHub client
 func (c *Client) GetClient() *github.Client {
 	return c.client
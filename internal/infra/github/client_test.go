@@ -0,0 +1,31 @@
+package github
+
+import "testing"
+
+func TestNewClientForHost(t *testing.T) {
+	tests := []struct {
+		name       string
+		apiBaseURL string
+		wantBase   string
+		wantUpload string
+	}{
+		{name: "github.com", apiBaseURL: "https://api.github.com/", wantBase: "https://api.github.com/", wantUpload: "https://uploads.github.com/"},
+		{name: "empty", apiBaseURL: "", wantBase: "https://api.github.com/", wantUpload: "https://uploads.github.com/"},
+		{name: "enterprise", apiBaseURL: "https://ghe.example.com/api/v3/", wantBase: "https://ghe.example.com/api/v3/", wantUpload: "https://ghe.example.com/api/uploads/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClientForHost("token", tt.apiBaseURL, "https://uploads.github.com/")
+			if err != nil {
+				t.Fatalf("NewClientForHost() error = %v", err)
+			}
+			if got := client.GetClient().BaseURL.String(); got != tt.wantBase {
+				t.Errorf("BaseURL = %s, want %s", got, tt.wantBase)
+			}
+			if got := client.GetClient().UploadURL.String(); got != tt.wantUpload {
+				t.Errorf("UploadURL = %s, want %s", got, tt.wantUpload)
+			}
+		})
+	}
+}
@@ -106,12 +106,13 @@ func convertToDiffFile(ghFile *github.CommitFile) *models.DiffFile {
 	}
 
 	return &models.DiffFile{
-		Filename:  ghFile.GetFilename(),
-		Status:    convertToFileStatus(ghFile.GetStatus()),
-		Additions: ghFile.GetAdditions(),
-		Deletions: ghFile.GetDeletions(),
-		Changes:   ghFile.GetChanges(),
-		Patch:     ghFile.GetPatch(),
+		Filename:         ghFile.GetFilename(),
+		PreviousFilename: ghFile.GetPreviousFilename(),
+		Status:           convertToFileStatus(ghFile.GetStatus()),
+		Additions:        ghFile.GetAdditions(),
+		Deletions:        ghFile.GetDeletions(),
+		Changes:          ghFile.GetChanges(),
+		Patch:            ghFile.GetPatch(),
 	}
 }
 
@@ -131,6 +132,18 @@ func convertToFileStatus(status string) models.FileStatus {
 	}
 }
 
+// convertToCheckState converts a GitHub combined status state to a domain check state
+func convertToCheckState(state string) models.CheckState {
+	switch state {
+	case "success":
+		return models.CheckStateSuccess
+	case "failure", "error":
+		return models.CheckStateFailure
+	default:
+		return models.CheckStatePending
+	}
+}
+
 // convertToComparison converts a GitHub commits comparison to a domain comparison
 func convertToComparison(ghComparison *github.CommitsComparison) *models.Comparison {
 	if ghComparison == nil {
@@ -219,7 +232,7 @@ func convertFromCommitOptions(opts *models.CommitOptions) *github.CommitsListOpt
 	if opts == nil {
 		return &github.CommitsListOptions{
 			ListOptions: github.ListOptions{
-				PerPage: 30,
+				PerPage: defaultPerPage,
 			},
 		}
 	}
@@ -243,7 +256,7 @@ func convertFromCommitOptions(opts *models.CommitOptions) *github.CommitsListOpt
 	}
 
 	if ghOpts.ListOptions.PerPage == 0 {
-		ghOpts.ListOptions.PerPage = 30
+		ghOpts.ListOptions.PerPage = defaultPerPage
 	}
 
 	return ghOpts
@@ -2,9 +2,11 @@ package github
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/a1yama/tig-gh/internal/domain/models"
 	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/google/go-github/v57/github"
 )
 
 // CommitRepositoryImpl implements the CommitRepository interface
@@ -70,3 +72,40 @@ func (r *CommitRepositoryImpl) GetBranch(ctx context.Context, owner, repo, branc
 
 	return convertToBranch(ghBranch), nil
 }
+
+// GetDefaultBranch retrieves the name of the repository's default branch
+func (r *CommitRepositoryImpl) GetDefaultBranch(ctx context.Context, owner, repo string) (string, error) {
+	ghRepo, resp, err := r.client.client.Repositories.Get(ctx, owner, repo)
+	if err != nil {
+		return "", handleGitHubError(err, resp)
+	}
+
+	return ghRepo.GetDefaultBranch(), nil
+}
+
+// GetFileContent retrieves the content of a file at the given ref
+func (r *CommitRepositoryImpl) GetFileContent(ctx context.Context, owner, repo, path, ref string) (string, error) {
+	fileContent, _, resp, err := r.client.client.Repositories.GetContents(ctx, owner, repo, path, &github.RepositoryContentGetOptions{Ref: ref})
+	if err != nil {
+		return "", handleGitHubError(err, resp)
+	}
+	if fileContent == nil {
+		return "", fmt.Errorf("%s is not a file", path)
+	}
+
+	return fileContent.GetContent()
+}
+
+// GetCombinedStatus retrieves the combined commit status of a ref
+func (r *CommitRepositoryImpl) GetCombinedStatus(ctx context.Context, owner, repo, ref string) (models.CheckState, error) {
+	combined, resp, err := r.client.client.Repositories.GetCombinedStatus(ctx, owner, repo, ref, nil)
+	if err != nil {
+		return "", handleGitHubError(err, resp)
+	}
+
+	// GitHub reports "pending" for refs without any status; treat those as unknown
+	if combined.GetTotalCount() == 0 {
+		return "", nil
+	}
+	return convertToCheckState(combined.GetState()), nil
+}
@@ -0,0 +1,64 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func TestGetCombinedStatus(t *testing.T) {
+	responses := map[string]string{
+		"/repos/owner/repo/commits/green/status":   `{"state":"success","total_count":2}`,
+		"/repos/owner/repo/commits/red/status":     `{"state":"error","total_count":1}`,
+		"/repos/owner/repo/commits/running/status": `{"state":"pending","total_count":1}`,
+		"/repos/owner/repo/commits/none/status":    `{"state":"pending","total_count":0}`,
+	}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	})
+	repo := NewCommitRepository(client)
+
+	tests := map[string]models.CheckState{
+		"green":   models.CheckStateSuccess,
+		"red":     models.CheckStateFailure,
+		"running": models.CheckStatePending,
+		"none":    "",
+	}
+	for ref, want := range tests {
+		got, err := repo.GetCombinedStatus(context.Background(), "owner", "repo", ref)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", ref, err)
+		}
+		if got != want {
+			t.Errorf("%s: state = %q, want %q", ref, got, want)
+		}
+	}
+}
+
+func TestGetDefaultBranch(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/owner/repo" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"name":"repo","default_branch":"trunk"}`))
+	})
+	repo := NewCommitRepository(client)
+
+	branch, err := repo.GetDefaultBranch(context.Background(), "owner", "repo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if branch != "trunk" {
+		t.Errorf("branch = %q, want trunk", branch)
+	}
+}
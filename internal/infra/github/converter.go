@@ -64,6 +64,10 @@ func convertToIssue(ghIssue *github.Issue) *models.Issue {
 		issue.ClosedAt = &closedAt
 	}
 
+	if ghIssue.Reactions != nil {
+		issue.Reactions = convertToReactions(ghIssue.Reactions)
+	}
+
 	return issue
 }
 
@@ -112,6 +116,19 @@ func convertToUser(ghUser *github.User) models.User {
 	}
 }
 
+// convertToTeam converts a GitHub team to a domain team. Team listings often
+// leave out the organization, so org is used when it is missing.
+func convertToTeam(ghTeam *github.Team, org string) models.Team {
+	if login := ghTeam.GetOrganization().GetLogin(); login != "" {
+		org = login
+	}
+	return models.Team{
+		Org:  org,
+		Slug: ghTeam.GetSlug(),
+		Name: ghTeam.GetName(),
+	}
+}
+
 // convertToLabel converts a GitHub label to a domain label
 func convertToLabel(ghLabel *github.Label) models.Label {
 	if ghLabel == nil {
@@ -176,7 +193,7 @@ func convertFromIssueOptions(opts *models.IssueOptions) *github.IssueListByRepoO
 		return &github.IssueListByRepoOptions{
 			State: "all",
 			ListOptions: github.ListOptions{
-				PerPage: 30,
+				PerPage: defaultPerPage,
 			},
 		}
 	}
@@ -199,8 +216,15 @@ func convertFromIssueOptions(opts *models.IssueOptions) *github.IssueListByRepoO
 		ghOpts.Since = *opts.Since
 	}
 
+	// The API cannot sort by reactions; the most recently updated issues are
+	// fetched and sorted by the caller
+	if opts.Sort == models.IssueSortReactions {
+		ghOpts.Sort = string(models.IssueSortUpdated)
+		ghOpts.Direction = string(models.SortDirectionDesc)
+	}
+
 	if ghOpts.ListOptions.PerPage == 0 {
-		ghOpts.ListOptions.PerPage = 30
+		ghOpts.ListOptions.PerPage = defaultPerPage
 	}
 
 	return ghOpts
@@ -321,6 +345,13 @@ func convertToPullRequest(ghPR *github.PullRequest) *models.PullRequest {
 		}
 	}
 
+	if len(ghPR.RequestedTeams) > 0 {
+		pr.RequestedTeams = make([]models.Team, 0, len(ghPR.RequestedTeams))
+		for _, team := range ghPR.RequestedTeams {
+			pr.RequestedTeams = append(pr.RequestedTeams, convertToTeam(team, ghPR.GetBase().GetRepo().GetOwner().GetLogin()))
+		}
+	}
+
 	if len(ghPR.Assignees) > 0 {
 		pr.Assignees = make([]models.User, 0, len(ghPR.Assignees))
 		for _, assignee := range ghPR.Assignees {
@@ -458,7 +489,7 @@ func convertFromPROptions(opts *models.PROptions) *github.PullRequestListOptions
 		return &github.PullRequestListOptions{
 			State: "all",
 			ListOptions: github.ListOptions{
-				PerPage: 30,
+				PerPage: defaultPerPage,
 			},
 		}
 	}
@@ -476,7 +507,7 @@ func convertFromPROptions(opts *models.PROptions) *github.PullRequestListOptions
 	}
 
 	if ghOpts.ListOptions.PerPage == 0 {
-		ghOpts.ListOptions.PerPage = 30
+		ghOpts.ListOptions.PerPage = defaultPerPage
 	}
 
 	return ghOpts
@@ -553,6 +584,24 @@ func convertFromMergeOptions(opts *models.MergeOptions) *github.PullRequestOptio
 	return ghOpts
 }
 
+// convertFromCreateReviewInput converts domain review input to a GitHub review request
+func convertFromCreateReviewInput(input *models.CreateReviewInput) *github.PullRequestReviewRequest {
+	if input == nil {
+		return nil
+	}
+
+	event := string(input.Event)
+	req := &github.PullRequestReviewRequest{
+		Event: &event,
+	}
+
+	if input.Body != "" {
+		req.Body = &input.Body
+	}
+
+	return req
+}
+
 // convertToComment converts a GitHub issue comment to a domain comment
 func convertToComment(ghComment *github.IssueComment) *models.Comment {
 	if ghComment == nil {
@@ -571,5 +620,24 @@ func convertToComment(ghComment *github.IssueComment) *models.Comment {
 		comment.User = convertToUser(ghComment.User)
 	}
 
+	if ghComment.Reactions != nil {
+		comment.Reactions = convertToReactions(ghComment.Reactions)
+	}
+
 	return comment
 }
+
+// convertToReactions converts GitHub reaction counts to domain reactions
+func convertToReactions(ghReactions *github.Reactions) models.Reactions {
+	return models.Reactions{
+		TotalCount: ghReactions.GetTotalCount(),
+		PlusOne:    ghReactions.GetPlusOne(),
+		MinusOne:   ghReactions.GetMinusOne(),
+		Laugh:      ghReactions.GetLaugh(),
+		Confused:   ghReactions.GetConfused(),
+		Heart:      ghReactions.GetHeart(),
+		Hooray:     ghReactions.GetHooray(),
+		Rocket:     ghReactions.GetRocket(),
+		Eyes:       ghReactions.GetEyes(),
+	}
+}
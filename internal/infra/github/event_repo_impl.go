@@ -0,0 +1,99 @@
+package github
+
+import (
+	"context"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/google/go-github/v57/github"
+)
+
+// repositoryEventsPageSize is how many events a poll reads. GitHub keeps the
+// events of the last 90 days but a poll only needs the ones since the last.
+const repositoryEventsPageSize = 30
+
+// EventRepositoryImpl implements the EventRepository interface
+type EventRepositoryImpl struct {
+	client *Client
+}
+
+// NewEventRepository creates a new EventRepository implementation
+func NewEventRepository(client *Client) repository.EventRepository {
+	return &EventRepositoryImpl{
+		client: client,
+	}
+}
+
+// ListRepositoryEvents retrieves the latest changes to the issues and pull requests of a repository
+func (r *EventRepositoryImpl) ListRepositoryEvents(ctx context.Context, owner, repo string) ([]*models.RepositoryEvent, error) {
+	ghEvents, resp, err := r.client.client.Activity.ListRepositoryEvents(ctx, owner, repo, &github.ListOptions{PerPage: repositoryEventsPageSize})
+	if err != nil {
+		return nil, handleGitHubError(err, resp)
+	}
+
+	events := make([]*models.RepositoryEvent, 0, len(ghEvents))
+	for _, ghEvent := range ghEvents {
+		if event := convertToRepositoryEvent(ghEvent, owner, repo); event != nil {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+// convertToRepositoryEvent converts an event about an issue or pull request;
+// other events (pushes, stars, ...) and unreadable payloads return nil
+func convertToRepositoryEvent(ghEvent *github.Event, owner, repo string) *models.RepositoryEvent {
+	payload, err := ghEvent.ParsePayload()
+	if err != nil {
+		return nil
+	}
+
+	event := &models.RepositoryEvent{
+		ID:    ghEvent.GetID(),
+		Owner: owner,
+		Repo:  repo,
+		Actor: ghEvent.GetActor().GetLogin(),
+	}
+	if ghEvent.CreatedAt != nil {
+		event.CreatedAt = ghEvent.CreatedAt.Time
+	}
+
+	switch p := payload.(type) {
+	case *github.IssuesEvent:
+		event.Kind = models.RepositoryEventIssue
+		event.Action = p.GetAction()
+		event.Number = p.GetIssue().GetNumber()
+		event.IsPullRequest = p.GetIssue().IsPullRequest()
+	case *github.IssueCommentEvent:
+		// Comments on a pull request's conversation are issue comments too
+		event.Kind = models.RepositoryEventComment
+		event.Action = p.GetAction()
+		event.Number = p.GetIssue().GetNumber()
+		event.IsPullRequest = p.GetIssue().IsPullRequest()
+	case *github.PullRequestEvent:
+		event.Kind = models.RepositoryEventPullRequest
+		event.Action = p.GetAction()
+		event.Number = p.GetNumber()
+		if event.Number == 0 {
+			event.Number = p.GetPullRequest().GetNumber()
+		}
+		event.IsPullRequest = true
+		event.Merged = p.GetAction() == "closed" && p.GetPullRequest().GetMerged()
+	case *github.PullRequestReviewEvent:
+		event.Kind = models.RepositoryEventReview
+		event.Action = p.GetAction()
+		event.Number = p.GetPullRequest().GetNumber()
+		event.IsPullRequest = true
+	case *github.PullRequestReviewCommentEvent:
+		event.Kind = models.RepositoryEventReview
+		event.Action = p.GetAction()
+		event.Number = p.GetPullRequest().GetNumber()
+		event.IsPullRequest = true
+	default:
+		return nil
+	}
+	if event.Number <= 0 {
+		return nil
+	}
+	return event
+}
@@ -0,0 +1,56 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func TestEventRepository_ListRepositoryEvents(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/owner/repo/events" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`[
+			{"id":"5","type":"PullRequestEvent","actor":{"login":"carol"},"created_at":"2025-01-22T12:05:00Z",
+				"payload":{"action":"closed","number":7,"pull_request":{"number":7,"merged":true}}},
+			{"id":"4","type":"PushEvent","actor":{"login":"carol"},"payload":{"ref":"refs/heads/main"}},
+			{"id":"3","type":"IssueCommentEvent","actor":{"login":"bob"},
+				"payload":{"action":"created","issue":{"number":7,"pull_request":{"url":"https://api.github.com/repos/owner/repo/pulls/7"}}}},
+			{"id":"2","type":"PullRequestReviewEvent","actor":{"login":"dave"},
+				"payload":{"action":"created","pull_request":{"number":8}}},
+			{"id":"1","type":"IssuesEvent","actor":{"login":"alice"},
+				"payload":{"action":"opened","issue":{"number":12}}}
+		]`))
+	})
+	repo := NewEventRepository(client)
+
+	events, err := repo.ListRepositoryEvents(context.Background(), "owner", "repo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events) != 4 {
+		t.Fatalf("expected the push to be left out, got %d events", len(events))
+	}
+
+	merged := events[0]
+	if merged.ID != "5" || merged.Kind != models.RepositoryEventPullRequest || !merged.Merged || merged.Number != 7 {
+		t.Errorf("unexpected merge event %+v", merged)
+	}
+	if merged.CreatedAt.IsZero() || merged.Owner != "owner" || merged.Repo != "repo" {
+		t.Errorf("expected the time and repository to be set, got %+v", merged)
+	}
+	if comment := events[1]; comment.Kind != models.RepositoryEventComment || !comment.IsPullRequest || comment.Actor != "bob" {
+		t.Errorf("unexpected comment event %+v", comment)
+	}
+	if review := events[2]; review.Kind != models.RepositoryEventReview || review.Number != 8 {
+		t.Errorf("unexpected review event %+v", review)
+	}
+	if opened := events[3]; opened.Kind != models.RepositoryEventIssue || opened.IsPullRequest || opened.Summary() != "@alice opened #12" {
+		t.Errorf("unexpected issue event %+v (%q)", opened, opened.Summary())
+	}
+}
@@ -0,0 +1,100 @@
+package github
+
+import (
+	"sort"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/google/go-github/v57/github"
+)
+
+// convertToGist converts a GitHub gist to a domain gist
+func convertToGist(ghGist *github.Gist) *models.Gist {
+	if ghGist == nil {
+		return nil
+	}
+
+	gist := &models.Gist{
+		ID:          ghGist.GetID(),
+		Description: ghGist.GetDescription(),
+		Public:      ghGist.GetPublic(),
+		Owner:       convertToUser(ghGist.Owner),
+		HTMLURL:     ghGist.GetHTMLURL(),
+		Comments:    ghGist.GetComments(),
+		CreatedAt:   ghGist.GetCreatedAt().Time,
+		UpdatedAt:   ghGist.GetUpdatedAt().Time,
+	}
+
+	for name, ghFile := range ghGist.Files {
+		filename := ghFile.GetFilename()
+		if filename == "" {
+			filename = string(name)
+		}
+		gist.Files = append(gist.Files, &models.GistFile{
+			Filename: filename,
+			Language: ghFile.GetLanguage(),
+			Size:     ghFile.GetSize(),
+			RawURL:   ghFile.GetRawURL(),
+			Content:  ghFile.GetContent(),
+		})
+	}
+	// The API returns files as a map, keep them in a stable order
+	sort.Slice(gist.Files, func(i, j int) bool {
+		return gist.Files[i].Filename < gist.Files[j].Filename
+	})
+
+	return gist
+}
+
+// convertToGists converts a slice of GitHub gists to domain gists
+func convertToGists(ghGists []*github.Gist) []*models.Gist {
+	if len(ghGists) == 0 {
+		return nil
+	}
+
+	gists := make([]*models.Gist, 0, len(ghGists))
+	for _, ghGist := range ghGists {
+		if gist := convertToGist(ghGist); gist != nil {
+			gists = append(gists, gist)
+		}
+	}
+
+	return gists
+}
+
+// convertFromGistOptions converts domain gist options to GitHub gist list options
+func convertFromGistOptions(opts *models.GistOptions) *github.GistListOptions {
+	if opts == nil {
+		return &github.GistListOptions{ListOptions: github.ListOptions{PerPage: defaultPerPage}}
+	}
+
+	ghOpts := &github.GistListOptions{
+		ListOptions: github.ListOptions{
+			Page:    opts.Page,
+			PerPage: opts.PerPage,
+		},
+	}
+	if ghOpts.PerPage == 0 {
+		ghOpts.PerPage = defaultPerPage
+	}
+
+	return ghOpts
+}
+
+// convertFromCreateGistInput converts a domain create gist input to a GitHub gist
+func convertFromCreateGistInput(input *models.CreateGistInput) *github.Gist {
+	gist := &github.Gist{
+		Public: github.Bool(input.Public),
+		Files:  make(map[github.GistFilename]github.GistFile, len(input.Files)),
+	}
+
+	if input.Description != "" {
+		gist.Description = github.String(input.Description)
+	}
+	for name, content := range input.Files {
+		gist.Files[github.GistFilename(name)] = github.GistFile{
+			Content: github.String(content),
+		}
+	}
+
+	return gist
+}
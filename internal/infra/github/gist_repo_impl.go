@@ -0,0 +1,56 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+)
+
+// GistRepositoryImpl implements the GistRepository interface
+type GistRepositoryImpl struct {
+	client *Client
+}
+
+// NewGistRepository creates a new GistRepository implementation
+func NewGistRepository(client *Client) repository.GistRepository {
+	return &GistRepositoryImpl{
+		client: client,
+	}
+}
+
+// List retrieves the authenticated user's gists
+func (r *GistRepositoryImpl) List(ctx context.Context, opts *models.GistOptions) ([]*models.Gist, error) {
+	// An empty user lists the gists of the authenticated user
+	ghGists, resp, err := r.client.client.Gists.List(ctx, "", convertFromGistOptions(opts))
+	if err != nil {
+		return nil, handleGitHubError(err, resp)
+	}
+
+	return convertToGists(ghGists), nil
+}
+
+// Get retrieves a single gist including its file contents
+func (r *GistRepositoryImpl) Get(ctx context.Context, id string) (*models.Gist, error) {
+	ghGist, resp, err := r.client.client.Gists.Get(ctx, id)
+	if err != nil {
+		return nil, handleGitHubError(err, resp)
+	}
+
+	return convertToGist(ghGist), nil
+}
+
+// Create creates a new gist
+func (r *GistRepositoryImpl) Create(ctx context.Context, input *models.CreateGistInput) (*models.Gist, error) {
+	if input == nil || len(input.Files) == 0 {
+		return nil, fmt.Errorf("at least one file is required")
+	}
+
+	ghGist, resp, err := r.client.client.Gists.Create(ctx, convertFromCreateGistInput(input))
+	if err != nil {
+		return nil, handleGitHubError(err, resp)
+	}
+
+	return convertToGist(ghGist), nil
+}
@@ -0,0 +1,79 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func TestGistRepository_GetSortsFiles(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/gists/abc" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"abc","description":"snippets","public":false,
+			"files":{
+				"z.md":{"filename":"z.md","content":"# z","raw_url":"https://example.com/z"},
+				"a.go":{"filename":"a.go","language":"Go","size":9,"content":"package a"}
+			}}`))
+	})
+	repo := NewGistRepository(client)
+
+	gist, err := repo.Get(context.Background(), "abc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(gist.Files) != 2 || gist.Files[0].Filename != "a.go" || gist.Files[1].Filename != "z.md" {
+		t.Fatalf("unexpected files %+v", gist.Files)
+	}
+	if gist.Files[0].Content != "package a" || gist.Files[1].RawURL != "https://example.com/z" {
+		t.Errorf("unexpected file contents %+v %+v", gist.Files[0], gist.Files[1])
+	}
+}
+
+func TestGistRepository_Create(t *testing.T) {
+	var body struct {
+		Description string                       `json:"description"`
+		Public      bool                         `json:"public"`
+		Files       map[string]map[string]string `json:"files"`
+	}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/gists" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(data, &body); err != nil {
+			t.Errorf("invalid body: %v", err)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"new","html_url":"https://gist.github.com/new"}`))
+	})
+	repo := NewGistRepository(client)
+
+	gist, err := repo.Create(context.Background(), &models.CreateGistInput{
+		Description: "deploy",
+		Public:      true,
+		Files:       map[string]string{"deploy.sh": "echo hi"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gist.HTMLURL != "https://gist.github.com/new" {
+		t.Errorf("html url = %q", gist.HTMLURL)
+	}
+	if body.Description != "deploy" || !body.Public || body.Files["deploy.sh"]["content"] != "echo hi" {
+		t.Errorf("unexpected request body %+v", body)
+	}
+
+	if _, err := repo.Create(context.Background(), &models.CreateGistInput{}); err == nil {
+		t.Error("expected an error without files")
+	}
+}
@@ -0,0 +1,62 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// graphQLRequest is the body of a GraphQL API request
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// graphQLError is an error returned in a GraphQL response body
+type graphQLError struct {
+	Message string `json:"message"`
+}
+
+// graphQLResponse wraps the data and errors of a GraphQL response
+type graphQLResponse struct {
+	Data   interface{}    `json:"data"`
+	Errors []graphQLError `json:"errors"`
+}
+
+// graphQLURL returns the GraphQL endpoint for the client's REST base URL.
+// github.com serves it at /graphql, GitHub Enterprise Server at /api/graphql.
+func (c *Client) graphQLURL() string {
+	base := c.client.BaseURL.String()
+	if strings.HasSuffix(base, "/api/v3/") {
+		return strings.TrimSuffix(base, "v3/") + "graphql"
+	}
+	return base + "graphql"
+}
+
+// graphQL executes a GraphQL query and decodes its data into out
+func (c *Client) graphQL(ctx context.Context, query string, variables map[string]interface{}, out interface{}) error {
+	req, err := c.client.NewRequest(http.MethodPost, c.graphQLURL(), &graphQLRequest{
+		Query:     query,
+		Variables: variables,
+	})
+	if err != nil {
+		return err
+	}
+
+	body := &graphQLResponse{Data: out}
+	resp, err := c.client.Do(ctx, req, body)
+	if err != nil {
+		return handleGitHubError(err, resp)
+	}
+
+	if len(body.Errors) > 0 {
+		messages := make([]string, 0, len(body.Errors))
+		for _, e := range body.Errors {
+			messages = append(messages, e.Message)
+		}
+		return fmt.Errorf("github graphql error: %s", strings.Join(messages, "; "))
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := NewClient("")
+	base, err := url.Parse(server.URL + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.client.BaseURL = base
+	return client
+}
+
+func TestGraphQLURL(t *testing.T) {
+	client := NewClient("")
+	if got := client.graphQLURL(); got != "https://api.github.com/graphql" {
+		t.Errorf("unexpected github.com endpoint %q", got)
+	}
+
+	client.client.BaseURL, _ = url.Parse("https://ghe.example.com/api/v3/")
+	if got := client.graphQLURL(); got != "https://ghe.example.com/api/graphql" {
+		t.Errorf("unexpected GHES endpoint %q", got)
+	}
+}
+
+func TestListReviewThreads(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/graphql" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		var req graphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		if req.Variables["number"] != float64(7) {
+			t.Errorf("unexpected variables %v", req.Variables)
+		}
+		_, _ = w.Write([]byte(`{"data":{"repository":{"pullRequest":{"reviewThreads":{"nodes":[
+			{"id":"T1","isResolved":true,"isOutdated":false,"path":"main.go","line":10,"originalLine":8,"startLine":9,"diffSide":"RIGHT",
+			 "comments":{"nodes":[{"databaseId":1,"body":"nit","url":"u","createdAt":"2024-01-01T00:00:00Z","updatedAt":"2024-01-01T00:00:00Z","author":{"login":"alice"}}]}},
+			{"id":"T2","isResolved":false,"isOutdated":true,"path":"old.go","line":null,"originalLine":4,
+			 "comments":{"nodes":[{"databaseId":2,"body":"gone","author":null},{"databaseId":3,"state":"PENDING","body":"draft","author":{"login":"me"}}]}}
+		]}}}}}`))
+	})
+
+	threads, err := NewPullRequestRepository(client).ListReviewThreads(context.Background(), "owner", "repo", 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(threads) != 2 {
+		t.Fatalf("expected 2 threads, got %d", len(threads))
+	}
+	if !threads[0].IsResolved || threads[0].Line != 10 || threads[0].StartLine != 9 || threads[0].DiffSide != "RIGHT" || threads[0].Comments[0].User.Login != "alice" {
+		t.Errorf("unexpected first thread %+v", threads[0])
+	}
+	if !threads[1].IsOutdated || threads[1].Line != 4 {
+		t.Errorf("expected outdated thread to fall back to the original line, got %+v", threads[1])
+	}
+	if threads[0].Comments[0].Pending || !threads[1].Comments[1].Pending {
+		t.Errorf("expected only the comment of the unsubmitted review to be pending")
+	}
+}
+
+func TestListReviewThreadsFollowsPages(t *testing.T) {
+	var requests []map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		requests = append(requests, req.Variables)
+		switch {
+		case strings.Contains(req.Query, "node(id: $id)"):
+			// The second page of the long thread's comments
+			if req.Variables["id"] != "T1" || req.Variables["after"] != "c1" {
+				t.Errorf("unexpected comment page variables %v", req.Variables)
+			}
+			_, _ = w.Write([]byte(`{"data":{"node":{"comments":{"pageInfo":{"hasNextPage":false,"endCursor":"c2"},"nodes":[
+				{"databaseId":2,"body":"second","author":{"login":"bob"}}]}}}}`))
+		case req.Variables["after"] == nil:
+			_, _ = w.Write([]byte(`{"data":{"repository":{"pullRequest":{"reviewThreads":{"pageInfo":{"hasNextPage":true,"endCursor":"t1"},"nodes":[
+				{"id":"T1","path":"main.go","line":1,"comments":{"pageInfo":{"hasNextPage":true,"endCursor":"c1"},"nodes":[
+					{"databaseId":1,"body":"first","author":{"login":"alice"}}]}}]}}}}}`))
+		case req.Variables["after"] == "t1":
+			_, _ = w.Write([]byte(`{"data":{"repository":{"pullRequest":{"reviewThreads":{"pageInfo":{"hasNextPage":false,"endCursor":"t2"},"nodes":[
+				{"id":"T2","path":"util.go","line":2,"comments":{"pageInfo":{"hasNextPage":false},"nodes":[
+					{"databaseId":3,"body":"other","author":{"login":"carol"}}]}}]}}}}}`))
+		default:
+			t.Errorf("unexpected variables %v", req.Variables)
+		}
+	})
+
+	threads, err := NewPullRequestRepository(client).ListReviewThreads(context.Background(), "owner", "repo", 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(threads) != 2 || threads[1].ID != "T2" {
+		t.Fatalf("expected the threads of both pages, got %d", len(threads))
+	}
+	if len(threads[0].Comments) != 2 || threads[0].Comments[1].Body != "second" {
+		t.Errorf("expected the comments past the first page, got %+v", threads[0].Comments)
+	}
+	if len(requests) != 3 {
+		t.Errorf("expected 3 queries, got %d", len(requests))
+	}
+}
+
+func TestGraphQLErrors(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":null,"errors":[{"message":"Could not resolve to a PullRequest"}]}`))
+	})
+
+	_, err := NewPullRequestRepository(client).ListReviewThreads(context.Background(), "owner", "repo", 1)
+	if err == nil {
+		t.Fatal("expected GraphQL errors to be returned")
+	}
+}
+
+func TestConvertDraft(t *testing.T) {
+	var mutation string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/owner/repo/pulls/7":
+			_, _ = w.Write([]byte(`{"number":7,"node_id":"PR_node","state":"open","draft":true}`))
+		case "/graphql":
+			var req graphQLRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatal(err)
+			}
+			if req.Variables["id"] != "PR_node" {
+				t.Errorf("unexpected variables %v", req.Variables)
+			}
+			mutation = req.Query
+			_, _ = w.Write([]byte(`{"data":{"markPullRequestReadyForReview":{"pullRequest":{"isDraft":false}}}}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	})
+
+	pr, err := NewPullRequestRepository(client).ConvertDraft(context.Background(), "owner", "repo", 7, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(mutation, "markPullRequestReadyForReview") {
+		t.Errorf("expected ready-for-review mutation, got %q", mutation)
+	}
+	if pr.Number != 7 || pr.Draft {
+		t.Errorf("unexpected pull request %+v", pr)
+	}
+}
+
+func TestGetMergeRequirements(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"repository":{"pullRequest":{
+			"reviewDecision":"REVIEW_REQUIRED",
+			"baseRef":{"branchProtectionRule":{"requiresApprovingReviews":true,"requiredApprovingReviewCount":2,"requiresCodeOwnerReviews":true,"requiredStatusCheckContexts":["build","deploy"]}},
+			"commits":{"nodes":[{"commit":{"statusCheckRollup":{"contexts":{"nodes":[
+				{"__typename":"CheckRun","name":"build","status":"COMPLETED","conclusion":"FAILURE","isRequired":true},
+				{"__typename":"CheckRun","name":"lint","status":"IN_PROGRESS","conclusion":null,"isRequired":false},
+				{"__typename":"StatusContext","context":"ci/legacy","state":"SUCCESS","isRequired":false}
+			]}}}}]}
+		}}}}`))
+	})
+
+	req, err := NewPullRequestRepository(client).GetMergeRequirements(context.Background(), "owner", "repo", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !req.Protected || req.RequiredApprovals != 2 || !req.RequireCodeOwnerReviews || req.ReviewDecision != "REVIEW_REQUIRED" {
+		t.Errorf("unexpected protection %+v", req)
+	}
+
+	want := []models.CheckStatus{
+		{Name: "build", State: models.CheckStateFailure, Required: true},
+		{Name: "lint", State: models.CheckStatePending},
+		{Name: "ci/legacy", State: models.CheckStateSuccess},
+		{Name: "deploy", State: models.CheckStatePending, Required: true},
+	}
+	if !reflect.DeepEqual(req.Checks, want) {
+		t.Errorf("unexpected checks %+v", req.Checks)
+	}
+}
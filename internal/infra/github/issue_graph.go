@@ -0,0 +1,183 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// issueGraphQuery fetches the issues and pull requests an issue (or pull
+// request) is directly connected to: its parent and sub-issues, the pull
+// requests closing it, the issues marked as its duplicates and the ones
+// mentioning it. Events are returned oldest first so that unmarking a
+// duplicate undoes an earlier mark.
+const issueGraphQuery = `query($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    issueOrPullRequest(number: $number) {
+      __typename
+      ... on Issue {
+        ...graphIssue
+        body
+        parent { ...graphIssue }
+        subIssues(first: 50) { nodes { ...graphIssue } }
+        closedByPullRequestsReferences(first: 20, includeClosedPrs: true) { nodes { ...graphPullRequest } }
+        timelineItems(first: 100, itemTypes: [CROSS_REFERENCED_EVENT, MARKED_AS_DUPLICATE_EVENT, UNMARKED_AS_DUPLICATE_EVENT]) { ...graphEvents }
+      }
+      ... on PullRequest {
+        ...graphPullRequest
+        body
+        closingIssuesReferences(first: 50) { nodes { ...graphIssue } }
+        timelineItems(first: 100, itemTypes: [CROSS_REFERENCED_EVENT]) { ...graphEvents }
+      }
+    }
+  }
+}
+
+fragment graphIssue on Issue {
+  __typename
+  number
+  title
+  state
+  repository { name owner { login } }
+}
+
+fragment graphPullRequest on PullRequest {
+  __typename
+  number
+  title
+  state
+  repository { name owner { login } }
+}
+
+fragment graphEvents on IssueTimelineItemsConnection {
+  nodes {
+    __typename
+    ... on CrossReferencedEvent { source { ...graphIssue ...graphPullRequest } }
+    ... on MarkedAsDuplicateEvent { canonical { ...graphIssue ...graphPullRequest } duplicate { ...graphIssue ...graphPullRequest } }
+    ... on UnmarkedAsDuplicateEvent { canonical { ...graphIssue ...graphPullRequest } duplicate { ...graphIssue ...graphPullRequest } }
+  }
+}`
+
+// issueGraphResult is the response shape of issueGraphQuery
+type issueGraphResult struct {
+	Repository struct {
+		IssueOrPullRequest *graphQLGraphItem `json:"issueOrPullRequest"`
+	} `json:"repository"`
+}
+
+// graphQLGraphItem is the focused issue or pull request of issueGraphQuery
+type graphQLGraphItem struct {
+	graphQLGraphNode
+	Body      string            `json:"body"`
+	Parent    *graphQLGraphNode `json:"parent"`
+	SubIssues struct {
+		Nodes []graphQLGraphNode `json:"nodes"`
+	} `json:"subIssues"`
+	ClosedBy struct {
+		Nodes []graphQLGraphNode `json:"nodes"`
+	} `json:"closedByPullRequestsReferences"`
+	Closes struct {
+		Nodes []graphQLGraphNode `json:"nodes"`
+	} `json:"closingIssuesReferences"`
+	TimelineItems struct {
+		Nodes []graphQLGraphEvent `json:"nodes"`
+	} `json:"timelineItems"`
+}
+
+// graphQLGraphNode is an issue or pull request of issueGraphQuery
+type graphQLGraphNode struct {
+	Typename   string `json:"__typename"`
+	Number     int    `json:"number"`
+	Title      string `json:"title"`
+	State      string `json:"state"`
+	Repository struct {
+		Name  string `json:"name"`
+		Owner struct {
+			Login string `json:"login"`
+		} `json:"owner"`
+	} `json:"repository"`
+}
+
+// graphQLGraphEvent is a cross-reference or (un)marked duplicate event
+type graphQLGraphEvent struct {
+	Typename  string            `json:"__typename"`
+	Source    *graphQLGraphNode `json:"source"`
+	Canonical *graphQLGraphNode `json:"canonical"`
+	Duplicate *graphQLGraphNode `json:"duplicate"`
+}
+
+// convert returns the domain node; GraphQL states are OPEN / CLOSED / MERGED
+func (n graphQLGraphNode) convert() models.IssueGraphNode {
+	return models.IssueGraphNode{
+		Owner:         n.Repository.Owner.Login,
+		Repo:          n.Repository.Name,
+		Number:        n.Number,
+		Title:         n.Title,
+		State:         strings.ToLower(n.State),
+		IsPullRequest: n.Typename == "PullRequest",
+	}
+}
+
+// GetIssueGraph retrieves the issues and pull requests an issue or pull request is connected to
+func (r *IssueRepositoryImpl) GetIssueGraph(ctx context.Context, owner, repo string, number int) (*models.IssueGraph, error) {
+	var result issueGraphResult
+	err := r.client.graphQL(ctx, issueGraphQuery, map[string]interface{}{
+		"owner":  owner,
+		"repo":   repo,
+		"number": number,
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+	item := result.Repository.IssueOrPullRequest
+	if item == nil {
+		return nil, &models.APIError{Kind: models.APIErrorNotFound, Err: fmt.Errorf("%s/%s#%d not found", owner, repo, number)}
+	}
+
+	graph := &models.IssueGraph{Node: item.convert(), Body: item.Body}
+	if item.Parent != nil {
+		graph.AddRelation(models.IssueRelationTrackedBy, item.Parent.convert())
+	}
+	for _, node := range item.SubIssues.Nodes {
+		graph.AddRelation(models.IssueRelationTracks, node.convert())
+	}
+	for _, node := range item.ClosedBy.Nodes {
+		graph.AddRelation(models.IssueRelationClosedBy, node.convert())
+	}
+	for _, node := range item.Closes.Nodes {
+		graph.AddRelation(models.IssueRelationCloses, node.convert())
+	}
+
+	var mentions []models.IssueGraphNode
+	for _, event := range item.TimelineItems.Nodes {
+		switch event.Typename {
+		case "CrossReferencedEvent":
+			if event.Source != nil {
+				mentions = append(mentions, event.Source.convert())
+			}
+		case "MarkedAsDuplicateEvent", "UnmarkedAsDuplicateEvent":
+			if event.Canonical == nil || event.Duplicate == nil {
+				continue
+			}
+			// The event is on both issues; the other one decides the direction
+			kind, other := models.IssueRelationDuplicateOf, event.Canonical.convert()
+			if other.SameAs(graph.Node) {
+				kind, other = models.IssueRelationDuplicatedBy, event.Duplicate.convert()
+			}
+			if event.Typename == "MarkedAsDuplicateEvent" {
+				graph.AddRelation(kind, other)
+			} else {
+				graph.RemoveRelation(kind, other)
+			}
+		}
+	}
+	// A mention is only shown when nothing says more about the connection
+	for _, node := range mentions {
+		if !graph.Connected(node) {
+			graph.AddRelation(models.IssueRelationMentionedIn, node)
+		}
+	}
+	return graph, nil
+}
@@ -0,0 +1,72 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func TestGetIssueGraph(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(req.Query, "issueOrPullRequest") || req.Variables["number"] != float64(42) {
+			t.Errorf("unexpected request %v", req)
+		}
+		_, _ = w.Write([]byte(`{"data":{"repository":{"issueOrPullRequest":{
+			"__typename":"Issue","number":42,"title":"Crash on start","state":"OPEN","body":"See #7",
+			"repository":{"name":"repo","owner":{"login":"owner"}},
+			"parent":{"__typename":"Issue","number":10,"title":"Epic","state":"OPEN","repository":{"name":"repo","owner":{"login":"owner"}}},
+			"subIssues":{"nodes":[]},
+			"closedByPullRequestsReferences":{"nodes":[
+				{"__typename":"PullRequest","number":50,"title":"Fix crash","state":"MERGED","repository":{"name":"repo","owner":{"login":"owner"}}}
+			]},
+			"timelineItems":{"nodes":[
+				{"__typename":"CrossReferencedEvent","source":{"__typename":"PullRequest","number":50,"title":"Fix crash","state":"MERGED","repository":{"name":"repo","owner":{"login":"owner"}}}},
+				{"__typename":"CrossReferencedEvent","source":{"__typename":"Issue","number":3,"title":"Upstream","state":"CLOSED","repository":{"name":"lib","owner":{"login":"other"}}}},
+				{"__typename":"MarkedAsDuplicateEvent",
+					"canonical":{"__typename":"Issue","number":42,"title":"Crash on start","state":"OPEN","repository":{"name":"repo","owner":{"login":"owner"}}},
+					"duplicate":{"__typename":"Issue","number":44,"title":"App crashes","state":"CLOSED","repository":{"name":"repo","owner":{"login":"owner"}}}},
+				{"__typename":"MarkedAsDuplicateEvent",
+					"canonical":{"__typename":"Issue","number":42,"title":"Crash on start","state":"OPEN","repository":{"name":"repo","owner":{"login":"owner"}}},
+					"duplicate":{"__typename":"Issue","number":45,"title":"Startup error","state":"OPEN","repository":{"name":"repo","owner":{"login":"owner"}}}},
+				{"__typename":"UnmarkedAsDuplicateEvent",
+					"canonical":{"__typename":"Issue","number":42,"title":"Crash on start","state":"OPEN","repository":{"name":"repo","owner":{"login":"owner"}}},
+					"duplicate":{"__typename":"Issue","number":45,"title":"Startup error","state":"OPEN","repository":{"name":"repo","owner":{"login":"owner"}}}}
+			]}
+		}}}}`))
+	})
+
+	graph, err := NewIssueRepository(client).GetIssueGraph(context.Background(), "owner", "repo", 42)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if graph.Node.Title != "Crash on start" || graph.Node.IsPullRequest || graph.Body != "See #7" {
+		t.Errorf("unexpected focused issue %+v", graph.Node)
+	}
+
+	var got []string
+	for _, relation := range graph.Relations {
+		got = append(got, fmt.Sprintf("%s %s/%s#%d", relation.Kind, relation.Node.Owner, relation.Node.Repo, relation.Node.Number))
+	}
+	// The closing pull request is not repeated as a mention, and #45 was unmarked
+	want := []string{
+		"tracked_by owner/repo#10",
+		"closed_by owner/repo#50",
+		"duplicated_by owner/repo#44",
+		"mentioned_in other/lib#3",
+	}
+	if strings.Join(got, ", ") != strings.Join(want, ", ") {
+		t.Errorf("unexpected relations %v, want %v", got, want)
+	}
+	if fix := graph.RelationsOf(models.IssueRelationClosedBy)[0].Node; !fix.IsPullRequest || fix.State != "merged" {
+		t.Errorf("unexpected closing pull request %+v", fix)
+	}
+}
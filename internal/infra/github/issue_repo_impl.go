@@ -2,7 +2,9 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 
 	"github.com/a1yama/tig-gh/internal/domain/models"
@@ -130,26 +132,45 @@ func handleGitHubError(err error, resp *github.Response) error {
 		return nil
 	}
 
+	// A request cut off by its deadline, possibly while reading the response
+	var timeout *TimeoutError
+	if errors.As(err, &timeout) {
+		return &models.APIError{Kind: models.APIErrorTimeout, Err: fmt.Errorf("github api error: %w", err)}
+	}
+
 	// If no response, return the original error
 	if resp == nil {
+		var netErr net.Error
+		if errors.As(err, &netErr) && !errors.Is(err, context.Canceled) {
+			return &models.APIError{Kind: models.APIErrorNetwork, Err: fmt.Errorf("github api error: %w", err)}
+		}
 		return fmt.Errorf("github api error: %w", err)
 	}
 
 	switch resp.StatusCode {
 	case http.StatusNotFound:
-		return fmt.Errorf("resource not found (404): %w", err)
+		return &models.APIError{Kind: models.APIErrorNotFound, Err: fmt.Errorf("resource not found (404): %w", err)}
 	case http.StatusUnauthorized:
 		return fmt.Errorf("unauthorized - check your token (401): %w", err)
-	case http.StatusForbidden:
-		// Check if it's a rate limit error
-		if resp.Rate.Remaining == 0 {
-			return fmt.Errorf("rate limit exceeded, resets at %v: %w", resp.Rate.Reset, err)
+	case http.StatusForbidden, http.StatusTooManyRequests:
+		// Check if it's a rate limit error (servers without rate limit headers never are)
+		if isRateLimited(err, resp) {
+			if rate := rateLimitFromResponse(resp); rate.Known && !rate.Reset.IsZero() {
+				return &models.APIError{Kind: models.APIErrorRateLimit, Reset: rate.Reset, Err: fmt.Errorf("rate limit exceeded, resets at %v: %w", rate.Reset, err)}
+			}
+			return &models.APIError{Kind: models.APIErrorRateLimit, Err: fmt.Errorf("rate limit exceeded: %w", err)}
+		}
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return fmt.Errorf("too many requests (429): %w", err)
+		}
+		if problem := ssoProblem(err, resp); problem != nil {
+			return problem
 		}
 		return fmt.Errorf("forbidden - insufficient permissions (403): %w", err)
 	case http.StatusUnprocessableEntity:
 		return fmt.Errorf("validation failed (422): %w", err)
 	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable:
-		return fmt.Errorf("github server error (%d): %w", resp.StatusCode, err)
+		return &models.APIError{Kind: models.APIErrorServer, Err: fmt.Errorf("github server error (%d): %w", resp.StatusCode, err)}
 	default:
 		return fmt.Errorf("github api error (status %d): %w", resp.StatusCode, err)
 	}
@@ -193,3 +214,13 @@ func (r *IssueRepositoryImpl) ListComments(ctx context.Context, owner, repo stri
 
 	return result, nil
 }
+
+// AddReaction adds a reaction to a comment on an issue
+func (r *IssueRepositoryImpl) AddReaction(ctx context.Context, owner, repo string, number int, commentID int64, content models.ReactionContent) error {
+	_, resp, err := r.client.client.Reactions.CreateIssueCommentReaction(ctx, owner, repo, commentID, string(content))
+	if err != nil {
+		return handleGitHubError(err, resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,255 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"gopkg.in/yaml.v3"
+)
+
+// issueTemplateDir is where GitHub looks for issue templates
+const issueTemplateDir = ".github/ISSUE_TEMPLATE"
+
+// ListTemplates retrieves the Markdown templates and issue forms in .github/ISSUE_TEMPLATE,
+// in file name order like the GitHub template chooser. Files that are not valid
+// templates are skipped; a repository without the directory has no templates.
+func (r *IssueRepositoryImpl) ListTemplates(ctx context.Context, owner, repo string) ([]*models.IssueTemplate, error) {
+	_, entries, resp, err := r.client.client.Repositories.GetContents(ctx, owner, repo, issueTemplateDir, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, handleGitHubError(err, resp)
+	}
+
+	var templates []*models.IssueTemplate
+	for _, entry := range entries {
+		name := entry.GetName()
+		if entry.GetType() != "file" || isIssueTemplateConfig(name) {
+			continue
+		}
+		ext := strings.ToLower(path.Ext(name))
+		if ext != ".md" && ext != ".yml" && ext != ".yaml" {
+			continue
+		}
+
+		file, _, resp, err := r.client.client.Repositories.GetContents(ctx, owner, repo, entry.GetPath(), nil)
+		if err != nil {
+			return nil, handleGitHubError(err, resp)
+		}
+		if file == nil {
+			continue
+		}
+		content, err := file.GetContent()
+		if err != nil {
+			continue
+		}
+
+		var template *models.IssueTemplate
+		if ext == ".md" {
+			template, err = parseMarkdownIssueTemplate(content)
+		} else {
+			template, err = parseIssueForm(content)
+		}
+		if err != nil {
+			continue
+		}
+		if template.Name == "" {
+			template.Name = strings.TrimSuffix(name, path.Ext(name))
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+// isIssueTemplateConfig reports whether name is the template chooser configuration
+func isIssueTemplateConfig(name string) bool {
+	name = strings.ToLower(name)
+	return name == "config.yml" || name == "config.yaml"
+}
+
+// stringList accepts both a YAML list and a comma separated string ("bug, triage")
+type stringList []string
+
+// UnmarshalYAML implements yaml.Unmarshaler
+func (l *stringList) UnmarshalYAML(node *yaml.Node) error {
+	var values []string
+	switch node.Kind {
+	case yaml.SequenceNode:
+		if err := node.Decode(&values); err != nil {
+			return err
+		}
+	case yaml.ScalarNode:
+		values = strings.Split(node.Value, ",")
+	default:
+		return fmt.Errorf("expected a list or a string, got %v", node.Tag)
+	}
+
+	*l = nil
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			*l = append(*l, value)
+		}
+	}
+	return nil
+}
+
+// markdownTemplateFrontMatter is the YAML front matter of a Markdown issue template
+type markdownTemplateFrontMatter struct {
+	Name      string     `yaml:"name"`
+	About     string     `yaml:"about"`
+	Title     string     `yaml:"title"`
+	Labels    stringList `yaml:"labels"`
+	Assignees stringList `yaml:"assignees"`
+}
+
+// parseMarkdownIssueTemplate parses a Markdown template with optional front matter
+func parseMarkdownIssueTemplate(content string) (*models.IssueTemplate, error) {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	body := content
+	var meta markdownTemplateFrontMatter
+
+	if rest, ok := strings.CutPrefix(content, "---\n"); ok {
+		end := strings.Index(rest, "\n---")
+		if end < 0 {
+			return nil, errors.New("unterminated front matter")
+		}
+		if err := yaml.Unmarshal([]byte(rest[:end]), &meta); err != nil {
+			return nil, fmt.Errorf("invalid front matter: %w", err)
+		}
+		body = rest[end+len("\n---"):]
+		if i := strings.IndexByte(body, '\n'); i >= 0 {
+			body = body[i+1:]
+		} else {
+			body = ""
+		}
+	}
+
+	return &models.IssueTemplate{
+		Name:      strings.TrimSpace(meta.Name),
+		About:     strings.TrimSpace(meta.About),
+		Title:     meta.Title,
+		Labels:    meta.Labels,
+		Assignees: meta.Assignees,
+		Body:      strings.TrimLeft(body, "\n"),
+	}, nil
+}
+
+// issueForm is an issue form (YAML template)
+type issueForm struct {
+	Name        string             `yaml:"name"`
+	Description string             `yaml:"description"`
+	Title       string             `yaml:"title"`
+	Labels      stringList         `yaml:"labels"`
+	Assignees   stringList         `yaml:"assignees"`
+	Body        []issueFormElement `yaml:"body"`
+}
+
+// issueFormElement is one element of an issue form
+type issueFormElement struct {
+	Type       string `yaml:"type"`
+	Attributes struct {
+		Label       string            `yaml:"label"`
+		Description string            `yaml:"description"`
+		Value       string            `yaml:"value"`
+		Render      string            `yaml:"render"`
+		Options     []issueFormOption `yaml:"options"`
+	} `yaml:"attributes"`
+}
+
+// issueFormOption is a dropdown option (a string) or a checkbox ({label: ...})
+type issueFormOption struct {
+	Label string
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler
+func (o *issueFormOption) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		o.Label = node.Value
+		return nil
+	}
+	var option struct {
+		Label string `yaml:"label"`
+	}
+	if err := node.Decode(&option); err != nil {
+		return err
+	}
+	o.Label = option.Label
+	return nil
+}
+
+// parseIssueForm parses an issue form and converts its fields to Markdown
+// sections, laid out like the body GitHub creates from a submitted form
+func parseIssueForm(content string) (*models.IssueTemplate, error) {
+	var form issueForm
+	if err := yaml.Unmarshal([]byte(content), &form); err != nil {
+		return nil, fmt.Errorf("invalid issue form: %w", err)
+	}
+	if form.Name == "" && len(form.Body) == 0 {
+		return nil, errors.New("not an issue form")
+	}
+
+	return &models.IssueTemplate{
+		Name:      strings.TrimSpace(form.Name),
+		About:     strings.TrimSpace(form.Description),
+		Title:     form.Title,
+		Labels:    form.Labels,
+		Assignees: form.Assignees,
+		Body:      issueFormMarkdown(form.Body),
+	}, nil
+}
+
+// issueFormMarkdown renders the form fields as "### Label" sections. Markdown
+// elements are instructions that GitHub does not submit, so they become comments.
+func issueFormMarkdown(elements []issueFormElement) string {
+	var b bytes.Buffer
+	for _, element := range elements {
+		attrs := element.Attributes
+		if element.Type == "markdown" {
+			if value := strings.TrimSpace(attrs.Value); value != "" {
+				fmt.Fprintf(&b, "<!--\n%s\n-->\n\n", strings.ReplaceAll(value, "-->", "--&gt;"))
+			}
+			continue
+		}
+		if attrs.Label == "" {
+			continue
+		}
+
+		fmt.Fprintf(&b, "### %s\n\n", attrs.Label)
+		if description := strings.TrimSpace(attrs.Description); description != "" {
+			fmt.Fprintf(&b, "<!-- %s -->\n", strings.ReplaceAll(singleLine(description), "-->", "--&gt;"))
+		}
+		switch element.Type {
+		case "checkboxes":
+			for _, option := range attrs.Options {
+				fmt.Fprintf(&b, "- [ ] %s\n", option.Label)
+			}
+		case "dropdown":
+			labels := make([]string, 0, len(attrs.Options))
+			for _, option := range attrs.Options {
+				labels = append(labels, option.Label)
+			}
+			fmt.Fprintf(&b, "<!-- One of: %s -->\n", strings.Join(labels, ", "))
+		default:
+			value := strings.TrimRight(attrs.Value, "\n")
+			if attrs.Render != "" {
+				fmt.Fprintf(&b, "```%s\n%s\n```\n", attrs.Render, value)
+			} else if value != "" {
+				b.WriteString(value + "\n")
+			}
+		}
+		b.WriteString("\n")
+	}
+	return strings.TrimRight(b.String(), "\n") + "\n"
+}
+
+// singleLine joins the lines of s with spaces
+func singleLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,130 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIssueRepository_ListTemplates(t *testing.T) {
+	files := map[string]string{
+		".github/ISSUE_TEMPLATE/bug_report.md": "---\nname: Bug report\nabout: Report a problem\ntitle: \"[Bug] \"\nlabels: bug, triage\nassignees: ''\n---\n\n## Steps\n\n1.\n",
+		".github/ISSUE_TEMPLATE/feature.yml":   "name: Feature request\ndescription: Suggest an idea\nlabels: [enhancement]\nbody:\n  - type: textarea\n    attributes:\n      label: Motivation\n",
+		".github/ISSUE_TEMPLATE/config.yml":    "blank_issues_enabled: false\n",
+	}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/repos/owner/repo/contents/")
+		if path == ".github/ISSUE_TEMPLATE" {
+			_, _ = w.Write([]byte(`[
+				{"type":"file","name":"bug_report.md","path":".github/ISSUE_TEMPLATE/bug_report.md"},
+				{"type":"file","name":"config.yml","path":".github/ISSUE_TEMPLATE/config.yml"},
+				{"type":"file","name":"feature.yml","path":".github/ISSUE_TEMPLATE/feature.yml"},
+				{"type":"file","name":"notes.txt","path":".github/ISSUE_TEMPLATE/notes.txt"}]`))
+			return
+		}
+		content, ok := files[path]
+		if !ok || strings.HasSuffix(path, "config.yml") {
+			t.Errorf("unexpected request for %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"type":     "file",
+			"path":     path,
+			"encoding": "base64",
+			"content":  base64.StdEncoding.EncodeToString([]byte(content)),
+		})
+	})
+	repo := NewIssueRepository(client)
+
+	templates, err := repo.ListTemplates(context.Background(), "owner", "repo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(templates) != 2 {
+		t.Fatalf("got %d templates, want 2", len(templates))
+	}
+	bug := templates[0]
+	if bug.Name != "Bug report" || bug.About != "Report a problem" || bug.Title != "[Bug] " {
+		t.Errorf("unexpected template %+v", bug)
+	}
+	if strings.Join(bug.Labels, ",") != "bug,triage" || len(bug.Assignees) != 0 {
+		t.Errorf("labels %v, assignees %v", bug.Labels, bug.Assignees)
+	}
+	if bug.Body != "## Steps\n\n1.\n" {
+		t.Errorf("body %q", bug.Body)
+	}
+	feature := templates[1]
+	if feature.Name != "Feature request" || feature.About != "Suggest an idea" || feature.Body != "### Motivation\n" {
+		t.Errorf("unexpected template %+v", feature)
+	}
+	if strings.Join(feature.Labels, ",") != "enhancement" {
+		t.Errorf("labels %v", feature.Labels)
+	}
+}
+
+func TestIssueRepository_ListTemplates_NoDirectory(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"Not Found"}`))
+	})
+	repo := NewIssueRepository(client)
+
+	templates, err := repo.ListTemplates(context.Background(), "owner", "repo")
+	if err != nil || len(templates) != 0 {
+		t.Errorf("got %v, %v; want no templates", templates, err)
+	}
+}
+
+func TestParseIssueForm(t *testing.T) {
+	form := `name: Bug
+body:
+  - type: markdown
+    attributes:
+      value: Thanks for reporting!
+  - type: input
+    attributes:
+      label: Version
+      description: Which version?
+      value: v1.0
+  - type: dropdown
+    attributes:
+      label: OS
+      options: [Linux, macOS]
+  - type: textarea
+    attributes:
+      label: Logs
+      render: shell
+  - type: checkboxes
+    attributes:
+      label: Checks
+      options:
+        - label: I searched existing issues
+          required: true
+`
+	template, err := parseIssueForm(form)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "<!--\nThanks for reporting!\n-->\n\n" +
+		"### Version\n\n<!-- Which version? -->\nv1.0\n\n" +
+		"### OS\n\n<!-- One of: Linux, macOS -->\n\n" +
+		"### Logs\n\n```shell\n\n```\n\n" +
+		"### Checks\n\n- [ ] I searched existing issues\n"
+	if template.Body != want {
+		t.Errorf("body:\n%s\nwant:\n%s", template.Body, want)
+	}
+}
+
+func TestParseMarkdownIssueTemplate_WithoutFrontMatter(t *testing.T) {
+	template, err := parseMarkdownIssueTemplate("Describe the issue\r\n")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if template.Name != "" || template.Body != "Describe the issue\n" {
+		t.Errorf("unexpected template %+v", template)
+	}
+}
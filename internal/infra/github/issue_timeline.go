@@ -0,0 +1,119 @@
+package github
+
+import (
+	"context"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/google/go-github/v57/github"
+)
+
+// timelineMaxPages bounds the pages fetched for a long-running issue (100 events each)
+const timelineMaxPages = 10
+
+// ListTimeline retrieves the timeline of an issue or pull request, oldest first
+func (r *IssueRepositoryImpl) ListTimeline(ctx context.Context, owner, repo string, number int) ([]*models.TimelineEvent, error) {
+	return listTimeline(ctx, r.client, owner, repo, number)
+}
+
+// listTimeline fetches the timeline of an issue or pull request, oldest first
+func listTimeline(ctx context.Context, client *Client, owner, repo string, number int) ([]*models.TimelineEvent, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	var events []*models.TimelineEvent
+	for page := 0; page < timelineMaxPages; page++ {
+		timeline, resp, err := client.client.Issues.ListIssueTimeline(ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, handleGitHubError(err, resp)
+		}
+		for _, item := range timeline {
+			if event := convertToTimelineEvent(item); event != nil {
+				events = append(events, event)
+			}
+		}
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return events, nil
+}
+
+// convertToTimelineEvent converts a GitHub timeline item to a domain event
+func convertToTimelineEvent(item *github.Timeline) *models.TimelineEvent {
+	if item == nil || item.GetEvent() == "" {
+		return nil
+	}
+
+	event := &models.TimelineEvent{
+		ID:        item.GetID(),
+		Type:      models.TimelineEventType(item.GetEvent()),
+		Actor:     convertToUser(item.Actor),
+		CreatedAt: item.GetCreatedAt().Time,
+		CommitID:  item.GetCommitID(),
+		State:     strings.ToLower(item.GetState()),
+		Body:      item.GetBody(),
+	}
+	if item.Actor == nil {
+		// Comments and reviews name their author as user
+		event.Actor = convertToUser(item.User)
+	}
+	if item.Label != nil {
+		event.Label = item.Label.GetName()
+	}
+	if item.Assignee != nil {
+		event.Assignee = item.Assignee.GetLogin()
+	}
+	if item.Reviewer != nil {
+		event.Reviewer = item.Reviewer.GetLogin()
+	} else if team := item.RequestedTeam; team != nil {
+		event.Reviewer = team.GetSlug()
+		if org := team.GetOrganization().GetLogin(); org != "" {
+			event.Reviewer = org + "/" + event.Reviewer
+		}
+	}
+	if item.Milestone != nil {
+		event.Milestone = item.Milestone.GetTitle()
+	}
+	if item.Rename != nil {
+		event.RenamedFrom = item.Rename.GetFrom()
+		event.RenamedTo = item.Rename.GetTo()
+	}
+	if source := item.GetSource(); source != nil && source.Issue != nil {
+		event.Source = convertToTimelineSource(source.Issue)
+	}
+
+	switch event.Type {
+	case models.TimelineReviewed:
+		event.CreatedAt = item.GetSubmittedAt().Time
+	case models.TimelineCommitted:
+		// Commits carry their author date and have no actor
+		event.CreatedAt = item.GetAuthor().GetDate().Time
+		event.CommitID = item.GetSHA()
+		event.Body = item.GetMessage()
+		if event.Actor.Login == "" {
+			event.Actor.Login = item.GetAuthor().GetName()
+		}
+	}
+	return event
+}
+
+// convertToTimelineSource converts the issue a cross-reference comes from
+func convertToTimelineSource(issue *github.Issue) *models.TimelineSource {
+	source := &models.TimelineSource{
+		Number:        issue.GetNumber(),
+		Title:         issue.GetTitle(),
+		IsPullRequest: issue.IsPullRequest(),
+	}
+	if repo := issue.GetRepository(); repo != nil {
+		source.Owner = repo.GetOwner().GetLogin()
+		source.Repo = repo.GetName()
+	}
+	if source.Owner == "" {
+		// https://api.github.com/repos/{owner}/{repo}
+		parts := strings.Split(strings.TrimSuffix(issue.GetRepositoryURL(), "/"), "/")
+		if n := len(parts); n >= 3 && parts[n-3] == "repos" {
+			source.Owner, source.Repo = parts[n-2], parts[n-1]
+		}
+	}
+	return source
+}
@@ -0,0 +1,52 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func TestListTimeline(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/owner/repo/issues/7/timeline" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`[
+			{"id":1,"event":"labeled","actor":{"login":"alice"},"created_at":"2024-05-01T10:00:00Z","label":{"name":"bug"}},
+			{"id":2,"event":"review_requested","actor":{"login":"alice"},"created_at":"2024-05-01T11:00:00Z","requested_team":{"slug":"core","organization":{"login":"acme"}}},
+			{"id":3,"event":"cross-referenced","actor":{"login":"bob"},"created_at":"2024-05-02T09:00:00Z",
+			 "source":{"type":"issue","issue":{"number":12,"title":"Crash","repository_url":"https://api.github.com/repos/other/lib","pull_request":{"url":"x"}}}},
+			{"event":"reviewed","user":{"login":"carol"},"state":"APPROVED","body":"LGTM","submitted_at":"2024-05-03T08:00:00Z"},
+			{"event":"committed","sha":"abc123","message":"Fix crash","author":{"name":"Dave","date":"2024-04-30T12:00:00Z"}},
+			{"id":4,"event":"head_ref_force_pushed","actor":{"login":"alice"},"commit_id":"def456","created_at":"2024-05-04T08:00:00Z"}
+		]`))
+	})
+
+	events, err := NewIssueRepository(client).(*IssueRepositoryImpl).ListTimeline(context.Background(), "owner", "repo", 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events) != 6 {
+		t.Fatalf("expected 6 events, got %d", len(events))
+	}
+	if events[0].Type != models.TimelineLabeled || events[0].Label != "bug" || events[0].Actor.Login != "alice" {
+		t.Errorf("unexpected labeled event %+v", events[0])
+	}
+	if events[1].Reviewer != "acme/core" {
+		t.Errorf("unexpected reviewer %q", events[1].Reviewer)
+	}
+	if src := events[2].Source; src == nil || *src != (models.TimelineSource{Owner: "other", Repo: "lib", Number: 12, Title: "Crash", IsPullRequest: true}) {
+		t.Errorf("unexpected source %+v", src)
+	}
+	if events[3].Actor.Login != "carol" || events[3].State != "approved" || events[3].CreatedAt.IsZero() {
+		t.Errorf("unexpected review event %+v", events[3])
+	}
+	if events[4].CommitID != "abc123" || events[4].Actor.Login != "Dave" || events[4].CreatedAt.Day() != 30 {
+		t.Errorf("unexpected commit event %+v", events[4])
+	}
+	if events[5].CommitID != "def456" {
+		t.Errorf("unexpected force push %+v", events[5])
+	}
+}
@@ -0,0 +1,221 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/google/go-github/v57/github"
+)
+
+// issueSpan はIssueがオープンだった期間と現在のラベル
+type issueSpan struct {
+	createdAt time.Time
+	closedAt  *time.Time
+	labels    []string
+}
+
+type issueBacklogFetchResult struct {
+	slug  string
+	spans []issueSpan
+	err   error
+}
+
+// FetchIssueBacklog は直近 weeks 週の各週末時点のオープンIssue数をラベル区分ごとに集計する。
+// 作成・クローズ日時から各時点の状態を復元し、ラベルは現在のものを使う。
+// 集計したIssue（現在オープンのものと期間中にクローズされたもの）のラベルの使われ方もあわせて返す。
+func (r *MetricsRepositoryImpl) FetchIssueBacklog(ctx context.Context, repos []string, weeks int, buckets []string, now time.Time) (*models.IssueBacklogMetrics, error) {
+	ctx = WithOperation(ctx, OperationMetrics)
+	buckets = issueBacklogBuckets(buckets)
+	result := &models.IssueBacklogMetrics{
+		Buckets:            buckets,
+		Weeks:              []models.IssueBacklogWeek{},
+		ByRepository:       make(map[string][]models.IssueBacklogWeek),
+		LabelsByRepository: make(map[string]models.LabelInsights),
+	}
+	if weeks <= 0 {
+		return result, nil
+	}
+	start := now.Add(-time.Duration(weeks) * 7 * 24 * time.Hour)
+
+	var tasks []repoFetchTask
+	for _, repoSlug := range repos {
+		repoSlug = strings.TrimSpace(repoSlug)
+		if repoSlug == "" {
+			continue
+		}
+		owner, repo, err := parseRepositorySlug(repoSlug)
+		if err != nil {
+			continue
+		}
+		tasks = append(tasks, repoFetchTask{slug: repoSlug, owner: owner, name: repo})
+	}
+	if len(tasks) == 0 {
+		return result, nil
+	}
+
+	workerCount := repoWorkerCount
+	if len(tasks) < workerCount {
+		workerCount = len(tasks)
+	}
+
+	jobs := make(chan repoFetchTask)
+	results := make(chan issueBacklogFetchResult)
+	var workers sync.WaitGroup
+
+	for i := 0; i < workerCount; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for task := range jobs {
+				spans, err := r.fetchIssueSpans(ctx, task.owner, task.name, start)
+				results <- issueBacklogFetchResult{slug: task.slug, spans: spans, err: err}
+			}
+		}()
+	}
+
+	go func() {
+		for _, task := range tasks {
+			jobs <- task
+		}
+		close(jobs)
+	}()
+
+	go func() {
+		workers.Wait()
+		close(results)
+	}()
+
+	var allSpans []issueSpan
+	var errs []string
+	for res := range results {
+		if res.err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", res.slug, res.err))
+			continue
+		}
+		result.ByRepository[res.slug] = calculateIssueBacklog(res.spans, buckets, weeks, now)
+		labels := calculateLabelInsights(res.spans)
+		result.LabelsByRepository[res.slug] = labels
+		result.Labels.Merge(labels)
+		allSpans = append(allSpans, res.spans...)
+	}
+
+	if len(result.ByRepository) == 0 && len(errs) > 0 {
+		sort.Strings(errs)
+		return nil, fmt.Errorf("failed to fetch issues: %s", strings.Join(errs, "; "))
+	}
+
+	result.Weeks = calculateIssueBacklog(allSpans, buckets, weeks, now)
+	return result, nil
+}
+
+// fetchIssueSpans は現在オープンのIssueと start 以降にクローズされたIssueを取得する。
+// それ以前にクローズされたIssueはどの集計時点でもオープンではないため取得しない。
+func (r *MetricsRepositoryImpl) fetchIssueSpans(ctx context.Context, owner, repo string, start time.Time) ([]issueSpan, error) {
+	var spans []issueSpan
+
+	for _, opts := range []*github.IssueListByRepoOptions{
+		{State: "open", ListOptions: github.ListOptions{PerPage: 100}},
+		{State: "closed", Since: start, ListOptions: github.ListOptions{PerPage: 100}},
+	} {
+		for {
+			issues, resp, err := r.client.client.Issues.ListByRepo(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, handleGitHubError(err, resp)
+			}
+
+			for _, issue := range issues {
+				// Issues API はPRも返すため除外する
+				if issue == nil || issue.IsPullRequest() || issue.CreatedAt == nil {
+					continue
+				}
+				span := issueSpan{createdAt: issue.CreatedAt.Time}
+				if issue.ClosedAt != nil {
+					closedAt := issue.ClosedAt.Time
+					span.closedAt = &closedAt
+				}
+				for _, label := range issue.Labels {
+					span.labels = append(span.labels, label.GetName())
+				}
+				spans = append(spans, span)
+			}
+
+			if resp == nil || resp.NextPage == 0 {
+				break
+			}
+			opts.Page = resp.NextPage
+		}
+	}
+
+	return spans, nil
+}
+
+// issueBacklogBuckets は空や重複を除いたラベル区分の末尾に other を加える
+func issueBacklogBuckets(labels []string) []string {
+	buckets := make([]string, 0, len(labels)+1)
+	seen := map[string]struct{}{models.IssueBacklogOtherBucket: {}}
+	for _, label := range labels {
+		label = strings.TrimSpace(label)
+		key := strings.ToLower(label)
+		if _, ok := seen[key]; ok || label == "" {
+			continue
+		}
+		seen[key] = struct{}{}
+		buckets = append(buckets, label)
+	}
+	return append(buckets, models.IssueBacklogOtherBucket)
+}
+
+// issueBucket はラベルが最初に一致した区分を返す（大文字小文字は区別しない）
+func issueBucket(labels, buckets []string) string {
+	for _, bucket := range buckets[:len(buckets)-1] {
+		for _, label := range labels {
+			if strings.EqualFold(label, bucket) {
+				return bucket
+			}
+		}
+	}
+	return models.IssueBacklogOtherBucket
+}
+
+// calculateLabelInsights はIssueごとのラベルを集計する
+func calculateLabelInsights(spans []issueSpan) models.LabelInsights {
+	var insights models.LabelInsights
+	for _, span := range spans {
+		insights.Add(span.labels)
+	}
+	return insights
+}
+
+// calculateIssueBacklog は now までの weeks 週の各週末時点でオープンだったIssue数を区分ごとに数える
+func calculateIssueBacklog(spans []issueSpan, buckets []string, weeks int, now time.Time) []models.IssueBacklogWeek {
+	points := make([]models.IssueBacklogWeek, weeks)
+	for i := range points {
+		end := now.Add(-time.Duration(weeks-1-i) * 7 * 24 * time.Hour)
+		open := make(map[string]int, len(buckets))
+		for _, bucket := range buckets {
+			open[bucket] = 0
+		}
+		points[i] = models.IssueBacklogWeek{End: end, Open: open}
+	}
+
+	for _, span := range spans {
+		bucket := issueBucket(span.labels, buckets)
+		for i := range points {
+			end := points[i].End
+			if span.createdAt.After(end) {
+				continue
+			}
+			if span.closedAt != nil && !span.closedAt.After(end) {
+				continue
+			}
+			points[i].Open[bucket]++
+		}
+	}
+
+	return points
+}
@@ -4,14 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 	"unicode/utf8"
 
 	"github.com/a1yama/tig-gh/internal/domain/models"
 	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/infra/clock"
 	"github.com/google/go-github/v57/github"
 )
 
@@ -26,11 +29,27 @@ type leadTimeSample struct {
 	mergedAt      time.Time
 	firstReviewAt *time.Time
 	approvedAt    *time.Time
+	author        string
+	reviewers     []string // 作成者以外でレビューを送信したユーザー（重複なし）
 }
 
 // MetricsRepositoryImpl は MetricsRepository を実装する
 type MetricsRepositoryImpl struct {
-	client *Client
+	client         *Client
+	protectedPaths models.ProtectedPaths
+	percentiles    []int
+
+	// 集計から除くPRの作成者（小文字のログイン名）と、[bot] で終わる作成者を除くかどうか
+	excludeAuthors map[string]bool
+	excludeBots    bool
+
+	// 直前の取得で成功したリポジトリのサンプル（失敗したリポジトリだけを再取得するため）
+	// 取得を終えるたびに更新し、stashPath があればファイルにも記録する
+	mu          sync.Mutex
+	lastSince   time.Time
+	lastUpdated time.Time
+	lastSamples map[string][]leadTimeSample
+	stashPath   string
 }
 
 type repoFetchTask struct {
@@ -40,9 +59,10 @@ type repoFetchTask struct {
 }
 
 type repoFetchResult struct {
-	slug    string
-	samples []leadTimeSample
-	err     error
+	slug           string
+	samples        []leadTimeSample
+	reviewFailures int // レビューを取得できなかったPRの数（レビュー時刻なしで集計される）
+	err            error
 }
 
 type stagnantFetchResult struct {
@@ -51,27 +71,167 @@ type stagnantFetchResult struct {
 	err  error
 }
 
-// NewMetricsRepository は MetricsRepository 実装を生成する
-func NewMetricsRepository(client *Client) repository.MetricsRepository {
-	return &MetricsRepositoryImpl{client: client}
+// NewMetricsRepository は MetricsRepository 実装を生成する。
+// protectedPaths を変更するオープンPRは品質問題として報告される
+func NewMetricsRepository(client *Client, protectedPaths []string) repository.MetricsRepository {
+	return &MetricsRepositoryImpl{client: client, protectedPaths: models.ProtectedPaths(protectedPaths)}
 }
 
-// GetRateLimit returns the current GitHub API rate limit status
-func (r *MetricsRepositoryImpl) GetRateLimit(ctx context.Context) (*github.Rate, error) {
-	limits, _, err := r.client.client.RateLimits(ctx)
+// SetExcludedAuthors は集計から除くPRの作成者を設定する。bots が true なら
+// ログイン名が [bot] で終わる作成者（dependabot[bot] など）も除く
+func (r *MetricsRepositoryImpl) SetExcludedAuthors(authors []string, bots bool) {
+	r.excludeAuthors = make(map[string]bool, len(authors))
+	for _, author := range authors {
+		if author = strings.ToLower(strings.TrimSpace(author)); author != "" {
+			r.excludeAuthors[author] = true
+		}
+	}
+	r.excludeBots = bots
+}
+
+// authorExcluded は author のPRを集計から除くかどうかを返す
+func (r *MetricsRepositoryImpl) authorExcluded(author string) bool {
+	author = strings.ToLower(author)
+	if r.excludeBots && strings.HasSuffix(author, "[bot]") {
+		return true
+	}
+	return r.excludeAuthors[author]
+}
+
+// excludeSamples は除外する作成者のサンプルを取り除き、残りと除いた数を返す
+func (r *MetricsRepositoryImpl) excludeSamples(samples []leadTimeSample) ([]leadTimeSample, int) {
+	kept := make([]leadTimeSample, 0, len(samples))
+	for _, sample := range samples {
+		if !r.authorExcluded(sample.author) {
+			kept = append(kept, sample)
+		}
+	}
+	return kept, len(samples) - len(kept)
+}
+
+// SetLeadTimePercentiles は全体とリポジトリごとのリードタイムに加えるパーセンタイルを設定する
+func (r *MetricsRepositoryImpl) SetLeadTimePercentiles(percentiles []int) {
+	r.percentiles = append([]int(nil), percentiles...)
+}
+
+// GetRateLimit returns the current GitHub API rate limit status.
+// Servers that don't report rate limits (e.g. GHES with rate limiting disabled)
+// yield an unknown rate limit rather than an error.
+func (r *MetricsRepositoryImpl) GetRateLimit(ctx context.Context) (*models.RateLimit, error) {
+	limits, resp, err := r.client.client.RateLimits(ctx)
 	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return &models.RateLimit{}, nil
+		}
 		return nil, err
 	}
 
 	if limits != nil && limits.Core != nil {
-		return limits.Core, nil
+		rate := convertToRateLimit(limits.Core)
+		return &rate, nil
 	}
 
-	return nil, nil
+	// Fall back to the response headers when the body has no core resource
+	rate := rateLimitFromResponse(resp)
+	return &rate, nil
 }
 
-// FetchLeadTimeMetrics は複数リポジトリのリードタイムメトリクスを取得する
+// recentRepositoryLimit は ListRecentRepositories が取得元ごとに返す最大件数
+const recentRepositoryLimit = 50
+
+// ListRecentRepositories は owner（組織またはユーザー）と認証ユーザーのリポジトリを
+// 主要言語・トピックとともに最近 push された順に返す。アーカイブ済みのリポジトリは除く。
+// 認証していない場合は owner のリポジトリだけを返す。
+func (r *MetricsRepositoryImpl) ListRecentRepositories(ctx context.Context, owner string) ([]*models.RepositorySummary, error) {
+	listOpts := github.ListOptions{PerPage: recentRepositoryLimit}
+	var lists [][]*github.Repository
+	var firstErr error
+
+	if owner != "" {
+		repos, resp, err := r.client.client.Repositories.ListByOrg(ctx, owner, &github.RepositoryListByOrgOptions{
+			Sort:        "pushed",
+			ListOptions: listOpts,
+		})
+		if err != nil && resp != nil && resp.StatusCode == http.StatusNotFound {
+			// 組織でなければユーザーのリポジトリ
+			repos, resp, err = r.client.client.Repositories.ListByUser(ctx, owner, &github.RepositoryListByUserOptions{
+				Sort:        "pushed",
+				ListOptions: listOpts,
+			})
+		}
+		if err != nil {
+			firstErr = handleGitHubError(err, resp)
+		}
+		lists = append(lists, repos)
+	}
+
+	mine, resp, err := r.client.client.Repositories.ListByAuthenticatedUser(ctx, &github.RepositoryListByAuthenticatedUserOptions{
+		Sort:        "pushed",
+		ListOptions: listOpts,
+	})
+	if err != nil && firstErr == nil && owner == "" {
+		firstErr = handleGitHubError(err, resp)
+	}
+	lists = append(lists, mine)
+
+	var summaries []*models.RepositorySummary
+	seen := make(map[string]struct{})
+	for _, repos := range lists {
+		for _, repo := range repos {
+			name := repo.GetFullName()
+			if name == "" || repo.GetArchived() {
+				continue
+			}
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+			summaries = append(summaries, &models.RepositorySummary{
+				FullName: name,
+				Language: repo.GetLanguage(),
+				Topics:   repo.Topics,
+			})
+		}
+	}
+
+	if len(summaries) == 0 && firstErr != nil {
+		return nil, firstErr
+	}
+	return summaries, nil
+}
+
+// FetchLeadTimeMetrics は複数リポジトリのリードタイムメトリクスを取得する。
+// 一部のリポジトリが失敗しても残りで計算し、各リポジトリの結果を RepositoryStatuses に記録する
 func (r *MetricsRepositoryImpl) FetchLeadTimeMetrics(ctx context.Context, repos []string, since time.Time, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error) {
+	return r.fetchLeadTimeMetrics(ctx, repos, since, nil, progressFn)
+}
+
+// RefetchLeadTimeMetrics は failed のリポジトリだけを取得し直し、それ以外は直前の取得結果を
+// 使って repos 全体のメトリクスを計算する。直前の取得と since が異なる場合はすべて取得する
+func (r *MetricsRepositoryImpl) RefetchLeadTimeMetrics(ctx context.Context, repos, failed []string, since time.Time, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error) {
+	retry := make(map[string]bool, len(failed))
+	for _, slug := range failed {
+		retry[strings.TrimSpace(slug)] = true
+	}
+
+	reuse := make(map[string][]leadTimeSample)
+	r.mu.Lock()
+	if r.lastSince.Equal(since) {
+		for slug, samples := range r.lastSamples {
+			if !retry[slug] {
+				reuse[slug] = samples
+			}
+		}
+	}
+	r.mu.Unlock()
+
+	return r.fetchLeadTimeMetrics(ctx, repos, since, reuse, progressFn)
+}
+
+// fetchLeadTimeMetrics は reuse にあるリポジトリはそのサンプルを使い、残りを取得してメトリクスを計算する
+func (r *MetricsRepositoryImpl) fetchLeadTimeMetrics(ctx context.Context, repos []string, since time.Time, reuse map[string][]leadTimeSample, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error) {
+	// 大きな履歴のページは一覧より時間がかかるため、メトリクス用のタイムアウトを使う
+	ctx = WithOperation(ctx, OperationMetrics)
 	result := &models.LeadTimeMetrics{
 		Overall:                    models.LeadTimeStat{},
 		ByRepository:               make(map[string]models.LeadTimeStat),
@@ -87,7 +247,10 @@ func (r *MetricsRepositoryImpl) FetchLeadTimeMetrics(ctx context.Context, repos
 	}
 
 	repoSamples := make(map[string][]leadTimeSample)
+	repoErrs := make(map[string]error)
 	var errs []error
+	// warnings は集計を続けられた一部の失敗（出力先は呼び出し側が決める）
+	var warnings []string
 
 	totalRepos := len(repos)
 	processedRepos := 0
@@ -111,6 +274,8 @@ func (r *MetricsRepositoryImpl) FetchLeadTimeMetrics(ctx context.Context, repos
 		})
 	}
 
+	r.startStash(since, reuse)
+
 	var tasks []repoFetchTask
 
 	for _, repoFull := range repos {
@@ -119,9 +284,17 @@ func (r *MetricsRepositoryImpl) FetchLeadTimeMetrics(ctx context.Context, repos
 			continue
 		}
 
+		if samples, ok := reuse[repoFull]; ok {
+			repoSamples[repoFull] = samples
+			processedRepos++
+			reportProgress(repoFull)
+			continue
+		}
+
 		owner, name, err := parseRepositorySlug(repoFull)
 		if err != nil {
 			errs = append(errs, err)
+			repoErrs[repoFull] = err
 			processedRepos++
 			reportProgress(repoFull)
 			continue
@@ -149,11 +322,12 @@ func (r *MetricsRepositoryImpl) FetchLeadTimeMetrics(ctx context.Context, repos
 			go func() {
 				defer workers.Done()
 				for task := range jobs {
-					samples, fetchErr := r.fetchLeadTimeSamples(ctx, task.owner, task.name, since)
+					samples, reviewFailures, fetchErr := r.fetchLeadTimeSamples(ctx, task.owner, task.name, since)
 					results <- repoFetchResult{
-						slug:    task.slug,
-						samples: samples,
-						err:     fetchErr,
+						slug:           task.slug,
+						samples:        samples,
+						reviewFailures: reviewFailures,
+						err:            fetchErr,
 					}
 				}
 			}()
@@ -174,8 +348,13 @@ func (r *MetricsRepositoryImpl) FetchLeadTimeMetrics(ctx context.Context, repos
 		for result := range results {
 			if result.err != nil {
 				errs = append(errs, fmt.Errorf("%s: %w", result.slug, result.err))
+				repoErrs[result.slug] = result.err
 			} else {
 				repoSamples[result.slug] = result.samples
+				r.stashSamples(result.slug, result.samples)
+				if result.reviewFailures > 0 {
+					warnings = append(warnings, fmt.Sprintf("%s: failed to fetch reviews of %d PRs", result.slug, result.reviewFailures))
+				}
 			}
 
 			processedRepos++
@@ -191,14 +370,43 @@ func (r *MetricsRepositoryImpl) FetchLeadTimeMetrics(ctx context.Context, repos
 		})
 	}
 
+	// 取得できたリポジトリの状態を設定順に記録し、失敗したリポジトリは以降の分析から除く
+	var okRepos []string
+	seen := make(map[string]bool)
+	for _, slug := range repos {
+		slug = strings.TrimSpace(slug)
+		if slug == "" || seen[slug] {
+			continue
+		}
+		seen[slug] = true
+		status := models.MetricsRepositoryStatus{Repository: slug}
+		if err, failed := repoErrs[slug]; failed {
+			status.Error = metricsFailureReason(err)
+		} else if _, ok := repoSamples[slug]; ok {
+			okRepos = append(okRepos, slug)
+		} else {
+			continue
+		}
+		result.RepositoryStatuses = append(result.RepositoryStatuses, status)
+	}
+
+	// すべて取得できたら再開する必要はない
+	if ctx.Err() == nil && len(repoErrs) == 0 {
+		r.clearStash()
+	}
+
 	var overallSamples []leadTimeSample
 
-	currentTime := time.Now()
+	currentTime := clock.Now()
 
 	for slug, samples := range repoSamples {
+		// 作成者で除くのは集計時にする（記録・再取得に使うサンプルには残し、設定の変更がすぐ反映されるように）
+		samples, excluded := r.excludeSamples(samples)
+		result.ExcludedPRs += excluded
+
 		durations := samplesToDurations(samples)
 
-		result.ByRepository[slug] = calculateLeadTimeStat(durations)
+		result.ByRepository[slug] = calculateLeadTimeStat(durations, r.percentiles...)
 
 		result.ByRepositoryDayOfWeek[slug] = aggregateByDayOfWeek(samples)
 
@@ -211,7 +419,7 @@ func (r *MetricsRepositoryImpl) FetchLeadTimeMetrics(ctx context.Context, repos
 
 	allDurations := samplesToDurations(overallSamples)
 
-	result.Overall = calculateLeadTimeStat(allDurations)
+	result.Overall = calculateLeadTimeStat(allDurations, r.percentiles...)
 
 	result.ByDayOfWeek = aggregateByDayOfWeek(overallSamples)
 
@@ -219,36 +427,82 @@ func (r *MetricsRepositoryImpl) FetchLeadTimeMetrics(ctx context.Context, repos
 
 	result.PhaseBreakdown = calculatePhaseBreakdown(overallSamples)
 
-	qualityIssues, qualityErr := r.analyzeOpenPRQuality(ctx, repos)
+	result.Trend = calculateTrend(overallSamples, since, currentTime)
+
+	// 作成者・レビュアーごとの集計（Metrics ビューのフィルタ用）
+	result.ByAuthor, result.ByAuthorPhaseBreakdown = r.calculateGroupStats(groupSamplesByAuthor(overallSamples))
+
+	result.ByReviewer, result.ByReviewerPhaseBreakdown = r.calculateGroupStats(groupSamplesByReviewer(overallSamples))
+
+	qualityIssues, qualityErr := r.analyzeOpenPRQuality(ctx, okRepos)
 	if qualityErr != nil {
-		fmt.Printf("failed to analyze PR quality: %v\n", qualityErr)
+		warnings = append(warnings, fmt.Sprintf("failed to analyze PR quality: %v", qualityErr))
 	} else {
 		result.QualityIssues = qualityIssues
 	}
 
-	// Fetch stagnant PR metrics
-	stagnantMetrics, err := r.fetchStagnantPRMetrics(ctx, repos, time.Now())
-	if err != nil {
-		fmt.Printf("failed to fetch stagnant PR metrics: %v\n", err)
-	} else {
-		result.StagnantPRs = stagnantMetrics
-	}
+	// Fetch stagnant PR metrics; repositories that fail are left out of the count
+	stagnantMetrics, stagnantWarnings := r.fetchStagnantPRMetrics(ctx, okRepos, clock.Now())
+	result.StagnantPRs = stagnantMetrics
+	warnings = append(warnings, stagnantWarnings...)
+	result.Warnings = warnings
 
 	if len(repoSamples) == 0 && len(errs) > 0 {
 		return nil, errors.Join(errs...)
 	}
 
-	if len(errs) > 0 {
-		return result, errors.Join(errs...)
+	return result, nil
+}
+
+// groupSamplesByAuthor はサンプルを作成者ごとに分ける（作成者を記録していない古いサンプルは除く）
+func groupSamplesByAuthor(samples []leadTimeSample) map[string][]leadTimeSample {
+	groups := make(map[string][]leadTimeSample)
+	for _, sample := range samples {
+		if sample.author == "" {
+			continue
+		}
+		groups[sample.author] = append(groups[sample.author], sample)
+	}
+	return groups
+}
+
+// groupSamplesByReviewer はサンプルをレビュアーごとに分ける（1つのサンプルが複数のレビュアーに入る）
+func groupSamplesByReviewer(samples []leadTimeSample) map[string][]leadTimeSample {
+	groups := make(map[string][]leadTimeSample)
+	for _, sample := range samples {
+		for _, reviewer := range sample.reviewers {
+			groups[reviewer] = append(groups[reviewer], sample)
+		}
 	}
+	return groups
+}
 
-	return result, nil
+// calculateGroupStats はグループごとのリードタイムとレビューフェーズを計算する
+func (r *MetricsRepositoryImpl) calculateGroupStats(groups map[string][]leadTimeSample) (map[string]models.LeadTimeStat, map[string]models.ReviewPhaseMetrics) {
+	stats := make(map[string]models.LeadTimeStat, len(groups))
+	phases := make(map[string]models.ReviewPhaseMetrics, len(groups))
+	for key, samples := range groups {
+		stats[key] = calculateLeadTimeStat(samplesToDurations(samples), r.percentiles...)
+		phases[key] = calculatePhaseBreakdown(samples)
+	}
+	return stats, phases
 }
 
-func (r *MetricsRepositoryImpl) fetchLeadTimeSamples(ctx context.Context, owner, repo string, since time.Time) ([]leadTimeSample, error) {
+// metricsFailureReason はステータス表に表示する失敗理由を返す。
+// API エラーは "resource not found (404)" のように先頭の説明だけにする
+func metricsFailureReason(err error) string {
+	message := err.Error()
+	if i := strings.Index(message, ": "); i > 0 {
+		return message[:i]
+	}
+	return message
+}
+
+// fetchLeadTimeSamples はマージ済みPRのサンプルと、レビューを取得できなかったPRの数を返す
+func (r *MetricsRepositoryImpl) fetchLeadTimeSamples(ctx context.Context, owner, repo string, since time.Time) ([]leadTimeSample, int, error) {
 	defaultBranch, err := r.getDefaultBranch(ctx, owner, repo)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	opts := &github.PullRequestListOptions{
@@ -265,12 +519,12 @@ func (r *MetricsRepositoryImpl) fetchLeadTimeSamples(ctx context.Context, owner,
 
 	for {
 		if err := ctx.Err(); err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 
 		prs, resp, err := r.client.client.PullRequests.List(ctx, owner, repo, opts)
 		if err != nil {
-			return nil, handleGitHubError(err, resp)
+			return nil, 0, handleGitHubError(err, resp)
 		}
 
 		stop := false
@@ -297,6 +551,7 @@ func (r *MetricsRepositoryImpl) fetchLeadTimeSamples(ctx context.Context, owner,
 			samples = append(samples, leadTimeSample{
 				duration: mergedAt.Sub(createdAt),
 				mergedAt: mergedAt,
+				author:   pr.GetUser().GetLogin(),
 			})
 			lastIdx := len(samples) - 1
 			reviewRequests = append(reviewRequests, reviewRequest{
@@ -316,11 +571,12 @@ func (r *MetricsRepositoryImpl) fetchLeadTimeSamples(ctx context.Context, owner,
 		opts.Page = nextPage
 	}
 
-	if err := r.populateFirstReviewTimes(ctx, owner, repo, samples, reviewRequests); err != nil {
-		return nil, err
+	reviewFailures, err := r.populateFirstReviewTimes(ctx, owner, repo, samples, reviewRequests)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	return samples, nil
+	return samples, reviewFailures, nil
 }
 
 type reviewRequest struct {
@@ -328,9 +584,11 @@ type reviewRequest struct {
 	number      int
 }
 
-func (r *MetricsRepositoryImpl) populateFirstReviewTimes(ctx context.Context, owner, repo string, samples []leadTimeSample, requests []reviewRequest) error {
+// populateFirstReviewTimes はサンプルにレビューの時刻を埋め、レビューを取得できなかったPRの数を返す。
+// 取得できなかったPRはレビュー時刻なしのまま集計する
+func (r *MetricsRepositoryImpl) populateFirstReviewTimes(ctx context.Context, owner, repo string, samples []leadTimeSample, requests []reviewRequest) (int, error) {
 	if len(requests) == 0 {
-		return nil
+		return 0, nil
 	}
 
 	workerCount := reviewWorkerCount
@@ -340,6 +598,7 @@ func (r *MetricsRepositoryImpl) populateFirstReviewTimes(ctx context.Context, ow
 
 	jobs := make(chan reviewRequest)
 	var wg sync.WaitGroup
+	var failures atomic.Int32
 
 	for i := 0; i < workerCount; i++ {
 		wg.Add(1)
@@ -349,9 +608,14 @@ func (r *MetricsRepositoryImpl) populateFirstReviewTimes(ctx context.Context, ow
 				if ctx.Err() != nil {
 					return
 				}
-				firstReview, approval := r.fetchSampleFirstReview(ctx, owner, repo, req.number)
+				firstReview, approval, reviewers, err := r.fetchReviewTimestamps(ctx, owner, repo, req.number)
+				if err != nil {
+					failures.Add(1)
+					continue
+				}
 				samples[req.sampleIndex].firstReviewAt = firstReview
 				samples[req.sampleIndex].approvedAt = approval
+				samples[req.sampleIndex].reviewers = excludeLogin(reviewers, samples[req.sampleIndex].author)
 			}
 		}()
 	}
@@ -367,29 +631,22 @@ sendLoop:
 	close(jobs)
 	wg.Wait()
 
-	return ctx.Err()
-}
-
-func (r *MetricsRepositoryImpl) fetchSampleFirstReview(ctx context.Context, owner, repo string, number int) (*time.Time, *time.Time) {
-	firstReview, approved, err := r.fetchReviewTimestamps(ctx, owner, repo, number)
-	if err != nil {
-		fmt.Printf("failed to fetch reviews for %s/%s#%d: %v\n", owner, repo, number, err)
-		return nil, nil
-	}
-	return firstReview, approved
+	return int(failures.Load()), ctx.Err()
 }
 
-func (r *MetricsRepositoryImpl) fetchReviewTimestamps(ctx context.Context, owner, repo string, number int) (*time.Time, *time.Time, error) {
+// fetchReviewTimestamps は最初のレビューと最初の承認の日時、レビューを送信したユーザー（送信順・重複なし）を返す
+func (r *MetricsRepositoryImpl) fetchReviewTimestamps(ctx context.Context, owner, repo string, number int) (*time.Time, *time.Time, []string, error) {
 	opts := &github.ListOptions{PerPage: 100}
 	var firstReview time.Time
 	firstFound := false
 	var approval time.Time
 	approvalFound := false
+	var reviewers []string
 
 	for {
 		reviews, resp, err := r.client.client.PullRequests.ListReviews(ctx, owner, repo, number, opts)
 		if err != nil {
-			return nil, nil, handleGitHubError(err, resp)
+			return nil, nil, nil, handleGitHubError(err, resp)
 		}
 
 		for _, review := range reviews {
@@ -397,6 +654,9 @@ func (r *MetricsRepositoryImpl) fetchReviewTimestamps(ctx context.Context, owner
 				continue
 			}
 			submitted := review.SubmittedAt.Time
+			if login := review.GetUser().GetLogin(); login != "" && !containsLogin(reviewers, login) {
+				reviewers = append(reviewers, login)
+			}
 			if !firstFound || submitted.Before(firstReview) {
 				firstReview = submitted
 				firstFound = true
@@ -428,7 +688,28 @@ func (r *MetricsRepositoryImpl) fetchReviewTimestamps(ctx context.Context, owner
 		approvalPtr = &approvalCopy
 	}
 
-	return firstPtr, approvalPtr, nil
+	return firstPtr, approvalPtr, reviewers, nil
+}
+
+// containsLogin は logins に login が含まれるかを返す（大文字小文字を区別しない）
+func containsLogin(logins []string, login string) bool {
+	for _, l := range logins {
+		if strings.EqualFold(l, login) {
+			return true
+		}
+	}
+	return false
+}
+
+// excludeLogin は logins から login を除いたものを返す（自分のPRへのコメントはレビューとして数えない）
+func excludeLogin(logins []string, login string) []string {
+	var rest []string
+	for _, l := range logins {
+		if !strings.EqualFold(l, login) {
+			rest = append(rest, l)
+		}
+	}
+	return rest
 }
 
 func aggregateByDayOfWeek(samples []leadTimeSample) map[time.Weekday]models.DayOfWeekStats {
@@ -541,6 +822,73 @@ func calculateWeeklyComparison(samples []leadTimeSample, now time.Time) models.W
 	}
 }
 
+// trendMonthlyThreshold を超える期間は月単位、それ以下は週単位で集計する
+const trendMonthlyThreshold = 90 * 24 * time.Hour
+
+// calculateTrend はマージ日時を基準に期間ごとの平均リードタイムを算出する
+func calculateTrend(samples []leadTimeSample, since, now time.Time) []models.TrendPoint {
+	if !now.After(since) {
+		return []models.TrendPoint{}
+	}
+
+	monthly := now.Sub(since) > trendMonthlyThreshold
+
+	bucketStart := func(t time.Time) time.Time {
+		t = t.In(now.Location())
+		if monthly {
+			return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+		}
+		// 週の始まりは月曜日
+		offset := (int(t.Weekday()) + 6) % 7
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		return day.AddDate(0, 0, -offset)
+	}
+	nextBucket := func(t time.Time) time.Time {
+		if monthly {
+			return t.AddDate(0, 1, 0)
+		}
+		return t.AddDate(0, 0, 7)
+	}
+	label := func(t time.Time) string {
+		if monthly {
+			return t.Format("2006-01")
+		}
+		return t.Format("2006-01-02")
+	}
+
+	type bucket struct {
+		total time.Duration
+		count int
+	}
+	buckets := make(map[time.Time]*bucket)
+	for _, sample := range samples {
+		if sample.mergedAt.Before(since) || sample.mergedAt.After(now) {
+			continue
+		}
+		key := bucketStart(sample.mergedAt)
+		b, ok := buckets[key]
+		if !ok {
+			b = &bucket{}
+			buckets[key] = b
+		}
+		b.total += sample.duration
+		b.count++
+	}
+
+	// データのない期間も0件として並べ、推移の途切れを可視化する
+	var trend []models.TrendPoint
+	for start := bucketStart(since); !start.After(now); start = nextBucket(start) {
+		point := models.TrendPoint{Period: label(start)}
+		if b, ok := buckets[start]; ok && b.count > 0 {
+			point.AverageLeadTime = time.Duration(int64(b.total) / int64(b.count))
+			point.PRCount = b.count
+		}
+		trend = append(trend, point)
+	}
+
+	return trend
+}
+
 func calculatePercentChange(current, previous int) float64 {
 	if previous == 0 {
 		if current == 0 {
@@ -695,10 +1043,14 @@ func (r *MetricsRepositoryImpl) fetchPRQualityIssuesForRepo(ctx context.Context,
 		}
 
 		for _, pr := range prs {
-			if pr == nil {
+			if pr == nil || r.authorExcluded(pr.GetUser().GetLogin()) {
 				continue
 			}
-			issues = append(issues, collectQualityIssuesForPR(slug, pr)...)
+			protected, err := r.touchedProtectedPaths(ctx, owner, repo, pr.GetNumber())
+			if err != nil {
+				return nil, err
+			}
+			issues = append(issues, collectQualityIssuesForPR(slug, pr, protected)...)
 		}
 
 		if resp == nil || resp.NextPage == 0 {
@@ -710,7 +1062,36 @@ func (r *MetricsRepositoryImpl) fetchPRQualityIssuesForRepo(ctx context.Context,
 	return issues, nil
 }
 
-func collectQualityIssuesForPR(repoSlug string, pr *github.PullRequest) []scoredQualityIssue {
+// touchedProtectedPaths は PR が変更した保護対象パスのパターンを返す。
+// ファイル一覧を取得できない PR は判定対象外とする
+func (r *MetricsRepositoryImpl) touchedProtectedPaths(ctx context.Context, owner, repo string, number int) ([]string, error) {
+	if len(r.protectedPaths) == 0 {
+		return nil, nil
+	}
+
+	opts := &github.ListOptions{PerPage: 100}
+	var filenames []string
+	for {
+		files, resp, err := r.client.client.PullRequests.ListFiles(ctx, owner, repo, number, opts)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+			return nil, nil
+		}
+		for _, file := range files {
+			filenames = append(filenames, file.GetFilename())
+		}
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return r.protectedPaths.Touched(filenames), nil
+}
+
+func collectQualityIssuesForPR(repoSlug string, pr *github.PullRequest, protected []string) []scoredQualityIssue {
 	lines := pr.GetAdditions() + pr.GetDeletions()
 	files := pr.GetChangedFiles()
 	commits := pr.GetCommits()
@@ -782,6 +1163,15 @@ func collectQualityIssuesForPR(repoSlug string, pr *github.PullRequest) []scored
 		)
 	}
 
+	if len(protected) > 0 {
+		addIssue(
+			"protected_paths",
+			"high",
+			fmt.Sprintf("保護対象パス（%s）の変更は影響範囲が大きい", strings.Join(protected, ", ")),
+			"担当者のレビューを受け、マージ前に影響範囲を確認",
+		)
+	}
+
 	return issues
 }
 
@@ -806,6 +1196,8 @@ func calculateQualityImpact(issueType string, lines, files, commits int) int {
 		impact += commits * 20
 	case "large_single_commit":
 		impact += 200
+	case "protected_paths":
+		impact += 300
 	case "no_description", "short_description":
 		impact += (files + commits) * 10
 	}
@@ -821,7 +1213,9 @@ func formatQualityDetails(lines, files, commits int) string {
 	return details
 }
 
-func (r *MetricsRepositoryImpl) fetchStagnantPRMetrics(ctx context.Context, repos []string, now time.Time) (models.StagnantPRMetrics, error) {
+// fetchStagnantPRMetrics は停滞しているPRを集計する。取得できなかったリポジトリは除き、その理由を返す
+func (r *MetricsRepositoryImpl) fetchStagnantPRMetrics(ctx context.Context, repos []string, now time.Time) (models.StagnantPRMetrics, []string) {
+	var warnings []string
 	var allStagnantPRs []models.StagnantPRInfo
 
 	var tasks []repoFetchTask
@@ -846,7 +1240,7 @@ func (r *MetricsRepositoryImpl) fetchStagnantPRMetrics(ctx context.Context, repo
 	if len(tasks) == 0 {
 		return models.StagnantPRMetrics{
 			Threshold: stagnantPRThreshold,
-		}, nil
+		}, warnings
 	}
 
 	workerCount := repoWorkerCount
@@ -881,7 +1275,7 @@ func (r *MetricsRepositoryImpl) fetchStagnantPRMetrics(ctx context.Context, repo
 
 				var stagnant []models.StagnantPRInfo
 				for _, pr := range prs {
-					if pr == nil || pr.CreatedAt == nil {
+					if pr == nil || pr.CreatedAt == nil || r.authorExcluded(pr.GetUser().GetLogin()) {
 						continue
 					}
 
@@ -918,7 +1312,7 @@ func (r *MetricsRepositoryImpl) fetchStagnantPRMetrics(ctx context.Context, repo
 
 	for result := range results {
 		if result.err != nil {
-			fmt.Printf("failed to fetch stagnant PR metrics for %s: %v\n", result.repo, result.err)
+			warnings = append(warnings, fmt.Sprintf("failed to fetch stagnant PR metrics for %s: %v", result.repo, result.err))
 			continue
 		}
 
@@ -930,7 +1324,7 @@ func (r *MetricsRepositoryImpl) fetchStagnantPRMetrics(ctx context.Context, repo
 	if len(allStagnantPRs) == 0 {
 		return models.StagnantPRMetrics{
 			Threshold: stagnantPRThreshold,
-		}, nil
+		}, warnings
 	}
 
 	var totalAgeSeconds float64
@@ -959,7 +1353,7 @@ func (r *MetricsRepositoryImpl) fetchStagnantPRMetrics(ctx context.Context, repo
 		TotalStagnant:  len(allStagnantPRs),
 		AverageAge:     averageAge,
 		LongestWaiting: longestWaiting,
-	}, nil
+	}, warnings
 }
 
 func parseRepositorySlug(slug string) (string, string, error) {
@@ -991,7 +1385,8 @@ func samplesToDurations(samples []leadTimeSample) []time.Duration {
 	return durations
 }
 
-func calculateLeadTimeStat(durations []time.Duration) models.LeadTimeStat {
+// calculateLeadTimeStat は平均・中央値と percentiles のパーセンタイルを計算する
+func calculateLeadTimeStat(durations []time.Duration, percentiles ...int) models.LeadTimeStat {
 	count := len(durations)
 	if count == 0 {
 		return models.LeadTimeStat{}
@@ -1010,11 +1405,35 @@ func calculateLeadTimeStat(durations []time.Duration) models.LeadTimeStat {
 	avg := time.Duration(int64(total) / int64(count))
 	median := calculateMedian(sorted)
 
-	return models.LeadTimeStat{
+	stat := models.LeadTimeStat{
 		Average: avg,
 		Median:  median,
 		Count:   count,
 	}
+	for _, p := range percentiles {
+		stat.Percentiles = append(stat.Percentiles, models.LeadTimePercentile{
+			Percentile: p,
+			Value:      calculatePercentile(sorted, p),
+		})
+	}
+	return stat
+}
+
+// calculatePercentile は昇順の sorted の p パーセンタイルを最近順位法で返す
+// （p90 なら全体の90%がその値以下になる最小のサンプル）
+func calculatePercentile(sorted []time.Duration, p int) time.Duration {
+	n := len(sorted)
+	if n == 0 {
+		return 0
+	}
+	rank := (p*n + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > n {
+		rank = n
+	}
+	return sorted[rank-1]
 }
 
 func calculateMedian(sorted []time.Duration) time.Duration {
@@ -1,8 +1,18 @@
 package github
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/google/go-github/v57/github"
 )
 
 func TestCalculateLeadTimeStat(t *testing.T) {
@@ -24,6 +34,37 @@ func TestCalculateLeadTimeStat(t *testing.T) {
 	if stat.Median != 2*time.Hour+30*time.Minute {
 		t.Fatalf("unexpected median %v", stat.Median)
 	}
+	if len(stat.Percentiles) != 0 {
+		t.Fatalf("expected no percentiles, got %v", stat.Percentiles)
+	}
+}
+
+func TestCalculateLeadTimeStat_Percentiles(t *testing.T) {
+	durations := make([]time.Duration, 0, 20)
+	for i := 20; i >= 1; i-- {
+		durations = append(durations, time.Duration(i)*time.Hour)
+	}
+
+	stat := calculateLeadTimeStat(durations, 50, 90, 95, 100)
+
+	want := []models.LeadTimePercentile{
+		{Percentile: 50, Value: 10 * time.Hour},
+		{Percentile: 90, Value: 18 * time.Hour},
+		{Percentile: 95, Value: 19 * time.Hour},
+		{Percentile: 100, Value: 20 * time.Hour},
+	}
+	if len(stat.Percentiles) != len(want) {
+		t.Fatalf("unexpected percentiles %v", stat.Percentiles)
+	}
+	for i, p := range want {
+		if stat.Percentiles[i] != p {
+			t.Fatalf("percentile %d: want %v, got %v", i, p, stat.Percentiles[i])
+		}
+	}
+
+	if got := calculatePercentile([]time.Duration{time.Hour}, 1); got != time.Hour {
+		t.Fatalf("single sample should be every percentile, got %v", got)
+	}
 }
 
 func TestParseRepositorySlug(t *testing.T) {
@@ -39,3 +80,488 @@ func TestParseRepositorySlug(t *testing.T) {
 		t.Fatal("expected error for invalid slug")
 	}
 }
+
+func TestCalculateTrend_Weekly(t *testing.T) {
+	now := time.Date(2025, 1, 22, 12, 0, 0, 0, time.UTC) // Wednesday
+	since := now.AddDate(0, 0, -14)
+
+	samples := []leadTimeSample{
+		{duration: 2 * time.Hour, mergedAt: time.Date(2025, 1, 8, 13, 0, 0, 0, time.UTC)},
+		{duration: 4 * time.Hour, mergedAt: time.Date(2025, 1, 9, 10, 0, 0, 0, time.UTC)},
+		{duration: 10 * time.Hour, mergedAt: time.Date(2025, 1, 21, 10, 0, 0, 0, time.UTC)},
+		{duration: time.Hour, mergedAt: time.Date(2024, 12, 1, 10, 0, 0, 0, time.UTC)}, // out of range
+	}
+
+	trend := calculateTrend(samples, since, now)
+
+	if len(trend) != 3 {
+		t.Fatalf("expected 3 weekly buckets, got %d: %+v", len(trend), trend)
+	}
+	if trend[0].Period != "2025-01-06" || trend[0].PRCount != 2 || trend[0].AverageLeadTime != 3*time.Hour {
+		t.Fatalf("unexpected first bucket %+v", trend[0])
+	}
+	if trend[1].Period != "2025-01-13" || trend[1].PRCount != 0 {
+		t.Fatalf("expected empty middle bucket, got %+v", trend[1])
+	}
+	if trend[2].Period != "2025-01-20" || trend[2].PRCount != 1 || trend[2].AverageLeadTime != 10*time.Hour {
+		t.Fatalf("unexpected last bucket %+v", trend[2])
+	}
+}
+
+func TestCalculateTrend_Monthly(t *testing.T) {
+	now := time.Date(2025, 4, 15, 0, 0, 0, 0, time.UTC)
+	since := now.AddDate(0, 0, -120)
+
+	samples := []leadTimeSample{
+		{duration: 6 * time.Hour, mergedAt: time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC)},
+	}
+
+	trend := calculateTrend(samples, since, now)
+
+	if len(trend) != 5 {
+		t.Fatalf("expected 5 monthly buckets, got %d: %+v", len(trend), trend)
+	}
+	if trend[0].Period != "2024-12" || trend[4].Period != "2025-04" {
+		t.Fatalf("unexpected bucket range %s..%s", trend[0].Period, trend[4].Period)
+	}
+	if trend[2].PRCount != 1 || trend[2].AverageLeadTime != 6*time.Hour {
+		t.Fatalf("unexpected february bucket %+v", trend[2])
+	}
+}
+
+func TestTouchedProtectedPaths(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/owner/repo/pulls/5/files" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `[{"filename":"migrations/001_init.sql"}]`)
+			return
+		}
+		w.Header().Set("Link", `<`+"http://"+r.Host+r.URL.Path+`?page=2>; rel="next"`)
+		fmt.Fprint(w, `[{"filename":"cmd/main.go"}]`)
+	})
+
+	repo := NewMetricsRepository(client, []string{"infra/", "migrations/"}).(*MetricsRepositoryImpl)
+	touched, err := repo.touchedProtectedPaths(context.Background(), "owner", "repo", 5)
+	if err != nil {
+		t.Fatalf("touchedProtectedPaths() error = %v", err)
+	}
+	if len(touched) != 1 || touched[0] != "migrations/" {
+		t.Errorf("touchedProtectedPaths() = %v, want [migrations/]", touched)
+	}
+
+	// Without protected paths no files are fetched
+	repo = NewMetricsRepository(client, nil).(*MetricsRepositoryImpl)
+	if touched, err := repo.touchedProtectedPaths(context.Background(), "owner", "repo", 404); err != nil || touched != nil {
+		t.Errorf("touchedProtectedPaths() without patterns = %v, %v", touched, err)
+	}
+}
+
+func TestCollectQualityIssuesForPR_ProtectedPaths(t *testing.T) {
+	pr := &github.PullRequest{
+		Number: github.Int(9),
+		Title:  github.String("Rotate credentials"),
+		Body:   github.String("Rotates the deploy credentials used by the release workflow."),
+	}
+
+	if issues := collectQualityIssuesForPR("owner/repo", pr, nil); len(issues) != 0 {
+		t.Fatalf("expected no issues, got %+v", issues)
+	}
+
+	issues := collectQualityIssuesForPR("owner/repo", pr, []string{".github/workflows/"})
+	if len(issues) != 1 {
+		t.Fatalf("expected one issue, got %+v", issues)
+	}
+	issue := issues[0].issue
+	if issue.IssueType != "protected_paths" || issue.Severity != "high" || issue.Number != 9 {
+		t.Errorf("unexpected issue %+v", issue)
+	}
+}
+
+func TestCalculateIssueBacklog(t *testing.T) {
+	now := time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC)
+	at := func(daysAgo int) *time.Time {
+		ts := now.AddDate(0, 0, -daysAgo)
+		return &ts
+	}
+	buckets := issueBacklogBuckets([]string{"Bug", " ", "enhancement", "bug"})
+	if fmt.Sprint(buckets) != "[Bug enhancement other]" {
+		t.Fatalf("issueBacklogBuckets() = %v", buckets)
+	}
+
+	spans := []issueSpan{
+		{createdAt: *at(30), labels: []string{"bug"}},                           // 3週間前からオープン
+		{createdAt: *at(30), closedAt: at(10), labels: []string{"question"}},    // 2週間前まではオープン
+		{createdAt: *at(3), labels: []string{"enhancement", "bug"}},             // 今週作成、bug が優先
+		{createdAt: *at(40), closedAt: at(20), labels: []string{"enhancement"}}, // 3週間前だけオープン
+	}
+
+	weeks := calculateIssueBacklog(spans, buckets, 4, now)
+	if len(weeks) != 4 || !weeks[3].End.Equal(now) || !weeks[0].End.Equal(now.AddDate(0, 0, -21)) {
+		t.Fatalf("unexpected week ends: %+v", weeks)
+	}
+
+	want := []map[string]int{
+		{"Bug": 1, "enhancement": 1, "other": 1},
+		{"Bug": 1, "enhancement": 0, "other": 1},
+		{"Bug": 1, "enhancement": 0, "other": 0},
+		{"Bug": 2, "enhancement": 0, "other": 0},
+	}
+	for i, week := range weeks {
+		if fmt.Sprint(week.Open) != fmt.Sprint(want[i]) {
+			t.Errorf("week %d = %v, want %v", i, week.Open, want[i])
+		}
+	}
+}
+
+func TestFetchIssueBacklog(t *testing.T) {
+	now := time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/owner/repo/issues" {
+			http.NotFound(w, r)
+			return
+		}
+		switch r.URL.Query().Get("state") {
+		case "open":
+			fmt.Fprint(w, `[
+				{"number":1,"created_at":"2025-03-01T00:00:00Z","labels":[{"name":"bug"}]},
+				{"number":2,"created_at":"2025-03-01T00:00:00Z","pull_request":{"url":"x"}}
+			]`)
+		case "closed":
+			if r.URL.Query().Get("since") == "" {
+				t.Errorf("closed issues should be limited to the window")
+			}
+			fmt.Fprint(w, `[{"number":3,"created_at":"2025-03-01T00:00:00Z","closed_at":"2025-03-29T00:00:00Z"}]`)
+		}
+	})
+
+	repo := NewMetricsRepository(client, nil)
+	backlog, err := repo.FetchIssueBacklog(context.Background(), []string{"owner/repo", "invalid"}, 2, []string{"bug"}, now)
+	if err != nil {
+		t.Fatalf("FetchIssueBacklog() error = %v", err)
+	}
+
+	if fmt.Sprint(backlog.Buckets) != "[bug other]" || len(backlog.Weeks) != 2 {
+		t.Fatalf("unexpected backlog: %+v", backlog)
+	}
+	if got := fmt.Sprint(backlog.Weeks[0].Open); got != "map[bug:1 other:1]" {
+		t.Errorf("last week = %s", got)
+	}
+	if got := fmt.Sprint(backlog.Weeks[1].Open); got != "map[bug:1 other:0]" {
+		t.Errorf("this week = %s", got)
+	}
+	if len(backlog.ByRepository["owner/repo"]) != 2 {
+		t.Errorf("expected per-repository backlog, got %+v", backlog.ByRepository)
+	}
+	// PRは除き、オープンのIssueと期間中にクローズされたIssueのラベルを集計する
+	if labels := backlog.Labels; labels.Issues != 2 || labels.Unlabeled != 1 || labels.Labels["bug"] != 1 {
+		t.Errorf("unexpected label insights: %+v", labels)
+	}
+	if backlog.LabelsByRepository["owner/repo"].Issues != 2 {
+		t.Errorf("expected per-repository label insights, got %+v", backlog.LabelsByRepository)
+	}
+}
+
+func TestCalculateLabelInsights(t *testing.T) {
+	spans := []issueSpan{
+		{labels: []string{"bug", "ui"}},
+		{labels: []string{"ui", "bug", "bug"}}, // 重複したラベルは1回と数える
+		{labels: []string{"docs"}},
+		{labels: []string{"ui", "docs", "bug"}},
+		{},
+	}
+
+	insights := calculateLabelInsights(spans)
+	if insights.Issues != 5 || insights.Unlabeled != 1 {
+		t.Fatalf("unexpected totals: %+v", insights)
+	}
+	if got := fmt.Sprint(insights.TopLabels(2)); got != "[{[bug] 3} {[ui] 3}]" {
+		t.Errorf("TopLabels() = %s", got)
+	}
+	if got := fmt.Sprint(insights.TopPairs(2)); got != "[{[bug ui] 3} {[bug docs] 1}]" {
+		t.Errorf("TopPairs() = %s", got)
+	}
+
+	var merged models.LabelInsights
+	merged.Merge(insights)
+	merged.Merge(insights)
+	if merged.Issues != 10 || merged.Labels["docs"] != 4 || merged.Pairs["bug"]["ui"] != 6 || merged.UnlabeledRatio() != 0.2 {
+		t.Errorf("unexpected merged insights: %+v", merged)
+	}
+}
+
+func TestListRecentRepositories(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("sort") != "pushed" {
+			t.Errorf("%s: expected repositories sorted by push, got %q", r.URL.Path, r.URL.RawQuery)
+		}
+		switch r.URL.Path {
+		case "/orgs/octocat/repos":
+			http.NotFound(w, r)
+		case "/users/octocat/repos":
+			fmt.Fprint(w, `[{"full_name":"octocat/hello","language":"Go","topics":["cli","tui"]},{"full_name":"octocat/old","archived":true}]`)
+		case "/user/repos":
+			fmt.Fprint(w, `[{"full_name":"me/dotfiles"},{"full_name":"octocat/hello"}]`)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	repo := NewMetricsRepository(client, nil)
+	repos, err := repo.ListRecentRepositories(context.Background(), "octocat")
+	if err != nil {
+		t.Fatalf("ListRecentRepositories() error = %v", err)
+	}
+	if len(repos) != 2 || repos[0].FullName != "octocat/hello" || repos[1].FullName != "me/dotfiles" {
+		t.Fatalf("ListRecentRepositories() = %+v", repos)
+	}
+	if repos[0].Language != "Go" || fmt.Sprint(repos[0].Topics) != "[cli tui]" {
+		t.Errorf("expected the language and topics, got %+v", repos[0])
+	}
+}
+
+func TestFetchLeadTimeMetrics_PartialFailure(t *testing.T) {
+	var okFetches, goneFetches int32
+	var gone atomic.Bool
+	gone.Store(true)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/owner/ok":
+			atomic.AddInt32(&okFetches, 1)
+			fmt.Fprint(w, `{"default_branch":"main"}`)
+		case "/repos/owner/gone":
+			atomic.AddInt32(&goneFetches, 1)
+			if gone.Load() {
+				http.NotFound(w, r)
+				return
+			}
+			fmt.Fprint(w, `{"default_branch":"main"}`)
+		default:
+			fmt.Fprint(w, `[]`)
+		}
+	})
+	client.SetRetries(0)
+
+	repo := NewMetricsRepository(client, nil).(*MetricsRepositoryImpl)
+	repos := []string{"owner/ok", "owner/gone", "invalid"}
+	since := time.Now().Add(-72 * time.Hour)
+
+	metrics, err := repo.FetchLeadTimeMetrics(context.Background(), repos, since, nil)
+	if err != nil {
+		t.Fatalf("a partial failure should not fail the run: %v", err)
+	}
+	want := []models.MetricsRepositoryStatus{
+		{Repository: "owner/ok"},
+		{Repository: "owner/gone", Error: "resource not found (404)"},
+		{Repository: "invalid", Error: "invalid repository format"},
+	}
+	if !reflect.DeepEqual(metrics.RepositoryStatuses, want) {
+		t.Errorf("RepositoryStatuses = %+v, want %+v", metrics.RepositoryStatuses, want)
+	}
+	if fmt.Sprint(metrics.FailedRepositories()) != "[owner/gone invalid]" {
+		t.Errorf("FailedRepositories() = %v", metrics.FailedRepositories())
+	}
+
+	// Retrying fetches only the failed repositories again
+	gone.Store(false)
+	metrics, err = repo.RefetchLeadTimeMetrics(context.Background(), repos, []string{"owner/gone"}, since, nil)
+	if err != nil {
+		t.Fatalf("RefetchLeadTimeMetrics() error = %v", err)
+	}
+	if okFetches != 1 || goneFetches != 2 {
+		t.Errorf("fetched owner/ok %d times and owner/gone %d times, want 1 and 2", okFetches, goneFetches)
+	}
+	if got := metrics.FailedRepositories(); fmt.Sprint(got) != "[invalid]" {
+		t.Errorf("FailedRepositories() after retry = %v", got)
+	}
+
+	// A different period cannot reuse the previous samples
+	if _, err := repo.RefetchLeadTimeMetrics(context.Background(), repos, nil, since.Add(-time.Hour), nil); err != nil {
+		t.Fatalf("RefetchLeadTimeMetrics() error = %v", err)
+	}
+	if okFetches != 2 {
+		t.Errorf("owner/ok fetched %d times, want a full fetch for a new period", okFetches)
+	}
+}
+
+func TestFetchLeadTimeMetrics_ExcludesAuthors(t *testing.T) {
+	now := time.Now().UTC()
+	at := func(d time.Duration) string { return now.Add(-d).Format(time.RFC3339) }
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/repos/owner/app":
+			fmt.Fprint(w, `{"default_branch":"main"}`)
+		case r.URL.Path == "/repos/owner/app/pulls" && r.URL.Query().Get("state") == "closed":
+			fmt.Fprintf(w, `[
+				{"number":1,"user":{"login":"alice"},"base":{"ref":"main"},"created_at":%q,"merged_at":%q},
+				{"number":2,"user":{"login":"dependabot[bot]"},"base":{"ref":"main"},"created_at":%q,"merged_at":%q},
+				{"number":3,"user":{"login":"Release-Robot"},"base":{"ref":"main"},"created_at":%q,"merged_at":%q}
+			]`, at(10*time.Hour), at(time.Hour), at(3*time.Hour), at(2*time.Hour), at(5*time.Hour), at(4*time.Hour))
+		case r.URL.Path == "/repos/owner/app/pulls":
+			fmt.Fprintf(w, `[
+				{"number":4,"user":{"login":"bob"},"title":"Slow","created_at":%q},
+				{"number":5,"user":{"login":"renovate[bot]"},"title":"Bump","created_at":%q}
+			]`, at(100*time.Hour), at(100*time.Hour))
+		default:
+			fmt.Fprint(w, `[]`)
+		}
+	})
+
+	repo := NewMetricsRepository(client, nil).(*MetricsRepositoryImpl)
+	repo.SetExcludedAuthors([]string{"release-robot"}, true)
+
+	metrics, err := repo.FetchLeadTimeMetrics(context.Background(), []string{"owner/app"}, now.Add(-72*time.Hour), nil)
+	if err != nil {
+		t.Fatalf("FetchLeadTimeMetrics() error = %v", err)
+	}
+	if metrics.ExcludedPRs != 2 {
+		t.Errorf("ExcludedPRs = %d, want 2", metrics.ExcludedPRs)
+	}
+	if metrics.Overall.Count != 1 || metrics.Overall.Average != 9*time.Hour {
+		t.Errorf("Overall = %+v, want only alice's PR", metrics.Overall)
+	}
+	if metrics.StagnantPRs.TotalStagnant != 1 || metrics.StagnantPRs.LongestWaiting[0].Number != 4 {
+		t.Errorf("StagnantPRs = %+v, want only bob's PR", metrics.StagnantPRs)
+	}
+
+	// Without exclusions every PR counts
+	repo.SetExcludedAuthors(nil, false)
+	metrics, err = repo.FetchLeadTimeMetrics(context.Background(), []string{"owner/app"}, now.Add(-72*time.Hour), nil)
+	if err != nil {
+		t.Fatalf("FetchLeadTimeMetrics() error = %v", err)
+	}
+	if metrics.ExcludedPRs != 0 || metrics.Overall.Count != 3 {
+		t.Errorf("expected all 3 PRs without exclusions, got %d (excluded %d)", metrics.Overall.Count, metrics.ExcludedPRs)
+	}
+}
+
+func TestFetchLeadTimeMetrics_GroupsByAuthorAndReviewer(t *testing.T) {
+	now := time.Now().UTC()
+	at := func(d time.Duration) string { return now.Add(-d).Format(time.RFC3339) }
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/owner/app":
+			fmt.Fprint(w, `{"default_branch":"main"}`)
+		case "/repos/owner/app/pulls":
+			if r.URL.Query().Get("state") != "closed" {
+				fmt.Fprint(w, `[]`)
+				return
+			}
+			fmt.Fprintf(w, `[
+				{"number":1,"user":{"login":"alice"},"base":{"ref":"main"},"created_at":%q,"merged_at":%q},
+				{"number":2,"user":{"login":"bob"},"base":{"ref":"main"},"created_at":%q,"merged_at":%q}
+			]`, at(10*time.Hour), at(time.Hour), at(3*time.Hour), at(2*time.Hour))
+		case "/repos/owner/app/pulls/1/reviews":
+			fmt.Fprintf(w, `[
+				{"user":{"login":"carol"},"state":"COMMENTED","submitted_at":%q},
+				{"user":{"login":"alice"},"state":"COMMENTED","submitted_at":%q},
+				{"user":{"login":"carol"},"state":"APPROVED","submitted_at":%q}
+			]`, at(8*time.Hour), at(7*time.Hour), at(6*time.Hour))
+		case "/repos/owner/app/pulls/2/reviews":
+			fmt.Fprintf(w, `[
+				{"user":{"login":"carol"},"state":"APPROVED","submitted_at":%q},
+				{"user":{"login":"dave"},"state":"APPROVED","submitted_at":%q}
+			]`, at(150*time.Minute), at(140*time.Minute))
+		default:
+			fmt.Fprint(w, `[]`)
+		}
+	})
+
+	repo := NewMetricsRepository(client, nil).(*MetricsRepositoryImpl)
+	metrics, err := repo.FetchLeadTimeMetrics(context.Background(), []string{"owner/app"}, now.Add(-72*time.Hour), nil)
+	if err != nil {
+		t.Fatalf("FetchLeadTimeMetrics() error = %v", err)
+	}
+
+	if len(metrics.ByAuthor) != 2 || metrics.ByAuthor["alice"].Average != 9*time.Hour || metrics.ByAuthor["bob"].Count != 1 {
+		t.Errorf("ByAuthor = %+v, want alice (9h) and bob", metrics.ByAuthor)
+	}
+	if metrics.ByAuthorPhaseBreakdown["alice"].CreatedToFirstReview != 2*time.Hour {
+		t.Errorf("ByAuthorPhaseBreakdown[alice] = %+v, want 2h to the first review", metrics.ByAuthorPhaseBreakdown["alice"])
+	}
+
+	// A reviewer counts once per PR, and authors commenting on their own PR are not reviewers
+	if _, ok := metrics.ByReviewer["alice"]; ok {
+		t.Error("expected a comment on one's own PR not to count as a review")
+	}
+	if metrics.ByReviewer["carol"].Count != 2 || metrics.ByReviewer["dave"].Count != 1 {
+		t.Errorf("ByReviewer = %+v, want carol on 2 PRs and dave on 1", metrics.ByReviewer)
+	}
+}
+
+func TestFetchLeadTimeMetrics_StashResumesAfterRestart(t *testing.T) {
+	var okFetches, goneFetches int32
+	var gone atomic.Bool
+	gone.Store(true)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/owner/ok":
+			atomic.AddInt32(&okFetches, 1)
+			fmt.Fprint(w, `{"default_branch":"main"}`)
+		case "/repos/owner/gone":
+			atomic.AddInt32(&goneFetches, 1)
+			if gone.Load() {
+				http.NotFound(w, r)
+				return
+			}
+			fmt.Fprint(w, `{"default_branch":"main"}`)
+		default:
+			fmt.Fprint(w, `[]`)
+		}
+	})
+	client.SetRetries(0)
+
+	path := filepath.Join(t.TempDir(), "state", "metrics-stash.json")
+	repos := []string{"owner/ok", "owner/gone"}
+	since := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	first := NewMetricsRepository(client, nil).(*MetricsRepositoryImpl)
+	if err := first.SetStashPath(path); err != nil {
+		t.Fatalf("SetStashPath() error = %v", err)
+	}
+	if _, err := first.FetchLeadTimeMetrics(context.Background(), repos, since, nil); err != nil {
+		t.Fatalf("FetchLeadTimeMetrics() error = %v", err)
+	}
+
+	// A new process picks up where the previous one stopped
+	second := NewMetricsRepository(client, nil).(*MetricsRepositoryImpl)
+	if err := second.SetStashPath(path); err != nil {
+		t.Fatalf("SetStashPath() error = %v", err)
+	}
+	run := second.InterruptedRun(repos)
+	if run == nil || !run.Since.Equal(since) || fmt.Sprint(run.Completed) != "[owner/ok]" || fmt.Sprint(run.Remaining) != "[owner/gone]" {
+		t.Fatalf("InterruptedRun() = %+v", run)
+	}
+
+	gone.Store(false)
+	metrics, err := second.RefetchLeadTimeMetrics(context.Background(), repos, nil, run.Since, nil)
+	if err != nil {
+		t.Fatalf("RefetchLeadTimeMetrics() error = %v", err)
+	}
+	if okFetches != 1 || goneFetches != 2 {
+		t.Errorf("fetched owner/ok %d times and owner/gone %d times, want 1 and 2", okFetches, goneFetches)
+	}
+	if len(metrics.FailedRepositories()) != 0 {
+		t.Errorf("FailedRepositories() = %v", metrics.FailedRepositories())
+	}
+
+	// A finished run leaves nothing to resume
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected the stash to be removed, got %v", err)
+	}
+	if run := second.InterruptedRun(repos); run != nil {
+		t.Errorf("InterruptedRun() after finishing = %+v, want nil", run)
+	}
+}
+
+func TestFetchLeadTimeMetrics_AllFailed(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	})
+	repo := NewMetricsRepository(client, nil)
+	if _, err := repo.FetchLeadTimeMetrics(context.Background(), []string{"owner/gone"}, time.Now(), nil); err == nil {
+		t.Error("expected an error when no repository could be fetched")
+	}
+}
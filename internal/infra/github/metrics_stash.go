@@ -0,0 +1,195 @@
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// metricsStash は取得を終えたリポジトリのサンプルの記録（途中で終わった取得を再開するため）
+type metricsStash struct {
+	Since        time.Time                          `json:"since"`
+	UpdatedAt    time.Time                          `json:"updated_at"`
+	Repositories map[string][]stashedLeadTimeSample `json:"repositories"`
+}
+
+// stashedLeadTimeSample は記録用の leadTimeSample
+type stashedLeadTimeSample struct {
+	Duration      time.Duration `json:"duration"`
+	MergedAt      time.Time     `json:"merged_at"`
+	FirstReviewAt *time.Time    `json:"first_review_at,omitempty"`
+	ApprovedAt    *time.Time    `json:"approved_at,omitempty"`
+	Author        string        `json:"author,omitempty"`
+	Reviewers     []string      `json:"reviewers,omitempty"`
+}
+
+// SetStashPath は取得を終えたリポジトリのサンプルを path に記録するようにし、
+// 前回記録したものがあれば読み込む。アプリを終了しても途中で終わった取得を再開できる
+func (r *MetricsRepositoryImpl) SetStashPath(path string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stashPath = path
+	if path == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read metrics stash: %w", err)
+	}
+	var stash metricsStash
+	if err := json.Unmarshal(data, &stash); err != nil {
+		return fmt.Errorf("failed to parse metrics stash: %w", err)
+	}
+
+	r.lastSince = stash.Since
+	r.lastUpdated = stash.UpdatedAt
+	r.lastSamples = make(map[string][]leadTimeSample, len(stash.Repositories))
+	for slug, stashed := range stash.Repositories {
+		samples := make([]leadTimeSample, len(stashed))
+		for i, s := range stashed {
+			samples[i] = leadTimeSample{
+				duration:      s.Duration,
+				mergedAt:      s.MergedAt,
+				firstReviewAt: s.FirstReviewAt,
+				approvedAt:    s.ApprovedAt,
+				author:        s.Author,
+				reviewers:     s.Reviewers,
+			}
+		}
+		r.lastSamples[slug] = samples
+	}
+	return nil
+}
+
+// InterruptedRun は repos の直前の取得が途中で終わっていれば、取得を終えたリポジトリと
+// 残りのリポジトリを返す。すべて取得済み、または1つも取得していなければ nil
+func (r *MetricsRepositoryImpl) InterruptedRun(repos []string) *models.InterruptedMetricsRun {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.lastSince.IsZero() {
+		return nil
+	}
+
+	run := &models.InterruptedMetricsRun{Since: r.lastSince, UpdatedAt: r.lastUpdated}
+	seen := make(map[string]bool)
+	for _, slug := range repos {
+		slug = strings.TrimSpace(slug)
+		if slug == "" || seen[slug] {
+			continue
+		}
+		seen[slug] = true
+		if _, ok := r.lastSamples[slug]; ok {
+			run.Completed = append(run.Completed, slug)
+		} else {
+			run.Remaining = append(run.Remaining, slug)
+		}
+	}
+	if len(run.Completed) == 0 || len(run.Remaining) == 0 {
+		return nil
+	}
+	return run
+}
+
+// startStash は since の取得を始める。reuse のサンプルはそのまま取得済みとして記録する
+func (r *MetricsRepositoryImpl) startStash(since time.Time, reuse map[string][]leadTimeSample) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastSince = since
+	r.lastUpdated = time.Now()
+	r.lastSamples = make(map[string][]leadTimeSample, len(reuse))
+	for slug, samples := range reuse {
+		r.lastSamples[slug] = samples
+	}
+	r.writeStash()
+}
+
+// stashSamples は取得を終えたリポジトリのサンプルを記録する
+func (r *MetricsRepositoryImpl) stashSamples(slug string, samples []leadTimeSample) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastUpdated = time.Now()
+	r.lastSamples[slug] = samples
+	r.writeStash()
+}
+
+// clearStash はすべてのリポジトリを取得できたときに記録ファイルを消す
+// 失敗したリポジトリの再取得に使うため、メモリ上のサンプルは残す
+func (r *MetricsRepositoryImpl) clearStash() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stashPath == "" {
+		return
+	}
+	_ = os.Remove(r.stashPath)
+}
+
+// writeStash はメモリ上のサンプルを記録ファイルに書き出す（r.mu を持って呼ぶ）
+// 記録は再開のための補助なので、書き込めなくても取得は続ける
+func (r *MetricsRepositoryImpl) writeStash() {
+	if r.stashPath == "" {
+		return
+	}
+
+	stash := metricsStash{
+		Since:        r.lastSince,
+		UpdatedAt:    r.lastUpdated,
+		Repositories: make(map[string][]stashedLeadTimeSample, len(r.lastSamples)),
+	}
+	for slug, samples := range r.lastSamples {
+		stashed := make([]stashedLeadTimeSample, len(samples))
+		for i, s := range samples {
+			stashed[i] = stashedLeadTimeSample{
+				Duration:      s.duration,
+				MergedAt:      s.mergedAt,
+				FirstReviewAt: s.firstReviewAt,
+				ApprovedAt:    s.approvedAt,
+				Author:        s.author,
+				Reviewers:     s.reviewers,
+			}
+		}
+		stash.Repositories[slug] = stashed
+	}
+
+	_ = writeStashFile(r.stashPath, stash)
+}
+
+// writeStashFile は一時ファイル経由で path に書き込む（途中で落ちても記録が壊れない）
+func writeStashFile(path string, stash metricsStash) error {
+	data, err := json.Marshal(stash)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, ".metrics-stash-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package github
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/google/go-github/v57/github"
+)
+
+// ListMilestones retrieves the open milestones of a repository, nearest due date first
+func (r *IssueRepositoryImpl) ListMilestones(ctx context.Context, owner, repo string) ([]*models.Milestone, error) {
+	var milestones []*models.Milestone
+	opts := &github.MilestoneListOptions{
+		State:       "open",
+		Sort:        "due_on",
+		Direction:   "asc",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	for {
+		ghMilestones, resp, err := r.client.client.Issues.ListMilestones(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, handleGitHubError(err, resp)
+		}
+		for _, ghMilestone := range ghMilestones {
+			if milestone := convertToMilestone(ghMilestone); milestone != nil {
+				milestones = append(milestones, milestone)
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return milestones, nil
+}
+
+// ListMilestoneIssues retrieves every issue of a milestone, open and closed.
+// Pull requests in the milestone are left out.
+func (r *IssueRepositoryImpl) ListMilestoneIssues(ctx context.Context, owner, repo string, number int) ([]*models.Issue, error) {
+	var issues []*models.Issue
+	opts := &github.IssueListByRepoOptions{
+		Milestone:   strconv.Itoa(number),
+		State:       "all",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	for {
+		ghIssues, resp, err := r.client.client.Issues.ListByRepo(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, handleGitHubError(err, resp)
+		}
+		issues = append(issues, convertToIssues(ghIssues)...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return issues, nil
+}
@@ -0,0 +1,40 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestIssueRepository_ListMilestoneIssues(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/owner/repo/issues" {
+			t.Errorf("unexpected request for %s", r.URL.Path)
+			return
+		}
+		q := r.URL.Query()
+		if q.Get("milestone") != "3" || q.Get("state") != "all" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		if q.Get("page") == "2" {
+			_, _ = w.Write([]byte(`[{"number":3,"state":"open","created_at":"2026-03-03T00:00:00Z"}]`))
+			return
+		}
+		w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/issues?page=2>; rel="next"`)
+		_, _ = w.Write([]byte(`[
+			{"number":1,"state":"closed","created_at":"2026-03-01T00:00:00Z","closed_at":"2026-03-02T00:00:00Z"},
+			{"number":2,"state":"open","pull_request":{"url":"https://example.com/pulls/2"}}
+		]`))
+	})
+
+	issues, err := NewIssueRepository(client).ListMilestoneIssues(context.Background(), "owner", "repo", 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(issues) != 2 || issues[0].Number != 1 || issues[1].Number != 3 {
+		t.Fatalf("expected issues 1 and 3 from both pages without the pull request, got %+v", issues)
+	}
+	if issues[0].ClosedAt == nil {
+		t.Error("expected the closed date of issue 1")
+	}
+}
@@ -0,0 +1,65 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/google/go-github/v57/github"
+)
+
+// ListConflictFiles retrieves the files a pull request and its base branch
+// both changed since the merge base: the PR's own changes come from comparing
+// the base with the head, the base's from comparing the merge base with it.
+func (r *PullRequestRepositoryImpl) ListConflictFiles(ctx context.Context, owner, repo string, number int) (*models.ConflictFiles, error) {
+	ghPR, resp, err := r.client.client.PullRequests.Get(ctx, owner, repo, number)
+	if err != nil {
+		return nil, handleGitHubError(err, resp)
+	}
+	baseRef := ghPR.GetBase().GetRef()
+
+	head, resp, err := r.client.client.Repositories.CompareCommits(ctx, owner, repo, baseRef, ghPR.GetHead().GetSHA(), nil)
+	if err != nil {
+		return nil, handleGitHubError(err, resp)
+	}
+	conflicts := &models.ConflictFiles{BaseRef: baseRef, BehindBy: head.GetBehindBy()}
+	if conflicts.BehindBy == 0 {
+		// The head has every base commit, so nothing on the base can conflict
+		return conflicts, nil
+	}
+
+	base, resp, err := r.client.client.Repositories.CompareCommits(ctx, owner, repo, head.GetMergeBaseCommit().GetSHA(), baseRef, nil)
+	if err != nil {
+		return nil, handleGitHubError(err, resp)
+	}
+	changedOnBase := make(map[string]bool)
+	for _, file := range base.Files {
+		changedOnBase[file.GetFilename()] = true
+		if previous := file.GetPreviousFilename(); previous != "" {
+			changedOnBase[previous] = true
+		}
+	}
+	for _, file := range head.Files {
+		if changedOnBase[file.GetFilename()] || (file.GetPreviousFilename() != "" && changedOnBase[file.GetPreviousFilename()]) {
+			conflicts.Files = append(conflicts.Files, file.GetFilename())
+		}
+	}
+	sort.Strings(conflicts.Files)
+	return conflicts, nil
+}
+
+// UpdateBranch asks GitHub to merge the base branch into the head branch of
+// a pull request. GitHub accepts the request and merges in the background.
+func (r *PullRequestRepositoryImpl) UpdateBranch(ctx context.Context, owner, repo string, number int, expectedHeadSHA string) error {
+	opts := &github.PullRequestBranchUpdateOptions{}
+	if expectedHeadSHA != "" {
+		opts.ExpectedHeadSHA = github.String(expectedHeadSHA)
+	}
+	_, resp, err := r.client.client.PullRequests.UpdateBranch(ctx, owner, repo, number, opts)
+	var accepted *github.AcceptedError
+	if err != nil && !errors.As(err, &accepted) {
+		return handleGitHubError(err, resp)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListConflictFiles(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/owner/repo/pulls/5":
+			_, _ = w.Write([]byte(`{"number":5,"base":{"ref":"main"},"head":{"ref":"feature","sha":"head1"}}`))
+		case "/repos/owner/repo/compare/main...head1":
+			_, _ = w.Write([]byte(`{"behind_by":2,"merge_base_commit":{"sha":"base0"},"files":[
+				{"filename":"go.mod"},{"filename":"README.md"},{"filename":"cmd/new.go","previous_filename":"cmd/old.go"}]}`))
+		case "/repos/owner/repo/compare/base0...main":
+			_, _ = w.Write([]byte(`{"files":[{"filename":"go.mod"},{"filename":"cmd/old.go"},{"filename":"docs/intro.md"}]}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	conflicts, err := NewPullRequestRepository(client).ListConflictFiles(context.Background(), "owner", "repo", 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if conflicts.BaseRef != "main" || conflicts.BehindBy != 2 {
+		t.Errorf("unexpected base: %+v", conflicts)
+	}
+	if want := []string{"cmd/new.go", "go.mod"}; !reflect.DeepEqual(conflicts.Files, want) {
+		t.Errorf("expected %v, got %v", want, conflicts.Files)
+	}
+}
+
+func TestUpdateBranch(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/repos/owner/repo/pulls/5/update-branch" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"message":"Updating pull request branch."}`))
+	})
+
+	if err := NewPullRequestRepository(client).UpdateBranch(context.Background(), "owner", "repo", 5, "head1"); err != nil {
+		t.Fatalf("expected the accepted update to succeed, got %v", err)
+	}
+}
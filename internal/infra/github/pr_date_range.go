@@ -0,0 +1,47 @@
+package github
+
+import (
+	"context"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// rangeScanPages caps the pages scanned for a date range, so a range far in
+// the past does not walk the whole history
+const rangeScanPages = 10
+
+// listInRange lists the PRs whose ActivityTime is in the range of opts. The
+// API cannot filter by date, so pages sorted by last update are scanned
+// until they are older than the range; a PR is merged, closed or opened
+// before its last update. All matches are returned as the first page.
+func (r *PullRequestRepositoryImpl) listInRange(ctx context.Context, owner, repo string, opts *models.PROptions) ([]*models.PullRequest, error) {
+	if opts.Page > 1 {
+		return nil, nil
+	}
+	scan := *opts
+	scan.Sort = models.PRSortUpdated
+	scan.Direction = models.SortDirectionDesc
+	dateRange := models.DateRange{Since: opts.Since, Until: opts.Until}
+
+	var matched []*models.PullRequest
+	for page := 1; page <= rangeScanPages; page++ {
+		scan.Page = page
+		ghPRs, resp, err := r.client.client.PullRequests.List(ctx, owner, repo, convertFromPROptions(&scan))
+		if err != nil {
+			return nil, handleGitHubError(err, resp)
+		}
+		prs := convertToPullRequests(ghPRs)
+		for _, pr := range prs {
+			if dateRange.Contains(pr.ActivityTime()) {
+				matched = append(matched, pr)
+			}
+		}
+		if resp.NextPage == 0 || len(prs) == 0 {
+			break
+		}
+		if opts.Since != nil && prs[len(prs)-1].UpdatedAt.Before(*opts.Since) {
+			break
+		}
+	}
+	return matched, nil
+}
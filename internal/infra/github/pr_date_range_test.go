@@ -0,0 +1,55 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func TestListPullRequestsInRange(t *testing.T) {
+	pages := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		if got := r.URL.Query().Get("sort"); got != "updated" {
+			t.Errorf("expected the scan to be sorted by update, got %q", got)
+		}
+		// Two pages; the scan stops after the second as it is older than the range
+		if r.URL.Query().Get("page") != "2" {
+			w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/pulls?page=2>; rel="next"`)
+			_, _ = w.Write([]byte(`[
+				{"number":4,"created_at":"2026-10-09T10:00:00Z","updated_at":"2026-10-09T10:00:00Z"},
+				{"number":3,"created_at":"2026-09-01T10:00:00Z","merged_at":"2026-10-02T10:00:00Z","updated_at":"2026-10-08T10:00:00Z"}
+			]`))
+			return
+		}
+		w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/pulls?page=3>; rel="next"`)
+		_, _ = w.Write([]byte(`[
+			{"number":2,"created_at":"2026-10-01T09:00:00Z","updated_at":"2026-10-01T09:00:00Z"},
+			{"number":1,"created_at":"2026-09-20T10:00:00Z","updated_at":"2026-09-21T10:00:00Z"}
+		]`))
+	})
+	repo := NewPullRequestRepository(client)
+
+	since := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+	opts := &models.PROptions{State: models.PRStateAll, Since: &since, Until: &until, PerPage: 2}
+	prs, err := repo.List(context.Background(), "owner", "repo", opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(prs) != 2 || prs[0].Number != 3 || prs[1].Number != 2 {
+		t.Fatalf("expected #3 (merged in range) and #2 (opened in range), got %+v", prs)
+	}
+	if pages != 2 {
+		t.Errorf("expected the scan to stop once older than the range, fetched %d pages", pages)
+	}
+
+	// Every match comes back as the first page
+	opts.Page = 2
+	if prs, err := repo.List(context.Background(), "owner", "repo", opts); err != nil || prs != nil {
+		t.Errorf("expected no further pages, got %+v, %v", prs, err)
+	}
+}
@@ -0,0 +1,81 @@
+package github
+
+import (
+	"context"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// linkedIssuesQuery fetches the issues a pull request closes. GitHub fills
+// closingIssuesReferences from closing keywords (when the base is the default
+// branch) and from issues linked in the sidebar, which the timeline records as
+// connected events.
+const linkedIssuesQuery = `query($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    pullRequest(number: $number) {
+      closingIssuesReferences(first: 50) {
+        nodes {
+          number
+          title
+          state
+          repository {
+            name
+            owner { login }
+          }
+        }
+      }
+    }
+  }
+}`
+
+// linkedIssuesResult is the response shape of linkedIssuesQuery
+type linkedIssuesResult struct {
+	Repository struct {
+		PullRequest struct {
+			ClosingIssuesReferences struct {
+				Nodes []graphQLLinkedIssue `json:"nodes"`
+			} `json:"closingIssuesReferences"`
+		} `json:"pullRequest"`
+	} `json:"repository"`
+}
+
+// graphQLLinkedIssue is an issue node of closingIssuesReferences
+type graphQLLinkedIssue struct {
+	Number     int    `json:"number"`
+	Title      string `json:"title"`
+	State      string `json:"state"`
+	Repository struct {
+		Name  string `json:"name"`
+		Owner struct {
+			Login string `json:"login"`
+		} `json:"owner"`
+	} `json:"repository"`
+}
+
+// ListLinkedIssues retrieves the issues a pull request closes when merged
+func (r *PullRequestRepositoryImpl) ListLinkedIssues(ctx context.Context, owner, repo string, number int) ([]*models.LinkedIssue, error) {
+	var result linkedIssuesResult
+	err := r.client.graphQL(ctx, linkedIssuesQuery, map[string]interface{}{
+		"owner":  owner,
+		"repo":   repo,
+		"number": number,
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := result.Repository.PullRequest.ClosingIssuesReferences.Nodes
+	issues := make([]*models.LinkedIssue, 0, len(nodes))
+	for _, node := range nodes {
+		issues = append(issues, &models.LinkedIssue{
+			Owner:  node.Repository.Owner.Login,
+			Repo:   node.Repository.Name,
+			Number: node.Number,
+			Title:  node.Title,
+			// GraphQL states are OPEN / CLOSED
+			State: models.IssueState(strings.ToLower(node.State)),
+		})
+	}
+	return issues, nil
+}
@@ -0,0 +1,41 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func TestListLinkedIssues(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(req.Query, "closingIssuesReferences") || req.Variables["number"] != float64(7) {
+			t.Errorf("unexpected request %v", req)
+		}
+		_, _ = w.Write([]byte(`{"data":{"repository":{"pullRequest":{"closingIssuesReferences":{"nodes":[
+			{"number":12,"title":"Crash on start","state":"OPEN","repository":{"name":"repo","owner":{"login":"owner"}}},
+			{"number":3,"title":"Upstream","state":"CLOSED","repository":{"name":"lib","owner":{"login":"other"}}}
+		]}}}}}`))
+	})
+
+	issues, err := NewPullRequestRepository(client).ListLinkedIssues(context.Background(), "owner", "repo", 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(issues) != 2 {
+		t.Fatalf("expected 2 issues, got %d", len(issues))
+	}
+	if *issues[0] != (models.LinkedIssue{Owner: "owner", Repo: "repo", Number: 12, Title: "Crash on start", State: models.IssueStateOpen}) {
+		t.Errorf("unexpected first issue %+v", issues[0])
+	}
+	if issues[1].Owner != "other" || issues[1].State != models.IssueStateClosed {
+		t.Errorf("unexpected second issue %+v", issues[1])
+	}
+}
@@ -0,0 +1,126 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// readinessBatchSize caps the pull requests asked about in one query, keeping
+// it well within the GraphQL node limit
+const readinessBatchSize = 50
+
+// mergeReadinessFields are the fields of a pull request behind its readiness
+const mergeReadinessFields = `
+fragment readiness on PullRequest {
+  number
+  isDraft
+  mergeable
+  reviewDecision
+  latestOpinionatedReviews(first: 50) {
+    nodes {
+      state
+    }
+  }
+  commits(last: 1) {
+    nodes {
+      commit {
+        statusCheckRollup {
+          state
+        }
+      }
+    }
+  }
+}`
+
+// mergeReadinessNode is the response shape of the readiness fragment
+type mergeReadinessNode struct {
+	Number                   int    `json:"number"`
+	IsDraft                  bool   `json:"isDraft"`
+	Mergeable                string `json:"mergeable"`
+	ReviewDecision           string `json:"reviewDecision"`
+	LatestOpinionatedReviews struct {
+		Nodes []struct {
+			State string `json:"state"`
+		} `json:"nodes"`
+	} `json:"latestOpinionatedReviews"`
+	Commits struct {
+		Nodes []struct {
+			Commit struct {
+				StatusCheckRollup *struct {
+					State string `json:"state"`
+				} `json:"statusCheckRollup"`
+			} `json:"commit"`
+		} `json:"nodes"`
+	} `json:"commits"`
+}
+
+// mergeReadinessQuery builds a query asking for the readiness of each number,
+// aliased pr<number>
+func mergeReadinessQuery(numbers []int) string {
+	var q strings.Builder
+	q.WriteString("query($owner: String!, $repo: String!) {\n  repository(owner: $owner, name: $repo) {\n")
+	for _, number := range numbers {
+		fmt.Fprintf(&q, "    pr%d: pullRequest(number: %d) { ...readiness }\n", number, number)
+	}
+	q.WriteString("  }\n}\n")
+	q.WriteString(mergeReadinessFields)
+	return q.String()
+}
+
+// ListMergeReadiness retrieves whether each of the pull requests can be merged
+// now, asking about a batch of them per GraphQL query
+func (r *PullRequestRepositoryImpl) ListMergeReadiness(ctx context.Context, owner, repo string, numbers []int) (map[int]*models.MergeReadiness, error) {
+	readiness := make(map[int]*models.MergeReadiness, len(numbers))
+	for start := 0; start < len(numbers); start += readinessBatchSize {
+		batch := numbers[start:min(start+readinessBatchSize, len(numbers))]
+		var result struct {
+			Repository map[string]*mergeReadinessNode `json:"repository"`
+		}
+		err := r.client.graphQL(ctx, mergeReadinessQuery(batch), map[string]interface{}{
+			"owner": owner,
+			"repo":  repo,
+		}, &result)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range result.Repository {
+			if node != nil {
+				readiness[node.Number] = convertToMergeReadiness(node)
+			}
+		}
+	}
+	return readiness, nil
+}
+
+// convertToMergeReadiness converts the GraphQL readiness of a pull request
+func convertToMergeReadiness(node *mergeReadinessNode) *models.MergeReadiness {
+	readiness := &models.MergeReadiness{
+		Draft:          node.IsDraft,
+		ReviewDecision: node.ReviewDecision,
+		Mergeable:      node.Mergeable,
+	}
+	for _, review := range node.LatestOpinionatedReviews.Nodes {
+		switch review.State {
+		case "APPROVED":
+			readiness.Approvals++
+		case "CHANGES_REQUESTED":
+			readiness.ChangesRequested = true
+		}
+	}
+	for _, commit := range node.Commits.Nodes {
+		if rollup := commit.Commit.StatusCheckRollup; rollup != nil {
+			switch rollup.State {
+			case "SUCCESS":
+				readiness.ChecksState = models.CheckStateSuccess
+			case "FAILURE", "ERROR":
+				readiness.ChecksState = models.CheckStateFailure
+			default:
+				readiness.ChecksState = models.CheckStatePending
+			}
+		}
+	}
+	return readiness
+}
@@ -0,0 +1,46 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func TestListMergeReadiness(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		if !strings.Contains(req.Query, "pr1: pullRequest(number: 1)") || !strings.Contains(req.Query, "pr2: pullRequest(number: 2)") {
+			t.Errorf("expected an aliased field per PR, got %s", req.Query)
+		}
+		_, _ = w.Write([]byte(`{"data":{"repository":{
+			"pr1":{"number":1,"isDraft":false,"mergeable":"MERGEABLE","reviewDecision":"APPROVED",
+				"latestOpinionatedReviews":{"nodes":[{"state":"APPROVED"}]},
+				"commits":{"nodes":[{"commit":{"statusCheckRollup":{"state":"SUCCESS"}}}]}},
+			"pr2":{"number":2,"isDraft":false,"mergeable":"CONFLICTING","reviewDecision":null,
+				"latestOpinionatedReviews":{"nodes":[{"state":"APPROVED"},{"state":"CHANGES_REQUESTED"}]},
+				"commits":{"nodes":[{"commit":{"statusCheckRollup":{"state":"ERROR"}}}]}}
+		}}}`))
+	})
+
+	readiness, err := NewPullRequestRepository(client).ListMergeReadiness(context.Background(), "owner", "repo", []int{1, 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := readiness[1]; got == nil || !got.Ready() || got.ChecksState != models.CheckStateSuccess {
+		t.Errorf("expected #1 to be ready, got %+v", got)
+	}
+	got := readiness[2]
+	if got == nil || got.Ready() {
+		t.Fatalf("expected #2 not to be ready, got %+v", got)
+	}
+	if got.Approvals != 1 || !got.ChangesRequested || got.ChecksState != models.CheckStateFailure {
+		t.Errorf("unexpected readiness of #2: %+v", got)
+	}
+}
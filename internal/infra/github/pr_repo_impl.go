@@ -3,6 +3,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/a1yama/tig-gh/internal/domain/models"
 	"github.com/a1yama/tig-gh/internal/domain/repository"
@@ -23,6 +24,9 @@ func NewPullRequestRepository(client *Client) repository.PullRequestRepository {
 
 // List retrieves a list of pull requests for a repository
 func (r *PullRequestRepositoryImpl) List(ctx context.Context, owner, repo string, opts *models.PROptions) ([]*models.PullRequest, error) {
+	if opts != nil && (opts.Since != nil || opts.Until != nil) {
+		return r.listInRange(ctx, owner, repo, opts)
+	}
 	ghOpts := convertFromPROptions(opts)
 
 	ghPRs, resp, err := r.client.client.PullRequests.List(ctx, owner, repo, ghOpts)
@@ -69,8 +73,26 @@ func (r *PullRequestRepositoryImpl) Update(ctx context.Context, owner, repo stri
 	if err != nil {
 		return nil, handleGitHubError(err, resp)
 	}
+	pr := convertToPullRequest(ghPR)
+
+	// Assignees, labels and milestones of pull requests are managed through the issues API
+	if input.Assignees != nil || input.Labels != nil || input.Milestone != nil {
+		issueReq := &github.IssueRequest{
+			Assignees: input.Assignees,
+			Labels:    input.Labels,
+			Milestone: input.Milestone,
+		}
+		ghIssue, resp, err := r.client.client.Issues.Edit(ctx, owner, repo, number, issueReq)
+		if err != nil {
+			return nil, handleGitHubError(err, resp)
+		}
+		issue := convertToIssue(ghIssue)
+		pr.Assignees = issue.Assignees
+		pr.Labels = issue.Labels
+		pr.Milestone = issue.Milestone
+	}
 
-	return convertToPullRequest(ghPR), nil
+	return pr, nil
 }
 
 // Merge merges a pull request
@@ -161,6 +183,84 @@ func (r *PullRequestRepositoryImpl) ListReviews(ctx context.Context, owner, repo
 	return convertToReviews(ghReviews), nil
 }
 
+// ListFiles retrieves the files changed by a pull request
+func (r *PullRequestRepositoryImpl) ListFiles(ctx context.Context, owner, repo string, number int) ([]*models.DiffFile, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	var files []*models.DiffFile
+
+	for {
+		ghFiles, resp, err := r.client.client.PullRequests.ListFiles(ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, handleGitHubError(err, resp)
+		}
+
+		for _, ghFile := range ghFiles {
+			files = append(files, convertToDiffFile(ghFile))
+		}
+
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return files, nil
+}
+
+// ListFilesPage retrieves one page of the files changed by a pull request.
+// Each file carries its own patch, so large pull requests are not cut off
+// like their combined diff.
+func (r *PullRequestRepositoryImpl) ListFilesPage(ctx context.Context, owner, repo string, number, page, perPage int) ([]*models.DiffFile, error) {
+	opts := &github.ListOptions{Page: page, PerPage: perPage}
+	ghFiles, resp, err := r.client.client.PullRequests.ListFiles(ctx, owner, repo, number, opts)
+	if err != nil {
+		return nil, handleGitHubError(err, resp)
+	}
+
+	files := make([]*models.DiffFile, 0, len(ghFiles))
+	for _, ghFile := range ghFiles {
+		files = append(files, convertToDiffFile(ghFile))
+	}
+
+	return files, nil
+}
+
+// ListForCommit retrieves the pull requests associated with a commit
+func (r *PullRequestRepositoryImpl) ListForCommit(ctx context.Context, owner, repo, sha string) ([]*models.PullRequest, error) {
+	ghPRs, resp, err := r.client.client.PullRequests.ListPullRequestsWithCommit(ctx, owner, repo, sha, &github.ListOptions{PerPage: 100})
+	if err != nil {
+		return nil, handleGitHubError(err, resp)
+	}
+
+	return convertToPullRequests(ghPRs), nil
+}
+
+// SetLabels replaces the labels of a pull request
+func (r *PullRequestRepositoryImpl) SetLabels(ctx context.Context, owner, repo string, number int, labels []string) ([]models.Label, error) {
+	// Pull request labels are managed through the issues API
+	ghLabels, resp, err := r.client.client.Issues.ReplaceLabelsForIssue(ctx, owner, repo, number, labels)
+	if err != nil {
+		return nil, handleGitHubError(err, resp)
+	}
+
+	result := make([]models.Label, 0, len(ghLabels))
+	for _, ghLabel := range ghLabels {
+		result = append(result, convertToLabel(ghLabel))
+	}
+
+	return result, nil
+}
+
+// CreateReview submits a review on a pull request
+func (r *PullRequestRepositoryImpl) CreateReview(ctx context.Context, owner, repo string, number int, input *models.CreateReviewInput) (*models.Review, error) {
+	ghReview, resp, err := r.client.client.PullRequests.CreateReview(ctx, owner, repo, number, convertFromCreateReviewInput(input))
+	if err != nil {
+		return nil, handleGitHubError(err, resp)
+	}
+
+	return convertToReview(ghReview), nil
+}
+
 // ListComments retrieves comments for a pull request
 func (r *PullRequestRepositoryImpl) ListComments(ctx context.Context, owner, repo string, number int, opts *models.CommentOptions) ([]*models.Comment, error) {
 	// デフォルトオプション
@@ -200,3 +300,457 @@ func (r *PullRequestRepositoryImpl) ListComments(ctx context.Context, owner, rep
 
 	return result, nil
 }
+
+// reviewCommentFragment selects the fields of a review comment
+const reviewCommentFragment = `fragment reviewComment on PullRequestReviewComment {
+  databaseId
+  state
+  body
+  url
+  createdAt
+  updatedAt
+  author { login }
+}`
+
+// reviewThreadsQuery fetches a page of review threads with their resolution
+// state, which is only available through the GraphQL API
+const reviewThreadsQuery = `query($owner: String!, $repo: String!, $number: Int!, $after: String) {
+  repository(owner: $owner, name: $repo) {
+    pullRequest(number: $number) {
+      reviewThreads(first: 100, after: $after) {
+        pageInfo { hasNextPage endCursor }
+        nodes {
+          id
+          isResolved
+          isOutdated
+          path
+          line
+          originalLine
+          startLine
+          originalStartLine
+          diffSide
+          comments(first: 50) {
+            pageInfo { hasNextPage endCursor }
+            nodes { ...reviewComment }
+          }
+        }
+      }
+    }
+  }
+}
+` + reviewCommentFragment
+
+// threadCommentsQuery fetches the comments of a long thread past the first page
+const threadCommentsQuery = `query($id: ID!, $after: String) {
+  node(id: $id) {
+    ... on PullRequestReviewThread {
+      comments(first: 100, after: $after) {
+        pageInfo { hasNextPage endCursor }
+        nodes { ...reviewComment }
+      }
+    }
+  }
+}
+` + reviewCommentFragment
+
+// graphQLPageInfo tells whether a connection has more pages
+type graphQLPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// next returns the cursor of the next page, if there is one
+func (p graphQLPageInfo) next() (*string, bool) {
+	if !p.HasNextPage || p.EndCursor == "" {
+		return nil, false
+	}
+	cursor := p.EndCursor
+	return &cursor, true
+}
+
+// reviewThreadsResult is the response shape of reviewThreadsQuery
+type reviewThreadsResult struct {
+	Repository struct {
+		PullRequest struct {
+			ReviewThreads struct {
+				PageInfo graphQLPageInfo       `json:"pageInfo"`
+				Nodes    []graphQLReviewThread `json:"nodes"`
+			} `json:"reviewThreads"`
+		} `json:"pullRequest"`
+	} `json:"repository"`
+}
+
+// threadCommentsResult is the response shape of threadCommentsQuery
+type threadCommentsResult struct {
+	Node struct {
+		Comments graphQLReviewComments `json:"comments"`
+	} `json:"node"`
+}
+
+// graphQLReviewThread is a review thread node
+type graphQLReviewThread struct {
+	ID                string                `json:"id"`
+	IsResolved        bool                  `json:"isResolved"`
+	IsOutdated        bool                  `json:"isOutdated"`
+	Path              string                `json:"path"`
+	Line              *int                  `json:"line"`
+	OriginalLine      *int                  `json:"originalLine"`
+	StartLine         *int                  `json:"startLine"`
+	OriginalStartLine *int                  `json:"originalStartLine"`
+	DiffSide          string                `json:"diffSide"`
+	Comments          graphQLReviewComments `json:"comments"`
+}
+
+// graphQLReviewComments is a page of the comments of a review thread
+type graphQLReviewComments struct {
+	PageInfo graphQLPageInfo        `json:"pageInfo"`
+	Nodes    []graphQLReviewComment `json:"nodes"`
+}
+
+// graphQLReviewComment is a review comment node
+type graphQLReviewComment struct {
+	DatabaseID int64     `json:"databaseId"`
+	State      string    `json:"state"`
+	Body       string    `json:"body"`
+	URL        string    `json:"url"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+	Author     *struct {
+		Login string `json:"login"`
+	} `json:"author"`
+}
+
+// ListReviewThreads retrieves review comment threads for a pull request,
+// following the pages of threads and of the comments of long threads
+func (r *PullRequestRepositoryImpl) ListReviewThreads(ctx context.Context, owner, repo string, number int) ([]*models.ReviewThread, error) {
+	var nodes []graphQLReviewThread
+	var after *string
+	for {
+		var result reviewThreadsResult
+		err := r.client.graphQL(ctx, reviewThreadsQuery, map[string]interface{}{
+			"owner":  owner,
+			"repo":   repo,
+			"number": number,
+			"after":  after,
+		}, &result)
+		if err != nil {
+			return nil, err
+		}
+		page := result.Repository.PullRequest.ReviewThreads
+		nodes = append(nodes, page.Nodes...)
+
+		var more bool
+		if after, more = page.PageInfo.next(); !more {
+			break
+		}
+	}
+
+	for i := range nodes {
+		if err := r.listRemainingComments(ctx, &nodes[i]); err != nil {
+			return nil, err
+		}
+	}
+	return convertToReviewThreads(nodes), nil
+}
+
+// listRemainingComments fetches the comments of a thread past its first page
+func (r *PullRequestRepositoryImpl) listRemainingComments(ctx context.Context, thread *graphQLReviewThread) error {
+	after, more := thread.Comments.PageInfo.next()
+	for more {
+		var result threadCommentsResult
+		err := r.client.graphQL(ctx, threadCommentsQuery, map[string]interface{}{
+			"id":    thread.ID,
+			"after": after,
+		}, &result)
+		if err != nil {
+			return err
+		}
+		thread.Comments.Nodes = append(thread.Comments.Nodes, result.Node.Comments.Nodes...)
+		after, more = result.Node.Comments.PageInfo.next()
+	}
+	return nil
+}
+
+// convertToReviewThreads converts GraphQL review threads to domain review threads
+func convertToReviewThreads(nodes []graphQLReviewThread) []*models.ReviewThread {
+	threads := make([]*models.ReviewThread, 0, len(nodes))
+	for _, node := range nodes {
+		thread := &models.ReviewThread{
+			ID:         node.ID,
+			Path:       node.Path,
+			DiffSide:   node.DiffSide,
+			IsResolved: node.IsResolved,
+			IsOutdated: node.IsOutdated,
+		}
+
+		// Outdated threads no longer have a line in the current diff
+		if node.Line != nil {
+			thread.Line = *node.Line
+			if node.StartLine != nil {
+				thread.StartLine = *node.StartLine
+			}
+		} else if node.OriginalLine != nil {
+			thread.Line = *node.OriginalLine
+			if node.OriginalStartLine != nil {
+				thread.StartLine = *node.OriginalStartLine
+			}
+		}
+
+		for _, c := range node.Comments.Nodes {
+			comment := &models.Comment{
+				ID:        c.DatabaseID,
+				Body:      c.Body,
+				HTMLURL:   c.URL,
+				CreatedAt: c.CreatedAt,
+				UpdatedAt: c.UpdatedAt,
+				Pending:   c.State == "PENDING",
+			}
+			if c.Author != nil {
+				comment.User = models.User{Login: c.Author.Login}
+			}
+			thread.Comments = append(thread.Comments, comment)
+		}
+
+		threads = append(threads, thread)
+	}
+
+	return threads
+}
+
+// mergeRequirementsQuery fetches the base branch protection rule together with
+// the review decision and the checks on the head commit. isRequired is resolved
+// by GitHub, so required checks are known even without admin access.
+const mergeRequirementsQuery = `query($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    pullRequest(number: $number) {
+      reviewDecision
+      baseRef {
+        branchProtectionRule {
+          requiresApprovingReviews
+          requiredApprovingReviewCount
+          requiresCodeOwnerReviews
+          requiredStatusCheckContexts
+        }
+      }
+      commits(last: 1) {
+        nodes {
+          commit {
+            statusCheckRollup {
+              contexts(first: 100) {
+                nodes {
+                  __typename
+                  ... on CheckRun {
+                    name
+                    status
+                    conclusion
+                    isRequired(pullRequestNumber: $number)
+                  }
+                  ... on StatusContext {
+                    context
+                    state
+                    isRequired(pullRequestNumber: $number)
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+// mergeRequirementsResult is the response shape of mergeRequirementsQuery
+type mergeRequirementsResult struct {
+	Repository struct {
+		PullRequest struct {
+			ReviewDecision string `json:"reviewDecision"`
+			BaseRef        *struct {
+				BranchProtectionRule *graphQLBranchProtectionRule `json:"branchProtectionRule"`
+			} `json:"baseRef"`
+			Commits struct {
+				Nodes []struct {
+					Commit struct {
+						StatusCheckRollup *struct {
+							Contexts struct {
+								Nodes []graphQLCheckContext `json:"nodes"`
+							} `json:"contexts"`
+						} `json:"statusCheckRollup"`
+					} `json:"commit"`
+				} `json:"nodes"`
+			} `json:"commits"`
+		} `json:"pullRequest"`
+	} `json:"repository"`
+}
+
+// graphQLBranchProtectionRule is a branch protection rule node
+type graphQLBranchProtectionRule struct {
+	RequiresApprovingReviews     bool     `json:"requiresApprovingReviews"`
+	RequiredApprovingReviewCount int      `json:"requiredApprovingReviewCount"`
+	RequiresCodeOwnerReviews     bool     `json:"requiresCodeOwnerReviews"`
+	RequiredStatusCheckContexts  []string `json:"requiredStatusCheckContexts"`
+}
+
+// graphQLCheckContext is either a CheckRun or a StatusContext node
+type graphQLCheckContext struct {
+	Typename   string `json:"__typename"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion"`
+	Context    string `json:"context"`
+	State      string `json:"state"`
+	IsRequired bool   `json:"isRequired"`
+}
+
+// GetMergeRequirements retrieves the base branch protection rules and the state of required reviews and checks
+func (r *PullRequestRepositoryImpl) GetMergeRequirements(ctx context.Context, owner, repo string, number int) (*models.MergeRequirements, error) {
+	var result mergeRequirementsResult
+	err := r.client.graphQL(ctx, mergeRequirementsQuery, map[string]interface{}{
+		"owner":  owner,
+		"repo":   repo,
+		"number": number,
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertToMergeRequirements(&result), nil
+}
+
+// convertToMergeRequirements converts the GraphQL merge requirements to domain merge requirements
+func convertToMergeRequirements(result *mergeRequirementsResult) *models.MergeRequirements {
+	pr := result.Repository.PullRequest
+	requirements := &models.MergeRequirements{
+		ReviewDecision: pr.ReviewDecision,
+	}
+
+	var requiredContexts []string
+	if pr.BaseRef != nil && pr.BaseRef.BranchProtectionRule != nil {
+		rule := pr.BaseRef.BranchProtectionRule
+		requirements.Protected = true
+		if rule.RequiresApprovingReviews {
+			requirements.RequiredApprovals = rule.RequiredApprovingReviewCount
+		}
+		requirements.RequireCodeOwnerReviews = rule.RequiresCodeOwnerReviews
+		requiredContexts = rule.RequiredStatusCheckContexts
+	}
+
+	seen := make(map[string]bool)
+	for _, commit := range pr.Commits.Nodes {
+		if commit.Commit.StatusCheckRollup == nil {
+			continue
+		}
+		for _, node := range commit.Commit.StatusCheckRollup.Contexts.Nodes {
+			check := convertToCheckStatus(node)
+			seen[check.Name] = true
+			requirements.Checks = append(requirements.Checks, check)
+		}
+	}
+
+	// Required checks that have not reported yet are still expected
+	for _, name := range requiredContexts {
+		if !seen[name] {
+			requirements.Checks = append(requirements.Checks, models.CheckStatus{
+				Name:     name,
+				State:    models.CheckStatePending,
+				Required: true,
+			})
+		}
+	}
+
+	return requirements
+}
+
+// convertToCheckStatus converts a GraphQL check run or status context to a domain check status
+func convertToCheckStatus(node graphQLCheckContext) models.CheckStatus {
+	if node.Typename == "StatusContext" {
+		check := models.CheckStatus{Name: node.Context, Required: node.IsRequired}
+		switch node.State {
+		case "SUCCESS":
+			check.State = models.CheckStateSuccess
+		case "ERROR", "FAILURE":
+			check.State = models.CheckStateFailure
+		default:
+			check.State = models.CheckStatePending
+		}
+		return check
+	}
+
+	check := models.CheckStatus{Name: node.Name, Required: node.IsRequired}
+	if node.Status != "COMPLETED" {
+		check.State = models.CheckStatePending
+		return check
+	}
+	switch node.Conclusion {
+	case "SUCCESS", "NEUTRAL", "SKIPPED":
+		check.State = models.CheckStateSuccess
+	default:
+		check.State = models.CheckStateFailure
+	}
+	return check
+}
+
+// markReadyForReviewMutation marks a draft pull request as ready for review
+const markReadyForReviewMutation = `mutation($id: ID!) {
+  markPullRequestReadyForReview(input: {pullRequestId: $id}) {
+    pullRequest { isDraft }
+  }
+}`
+
+// convertToDraftMutation converts a pull request back to a draft
+const convertToDraftMutation = `mutation($id: ID!) {
+  convertPullRequestToDraft(input: {pullRequestId: $id}) {
+    pullRequest { isDraft }
+  }
+}`
+
+// draftMutationResult is the response shape of the draft mutations
+type draftMutationResult struct {
+	MarkReady *draftMutationPayload `json:"markPullRequestReadyForReview"`
+	ToDraft   *draftMutationPayload `json:"convertPullRequestToDraft"`
+}
+
+// draftMutationPayload holds the pull request returned by a draft mutation
+type draftMutationPayload struct {
+	PullRequest struct {
+		IsDraft bool `json:"isDraft"`
+	} `json:"pullRequest"`
+}
+
+// ConvertDraft converts a pull request to a draft or marks it ready for review.
+// The REST API cannot change the draft state, so this goes through GraphQL.
+func (r *PullRequestRepositoryImpl) ConvertDraft(ctx context.Context, owner, repo string, number int, draft bool) (*models.PullRequest, error) {
+	ghPR, resp, err := r.client.client.PullRequests.Get(ctx, owner, repo, number)
+	if err != nil {
+		return nil, handleGitHubError(err, resp)
+	}
+
+	pr := convertToPullRequest(ghPR)
+	if pr.Draft == draft {
+		return pr, nil
+	}
+
+	mutation := markReadyForReviewMutation
+	if draft {
+		mutation = convertToDraftMutation
+	}
+
+	var result draftMutationResult
+	err = r.client.graphQL(ctx, mutation, map[string]interface{}{
+		"id": ghPR.GetNodeID(),
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := result.MarkReady
+	if draft {
+		payload = result.ToDraft
+	}
+	if payload == nil {
+		return nil, fmt.Errorf("github graphql error: empty draft mutation result")
+	}
+	pr.Draft = payload.PullRequest.IsDraft
+
+	return pr, nil
+}
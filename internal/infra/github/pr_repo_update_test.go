@@ -0,0 +1,106 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func TestPullRequestRepository_UpdateIssueFields(t *testing.T) {
+	var issueEdit map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/owner/repo/pulls/7":
+			_, _ = w.Write([]byte(`{"number":7,"title":"Faster startup","state":"open"}`))
+		case "/repos/owner/repo/issues/7":
+			if err := json.NewDecoder(r.Body).Decode(&issueEdit); err != nil {
+				t.Fatal(err)
+			}
+			_, _ = w.Write([]byte(`{"number":7,"labels":[{"name":"bug"}],"assignees":[{"login":"octocat"}],"milestone":{"number":3,"title":"v2"}}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	labels := []string{"bug"}
+	assignees := []string{"octocat"}
+	milestone := 3
+	pr, err := NewPullRequestRepository(client).Update(context.Background(), "owner", "repo", 7, &models.UpdatePRInput{
+		Labels:    &labels,
+		Assignees: &assignees,
+		Milestone: &milestone,
+	})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	if issueEdit["milestone"] != float64(3) {
+		t.Errorf("issue edit = %v, want milestone 3", issueEdit)
+	}
+	if pr.Title != "Faster startup" || len(pr.Labels) != 1 || pr.Labels[0].Name != "bug" ||
+		len(pr.Assignees) != 1 || pr.Assignees[0].Login != "octocat" || pr.Milestone == nil || pr.Milestone.Number != 3 {
+		t.Errorf("Update() = %+v, want the issue fields applied", pr)
+	}
+}
+
+func TestPullRequestRepository_UpdateWithoutIssueFields(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/owner/repo/pulls/7" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"number":7,"state":"closed"}`))
+	})
+
+	state := models.PRStateClosed
+	pr, err := NewPullRequestRepository(client).Update(context.Background(), "owner", "repo", 7, &models.UpdatePRInput{State: &state})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if pr.State != models.PRStateClosed {
+		t.Errorf("State = %v, want closed", pr.State)
+	}
+}
+
+func TestPullRequestRepository_ListForCommit(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/owner/repo/commits/abc123/pulls" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`[{"number":12,"title":"Add cache","state":"closed","merged_at":"2024-01-02T00:00:00Z"}]`))
+	})
+
+	prs, err := NewPullRequestRepository(client).ListForCommit(context.Background(), "owner", "repo", "abc123")
+	if err != nil {
+		t.Fatalf("ListForCommit() error = %v", err)
+	}
+	if len(prs) != 1 || prs[0].Number != 12 || prs[0].MergedAt == nil {
+		t.Errorf("ListForCommit() = %+v, want merged #12", prs)
+	}
+}
+
+func TestPullRequestRepository_ListFilesPage(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/owner/repo/pulls/7/files" || r.URL.Query().Get("page") != "2" || r.URL.Query().Get("per_page") != "100" {
+			t.Errorf("unexpected request %s", r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`[{"filename":"new.go","previous_filename":"old.go","status":"renamed","changes":2,"patch":"@@ -1 +1 @@\n-a\n+b"}]`))
+	})
+
+	files, err := NewPullRequestRepository(client).ListFilesPage(context.Background(), "owner", "repo", 7, 2, 100)
+	if err != nil {
+		t.Fatalf("ListFilesPage() error = %v", err)
+	}
+	if len(files) != 1 || files[0].PreviousFilename != "old.go" || files[0].Patch == "" {
+		t.Errorf("ListFilesPage() = %+v, want the renamed file with its patch", files)
+	}
+}
@@ -0,0 +1,17 @@
+package github
+
+import (
+	"context"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// ListPendingReviewRequests retrieves the review requests of a pull request
+// still waiting, with when each was made, from the pull request's timeline
+func (r *PullRequestRepositoryImpl) ListPendingReviewRequests(ctx context.Context, owner, repo string, number int) ([]*models.PendingReviewRequest, error) {
+	events, err := listTimeline(ctx, r.client, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+	return models.PendingReviewRequests(events), nil
+}
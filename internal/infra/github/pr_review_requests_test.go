@@ -0,0 +1,56 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestListPendingReviewRequests(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/owner/repo/issues/7/timeline" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`[
+			{"id":1,"event":"review_requested","actor":{"login":"alice"},"created_at":"2024-05-01T10:00:00Z","requested_reviewer":{"login":"bob"}},
+			{"id":2,"event":"review_requested","actor":{"login":"alice"},"created_at":"2024-05-01T11:00:00Z","requested_team":{"slug":"core","organization":{"login":"acme"}}},
+			{"id":3,"event":"review_requested","actor":{"login":"alice"},"created_at":"2024-05-01T12:00:00Z","requested_reviewer":{"login":"carol"}},
+			{"id":4,"event":"review_requested","actor":{"login":"alice"},"created_at":"2024-05-01T12:00:00Z","requested_reviewer":{"login":"dave"}},
+			{"event":"reviewed","user":{"login":"Carol"},"state":"APPROVED","submitted_at":"2024-05-02T08:00:00Z"},
+			{"id":5,"event":"review_request_removed","actor":{"login":"alice"},"created_at":"2024-05-02T09:00:00Z","requested_reviewer":{"login":"dave"}},
+			{"id":6,"event":"review_requested","actor":{"login":"alice"},"created_at":"2024-05-03T09:00:00Z","requested_reviewer":{"login":"bob"}},
+			{"id":7,"event":"review_requested","actor":{"login":"alice"},"created_at":"2024-05-03T10:00:00Z","requested_reviewer":{"login":"carol"}}
+		]`))
+	})
+
+	requests, err := NewPullRequestRepository(client).ListPendingReviewRequests(context.Background(), "owner", "repo", 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(requests) != 3 {
+		t.Fatalf("expected 3 waiting requests, got %d", len(requests))
+	}
+
+	at := func(value string) time.Time {
+		parsed, _ := time.Parse(time.RFC3339, value)
+		return parsed
+	}
+	// Asking bob again while waiting keeps the first request; carol's review
+	// ended the first request to carol, so the second one starts over
+	want := []struct {
+		reviewer string
+		at       time.Time
+		team     bool
+	}{
+		{"bob", at("2024-05-01T10:00:00Z"), false},
+		{"acme/core", at("2024-05-01T11:00:00Z"), true},
+		{"carol", at("2024-05-03T10:00:00Z"), false},
+	}
+	for i, w := range want {
+		got := requests[i]
+		if got.Reviewer != w.reviewer || !got.RequestedAt.Equal(w.at) || got.Team() != w.team {
+			t.Errorf("request %d = %+v, want %s at %v", i, got, w.reviewer, w.at)
+		}
+	}
+}
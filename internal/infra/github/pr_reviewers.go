@@ -0,0 +1,162 @@
+package github
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/google/go-github/v57/github"
+)
+
+// codeOwnersPaths are where GitHub looks for CODEOWNERS, in its order
+var codeOwnersPaths = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// ListReviewerCandidates retrieves the users and teams reviews can be requested from
+func (r *PullRequestRepositoryImpl) ListReviewerCandidates(ctx context.Context, owner, repo string) (*models.ReviewerCandidates, error) {
+	candidates := &models.ReviewerCandidates{}
+
+	// Assignees are the users with write access, who are the ones able to review
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		users, resp, err := r.client.client.Issues.ListAssignees(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, handleGitHubError(err, resp)
+		}
+		for _, user := range users {
+			candidates.Users = append(candidates.Users, convertToUser(user))
+		}
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	// Repositories of a user have no teams, and listing teams of an
+	// organization's repository can need more access than reviewing does
+	opts = &github.ListOptions{PerPage: 100}
+	for {
+		teams, resp, err := r.client.client.Repositories.ListTeams(ctx, owner, repo, opts)
+		if err != nil {
+			if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden) && !isRateLimited(err, resp) {
+				break
+			}
+			return nil, handleGitHubError(err, resp)
+		}
+		for _, team := range teams {
+			candidates.Teams = append(candidates.Teams, convertToTeam(team, owner))
+		}
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return candidates, nil
+}
+
+// GetCodeOwners retrieves the CODEOWNERS rules at a ref, or none when the
+// repository has no CODEOWNERS file
+func (r *PullRequestRepositoryImpl) GetCodeOwners(ctx context.Context, owner, repo, ref string) (models.CodeOwners, error) {
+	for _, path := range codeOwnersPaths {
+		file, _, resp, err := r.client.client.Repositories.GetContents(ctx, owner, repo, path, &github.RepositoryContentGetOptions{Ref: ref})
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return nil, handleGitHubError(err, resp)
+		}
+		if file == nil {
+			continue
+		}
+		content, err := file.GetContent()
+		if err != nil {
+			return nil, err
+		}
+		return models.ParseCodeOwners(content), nil
+	}
+	return nil, nil
+}
+
+// RequestReviewers requests reviews of a pull request from users and teams
+func (r *PullRequestRepositoryImpl) RequestReviewers(ctx context.Context, owner, repo string, number int, request *models.ReviewRequest) (*models.PullRequest, error) {
+	ghPR, resp, err := r.client.client.PullRequests.RequestReviewers(ctx, owner, repo, number, github.ReviewersRequest{
+		Reviewers:     request.Users,
+		TeamReviewers: request.Teams,
+	})
+	if err != nil {
+		return nil, handleGitHubError(err, resp)
+	}
+
+	return convertToPullRequest(ghPR), nil
+}
+
+// approvalsQuery fetches the latest approving or rejecting review of each
+// reviewer with write access, with the teams it was submitted on behalf of.
+// Only GraphQL tells which team a review counts for.
+const approvalsQuery = `query($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    pullRequest(number: $number) {
+      latestOpinionatedReviews(first: 100, writersOnly: true) {
+        nodes {
+          state
+          author { login }
+          onBehalfOf(first: 20) {
+            nodes {
+              slug
+              organization { login }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+// approvalsResult is the response shape of approvalsQuery
+type approvalsResult struct {
+	Repository struct {
+		PullRequest struct {
+			LatestOpinionatedReviews struct {
+				Nodes []struct {
+					State  string `json:"state"`
+					Author struct {
+						Login string `json:"login"`
+					} `json:"author"`
+					OnBehalfOf struct {
+						Nodes []struct {
+							Slug         string `json:"slug"`
+							Organization struct {
+								Login string `json:"login"`
+							} `json:"organization"`
+						} `json:"nodes"`
+					} `json:"onBehalfOf"`
+				} `json:"nodes"`
+			} `json:"latestOpinionatedReviews"`
+		} `json:"pullRequest"`
+	} `json:"repository"`
+}
+
+// ListApprovals retrieves the users and teams currently approving a pull request
+func (r *PullRequestRepositoryImpl) ListApprovals(ctx context.Context, owner, repo string, number int) (*models.Approvals, error) {
+	var result approvalsResult
+	err := r.client.graphQL(ctx, approvalsQuery, map[string]interface{}{
+		"owner":  owner,
+		"repo":   repo,
+		"number": number,
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	approvals := &models.Approvals{}
+	for _, review := range result.Repository.PullRequest.LatestOpinionatedReviews.Nodes {
+		if review.State != "APPROVED" {
+			continue
+		}
+		approvals.Users = append(approvals.Users, review.Author.Login)
+		for _, team := range review.OnBehalfOf.Nodes {
+			approvals.Teams = append(approvals.Teams, models.Team{Org: team.Organization.Login, Slug: team.Slug}.Key())
+		}
+	}
+	return approvals, nil
+}
@@ -0,0 +1,148 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestPullRequestRepository_ListReviewerCandidates(t *testing.T) {
+	teams := `[{"slug":"backend","name":"Backend"},{"slug":"docs","name":"Docs","organization":{"login":"octo"}}]`
+	for _, tt := range []struct {
+		name      string
+		teams     int
+		wantTeams string
+	}{
+		{name: "organization", teams: http.StatusOK, wantTeams: "octo/backend Backend,octo/docs Docs"},
+		{name: "user repository", teams: http.StatusNotFound, wantTeams: ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/repos/octo/repo/assignees":
+					_, _ = w.Write([]byte(`[{"login":"alice"},{"login":"bob"}]`))
+				case "/repos/octo/repo/teams":
+					w.WriteHeader(tt.teams)
+					if tt.teams == http.StatusOK {
+						_, _ = w.Write([]byte(teams))
+					} else {
+						_, _ = w.Write([]byte(`{"message":"Not Found"}`))
+					}
+				default:
+					t.Errorf("unexpected request for %s", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			candidates, err := NewPullRequestRepository(client).ListReviewerCandidates(context.Background(), "octo", "repo")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(candidates.Users) != 2 || candidates.Users[1].Login != "bob" {
+				t.Errorf("users = %+v", candidates.Users)
+			}
+			var got []string
+			for _, team := range candidates.Teams {
+				got = append(got, team.Key()+" "+team.Name)
+			}
+			if strings.Join(got, ",") != tt.wantTeams {
+				t.Errorf("teams = %v, want %s", got, tt.wantTeams)
+			}
+		})
+	}
+}
+
+func TestPullRequestRepository_GetCodeOwners(t *testing.T) {
+	codeowners := strings.Join([]string{
+		"# Default owners",
+		"*            @octo/core",
+		"*.md         @octo/docs docs@example.com",
+		"/api/        @alice",
+		"internal/ui  @bob  # the TUI",
+		"**/testdata/",
+	}, "\n")
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("ref") != "main" {
+			t.Errorf("expected the base branch, got ref %q", r.URL.Query().Get("ref"))
+		}
+		// Only the second location GitHub looks at has the file
+		if r.URL.Path != "/repos/octo/repo/contents/CODEOWNERS" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Not Found"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"type":     "file",
+			"path":     "CODEOWNERS",
+			"encoding": "base64",
+			"content":  base64.StdEncoding.EncodeToString([]byte(codeowners)),
+		})
+	})
+
+	owners, err := NewPullRequestRepository(client).GetCodeOwners(context.Background(), "octo", "repo", "main")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := map[string]string{
+		"main.go":                  "@octo/core",
+		"README.md":                "@octo/docs docs@example.com",
+		"api/handler.go":           "@alice",
+		"pkg/api/handler.go":       "@octo/core",
+		"internal/ui/app.go":       "@bob",
+		"internal/ui/testdata/x":   "",
+		"internal/uikit/widget.go": "@octo/core",
+	}
+	for file, want := range tests {
+		if got := strings.Join(owners.Owners(file), " "); got != want {
+			t.Errorf("Owners(%q) = %q, want %q", file, got, want)
+		}
+	}
+
+	suggested := owners.Suggest([]string{"api/handler.go", "README.md", "api/routes.go"})
+	if strings.Join(suggested, ",") != "alice,octo/docs" {
+		t.Errorf("Suggest() = %v, want the owners once each without e-mail addresses", suggested)
+	}
+}
+
+func TestPullRequestRepository_GetCodeOwnersNone(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"Not Found"}`))
+	})
+
+	owners, err := NewPullRequestRepository(client).GetCodeOwners(context.Background(), "octo", "repo", "main")
+	if err != nil || owners != nil {
+		t.Errorf("GetCodeOwners() = %v, %v; want no rules", owners, err)
+	}
+}
+
+func TestPullRequestRepository_ListApprovals(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(req.Query, "latestOpinionatedReviews") || req.Variables["number"] != float64(9) {
+			t.Errorf("unexpected request %v", req)
+		}
+		_, _ = w.Write([]byte(`{"data":{"repository":{"pullRequest":{"latestOpinionatedReviews":{"nodes":[
+			{"state":"APPROVED","author":{"login":"alice"},"onBehalfOf":{"nodes":[{"slug":"backend","organization":{"login":"octo"}}]}},
+			{"state":"CHANGES_REQUESTED","author":{"login":"bob"},"onBehalfOf":{"nodes":[{"slug":"docs","organization":{"login":"octo"}}]}}
+		]}}}}}`))
+	})
+
+	approvals, err := NewPullRequestRepository(client).ListApprovals(context.Background(), "octo", "repo", 9)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(approvals.Users, ",") != "alice" || strings.Join(approvals.Teams, ",") != "octo/backend" {
+		t.Errorf("approvals = %+v", approvals)
+	}
+	if !approvals.Approved("OCTO/Backend") || approvals.Approved("octo/docs") {
+		t.Errorf("expected only octo/backend approved on behalf of a team")
+	}
+}
@@ -0,0 +1,91 @@
+package github
+
+import (
+	"context"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// viewerReviewsQuery fetches the authenticated user with the head commit and
+// the latest reviews of a pull request, each with the commit it was submitted on
+const viewerReviewsQuery = `query($owner: String!, $repo: String!, $number: Int!) {
+  viewer { login }
+  repository(owner: $owner, name: $repo) {
+    pullRequest(number: $number) {
+      headRefOid
+      reviews(last: 100) {
+        nodes {
+          state
+          submittedAt
+          author { login }
+          commit { oid }
+        }
+      }
+    }
+  }
+}`
+
+// viewerReviewsResult is the response shape of viewerReviewsQuery
+type viewerReviewsResult struct {
+	Viewer struct {
+		Login string `json:"login"`
+	} `json:"viewer"`
+	Repository struct {
+		PullRequest struct {
+			HeadRefOid string `json:"headRefOid"`
+			Reviews    struct {
+				Nodes []struct {
+					State       string     `json:"state"`
+					SubmittedAt *time.Time `json:"submittedAt"`
+					Author      struct {
+						Login string `json:"login"`
+					} `json:"author"`
+					Commit *struct {
+						Oid string `json:"oid"`
+					} `json:"commit"`
+				} `json:"nodes"`
+			} `json:"reviews"`
+		} `json:"pullRequest"`
+	} `json:"repository"`
+}
+
+// GetChangesSinceReview retrieves what changed in a pull request since the
+// authenticated user's last submitted review, comparing the reviewed commit with the head
+func (r *PullRequestRepositoryImpl) GetChangesSinceReview(ctx context.Context, owner, repo string, number int) (*models.ChangesSinceReview, error) {
+	var result viewerReviewsResult
+	err := r.client.graphQL(ctx, viewerReviewsQuery, map[string]interface{}{
+		"owner":  owner,
+		"repo":   repo,
+		"number": number,
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	pr := result.Repository.PullRequest
+	changes := &models.ChangesSinceReview{HeadSHA: pr.HeadRefOid}
+	// Reviews come oldest first; pending reviews have not been submitted yet
+	for _, review := range pr.Reviews.Nodes {
+		if review.Author.Login != result.Viewer.Login || review.State == "PENDING" ||
+			review.Commit == nil || review.SubmittedAt == nil {
+			continue
+		}
+		changes.ReviewedSHA = review.Commit.Oid
+		changes.ReviewedAt = *review.SubmittedAt
+	}
+	if !changes.Reviewed() || changes.UpToDate() {
+		return changes, nil
+	}
+
+	ghComparison, resp, err := r.client.client.Repositories.CompareCommits(ctx, owner, repo, changes.ReviewedSHA, changes.HeadSHA, nil)
+	if err != nil {
+		return nil, handleGitHubError(err, resp)
+	}
+	comparison := convertToComparison(ghComparison)
+	changes.Commits = comparison.Commits
+	changes.Files = comparison.Files
+	// After a force push the reviewed commit is no longer an ancestor of the head
+	changes.Rewritten = comparison.Status == models.ComparisonStatusDiverged || comparison.Status == models.ComparisonStatusBehind
+	return changes, nil
+}
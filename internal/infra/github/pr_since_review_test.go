@@ -0,0 +1,61 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetChangesSinceReview(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/graphql":
+			_, _ = w.Write([]byte(`{"data":{"viewer":{"login":"me"},"repository":{"pullRequest":{"headRefOid":"head","reviews":{"nodes":[
+				{"state":"COMMENTED","submittedAt":"2025-01-20T10:00:00Z","author":{"login":"me"},"commit":{"oid":"first"}},
+				{"state":"CHANGES_REQUESTED","submittedAt":"2025-01-21T10:00:00Z","author":{"login":"me"},"commit":{"oid":"reviewed"}},
+				{"state":"APPROVED","submittedAt":"2025-01-22T10:00:00Z","author":{"login":"other"},"commit":{"oid":"head"}},
+				{"state":"PENDING","submittedAt":null,"author":{"login":"me"},"commit":{"oid":"head"}}
+			]}}}}}`))
+		case "/repos/owner/repo/compare/reviewed...head":
+			_, _ = w.Write([]byte(`{"status":"ahead","commits":[{"sha":"fix","commit":{"message":"Address review"}}],
+				"files":[{"filename":"main.go","status":"modified","additions":2,"deletions":1,"patch":"@@ -1 +1 @@"}]}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	})
+
+	changes, err := NewPullRequestRepository(client).GetChangesSinceReview(context.Background(), "owner", "repo", 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if changes.ReviewedSHA != "reviewed" || changes.HeadSHA != "head" || changes.ReviewedAt.Day() != 21 {
+		t.Errorf("expected the viewer's last submitted review, got %+v", changes)
+	}
+	if len(changes.Commits) != 1 || changes.Commits[0].SHA != "fix" {
+		t.Errorf("unexpected commits %+v", changes.Commits)
+	}
+	if len(changes.Files) != 1 || changes.Files[0].Filename != "main.go" || changes.Files[0].Additions != 2 {
+		t.Errorf("unexpected files %+v", changes.Files)
+	}
+	if changes.Rewritten {
+		t.Error("expected a fast-forward not to be reported as rewritten")
+	}
+}
+
+func TestGetChangesSinceReview_NotReviewed(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/graphql" {
+			t.Errorf("expected no comparison without a review, got %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"data":{"viewer":{"login":"me"},"repository":{"pullRequest":{"headRefOid":"head","reviews":{"nodes":[]}}}}}`))
+	})
+
+	changes, err := NewPullRequestRepository(client).GetChangesSinceReview(context.Background(), "owner", "repo", 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if changes.Reviewed() {
+		t.Errorf("expected no review, got %+v", changes)
+	}
+}
@@ -0,0 +1,93 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/google/go-github/v57/github"
+)
+
+// errHeadMoved is returned when the head branch got new commits since the
+// suggestion was read, so its line numbers may no longer match
+var errHeadMoved = errors.New("the branch has new commits; reload and try again")
+
+// CommitSuggestion commits a suggested change to the head branch of a pull
+// request by rewriting the lines of the file through the contents API. The
+// branch may live in a fork; the blob SHA of the file guards against a push
+// racing the commit.
+func (r *PullRequestRepositoryImpl) CommitSuggestion(ctx context.Context, owner, repo string, number int, input *models.SuggestionInput) error {
+	pr, resp, err := r.client.client.PullRequests.Get(ctx, owner, repo, number)
+	if err != nil {
+		return handleGitHubError(err, resp)
+	}
+	head := pr.GetHead()
+	if head.GetRepo() == nil {
+		return fmt.Errorf("the head repository of #%d was deleted", number)
+	}
+	if input.ExpectedHeadSHA != "" && head.GetSHA() != input.ExpectedHeadSHA {
+		return errHeadMoved
+	}
+	headOwner, headRepo := head.GetRepo().GetOwner().GetLogin(), head.GetRepo().GetName()
+
+	file, _, resp, err := r.client.client.Repositories.GetContents(ctx, headOwner, headRepo, input.Path, &github.RepositoryContentGetOptions{Ref: head.GetSHA()})
+	if err != nil {
+		return handleGitHubError(err, resp)
+	}
+	if file == nil {
+		return fmt.Errorf("%s is not a file", input.Path)
+	}
+	content, err := file.GetContent()
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", input.Path, err)
+	}
+
+	updated, err := replaceLines(content, input.StartLine, input.Line, input.Replacement)
+	if err != nil {
+		return fmt.Errorf("%s: %w", input.Path, err)
+	}
+
+	_, resp, err = r.client.client.Repositories.UpdateFile(ctx, headOwner, headRepo, input.Path, &github.RepositoryContentFileOptions{
+		Message: github.String(input.Message),
+		Content: []byte(updated),
+		SHA:     file.SHA,
+		Branch:  github.String(head.GetRef()),
+	})
+	if err != nil {
+		return handleGitHubError(err, resp)
+	}
+	return nil
+}
+
+// replaceLines replaces lines start..end (1-based, inclusive) of content with
+// replacement, keeping the line endings of the file
+func replaceLines(content string, start, end int, replacement string) (string, error) {
+	if start <= 0 {
+		start = end
+	}
+	lines := strings.Split(content, "\n")
+	count := len(lines)
+	if lines[count-1] == "" {
+		// The newline at the end of the file does not start another line
+		count--
+	}
+	if start > end || end > count {
+		return "", fmt.Errorf("lines %d-%d are not in the file (%d lines)", start, end, count)
+	}
+
+	newline := ""
+	if strings.HasSuffix(lines[end-1], "\r") {
+		newline = "\r"
+	}
+	var replaced []string
+	if replacement != "" {
+		for _, line := range strings.Split(strings.TrimSuffix(replacement, "\n"), "\n") {
+			replaced = append(replaced, strings.TrimSuffix(line, "\r")+newline)
+		}
+	}
+
+	result := append(append(append([]string{}, lines[:start-1]...), replaced...), lines[end:]...)
+	return strings.Join(result, "\n"), nil
+}
@@ -0,0 +1,104 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func TestCommitSuggestion(t *testing.T) {
+	original := "package main\n\nfunc main() {\n\tprintln(\"hi\")\n}\n"
+	var committed map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/repos/owner/repo/pulls/5":
+			_, _ = w.Write([]byte(`{"number":5,"head":{"ref":"feature","sha":"head1","repo":{"name":"fork","owner":{"login":"contributor"}}}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/contributor/fork/contents/main.go":
+			if r.URL.Query().Get("ref") != "head1" {
+				t.Errorf("expected the file at the head, got %q", r.URL.RawQuery)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"type":     "file",
+				"encoding": "base64",
+				"sha":      "blob1",
+				"content":  base64.StdEncoding.EncodeToString([]byte(original)),
+			})
+		case r.Method == http.MethodPut && r.URL.Path == "/repos/contributor/fork/contents/main.go":
+			_ = json.NewDecoder(r.Body).Decode(&committed)
+			_, _ = w.Write([]byte(`{"commit":{"sha":"head2"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	err := NewPullRequestRepository(client).CommitSuggestion(context.Background(), "owner", "repo", 5, &models.SuggestionInput{
+		Path:            "main.go",
+		StartLine:       3,
+		Line:            5,
+		Replacement:     "func main() {\n\tprintln(\"hello\")\n}",
+		Message:         "Apply suggestion",
+		ExpectedHeadSHA: "head1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	content, _ := base64.StdEncoding.DecodeString(committed["content"].(string))
+	if want := "package main\n\nfunc main() {\n\tprintln(\"hello\")\n}\n"; string(content) != want {
+		t.Errorf("expected %q, got %q", want, content)
+	}
+	if committed["sha"] != "blob1" || committed["branch"] != "feature" || committed["message"] != "Apply suggestion" {
+		t.Errorf("unexpected commit: %v", committed)
+	}
+}
+
+func TestCommitSuggestion_HeadMoved(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/owner/repo/pulls/5" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"number":5,"head":{"ref":"feature","sha":"head2","repo":{"name":"repo","owner":{"login":"owner"}}}}`))
+	})
+
+	err := NewPullRequestRepository(client).CommitSuggestion(context.Background(), "owner", "repo", 5, &models.SuggestionInput{
+		Path: "main.go", Line: 1, Replacement: "x", ExpectedHeadSHA: "head1",
+	})
+	if !errors.Is(err, errHeadMoved) {
+		t.Errorf("expected errHeadMoved, got %v", err)
+	}
+}
+
+func TestReplaceLines(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		start, end  int
+		replacement string
+		want        string
+		wantErr     bool
+	}{
+		{"single line", "a\nb\nc\n", 0, 2, "B", "a\nB\nc\n", false},
+		{"range", "a\nb\nc\n", 1, 2, "x\ny\nz\n", "x\ny\nz\nc\n", false},
+		{"delete", "a\nb\nc\n", 2, 3, "", "a\n", false},
+		{"no final newline", "a\nb", 2, 2, "B", "a\nB", false},
+		{"crlf", "a\r\nb\r\n", 1, 1, "A", "A\r\nb\r\n", false},
+		{"past the end", "a\nb\n", 2, 3, "x", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := replaceLines(tt.content, tt.start, tt.end, tt.replacement)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
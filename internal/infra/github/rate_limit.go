@@ -0,0 +1,90 @@
+package github
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/google/go-github/v57/github"
+)
+
+// Rate limit headers. github.com, ghe.com and GitHub Enterprise Server send the same
+// names, but GHES omits them entirely when rate limiting is disabled.
+const (
+	headerRateLimit     = "X-RateLimit-Limit"
+	headerRateRemaining = "X-RateLimit-Remaining"
+	headerRateReset     = "X-RateLimit-Reset"
+)
+
+// parseRateLimitHeaders reads the rate limit from response headers.
+// Missing or malformed headers yield an unknown rate limit instead of zero values.
+func parseRateLimitHeaders(header http.Header) models.RateLimit {
+	limit, okLimit := parseHeaderInt(header, headerRateLimit)
+	remaining, okRemaining := parseHeaderInt(header, headerRateRemaining)
+	if !okLimit || !okRemaining || limit <= 0 {
+		return models.RateLimit{}
+	}
+
+	rate := models.RateLimit{
+		Known:     true,
+		Limit:     limit,
+		Remaining: remaining,
+	}
+	if reset, ok := parseHeaderInt(header, headerRateReset); ok && reset > 0 {
+		rate.Reset = time.Unix(int64(reset), 0)
+	}
+	return rate
+}
+
+// parseHeaderInt parses an integer header value
+func parseHeaderInt(header http.Header, key string) (int, bool) {
+	value := strings.TrimSpace(header.Get(key))
+	if value == "" {
+		return 0, false
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// rateLimitFromResponse returns the rate limit reported by a response
+func rateLimitFromResponse(resp *github.Response) models.RateLimit {
+	if resp == nil || resp.Response == nil {
+		return models.RateLimit{}
+	}
+	return parseRateLimitHeaders(resp.Header)
+}
+
+// convertToRateLimit converts a rate from the rate limit API.
+// A zero limit means the server did not report one.
+func convertToRateLimit(rate *github.Rate) models.RateLimit {
+	if rate == nil || rate.Limit <= 0 {
+		return models.RateLimit{}
+	}
+	return models.RateLimit{
+		Known:     true,
+		Limit:     rate.Limit,
+		Remaining: rate.Remaining,
+		Reset:     rate.Reset.Time,
+	}
+}
+
+// isRateLimited reports whether an error was caused by a (primary or secondary) rate limit
+func isRateLimited(err error, resp *github.Response) bool {
+	var rateErr *github.RateLimitError
+	if errors.As(err, &rateErr) {
+		return true
+	}
+	var abuseErr *github.AbuseRateLimitError
+	if errors.As(err, &abuseErr) {
+		return true
+	}
+
+	rate := rateLimitFromResponse(resp)
+	return rate.Known && rate.Remaining == 0
+}
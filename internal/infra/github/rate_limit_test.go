@@ -0,0 +1,110 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/google/go-github/v57/github"
+)
+
+func newResponse(status int, header http.Header) *github.Response {
+	return &github.Response{Response: &http.Response{StatusCode: status, Header: header}}
+}
+
+func TestParseRateLimitHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "5000")
+	header.Set("X-RateLimit-Remaining", "42")
+	header.Set("X-RateLimit-Reset", "1700000000")
+
+	rate := parseRateLimitHeaders(header)
+	if !rate.Known || rate.Limit != 5000 || rate.Remaining != 42 {
+		t.Fatalf("unexpected rate %+v", rate)
+	}
+	if !rate.Reset.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("unexpected reset %v", rate.Reset)
+	}
+}
+
+func TestParseRateLimitHeaders_Missing(t *testing.T) {
+	// GHES with rate limiting disabled sends no rate limit headers
+	if rate := parseRateLimitHeaders(http.Header{}); rate.Known {
+		t.Fatalf("expected unknown rate limit, got %+v", rate)
+	}
+
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "unlimited")
+	header.Set("X-RateLimit-Remaining", "0")
+	if rate := parseRateLimitHeaders(header); rate.Known {
+		t.Fatalf("expected malformed headers to be unknown, got %+v", rate)
+	}
+}
+
+func TestHandleGitHubError_ForbiddenWithoutRateHeaders(t *testing.T) {
+	err := handleGitHubError(errors.New("boom"), newResponse(http.StatusForbidden, http.Header{}))
+	if !strings.Contains(err.Error(), "insufficient permissions") {
+		t.Fatalf("expected permission error without rate headers, got %v", err)
+	}
+}
+
+func TestHandleGitHubError_RateLimited(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "60")
+	header.Set("X-RateLimit-Remaining", "0")
+	header.Set("X-RateLimit-Reset", "1700000000")
+
+	err := handleGitHubError(errors.New("boom"), newResponse(http.StatusForbidden, header))
+	if !strings.Contains(err.Error(), "rate limit exceeded") {
+		t.Fatalf("expected rate limit error, got %v", err)
+	}
+
+	err = handleGitHubError(&github.AbuseRateLimitError{Message: "slow down"}, newResponse(http.StatusTooManyRequests, http.Header{}))
+	if !strings.Contains(err.Error(), "rate limit exceeded") {
+		t.Fatalf("expected secondary rate limit error, got %v", err)
+	}
+}
+
+func TestConvertToRateLimit_ZeroLimitIsUnknown(t *testing.T) {
+	if rate := convertToRateLimit(&github.Rate{}); rate.Known {
+		t.Fatalf("expected unknown rate, got %+v", rate)
+	}
+	if rate := convertToRateLimit(&github.Rate{Limit: 5000, Remaining: 10}); !rate.Known || rate.Remaining != 10 {
+		t.Fatalf("unexpected rate %+v", rate)
+	}
+}
+
+func TestHandleGitHubError_Kinds(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		resp *github.Response
+		want models.APIErrorKind
+	}{
+		{"not found", errors.New("boom"), newResponse(http.StatusNotFound, http.Header{}), models.APIErrorNotFound},
+		{"server", errors.New("boom"), newResponse(http.StatusBadGateway, http.Header{}), models.APIErrorServer},
+		{"secondary rate limit", &github.AbuseRateLimitError{Message: "slow down"}, newResponse(http.StatusTooManyRequests, http.Header{}), models.APIErrorRateLimit},
+		{"network", &url.Error{Op: "Get", URL: "https://api.github.com", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}, nil, models.APIErrorNetwork},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var apiErr *models.APIError
+			if err := handleGitHubError(tt.err, tt.resp); !errors.As(err, &apiErr) || apiErr.Kind != tt.want {
+				t.Fatalf("got %v, want kind %s", err, tt.want)
+			}
+		})
+	}
+
+	// Cancelled requests are not network failures
+	var apiErr *models.APIError
+	cancelled := &url.Error{Op: "Get", URL: "https://api.github.com", Err: context.Canceled}
+	if err := handleGitHubError(cancelled, nil); errors.As(err, &apiErr) {
+		t.Errorf("expected a cancelled request to stay unclassified, got %v", err)
+	}
+}
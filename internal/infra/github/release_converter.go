@@ -0,0 +1,125 @@
+package github
+
+import (
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/google/go-github/v57/github"
+)
+
+// convertToRelease converts a GitHub release to a domain release
+func convertToRelease(ghRelease *github.RepositoryRelease) *models.Release {
+	if ghRelease == nil {
+		return nil
+	}
+
+	release := &models.Release{
+		ID:              ghRelease.GetID(),
+		TagName:         ghRelease.GetTagName(),
+		TargetCommitish: ghRelease.GetTargetCommitish(),
+		Name:            ghRelease.GetName(),
+		Body:            ghRelease.GetBody(),
+		Draft:           ghRelease.GetDraft(),
+		Prerelease:      ghRelease.GetPrerelease(),
+		Author:          convertToUser(ghRelease.Author),
+		HTMLURL:         ghRelease.GetHTMLURL(),
+		CreatedAt:       ghRelease.GetCreatedAt().Time,
+	}
+
+	if ghRelease.PublishedAt != nil {
+		publishedAt := ghRelease.PublishedAt.Time
+		release.PublishedAt = &publishedAt
+	}
+
+	for _, ghAsset := range ghRelease.Assets {
+		if ghAsset == nil {
+			continue
+		}
+		release.Assets = append(release.Assets, &models.ReleaseAsset{
+			ID:                 ghAsset.GetID(),
+			Name:               ghAsset.GetName(),
+			ContentType:        ghAsset.GetContentType(),
+			Size:               ghAsset.GetSize(),
+			DownloadCount:      ghAsset.GetDownloadCount(),
+			BrowserDownloadURL: ghAsset.GetBrowserDownloadURL(),
+			UpdatedAt:          ghAsset.GetUpdatedAt().Time,
+		})
+	}
+
+	return release
+}
+
+// convertToReleases converts a slice of GitHub releases to domain releases
+func convertToReleases(ghReleases []*github.RepositoryRelease) []*models.Release {
+	if len(ghReleases) == 0 {
+		return nil
+	}
+
+	releases := make([]*models.Release, 0, len(ghReleases))
+	for _, ghRelease := range ghReleases {
+		if release := convertToRelease(ghRelease); release != nil {
+			releases = append(releases, release)
+		}
+	}
+
+	return releases
+}
+
+// convertToTags converts a slice of GitHub tags to domain tags
+func convertToTags(ghTags []*github.RepositoryTag) []*models.Tag {
+	if len(ghTags) == 0 {
+		return nil
+	}
+
+	tags := make([]*models.Tag, 0, len(ghTags))
+	for _, ghTag := range ghTags {
+		if ghTag == nil {
+			continue
+		}
+		tags = append(tags, &models.Tag{
+			Name: ghTag.GetName(),
+			SHA:  ghTag.GetCommit().GetSHA(),
+		})
+	}
+
+	return tags
+}
+
+// convertFromReleaseOptions converts domain release options to GitHub list options
+func convertFromReleaseOptions(opts *models.ReleaseOptions) *github.ListOptions {
+	if opts == nil {
+		return &github.ListOptions{PerPage: defaultPerPage}
+	}
+
+	ghOpts := &github.ListOptions{
+		Page:    opts.Page,
+		PerPage: opts.PerPage,
+	}
+	if ghOpts.PerPage == 0 {
+		ghOpts.PerPage = defaultPerPage
+	}
+
+	return ghOpts
+}
+
+// convertFromCreateReleaseInput converts a domain create release input to a GitHub release
+func convertFromCreateReleaseInput(input *models.CreateReleaseInput) *github.RepositoryRelease {
+	release := &github.RepositoryRelease{
+		TagName:    github.String(input.TagName),
+		Draft:      github.Bool(input.Draft),
+		Prerelease: github.Bool(input.Prerelease),
+	}
+
+	if input.Target != "" {
+		release.TargetCommitish = github.String(input.Target)
+	}
+	if input.Name != "" {
+		release.Name = github.String(input.Name)
+	}
+	if input.Body != "" {
+		release.Body = github.String(input.Body)
+	}
+	if input.GenerateNotes {
+		release.GenerateReleaseNotes = github.Bool(true)
+	}
+
+	return release
+}
@@ -0,0 +1,68 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+)
+
+// ReleaseRepositoryImpl implements the ReleaseRepository interface
+type ReleaseRepositoryImpl struct {
+	client *Client
+}
+
+// NewReleaseRepository creates a new ReleaseRepository implementation
+func NewReleaseRepository(client *Client) repository.ReleaseRepository {
+	return &ReleaseRepositoryImpl{
+		client: client,
+	}
+}
+
+// List retrieves a list of releases for a repository
+func (r *ReleaseRepositoryImpl) List(ctx context.Context, owner, repo string, opts *models.ReleaseOptions) ([]*models.Release, error) {
+	ghReleases, resp, err := r.client.client.Repositories.ListReleases(ctx, owner, repo, convertFromReleaseOptions(opts))
+	if err != nil {
+		return nil, handleGitHubError(err, resp)
+	}
+
+	return convertToReleases(ghReleases), nil
+}
+
+// ListTags retrieves a list of tags for a repository
+func (r *ReleaseRepositoryImpl) ListTags(ctx context.Context, owner, repo string, opts *models.ReleaseOptions) ([]*models.Tag, error) {
+	ghTags, resp, err := r.client.client.Repositories.ListTags(ctx, owner, repo, convertFromReleaseOptions(opts))
+	if err != nil {
+		return nil, handleGitHubError(err, resp)
+	}
+
+	return convertToTags(ghTags), nil
+}
+
+// Create creates a new release
+func (r *ReleaseRepositoryImpl) Create(ctx context.Context, owner, repo string, input *models.CreateReleaseInput) (*models.Release, error) {
+	if input == nil || input.TagName == "" {
+		return nil, fmt.Errorf("tag name is required")
+	}
+
+	ghRelease, resp, err := r.client.client.Repositories.CreateRelease(ctx, owner, repo, convertFromCreateReleaseInput(input))
+	if err != nil {
+		return nil, handleGitHubError(err, resp)
+	}
+
+	return convertToRelease(ghRelease), nil
+}
+
+// DownloadAsset opens the contents of a release asset
+func (r *ReleaseRepositoryImpl) DownloadAsset(ctx context.Context, owner, repo string, assetID int64) (io.ReadCloser, error) {
+	// Assets are served from a redirect to storage; follow it without the API credentials
+	rc, _, err := r.client.client.Repositories.DownloadReleaseAsset(ctx, owner, repo, assetID, http.DefaultClient)
+	if err != nil {
+		return nil, fmt.Errorf("github api error: %w", err)
+	}
+
+	return rc, nil
+}
@@ -0,0 +1,77 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func TestReleaseRepository_List(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/owner/repo/releases" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`[{"id":1,"tag_name":"v1.0.0","name":"First","prerelease":true,
+			"author":{"login":"octocat"},
+			"assets":[{"id":7,"name":"app.zip","size":1024,"download_count":3}]}]`))
+	})
+	repo := NewReleaseRepository(client)
+
+	releases, err := repo.List(context.Background(), "owner", "repo", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(releases) != 1 {
+		t.Fatalf("got %d releases, want 1", len(releases))
+	}
+	release := releases[0]
+	if release.TagName != "v1.0.0" || !release.Prerelease || release.Author.Login != "octocat" {
+		t.Errorf("unexpected release %+v", release)
+	}
+	if len(release.Assets) != 1 || release.Assets[0].ID != 7 || release.Assets[0].Size != 1024 {
+		t.Errorf("unexpected assets %+v", release.Assets)
+	}
+}
+
+func TestReleaseRepository_Create(t *testing.T) {
+	var body map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/repos/owner/repo/releases" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(data, &body); err != nil {
+			t.Errorf("invalid body: %v", err)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":2,"tag_name":"v1.1.0"}`))
+	})
+	repo := NewReleaseRepository(client)
+
+	release, err := repo.Create(context.Background(), "owner", "repo", &models.CreateReleaseInput{
+		TagName:       "v1.1.0",
+		Target:        "main",
+		GenerateNotes: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if release.TagName != "v1.1.0" {
+		t.Errorf("tag = %q", release.TagName)
+	}
+	if body["tag_name"] != "v1.1.0" || body["target_commitish"] != "main" || body["generate_release_notes"] != true {
+		t.Errorf("unexpected request body %v", body)
+	}
+
+	if _, err := repo.Create(context.Background(), "owner", "repo", &models.CreateReleaseInput{}); err == nil {
+		t.Error("expected an error without a tag name")
+	}
+}
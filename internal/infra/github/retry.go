@@ -0,0 +1,198 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultRetries is how many times a request is retried after a transient failure
+const DefaultRetries = 3
+
+const (
+	// retryBaseDelay is the backoff before the first retry; it doubles on each retry
+	retryBaseDelay = 500 * time.Millisecond
+	// retryMaxDelay caps the backoff between retries
+	retryMaxDelay = 30 * time.Second
+	// retryMaxRetryAfter is the longest Retry-After that is waited out. Longer
+	// waits are reported as errors rather than freezing the view.
+	retryMaxRetryAfter = 2 * time.Minute
+)
+
+// retryTransport retries requests that failed transiently: 5xx responses to
+// idempotent requests and secondary rate limits. It waits as long as the
+// server asks in Retry-After, otherwise for a jittered exponential backoff.
+// Primary rate limits are not retried since they reset up to an hour later.
+type retryTransport struct {
+	base    http.RoundTripper
+	retries int
+
+	// sleep and jitter are replaced in tests
+	sleep  func(ctx context.Context, d time.Duration) error
+	jitter func(d time.Duration) time.Duration
+}
+
+// newRetryTransport wraps base so that requests are retried up to retries times
+func newRetryTransport(base http.RoundTripper, retries int) *retryTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &retryTransport{
+		base:    base,
+		retries: retries,
+		sleep:   sleepContext,
+		jitter:  equalJitter,
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		// A RoundTripper must not modify the request, so retries send a copy
+		next := req
+		if attempt > 0 {
+			body, err := rewindBody(req)
+			if err != nil {
+				return nil, err
+			}
+			next = req.Clone(req.Context())
+			next.Body = body
+		}
+
+		resp, err := t.base.RoundTrip(next)
+		if err != nil || attempt >= t.retries || !canReplay(req) {
+			return resp, err
+		}
+
+		delay, retry := t.retryDelay(req, resp, attempt)
+		if !retry {
+			return resp, nil
+		}
+
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		if err := t.sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// retryDelay reports whether resp is a transient failure and how long to wait
+// before retrying it
+func (t *retryTransport) retryDelay(req *http.Request, resp *http.Response, attempt int) (time.Duration, bool) {
+	switch {
+	case resp.StatusCode >= 500:
+		// A failed write may still have been applied, so only repeat requests that are safe to repeat
+		if !isIdempotent(req.Method) {
+			return 0, false
+		}
+	case resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests:
+		if !isSecondaryRateLimit(resp) {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+		return wait, wait <= retryMaxRetryAfter
+	}
+
+	delay := retryBaseDelay << attempt
+	if delay <= 0 || delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+	return t.jitter(delay), true
+}
+
+// isSecondaryRateLimit reports whether a 403 or 429 response is a secondary
+// rate limit. The primary rate limit is exhausted when no requests remain;
+// the secondary one is signalled by Retry-After or the message in the body.
+func isSecondaryRateLimit(resp *http.Response) bool {
+	if resp.Header.Get(headerRateRemaining) == "0" {
+		return false
+	}
+	if resp.Header.Get("Retry-After") != "" || resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	// Keep the body readable for the caller when the response is not retried
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+	return strings.Contains(strings.ToLower(string(body)), "secondary rate limit")
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if wait := at.Sub(now); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// isIdempotent reports whether repeating a request with method has no further effect
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// canReplay reports whether the body of req can be sent again
+func canReplay(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// rewindBody returns a fresh copy of the body of req
+func rewindBody(req *http.Request) (io.ReadCloser, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req.Body, nil
+	}
+	return req.GetBody()
+}
+
+// equalJitter returns a random duration between d/2 and d, so that clients
+// that failed together do not retry together
+func equalJitter(d time.Duration) time.Duration {
+	half := d / 2
+	if half <= 0 {
+		return d
+	}
+	return half + rand.N(half+1)
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,219 @@
+package github
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newRetryTestClient returns an HTTP client that retries against handler and
+// records the waits instead of sleeping
+func newRetryTestClient(t *testing.T, retries int, handler http.HandlerFunc) (*http.Client, string, *[]time.Duration) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	var waits []time.Duration
+	transport := newRetryTransport(http.DefaultTransport, retries)
+	transport.jitter = func(d time.Duration) time.Duration { return d }
+	transport.sleep = func(ctx context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return ctx.Err()
+	}
+	return &http.Client{Transport: transport}, server.URL, &waits
+}
+
+func TestRetryTransport_RetriesServerErrorsWithBackoff(t *testing.T) {
+	var calls int32
+	client, url, waits := newRetryTestClient(t, 3, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte("ok"))
+	})
+
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || calls != 3 {
+		t.Fatalf("status = %d after %d calls, want 200 after 3", resp.StatusCode, calls)
+	}
+	if len(*waits) != 2 || (*waits)[0] != retryBaseDelay || (*waits)[1] != 2*retryBaseDelay {
+		t.Errorf("waits = %v, want exponential backoff from %v", *waits, retryBaseDelay)
+	}
+}
+
+func TestRetryTransport_GivesUpAfterRetries(t *testing.T) {
+	var calls int32
+	client, url, _ := newRetryTestClient(t, 2, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable || calls != 3 {
+		t.Errorf("status = %d after %d calls, want 503 after 3", resp.StatusCode, calls)
+	}
+}
+
+func TestRetryTransport_HonorsRetryAfterOnSecondaryRateLimit(t *testing.T) {
+	var calls int32
+	var bodies []string
+	client, url, waits := newRetryTestClient(t, 3, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "7")
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"message":"You have exceeded a secondary rate limit"}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	// Rate limited writes were not applied, so they are retried with their body
+	resp, err := client.Post(url, "application/json", strings.NewReader(`{"title":"x"}`))
+	if err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated || calls != 2 {
+		t.Fatalf("status = %d after %d calls, want 201 after 2", resp.StatusCode, calls)
+	}
+	if len(*waits) != 1 || (*waits)[0] != 7*time.Second {
+		t.Errorf("waits = %v, want [7s]", *waits)
+	}
+	if bodies[1] != `{"title":"x"}` {
+		t.Errorf("retried body = %q", bodies[1])
+	}
+}
+
+func TestRetryTransport_DoesNotRetry(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		status int
+		header map[string]string
+		body   string
+	}{
+		{name: "not found", method: http.MethodGet, status: http.StatusNotFound},
+		{name: "server error on POST", method: http.MethodPost, status: http.StatusInternalServerError},
+		{
+			name:   "primary rate limit",
+			method: http.MethodGet,
+			status: http.StatusForbidden,
+			header: map[string]string{headerRateRemaining: "0", headerRateReset: "1700000000"},
+		},
+		{name: "forbidden", method: http.MethodGet, status: http.StatusForbidden, body: `{"message":"Resource not accessible"}`},
+		{
+			name:   "Retry-After too long",
+			method: http.MethodGet,
+			status: http.StatusTooManyRequests,
+			header: map[string]string{"Retry-After": "3600"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			client, url, _ := newRetryTestClient(t, 3, func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+				for k, v := range tt.header {
+					w.Header().Set(k, v)
+				}
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			})
+
+			req, _ := http.NewRequest(tt.method, url, strings.NewReader("{}"))
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			if calls != 1 || resp.StatusCode != tt.status {
+				t.Errorf("status = %d after %d calls, want %d after 1", resp.StatusCode, calls, tt.status)
+			}
+			// The body is still readable after checking for a secondary rate limit
+			if body, _ := io.ReadAll(resp.Body); string(body) != tt.body {
+				t.Errorf("body = %q, want %q", body, tt.body)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_StopsWhenContextIsCancelled(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	transport := newRetryTransport(http.DefaultTransport, 3)
+	transport.sleep = func(ctx context.Context, d time.Duration) error {
+		cancel()
+		return sleepContext(ctx, d)
+	}
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	_, err := (&http.Client{Transport: transport}).Do(req)
+	if err == nil || calls != 1 {
+		t.Errorf("err = %v after %d calls, want cancellation after 1", err, calls)
+	}
+}
+
+func TestClientSetRetries(t *testing.T) {
+	var calls int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	client.SetRetries(0)
+
+	if _, _, err := client.GetClient().Users.Get(context.Background(), "octocat"); err == nil {
+		t.Fatal("expected an error")
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1 with retries disabled", calls)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{"", 0, false},
+		{"30", 30 * time.Second, true},
+		{"-1", 0, false},
+		{"Mon, 01 Jan 2024 00:01:00 GMT", time.Minute, true},
+		{"Sun, 31 Dec 2023 23:59:00 GMT", 0, true},
+		{"soon", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(tt.value, now)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("parseRetryAfter(%q) = %v, %v; want %v, %v", tt.value, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -30,7 +30,7 @@ func (r *SearchRepositoryImpl) Search(ctx context.Context, owner, repo string, o
 			State:     models.IssueStateOpen,
 			Sort:      models.SearchSortUpdated,
 			Direction: models.SortDirectionDesc,
-			PerPage:   30,
+			PerPage:   defaultPerPage,
 			Page:      1,
 		}
 	}
@@ -63,6 +63,7 @@ func (r *SearchRepositoryImpl) Search(ctx context.Context, owner, repo string, o
 
 	for _, issue := range result.Issues {
 		searchResult := convertSearchIssue(issue)
+		searchResult.Repository = repositoryFromURL(issue.GetRepositoryURL())
 		searchResults.Items = append(searchResults.Items, searchResult)
 	}
 
@@ -71,8 +72,15 @@ func (r *SearchRepositoryImpl) Search(ctx context.Context, owner, repo string, o
 
 // buildSearchQuery builds a GitHub search query string from options
 func buildSearchQuery(owner, repo string, opts *models.SearchOptions) string {
-	parts := []string{
-		fmt.Sprintf("repo:%s/%s", owner, repo),
+	var parts []string
+	switch {
+	case len(opts.Repositories) > 0:
+		// GitHub matches any of several repo: qualifiers
+		for _, r := range opts.Repositories {
+			parts = append(parts, fmt.Sprintf("repo:%s", r))
+		}
+	case owner != "" && repo != "":
+		parts = append(parts, fmt.Sprintf("repo:%s/%s", owner, repo))
 	}
 
 	// Add search query if provided
@@ -111,6 +119,20 @@ func buildSearchQuery(owner, repo string, opts *models.SearchOptions) string {
 	return strings.Join(parts, " ")
 }
 
+// repositoryFromURL returns owner/repo from the API URL of a repository
+// (https://api.github.com/repos/owner/repo, or /api/v3/repos/... on GHE)
+func repositoryFromURL(url string) string {
+	_, path, ok := strings.Cut(url, "/repos/")
+	if !ok {
+		return ""
+	}
+	parts := strings.Split(path, "/")
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return ""
+	}
+	return parts[0] + "/" + parts[1]
+}
+
 // convertSearchIssue converts a GitHub issue from search results to a SearchResult
 func convertSearchIssue(ghIssue *github.Issue) models.SearchResult {
 	// Check if it's a pull request by looking for the PullRequestLinks field
@@ -0,0 +1,62 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func TestSearchRepository_AcrossRepositories(t *testing.T) {
+	var query string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/search/issues" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		query = r.URL.Query().Get("q")
+		_, _ = w.Write([]byte(`{"total_count":1,"items":[
+			{"number":7,"title":"Fix","state":"open","repository_url":"https://api.github.com/repos/acme/api",
+			 "pull_request":{"url":"https://api.github.com/repos/acme/api/pulls/7"}}]}`))
+	})
+	repo := NewSearchRepository(client)
+
+	results, err := repo.Search(context.Background(), "owner", "repo", &models.SearchOptions{
+		Type:         models.SearchTypePR,
+		State:        models.IssueStateOpen,
+		Query:        "review-requested:@me",
+		Repositories: []string{"acme/api", "acme/web"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "repo:acme/api repo:acme/web review-requested:@me is:pr is:open"; query != want {
+		t.Errorf("query = %q, want %q", query, want)
+	}
+	if len(results.Items) != 1 || results.Items[0].Repository != "acme/api" || results.Items[0].PullRequest == nil {
+		t.Errorf("unexpected results %+v", results.Items)
+	}
+
+	if _, err := repo.Search(context.Background(), "", "", &models.SearchOptions{Query: "assignee:@me"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if query != "assignee:@me" {
+		t.Errorf("expected no repo qualifier without a repository, got %q", query)
+	}
+}
+
+func TestRepositoryFromURL(t *testing.T) {
+	tests := map[string]string{
+		"https://api.github.com/repos/acme/api":          "acme/api",
+		"https://ghe.example.com/api/v3/repos/team/tool": "team/tool",
+		"https://api.github.com/users/acme":              "",
+		"":                                               "",
+	}
+	for url, want := range tests {
+		if got := repositoryFromURL(url); got != want {
+			t.Errorf("repositoryFromURL(%q) = %q, want %q", url, got, want)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package github
+
+import (
+	"context"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/google/go-github/v57/github"
+)
+
+// StarRepositoryImpl implements the StarRepository interface
+type StarRepositoryImpl struct {
+	client *Client
+}
+
+// NewStarRepository creates a new StarRepository implementation
+func NewStarRepository(client *Client) repository.StarRepository {
+	return &StarRepositoryImpl{
+		client: client,
+	}
+}
+
+// ListStarred retrieves the authenticated user's starred repositories, most
+// recently pushed first
+func (r *StarRepositoryImpl) ListStarred(ctx context.Context, opts *models.StarredOptions) ([]*models.StarredRepository, error) {
+	ghOpts := &github.ActivityListStarredOptions{
+		Sort:        "updated",
+		Direction:   "desc",
+		ListOptions: github.ListOptions{PerPage: defaultPerPage},
+	}
+	if opts != nil {
+		ghOpts.Page = opts.Page
+		if opts.PerPage > 0 {
+			ghOpts.PerPage = opts.PerPage
+		}
+	}
+
+	// An empty user lists the stars of the authenticated user
+	ghStarred, resp, err := r.client.client.Activity.ListStarred(ctx, "", ghOpts)
+	if err != nil {
+		return nil, handleGitHubError(err, resp)
+	}
+
+	starred := make([]*models.StarredRepository, 0, len(ghStarred))
+	for _, s := range ghStarred {
+		if s.Repository == nil {
+			continue
+		}
+		starred = append(starred, convertToStarredRepository(s))
+	}
+	return starred, nil
+}
+
+// convertToStarredRepository converts a starred repository of the API
+func convertToStarredRepository(s *github.StarredRepository) *models.StarredRepository {
+	repo := s.Repository
+	return &models.StarredRepository{
+		Owner:       repo.GetOwner().GetLogin(),
+		Name:        repo.GetName(),
+		Description: repo.GetDescription(),
+		Language:    repo.GetLanguage(),
+		Topics:      repo.Topics,
+		HTMLURL:     repo.GetHTMLURL(),
+		Stars:       repo.GetStargazersCount(),
+		OpenIssues:  repo.GetOpenIssuesCount(),
+		Fork:        repo.GetFork(),
+		Archived:    repo.GetArchived(),
+		PushedAt:    repo.GetPushedAt().Time,
+		StarredAt:   s.GetStarredAt().Time,
+	}
+}
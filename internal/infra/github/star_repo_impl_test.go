@@ -0,0 +1,46 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func TestStarRepository_ListStarred(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user/starred" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		query := r.URL.Query()
+		if query.Get("sort") != "updated" || query.Get("direction") != "desc" || query.Get("page") != "2" || query.Get("per_page") != "50" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		_, _ = w.Write([]byte(`[
+			{"starred_at":"2026-09-01T00:00:00Z","repo":{"name":"bubbletea","owner":{"login":"charmbracelet"},
+				"description":"A TUI framework","language":"Go","stargazers_count":30000,"open_issues_count":120,
+				"archived":false,"pushed_at":"2026-10-17T12:00:00Z","html_url":"https://github.com/charmbracelet/bubbletea"}},
+			{"starred_at":"2026-08-01T00:00:00Z"}
+		]`))
+	})
+	repo := NewStarRepository(client)
+
+	starred, err := repo.ListStarred(context.Background(), &models.StarredOptions{Page: 2, PerPage: 50})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(starred) != 1 {
+		t.Fatalf("expected the entry without a repository to be skipped, got %d", len(starred))
+	}
+	got := starred[0]
+	if got.FullName() != "charmbracelet/bubbletea" || got.Language != "Go" || got.OpenIssues != 120 || got.Stars != 30000 {
+		t.Errorf("unexpected repository %+v", got)
+	}
+	if !got.PushedAt.Equal(time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)) || !got.StarredAt.Equal(time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected times %v %v", got.PushedAt, got.StarredAt)
+	}
+}
@@ -0,0 +1,158 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// Operation is the kind of work a request is part of. Each kind has its own
+// deadline (github.timeouts).
+type Operation string
+
+const (
+	// OperationList fetches a page of a list (issues, comments, search results)
+	OperationList Operation = "list"
+	// OperationDetail fetches or changes a single item
+	OperationDetail Operation = "detail"
+	// OperationMetrics fetches the history the metrics are calculated from
+	OperationMetrics Operation = "metrics"
+)
+
+// DefaultTimeouts are the deadlines of a new client; see SetTimeouts
+var DefaultTimeouts = models.TimeoutsConfig{
+	Connect: 10 * time.Second,
+	List:    30 * time.Second,
+	Detail:  20 * time.Second,
+	Metrics: time.Minute,
+}
+
+// operationKey is the context key for the operation of a request
+type operationKey struct{}
+
+// WithOperation returns a context whose requests get the deadline of op.
+// Requests without one are classified by their shape: paged GET requests are
+// lists, everything else is a detail.
+func WithOperation(ctx context.Context, op Operation) context.Context {
+	return context.WithValue(ctx, operationKey{}, op)
+}
+
+// operationOf returns the operation req is part of
+func operationOf(req *http.Request) Operation {
+	if op, ok := req.Context().Value(operationKey{}).(Operation); ok {
+		return op
+	}
+	if req.Method == http.MethodGet {
+		query := req.URL.Query()
+		if query.Has("per_page") || query.Has("page") {
+			return OperationList
+		}
+	}
+	return OperationDetail
+}
+
+// TimeoutError is returned when a request outlived the deadline of its
+// operation. The connection is abandoned, so the request can be retried.
+type TimeoutError struct {
+	Operation Operation
+	After     time.Duration
+}
+
+// Error describes which deadline was exceeded
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("%s request timed out after %s", e.Operation, e.After)
+}
+
+// Timeout implements net.Error
+func (e *TimeoutError) Timeout() bool { return true }
+
+// Temporary implements net.Error
+func (e *TimeoutError) Temporary() bool { return true }
+
+// timeoutTransport gives every attempt of a request the deadline of its
+// operation, covering the response body too. It sits below the retry
+// transport so a retry gets a fresh deadline. Streamed downloads (release
+// assets) are exempt since their size is unbounded.
+type timeoutTransport struct {
+	base     http.RoundTripper
+	timeouts models.TimeoutsConfig
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	op := operationOf(req)
+	timeout := t.deadline(op)
+	if timeout <= 0 || req.Header.Get("Accept") == "application/octet-stream" {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		// Only our deadline is reported as a timeout; a cancelled or expired
+		// caller context keeps its own error
+		if ctx.Err() == context.DeadlineExceeded && req.Context().Err() == nil {
+			return nil, &TimeoutError{Operation: op, After: timeout}
+		}
+		return nil, err
+	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, ctx: ctx, cancel: cancel, err: &TimeoutError{Operation: op, After: timeout}}
+	return resp, nil
+}
+
+// deadline returns the timeout of op (zero for none)
+func (t *timeoutTransport) deadline(op Operation) time.Duration {
+	switch op {
+	case OperationList:
+		return t.timeouts.List
+	case OperationMetrics:
+		return t.timeouts.Metrics
+	default:
+		return t.timeouts.Detail
+	}
+}
+
+// cancelOnClose releases the deadline of a request once its body is closed.
+// A body cut off by the deadline reports the timeout.
+type cancelOnClose struct {
+	io.ReadCloser
+	ctx    context.Context
+	cancel context.CancelFunc
+	err    *TimeoutError
+}
+
+// Read reads the body, reporting our deadline as a timeout
+func (b *cancelOnClose) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && err != io.EOF && b.ctx.Err() == context.DeadlineExceeded {
+		return n, b.err
+	}
+	return n, err
+}
+
+// Close closes the body and releases the deadline
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// newHTTPTransport returns the transport requests are sent with, connecting
+// within connect (no limit when zero)
+func newHTTPTransport(connect time.Duration) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	setConnectTimeout(transport, connect)
+	return transport
+}
+
+// setConnectTimeout limits how long connecting and the TLS handshake may take
+func setConnectTimeout(transport *http.Transport, connect time.Duration) {
+	transport.DialContext = (&net.Dialer{Timeout: connect, KeepAlive: 30 * time.Second}).DialContext
+	transport.TLSHandshakeTimeout = connect
+}
@@ -0,0 +1,110 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// hangingHandler answers nothing until the request is abandoned
+func hangingHandler(w http.ResponseWriter, r *http.Request) {
+	select {
+	case <-r.Context().Done():
+	case <-time.After(5 * time.Second):
+	}
+}
+
+func TestOperationOf(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		ctx    context.Context
+		want   Operation
+	}{
+		{name: "paged list", method: http.MethodGet, url: "https://api.github.com/repos/o/r/issues?per_page=30", want: OperationList},
+		{name: "next page", method: http.MethodGet, url: "https://api.github.com/repos/o/r/issues?page=2", want: OperationList},
+		{name: "single item", method: http.MethodGet, url: "https://api.github.com/repos/o/r/pulls/1", want: OperationDetail},
+		{name: "write", method: http.MethodPost, url: "https://api.github.com/repos/o/r/issues?per_page=30", want: OperationDetail},
+		{name: "marked", method: http.MethodGet, url: "https://api.github.com/repos/o/r/pulls?per_page=100", ctx: WithOperation(context.Background(), OperationMetrics), want: OperationMetrics},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			req, _ := http.NewRequestWithContext(ctx, tt.method, tt.url, nil)
+			if got := operationOf(req); got != tt.want {
+				t.Errorf("operationOf() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeoutTransport_HungRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(hangingHandler))
+	t.Cleanup(server.Close)
+	client := &http.Client{Transport: &timeoutTransport{
+		base:     http.DefaultTransport,
+		timeouts: models.TimeoutsConfig{List: 50 * time.Millisecond, Detail: 0},
+	}}
+
+	start := time.Now()
+	_, err := client.Get(server.URL + "/issues?per_page=30")
+	var timeout *TimeoutError
+	if !errors.As(err, &timeout) || timeout.Operation != OperationList || timeout.After != 50*time.Millisecond {
+		t.Fatalf("expected a list timeout, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("the request took %v to time out", elapsed)
+	}
+
+	// A cancelled caller keeps its own error
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/issues?per_page=30", nil)
+	if _, err := client.Do(req); errors.As(err, &timeout) || !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the cancellation, got %v", err)
+	}
+}
+
+func TestTimeoutTransport_HungBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("partial"))
+		w.(http.Flusher).Flush()
+		hangingHandler(w, r)
+	}))
+	t.Cleanup(server.Close)
+	client := &http.Client{Transport: &timeoutTransport{
+		base:     http.DefaultTransport,
+		timeouts: models.TimeoutsConfig{Detail: 50 * time.Millisecond},
+	}}
+
+	resp, err := client.Get(server.URL + "/pulls/1")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	defer resp.Body.Close()
+	var timeout *TimeoutError
+	if _, err := io.ReadAll(resp.Body); !errors.As(err, &timeout) {
+		t.Errorf("expected the body to time out, got %v", err)
+	}
+}
+
+func TestClientSetTimeouts(t *testing.T) {
+	client := newTestClient(t, hangingHandler)
+	client.SetTimeouts(models.TimeoutsConfig{Connect: time.Second, Detail: 50 * time.Millisecond})
+
+	_, err := NewPullRequestRepository(client).Get(context.Background(), "owner", "repo", 1)
+	var apiErr *models.APIError
+	if !errors.As(err, &apiErr) || apiErr.Kind != models.APIErrorTimeout {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+}
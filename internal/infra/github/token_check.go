@@ -0,0 +1,147 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/google/go-github/v57/github"
+)
+
+const (
+	// headerOAuthScopes lists the scopes of a classic token; fine-grained tokens omit it
+	headerOAuthScopes = "X-OAuth-Scopes"
+	// headerGitHubSSO is sent when an organization requires SAML SSO authorization
+	headerGitHubSSO = "X-GitHub-SSO"
+)
+
+// CheckToken validates the token by fetching the authenticated user and, when
+// owner and repo are given, the repository. Failures users can fix themselves
+// (invalid token, missing repo scope, SAML SSO) are returned as *models.AuthProblem.
+func (c *Client) CheckToken(ctx context.Context, owner, repo string) (*models.TokenInfo, error) {
+	user, resp, err := c.client.Users.Get(ctx, "")
+	if err != nil {
+		return nil, c.authError(err, resp)
+	}
+
+	info := &models.TokenInfo{Login: user.GetLogin()}
+	if header, ok := resp.Header[http.CanonicalHeaderKey(headerOAuthScopes)]; ok {
+		info.Scopes = parseScopes(strings.Join(header, ","))
+	} else {
+		info.FineGrained = true
+	}
+
+	if owner == "" || repo == "" {
+		return info, nil
+	}
+
+	r, resp, err := c.client.Repositories.Get(ctx, owner, repo)
+	if err != nil {
+		if problem := c.authError(err, resp); isAuthProblem(problem) {
+			return info, problem
+		}
+		// A private repository is reported as missing to tokens without the repo scope
+		if resp != nil && resp.StatusCode == http.StatusNotFound && !info.FineGrained && !hasScope(info.Scopes, "repo") {
+			return info, c.missingScope(err, "repo", fmt.Sprintf("%s/%s is not visible without the repo scope", owner, repo))
+		}
+		// Other failures (unknown repository, network) are left to the views
+		return info, nil
+	}
+
+	// public_repo is enough for public repositories
+	if !info.FineGrained && !hasScope(info.Scopes, "repo") && (r.GetPrivate() || !hasScope(info.Scopes, "public_repo")) {
+		return info, c.missingScope(nil, "repo", "the token cannot access repositories")
+	}
+	return info, nil
+}
+
+// authError maps an API failure to an AuthProblem when the user can fix it,
+// or to the usual API error otherwise. SAML SSO is detected by handleGitHubError.
+func (c *Client) authError(err error, resp *github.Response) error {
+	if resp != nil && resp.StatusCode == http.StatusUnauthorized {
+		return &models.AuthProblem{
+			Kind:    models.AuthProblemInvalidToken,
+			Message: "GitHub rejected the token (401)",
+			Hint:    "it has expired or been revoked; create a new one or run `tig-gh auth login`",
+			URL:     c.webURL("settings/tokens"),
+			Err:     err,
+		}
+	}
+	return handleGitHubError(err, resp)
+}
+
+// missingScope builds the AuthProblem for a token without scope
+func (c *Client) missingScope(err error, scope, message string) *models.AuthProblem {
+	return &models.AuthProblem{
+		Kind:    models.AuthProblemMissingScope,
+		Message: message,
+		Hint:    fmt.Sprintf("add the %s scope to the token or run `tig-gh auth login`", scope),
+		Scopes:  []string{scope},
+		URL:     c.webURL("settings/tokens"),
+		Err:     err,
+	}
+}
+
+// webURL returns a page on the GitHub web host of the client
+func (c *Client) webURL(path string) string {
+	host := "github.com"
+	if base := c.client.BaseURL; base != nil && base.Host != "api.github.com" {
+		host = base.Host
+	}
+	return "https://" + host + "/" + path
+}
+
+// ssoProblem returns the AuthProblem for a response rejected by SAML SSO, or nil
+func ssoProblem(err error, resp *github.Response) *models.AuthProblem {
+	if resp == nil || resp.Response == nil {
+		return nil
+	}
+	header := resp.Header.Get(headerGitHubSSO)
+	if !strings.HasPrefix(header, "required") {
+		return nil
+	}
+
+	problem := &models.AuthProblem{
+		Kind:    models.AuthProblemSAMLSSO,
+		Message: "the organization requires SAML SSO and the token is not authorized for it",
+		Hint:    "open the authorization page and sign in with your identity provider",
+		Err:     err,
+	}
+	// "required; url=https://github.com/orgs/ORG/sso?authorization_request=..."
+	for _, part := range strings.Split(header, ";") {
+		if value, ok := strings.CutPrefix(strings.TrimSpace(part), "url="); ok {
+			problem.URL = value
+		}
+	}
+	return problem
+}
+
+// parseScopes splits an X-OAuth-Scopes header
+func parseScopes(header string) []string {
+	var scopes []string
+	for _, scope := range strings.Split(header, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
+
+// hasScope reports whether scopes contains want
+func hasScope(scopes []string, want string) bool {
+	for _, scope := range scopes {
+		if scope == want {
+			return true
+		}
+	}
+	return false
+}
+
+// isAuthProblem reports whether err is an AuthProblem
+func isAuthProblem(err error) bool {
+	var problem *models.AuthProblem
+	return errors.As(err, &problem)
+}
@@ -0,0 +1,108 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func TestCheckToken(t *testing.T) {
+	tests := []struct {
+		name        string
+		scopes      string
+		userStatus  int
+		repoStatus  int
+		repoBody    string
+		sso         string
+		wantKind    models.AuthProblemKind
+		wantURL     string
+		fineGrained bool
+	}{
+		{name: "valid classic token", scopes: "repo, read:org", repoBody: `{"private":true}`},
+		{name: "fine-grained token", repoBody: `{"private":true}`, fineGrained: true},
+		{name: "expired token", userStatus: http.StatusUnauthorized, wantKind: models.AuthProblemInvalidToken, wantURL: "/settings/tokens"},
+		{name: "private repository without repo scope", scopes: "read:org", repoStatus: http.StatusNotFound, wantKind: models.AuthProblemMissingScope},
+		{name: "public repository with public_repo", scopes: "public_repo", repoBody: `{"private":false}`},
+		{name: "public repository without scopes", scopes: "", repoBody: `{"private":false}`, wantKind: models.AuthProblemMissingScope},
+		{name: "unknown repository", scopes: "repo", repoStatus: http.StatusNotFound},
+		{
+			name:       "SAML SSO",
+			scopes:     "repo",
+			repoStatus: http.StatusForbidden,
+			sso:        "required; url=https://github.com/orgs/acme/sso?authorization_request=abc",
+			wantKind:   models.AuthProblemSAMLSSO,
+			wantURL:    "https://github.com/orgs/acme/sso?authorization_request=abc",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/user":
+					if !tt.fineGrained {
+						w.Header().Set("X-OAuth-Scopes", tt.scopes)
+					}
+					if tt.userStatus != 0 {
+						w.WriteHeader(tt.userStatus)
+						w.Write([]byte(`{"message":"Bad credentials"}`))
+						return
+					}
+					w.Write([]byte(`{"login":"octocat"}`))
+				case "/repos/acme/app":
+					if tt.sso != "" {
+						w.Header().Set("X-GitHub-SSO", tt.sso)
+					}
+					if tt.repoStatus != 0 {
+						w.WriteHeader(tt.repoStatus)
+						w.Write([]byte(`{"message":"Not Found"}`))
+						return
+					}
+					w.Write([]byte(tt.repoBody))
+				default:
+					http.NotFound(w, r)
+				}
+			})
+
+			info, err := client.CheckToken(context.Background(), "acme", "app")
+			if tt.wantKind == "" {
+				if err != nil {
+					t.Fatalf("CheckToken() error = %v", err)
+				}
+				if info.Login != "octocat" || info.FineGrained != tt.fineGrained {
+					t.Errorf("info = %+v", info)
+				}
+				return
+			}
+
+			var problem *models.AuthProblem
+			if !errors.As(err, &problem) {
+				t.Fatalf("CheckToken() error = %v, want an AuthProblem", err)
+			}
+			if problem.Kind != tt.wantKind {
+				t.Errorf("Kind = %s, want %s", problem.Kind, tt.wantKind)
+			}
+			if tt.wantURL != "" && !strings.HasSuffix(problem.URL, tt.wantURL) {
+				t.Errorf("URL = %q, want %q", problem.URL, tt.wantURL)
+			}
+		})
+	}
+}
+
+func TestHandleGitHubError_SAMLSSO(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-GitHub-SSO", "required; url=https://github.com/orgs/acme/sso")
+	err := handleGitHubError(errors.New("boom"), newResponse(http.StatusForbidden, header))
+
+	var problem *models.AuthProblem
+	if !errors.As(err, &problem) || problem.Kind != models.AuthProblemSAMLSSO {
+		t.Fatalf("expected a SAML SSO problem, got %v", err)
+	}
+	if problem.URL != "https://github.com/orgs/acme/sso" {
+		t.Errorf("URL = %q", problem.URL)
+	}
+}
@@ -0,0 +1,34 @@
+package github
+
+import (
+	"net/http"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/infra/apiusage"
+)
+
+// headerRateResource names the rate limit a response counted against. Search
+// and GraphQL have their own budgets, so only core responses update the
+// remaining budget shown to the user.
+const headerRateResource = "X-RateLimit-Resource"
+
+// usageTransport counts every request that reached the server, attributed to
+// the source set on its context with apiusage.WithSource. It sits below the
+// retry transport so retried attempts are counted too.
+type usageTransport struct {
+	base    http.RoundTripper
+	counter *apiusage.Counter
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *usageTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if resp != nil {
+		var rate models.RateLimit
+		if resource := resp.Header.Get(headerRateResource); resource == "" || resource == "core" {
+			rate = parseRateLimitHeaders(resp.Header)
+		}
+		t.counter.Record(apiusage.Source(req.Context()), rate)
+	}
+	return resp, err
+}
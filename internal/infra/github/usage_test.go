@@ -0,0 +1,67 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/infra/apiusage"
+)
+
+func TestClientUsage_CountsCallsBySource(t *testing.T) {
+	var calls int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "5000")
+		w.Header().Set(headerRateReset, "1700000000")
+		switch r.URL.Path {
+		case "/search/issues":
+			// Search has its own budget, which must not replace the core one
+			w.Header().Set(headerRateResource, "search")
+			w.Header().Set(headerRateLimit, "30")
+			w.Header().Set(headerRateRemaining, "29")
+			w.Write([]byte(`{"items":[]}`))
+		default:
+			w.Header().Set(headerRateResource, "core")
+			if atomic.AddInt32(&calls, 1) == 1 {
+				w.Header().Set(headerRateRemaining, "4999")
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			w.Header().Set(headerRateRemaining, "4998")
+			w.Write([]byte(`{"login":"octocat"}`))
+		}
+	})
+	client.retry.sleep = func(ctx context.Context, d time.Duration) error { return nil }
+
+	ctx := apiusage.WithSource(context.Background(), "Issues")
+	if _, _, err := client.GetClient().Users.Get(ctx, "octocat"); err != nil {
+		t.Fatalf("Users.Get() error = %v", err)
+	}
+	if _, _, err := client.GetClient().Search.Issues(context.Background(), "is:open", nil); err != nil {
+		t.Fatalf("Search.Issues() error = %v", err)
+	}
+
+	snap := client.Usage().Snapshot()
+	// The retried attempt counts against the budget too
+	if snap.Total != 3 || snap.Calls("Issues") != 2 || snap.Calls(apiusage.OtherSource) != 1 {
+		t.Errorf("snapshot = %+v, want 2 Issues calls and 1 other", snap)
+	}
+	if snap.Rate.Limit != 5000 || snap.Rate.Remaining != 4998 {
+		t.Errorf("Rate = %+v, want the core budget", snap.Rate)
+	}
+}
+
+func TestNewClientForHost_SharesUsage(t *testing.T) {
+	client, err := NewClientForHost("", "https://ghe.example.com/api/v3/", "")
+	if err != nil {
+		t.Fatalf("NewClientForHost() error = %v", err)
+	}
+	if client.Usage() == nil {
+		t.Error("enterprise clients should count their calls")
+	}
+	if NewClientWithHTTPClient(http.DefaultClient).Usage() != nil {
+		t.Error("custom HTTP clients are not instrumented")
+	}
+}
@@ -0,0 +1,129 @@
+package github
+
+import (
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/google/go-github/v57/github"
+)
+
+// convertToWorkflowRun converts a GitHub workflow run to a domain workflow run
+func convertToWorkflowRun(ghRun *github.WorkflowRun) *models.WorkflowRun {
+	if ghRun == nil {
+		return nil
+	}
+
+	run := &models.WorkflowRun{
+		ID:         ghRun.GetID(),
+		Name:       ghRun.GetName(),
+		Title:      ghRun.GetDisplayTitle(),
+		RunNumber:  ghRun.GetRunNumber(),
+		RunAttempt: ghRun.GetRunAttempt(),
+		Event:      ghRun.GetEvent(),
+		Status:     models.WorkflowStatus(ghRun.GetStatus()),
+		Conclusion: models.WorkflowConclusion(ghRun.GetConclusion()),
+		HeadBranch: ghRun.GetHeadBranch(),
+		HeadSHA:    ghRun.GetHeadSHA(),
+		Actor:      convertToUser(ghRun.Actor),
+		HTMLURL:    ghRun.GetHTMLURL(),
+		CreatedAt:  ghRun.GetCreatedAt().Time,
+		UpdatedAt:  ghRun.GetUpdatedAt().Time,
+	}
+
+	if ghRun.RunStartedAt != nil {
+		startedAt := ghRun.RunStartedAt.Time
+		run.StartedAt = &startedAt
+	}
+
+	return run
+}
+
+// convertToWorkflowRuns converts a slice of GitHub workflow runs to domain workflow runs
+func convertToWorkflowRuns(ghRuns []*github.WorkflowRun) []*models.WorkflowRun {
+	if len(ghRuns) == 0 {
+		return nil
+	}
+
+	runs := make([]*models.WorkflowRun, 0, len(ghRuns))
+	for _, ghRun := range ghRuns {
+		if run := convertToWorkflowRun(ghRun); run != nil {
+			runs = append(runs, run)
+		}
+	}
+
+	return runs
+}
+
+// convertToWorkflowJob converts a GitHub workflow job to a domain workflow job
+func convertToWorkflowJob(ghJob *github.WorkflowJob) *models.WorkflowJob {
+	if ghJob == nil {
+		return nil
+	}
+
+	job := &models.WorkflowJob{
+		ID:         ghJob.GetID(),
+		RunID:      ghJob.GetRunID(),
+		Name:       ghJob.GetName(),
+		Status:     models.WorkflowStatus(ghJob.GetStatus()),
+		Conclusion: models.WorkflowConclusion(ghJob.GetConclusion()),
+		HTMLURL:    ghJob.GetHTMLURL(),
+	}
+
+	if ghJob.StartedAt != nil {
+		startedAt := ghJob.StartedAt.Time
+		job.StartedAt = &startedAt
+	}
+	if ghJob.CompletedAt != nil {
+		completedAt := ghJob.CompletedAt.Time
+		job.CompletedAt = &completedAt
+	}
+
+	for _, ghStep := range ghJob.Steps {
+		if ghStep == nil {
+			continue
+		}
+		job.Steps = append(job.Steps, &models.WorkflowStep{
+			Number:     ghStep.GetNumber(),
+			Name:       ghStep.GetName(),
+			Status:     models.WorkflowStatus(ghStep.GetStatus()),
+			Conclusion: models.WorkflowConclusion(ghStep.GetConclusion()),
+		})
+	}
+
+	return job
+}
+
+// convertToWorkflowJobs converts a slice of GitHub workflow jobs to domain workflow jobs
+func convertToWorkflowJobs(ghJobs []*github.WorkflowJob) []*models.WorkflowJob {
+	if len(ghJobs) == 0 {
+		return nil
+	}
+
+	jobs := make([]*models.WorkflowJob, 0, len(ghJobs))
+	for _, ghJob := range ghJobs {
+		if job := convertToWorkflowJob(ghJob); job != nil {
+			jobs = append(jobs, job)
+		}
+	}
+
+	return jobs
+}
+
+// convertFromWorkflowRunOptions converts domain workflow run options to GitHub list options
+func convertFromWorkflowRunOptions(opts *models.WorkflowRunOptions) *github.ListWorkflowRunsOptions {
+	if opts == nil {
+		return &github.ListWorkflowRunsOptions{ListOptions: github.ListOptions{PerPage: defaultPerPage}}
+	}
+
+	ghOpts := &github.ListWorkflowRunsOptions{
+		Branch: opts.Branch,
+		Status: opts.Status,
+		ListOptions: github.ListOptions{
+			Page:    opts.Page,
+			PerPage: opts.PerPage,
+		},
+	}
+	if ghOpts.PerPage == 0 {
+		ghOpts.PerPage = defaultPerPage
+	}
+
+	return ghOpts
+}
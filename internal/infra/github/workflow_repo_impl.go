@@ -0,0 +1,107 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/google/go-github/v57/github"
+)
+
+// maxJobLogBytes caps how much of a job log is kept; the end of the log is the interesting part
+const maxJobLogBytes = 1 << 20
+
+// WorkflowRepositoryImpl implements the WorkflowRepository interface
+type WorkflowRepositoryImpl struct {
+	client *Client
+}
+
+// NewWorkflowRepository creates a new WorkflowRepository implementation
+func NewWorkflowRepository(client *Client) repository.WorkflowRepository {
+	return &WorkflowRepositoryImpl{
+		client: client,
+	}
+}
+
+// ListRuns retrieves the recent workflow runs of a repository
+func (r *WorkflowRepositoryImpl) ListRuns(ctx context.Context, owner, repo string, opts *models.WorkflowRunOptions) ([]*models.WorkflowRun, error) {
+	ghRuns, resp, err := r.client.client.Actions.ListRepositoryWorkflowRuns(ctx, owner, repo, convertFromWorkflowRunOptions(opts))
+	if err != nil {
+		return nil, handleGitHubError(err, resp)
+	}
+
+	return convertToWorkflowRuns(ghRuns.WorkflowRuns), nil
+}
+
+// ListJobs retrieves the jobs of the latest attempt of a workflow run
+func (r *WorkflowRepositoryImpl) ListJobs(ctx context.Context, owner, repo string, runID int64) ([]*models.WorkflowJob, error) {
+	ghJobs, resp, err := r.client.client.Actions.ListWorkflowJobs(ctx, owner, repo, runID, &github.ListWorkflowJobsOptions{
+		Filter:      "latest",
+		ListOptions: github.ListOptions{PerPage: 100},
+	})
+	if err != nil {
+		return nil, handleGitHubError(err, resp)
+	}
+
+	return convertToWorkflowJobs(ghJobs.Jobs), nil
+}
+
+// GetJobLogs retrieves the plain text log of a job
+func (r *WorkflowRepositoryImpl) GetJobLogs(ctx context.Context, owner, repo string, jobID int64) (string, error) {
+	logURL, resp, err := r.client.client.Actions.GetWorkflowJobLogs(ctx, owner, repo, jobID, 1)
+	if err != nil {
+		return "", handleGitHubError(err, resp)
+	}
+
+	// Logs are served from a redirect to storage; fetch them without the API credentials
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, logURL.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create log request: %w", err)
+	}
+	logResp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to download job log: %w", err)
+	}
+	defer logResp.Body.Close()
+
+	if logResp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to download job log: %s", logResp.Status)
+	}
+
+	data, err := io.ReadAll(logResp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read job log: %w", err)
+	}
+	if len(data) > maxJobLogBytes {
+		data = data[len(data)-maxJobLogBytes:]
+	}
+
+	return string(data), nil
+}
+
+// RerunFailedJobs re-runs the failed jobs of a workflow run
+func (r *WorkflowRepositoryImpl) RerunFailedJobs(ctx context.Context, owner, repo string, runID int64) error {
+	resp, err := r.client.client.Actions.RerunFailedJobsByID(ctx, owner, repo, runID)
+	if err != nil {
+		return handleGitHubError(err, resp)
+	}
+
+	return nil
+}
+
+// CancelRun cancels an in-progress workflow run
+func (r *WorkflowRepositoryImpl) CancelRun(ctx context.Context, owner, repo string, runID int64) error {
+	resp, err := r.client.client.Actions.CancelWorkflowRunByID(ctx, owner, repo, runID)
+	if err != nil {
+		// The cancel endpoint answers 202 Accepted, which go-github reports as an AcceptedError
+		if _, ok := err.(*github.AcceptedError); ok {
+			return nil
+		}
+		return handleGitHubError(err, resp)
+	}
+
+	return nil
+}
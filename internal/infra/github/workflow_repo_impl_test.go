@@ -0,0 +1,97 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func TestWorkflowRepository_ListRuns(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/owner/repo/actions/runs" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if got := r.URL.Query().Get("branch"); got != "main" {
+			t.Errorf("branch = %q, want main", got)
+		}
+		_, _ = w.Write([]byte(`{"total_count":1,"workflow_runs":[{"id":10,"name":"CI","run_number":42,
+			"status":"completed","conclusion":"failure","head_branch":"main","event":"push",
+			"actor":{"login":"octocat"},"run_started_at":"2026-10-01T10:00:00Z","updated_at":"2026-10-01T10:05:00Z"}]}`))
+	})
+	repo := NewWorkflowRepository(client)
+
+	runs, err := repo.ListRuns(context.Background(), "owner", "repo", &models.WorkflowRunOptions{Branch: "main"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(runs) != 1 {
+		t.Fatalf("got %d runs, want 1", len(runs))
+	}
+	run := runs[0]
+	if run.ID != 10 || run.RunNumber != 42 || run.Actor.Login != "octocat" || !run.Failed() {
+		t.Errorf("unexpected run %+v", run)
+	}
+	if got := run.Duration(run.UpdatedAt); got.Minutes() != 5 {
+		t.Errorf("duration = %v, want 5m", got)
+	}
+}
+
+func TestWorkflowRepository_GetJobLogs(t *testing.T) {
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Error("log storage must not receive the API credentials")
+		}
+		_, _ = w.Write([]byte("step 1\nstep 2\n"))
+	}))
+	defer storage.Close()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/owner/repo/actions/jobs/7/logs" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		http.Redirect(w, r, storage.URL+"/log.txt", http.StatusFound)
+	})
+	repo := NewWorkflowRepository(client)
+
+	log, err := repo.GetJobLogs(context.Background(), "owner", "repo", 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if log != "step 1\nstep 2\n" {
+		t.Errorf("log = %q", log)
+	}
+}
+
+func TestWorkflowRepository_RerunAndCancel(t *testing.T) {
+	var calls []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch r.URL.Path {
+		case "/repos/owner/repo/actions/runs/10/rerun-failed-jobs":
+			w.WriteHeader(http.StatusCreated)
+		case "/repos/owner/repo/actions/runs/10/cancel":
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	repo := NewWorkflowRepository(client)
+
+	if err := repo.RerunFailedJobs(context.Background(), "owner", "repo", 10); err != nil {
+		t.Errorf("RerunFailedJobs: unexpected error: %v", err)
+	}
+	if err := repo.CancelRun(context.Background(), "owner", "repo", 10); err != nil {
+		t.Errorf("CancelRun: unexpected error: %v", err)
+	}
+	if len(calls) != 2 || calls[0] != "POST /repos/owner/repo/actions/runs/10/rerun-failed-jobs" || calls[1] != "POST /repos/owner/repo/actions/runs/10/cancel" {
+		t.Errorf("unexpected calls %v", calls)
+	}
+}
@@ -0,0 +1,26 @@
+package notify
+
+import (
+	"github.com/gen2brain/beeep"
+)
+
+// appName is shown as the sender of the notifications where the platform supports it
+const appName = "tig-gh"
+
+// Desktop shows notifications with the platform's notification service
+// (libnotify over D-Bus, the macOS notification center or Windows toasts)
+type Desktop struct {
+	notify func(title, message string, icon any) error
+}
+
+// NewDesktop creates a desktop notifier
+func NewDesktop() *Desktop {
+	beeep.AppName = appName
+	return &Desktop{notify: beeep.Notify}
+}
+
+// Notify shows a notification. It fails when the session has no notification
+// service, e.g. over SSH.
+func (d *Desktop) Notify(title, message string) error {
+	return d.notify(title, message, "")
+}
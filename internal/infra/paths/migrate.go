@@ -0,0 +1,38 @@
+package paths
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// MigrateState は以前の場所 from にある記録 names を to に移し、移したものを返す
+// to に同名のものが既にあれば移さない（新しい場所の内容を優先する）
+func MigrateState(from, to string, names ...string) ([]string, error) {
+	if from == "" || to == "" || filepath.Clean(from) == filepath.Clean(to) {
+		return nil, nil
+	}
+
+	var moved []string
+	var errs []error
+	for _, name := range names {
+		src := filepath.Join(from, name)
+		dst := filepath.Join(to, name)
+		if _, err := os.Stat(src); err != nil {
+			continue
+		}
+		if _, err := os.Stat(dst); err == nil {
+			continue
+		}
+		if err := os.MkdirAll(to, 0o755); err != nil {
+			return moved, fmt.Errorf("failed to create state directory: %w", err)
+		}
+		if err := os.Rename(src, dst); err != nil {
+			errs = append(errs, fmt.Errorf("failed to move %s to %s: %w", src, to, err))
+			continue
+		}
+		moved = append(moved, name)
+	}
+	return moved, errors.Join(errs...)
+}
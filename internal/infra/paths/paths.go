@@ -0,0 +1,108 @@
+package paths
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// AppName はディレクトリ名に使用するアプリケーション名
+const AppName = "tig-gh"
+
+// テストから差し替え可能なOS依存の関数
+var (
+	goos          = runtime.GOOS
+	userHomeDir   = os.UserHomeDir
+	userConfigDir = os.UserConfigDir
+	userCacheDir  = os.UserCacheDir
+	getenv        = os.Getenv
+)
+
+// ExpandPath は先頭の "~" をホームディレクトリに展開する
+// "~/foo" と "~\foo" (Windows) の両方に対応し、"~user" 形式は展開しない
+func ExpandPath(path string) string {
+	if path == "" || path[0] != '~' {
+		return path
+	}
+
+	rest := path[1:]
+	if rest != "" && rest[0] != '/' && rest[0] != '\\' {
+		return path
+	}
+
+	home, err := userHomeDir()
+	if err != nil {
+		return path
+	}
+
+	rest = strings.TrimLeft(rest, `/\`)
+	if rest == "" {
+		return home
+	}
+	return filepath.Join(home, filepath.FromSlash(strings.ReplaceAll(rest, `\`, "/")))
+}
+
+// ConfigDir は設定ファイルを置くディレクトリを返す
+// Windows では %AppData%\tig-gh、それ以外では $XDG_CONFIG_HOME/tig-gh（未設定なら ~/.config/tig-gh）を使用する
+func ConfigDir() (string, error) {
+	if goos == "windows" {
+		dir, err := userConfigDir()
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(dir, AppName), nil
+	}
+
+	return xdgDir("XDG_CONFIG_HOME", ".config")
+}
+
+// CacheDir はキャッシュを置くディレクトリを返す
+// Windows では %LocalAppData%\tig-gh、それ以外では $XDG_CACHE_HOME/tig-gh（未設定なら ~/.cache/tig-gh）を使用する
+func CacheDir() (string, error) {
+	if goos == "windows" {
+		dir, err := userCacheDir()
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(dir, AppName), nil
+	}
+
+	return xdgDir("XDG_CACHE_HOME", ".cache")
+}
+
+// StateDir は閲覧済みファイルやウォッチ一覧など、消えると困る記録を置くディレクトリを返す
+// 削除してよいキャッシュとは分けている
+// Windows では %LocalAppData%\tig-gh\state、それ以外では $XDG_STATE_HOME/tig-gh（未設定なら ~/.local/state/tig-gh）を使用する
+func StateDir() (string, error) {
+	if goos == "windows" {
+		dir, err := userCacheDir()
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(dir, AppName, "state"), nil
+	}
+
+	return xdgDir("XDG_STATE_HOME", filepath.Join(".local", "state"))
+}
+
+// xdgDir は環境変数 env が指すディレクトリ配下の tig-gh を返す
+// XDG Base Directory の仕様どおり、未設定や相対パスの場合はホームディレクトリ配下の fallback を使う
+func xdgDir(env, fallback string) (string, error) {
+	if dir := getenv(env); filepath.IsAbs(dir) {
+		return filepath.Join(dir, AppName), nil
+	}
+	home, err := userHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, fallback, AppName), nil
+}
+
+// SystemConfigDir はシステムワイドの設定ディレクトリを返す（存在しないOSでは空文字）
+func SystemConfigDir() string {
+	if goos == "windows" {
+		return ""
+	}
+	return filepath.Join("/etc", AppName)
+}
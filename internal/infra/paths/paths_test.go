@@ -0,0 +1,157 @@
+package paths
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func withPlatform(t *testing.T, os, home string) {
+	t.Helper()
+	origGOOS, origHome, origConfig, origCache, origGetenv := goos, userHomeDir, userConfigDir, userCacheDir, getenv
+	t.Cleanup(func() {
+		goos, userHomeDir, userConfigDir, userCacheDir, getenv = origGOOS, origHome, origConfig, origCache, origGetenv
+	})
+	getenv = func(string) string { return "" }
+
+	goos = os
+	userHomeDir = func() (string, error) { return home, nil }
+	userConfigDir = func() (string, error) { return filepath.Join(home, "AppData", "Roaming"), nil }
+	userCacheDir = func() (string, error) { return filepath.Join(home, "AppData", "Local"), nil }
+}
+
+func TestExpandPath(t *testing.T) {
+	home := filepath.Join("home", "user")
+	withPlatform(t, "linux", home)
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "empty", in: "", want: ""},
+		{name: "tilde only", in: "~", want: home},
+		{name: "unix separator", in: "~/.cache/tig-gh", want: filepath.Join(home, ".cache", "tig-gh")},
+		{name: "windows separator", in: `~\cache\tig-gh`, want: filepath.Join(home, "cache", "tig-gh")},
+		{name: "other user untouched", in: "~other/dir", want: "~other/dir"},
+		{name: "absolute untouched", in: "/var/tmp", want: "/var/tmp"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExpandPath(tt.in); got != tt.want {
+				t.Errorf("ExpandPath(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandPath_HomeError(t *testing.T) {
+	withPlatform(t, "linux", "")
+	userHomeDir = func() (string, error) { return "", errors.New("no home") }
+
+	if got := ExpandPath("~/x"); got != "~/x" {
+		t.Errorf("expected path to be returned unchanged, got %q", got)
+	}
+}
+
+func TestConfigAndCacheDir(t *testing.T) {
+	home := filepath.Join("home", "user")
+
+	tests := []struct {
+		goos       string
+		wantConfig string
+		wantCache  string
+		wantState  string
+		wantSystem string
+	}{
+		{
+			goos:       "linux",
+			wantConfig: filepath.Join(home, ".config", "tig-gh"),
+			wantCache:  filepath.Join(home, ".cache", "tig-gh"),
+			wantState:  filepath.Join(home, ".local", "state", "tig-gh"),
+			wantSystem: filepath.Join("/etc", "tig-gh"),
+		},
+		{
+			goos:       "windows",
+			wantConfig: filepath.Join(home, "AppData", "Roaming", "tig-gh"),
+			wantCache:  filepath.Join(home, "AppData", "Local", "tig-gh"),
+			wantState:  filepath.Join(home, "AppData", "Local", "tig-gh", "state"),
+			wantSystem: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.goos, func(t *testing.T) {
+			withPlatform(t, tt.goos, home)
+
+			config, err := ConfigDir()
+			if err != nil || config != tt.wantConfig {
+				t.Errorf("ConfigDir() = %q, %v; want %q", config, err, tt.wantConfig)
+			}
+			cache, err := CacheDir()
+			if err != nil || cache != tt.wantCache {
+				t.Errorf("CacheDir() = %q, %v; want %q", cache, err, tt.wantCache)
+			}
+			state, err := StateDir()
+			if err != nil || state != tt.wantState {
+				t.Errorf("StateDir() = %q, %v; want %q", state, err, tt.wantState)
+			}
+			if got := SystemConfigDir(); got != tt.wantSystem {
+				t.Errorf("SystemConfigDir() = %q, want %q", got, tt.wantSystem)
+			}
+		})
+	}
+}
+
+func TestXDGBaseDirectories(t *testing.T) {
+	home := filepath.Join("home", "user")
+	withPlatform(t, "linux", home)
+	env := map[string]string{
+		"XDG_CONFIG_HOME": "/xdg/config",
+		"XDG_CACHE_HOME":  "/xdg/cache",
+		"XDG_STATE_HOME":  "relative/state", // ignored: the spec requires absolute paths
+	}
+	getenv = func(key string) string { return env[key] }
+
+	if dir, _ := ConfigDir(); dir != filepath.Join("/xdg/config", "tig-gh") {
+		t.Errorf("ConfigDir() = %q", dir)
+	}
+	if dir, _ := CacheDir(); dir != filepath.Join("/xdg/cache", "tig-gh") {
+		t.Errorf("CacheDir() = %q", dir)
+	}
+	if dir, _ := StateDir(); dir != filepath.Join(home, ".local", "state", "tig-gh") {
+		t.Errorf("StateDir() = %q", dir)
+	}
+}
+
+func TestMigrateState(t *testing.T) {
+	from := t.TempDir()
+	to := filepath.Join(t.TempDir(), "state")
+	if err := os.WriteFile(filepath.Join(from, "watched.json"), []byte("[]"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(from, "viewed"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	moved, err := MigrateState(from, to, "viewed", "watched.json", "missing")
+	if err != nil || len(moved) != 2 {
+		t.Fatalf("MigrateState() = %v, %v; want viewed and watched.json moved", moved, err)
+	}
+	if _, err := os.Stat(filepath.Join(to, "watched.json")); err != nil {
+		t.Errorf("expected watched.json in the new directory: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(from, "viewed")); !os.IsNotExist(err) {
+		t.Errorf("expected viewed moved away from the old directory")
+	}
+
+	// Files already in the new directory win over old copies
+	if err := os.WriteFile(filepath.Join(from, "watched.json"), []byte("old"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if moved, err := MigrateState(from, to, "watched.json"); err != nil || len(moved) != 0 {
+		t.Errorf("MigrateState() = %v, %v; want nothing moved", moved, err)
+	}
+}
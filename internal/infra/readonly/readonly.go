@@ -0,0 +1,195 @@
+// Package readonly wraps repositories so that every write operation fails with
+// repository.ErrReadOnly. It is used for guest sessions without a GitHub token.
+package readonly
+
+import (
+	"context"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+)
+
+// IssueRepository delegates reads to the wrapped repository and rejects writes
+type IssueRepository struct {
+	repository.IssueRepository
+}
+
+// NewIssueRepository creates a read-only issue repository
+func NewIssueRepository(repo repository.IssueRepository) repository.IssueRepository {
+	return &IssueRepository{IssueRepository: repo}
+}
+
+// ReadOnly reports that write operations are disabled
+func (r *IssueRepository) ReadOnly() bool {
+	return true
+}
+
+// Create rejects issue creation
+func (r *IssueRepository) Create(ctx context.Context, owner, repo string, input *models.CreateIssueInput) (*models.Issue, error) {
+	return nil, repository.ErrReadOnly
+}
+
+// Update rejects issue updates
+func (r *IssueRepository) Update(ctx context.Context, owner, repo string, number int, input *models.UpdateIssueInput) (*models.Issue, error) {
+	return nil, repository.ErrReadOnly
+}
+
+// Close rejects closing an issue
+func (r *IssueRepository) Close(ctx context.Context, owner, repo string, number int) error {
+	return repository.ErrReadOnly
+}
+
+// Reopen rejects reopening an issue
+func (r *IssueRepository) Reopen(ctx context.Context, owner, repo string, number int) error {
+	return repository.ErrReadOnly
+}
+
+// Lock rejects locking an issue
+func (r *IssueRepository) Lock(ctx context.Context, owner, repo string, number int) error {
+	return repository.ErrReadOnly
+}
+
+// Unlock rejects unlocking an issue
+func (r *IssueRepository) Unlock(ctx context.Context, owner, repo string, number int) error {
+	return repository.ErrReadOnly
+}
+
+// AddReaction rejects adding a reaction
+func (r *IssueRepository) AddReaction(ctx context.Context, owner, repo string, number int, commentID int64, content models.ReactionContent) error {
+	return repository.ErrReadOnly
+}
+
+// PullRequestRepository delegates reads to the wrapped repository and rejects writes
+type PullRequestRepository struct {
+	repository.PullRequestRepository
+}
+
+// NewPullRequestRepository creates a read-only pull request repository
+func NewPullRequestRepository(repo repository.PullRequestRepository) repository.PullRequestRepository {
+	return &PullRequestRepository{PullRequestRepository: repo}
+}
+
+// ReadOnly reports that write operations are disabled
+func (r *PullRequestRepository) ReadOnly() bool {
+	return true
+}
+
+// Create rejects pull request creation
+func (r *PullRequestRepository) Create(ctx context.Context, owner, repo string, input *models.CreatePRInput) (*models.PullRequest, error) {
+	return nil, repository.ErrReadOnly
+}
+
+// Update rejects pull request updates
+func (r *PullRequestRepository) Update(ctx context.Context, owner, repo string, number int, input *models.UpdatePRInput) (*models.PullRequest, error) {
+	return nil, repository.ErrReadOnly
+}
+
+// Merge rejects merging a pull request
+func (r *PullRequestRepository) Merge(ctx context.Context, owner, repo string, number int, opts *models.MergeOptions) error {
+	return repository.ErrReadOnly
+}
+
+// Close rejects closing a pull request
+func (r *PullRequestRepository) Close(ctx context.Context, owner, repo string, number int) error {
+	return repository.ErrReadOnly
+}
+
+// Reopen rejects reopening a pull request
+func (r *PullRequestRepository) Reopen(ctx context.Context, owner, repo string, number int) error {
+	return repository.ErrReadOnly
+}
+
+// SetLabels rejects label changes
+func (r *PullRequestRepository) SetLabels(ctx context.Context, owner, repo string, number int, labels []string) ([]models.Label, error) {
+	return nil, repository.ErrReadOnly
+}
+
+// CreateReview rejects submitting a review
+func (r *PullRequestRepository) CreateReview(ctx context.Context, owner, repo string, number int, input *models.CreateReviewInput) (*models.Review, error) {
+	return nil, repository.ErrReadOnly
+}
+
+// ConvertDraft rejects toggling the draft state
+func (r *PullRequestRepository) ConvertDraft(ctx context.Context, owner, repo string, number int, draft bool) (*models.PullRequest, error) {
+	return nil, repository.ErrReadOnly
+}
+
+// RequestReviewers rejects requesting reviews
+func (r *PullRequestRepository) RequestReviewers(ctx context.Context, owner, repo string, number int, request *models.ReviewRequest) (*models.PullRequest, error) {
+	return nil, repository.ErrReadOnly
+}
+
+// UpdateBranch rejects updating the head branch
+func (r *PullRequestRepository) UpdateBranch(ctx context.Context, owner, repo string, number int, expectedHeadSHA string) error {
+	return repository.ErrReadOnly
+}
+
+// CommitSuggestion rejects committing a suggested change
+func (r *PullRequestRepository) CommitSuggestion(ctx context.Context, owner, repo string, number int, input *models.SuggestionInput) error {
+	return repository.ErrReadOnly
+}
+
+// ReleaseRepository delegates reads to the wrapped repository and rejects writes
+type ReleaseRepository struct {
+	repository.ReleaseRepository
+}
+
+// NewReleaseRepository creates a read-only release repository
+func NewReleaseRepository(repo repository.ReleaseRepository) repository.ReleaseRepository {
+	return &ReleaseRepository{ReleaseRepository: repo}
+}
+
+// ReadOnly reports that write operations are disabled
+func (r *ReleaseRepository) ReadOnly() bool {
+	return true
+}
+
+// Create rejects release creation
+func (r *ReleaseRepository) Create(ctx context.Context, owner, repo string, input *models.CreateReleaseInput) (*models.Release, error) {
+	return nil, repository.ErrReadOnly
+}
+
+// GistRepository delegates reads to the wrapped repository and rejects writes
+type GistRepository struct {
+	repository.GistRepository
+}
+
+// NewGistRepository creates a read-only gist repository
+func NewGistRepository(repo repository.GistRepository) repository.GistRepository {
+	return &GistRepository{GistRepository: repo}
+}
+
+// ReadOnly reports that write operations are disabled
+func (r *GistRepository) ReadOnly() bool {
+	return true
+}
+
+// Create rejects gist creation
+func (r *GistRepository) Create(ctx context.Context, input *models.CreateGistInput) (*models.Gist, error) {
+	return nil, repository.ErrReadOnly
+}
+
+// WorkflowRepository delegates reads to the wrapped repository and rejects writes
+type WorkflowRepository struct {
+	repository.WorkflowRepository
+}
+
+// NewWorkflowRepository creates a read-only workflow repository
+func NewWorkflowRepository(repo repository.WorkflowRepository) repository.WorkflowRepository {
+	return &WorkflowRepository{WorkflowRepository: repo}
+}
+
+// ReadOnly reports that write operations are disabled
+func (r *WorkflowRepository) ReadOnly() bool {
+	return true
+}
+
+// RerunFailedJobs rejects re-running a workflow run
+func (r *WorkflowRepository) RerunFailedJobs(ctx context.Context, owner, repo string, runID int64) error {
+	return repository.ErrReadOnly
+}
+
+// CancelRun rejects cancelling a workflow run
+func (r *WorkflowRepository) CancelRun(ctx context.Context, owner, repo string, runID int64) error {
+	return repository.ErrReadOnly
+}
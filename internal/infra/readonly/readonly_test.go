@@ -0,0 +1,145 @@
+package readonly
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPullRequestRepository_RejectsWrites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	base := mock.NewMockPullRequestRepository(ctrl)
+	base.EXPECT().Get(gomock.Any(), "owner", "repo", 1).Return(&models.PullRequest{Number: 1}, nil)
+
+	repo := NewPullRequestRepository(base)
+	ctx := context.Background()
+
+	if !repository.IsReadOnly(repo) {
+		t.Fatal("expected repository to report read-only")
+	}
+	if pr, err := repo.Get(ctx, "owner", "repo", 1); err != nil || pr.Number != 1 {
+		t.Fatalf("expected reads to be delegated, got %v, %v", pr, err)
+	}
+
+	writes := map[string]error{
+		"Merge":  repo.Merge(ctx, "owner", "repo", 1, nil),
+		"Close":  repo.Close(ctx, "owner", "repo", 1),
+		"Reopen": repo.Reopen(ctx, "owner", "repo", 1),
+	}
+	writes["UpdateBranch"] = repo.UpdateBranch(ctx, "owner", "repo", 1, "")
+	_, writes["CreateReview"] = repo.CreateReview(ctx, "owner", "repo", 1, &models.CreateReviewInput{})
+	_, writes["SetLabels"] = repo.SetLabels(ctx, "owner", "repo", 1, nil)
+	_, writes["ConvertDraft"] = repo.ConvertDraft(ctx, "owner", "repo", 1, false)
+	_, writes["Update"] = repo.Update(ctx, "owner", "repo", 1, &models.UpdatePRInput{})
+	_, writes["Create"] = repo.Create(ctx, "owner", "repo", &models.CreatePRInput{})
+
+	for name, err := range writes {
+		if !errors.Is(err, repository.ErrReadOnly) {
+			t.Errorf("%s: expected ErrReadOnly, got %v", name, err)
+		}
+	}
+}
+
+func TestIssueRepository_RejectsWrites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	base := mock.NewMockIssueRepository(ctrl)
+	base.EXPECT().ListComments(gomock.Any(), "owner", "repo", 1, nil).Return(nil, nil)
+
+	repo := NewIssueRepository(base)
+	ctx := context.Background()
+
+	if !repository.IsReadOnly(repo) {
+		t.Fatal("expected repository to report read-only")
+	}
+	if _, err := repo.ListComments(ctx, "owner", "repo", 1, nil); err != nil {
+		t.Fatalf("expected reads to be delegated, got %v", err)
+	}
+
+	writes := map[string]error{
+		"AddReaction": repo.AddReaction(ctx, "owner", "repo", 1, 10, models.ReactionHeart),
+		"Close":       repo.Close(ctx, "owner", "repo", 1),
+		"Reopen":      repo.Reopen(ctx, "owner", "repo", 1),
+		"Lock":        repo.Lock(ctx, "owner", "repo", 1),
+		"Unlock":      repo.Unlock(ctx, "owner", "repo", 1),
+	}
+	_, writes["Create"] = repo.Create(ctx, "owner", "repo", &models.CreateIssueInput{})
+	_, writes["Update"] = repo.Update(ctx, "owner", "repo", 1, &models.UpdateIssueInput{})
+
+	for name, err := range writes {
+		if !errors.Is(err, repository.ErrReadOnly) {
+			t.Errorf("%s: expected ErrReadOnly, got %v", name, err)
+		}
+	}
+}
+
+func TestReleaseRepository_RejectsWrites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	base := mock.NewMockReleaseRepository(ctrl)
+	base.EXPECT().List(gomock.Any(), "owner", "repo", nil).Return([]*models.Release{{TagName: "v1.0.0"}}, nil)
+
+	repo := NewReleaseRepository(base)
+	ctx := context.Background()
+
+	if !repository.IsReadOnly(repo) {
+		t.Fatal("expected repository to report read-only")
+	}
+	if releases, err := repo.List(ctx, "owner", "repo", nil); err != nil || len(releases) != 1 {
+		t.Fatalf("expected reads to be delegated, got %v, %v", releases, err)
+	}
+	if _, err := repo.Create(ctx, "owner", "repo", &models.CreateReleaseInput{TagName: "v1.1.0"}); !errors.Is(err, repository.ErrReadOnly) {
+		t.Errorf("Create: expected ErrReadOnly, got %v", err)
+	}
+}
+
+func TestGistRepository_RejectsWrites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	base := mock.NewMockGistRepository(ctrl)
+	base.EXPECT().Get(gomock.Any(), "abc").Return(&models.Gist{ID: "abc"}, nil)
+
+	repo := NewGistRepository(base)
+	ctx := context.Background()
+
+	if !repository.IsReadOnly(repo) {
+		t.Fatal("expected repository to report read-only")
+	}
+	if gist, err := repo.Get(ctx, "abc"); err != nil || gist.ID != "abc" {
+		t.Fatalf("expected reads to be delegated, got %v, %v", gist, err)
+	}
+	if _, err := repo.Create(ctx, &models.CreateGistInput{Files: map[string]string{"a.go": "package a"}}); !errors.Is(err, repository.ErrReadOnly) {
+		t.Errorf("Create: expected ErrReadOnly, got %v", err)
+	}
+}
+
+func TestWorkflowRepository_RejectsWrites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	base := mock.NewMockWorkflowRepository(ctrl)
+	base.EXPECT().ListJobs(gomock.Any(), "owner", "repo", int64(1)).Return([]*models.WorkflowJob{{ID: 2}}, nil)
+
+	repo := NewWorkflowRepository(base)
+	ctx := context.Background()
+
+	if !repository.IsReadOnly(repo) {
+		t.Fatal("expected repository to report read-only")
+	}
+	if jobs, err := repo.ListJobs(ctx, "owner", "repo", 1); err != nil || len(jobs) != 1 {
+		t.Fatalf("expected reads to be delegated, got %v, %v", jobs, err)
+	}
+	if err := repo.RerunFailedJobs(ctx, "owner", "repo", 1); !errors.Is(err, repository.ErrReadOnly) {
+		t.Errorf("RerunFailedJobs: expected ErrReadOnly, got %v", err)
+	}
+	if err := repo.CancelRun(ctx, "owner", "repo", 1); !errors.Is(err, repository.ErrReadOnly) {
+		t.Errorf("CancelRun: expected ErrReadOnly, got %v", err)
+	}
+}
+
+func TestIsReadOnly_PlainRepository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	if repository.IsReadOnly(mock.NewMockIssueRepository(ctrl)) {
+		t.Error("expected plain repository to be writable")
+	}
+}
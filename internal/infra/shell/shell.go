@@ -0,0 +1,88 @@
+package shell
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os/exec"
+	"runtime"
+	"time"
+)
+
+// waitDelay is how long the output is drained after the command exited or was
+// stopped; a background process still holding the pipe is cut off after it
+const waitDelay = 2 * time.Second
+
+// maxLineLength is the longest output line kept whole; longer lines are split
+const maxLineLength = 1024 * 1024
+
+// Run is a shell command running in the background whose combined stdout and
+// stderr are streamed line by line
+type Run struct {
+	lines  chan string
+	cancel context.CancelFunc
+	err    error
+}
+
+// Start runs command with the platform shell (sh -c, or cmd /C on Windows) in
+// dir. Cancelling ctx or calling Stop kills the command.
+func Start(ctx context.Context, dir, command string) (*Run, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	name, args := shellCommand(runtime.GOOS, command)
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	cmd.WaitDelay = waitDelay
+
+	reader, writer := io.Pipe()
+	cmd.Stdout = writer
+	cmd.Stderr = writer
+	if err := cmd.Start(); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	run := &Run{lines: make(chan string, 64), cancel: cancel}
+	go func() {
+		writer.CloseWithError(cmd.Wait())
+	}()
+	go func() {
+		defer cancel()
+		scanner := bufio.NewScanner(reader)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxLineLength)
+		for scanner.Scan() {
+			// Once stopped nobody may be reading; the rest of the output is dropped
+			select {
+			case run.lines <- scanner.Text():
+			case <-ctx.Done():
+			}
+		}
+		run.err = scanner.Err()
+		close(run.lines)
+	}()
+	return run, nil
+}
+
+// Lines returns the output, one line at a time. It is closed once the command
+// has exited; Err then reports how.
+func (r *Run) Lines() <-chan string {
+	return r.lines
+}
+
+// Err returns the error the command exited with (nil on success). It is only
+// meaningful once Lines has been closed.
+func (r *Run) Err() error {
+	return r.err
+}
+
+// Stop kills the command
+func (r *Run) Stop() {
+	r.cancel()
+}
+
+// shellCommand returns the shell invocation running command on the platform
+func shellCommand(platform, command string) (string, []string) {
+	if platform == "windows" {
+		return "cmd", []string{"/C", command}
+	}
+	return "sh", []string{"-c", command}
+}
@@ -0,0 +1,76 @@
+package shell
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// collect reads the output until the command exits
+func collect(t *testing.T, run *Run) []string {
+	t.Helper()
+	var lines []string
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case line, ok := <-run.Lines():
+			if !ok {
+				return lines
+			}
+			lines = append(lines, line)
+		case <-timeout:
+			t.Fatal("the command did not finish")
+		}
+	}
+}
+
+func TestStart(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses sh")
+	}
+	dir := t.TempDir()
+	run, err := Start(context.Background(), dir, "pwd; echo oops >&2; exit 3")
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	lines := collect(t, run)
+	if len(lines) != 2 || lines[1] != "oops" {
+		t.Errorf("lines = %q, want the directory and stderr", lines)
+	}
+	var exitErr *exec.ExitError
+	if !errors.As(run.Err(), &exitErr) || exitErr.ExitCode() != 3 {
+		t.Errorf("Err() = %v, want exit status 3", run.Err())
+	}
+}
+
+func TestRun_Stop(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses sh")
+	}
+	run, err := Start(context.Background(), t.TempDir(), "echo started; sleep 30")
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if line := <-run.Lines(); line != "started" {
+		t.Fatalf("first line = %q", line)
+	}
+
+	run.Stop()
+	collect(t, run)
+	if run.Err() == nil {
+		t.Error("expected a stopped command to report an error")
+	}
+}
+
+func TestShellCommand(t *testing.T) {
+	if name, args := shellCommand("linux", "make test"); name != "sh" || len(args) != 2 || args[1] != "make test" {
+		t.Errorf("shellCommand(linux) = %s %q", name, args)
+	}
+	if name, args := shellCommand("windows", "make test"); name != "cmd" || args[0] != "/C" {
+		t.Errorf("shellCommand(windows) = %s %q", name, args)
+	}
+}
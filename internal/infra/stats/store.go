@@ -0,0 +1,141 @@
+// Package stats keeps local usage counters (views opened, actions used and
+// session length) in a file. Nothing is ever sent over the network.
+package stats
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// Store keeps the counters of all sessions in a JSON file
+type Store struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewStore creates a store writing to path (nothing is kept when empty). The
+// directory is created on the first save.
+func NewStore(path string) *Store {
+	return &Store{path: path}
+}
+
+// Load returns the counters, or nil when nothing was counted yet
+func (s *Store) Load() (*models.UsageStats, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.load()
+}
+
+// load reads the file; the caller holds s.mu
+func (s *Store) load() (*models.UsageStats, error) {
+	if s.path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read usage stats: %w", err)
+	}
+	var stats models.UsageStats
+	if err := json.Unmarshal(data, &stats); err != nil {
+		return nil, fmt.Errorf("failed to parse usage stats: %w", err)
+	}
+	return &stats, nil
+}
+
+// Add adds the counters of a session. The file is read again first, so
+// sessions running side by side don't overwrite each other's counts.
+func (s *Store) Add(session *models.UsageStats) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.path == "" {
+		return nil
+	}
+
+	total, err := s.load()
+	if err != nil || total == nil {
+		// A broken file is started over rather than blocking every exit
+		total = models.NewUsageStats(session.Since)
+	}
+	total.Merge(session)
+
+	data, err := json.MarshalIndent(total, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode usage stats: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return fmt.Errorf("failed to create stats directory: %w", err)
+	}
+	if err := os.WriteFile(s.path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write usage stats: %w", err)
+	}
+	return nil
+}
+
+// Reset deletes the counters
+func (s *Store) Reset() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.path == "" {
+		return nil
+	}
+	if err := os.Remove(s.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to reset usage stats: %w", err)
+	}
+	return nil
+}
+
+// Recorder counts the views and actions of one session and adds them to the
+// store when the session ends
+type Recorder struct {
+	store   *Store
+	started time.Time
+	now     func() time.Time
+
+	mu      sync.Mutex
+	session *models.UsageStats
+}
+
+// NewRecorder starts counting a session
+func NewRecorder(store *Store) *Recorder {
+	now := time.Now()
+	return &Recorder{
+		store:   store,
+		started: now,
+		now:     time.Now,
+		session: models.NewUsageStats(now),
+	}
+}
+
+// ViewOpened counts a view being opened
+func (r *Recorder) ViewOpened(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.session.Views[name]++
+}
+
+// ActionUsed counts an action
+func (r *Recorder) ActionUsed(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.session.Actions[name]++
+}
+
+// Close ends the session and saves its counters
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.session.Sessions = 1
+	r.session.SessionSeconds = int64(r.now().Sub(r.started).Seconds())
+	return r.store.Add(r.session)
+}
@@ -0,0 +1,42 @@
+package stats
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRecorder_AddsSessionsToTheStore(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "stats.json"))
+
+	start := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	for _, length := range []time.Duration{10 * time.Minute, 20 * time.Minute} {
+		r := NewRecorder(store)
+		r.started = start
+		r.now = func() time.Time { return start.Add(length) }
+		r.ViewOpened("Issues")
+		r.ViewOpened("Pull requests")
+		r.ActionUsed("pull request merged")
+		if err := r.Close(); err != nil {
+			t.Fatalf("Close: %v", err)
+		}
+	}
+
+	stats, err := store.Load()
+	if err != nil || stats == nil {
+		t.Fatalf("Load = %v, %v", stats, err)
+	}
+	if stats.Sessions != 2 || stats.AverageSession() != 15*time.Minute {
+		t.Errorf("sessions = %d, average = %v", stats.Sessions, stats.AverageSession())
+	}
+	if stats.Views["Issues"] != 2 || stats.Actions["pull request merged"] != 2 {
+		t.Errorf("unexpected counters %+v %+v", stats.Views, stats.Actions)
+	}
+
+	if err := store.Reset(); err != nil {
+		t.Fatalf("Reset: %v", err)
+	}
+	if stats, err := store.Load(); err != nil || stats != nil {
+		t.Errorf("expected no stats after a reset, got %+v, %v", stats, err)
+	}
+}
@@ -0,0 +1,136 @@
+package update
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+)
+
+// Checker looks for a newer release at startup. The result is remembered in
+// a state file so the API is asked at most once per interval.
+type Checker struct {
+	client   *Client
+	path     string
+	interval time.Duration
+	now      func() time.Time
+}
+
+// checkState is the content of the state file
+type checkState struct {
+	CheckedAt time.Time `json:"checked_at"`
+	Latest    string    `json:"latest"`
+}
+
+// NewChecker creates a checker remembering its result in path (no file when empty)
+func NewChecker(client *Client, path string, interval time.Duration) *Checker {
+	return &Checker{client: client, path: path, interval: interval, now: time.Now}
+}
+
+// Check returns the newest release version when it is newer than current,
+// or an empty string when current is up to date or a development build
+func (c *Checker) Check(ctx context.Context, current string) (string, error) {
+	if !IsRelease(current) {
+		return "", nil
+	}
+
+	state, ok := c.load()
+	if !ok || c.now().Sub(state.CheckedAt) >= c.interval {
+		release, err := c.client.Latest(ctx)
+		if err != nil {
+			return "", err
+		}
+		state = checkState{CheckedAt: c.now(), Latest: release.Version}
+		c.save(state)
+	}
+
+	if IsNewer(current, state.Latest) {
+		return state.Latest, nil
+	}
+	return "", nil
+}
+
+// load reads the last result; a missing or broken file means no check yet
+func (c *Checker) load() (checkState, bool) {
+	var state checkState
+	if c.path == "" {
+		return state, false
+	}
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return state, false
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return state, false
+	}
+	return state, true
+}
+
+// save records the result. Failures only mean the API is asked again next time.
+func (c *Checker) save(state checkState) {
+	if c.path == "" {
+		return
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return
+	}
+	_ = os.WriteFile(c.path, data, 0644)
+}
+
+// Updater upgrades the running binary to the newest release
+type Updater struct {
+	client  *Client
+	current string
+	latest  *Release
+}
+
+// NewUpdater creates an updater for the running version
+func NewUpdater(client *Client, current string) *Updater {
+	return &Updater{client: client, current: current}
+}
+
+// Latest returns the newest release version and whether it is newer than
+// the running one
+func (u *Updater) Latest(ctx context.Context) (string, bool, error) {
+	if !IsRelease(u.current) {
+		return "", false, ErrDevelopmentBuild
+	}
+	release, err := u.client.Latest(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	u.latest = release
+	return release.Version, IsNewer(u.current, release.Version), nil
+}
+
+// Upgrade downloads the newest release, verifies its checksum and replaces
+// the running executable with it. It returns the installed version.
+func (u *Updater) Upgrade(ctx context.Context) (string, error) {
+	if u.latest == nil {
+		if _, _, err := u.Latest(ctx); err != nil {
+			return "", err
+		}
+	}
+	data, err := u.client.Download(ctx, u.latest, runtime.GOOS, runtime.GOARCH)
+	if err != nil {
+		return "", err
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+	if resolved, err := filepath.EvalSymlinks(exe); err == nil {
+		exe = resolved
+	}
+	if err := Replace(exe, data); err != nil {
+		return "", err
+	}
+	return u.latest.Version, nil
+}
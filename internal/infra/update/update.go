@@ -0,0 +1,254 @@
+// Package update checks the project's releases for a newer tig-gh and
+// replaces the running binary with it
+package update
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LatestReleaseURL is the API endpoint of the project's newest release
+const LatestReleaseURL = "https://api.github.com/repos/a1yama/tig-gh/releases/latest"
+
+// ChecksumsAsset is the release asset listing the SHA-256 of every binary,
+// in the format written by sha256sum
+const ChecksumsAsset = "checksums.txt"
+
+// maxBinaryBytes caps the size of a downloaded binary
+const maxBinaryBytes = 200 << 20
+
+// ErrDevelopmentBuild is returned when the running binary was not built from a
+// release, so there is no version to compare with
+var ErrDevelopmentBuild = errors.New("this is a development build; install a release to upgrade it")
+
+// Release is a published version of tig-gh
+type Release struct {
+	Version string  `json:"tag_name"`
+	URL     string  `json:"html_url"`
+	Assets  []Asset `json:"assets"`
+}
+
+// Asset is a file attached to a release
+type Asset struct {
+	Name string `json:"name"`
+	URL  string `json:"browser_download_url"`
+}
+
+// asset returns the asset with the name
+func (r *Release) asset(name string) (Asset, bool) {
+	for _, a := range r.Assets {
+		if a.Name == name {
+			return a, true
+		}
+	}
+	return Asset{}, false
+}
+
+// AssetName returns the name of the binary built for the platform, as
+// produced by make build-all
+func AssetName(goos, goarch string) string {
+	name := fmt.Sprintf("tig-gh-%s-%s", goos, goarch)
+	if goos == "windows" {
+		name += ".exe"
+	}
+	return name
+}
+
+// Client talks to the releases API. No token is needed for public releases.
+type Client struct {
+	HTTPClient *http.Client
+	LatestURL  string
+}
+
+// NewClient creates a client for the project's releases
+func NewClient() *Client {
+	return &Client{
+		HTTPClient: &http.Client{Timeout: 60 * time.Second},
+		LatestURL:  LatestReleaseURL,
+	}
+}
+
+// Latest returns the newest release
+func (c *Client) Latest(ctx context.Context) (*Release, error) {
+	body, err := c.get(ctx, c.LatestURL, 1<<20)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for updates: %w", err)
+	}
+	var release Release
+	if err := json.Unmarshal(body, &release); err != nil {
+		return nil, fmt.Errorf("failed to parse the latest release: %w", err)
+	}
+	if release.Version == "" {
+		return nil, fmt.Errorf("the latest release has no version")
+	}
+	return &release, nil
+}
+
+// Download returns the binary of the release built for the platform, after
+// checking it against the release's checksums
+func (c *Client) Download(ctx context.Context, release *Release, goos, goarch string) ([]byte, error) {
+	name := AssetName(goos, goarch)
+	binary, ok := release.asset(name)
+	if !ok {
+		return nil, fmt.Errorf("release %s has no binary for %s/%s", release.Version, goos, goarch)
+	}
+	sums, ok := release.asset(ChecksumsAsset)
+	if !ok {
+		return nil, fmt.Errorf("release %s has no %s to verify the download with", release.Version, ChecksumsAsset)
+	}
+
+	list, err := c.get(ctx, sums.URL, 1<<20)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", ChecksumsAsset, err)
+	}
+	want, err := checksumOf(list, name)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.get(ctx, binary.URL, maxBinaryBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", name, err)
+	}
+	sum := sha256.Sum256(data)
+	if got := hex.EncodeToString(sum[:]); !strings.EqualFold(got, want) {
+		return nil, fmt.Errorf("checksum mismatch for %s: got %s, want %s", name, got, want)
+	}
+	return data, nil
+}
+
+// get fetches a URL, reading at most limit bytes of the body
+func (c *Client) get(ctx context.Context, url string, limit int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("User-Agent", "tig-gh")
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", url, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("%s: larger than %d bytes", url, limit)
+	}
+	return data, nil
+}
+
+// checksumOf returns the checksum listed for the file in a sha256sum output
+func checksumOf(list []byte, name string) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(list))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		// Binary mode marks the name with a leading *
+		if len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == name {
+			return fields[0], nil
+		}
+	}
+	return "", fmt.Errorf("%s does not list %s", ChecksumsAsset, name)
+}
+
+// Replace writes data over the executable at path. The new binary is written
+// next to it and renamed into place, so a failed write leaves the old one.
+func Replace(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	tmp, err := os.CreateTemp(dir, ".tig-gh-upgrade-*")
+	if err != nil {
+		return fmt.Errorf("cannot write to %s (reinstall or rerun with the needed permissions): %w", dir, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0755); err != nil {
+		return err
+	}
+
+	// Windows cannot replace a running executable, but it can rename it
+	if runtime.GOOS == "windows" {
+		old := path + ".old"
+		os.Remove(old)
+		if err := os.Rename(path, old); err != nil {
+			return err
+		}
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", path, err)
+	}
+	return nil
+}
+
+// IsRelease reports whether the version names a release (v1.2.3) rather than
+// a development build ("dev", or git describe output between tags)
+func IsRelease(version string) bool {
+	_, ok := parseVersion(version)
+	return ok
+}
+
+// IsNewer reports whether latest is a later release than current. Development
+// builds are never reported as outdated.
+func IsNewer(current, latest string) bool {
+	c, ok := parseVersion(current)
+	if !ok {
+		return false
+	}
+	l, ok := parseVersion(latest)
+	if !ok {
+		return false
+	}
+	for i := range c {
+		if l[i] != c[i] {
+			return l[i] > c[i]
+		}
+	}
+	return false
+}
+
+// parseVersion parses v1.2.3 (the v is optional)
+func parseVersion(version string) ([3]int, bool) {
+	var parsed [3]int
+	parts := strings.Split(strings.TrimPrefix(strings.TrimSpace(version), "v"), ".")
+	if len(parts) != 3 {
+		return parsed, false
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return parsed, false
+		}
+		parsed[i] = n
+	}
+	return parsed, true
+}
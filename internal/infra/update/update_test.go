@@ -0,0 +1,148 @@
+package update
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newReleaseServer serves a latest release with a binary for linux/amd64 and
+// its checksums; sum overrides the listed checksum when not empty
+func newReleaseServer(t *testing.T, version string, binary []byte, sum string) (*httptest.Server, *int) {
+	t.Helper()
+	if sum == "" {
+		s := sha256.Sum256(binary)
+		sum = hex.EncodeToString(s[:])
+	}
+	calls := 0
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/latest":
+			calls++
+			fmt.Fprintf(w, `{"tag_name":%q,"html_url":"https://example.com","assets":[
+				{"name":"tig-gh-linux-amd64","browser_download_url":"%s/bin"},
+				{"name":"checksums.txt","browser_download_url":"%s/sums"}]}`, version, srv.URL, srv.URL)
+		case "/bin":
+			w.Write(binary)
+		case "/sums":
+			fmt.Fprintf(w, "%s  tig-gh-darwin-arm64\n%s *tig-gh-linux-amd64\n", strings.Repeat("0", 64), sum)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func newTestClient(srv *httptest.Server) *Client {
+	return &Client{HTTPClient: srv.Client(), LatestURL: srv.URL + "/latest"}
+}
+
+func TestIsNewer(t *testing.T) {
+	tests := []struct {
+		current, latest string
+		want            bool
+	}{
+		{"v1.2.3", "v1.2.4", true},
+		{"v1.2.3", "v1.10.0", true},
+		{"1.2.3", "v2.0.0", true},
+		{"v1.2.3", "v1.2.3", false},
+		{"v1.3.0", "v1.2.9", false},
+		{"dev", "v1.0.0", false},
+		{"v1.2.3-4-gabcdef-dirty", "v1.2.4", false},
+		{"v1.2.3", "nightly", false},
+	}
+	for _, tt := range tests {
+		if got := IsNewer(tt.current, tt.latest); got != tt.want {
+			t.Errorf("IsNewer(%q, %q) = %v, want %v", tt.current, tt.latest, got, tt.want)
+		}
+	}
+}
+
+func TestClient_Download(t *testing.T) {
+	binary := []byte("new tig-gh")
+	srv, _ := newReleaseServer(t, "v1.1.0", binary, "")
+	client := newTestClient(srv)
+
+	release, err := client.Latest(context.Background())
+	if err != nil {
+		t.Fatalf("Latest: %v", err)
+	}
+	data, err := client.Download(context.Background(), release, "linux", "amd64")
+	if err != nil || string(data) != string(binary) {
+		t.Fatalf("Download = %q, %v", data, err)
+	}
+
+	if _, err := client.Download(context.Background(), release, "freebsd", "amd64"); err == nil || !strings.Contains(err.Error(), "no binary for freebsd/amd64") {
+		t.Errorf("expected an error for a missing platform, got %v", err)
+	}
+}
+
+func TestClient_DownloadRejectsChecksumMismatch(t *testing.T) {
+	srv, _ := newReleaseServer(t, "v1.1.0", []byte("tampered"), strings.Repeat("a", 64))
+	client := newTestClient(srv)
+
+	release, err := client.Latest(context.Background())
+	if err != nil {
+		t.Fatalf("Latest: %v", err)
+	}
+	if _, err := client.Download(context.Background(), release, "linux", "amd64"); err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Errorf("expected a checksum mismatch, got %v", err)
+	}
+}
+
+func TestChecker_RemembersTheLastCheck(t *testing.T) {
+	srv, calls := newReleaseServer(t, "v1.1.0", nil, "")
+	checker := NewChecker(newTestClient(srv), filepath.Join(t.TempDir(), "update.json"), 24*time.Hour)
+	now := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	checker.now = func() time.Time { return now }
+
+	for _, want := range []string{"v1.1.0", "v1.1.0"} {
+		got, err := checker.Check(context.Background(), "v1.0.0")
+		if err != nil || got != want {
+			t.Fatalf("Check = %q, %v; want %q", got, err, want)
+		}
+	}
+	if *calls != 1 {
+		t.Errorf("expected one API call within the interval, got %d", *calls)
+	}
+
+	now = now.Add(25 * time.Hour)
+	if got, _ := checker.Check(context.Background(), "v1.1.0"); got != "" || *calls != 2 {
+		t.Errorf("expected a new check reporting no update, got %q after %d calls", got, *calls)
+	}
+
+	if got, _ := checker.Check(context.Background(), "dev"); got != "" || *calls != 2 {
+		t.Errorf("expected development builds not checked, got %q after %d calls", got, *calls)
+	}
+}
+
+func TestReplace(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tig-gh")
+	if err := os.WriteFile(path, []byte("old"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := Replace(path, []byte("new")); err != nil {
+		t.Fatalf("Replace: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || string(data) != "new" {
+		t.Errorf("binary = %q, %v", data, err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm()&0100 == 0 {
+		t.Errorf("expected the new binary to be executable, got %v, %v", info.Mode(), err)
+	}
+	entries, _ := os.ReadDir(filepath.Dir(path))
+	if len(entries) != 1 {
+		t.Errorf("expected no temporary files left, got %d entries", len(entries))
+	}
+}
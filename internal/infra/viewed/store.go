@@ -0,0 +1,96 @@
+package viewed
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// Store keeps the viewed files of each pull request in a JSON file per PR
+// under its directory. The files stay on this machine.
+type Store struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewStore creates a store writing to dir. The directory is created on the first save.
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// Load returns the viewed files of a PR, or nil when none were marked
+func (s *Store) Load(owner, repo string, number int) (*models.ViewedFiles, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(s.path(owner, repo, number))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read viewed files: %w", err)
+	}
+
+	var viewed models.ViewedFiles
+	if err := json.Unmarshal(data, &viewed); err != nil {
+		return nil, fmt.Errorf("failed to parse viewed files: %w", err)
+	}
+	return &viewed, nil
+}
+
+// Save records the viewed files of a PR, removing the record when none are left
+func (s *Store) Save(owner, repo string, number int, viewed *models.ViewedFiles) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := s.path(owner, repo, number)
+	if viewed == nil || len(viewed.Files) == 0 {
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to remove viewed files: %w", err)
+		}
+		return nil
+	}
+
+	data, err := json.Marshal(viewed)
+	if err != nil {
+		return fmt.Errorf("failed to encode viewed files: %w", err)
+	}
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return fmt.Errorf("failed to create viewed files directory: %w", err)
+	}
+
+	// Write to a temporary file first so that a crash never leaves half a record
+	tmp, err := os.CreateTemp(s.dir, ".viewed-*")
+	if err != nil {
+		return fmt.Errorf("failed to write viewed files: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write viewed files: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write viewed files: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write viewed files: %w", err)
+	}
+	return nil
+}
+
+// path returns the file of a PR, e.g. "octo_hello-world_42.json"
+func (s *Store) path(owner, repo string, number int) string {
+	clean := func(name string) string {
+		return strings.NewReplacer("/", "-", `\`, "-", "..", "-").Replace(name)
+	}
+	return filepath.Join(s.dir, fmt.Sprintf("%s_%s_%d.json", clean(owner), clean(repo), number))
+}
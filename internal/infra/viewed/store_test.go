@@ -0,0 +1,60 @@
+package viewed
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func TestStore_SaveAndLoad(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "viewed")
+	store := NewStore(dir)
+
+	got, err := store.Load("octo", "hello", 42)
+	if err != nil || got != nil {
+		t.Fatalf("Load before any save = %v, %v; want nil, nil", got, err)
+	}
+
+	want := &models.ViewedFiles{HeadSHA: "abc123", Files: map[string]string{"main.go": "f1", "README.md": "f2"}}
+	if err := store.Save("octo", "hello", 42, want); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	got, err = store.Load("octo", "hello", 42)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if got.HeadSHA != "abc123" || len(got.Files) != 2 || got.Files["main.go"] != "f1" {
+		t.Errorf("Load = %+v, want %+v", got, want)
+	}
+
+	// Other PRs are kept apart
+	if other, _ := store.Load("octo", "hello", 43); other != nil {
+		t.Errorf("Load of another PR = %+v, want nil", other)
+	}
+}
+
+func TestStore_SaveNothingRemovesTheRecord(t *testing.T) {
+	dir := t.TempDir()
+	store := NewStore(dir)
+
+	if err := store.Save("octo", "hello", 1, &models.ViewedFiles{Files: map[string]string{"a": "1"}}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if err := store.Save("octo", "hello", 1, &models.ViewedFiles{}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 0 {
+		t.Errorf("expected no files left, got %d", len(entries))
+	}
+}
+
+func TestStore_PathStaysInDirectory(t *testing.T) {
+	store := NewStore("/tmp/viewed")
+	path := store.path("../..", "a/b", 1)
+	if filepath.Dir(path) != "/tmp/viewed" {
+		t.Errorf("path = %s, want a file directly in the store directory", path)
+	}
+}
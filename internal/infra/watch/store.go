@@ -0,0 +1,83 @@
+package watch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// Store keeps the watch list in a JSON file, along with the state each item
+// had at the last poll so that activity is noticed across sessions
+type Store struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewStore creates a store writing to path. The directory is created on the first save.
+func NewStore(path string) *Store {
+	return &Store{path: path}
+}
+
+// Load returns the watched items, or none when nothing was watched yet
+func (s *Store) Load() ([]*models.WatchedItem, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read watch list: %w", err)
+	}
+
+	var items []*models.WatchedItem
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("failed to parse watch list: %w", err)
+	}
+	return items, nil
+}
+
+// Save replaces the watch list
+func (s *Store) Save(items []*models.WatchedItem) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if items == nil {
+		items = []*models.WatchedItem{}
+	}
+	data, err := json.MarshalIndent(items, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode watch list: %w", err)
+	}
+	dir := filepath.Dir(s.path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create watch list directory: %w", err)
+	}
+
+	// Write to a temporary file first so that a crash never leaves half a list
+	tmp, err := os.CreateTemp(dir, ".watched-*")
+	if err != nil {
+		return fmt.Errorf("failed to write watch list: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write watch list: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write watch list: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write watch list: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package watch
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func TestStore_SaveAndLoad(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "state", "watched.json"))
+
+	got, err := store.Load()
+	if err != nil || got != nil {
+		t.Fatalf("Load before any save = %v, %v; want nil, nil", got, err)
+	}
+
+	items := []*models.WatchedItem{
+		{Owner: "octo", Repo: "hello", Number: 42, IsPullRequest: true, Title: "Add feature",
+			Snapshot: &models.WatchSnapshot{State: "open", Comments: 3, CheckState: models.CheckStatePending}},
+		{Owner: "octo", Repo: "hello", Number: 7, Title: "Bug"},
+	}
+	if err := store.Save(items); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	got, err = store.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(got) != 2 || got[0].Key() != "octo/hello#42" || got[0].Snapshot == nil || got[0].Snapshot.Comments != 3 {
+		t.Errorf("Load = %+v, want the saved items", got)
+	}
+	if got[1].Snapshot != nil {
+		t.Errorf("expected no snapshot before the first poll, got %+v", got[1].Snapshot)
+	}
+}
+
+func TestStore_SaveEmptyList(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "watched.json"))
+
+	if err := store.Save([]*models.WatchedItem{{Owner: "octo", Repo: "hello", Number: 1}}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if err := store.Save(nil); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	got, err := store.Load()
+	if err != nil || len(got) != 0 {
+		t.Errorf("Load = %v, %v; want an empty list", got, err)
+	}
+}
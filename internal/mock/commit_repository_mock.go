@@ -86,6 +86,51 @@ func (mr *MockCommitRepositoryMockRecorder) GetBranch(ctx, owner, repo, branch a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBranch", reflect.TypeOf((*MockCommitRepository)(nil).GetBranch), ctx, owner, repo, branch)
 }
 
+// GetCombinedStatus mocks base method.
+func (m *MockCommitRepository) GetCombinedStatus(ctx context.Context, owner, repo, ref string) (models.CheckState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCombinedStatus", ctx, owner, repo, ref)
+	ret0, _ := ret[0].(models.CheckState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCombinedStatus indicates an expected call of GetCombinedStatus.
+func (mr *MockCommitRepositoryMockRecorder) GetCombinedStatus(ctx, owner, repo, ref any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCombinedStatus", reflect.TypeOf((*MockCommitRepository)(nil).GetCombinedStatus), ctx, owner, repo, ref)
+}
+
+// GetDefaultBranch mocks base method.
+func (m *MockCommitRepository) GetDefaultBranch(ctx context.Context, owner, repo string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefaultBranch", ctx, owner, repo)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultBranch indicates an expected call of GetDefaultBranch.
+func (mr *MockCommitRepositoryMockRecorder) GetDefaultBranch(ctx, owner, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultBranch", reflect.TypeOf((*MockCommitRepository)(nil).GetDefaultBranch), ctx, owner, repo)
+}
+
+// GetFileContent mocks base method.
+func (m *MockCommitRepository) GetFileContent(ctx context.Context, owner, repo, path, ref string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileContent", ctx, owner, repo, path, ref)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileContent indicates an expected call of GetFileContent.
+func (mr *MockCommitRepositoryMockRecorder) GetFileContent(ctx, owner, repo, path, ref any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileContent", reflect.TypeOf((*MockCommitRepository)(nil).GetFileContent), ctx, owner, repo, path, ref)
+}
+
 // List mocks base method.
 func (m *MockCommitRepository) List(ctx context.Context, owner, repo string, opts *models.CommitOptions) ([]*models.Commit, error) {
 	m.ctrl.T.Helper()
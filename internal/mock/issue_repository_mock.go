@@ -41,6 +41,20 @@ func (m *MockIssueRepository) EXPECT() *MockIssueRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddReaction mocks base method.
+func (m *MockIssueRepository) AddReaction(ctx context.Context, owner, repo string, number int, commentID int64, content models.ReactionContent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReaction", ctx, owner, repo, number, commentID, content)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddReaction indicates an expected call of AddReaction.
+func (mr *MockIssueRepositoryMockRecorder) AddReaction(ctx, owner, repo, number, commentID, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReaction", reflect.TypeOf((*MockIssueRepository)(nil).AddReaction), ctx, owner, repo, number, commentID, content)
+}
+
 // Close mocks base method.
 func (m *MockIssueRepository) Close(ctx context.Context, owner, repo string, number int) error {
 	m.ctrl.T.Helper()
@@ -85,6 +99,21 @@ func (mr *MockIssueRepositoryMockRecorder) Get(ctx, owner, repo, number any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIssueRepository)(nil).Get), ctx, owner, repo, number)
 }
 
+// GetIssueGraph mocks base method.
+func (m *MockIssueRepository) GetIssueGraph(ctx context.Context, owner, repo string, number int) (*models.IssueGraph, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIssueGraph", ctx, owner, repo, number)
+	ret0, _ := ret[0].(*models.IssueGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIssueGraph indicates an expected call of GetIssueGraph.
+func (mr *MockIssueRepositoryMockRecorder) GetIssueGraph(ctx, owner, repo, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIssueGraph", reflect.TypeOf((*MockIssueRepository)(nil).GetIssueGraph), ctx, owner, repo, number)
+}
+
 // List mocks base method.
 func (m *MockIssueRepository) List(ctx context.Context, owner, repo string, opts *models.IssueOptions) ([]*models.Issue, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +144,66 @@ func (mr *MockIssueRepositoryMockRecorder) ListComments(ctx, owner, repo, number
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComments", reflect.TypeOf((*MockIssueRepository)(nil).ListComments), ctx, owner, repo, number, opts)
 }
 
+// ListMilestoneIssues mocks base method.
+func (m *MockIssueRepository) ListMilestoneIssues(ctx context.Context, owner, repo string, number int) ([]*models.Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMilestoneIssues", ctx, owner, repo, number)
+	ret0, _ := ret[0].([]*models.Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMilestoneIssues indicates an expected call of ListMilestoneIssues.
+func (mr *MockIssueRepositoryMockRecorder) ListMilestoneIssues(ctx, owner, repo, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMilestoneIssues", reflect.TypeOf((*MockIssueRepository)(nil).ListMilestoneIssues), ctx, owner, repo, number)
+}
+
+// ListMilestones mocks base method.
+func (m *MockIssueRepository) ListMilestones(ctx context.Context, owner, repo string) ([]*models.Milestone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMilestones", ctx, owner, repo)
+	ret0, _ := ret[0].([]*models.Milestone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMilestones indicates an expected call of ListMilestones.
+func (mr *MockIssueRepositoryMockRecorder) ListMilestones(ctx, owner, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMilestones", reflect.TypeOf((*MockIssueRepository)(nil).ListMilestones), ctx, owner, repo)
+}
+
+// ListTimeline mocks base method.
+func (m *MockIssueRepository) ListTimeline(ctx context.Context, owner, repo string, number int) ([]*models.TimelineEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTimeline", ctx, owner, repo, number)
+	ret0, _ := ret[0].([]*models.TimelineEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTimeline indicates an expected call of ListTimeline.
+func (mr *MockIssueRepositoryMockRecorder) ListTimeline(ctx, owner, repo, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTimeline", reflect.TypeOf((*MockIssueRepository)(nil).ListTimeline), ctx, owner, repo, number)
+}
+
+// ListTemplates mocks base method.
+func (m *MockIssueRepository) ListTemplates(ctx context.Context, owner, repo string) ([]*models.IssueTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTemplates", ctx, owner, repo)
+	ret0, _ := ret[0].([]*models.IssueTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTemplates indicates an expected call of ListTemplates.
+func (mr *MockIssueRepositoryMockRecorder) ListTemplates(ctx, owner, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplates", reflect.TypeOf((*MockIssueRepository)(nil).ListTemplates), ctx, owner, repo)
+}
+
 // Lock mocks base method.
 func (m *MockIssueRepository) Lock(ctx context.Context, owner, repo string, number int) error {
 	m.ctrl.T.Helper()
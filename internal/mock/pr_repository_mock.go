@@ -55,6 +55,21 @@ func (mr *MockPullRequestRepositoryMockRecorder) Close(ctx, owner, repo, number
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockPullRequestRepository)(nil).Close), ctx, owner, repo, number)
 }
 
+// ConvertDraft mocks base method.
+func (m *MockPullRequestRepository) ConvertDraft(ctx context.Context, owner, repo string, number int, draft bool) (*models.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConvertDraft", ctx, owner, repo, number, draft)
+	ret0, _ := ret[0].(*models.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConvertDraft indicates an expected call of ConvertDraft.
+func (mr *MockPullRequestRepositoryMockRecorder) ConvertDraft(ctx, owner, repo, number, draft any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertDraft", reflect.TypeOf((*MockPullRequestRepository)(nil).ConvertDraft), ctx, owner, repo, number, draft)
+}
+
 // Create mocks base method.
 func (m *MockPullRequestRepository) Create(ctx context.Context, owner, repo string, input *models.CreatePRInput) (*models.PullRequest, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +85,21 @@ func (mr *MockPullRequestRepositoryMockRecorder) Create(ctx, owner, repo, input
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPullRequestRepository)(nil).Create), ctx, owner, repo, input)
 }
 
+// CreateReview mocks base method.
+func (m *MockPullRequestRepository) CreateReview(ctx context.Context, owner, repo string, number int, input *models.CreateReviewInput) (*models.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReview", ctx, owner, repo, number, input)
+	ret0, _ := ret[0].(*models.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReview indicates an expected call of CreateReview.
+func (mr *MockPullRequestRepositoryMockRecorder) CreateReview(ctx, owner, repo, number, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReview", reflect.TypeOf((*MockPullRequestRepository)(nil).CreateReview), ctx, owner, repo, number, input)
+}
+
 // Get mocks base method.
 func (m *MockPullRequestRepository) Get(ctx context.Context, owner, repo string, number int) (*models.PullRequest, error) {
 	m.ctrl.T.Helper()
@@ -85,6 +115,51 @@ func (mr *MockPullRequestRepositoryMockRecorder) Get(ctx, owner, repo, number an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPullRequestRepository)(nil).Get), ctx, owner, repo, number)
 }
 
+// GetChangesSinceReview mocks base method.
+func (m *MockPullRequestRepository) GetChangesSinceReview(ctx context.Context, owner, repo string, number int) (*models.ChangesSinceReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangesSinceReview", ctx, owner, repo, number)
+	ret0, _ := ret[0].(*models.ChangesSinceReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangesSinceReview indicates an expected call of GetChangesSinceReview.
+func (mr *MockPullRequestRepositoryMockRecorder) GetChangesSinceReview(ctx, owner, repo, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangesSinceReview", reflect.TypeOf((*MockPullRequestRepository)(nil).GetChangesSinceReview), ctx, owner, repo, number)
+}
+
+// ListPendingReviewRequests mocks base method.
+func (m *MockPullRequestRepository) ListPendingReviewRequests(ctx context.Context, owner, repo string, number int) ([]*models.PendingReviewRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingReviewRequests", ctx, owner, repo, number)
+	ret0, _ := ret[0].([]*models.PendingReviewRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingReviewRequests indicates an expected call of ListPendingReviewRequests.
+func (mr *MockPullRequestRepositoryMockRecorder) ListPendingReviewRequests(ctx, owner, repo, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingReviewRequests", reflect.TypeOf((*MockPullRequestRepository)(nil).ListPendingReviewRequests), ctx, owner, repo, number)
+}
+
+// GetCodeOwners mocks base method.
+func (m *MockPullRequestRepository) GetCodeOwners(ctx context.Context, owner, repo, ref string) (models.CodeOwners, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCodeOwners", ctx, owner, repo, ref)
+	ret0, _ := ret[0].(models.CodeOwners)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCodeOwners indicates an expected call of GetCodeOwners.
+func (mr *MockPullRequestRepositoryMockRecorder) GetCodeOwners(ctx, owner, repo, ref any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCodeOwners", reflect.TypeOf((*MockPullRequestRepository)(nil).GetCodeOwners), ctx, owner, repo, ref)
+}
+
 // GetDiff mocks base method.
 func (m *MockPullRequestRepository) GetDiff(ctx context.Context, owner, repo string, number int) (string, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +175,21 @@ func (mr *MockPullRequestRepositoryMockRecorder) GetDiff(ctx, owner, repo, numbe
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiff", reflect.TypeOf((*MockPullRequestRepository)(nil).GetDiff), ctx, owner, repo, number)
 }
 
+// GetMergeRequirements mocks base method.
+func (m *MockPullRequestRepository) GetMergeRequirements(ctx context.Context, owner, repo string, number int) (*models.MergeRequirements, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMergeRequirements", ctx, owner, repo, number)
+	ret0, _ := ret[0].(*models.MergeRequirements)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMergeRequirements indicates an expected call of GetMergeRequirements.
+func (mr *MockPullRequestRepositoryMockRecorder) GetMergeRequirements(ctx, owner, repo, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMergeRequirements", reflect.TypeOf((*MockPullRequestRepository)(nil).GetMergeRequirements), ctx, owner, repo, number)
+}
+
 // IsMergeable mocks base method.
 func (m *MockPullRequestRepository) IsMergeable(ctx context.Context, owner, repo string, number int) (bool, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +220,21 @@ func (mr *MockPullRequestRepositoryMockRecorder) List(ctx, owner, repo, opts any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPullRequestRepository)(nil).List), ctx, owner, repo, opts)
 }
 
+// ListApprovals mocks base method.
+func (m *MockPullRequestRepository) ListApprovals(ctx context.Context, owner, repo string, number int) (*models.Approvals, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApprovals", ctx, owner, repo, number)
+	ret0, _ := ret[0].(*models.Approvals)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApprovals indicates an expected call of ListApprovals.
+func (mr *MockPullRequestRepositoryMockRecorder) ListApprovals(ctx, owner, repo, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApprovals", reflect.TypeOf((*MockPullRequestRepository)(nil).ListApprovals), ctx, owner, repo, number)
+}
+
 // ListComments mocks base method.
 func (m *MockPullRequestRepository) ListComments(ctx context.Context, owner, repo string, number int, opts *models.CommentOptions) ([]*models.Comment, error) {
 	m.ctrl.T.Helper()
@@ -145,6 +250,126 @@ func (mr *MockPullRequestRepositoryMockRecorder) ListComments(ctx, owner, repo,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComments", reflect.TypeOf((*MockPullRequestRepository)(nil).ListComments), ctx, owner, repo, number, opts)
 }
 
+// ListConflictFiles mocks base method.
+func (m *MockPullRequestRepository) ListConflictFiles(ctx context.Context, owner, repo string, number int) (*models.ConflictFiles, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConflictFiles", ctx, owner, repo, number)
+	ret0, _ := ret[0].(*models.ConflictFiles)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConflictFiles indicates an expected call of ListConflictFiles.
+func (mr *MockPullRequestRepositoryMockRecorder) ListConflictFiles(ctx, owner, repo, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConflictFiles", reflect.TypeOf((*MockPullRequestRepository)(nil).ListConflictFiles), ctx, owner, repo, number)
+}
+
+// ListFiles mocks base method.
+func (m *MockPullRequestRepository) ListFiles(ctx context.Context, owner, repo string, number int) ([]*models.DiffFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFiles", ctx, owner, repo, number)
+	ret0, _ := ret[0].([]*models.DiffFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFiles indicates an expected call of ListFiles.
+func (mr *MockPullRequestRepositoryMockRecorder) ListFiles(ctx, owner, repo, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFiles", reflect.TypeOf((*MockPullRequestRepository)(nil).ListFiles), ctx, owner, repo, number)
+}
+
+// ListReviewThreads mocks base method.
+func (m *MockPullRequestRepository) ListReviewThreads(ctx context.Context, owner, repo string, number int) ([]*models.ReviewThread, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReviewThreads", ctx, owner, repo, number)
+	ret0, _ := ret[0].([]*models.ReviewThread)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReviewThreads indicates an expected call of ListReviewThreads.
+func (mr *MockPullRequestRepositoryMockRecorder) ListReviewThreads(ctx, owner, repo, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReviewThreads", reflect.TypeOf((*MockPullRequestRepository)(nil).ListReviewThreads), ctx, owner, repo, number)
+}
+
+// ListFilesPage mocks base method.
+func (m *MockPullRequestRepository) ListFilesPage(ctx context.Context, owner, repo string, number, page, perPage int) ([]*models.DiffFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFilesPage", ctx, owner, repo, number, page, perPage)
+	ret0, _ := ret[0].([]*models.DiffFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFilesPage indicates an expected call of ListFilesPage.
+func (mr *MockPullRequestRepositoryMockRecorder) ListFilesPage(ctx, owner, repo, number, page, perPage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFilesPage", reflect.TypeOf((*MockPullRequestRepository)(nil).ListFilesPage), ctx, owner, repo, number, page, perPage)
+}
+
+// ListForCommit mocks base method.
+func (m *MockPullRequestRepository) ListForCommit(ctx context.Context, owner, repo, sha string) ([]*models.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForCommit", ctx, owner, repo, sha)
+	ret0, _ := ret[0].([]*models.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForCommit indicates an expected call of ListForCommit.
+func (mr *MockPullRequestRepositoryMockRecorder) ListForCommit(ctx, owner, repo, sha any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForCommit", reflect.TypeOf((*MockPullRequestRepository)(nil).ListForCommit), ctx, owner, repo, sha)
+}
+
+// ListLinkedIssues mocks base method.
+func (m *MockPullRequestRepository) ListLinkedIssues(ctx context.Context, owner, repo string, number int) ([]*models.LinkedIssue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLinkedIssues", ctx, owner, repo, number)
+	ret0, _ := ret[0].([]*models.LinkedIssue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLinkedIssues indicates an expected call of ListLinkedIssues.
+func (mr *MockPullRequestRepositoryMockRecorder) ListLinkedIssues(ctx, owner, repo, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLinkedIssues", reflect.TypeOf((*MockPullRequestRepository)(nil).ListLinkedIssues), ctx, owner, repo, number)
+}
+
+// ListMergeReadiness mocks base method.
+func (m *MockPullRequestRepository) ListMergeReadiness(ctx context.Context, owner, repo string, numbers []int) (map[int]*models.MergeReadiness, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMergeReadiness", ctx, owner, repo, numbers)
+	ret0, _ := ret[0].(map[int]*models.MergeReadiness)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMergeReadiness indicates an expected call of ListMergeReadiness.
+func (mr *MockPullRequestRepositoryMockRecorder) ListMergeReadiness(ctx, owner, repo, numbers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMergeReadiness", reflect.TypeOf((*MockPullRequestRepository)(nil).ListMergeReadiness), ctx, owner, repo, numbers)
+}
+
+// ListReviewerCandidates mocks base method.
+func (m *MockPullRequestRepository) ListReviewerCandidates(ctx context.Context, owner, repo string) (*models.ReviewerCandidates, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReviewerCandidates", ctx, owner, repo)
+	ret0, _ := ret[0].(*models.ReviewerCandidates)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReviewerCandidates indicates an expected call of ListReviewerCandidates.
+func (mr *MockPullRequestRepositoryMockRecorder) ListReviewerCandidates(ctx, owner, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReviewerCandidates", reflect.TypeOf((*MockPullRequestRepository)(nil).ListReviewerCandidates), ctx, owner, repo)
+}
+
 // ListReviews mocks base method.
 func (m *MockPullRequestRepository) ListReviews(ctx context.Context, owner, repo string, number int) ([]*models.Review, error) {
 	m.ctrl.T.Helper()
@@ -188,6 +413,36 @@ func (mr *MockPullRequestRepositoryMockRecorder) Reopen(ctx, owner, repo, number
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reopen", reflect.TypeOf((*MockPullRequestRepository)(nil).Reopen), ctx, owner, repo, number)
 }
 
+// RequestReviewers mocks base method.
+func (m *MockPullRequestRepository) RequestReviewers(ctx context.Context, owner, repo string, number int, request *models.ReviewRequest) (*models.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestReviewers", ctx, owner, repo, number, request)
+	ret0, _ := ret[0].(*models.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestReviewers indicates an expected call of RequestReviewers.
+func (mr *MockPullRequestRepositoryMockRecorder) RequestReviewers(ctx, owner, repo, number, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestReviewers", reflect.TypeOf((*MockPullRequestRepository)(nil).RequestReviewers), ctx, owner, repo, number, request)
+}
+
+// SetLabels mocks base method.
+func (m *MockPullRequestRepository) SetLabels(ctx context.Context, owner, repo string, number int, labels []string) ([]models.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLabels", ctx, owner, repo, number, labels)
+	ret0, _ := ret[0].([]models.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLabels indicates an expected call of SetLabels.
+func (mr *MockPullRequestRepositoryMockRecorder) SetLabels(ctx, owner, repo, number, labels any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLabels", reflect.TypeOf((*MockPullRequestRepository)(nil).SetLabels), ctx, owner, repo, number, labels)
+}
+
 // Update mocks base method.
 func (m *MockPullRequestRepository) Update(ctx context.Context, owner, repo string, number int, input *models.UpdatePRInput) (*models.PullRequest, error) {
 	m.ctrl.T.Helper()
@@ -202,3 +457,31 @@ func (mr *MockPullRequestRepositoryMockRecorder) Update(ctx, owner, repo, number
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPullRequestRepository)(nil).Update), ctx, owner, repo, number, input)
 }
+
+// CommitSuggestion mocks base method.
+func (m *MockPullRequestRepository) CommitSuggestion(ctx context.Context, owner, repo string, number int, input *models.SuggestionInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitSuggestion", ctx, owner, repo, number, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitSuggestion indicates an expected call of CommitSuggestion.
+func (mr *MockPullRequestRepositoryMockRecorder) CommitSuggestion(ctx, owner, repo, number, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitSuggestion", reflect.TypeOf((*MockPullRequestRepository)(nil).CommitSuggestion), ctx, owner, repo, number, input)
+}
+
+// UpdateBranch mocks base method.
+func (m *MockPullRequestRepository) UpdateBranch(ctx context.Context, owner, repo string, number int, expectedHeadSHA string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBranch", ctx, owner, repo, number, expectedHeadSHA)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBranch indicates an expected call of UpdateBranch.
+func (mr *MockPullRequestRepositoryMockRecorder) UpdateBranch(ctx, owner, repo, number, expectedHeadSHA any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBranch", reflect.TypeOf((*MockPullRequestRepository)(nil).UpdateBranch), ctx, owner, repo, number, expectedHeadSHA)
+}
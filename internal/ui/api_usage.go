@@ -0,0 +1,66 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/infra/apiusage"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// usagePanel breaks the session's API calls down by the view that made them,
+// so users can see what is consuming their rate limit
+type usagePanel struct {
+	counter *apiusage.Counter
+	visible bool
+	now     func() time.Time
+}
+
+// HandleKey closes the panel
+func (p *usagePanel) HandleKey(msg tea.KeyMsg) {
+	switch msg.String() {
+	case "esc", "q", "U":
+		p.visible = false
+	}
+}
+
+// View renders the breakdown
+func (p *usagePanel) View() string {
+	now := time.Now()
+	if p.now != nil {
+		now = p.now()
+	}
+	snap := p.counter.Snapshot()
+
+	var s strings.Builder
+	s.WriteString(styles.HeaderStyle.Render("API usage this session"))
+	s.WriteString("\n\n")
+	if snap.Total == 0 {
+		s.WriteString(styles.MutedStyle.Render("No API calls yet"))
+		s.WriteString("\n")
+	}
+	width := 0
+	for _, count := range snap.BySource {
+		if len(count.Source) > width {
+			width = len(count.Source)
+		}
+	}
+	for _, count := range snap.BySource {
+		s.WriteString(fmt.Sprintf("%-*s  %5d\n", width, count.Source, count.Calls))
+	}
+	s.WriteString("\n")
+	s.WriteString(snap.Summary(now))
+	s.WriteString("\n")
+	if remaining, ok := snap.Remaining(now); ok && !snap.Rate.Reset.IsZero() && remaining < snap.Rate.Limit {
+		s.WriteString(styles.MutedStyle.Render("Resets at " + snap.Rate.Reset.Local().Format("15:04")))
+		s.WriteString("\n")
+	} else if !ok {
+		s.WriteString(styles.MutedStyle.Render("The server has not reported a rate limit"))
+		s.WriteString("\n")
+	}
+	s.WriteString("\n")
+	s.WriteString(styles.FormatKeyBinding("esc", "close"))
+	return styles.BorderStyle.Render(s.String())
+}
@@ -1,8 +1,17 @@
 package ui
 
 import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
 	"github.com/a1yama/tig-gh/internal/app/usecase"
 	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/infra/apiusage"
+	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/events"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
 	"github.com/a1yama/tig-gh/internal/ui/views"
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -17,34 +26,109 @@ const (
 	SearchView
 	ReviewQueueView
 	MetricsView
+	ReleaseListView
+	GistListView
+	ActionsView
+	WatchListView
+	MyWorkView
+	StarredListView
 )
 
+// guestBanner labels sessions running without a GitHub token
+const guestBanner = "guest — read-only, unauthenticated (set GITHUB_TOKEN to enable write actions)"
+
 // App is the main application model
 type App struct {
-	currentView         ViewType
-	issueView           tea.Model
-	prView              tea.Model
-	prQueueView         tea.Model
-	commitView          tea.Model
-	searchView          tea.Model
-	metricsView         tea.Model
-	fetchIssuesUseCase  *usecase.FetchIssuesUseCase
-	fetchPRsUseCase     *usecase.FetchPRsUseCase
-	fetchCommitsUseCase *usecase.FetchCommitsUseCase
-	searchUseCase       *usecase.SearchUseCase
-	fetchMetricsUseCase *usecase.FetchLeadTimeMetricsUseCase
-	owner               string
-	repo                string
-	width               int
-	height              int
-	ready               bool
-	issueViewInited     bool
-	prViewInited        bool
-	prQueueViewInited   bool
-	commitViewInited    bool
-	searchViewInited    bool
-	metricsViewInited   bool
-	lastPrimaryView     ViewType
+	currentView          ViewType
+	issueView            tea.Model
+	prView               tea.Model
+	prQueueView          tea.Model
+	commitView           tea.Model
+	searchView           tea.Model
+	metricsView          tea.Model
+	releaseView          tea.Model
+	gistView             tea.Model
+	workflowView         tea.Model
+	watchView            tea.Model
+	myWorkView           tea.Model
+	starredView          tea.Model
+	fetchIssuesUseCase   *usecase.FetchIssuesUseCase
+	fetchPRsUseCase      *usecase.FetchPRsUseCase
+	fetchCommitsUseCase  *usecase.FetchCommitsUseCase
+	searchUseCase        *usecase.SearchUseCase
+	fetchReleasesUseCase *usecase.FetchReleasesUseCase
+	fetchGistsUseCase    *usecase.FetchGistsUseCase
+	fetchWorkflowRuns    *usecase.FetchWorkflowRunsUseCase
+	fetchMetricsUseCase  *usecase.FetchLeadTimeMetricsUseCase
+	metricsConfig        *models.MetricsConfig
+	protectedPaths       []string
+	freezeWindows        models.FreezeWindows
+	releaseTrain         views.ReleaseTrainUseCase
+	myWork               views.MyWorkUseCase
+	myWorkRepositories   []string
+	starred              views.FetchStarredUseCase
+	watchInterval        time.Duration
+	live                 views.LiveUpdates
+	liveInterval         time.Duration
+	autoRefresh          time.Duration
+	owner                string
+	repo                 string
+	width                int
+	height               int
+	ready                bool
+	issueViewInited      bool
+	prViewInited         bool
+	prQueueViewInited    bool
+	commitViewInited     bool
+	searchViewInited     bool
+	metricsViewInited    bool
+	releaseViewInited    bool
+	gistViewInited       bool
+	workflowViewInited   bool
+	watchViewInited      bool
+	myWorkViewInited     bool
+	starredViewInited    bool
+	lastPrimaryView      ViewType
+	disabledViews        map[ViewType]bool
+	throttle             *renderThrottle
+	guest                bool
+	profiles             profilePicker
+	profileSwitch        string
+	repoSwitch           *views.SwitchRepositoryMsg
+	palette              styles.Palette
+	viewPalettes         map[ViewType]styles.Palette
+	configCheck          func() error
+	configWarning        string
+	authCheck            func() error
+	updateCheck          func() string
+	auth                 authScreen
+	usage                usagePanel
+	stats                UsageRecorder
+	errorBanner          *components.ErrorBanner
+}
+
+// watchTickMsg starts the next poll of the watch list
+type watchTickMsg struct{}
+
+// liveTickMsg starts the next poll of the live updates
+type liveTickMsg struct{}
+
+// autoRefreshTickMsg reloads the list on screen (ui.auto_refresh)
+type autoRefreshTickMsg struct{}
+
+// watchPolledMsg carries the result of a scheduled poll of the watch list
+type watchPolledMsg struct {
+	polled tea.Msg
+}
+
+// updateCheckedMsg carries the newer release found after startup, if any
+type updateCheckedMsg struct {
+	version string
+}
+
+// configCheckedMsg carries the result of the config check run after startup
+type configCheckedMsg struct {
+	err error
 }
 
 // NewApp creates a new application instance (for backward compatibility)
@@ -56,10 +140,15 @@ func NewApp() *App {
 		prQueueView:     views.NewPRQueueView(),
 		commitView:      views.NewCommitView(),
 		metricsView:     views.NewMetricsView(),
+		releaseView:     views.NewReleaseView(),
+		gistView:        views.NewGistView(),
+		workflowView:    views.NewWorkflowView(),
 		owner:           "",
 		repo:            "",
 		ready:           false,
 		lastPrimaryView: IssueListView,
+		throttle:        newRenderThrottle(DefaultFPS),
+		errorBanner:     components.NewErrorBanner(),
 	}
 }
 
@@ -69,6 +158,9 @@ func NewAppWithUseCases(
 	fetchPRsUseCase *usecase.FetchPRsUseCase,
 	fetchCommitsUseCase *usecase.FetchCommitsUseCase,
 	searchUseCase *usecase.SearchUseCase,
+	fetchReleasesUseCase *usecase.FetchReleasesUseCase,
+	fetchGistsUseCase *usecase.FetchGistsUseCase,
+	fetchWorkflowRuns *usecase.FetchWorkflowRunsUseCase,
 	fetchMetricsUseCase *usecase.FetchLeadTimeMetricsUseCase,
 	owner, repo string,
 	defaultView string,
@@ -81,32 +173,245 @@ func NewAppWithUseCases(
 		initialView = PullRequestListView
 	case "commits":
 		initialView = CommitListView
+	case "releases":
+		initialView = ReleaseListView
+	case "actions":
+		initialView = ActionsView
 	default:
 		initialView = IssueListView
 	}
 
-	return &App{
-		currentView:         initialView,
-		issueView:           views.NewIssueViewWithUseCase(fetchIssuesUseCase, owner, repo),
-		prView:              views.NewPRViewWithUseCase(fetchPRsUseCase, owner, repo),
-		prQueueView:         views.NewPRQueueViewWithUseCase(fetchPRsUseCase, owner, repo),
-		commitView:          views.NewCommitViewWithUseCase(fetchCommitsUseCase, owner, repo),
-		searchView:          views.NewSearchViewWithUseCase(searchUseCase, owner, repo),
-		metricsView:         views.NewMetricsViewWithUseCase(fetchMetricsUseCase, metricsConfig),
-		fetchIssuesUseCase:  fetchIssuesUseCase,
-		fetchPRsUseCase:     fetchPRsUseCase,
-		fetchCommitsUseCase: fetchCommitsUseCase,
-		searchUseCase:       searchUseCase,
-		fetchMetricsUseCase: fetchMetricsUseCase,
-		owner:               owner,
-		repo:                repo,
-		ready:               false,
-		lastPrimaryView:     initialView,
+	// Only the initial view is built up front so the first frame renders
+	// quickly; the others are built on first use (see ensureView)
+	a := &App{
+		currentView:          initialView,
+		fetchIssuesUseCase:   fetchIssuesUseCase,
+		fetchPRsUseCase:      fetchPRsUseCase,
+		fetchCommitsUseCase:  fetchCommitsUseCase,
+		searchUseCase:        searchUseCase,
+		fetchReleasesUseCase: fetchReleasesUseCase,
+		fetchGistsUseCase:    fetchGistsUseCase,
+		fetchWorkflowRuns:    fetchWorkflowRuns,
+		fetchMetricsUseCase:  fetchMetricsUseCase,
+		metricsConfig:        metricsConfig,
+		owner:                owner,
+		repo:                 repo,
+		ready:                false,
+		lastPrimaryView:      initialView,
+		throttle:             newRenderThrottle(DefaultFPS),
+		errorBanner:          components.NewErrorBanner(),
+	}
+	a.ensureView(initialView)
+	return a
+}
+
+// ensureView builds a view the first time it is needed. Views built after
+// startup receive the settings and the terminal size set so far.
+func (a *App) ensureView(view ViewType) {
+	if a.viewModel(view) != nil {
+		return
+	}
+
+	var model tea.Model
+	switch view {
+	case IssueListView:
+		a.issueView = views.NewIssueViewWithUseCase(a.fetchIssuesUseCase, a.owner, a.repo)
+		model = a.issueView
+	case PullRequestListView:
+		a.prView = views.NewPRViewWithUseCase(a.fetchPRsUseCase, a.owner, a.repo)
+		model = a.prView
+	case ReviewQueueView:
+		a.prQueueView = views.NewPRQueueViewWithUseCase(a.fetchPRsUseCase, a.owner, a.repo)
+		model = a.prQueueView
+	case CommitListView:
+		a.commitView = views.NewCommitViewWithUseCase(a.fetchCommitsUseCase, a.owner, a.repo)
+		model = a.commitView
+	case SearchView:
+		a.searchView = views.NewSearchViewWithUseCase(a.searchUseCase, a.owner, a.repo)
+		model = a.searchView
+	case MetricsView:
+		a.metricsView = views.NewMetricsViewWithUseCase(a.fetchMetricsUseCase, a.metricsConfig)
+		model = a.metricsView
+	case ReleaseListView:
+		a.releaseView = views.NewReleaseViewWithUseCase(a.fetchReleasesUseCase, a.owner, a.repo)
+		model = a.releaseView
+	case GistListView:
+		a.gistView = views.NewGistViewWithUseCase(a.fetchGistsUseCase)
+		model = a.gistView
+	case ActionsView:
+		a.workflowView = views.NewWorkflowViewWithUseCase(a.fetchWorkflowRuns, a.owner, a.repo)
+		model = a.workflowView
+	case WatchListView:
+		a.watchView = views.NewWatchView()
+		model = a.watchView
+	case MyWorkView:
+		a.myWorkView = views.NewMyWorkViewWithUseCase(a.myWork, a.owner, a.repo, a.myWorkRepositories)
+		model = a.myWorkView
+	case StarredListView:
+		a.starredView = views.NewStarredViewWithUseCase(a.starred, a.owner, a.repo)
+		model = a.starredView
+	default:
+		return
+	}
+
+	a.applySettings(model)
+	if a.ready {
+		a.setViewModel(view, a.resizeView(model))
+	}
+}
+
+// applySettings passes the settings made with the Set* methods to a view
+func (a *App) applySettings(model tea.Model) {
+	switch v := model.(type) {
+	case *views.PRView:
+		v.SetProtectedPaths(a.protectedPaths)
+		v.SetFreezeWindows(a.freezeWindows)
+		if a.fetchCommitsUseCase != nil {
+			v.SetCommitRepository(a.fetchCommitsUseCase.GetRepository())
+		}
+		if a.fetchIssuesUseCase != nil {
+			v.SetIssueRepository(a.fetchIssuesUseCase.GetRepository())
+		}
+	case *views.PRQueueView:
+		v.SetProtectedPaths(a.protectedPaths)
+		v.SetFreezeWindows(a.freezeWindows)
+		if a.fetchCommitsUseCase != nil {
+			v.SetCommitRepository(a.fetchCommitsUseCase.GetRepository())
+		}
+	case *views.ReleaseView:
+		if a.releaseTrain != nil {
+			v.SetReleaseTrainUseCase(a.releaseTrain)
+		}
+	case *views.StarredView:
+		v.SetGuestMode(a.guest)
+	case *views.MyWorkView:
+		if a.fetchIssuesUseCase != nil && a.fetchPRsUseCase != nil {
+			v.SetRepositories(a.fetchIssuesUseCase.GetRepository(), a.fetchPRsUseCase.GetRepository())
+		}
+		if a.fetchCommitsUseCase != nil {
+			v.SetCommitRepository(a.fetchCommitsUseCase.GetRepository())
+		}
+	}
+}
+
+// resizeView sends the current terminal size to a view built after startup
+func (a *App) resizeView(model tea.Model) tea.Model {
+	model, _ = model.Update(a.viewSize())
+	return model
+}
+
+// viewSize returns the size available to the views below the banners
+func (a *App) viewSize() tea.WindowSizeMsg {
+	msg := tea.WindowSizeMsg{Width: a.width, Height: a.height}
+	if a.banner() != "" && msg.Height > 1 {
+		msg.Height--
+	}
+	if a.errorBanner.IsVisible() && msg.Height > 1 {
+		msg.Height--
+	}
+	return msg
+}
+
+// viewModel returns the model of a view, or nil if it has not been built yet
+func (a *App) viewModel(view ViewType) tea.Model {
+	switch view {
+	case IssueListView:
+		return a.issueView
+	case PullRequestListView:
+		return a.prView
+	case ReviewQueueView:
+		return a.prQueueView
+	case CommitListView:
+		return a.commitView
+	case SearchView:
+		return a.searchView
+	case MetricsView:
+		return a.metricsView
+	case ReleaseListView:
+		return a.releaseView
+	case GistListView:
+		return a.gistView
+	case ActionsView:
+		return a.workflowView
+	case WatchListView:
+		return a.watchView
+	case MyWorkView:
+		return a.myWorkView
+	case StarredListView:
+		return a.starredView
+	}
+	return nil
+}
+
+// setViewModel replaces the model of a view
+func (a *App) setViewModel(view ViewType, model tea.Model) {
+	switch view {
+	case IssueListView:
+		a.issueView = model
+	case PullRequestListView:
+		a.prView = model
+	case ReviewQueueView:
+		a.prQueueView = model
+	case CommitListView:
+		a.commitView = model
+	case SearchView:
+		a.searchView = model
+	case MetricsView:
+		a.metricsView = model
+	case ReleaseListView:
+		a.releaseView = model
+	case GistListView:
+		a.gistView = model
+	case ActionsView:
+		a.workflowView = model
+	case WatchListView:
+		a.watchView = model
+	case MyWorkView:
+		a.myWorkView = model
+	case StarredListView:
+		a.starredView = model
 	}
 }
 
 // Init initializes the application
 func (a *App) Init() tea.Cmd {
+	a.recordView(a.currentView)
+	return tea.Batch(a.initCurrentView(), a.runConfigCheck(), a.runAuthCheck(), a.runUpdateCheck(), a.pollWatchList(), a.pollLiveUpdates(), a.scheduleAutoRefresh())
+}
+
+// scheduleAutoRefresh schedules the next reload of the list on screen
+func (a *App) scheduleAutoRefresh() tea.Cmd {
+	if a.autoRefresh <= 0 {
+		return nil
+	}
+	return tea.Tick(a.autoRefresh, func(time.Time) tea.Msg { return autoRefreshTickMsg{} })
+}
+
+// pollWatchList polls the watched items; the next poll is scheduled once it is done
+func (a *App) pollWatchList() tea.Cmd {
+	if a.watchInterval <= 0 {
+		return nil
+	}
+	poll := views.PollWatchList()
+	if poll == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		return watchPolledMsg{polled: poll()}
+	}
+}
+
+// pollLiveUpdates polls the repository for changes made elsewhere; the
+// next poll is scheduled once it is done
+func (a *App) pollLiveUpdates() tea.Cmd {
+	if a.live == nil || a.liveInterval <= 0 {
+		return nil
+	}
+	return views.PollLiveUpdates(a.live)
+}
+
+// initCurrentView initializes the view shown first
+func (a *App) initCurrentView() tea.Cmd {
 	switch a.currentView {
 	case PullRequestListView:
 		a.prViewInited = true
@@ -114,25 +419,230 @@ func (a *App) Init() tea.Cmd {
 	case CommitListView:
 		a.commitViewInited = true
 		return a.commitView.Init()
+	case ReleaseListView:
+		a.releaseViewInited = true
+		return a.releaseView.Init()
+	case ActionsView:
+		a.workflowViewInited = true
+		return a.workflowView.Init()
 	default:
 		a.issueViewInited = true
 		return a.issueView.Init()
 	}
 }
 
+// runConfigCheck validates the config in the background so a slow or invalid
+// config does not hold back the first frame
+func (a *App) runConfigCheck() tea.Cmd {
+	if a.configCheck == nil {
+		return nil
+	}
+	check := a.configCheck
+	return func() tea.Msg {
+		return configCheckedMsg{err: check()}
+	}
+}
+
+// runAuthCheck validates the token in the background; problems the user can
+// fix are shown on the auth screen
+func (a *App) runAuthCheck() tea.Cmd {
+	if a.authCheck == nil {
+		return nil
+	}
+	check := a.authCheck
+	return func() tea.Msg {
+		return authCheckedMsg{err: check()}
+	}
+}
+
+// runUpdateCheck looks for a newer release in the background
+func (a *App) runUpdateCheck() tea.Cmd {
+	if a.updateCheck == nil {
+		return nil
+	}
+	check := a.updateCheck
+	return func() tea.Msg {
+		return updateCheckedMsg{version: check()}
+	}
+}
+
 // Update handles messages and updates the application state
 func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	var cmd tea.Cmd
-	var cmds []tea.Cmd
+	if _, ok := msg.(renderFrameMsg); ok {
+		a.throttle.frame()
+		return a, nil
+	}
 
+	previous := a.currentView
+	model, cmd := a.update(msg)
+	a.recordUsage(msg, previous)
+	if a.currentView != previous {
+		a.applyPalette()
+	}
+	return model, tea.Batch(cmd, a.throttle.invalidate(isUrgentMsg(msg)))
+}
+
+// update applies a message to the application state
+func (a *App) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case configCheckedMsg:
+		if msg.err == nil {
+			return a, nil
+		}
+		// The banner takes a line, so the views are resized
+		a.configWarning = msg.err.Error()
+		a.throttle.invalidate(true)
+		if !a.ready {
+			return a, nil
+		}
+		return a.broadcast(a.viewSize())
+
+	case authCheckedMsg:
+		// Network failures and the like are left to the views
+		var problem *models.AuthProblem
+		if errors.As(msg.err, &problem) {
+			a.auth.Show(problem)
+			a.throttle.invalidate(true)
+		}
+		return a, nil
+
+	case authBrowserMsg:
+		a.auth.HandleBrowser(msg)
+		return a, nil
+
+	case updateCheckedMsg:
+		// A quiet hint in the status bar; tig-gh upgrade installs it
+		components.SetUpdateHint(msg.version)
+		a.throttle.invalidate(true)
+		return a, nil
+
+	case events.ErrorReported:
+		// A token the session cannot use gets the auth screen rather than a banner
+		var problem *models.AuthProblem
+		if errors.As(msg.Err, &problem) {
+			a.auth.Show(problem)
+			a.throttle.invalidate(true)
+			return a, nil
+		}
+		wasVisible := a.errorBanner.IsVisible()
+		expire := a.errorBanner.Show(msg.Source, msg.Err, msg.Retry)
+		a.throttle.invalidate(true)
+		if wasVisible || !a.ready {
+			return a, expire
+		}
+		// The banner takes a line, so the views are resized
+		_, cmd := a.broadcast(a.viewSize())
+		return a, tea.Batch(expire, cmd)
+
+	case components.ErrorBannerExpiredMsg:
+		if a.errorBanner.HandleExpired(msg) {
+			return a.resizeForErrorBanner()
+		}
+		return a, nil
+
+	case watchTickMsg:
+		return a, a.pollWatchList()
+
+	case watchPolledMsg:
+		// Polls run one after another, so a slow poll never overlaps the next
+		next := tea.Tick(a.watchInterval, func(time.Time) tea.Msg { return watchTickMsg{} })
+		_, cmd := a.broadcast(msg.polled)
+		return a, tea.Batch(cmd, next)
+
+	case liveTickMsg:
+		return a, a.pollLiveUpdates()
+
+	case views.LivePolledMsg:
+		// A failed poll is retried at the next tick; the first poll only
+		// records the events already there
+		next := tea.Tick(a.liveInterval, func(time.Time) tea.Msg { return liveTickMsg{} })
+		if msg.Err != nil || len(msg.Events) == 0 {
+			return a, next
+		}
+		// Only the view on screen updates; the others catch up when reloaded
+		_, cmd := a.delegateToCurrentView(views.LiveUpdateMsg{Events: msg.Events})
+		return a, tea.Batch(cmd, next)
+
+	case autoRefreshTickMsg:
+		// Only the view on screen reloads; the others catch up when shown.
+		// Views busy with a load, a detail view or a modal skip the tick.
+		_, cmd := a.delegateToCurrentView(views.AutoRefreshMsg{})
+		return a, tea.Batch(cmd, a.scheduleAutoRefresh())
+
+	case views.WatchPolledMsg:
+		// Polls started from the watch view reach every view like scheduled ones
+		return a.broadcast(msg)
+
+	case views.SwitchRepositoryMsg:
+		// The session is rebuilt for the repository once the program exits
+		a.repoSwitch = &msg
+		return a, tea.Quit
+
 	case views.MetricsExitMsg:
 		if a.currentView == MetricsView {
 			a.currentView = a.lastPrimaryView
 		}
 		return a, nil
 
+	case tea.ResumeMsg:
+		// Back from ctrl+z: the terminal was cleared, so draw the current frame right away
+		a.throttle.invalidate(true)
+		return a.delegateToCurrentView(msg)
+
 	case tea.KeyMsg:
+		// ctrl+z suspends from anywhere, even while a modal takes text input;
+		// bubbletea releases the terminal and restores it on resume
+		if msg.String() == "ctrl+z" {
+			return a, tea.Suspend
+		}
+
+		// The auth screen takes every key while it is open
+		if a.auth.visible {
+			cmd, retry := a.auth.HandleKey(msg)
+			if retry {
+				return a, a.runAuthCheck()
+			}
+			return a, cmd
+		}
+
+		// The error banner's keys work from anywhere while it is shown
+		if a.errorBanner.IsVisible() {
+			switch msg.String() {
+			case "ctrl+r":
+				if a.errorBanner.CanRetry() {
+					retry := a.errorBanner.Retry()
+					_, resize := a.resizeForErrorBanner()
+					_, cmd := a.broadcast(retry)
+					return a, tea.Batch(resize, cmd)
+				}
+			case "ctrl+x":
+				a.errorBanner.Dismiss()
+				return a.resizeForErrorBanner()
+			}
+		}
+
+		// The profile picker takes every key while it is open
+		if a.profiles.visible {
+			if msg.String() == "ctrl+c" {
+				return a, tea.Quit
+			}
+			if picked := a.profiles.HandleKey(msg); picked != "" {
+				// The session is rebuilt for the new profile once the program exits
+				a.profileSwitch = picked
+				return a, tea.Quit
+			}
+			return a, nil
+		}
+
+		// The API usage panel takes every key while it is open
+		if a.usage.visible {
+			if msg.String() == "ctrl+c" {
+				return a, tea.Quit
+			}
+			a.usage.HandleKey(msg)
+			return a, nil
+		}
+
 		// Check if we're in search view with input focused
 		// If so, skip global key bindings except for special cases
 		if a.currentView == SearchView {
@@ -148,6 +658,36 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+		// Text typed into a modal (e.g. a review confirmation) must reach the view untouched
+		if a.isCapturingInput() {
+			if msg.String() == "ctrl+c" {
+				return a, tea.Quit
+			}
+			return a.delegateToCurrentView(msg)
+		}
+
+		// Detail views use 'R' to reload the entity, so don't switch views there
+		if msg.String() == "R" && a.isShowingDetail() {
+			return a.delegateToCurrentView(msg)
+		}
+
+		// PR detail views use 'm' to merge
+		if msg.String() == "m" && a.isShowingDetail() &&
+			(a.currentView == PullRequestListView || a.currentView == ReviewQueueView) {
+			return a.delegateToCurrentView(msg)
+		}
+
+		// The issue and PR lists use 'v' to select items for batch actions
+		if msg.String() == "v" && !a.isShowingDetail() &&
+			(a.currentView == IssueListView || a.currentView == PullRequestListView) {
+			return a.delegateToCurrentView(msg)
+		}
+
+		// The keys of disabled views go to the view on screen instead
+		if view, ok := viewKeys[msg.String()]; ok && a.disabledViews[view] {
+			return a.delegateToCurrentView(msg)
+		}
+
 		// Global key bindings
 		switch msg.String() {
 		case "ctrl+c", "q":
@@ -158,6 +698,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "i":
 			// Switch to issue view
 			a.currentView = IssueListView
+			a.ensureView(IssueListView)
 			if !a.issueViewInited {
 				a.issueViewInited = true
 				return a, a.issueView.Init()
@@ -167,6 +708,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "p":
 			// Switch to PR view
 			a.currentView = PullRequestListView
+			a.ensureView(PullRequestListView)
 			if !a.prViewInited {
 				a.prViewInited = true
 				return a, a.prView.Init()
@@ -176,6 +718,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "R":
 			// Switch to review queue view
 			a.currentView = ReviewQueueView
+			a.ensureView(ReviewQueueView)
 			if !a.prQueueViewInited {
 				a.prQueueViewInited = true
 				return a, a.prQueueView.Init()
@@ -187,6 +730,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				a.lastPrimaryView = a.currentView
 			}
 			a.currentView = MetricsView
+			a.ensureView(MetricsView)
 			if !a.metricsViewInited {
 				a.metricsViewInited = true
 				return a, a.metricsView.Init()
@@ -196,15 +740,99 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "c":
 			// Switch to commit view
 			a.currentView = CommitListView
+			a.ensureView(CommitListView)
 			if !a.commitViewInited {
 				a.commitViewInited = true
 				return a, a.commitView.Init()
 			}
 			return a, nil
 
+		case "v":
+			// Switch to release view
+			a.currentView = ReleaseListView
+			a.ensureView(ReleaseListView)
+			if !a.releaseViewInited {
+				a.releaseViewInited = true
+				return a, a.releaseView.Init()
+			}
+			return a, nil
+
+		case "S":
+			// Switch to gist view
+			a.currentView = GistListView
+			a.ensureView(GistListView)
+			if !a.gistViewInited {
+				a.gistViewInited = true
+				return a, a.gistView.Init()
+			}
+			return a, nil
+
+		case "A":
+			// Switch to actions view
+			a.currentView = ActionsView
+			a.ensureView(ActionsView)
+			if !a.workflowViewInited {
+				a.workflowViewInited = true
+				return a, a.workflowView.Init()
+			}
+			return a, nil
+
+		case "w":
+			// Switch to the watched issues and PRs
+			a.currentView = WatchListView
+			a.ensureView(WatchListView)
+			if !a.watchViewInited {
+				a.watchViewInited = true
+				return a, a.watchView.Init()
+			}
+			return a, nil
+
+		case "M":
+			// Switch to the issues and PRs that need me
+			if a.myWork == nil {
+				return a.delegateToCurrentView(msg)
+			}
+			a.currentView = MyWorkView
+			a.ensureView(MyWorkView)
+			if !a.myWorkViewInited {
+				a.myWorkViewInited = true
+				return a, a.myWorkView.Init()
+			}
+			return a, nil
+
+		case "*":
+			// Switch to the starred repositories
+			if a.starred == nil {
+				return a.delegateToCurrentView(msg)
+			}
+			a.currentView = StarredListView
+			a.ensureView(StarredListView)
+			if !a.starredViewInited {
+				a.starredViewInited = true
+				return a, a.starredView.Init()
+			}
+			return a, nil
+
+		case "P":
+			// Open the profile picker when there is another profile to switch to
+			if len(a.profiles.names) > 1 {
+				a.profiles.Open()
+				return a, nil
+			}
+			return a.delegateToCurrentView(msg)
+
+		case "U":
+			// Show the API calls made this session by view
+			if a.usage.counter != nil {
+				a.usage.visible = true
+				return a, nil
+			}
+			return a.delegateToCurrentView(msg)
+
 		case "/":
 			// Switch to search view
 			a.currentView = SearchView
+			a.ensureView(SearchView)
 			if !a.searchViewInited {
 				a.searchViewInited = true
 				return a, a.searchView.Init()
@@ -216,70 +844,125 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return a.delegateToCurrentView(msg)
 		}
 
+	case events.EntityChanged:
+		// Broadcast domain events to every view so all copies of the entity stay in sync
+		return a.broadcast(msg)
+
+	case components.ProgressMsg:
+		// Long-running operations keep reporting progress after switching views
+		return a.broadcast(msg)
+
 	case tea.WindowSizeMsg:
 		a.width = msg.Width
 		a.height = msg.Height
 		a.ready = true
 
-		// Propagate size to all views
-		a.issueView, cmd = a.issueView.Update(msg)
-		cmds = append(cmds, cmd)
+		// Propagate size to all views, leaving a line for the guest / profile banner
+		return a.broadcast(a.viewSize())
 
-		a.prView, cmd = a.prView.Update(msg)
-		cmds = append(cmds, cmd)
-
-		a.prQueueView, cmd = a.prQueueView.Update(msg)
-		cmds = append(cmds, cmd)
+	default:
+		// Delegate other messages to current view
+		return a.delegateToCurrentView(msg)
+	}
+}
 
-		a.commitView, cmd = a.commitView.Update(msg)
-		cmds = append(cmds, cmd)
+// allViews lists the views in the order messages are broadcast to them
+var allViews = []ViewType{
+	IssueListView,
+	PullRequestListView,
+	ReviewQueueView,
+	CommitListView,
+	SearchView,
+	MetricsView,
+	ReleaseListView,
+	GistListView,
+	ActionsView,
+	WatchListView,
+	MyWorkView,
+	StarredListView,
+}
 
-		a.searchView, cmd = a.searchView.Update(msg)
-		cmds = append(cmds, cmd)
+// viewKeys maps the global keys that switch views to their view
+var viewKeys = map[string]ViewType{
+	"i": IssueListView,
+	"p": PullRequestListView,
+	"R": ReviewQueueView,
+	"m": MetricsView,
+	"c": CommitListView,
+	"v": ReleaseListView,
+	"S": GistListView,
+	"A": ActionsView,
+	"w": WatchListView,
+	"M": MyWorkView,
+	"*": StarredListView,
+	"/": SearchView,
+}
 
-		a.metricsView, cmd = a.metricsView.Update(msg)
+// broadcast sends the message to every view that has been built
+func (a *App) broadcast(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+	for _, view := range allViews {
+		model := a.viewModel(view)
+		if model == nil {
+			// Views built later start from the current state
+			continue
+		}
+		model, cmd := model.Update(msg)
+		a.setViewModel(view, model)
 		cmds = append(cmds, cmd)
+	}
+	return a, tea.Batch(cmds...)
+}
 
-		return a, tea.Batch(cmds...)
-
-	default:
-		// Delegate other messages to current view
-		return a.delegateToCurrentView(msg)
+// resizeForErrorBanner gives the views back the line of a dismissed error banner
+func (a *App) resizeForErrorBanner() (tea.Model, tea.Cmd) {
+	a.throttle.invalidate(true)
+	if !a.ready {
+		return a, nil
 	}
+	return a.broadcast(a.viewSize())
 }
 
 // delegateToCurrentView delegates the message to the current active view
 func (a *App) delegateToCurrentView(msg tea.Msg) (tea.Model, tea.Cmd) {
-	var cmd tea.Cmd
-
-	switch a.currentView {
-	case IssueListView:
-		a.issueView, cmd = a.issueView.Update(msg)
-		return a, cmd
-
-	case PullRequestListView:
-		a.prView, cmd = a.prView.Update(msg)
-		return a, cmd
-
-	case ReviewQueueView:
-		a.prQueueView, cmd = a.prQueueView.Update(msg)
-		return a, cmd
+	model := a.viewModel(a.currentView)
+	if model == nil {
+		return a, nil
+	}
+	model, cmd := model.Update(msg)
+	a.setViewModel(a.currentView, model)
+	return a, cmd
+}
 
-	case CommitListView:
-		a.commitView, cmd = a.commitView.Update(msg)
-		return a, cmd
+// detailViewer is implemented by views that can show a nested detail view
+type detailViewer interface {
+	IsShowingDetail() bool
+}
 
-	case SearchView:
-		a.searchView, cmd = a.searchView.Update(msg)
-		return a, cmd
+// inputCapturer is implemented by views that can take free text input
+type inputCapturer interface {
+	IsCapturingInput() bool
+}
 
-	case MetricsView:
-		a.metricsView, cmd = a.metricsView.Update(msg)
-		return a, cmd
+// isCapturingInput reports whether the current view is taking text input
+func (a *App) isCapturingInput() bool {
+	if v, ok := a.currentViewModel().(inputCapturer); ok {
+		return v.IsCapturingInput()
+	}
+	return false
+}
 
-	default:
-		return a, nil
+// isShowingDetail reports whether the current view has a detail view open
+func (a *App) isShowingDetail() bool {
+	if v, ok := a.currentViewModel().(detailViewer); ok {
+		return v.IsShowingDetail()
 	}
+	return false
+}
+
+// currentViewModel returns the model of the current view
+func (a *App) currentViewModel() tea.Model {
+	return a.viewModel(a.currentView)
 }
 
 // View renders the application
@@ -288,28 +971,57 @@ func (a *App) View() string {
 		return "Initializing tig-gh..."
 	}
 
-	switch a.currentView {
-	case IssueListView:
-		return a.issueView.View()
-
-	case PullRequestListView:
-		return a.prView.View()
-
-	case ReviewQueueView:
-		return a.prQueueView.View()
-
-	case CommitListView:
-		return a.commitView.View()
+	return a.throttle.render(a.renderCurrentView)
+}
 
-	case SearchView:
-		return a.searchView.View()
+// renderCurrentView renders the current active view
+func (a *App) renderCurrentView() string {
+	a.applyPalette()
+	view := a.renderView()
+	if a.profiles.visible {
+		view = a.profiles.View()
+	}
+	if a.auth.visible {
+		view = a.auth.View()
+	}
+	if a.usage.visible {
+		view = a.usage.View()
+	}
+	if a.errorBanner.IsVisible() {
+		view = a.errorBanner.View(a.width) + "\n" + view
+	}
+	if banner := a.banner(); banner != "" {
+		style := styles.MutedStyle
+		if a.configWarning != "" {
+			style = styles.WarningStyle
+		}
+		return style.MaxWidth(a.width).Render(banner) + "\n" + view
+	}
+	return view
+}
 
-	case MetricsView:
-		return a.metricsView.View()
+// banner returns the line shown above the view for guest sessions and named profiles
+func (a *App) banner() string {
+	var parts []string
+	if current := a.profiles.current; current != "" && current != models.DefaultProfileName {
+		parts = append(parts, "profile: "+current)
+	}
+	if a.guest {
+		parts = append(parts, guestBanner)
+	}
+	if a.configWarning != "" {
+		parts = append(parts, "config: "+a.configWarning)
+	}
+	return strings.Join(parts, " · ")
+}
 
-	default:
+// renderView renders the current active view without decorations
+func (a *App) renderView() string {
+	model := a.viewModel(a.currentView)
+	if model == nil {
 		return "Unknown view"
 	}
+	return model.View()
 }
 
 // Helper methods
@@ -322,6 +1034,181 @@ func (a *App) GetCurrentView() ViewType {
 // SetCurrentView sets the current active view
 func (a *App) SetCurrentView(view ViewType) {
 	a.currentView = view
+	a.ensureView(view)
+	a.throttle.invalidate(true)
+}
+
+// DisableView turns off a view whose feature is disabled: its key goes to
+// the view on screen instead, and a disabled initial view falls back to the
+// issue list. Call it before the program starts.
+func (a *App) DisableView(view ViewType) {
+	if a.disabledViews == nil {
+		a.disabledViews = make(map[ViewType]bool)
+	}
+	a.disabledViews[view] = true
+	if a.currentView == view {
+		a.setViewModel(view, nil)
+		a.currentView = IssueListView
+		a.ensureView(IssueListView)
+	}
+	if a.lastPrimaryView == view {
+		a.lastPrimaryView = a.currentView
+	}
+}
+
+// IsViewDisabled reports whether the view was turned off with DisableView
+func (a *App) IsViewDisabled(view ViewType) bool {
+	return a.disabledViews[view]
+}
+
+// SetContext sets the context the views' API calls derive from; cancelling
+// it aborts every call still in flight
+func (a *App) SetContext(ctx context.Context) {
+	views.SetBaseContext(ctx)
+}
+
+// SetASCIIIcons replaces emoji and symbol icons with ASCII in every view.
+// Call it before the program starts rendering.
+func (a *App) SetASCIIIcons(enabled bool) {
+	if enabled {
+		styles.SetASCIIIcons()
+	}
+}
+
+// SetListLimits sets the page size and the maximum number of items loaded by list views
+func (a *App) SetListLimits(pageSize, maxItems int) {
+	views.SetListLimits(pageSize, maxItems)
+}
+
+// SetWatchList sets the watched issues and PRs (W in the lists, w to see them),
+// polled every interval for new activity
+func (a *App) SetWatchList(list views.WatchList, interval time.Duration) {
+	views.SetWatchList(list)
+	a.watchInterval = interval
+}
+
+// SetAutoRefresh reloads the issue, PR or commit list on screen every
+// interval, keeping the cursor on its item. Zero turns it off.
+func (a *App) SetAutoRefresh(interval time.Duration) {
+	views.SetAutoRefresh(interval)
+	a.autoRefresh = interval
+}
+
+// SetLiveUpdates polls the repository every interval for changes made
+// elsewhere (features.live): the list on screen reloads quietly and an open
+// issue or PR reloads, showing what changed
+func (a *App) SetLiveUpdates(live views.LiveUpdates, interval time.Duration) {
+	a.live = live
+	a.liveInterval = interval
+}
+
+// SetViewedFilesStore sets where the files marked as viewed in PR diffs are kept
+func (a *App) SetViewedFilesStore(store views.ViewedFilesStore) {
+	views.SetViewedFilesStore(store)
+}
+
+// SetAPIUsage shows the session's API calls and remaining budget in every
+// status bar, broken down by view with U
+func (a *App) SetAPIUsage(counter *apiusage.Counter) {
+	a.usage.counter = counter
+	if counter == nil {
+		components.SetUsageSummary(nil)
+		return
+	}
+	components.SetUsageSummary(func() string {
+		return counter.Snapshot().Summary(time.Now())
+	})
+}
+
+// SetGuestMode marks the session as an unauthenticated, read-only guest session
+func (a *App) SetGuestMode(guest bool) {
+	a.guest = guest
+	a.applySettings(a.starredView)
+	a.throttle.invalidate(true)
+}
+
+// SetProtectedPaths sets the path patterns flagged in the PR views
+func (a *App) SetProtectedPaths(patterns []string) {
+	a.protectedPaths = patterns
+	a.applySettings(a.prView)
+	a.applySettings(a.prQueueView)
+}
+
+// SetFreezeWindows sets the merge freeze windows checked by the PR views
+func (a *App) SetFreezeWindows(windows models.FreezeWindows) {
+	a.freezeWindows = windows
+	a.applySettings(a.prView)
+	a.applySettings(a.prQueueView)
+}
+
+// SetReviewCheckout sets the command the PR detail view runs with ctrl+t
+// after checking the PR out into a worktree, and where the worktrees go
+func (a *App) SetReviewCheckout(testCommand, worktreeDir string) {
+	views.SetReviewCheckout(testCommand, worktreeDir)
+}
+
+// SetReleaseTrainUseCase enables the release train view in the release view
+func (a *App) SetReleaseTrainUseCase(useCase views.ReleaseTrainUseCase) {
+	a.releaseTrain = useCase
+	a.applySettings(a.releaseView)
+}
+
+// SetConfigCheck sets the config validation run in the background after
+// startup; a failure is shown in the banner
+func (a *App) SetConfigCheck(check func() error) {
+	a.configCheck = check
+}
+
+// SetAuthCheck sets the token check run after the first frame; an
+// *models.AuthProblem it returns is shown in place of the view
+func (a *App) SetAuthCheck(check func() error) {
+	a.authCheck = check
+}
+
+// SetUpdateCheck sets the check for a newer release run after startup; the
+// version it returns, if any, is shown in the status bar
+func (a *App) SetUpdateCheck(check func() string) {
+	a.updateCheck = check
+}
+
+// SetMyWorkUseCase sets the searches behind the My Work view (M); it spans
+// the current repository or the configured repositories
+func (a *App) SetMyWorkUseCase(useCase views.MyWorkUseCase, repositories []string) {
+	a.myWork = useCase
+	a.myWorkRepositories = repositories
+}
+
+// SetStarredUseCase enables the starred repositories view (*), whose Enter
+// switches the session to the selected repository
+func (a *App) SetStarredUseCase(useCase views.FetchStarredUseCase) {
+	a.starred = useCase
+}
+
+// SetProfiles sets the profiles offered by the profile picker (P) and the one in use
+func (a *App) SetProfiles(names []string, current string) {
+	a.profiles.names = names
+	a.profiles.current = current
+	a.throttle.invalidate(true)
+}
+
+// ProfileSwitch returns the profile picked in the profile picker, or "" when
+// the program exited for another reason
+func (a *App) ProfileSwitch() string {
+	return a.profileSwitch
+}
+
+// RepositorySwitch returns the repository picked in the starred repositories
+// view, or false when the program exited for another reason
+func (a *App) RepositorySwitch() (owner, repo string, ok bool) {
+	if a.repoSwitch == nil {
+		return "", "", false
+	}
+	return a.repoSwitch.Owner, a.repoSwitch.Repo, true
+}
+
+// IsGuestMode returns whether the session is a read-only guest session
+func (a *App) IsGuestMode() bool {
+	return a.guest
 }
 
 // IsReady returns whether the app is ready to display
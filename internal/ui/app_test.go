@@ -0,0 +1,637 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/app/usecase"
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/infra/apiusage"
+	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/events"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	"github.com/a1yama/tig-gh/internal/ui/views"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestApp_GuestModeShowsBanner(t *testing.T) {
+	app := NewApp()
+	app.SetGuestMode(true)
+	app.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	view := app.View()
+	if !strings.Contains(strings.SplitN(view, "\n", 2)[0], "guest") {
+		t.Fatalf("expected guest banner on the first line, got %q", view)
+	}
+	if !app.IsGuestMode() {
+		t.Error("expected guest mode to be reported")
+	}
+}
+
+func TestApp_NoBannerWithToken(t *testing.T) {
+	app := NewApp()
+	app.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	if strings.Contains(app.View(), "guest") {
+		t.Error("expected no guest banner for authenticated sessions")
+	}
+}
+
+func TestApp_ProfilePickerSwitchesProfile(t *testing.T) {
+	app := NewApp()
+	app.SetProfiles([]string{"default", "ghe", "work"}, "work")
+	app.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	if !strings.Contains(strings.SplitN(app.View(), "\n", 2)[0], "profile: work") {
+		t.Fatalf("expected the profile banner, got %q", app.View())
+	}
+
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("P")})
+	if !strings.Contains(app.View(), "Switch profile") {
+		t.Fatalf("expected the profile picker, got %q", app.View())
+	}
+
+	// The cursor starts on the current profile; move up to "ghe"
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("k")})
+	_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if app.ProfileSwitch() != "ghe" {
+		t.Fatalf("ProfileSwitch() = %q, want ghe", app.ProfileSwitch())
+	}
+	if cmd == nil {
+		t.Fatal("expected the program to quit so it can restart with the new profile")
+	}
+}
+
+func TestApp_ProfilePickerNeedsSeveralProfiles(t *testing.T) {
+	app := NewApp()
+	app.SetProfiles([]string{"default"}, "default")
+	app.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("P")})
+	if strings.Contains(app.View(), "Switch profile") || strings.Contains(app.View(), "profile:") {
+		t.Errorf("expected no picker or banner for a single profile, got %q", app.View())
+	}
+}
+
+func TestApp_BuildsViewsOnFirstUse(t *testing.T) {
+	app := NewAppWithUseCases(nil, nil, nil, nil, nil, nil, nil, nil, "owner", "repo", "issues", nil)
+	app.SetProtectedPaths([]string{"infra/"})
+	if app.issueView == nil {
+		t.Fatal("expected the initial view to be built")
+	}
+	if app.prView != nil || app.releaseView != nil || app.metricsView != nil {
+		t.Fatal("expected the other views to be built on first use")
+	}
+
+	app.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	if app.prView == nil || app.GetCurrentView() != PullRequestListView {
+		t.Fatal("expected the PR view to be built when switching to it")
+	}
+	if app.releaseView != nil {
+		t.Error("expected views not visited yet to stay unbuilt")
+	}
+}
+
+func TestApp_DisabledViews(t *testing.T) {
+	app := NewAppWithUseCases(nil, nil, nil, nil, nil, nil, nil, nil, "owner", "repo", "actions", nil)
+	app.DisableView(ActionsView)
+	app.DisableView(MetricsView)
+	if app.GetCurrentView() != IssueListView || app.issueView == nil {
+		t.Fatalf("expected a disabled initial view to fall back to the issues, got %v", app.GetCurrentView())
+	}
+	if app.workflowView != nil {
+		t.Error("expected the view of a disabled feature not to be kept")
+	}
+
+	app.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	for _, key := range []string{"A", "m"} {
+		app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		if app.GetCurrentView() != IssueListView {
+			t.Errorf("%s: expected to stay on the issues, got %v", key, app.GetCurrentView())
+		}
+	}
+	if app.metricsView != nil {
+		t.Error("expected the metrics view not to be built")
+	}
+
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	if app.GetCurrentView() != PullRequestListView {
+		t.Errorf("expected an enabled view to open, got %v", app.GetCurrentView())
+	}
+}
+
+type countingRecorder struct {
+	views, actions map[string]int
+}
+
+func (r *countingRecorder) ViewOpened(name string) { r.views[name]++ }
+func (r *countingRecorder) ActionUsed(name string) { r.actions[name]++ }
+
+func TestApp_RecordsUsage(t *testing.T) {
+	app := NewAppWithUseCases(nil, nil, nil, nil, nil, nil, nil, nil, "owner", "repo", "issues", nil)
+	recorder := &countingRecorder{views: map[string]int{}, actions: map[string]int{}}
+	app.SetUsageRecorder(recorder)
+	app.Init()
+
+	app.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	app.Update(events.EntityChanged{Action: events.ActionMerged, PullRequest: &models.PullRequest{Number: 1}})
+
+	if recorder.views["Issues"] != 1 || recorder.views["Pull requests"] != 1 {
+		t.Errorf("views = %v, want the initial view and one switch", recorder.views)
+	}
+	if recorder.actions["pull request merged"] != 1 {
+		t.Errorf("actions = %v", recorder.actions)
+	}
+}
+
+func TestApp_ConfigCheckShowsBanner(t *testing.T) {
+	app := NewApp()
+	app.SetConfigCheck(func() error { return errors.New("unknown auth source \"vault\"") })
+	app.Update(tea.WindowSizeMsg{Width: 120, Height: 24})
+
+	if strings.Contains(app.View(), "config:") {
+		t.Fatal("expected no banner before the check has run")
+	}
+
+	app.Update(app.runConfigCheck()())
+	if first := strings.SplitN(app.View(), "\n", 2)[0]; !strings.Contains(first, `config: unknown auth source "vault"`) {
+		t.Errorf("expected the config error in the banner, got %q", first)
+	}
+}
+
+func TestApp_AuthCheckShowsProblem(t *testing.T) {
+	app := NewApp()
+	checks := 0
+	app.SetAuthCheck(func() error {
+		checks++
+		return fmt.Errorf("check: %w", &models.AuthProblem{
+			Kind:    models.AuthProblemSAMLSSO,
+			Message: "the organization requires SAML SSO",
+			URL:     "https://github.com/orgs/acme/sso",
+		})
+	})
+	app.Update(tea.WindowSizeMsg{Width: 120, Height: 24})
+
+	app.Update(app.runAuthCheck()())
+	view := app.View()
+	if !strings.Contains(view, "SAML single sign-on is required") || !strings.Contains(view, "https://github.com/orgs/acme/sso") {
+		t.Fatalf("expected the SSO screen\n%s", view)
+	}
+
+	// Keys go to the screen, not to the view below
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'2'}})
+	if app.GetCurrentView() != IssueListView {
+		t.Error("expected the view not to change while the auth screen is open")
+	}
+
+	_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	if cmd == nil {
+		t.Fatal("expected r to check the token again")
+	}
+	for _, msg := range flattenMsgs(cmd) {
+		app.Update(msg)
+	}
+	if checks != 2 || !app.auth.visible {
+		t.Errorf("checks = %d, visible = %v; want the problem shown again", checks, app.auth.visible)
+	}
+
+	app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if strings.Contains(app.View(), "SAML single sign-on") {
+		t.Error("expected esc to continue to the view")
+	}
+}
+
+func TestApp_AuthCheckIgnoresOtherErrors(t *testing.T) {
+	app := NewApp()
+	app.SetAuthCheck(func() error { return errors.New("dial tcp: connection refused") })
+	app.Update(tea.WindowSizeMsg{Width: 120, Height: 24})
+
+	app.Update(app.runAuthCheck()())
+	if app.auth.visible {
+		t.Error("expected network errors to be left to the views")
+	}
+}
+
+func TestApp_CtrlZSuspends(t *testing.T) {
+	app := NewApp()
+	app.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyCtrlZ})
+	if cmd == nil {
+		t.Fatal("expected a suspend command")
+	}
+	found := false
+	for _, msg := range flattenMsgs(cmd) {
+		if _, ok := msg.(tea.SuspendMsg); ok {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("expected ctrl+z to suspend the program")
+	}
+
+	app.Update(tea.ResumeMsg{})
+	if app.View() == "" {
+		t.Error("expected the view to be drawn after resuming")
+	}
+}
+
+// flattenMsgs runs a command, expanding batches, and returns its messages
+func flattenMsgs(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		return []tea.Msg{msg}
+	}
+	var msgs []tea.Msg
+	for _, c := range batch {
+		msgs = append(msgs, flattenMsgs(c)...)
+	}
+	return msgs
+}
+
+// recordingView is a view that records the messages it receives
+type recordingView struct {
+	msgs []tea.Msg
+}
+
+func (v *recordingView) Init() tea.Cmd { return nil }
+
+func (v *recordingView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	v.msgs = append(v.msgs, msg)
+	return v, nil
+}
+
+func (v *recordingView) View() string { return "recorded view" }
+
+// lastSize returns the last size the view was given
+func (v *recordingView) lastSize() tea.WindowSizeMsg {
+	var size tea.WindowSizeMsg
+	for _, msg := range v.msgs {
+		if s, ok := msg.(tea.WindowSizeMsg); ok {
+			size = s
+		}
+	}
+	return size
+}
+
+func TestApp_ErrorBannerRetries(t *testing.T) {
+	type retryIssues struct{}
+
+	app := NewApp()
+	view := &recordingView{}
+	app.issueView = view
+	app.Update(tea.WindowSizeMsg{Width: 120, Height: 24})
+
+	rateLimited := &models.APIError{Kind: models.APIErrorRateLimit, Err: errors.New("rate limit exceeded: boom")}
+	app.Update(events.ReportError("issues", rateLimited, retryIssues{})())
+	first := strings.SplitN(app.View(), "\n", 2)[0]
+	if !strings.Contains(first, "Rate limit exceeded — issues") || !strings.Contains(first, "ctrl+r") {
+		t.Fatalf("expected the error banner on the first line, got %q", first)
+	}
+	if got := view.lastSize().Height; got != 23 {
+		t.Errorf("expected the views to give the banner a line, got height %d", got)
+	}
+
+	app.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	retried := false
+	for _, msg := range view.msgs {
+		if _, ok := msg.(retryIssues); ok {
+			retried = true
+		}
+	}
+	if !retried {
+		t.Error("expected ctrl+r to send the retry message to the views")
+	}
+	if app.errorBanner.IsVisible() || view.lastSize().Height != 24 {
+		t.Error("expected retrying to dismiss the banner and give the line back")
+	}
+}
+
+func TestApp_ErrorBannerDismissAndExpire(t *testing.T) {
+	app := NewApp()
+	app.errorBanner.SetTimeout(time.Millisecond)
+	app.Update(tea.WindowSizeMsg{Width: 120, Height: 24})
+
+	expire := app.errorBanner.Show("gists", errors.New("first"), nil)
+	app.Update(events.ReportError("gists", errors.New("second"), nil)())
+
+	// The first error's timer must not dismiss the second one
+	app.Update(expire())
+	if !app.errorBanner.IsVisible() {
+		t.Fatal("expected a stale timer to leave the newer error up")
+	}
+	if strings.Contains(app.View(), "ctrl+r") {
+		t.Error("expected no retry hint for errors that cannot be retried")
+	}
+
+	app.Update(tea.KeyMsg{Type: tea.KeyCtrlX})
+	if app.errorBanner.IsVisible() || strings.Contains(app.View(), "second") {
+		t.Error("expected ctrl+x to dismiss the banner")
+	}
+}
+
+func TestApp_ErrorReportedAuthProblemOpensAuthScreen(t *testing.T) {
+	app := NewApp()
+	app.Update(tea.WindowSizeMsg{Width: 120, Height: 24})
+
+	problem := &models.AuthProblem{Kind: models.AuthProblemInvalidToken, Message: "GitHub rejected the token (401)"}
+	app.Update(events.ReportError("issues", fmt.Errorf("load: %w", problem), nil)())
+	if !app.auth.visible || app.errorBanner.IsVisible() {
+		t.Error("expected auth problems to open the auth screen instead of the banner")
+	}
+}
+
+func TestApp_APIUsage(t *testing.T) {
+	counter := apiusage.NewCounter()
+	counter.Record("Issues", models.RateLimit{Known: true, Limit: 5000, Remaining: 4990, Reset: time.Now().Add(time.Hour)})
+	counter.Record("Metrics", models.RateLimit{})
+
+	app := NewApp()
+	app.SetAPIUsage(counter)
+	defer app.SetAPIUsage(nil)
+	app.Update(tea.WindowSizeMsg{Width: 160, Height: 24})
+
+	sb := components.NewStatusBar()
+	sb.SetSize(160, 1)
+	if got := sb.Render(); !strings.Contains(got, "2 calls · ~4990/5000 left") {
+		t.Errorf("status bar should show the API usage, got %q", got)
+	}
+
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("U")})
+	if !app.usage.visible {
+		t.Fatal("U should open the API usage panel")
+	}
+	view := app.View()
+	for _, want := range []string{"API usage this session", "Issues", "Metrics"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("usage panel missing %q:\n%s", want, view)
+		}
+	}
+
+	app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if app.usage.visible {
+		t.Error("esc should close the API usage panel")
+	}
+}
+
+// snapshotUseCase serves fixed metrics like an exported report
+type snapshotUseCase struct{}
+
+func (snapshotUseCase) Execute(ctx context.Context, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error) {
+	return &models.LeadTimeMetrics{Overall: models.LeadTimeStat{Count: 3}}, nil
+}
+
+func (snapshotUseCase) GetRateLimit(ctx context.Context) (*models.RateLimit, error) {
+	return nil, errors.New("no rate limit")
+}
+
+func (snapshotUseCase) SnapshotName() string { return "report.json" }
+
+func TestMetricsSnapshotApp_QuitsOnExit(t *testing.T) {
+	cfg := models.DefaultConfig()
+	app := NewMetricsSnapshotApp(snapshotUseCase{}, &cfg.Metrics)
+	app.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+
+	_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	if cmd == nil {
+		t.Fatal("q should leave the metrics view")
+	}
+	_, cmd = app.Update(cmd())
+	if cmd == nil {
+		t.Fatal("leaving the metrics view should quit")
+	}
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Error("expected the program to quit")
+	}
+}
+
+// stubWatchList watches a single PR and counts its polls
+type stubWatchList struct {
+	polls int
+}
+
+func (l *stubWatchList) List() ([]*models.WatchedItem, error) {
+	return []*models.WatchedItem{{Owner: "owner", Repo: "repo", Number: 4, IsPullRequest: true, Title: "Speed up"}}, nil
+}
+func (l *stubWatchList) IsWatched(owner, repo string, number int) bool { return number == 4 }
+func (l *stubWatchList) Toggle(item *models.WatchedItem) (bool, error) { return true, nil }
+func (l *stubWatchList) Unwatch(owner, repo string, number int) error  { return nil }
+func (l *stubWatchList) MarkSeen(owner, repo string, number int) error { return nil }
+func (l *stubWatchList) Poll(ctx context.Context) ([]models.WatchEvent, error) {
+	l.polls++
+	return nil, nil
+}
+
+func TestApp_WatchListPollsAndShowsItems(t *testing.T) {
+	list := &stubWatchList{}
+	app := NewAppWithUseCases(nil, nil, nil, nil, nil, nil, nil, nil, "owner", "repo", "issues", nil)
+	app.SetWatchList(list, time.Minute)
+	defer app.SetWatchList(nil, 0)
+	app.Update(tea.WindowSizeMsg{Width: 120, Height: 24})
+
+	poll := app.pollWatchList()
+	if poll == nil {
+		t.Fatal("expected the watch list to be polled")
+	}
+	_, next := app.Update(poll())
+	if list.polls != 1 || next == nil {
+		t.Errorf("polls = %d, next = %v; want one poll and the next one scheduled", list.polls, next)
+	}
+
+	_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+	if app.GetCurrentView() != WatchListView || cmd == nil {
+		t.Fatal("expected w to open the watch list")
+	}
+	app.Update(cmd())
+	if out := app.View(); !strings.Contains(out, "owner/repo#4") {
+		t.Errorf("expected the watched PR\n%s", out)
+	}
+}
+
+func TestApp_AutoRefreshReschedules(t *testing.T) {
+	app := NewAppWithUseCases(nil, nil, nil, nil, nil, nil, nil, nil, "owner", "repo", "issues", nil)
+	if app.scheduleAutoRefresh() != nil {
+		t.Fatal("expected no auto refresh unless configured")
+	}
+
+	app.SetAutoRefresh(time.Minute)
+	defer app.SetAutoRefresh(0)
+	app.Update(tea.WindowSizeMsg{Width: 120, Height: 24})
+	if _, next := app.update(autoRefreshTickMsg{}); next == nil {
+		t.Error("expected the next refresh to be scheduled")
+	}
+}
+
+type stubLiveUpdates struct {
+	polls  int
+	events []*models.RepositoryEvent
+}
+
+func (l *stubLiveUpdates) Poll(ctx context.Context) ([]*models.RepositoryEvent, error) {
+	l.polls++
+	return l.events, nil
+}
+
+func TestApp_LiveUpdatesPollAndReschedule(t *testing.T) {
+	app := NewAppWithUseCases(nil, nil, nil, nil, nil, nil, nil, nil, "owner", "repo", "issues", nil)
+	if app.pollLiveUpdates() != nil {
+		t.Fatal("expected no live updates unless configured")
+	}
+
+	live := &stubLiveUpdates{events: []*models.RepositoryEvent{
+		{ID: "1", Kind: models.RepositoryEventIssue, Action: "opened", Owner: "owner", Repo: "repo", Number: 2},
+	}}
+	app.SetLiveUpdates(live, time.Minute)
+	app.Update(tea.WindowSizeMsg{Width: 120, Height: 24})
+
+	poll := app.pollLiveUpdates()
+	if poll == nil {
+		t.Fatal("expected the repository to be polled")
+	}
+	msg := poll()
+	if polled, ok := msg.(views.LivePolledMsg); !ok || len(polled.Events) != 1 {
+		t.Fatalf("unexpected poll result %#v", msg)
+	}
+	if _, next := app.Update(msg); live.polls != 1 || next == nil {
+		t.Errorf("polls = %d, next = %v; want one poll and the next one scheduled", live.polls, next)
+	}
+	if _, next := app.Update(views.LivePolledMsg{Err: errors.New("offline")}); next == nil {
+		t.Error("expected a failed poll to be retried at the next tick")
+	}
+}
+
+// testStarredUseCase lists canned starred repositories
+type testStarredUseCase struct {
+	starred []*models.StarredRepository
+}
+
+func (u *testStarredUseCase) Execute(ctx context.Context, opts *models.StarredOptions) ([]*models.StarredRepository, error) {
+	return u.starred, nil
+}
+
+func TestApp_StarredSwitchesRepository(t *testing.T) {
+	app := NewAppWithUseCases(nil, nil, nil, nil, nil, nil, nil, nil, "owner", "repo", "issues", nil)
+	app.SetStarredUseCase(&testStarredUseCase{starred: []*models.StarredRepository{{Owner: "charmbracelet", Name: "bubbletea"}}})
+	app.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+
+	_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("*")})
+	if app.currentView != StarredListView || cmd == nil {
+		t.Fatalf("expected the starred view to open and load, got view %d", app.currentView)
+	}
+	app.Update(cmd())
+
+	_, cmd = app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected Enter to ask for the switch")
+	}
+	if _, cmd = app.Update(cmd()); cmd == nil {
+		t.Fatal("expected the program to quit so it can restart on the repository")
+	}
+	if owner, repo, ok := app.RepositorySwitch(); !ok || owner != "charmbracelet" || repo != "bubbletea" {
+		t.Errorf("RepositorySwitch() = %s/%s %v", owner, repo, ok)
+	}
+}
+
+func TestApp_ViewPalettes(t *testing.T) {
+	defer styles.ApplyPalette(styles.PaletteDefault)
+
+	app := NewAppWithUseCases(nil, nil, nil, nil, nil, nil, nil, nil, "owner", "repo", "issues", nil)
+	app.SetPalettes("default", map[string]string{"prs": "colorblind", "unknown": "colorblind"})
+	app.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	if styles.CurrentPalette() != styles.PaletteDefault {
+		t.Fatalf("expected the default palette on the issues view, got %q", styles.CurrentPalette())
+	}
+
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	if app.currentView != PullRequestListView || styles.CurrentPalette() != styles.PaletteColorBlind {
+		t.Fatalf("expected the color-blind palette on the PR view, got %q", styles.CurrentPalette())
+	}
+
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("i")})
+	if styles.CurrentPalette() != styles.PaletteDefault {
+		t.Errorf("expected the default palette back on the issues view, got %q", styles.CurrentPalette())
+	}
+}
+
+// diffPRRepo lists one pull request changing one file
+type diffPRRepo struct {
+	repository.PullRequestRepository
+}
+
+func (r *diffPRRepo) List(ctx context.Context, owner, repo string, opts *models.PROptions) ([]*models.PullRequest, error) {
+	return []*models.PullRequest{{Number: 7, Title: "Fix it", State: models.PRStateOpen, Head: models.Branch{Name: "fix", SHA: "abc"}}}, nil
+}
+
+func (r *diffPRRepo) ListFilesPage(ctx context.Context, owner, repo string, number, page, perPage int) ([]*models.DiffFile, error) {
+	return []*models.DiffFile{{Filename: "main.go", Status: models.FileStatusModified, Changes: 1, Patch: "@@ -1 +1 @@\n-old\n+new"}}, nil
+}
+
+// memoryViewedStore keeps the viewed files in memory
+type memoryViewedStore map[int]*models.ViewedFiles
+
+func (s memoryViewedStore) Load(owner, repo string, number int) (*models.ViewedFiles, error) {
+	return s[number], nil
+}
+
+func (s memoryViewedStore) Save(owner, repo string, number int, viewed *models.ViewedFiles) error {
+	s[number] = viewed
+	return nil
+}
+
+func TestApp_ViewedFileSurvivesReopeningPR(t *testing.T) {
+	store := memoryViewedStore{}
+	app := NewAppWithUseCases(nil, usecase.NewFetchPRsUseCase(&diffPRRepo{}), nil, nil, nil, nil, nil, nil, "owner", "repo", "prs", nil)
+	app.SetViewedFilesStore(store)
+	defer app.SetViewedFilesStore(nil)
+	app.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	for _, msg := range flattenMsgs(app.initCurrentView()) {
+		app.Update(msg)
+	}
+
+	press := func(key string) tea.Cmd {
+		msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+		if key == "enter" {
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		}
+		_, cmd := app.Update(msg)
+		return cmd
+	}
+	openDiff := func() {
+		// The detail view's own loads are left out; only the diff is fetched
+		press("enter")
+		cmd := press("d")
+		if cmd == nil {
+			t.Fatal("expected d to open the diff of the PR")
+		}
+		app.Update(cmd())
+	}
+
+	openDiff()
+	if !strings.Contains(app.View(), "main.go") {
+		t.Fatalf("expected the diff of main.go, got:\n%s", app.View())
+	}
+	app.Update(press("v")())
+	if viewed := store[7]; viewed == nil || viewed.HeadSHA != "abc" || len(viewed.Files) != 1 {
+		t.Fatalf("expected main.go saved as viewed at abc, got %+v", viewed)
+	}
+
+	// Back to the PR, back to the list, and into the diff again
+	app.Update(press("q")())
+	press("q")
+	openDiff()
+	if view := app.View(); !strings.Contains(view, "Viewed. Press space to show the diff") {
+		t.Errorf("expected main.go folded away as viewed, got:\n%s", view)
+	}
+}
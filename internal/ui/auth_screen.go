@@ -0,0 +1,139 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/infra/browser"
+	"github.com/a1yama/tig-gh/internal/infra/clipboard"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// authCheckedMsg carries the result of the token check run after startup
+type authCheckedMsg struct {
+	err error
+}
+
+// authBrowserMsg reports the result of opening the fix-it page of an auth problem
+type authBrowserMsg struct {
+	url    string
+	err    error
+	copied clipboard.Method // how the URL was copied when it could not be opened
+}
+
+// Auth screen hooks, overridable in tests
+var (
+	openAuthURL = browser.Open
+	copyAuthURL = clipboard.CopyWithFallback
+)
+
+// authScreen explains a token the session cannot use and how to fix it,
+// instead of leaving each view to show the raw API error
+type authScreen struct {
+	problem *models.AuthProblem
+	status  string
+	visible bool
+}
+
+// Show opens the screen for problem
+func (s *authScreen) Show(problem *models.AuthProblem) {
+	s.problem = problem
+	s.status = ""
+	s.visible = true
+}
+
+// HandleKey handles a key while the screen is open. retry is set when the
+// token should be checked again.
+func (s *authScreen) HandleKey(msg tea.KeyMsg) (cmd tea.Cmd, retry bool) {
+	switch msg.String() {
+	case "o":
+		if url := s.problem.URL; url != "" {
+			return func() tea.Msg {
+				msg := authBrowserMsg{url: url, err: openAuthURL(url)}
+				if msg.err != nil {
+					if method, err := copyAuthURL(url); err == nil {
+						msg.copied = method
+					}
+				}
+				return msg
+			}, false
+		}
+	case "r":
+		s.visible = false
+		return nil, true
+	case "esc":
+		s.visible = false
+	case "q", "ctrl+c":
+		return tea.Quit, false
+	}
+	return nil, false
+}
+
+// HandleBrowser shows the outcome of opening the page
+func (s *authScreen) HandleBrowser(msg authBrowserMsg) {
+	copied := ""
+	if msg.copied != "" {
+		copied = fmt.Sprintf(" (copied via %s)", msg.copied)
+	}
+	switch {
+	case errors.Is(msg.err, browser.ErrNoBrowser):
+		s.status = "Open in your browser" + copied + ": " + msg.url
+	case msg.err != nil:
+		s.status = fmt.Sprintf("Failed to open browser: %v%s (%s)", msg.err, copied, msg.url)
+	default:
+		s.status = "Opened in browser; press r when done"
+	}
+}
+
+// title returns the heading for the kind of problem
+func (s *authScreen) title() string {
+	switch s.problem.Kind {
+	case models.AuthProblemInvalidToken:
+		return "The GitHub token is invalid or expired"
+	case models.AuthProblemMissingScope:
+		return "The GitHub token is missing the " + strings.Join(s.problem.Scopes, ", ") + " scope"
+	case models.AuthProblemSAMLSSO:
+		return "SAML single sign-on is required"
+	default:
+		return "The GitHub token cannot be used"
+	}
+}
+
+// View renders the screen
+func (s *authScreen) View() string {
+	var b strings.Builder
+	b.WriteString(styles.ErrorStyle.Render(s.title()))
+	b.WriteString("\n\n")
+	b.WriteString(s.problem.Message)
+	b.WriteString("\n")
+	if s.problem.Hint != "" {
+		b.WriteString(strings.ToUpper(s.problem.Hint[:1]) + s.problem.Hint[1:])
+		b.WriteString("\n")
+	}
+	if s.problem.URL != "" {
+		b.WriteString("\n")
+		b.WriteString(styles.MutedStyle.Render(s.problem.URL))
+		b.WriteString("\n")
+	}
+	if s.status != "" {
+		b.WriteString("\n")
+		b.WriteString(s.status)
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	keys := []string{}
+	if s.problem.URL != "" {
+		keys = append(keys, styles.FormatKeyBinding("o", "open page"))
+	}
+	keys = append(keys,
+		styles.FormatKeyBinding("r", "check again"),
+		styles.FormatKeyBinding("esc", "continue anyway"),
+		styles.FormatKeyBinding("q", "quit"),
+	)
+	b.WriteString(strings.Join(keys, "  "))
+	return styles.BorderStyle.Render(b.String())
+}
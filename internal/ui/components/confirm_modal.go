@@ -0,0 +1,202 @@
+package components
+
+import (
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ConfirmModal asks the user to type a confirmation word before a destructive
+// or irreversible action is submitted. It can optionally collect a message body.
+type ConfirmModal struct {
+	visible      bool
+	width        int
+	height       int
+	title        string
+	summary      []string
+	word         string
+	input        string
+	withBody     bool
+	body         string
+	focusBody    bool
+	confirmed    bool
+	errorMessage string
+}
+
+// NewConfirmModal creates a new confirmation modal
+func NewConfirmModal() *ConfirmModal {
+	return &ConfirmModal{}
+}
+
+// Show displays the modal with the given summary lines. The action is confirmed
+// only after word is typed; when withBody is set a non-empty message is required too.
+func (c *ConfirmModal) Show(title, word string, summary []string, withBody bool) {
+	c.visible = true
+	c.title = title
+	c.word = word
+	c.summary = summary
+	c.withBody = withBody
+	c.focusBody = withBody
+	c.input = ""
+	c.body = ""
+	c.confirmed = false
+	c.errorMessage = ""
+}
+
+// Hide hides the modal without confirming
+func (c *ConfirmModal) Hide() {
+	c.visible = false
+}
+
+// IsVisible returns true if the modal is visible
+func (c *ConfirmModal) IsVisible() bool {
+	return c.visible
+}
+
+// SetSize sets the size of the modal
+func (c *ConfirmModal) SetSize(width, height int) {
+	c.width = width
+	c.height = height
+}
+
+// Confirmed returns true once the user has typed the confirmation word
+func (c *ConfirmModal) Confirmed() bool {
+	return c.confirmed
+}
+
+// Body returns the message entered in the modal
+func (c *ConfirmModal) Body() string {
+	return strings.TrimSpace(c.body)
+}
+
+// Update handles input events
+func (c *ConfirmModal) Update(msg tea.Msg) {
+	if !c.visible {
+		return
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return
+	}
+
+	switch keyMsg.Type {
+	case tea.KeyEsc:
+		c.Hide()
+
+	case tea.KeyTab, tea.KeyShiftTab:
+		if c.withBody {
+			c.focusBody = !c.focusBody
+		}
+
+	case tea.KeyEnter:
+		c.submit()
+
+	case tea.KeyBackspace:
+		field := c.activeField()
+		*field = dropLastGrapheme(*field)
+
+	case tea.KeySpace:
+		*c.activeField() += " "
+
+	case tea.KeyRunes:
+		// Pasted messages keep their line breaks; the confirmation word stays on one line
+		*c.activeField() += typedText(keyMsg, c.withBody && c.focusBody)
+	}
+}
+
+// activeField returns the text field that currently receives input
+func (c *ConfirmModal) activeField() *string {
+	if c.withBody && c.focusBody {
+		return &c.body
+	}
+	return &c.input
+}
+
+// submit confirms the action if the required input is present
+func (c *ConfirmModal) submit() {
+	if c.withBody && c.focusBody {
+		// Enter in the message field moves on to the confirmation field
+		c.focusBody = false
+		return
+	}
+	if c.withBody && c.Body() == "" {
+		c.errorMessage = "A message is required"
+		c.focusBody = true
+		return
+	}
+	if !strings.EqualFold(strings.TrimSpace(c.input), c.word) {
+		c.errorMessage = "Type \"" + c.word + "\" to confirm"
+		return
+	}
+
+	c.confirmed = true
+	c.visible = false
+}
+
+// View renders the confirmation modal
+func (c *ConfirmModal) View() string {
+	if !c.visible {
+		return ""
+	}
+
+	var sections []string
+
+	if len(c.summary) > 0 {
+		sections = append(sections, strings.Join(c.summary, "\n"))
+	}
+
+	if c.withBody {
+		sections = append(sections, c.renderField("Message:", c.body, c.focusBody))
+	}
+
+	prompt := "Type \"" + c.word + "\" to confirm:"
+	sections = append(sections, c.renderField(prompt, c.input, !c.withBody || !c.focusBody))
+
+	if c.errorMessage != "" {
+		sections = append(sections, styles.ErrorStyle.Render(c.errorMessage))
+	}
+
+	help := []string{
+		styles.FormatKeyBinding("enter", "submit"),
+		styles.FormatKeyBinding("esc", "cancel"),
+	}
+	if c.withBody {
+		help = append([]string{styles.FormatKeyBinding("tab", "switch field")}, help...)
+	}
+	sections = append(sections, styles.HelpStyle.Render(strings.Join(help, " • ")))
+
+	width := c.width - 20
+	if width <= 0 {
+		width = 60
+	}
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.ColorPrimary).
+		Padding(1, 2).
+		Width(width).
+		MaxWidth(70)
+
+	title := styles.HeaderStyle.Render(c.title)
+
+	return lipgloss.Place(
+		c.width,
+		c.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		modalStyle.Render(title+"\n\n"+strings.Join(sections, "\n\n")),
+	)
+}
+
+// renderField renders a labelled text field
+func (c *ConfirmModal) renderField(label, value string, focused bool) string {
+	cursor := ""
+	if focused {
+		cursor = styles.CursorStyle.Render("█")
+	}
+	// Lines of a pasted multi-line message are indented under the prompt
+	value = strings.ReplaceAll(value, "\n", "\n  ")
+	return styles.BoldStyle.Render(label) + "\n> " + value + cursor
+}
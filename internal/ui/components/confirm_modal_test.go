@@ -0,0 +1,89 @@
+package components
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func typeInto(c *ConfirmModal, text string) {
+	c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text)})
+}
+
+func TestConfirmModal_RequiresWord(t *testing.T) {
+	c := NewConfirmModal()
+	c.Show("Approve?", "approve", []string{"summary"}, false)
+
+	typeInto(c, "aprove")
+	c.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if c.Confirmed() {
+		t.Fatal("expected misspelled word not to confirm")
+	}
+	if !c.IsVisible() {
+		t.Fatal("expected modal to stay open")
+	}
+
+	for i := 0; i < len("aprove"); i++ {
+		c.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+	typeInto(c, "Approve")
+	c.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !c.Confirmed() {
+		t.Fatal("expected word to confirm case-insensitively")
+	}
+	if c.IsVisible() {
+		t.Error("expected modal to close after confirming")
+	}
+}
+
+func TestConfirmModal_WithBody(t *testing.T) {
+	c := NewConfirmModal()
+	c.Show("Request changes?", "request", nil, true)
+
+	// Body field is focused first
+	typeInto(c, "needs")
+	c.Update(tea.KeyMsg{Type: tea.KeySpace})
+	typeInto(c, "work")
+	c.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	typeInto(c, "request")
+	c.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if !c.Confirmed() {
+		t.Fatal("expected confirmation")
+	}
+	if c.Body() != "needs work" {
+		t.Errorf("expected body %q, got %q", "needs work", c.Body())
+	}
+}
+
+func TestConfirmModal_EscCancels(t *testing.T) {
+	c := NewConfirmModal()
+	c.Show("Approve?", "approve", nil, false)
+	c.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	if c.IsVisible() || c.Confirmed() {
+		t.Error("expected esc to cancel")
+	}
+}
+
+func TestConfirmModal_PasteMultilineBody(t *testing.T) {
+	c := NewConfirmModal()
+	c.SetSize(100, 30)
+	c.Show("Request changes?", "request", nil, true)
+
+	c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("first line\r\nsecond line\rthird\x1b"), Paste: true})
+	if !c.focusBody || c.Confirmed() {
+		t.Fatal("expected the pasted newlines not to act as enter")
+	}
+	if want := "first line\nsecond line\nthird"; c.Body() != want {
+		t.Errorf("Body() = %q, want %q", c.Body(), want)
+	}
+
+	// The confirmation word stays on one line
+	c.Update(tea.KeyMsg{Type: tea.KeyTab})
+	c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("request\n"), Paste: true})
+	c.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !c.Confirmed() {
+		t.Fatal("expected the pasted word to confirm")
+	}
+}
@@ -0,0 +1,139 @@
+package components
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ErrorBannerTimeout is how long an error stays up before it is dismissed
+const ErrorBannerTimeout = 10 * time.Second
+
+// ErrorBannerExpiredMsg dismisses the error it was scheduled for, unless a
+// newer one has replaced it
+type ErrorBannerExpiredMsg struct {
+	seq int
+}
+
+// ErrorBanner is a one-line, dismissible notice for an API error shared by
+// all views. It names the kind of failure (rate limit, network, 404) and
+// offers to retry when the failed operation can be repeated.
+type ErrorBanner struct {
+	source  string
+	err     error
+	retry   tea.Msg
+	seq     int
+	visible bool
+	timeout time.Duration
+}
+
+// NewErrorBanner creates a new error banner
+func NewErrorBanner() *ErrorBanner {
+	return &ErrorBanner{timeout: ErrorBannerTimeout}
+}
+
+// Show replaces the current error with err. source names what failed and
+// retry is the message that repeats it (nil when it cannot be retried). The
+// returned command dismisses the error after ErrorBannerTimeout.
+func (b *ErrorBanner) Show(source string, err error, retry tea.Msg) tea.Cmd {
+	b.source = source
+	b.err = err
+	b.retry = retry
+	b.visible = true
+	b.seq++
+
+	seq := b.seq
+	return tea.Tick(b.timeout, func(time.Time) tea.Msg {
+		return ErrorBannerExpiredMsg{seq: seq}
+	})
+}
+
+// SetTimeout sets how long errors stay up
+func (b *ErrorBanner) SetTimeout(timeout time.Duration) {
+	b.timeout = timeout
+}
+
+// IsVisible returns true while an error is shown
+func (b *ErrorBanner) IsVisible() bool {
+	return b.visible
+}
+
+// CanRetry returns true when the error shown can be retried
+func (b *ErrorBanner) CanRetry() bool {
+	return b.visible && b.retry != nil
+}
+
+// Dismiss hides the error
+func (b *ErrorBanner) Dismiss() {
+	b.visible = false
+	b.retry = nil
+}
+
+// Retry hides the error and returns the message that repeats the failed operation
+func (b *ErrorBanner) Retry() tea.Msg {
+	retry := b.retry
+	b.Dismiss()
+	return retry
+}
+
+// HandleExpired hides the error msg was scheduled for and reports whether it did
+func (b *ErrorBanner) HandleExpired(msg ErrorBannerExpiredMsg) bool {
+	if !b.visible || msg.seq != b.seq {
+		return false
+	}
+	b.Dismiss()
+	return true
+}
+
+// View renders the banner in one line of width cells
+func (b *ErrorBanner) View(width int) string {
+	if !b.visible {
+		return ""
+	}
+
+	keys := []string{styles.FormatKeyBinding("ctrl+x", "dismiss")}
+	if b.retry != nil {
+		keys = append([]string{styles.FormatKeyBinding("ctrl+r", "retry")}, keys...)
+	}
+	hint := "  " + strings.Join(keys, " • ")
+
+	message := styles.IconCross + " " + errorTitle(b.err)
+	if b.source != "" {
+		message += " — " + b.source
+	}
+	message += ": " + strings.Join(strings.Fields(b.err.Error()), " ")
+
+	// The hint stays visible; the message is cut to the remaining cells
+	message = truncateCells(message, width-lipgloss.Width(hint))
+	return styles.ErrorBannerStyle.Render(message) + hint
+}
+
+// errorTitle names the kind of failure
+func errorTitle(err error) string {
+	var apiErr *models.APIError
+	if !errors.As(err, &apiErr) {
+		return "Error"
+	}
+	switch apiErr.Kind {
+	case models.APIErrorRateLimit:
+		if !apiErr.Reset.IsZero() {
+			return "Rate limit exceeded (resets " + apiErr.Reset.Local().Format("15:04") + ")"
+		}
+		return "Rate limit exceeded"
+	case models.APIErrorNotFound:
+		return "Not found"
+	case models.APIErrorNetwork:
+		return "Network error"
+	case models.APIErrorServer:
+		return "GitHub server error"
+	case models.APIErrorTimeout:
+		return "Request timed out"
+	default:
+		return "Error"
+	}
+}
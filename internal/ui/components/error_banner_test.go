@@ -0,0 +1,74 @@
+package components
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestErrorBanner_TitlesByKind(t *testing.T) {
+	tests := []struct {
+		kind models.APIErrorKind
+		want string
+	}{
+		{models.APIErrorRateLimit, "Rate limit exceeded"},
+		{models.APIErrorNotFound, "Not found"},
+		{models.APIErrorNetwork, "Network error"},
+		{models.APIErrorServer, "GitHub server error"},
+	}
+	for _, tt := range tests {
+		banner := NewErrorBanner()
+		err := fmt.Errorf("load: %w", &models.APIError{Kind: tt.kind, Err: errors.New("boom")})
+		banner.Show("issues", err, nil)
+		if view := banner.View(120); !strings.Contains(view, "✗ "+tt.want+" — issues: load: boom") {
+			t.Errorf("%s: got %q", tt.kind, view)
+		}
+	}
+
+	banner := NewErrorBanner()
+	banner.Show("", errors.New("something broke"), nil)
+	if view := banner.View(120); !strings.Contains(view, "✗ Error: something broke") {
+		t.Errorf("expected a generic title for other errors, got %q", view)
+	}
+}
+
+func TestErrorBanner_FitsOneLine(t *testing.T) {
+	banner := NewErrorBanner()
+	banner.Show("pull requests", errors.New(strings.Repeat("very long\nerror ", 20)), "retry")
+
+	view := banner.View(60)
+	if strings.Contains(view, "\n") {
+		t.Fatalf("expected one line, got %q", view)
+	}
+	if w := lipgloss.Width(view); w > 60 {
+		t.Errorf("width = %d, want at most 60", w)
+	}
+	if !strings.Contains(view, "ctrl+r") || !strings.Contains(view, "ctrl+x") {
+		t.Errorf("expected the retry and dismiss keys to stay visible, got %q", view)
+	}
+}
+
+func TestErrorBanner_RetryAndExpire(t *testing.T) {
+	banner := NewErrorBanner()
+	banner.Show("gists", errors.New("boom"), "retry")
+	if !banner.CanRetry() {
+		t.Fatal("expected the error to be retryable")
+	}
+	if retry := banner.Retry(); retry != "retry" || banner.IsVisible() {
+		t.Errorf("Retry() = %v, visible = %v; want the retry message and the banner hidden", retry, banner.IsVisible())
+	}
+
+	banner.Show("gists", errors.New("first"), nil)
+	stale := ErrorBannerExpiredMsg{seq: banner.seq}
+	banner.Show("gists", errors.New("second"), nil)
+	if banner.HandleExpired(stale) || !banner.IsVisible() {
+		t.Error("expected the timer of a replaced error to be ignored")
+	}
+	if !banner.HandleExpired(ErrorBannerExpiredMsg{seq: banner.seq}) || banner.IsVisible() {
+		t.Error("expected the error to be dismissed when its timer fires")
+	}
+}
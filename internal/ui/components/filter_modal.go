@@ -16,6 +16,18 @@ type FilterOption struct {
 	Selected bool
 }
 
+// sortChoices are the sort options in display order
+var sortChoices = []struct {
+	sort  models.IssueSort
+	label func() string
+}{
+	{models.IssueSortCreated, func() string { return "Created" }},
+	{models.IssueSortUpdated, func() string { return "Updated" }},
+	{models.IssueSortComments, func() string { return "Comments" }},
+	// The icon is read when rendering, as it may be swapped for ASCII
+	{models.IssueSortReactions, func() string { return "Reactions (" + styles.IconThumbsUp + ")" }},
+}
+
 // FilterModal represents a filter configuration modal
 type FilterModal struct {
 	visible        bool
@@ -199,8 +211,8 @@ func (f *FilterModal) getMaxCursor() int {
 	stateOptions := 3
 	// Label options
 	labelOptions := len(f.availableLabels)
-	// Sort options (3: created, updated, comments)
-	sortOptions := 3
+	// Sort options (4: created, updated, comments, reactions)
+	sortOptions := len(sortChoices)
 	// Direction options (2: asc, desc)
 	directionOptions := 2
 
@@ -235,20 +247,13 @@ func (f *FilterModal) handleSelection() {
 
 	position -= len(f.availableLabels)
 
-	// Sort section (0-2)
-	if position >= 0 && position <= 2 {
-		switch position {
-		case 0:
-			f.sort = models.IssueSortCreated
-		case 1:
-			f.sort = models.IssueSortUpdated
-		case 2:
-			f.sort = models.IssueSortComments
-		}
+	// Sort section
+	if position >= 0 && position < len(sortChoices) {
+		f.sort = sortChoices[position].sort
 		return
 	}
 
-	position -= 3
+	position -= len(sortChoices)
 
 	// Direction section (0-1)
 	if position >= 0 && position <= 1 {
@@ -326,12 +331,12 @@ func (f *FilterModal) renderStateSection(currentIndex *int) string {
 	for _, s := range states {
 		cursor := "  "
 		if *currentIndex == f.cursor {
-			cursor = styles.CursorStyle.Render("▶ ")
+			cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
 		}
 
 		checkbox := "[ ]"
 		if f.state == s.state {
-			checkbox = "[✓]"
+			checkbox = "[" + styles.IconCheck + "]"
 		}
 
 		line := cursor + checkbox + " " + s.label
@@ -354,12 +359,12 @@ func (f *FilterModal) renderLabelsSection(currentIndex *int) string {
 	for _, label := range f.availableLabels {
 		cursor := "  "
 		if *currentIndex == f.cursor {
-			cursor = styles.CursorStyle.Render("▶ ")
+			cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
 		}
 
 		checkbox := "[ ]"
 		if f.selectedLabels[label] {
-			checkbox = "[✓]"
+			checkbox = "[" + styles.IconCheck + "]"
 		}
 
 		line := cursor + checkbox + " " + label
@@ -379,27 +384,18 @@ func (f *FilterModal) renderSortSection(currentIndex *int) string {
 	var lines []string
 	lines = append(lines, styles.BoldStyle.Render("Sort by:"))
 
-	sorts := []struct {
-		sort  models.IssueSort
-		label string
-	}{
-		{models.IssueSortCreated, "Created"},
-		{models.IssueSortUpdated, "Updated"},
-		{models.IssueSortComments, "Comments"},
-	}
-
-	for _, s := range sorts {
+	for _, s := range sortChoices {
 		cursor := "  "
 		if *currentIndex == f.cursor {
-			cursor = styles.CursorStyle.Render("▶ ")
+			cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
 		}
 
 		checkbox := "( )"
 		if f.sort == s.sort {
-			checkbox = "(●)"
+			checkbox = "(" + styles.IconDot + ")"
 		}
 
-		line := cursor + checkbox + " " + s.label
+		line := cursor + checkbox + " " + s.label()
 		if *currentIndex == f.cursor {
 			line = styles.SelectedStyle.Render(line)
 		}
@@ -427,12 +423,12 @@ func (f *FilterModal) renderDirectionSection(currentIndex *int) string {
 	for _, d := range directions {
 		cursor := "  "
 		if *currentIndex == f.cursor {
-			cursor = styles.CursorStyle.Render("▶ ")
+			cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
 		}
 
 		checkbox := "( )"
 		if f.direction == d.direction {
-			checkbox = "(●)"
+			checkbox = "(" + styles.IconDot + ")"
 		}
 
 		line := cursor + checkbox + " " + d.label
@@ -492,6 +492,13 @@ func TestFilterModal_HandleSelection_Sort(t *testing.T) {
 	if fm.sort != models.IssueSortComments {
 		t.Errorf("Expected sort Comments, got %s", fm.sort)
 	}
+
+	// Select "Reactions" (cursor 7)
+	fm.cursor = 7
+	fm.handleSelection()
+	if fm.sort != models.IssueSortReactions {
+		t.Errorf("Expected sort Reactions, got %s", fm.sort)
+	}
 }
 
 func TestFilterModal_HandleSelection_Direction(t *testing.T) {
@@ -499,17 +506,17 @@ func TestFilterModal_HandleSelection_Direction(t *testing.T) {
 	fm.Show()
 	fm.SetLabels([]string{"bug"}) // 1 label
 
-	// Direction options start at cursor 3 (states) + 1 (label) + 3 (sorts) = 7
+	// Direction options start at cursor 3 (states) + 1 (label) + 4 (sorts) = 8
 
-	// Select "Ascending" (cursor 7)
-	fm.cursor = 7
+	// Select "Ascending" (cursor 8)
+	fm.cursor = 8
 	fm.handleSelection()
 	if fm.direction != models.SortDirectionAsc {
 		t.Errorf("Expected direction Asc, got %s", fm.direction)
 	}
 
-	// Select "Descending" (cursor 8)
-	fm.cursor = 8
+	// Select "Descending" (cursor 9)
+	fm.cursor = 9
 	fm.handleSelection()
 	if fm.direction != models.SortDirectionDesc {
 		t.Errorf("Expected direction Desc, got %s", fm.direction)
@@ -520,9 +527,9 @@ func TestFilterModal_GetMaxCursor(t *testing.T) {
 	fm := NewFilterModal()
 	fm.SetLabels([]string{"bug", "feature", "docs"})
 
-	// 3 states + 3 labels + 3 sorts + 2 directions - 1 = 10
+	// 3 states + 3 labels + 4 sorts + 2 directions - 1 = 11
 	maxCursor := fm.getMaxCursor()
-	expected := 3 + 3 + 3 + 2 - 1
+	expected := 3 + 3 + 4 + 2 - 1
 	if maxCursor != expected {
 		t.Errorf("Expected max cursor %d, got %d", expected, maxCursor)
 	}
@@ -604,8 +611,8 @@ func TestFilterModal_RenderSections(t *testing.T) {
 	if sortView == "" {
 		t.Error("Sort section should not be empty")
 	}
-	if currentIndex != 7 {
-		t.Errorf("Expected currentIndex to be 7 after sorts, got %d", currentIndex)
+	if currentIndex != 8 {
+		t.Errorf("Expected currentIndex to be 8 after sorts, got %d", currentIndex)
 	}
 
 	// Test direction section rendering
@@ -613,7 +620,7 @@ func TestFilterModal_RenderSections(t *testing.T) {
 	if directionView == "" {
 		t.Error("Direction section should not be empty")
 	}
-	if currentIndex != 9 {
-		t.Errorf("Expected currentIndex to be 9 after directions, got %d", currentIndex)
+	if currentIndex != 10 {
+		t.Errorf("Expected currentIndex to be 10 after directions, got %d", currentIndex)
 	}
 }
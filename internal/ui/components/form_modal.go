@@ -0,0 +1,215 @@
+package components
+
+import (
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// FormField describes one input of a FormModal. Checkbox fields are toggled
+// with space instead of taking text.
+type FormField struct {
+	Label       string
+	Placeholder string
+	Value       string
+	Required    bool
+	Checkbox    bool
+	Checked     bool
+}
+
+// FormModal collects a few text fields and checkboxes, e.g. to create an entity
+type FormModal struct {
+	visible      bool
+	width        int
+	height       int
+	title        string
+	fields       []FormField
+	focus        int
+	submitted    bool
+	errorMessage string
+}
+
+// NewFormModal creates a new form modal
+func NewFormModal() *FormModal {
+	return &FormModal{}
+}
+
+// Show displays the modal with the given fields, focusing the first one
+func (f *FormModal) Show(title string, fields []FormField) {
+	f.visible = true
+	f.title = title
+	f.fields = fields
+	f.focus = 0
+	f.submitted = false
+	f.errorMessage = ""
+}
+
+// Hide hides the modal without submitting
+func (f *FormModal) Hide() {
+	f.visible = false
+}
+
+// IsVisible returns true if the modal is visible
+func (f *FormModal) IsVisible() bool {
+	return f.visible
+}
+
+// SetSize sets the size of the modal
+func (f *FormModal) SetSize(width, height int) {
+	f.width = width
+	f.height = height
+}
+
+// Submitted returns true once the form has been submitted with all required fields
+func (f *FormModal) Submitted() bool {
+	return f.submitted
+}
+
+// Value returns the trimmed text of the field at index i
+func (f *FormModal) Value(i int) string {
+	if i < 0 || i >= len(f.fields) {
+		return ""
+	}
+	return strings.TrimSpace(f.fields[i].Value)
+}
+
+// Checked returns whether the checkbox at index i is checked
+func (f *FormModal) Checked(i int) bool {
+	if i < 0 || i >= len(f.fields) {
+		return false
+	}
+	return f.fields[i].Checked
+}
+
+// Update handles input events
+func (f *FormModal) Update(msg tea.Msg) {
+	if !f.visible || len(f.fields) == 0 {
+		return
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return
+	}
+
+	field := &f.fields[f.focus]
+	switch keyMsg.Type {
+	case tea.KeyEsc:
+		f.Hide()
+
+	case tea.KeyTab, tea.KeyDown:
+		f.focus = (f.focus + 1) % len(f.fields)
+
+	case tea.KeyShiftTab, tea.KeyUp:
+		f.focus = (f.focus + len(f.fields) - 1) % len(f.fields)
+
+	case tea.KeyEnter:
+		f.submit()
+
+	case tea.KeyBackspace:
+		if !field.Checkbox {
+			field.Value = dropLastGrapheme(field.Value)
+		}
+
+	case tea.KeySpace:
+		if field.Checkbox {
+			field.Checked = !field.Checked
+		} else {
+			field.Value += " "
+		}
+
+	case tea.KeyRunes:
+		if !field.Checkbox {
+			field.Value += typedText(keyMsg, false)
+		}
+	}
+}
+
+// submit submits the form if every required field has a value
+func (f *FormModal) submit() {
+	for i, field := range f.fields {
+		if field.Required && !field.Checkbox && f.Value(i) == "" {
+			f.errorMessage = field.Label + " is required"
+			f.focus = i
+			return
+		}
+	}
+
+	f.submitted = true
+	f.visible = false
+}
+
+// View renders the form modal
+func (f *FormModal) View() string {
+	if !f.visible {
+		return ""
+	}
+
+	var sections []string
+	for i, field := range f.fields {
+		sections = append(sections, f.renderField(field, i == f.focus))
+	}
+
+	if f.errorMessage != "" {
+		sections = append(sections, styles.ErrorStyle.Render(f.errorMessage))
+	}
+
+	help := []string{
+		styles.FormatKeyBinding("tab", "next field"),
+		styles.FormatKeyBinding("space", "toggle"),
+		styles.FormatKeyBinding("enter", "submit"),
+		styles.FormatKeyBinding("esc", "cancel"),
+	}
+	sections = append(sections, styles.HelpStyle.Render(strings.Join(help, " • ")))
+
+	width := f.width - 20
+	if width <= 0 {
+		width = 60
+	}
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.ColorPrimary).
+		Padding(1, 2).
+		Width(width).
+		MaxWidth(70)
+
+	title := styles.HeaderStyle.Render(f.title)
+
+	return lipgloss.Place(
+		f.width,
+		f.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		modalStyle.Render(title+"\n\n"+strings.Join(sections, "\n\n")),
+	)
+}
+
+// renderField renders a labelled text field or checkbox
+func (f *FormModal) renderField(field FormField, focused bool) string {
+	prefix := "  "
+	if focused {
+		prefix = styles.CursorStyle.Render(styles.IconCursor + " ")
+	}
+
+	if field.Checkbox {
+		box := "[ ]"
+		if field.Checked {
+			box = "[x]"
+		}
+		return prefix + box + " " + styles.BoldStyle.Render(field.Label)
+	}
+
+	label := field.Label
+	if field.Required {
+		label += " *"
+	}
+	value := field.Value
+	if focused {
+		value += styles.CursorStyle.Render("█")
+	} else if value == "" && field.Placeholder != "" {
+		value = styles.MutedStyle.Render(field.Placeholder)
+	}
+	return prefix + styles.BoldStyle.Render(label) + "\n  > " + value
+}
@@ -0,0 +1,100 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func newTestForm() *FormModal {
+	f := NewFormModal()
+	f.SetSize(80, 30)
+	f.Show("New release", []FormField{
+		{Label: "Tag", Required: true},
+		{Label: "Title", Placeholder: "defaults to the tag"},
+		{Label: "Generate notes", Checkbox: true, Checked: true},
+	})
+	return f
+}
+
+func TestFormModal_RequiredField(t *testing.T) {
+	f := newTestForm()
+
+	f.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if f.Submitted() || !f.IsVisible() {
+		t.Fatal("expected submit without the required tag to be rejected")
+	}
+	if !strings.Contains(f.View(), "Tag is required") {
+		t.Errorf("expected an error message, got\n%s", f.View())
+	}
+
+	f.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v1.2.0")})
+	f.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !f.Submitted() || f.IsVisible() {
+		t.Fatal("expected the form to be submitted")
+	}
+	if f.Value(0) != "v1.2.0" || f.Value(1) != "" || !f.Checked(2) {
+		t.Errorf("unexpected values %q %q %v", f.Value(0), f.Value(1), f.Checked(2))
+	}
+}
+
+func TestFormModal_Navigation(t *testing.T) {
+	f := newTestForm()
+
+	f.Update(tea.KeyMsg{Type: tea.KeyTab})
+	f.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Spring")})
+	f.Update(tea.KeyMsg{Type: tea.KeySpace})
+	f.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("release")})
+	if f.Value(1) != "Spring release" {
+		t.Errorf("title = %q", f.Value(1))
+	}
+
+	// Space toggles the checkbox and typing into it is ignored
+	f.Update(tea.KeyMsg{Type: tea.KeyTab})
+	f.Update(tea.KeyMsg{Type: tea.KeySpace})
+	f.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	if f.Checked(2) {
+		t.Error("expected space to uncheck the checkbox")
+	}
+
+	// Tab wraps around to the first field
+	f.Update(tea.KeyMsg{Type: tea.KeyTab})
+	f.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v2")})
+	if f.Value(0) != "v2" {
+		t.Errorf("tag = %q", f.Value(0))
+	}
+}
+
+func TestFormModal_EscCancels(t *testing.T) {
+	f := newTestForm()
+	f.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if f.IsVisible() || f.Submitted() {
+		t.Error("expected esc to close the form without submitting")
+	}
+}
+
+func TestFormModal_PasteDoesNotSubmit(t *testing.T) {
+	f := newTestForm()
+
+	f.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v1.2.0\n"), Paste: true})
+	if f.Submitted() || !f.IsVisible() {
+		t.Fatal("expected a pasted newline not to submit the form")
+	}
+	f.Update(tea.KeyMsg{Type: tea.KeyTab})
+	f.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Spring\r\nrelease"), Paste: true})
+	if f.Value(0) != "v1.2.0" || f.Value(1) != "Spring release" {
+		t.Errorf("unexpected values %q %q", f.Value(0), f.Value(1))
+	}
+}
+
+func TestFormModal_BackspaceDeletesWholeCharacter(t *testing.T) {
+	form := NewFormModal()
+	form.Show("Create release", []FormField{{Label: "Title"}})
+
+	form.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("リリース👨‍👩‍👧")})
+	form.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	if want := "リリース"; form.Value(0) != want {
+		t.Errorf("value = %q, want %q", form.Value(0), want)
+	}
+}
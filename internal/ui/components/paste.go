@@ -0,0 +1,38 @@
+package components
+
+import (
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// typedText returns the text a rune key inserts into a field. Bracketed pastes
+// arrive as one message with the newlines of the pasted text: multiline fields
+// keep them (as "\n", whatever the line endings) and single-line fields turn
+// them into spaces, so a pasted newline never acts as enter. Other control
+// characters, such as stray escape sequences, are dropped.
+func typedText(msg tea.KeyMsg, multiline bool) string {
+	if !msg.Paste {
+		return string(msg.Runes)
+	}
+
+	text := strings.ReplaceAll(string(msg.Runes), "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\r", "\n")
+	if !multiline {
+		text = strings.ReplaceAll(strings.TrimRight(text, "\n"), "\n", " ")
+	}
+
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '\n':
+			return r
+		case r == '\t':
+			return ' '
+		case unicode.IsControl(r):
+			return -1
+		default:
+			return r
+		}
+	}, text)
+}
@@ -0,0 +1,221 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ProgressUnit selects how the counts of a ProgressBar are rendered
+type ProgressUnit int
+
+const (
+	// ProgressUnitItems renders counts as "3/10"
+	ProgressUnitItems ProgressUnit = iota
+	// ProgressUnitBytes renders counts as "1.2 MB/4.0 MB"
+	ProgressUnitBytes
+)
+
+// progressBarCells is the width of the bar itself
+const progressBarCells = 24
+
+// ProgressBar renders the progress of a long-running operation, such as a
+// batch edit or a download, with a hint on how to cancel it
+type ProgressBar struct {
+	active    bool
+	title     string
+	unit      ProgressUnit
+	cancelKey string
+	cancelled bool
+	progress  models.Progress
+	width     int
+}
+
+// NewProgressBar creates a new progress bar
+func NewProgressBar() *ProgressBar {
+	return &ProgressBar{}
+}
+
+// Start shows the bar for a new operation. cancelKey is shown as the key that
+// cancels it; leave it empty for operations that cannot be cancelled.
+func (p *ProgressBar) Start(title string, unit ProgressUnit, cancelKey string) {
+	p.active = true
+	p.title = title
+	p.unit = unit
+	p.cancelKey = cancelKey
+	p.cancelled = false
+	p.progress = models.Progress{}
+}
+
+// SetProgress updates the progress shown
+func (p *ProgressBar) SetProgress(progress models.Progress) {
+	p.progress = progress
+}
+
+// Progress returns the last progress shown
+func (p *ProgressBar) Progress() models.Progress {
+	return p.progress
+}
+
+// Cancel marks the operation as being cancelled
+func (p *ProgressBar) Cancel() {
+	p.cancelled = true
+}
+
+// IsCancelled returns true once the operation has been cancelled
+func (p *ProgressBar) IsCancelled() bool {
+	return p.cancelled
+}
+
+// Stop hides the bar
+func (p *ProgressBar) Stop() {
+	p.active = false
+}
+
+// IsActive returns true while an operation is shown
+func (p *ProgressBar) IsActive() bool {
+	return p.active
+}
+
+// SetWidth sets the available width
+func (p *ProgressBar) SetWidth(width int) {
+	p.width = width
+}
+
+// View renders the progress bar
+func (p *ProgressBar) View() string {
+	if !p.active {
+		return ""
+	}
+
+	parts := []string{styles.BoldStyle.Render(p.title)}
+
+	if fraction := p.progress.Fraction(); fraction >= 0 {
+		filled := int(fraction * progressBarCells)
+		bar := styles.SuccessStyle.Render(strings.Repeat("█", filled)) +
+			styles.MutedStyle.Render(strings.Repeat("░", progressBarCells-filled))
+		parts = append(parts, bar, p.formatCount(), fmt.Sprintf("%3d%%", int(fraction*100)))
+	} else {
+		parts = append(parts, p.formatCount())
+	}
+
+	if p.progress.Failed > 0 {
+		parts = append(parts, styles.ErrorStyle.Render(fmt.Sprintf("%d failed", p.progress.Failed)))
+	}
+	if p.progress.Current != "" {
+		parts = append(parts, styles.MutedStyle.Render(p.progress.Current))
+	}
+
+	switch {
+	case p.cancelled:
+		parts = append(parts, styles.WarningStyle.Render("cancelling..."))
+	case p.cancelKey != "":
+		parts = append(parts, styles.FormatKeyBinding(p.cancelKey, "cancel"))
+	}
+
+	line := strings.Join(parts, "  ")
+	if p.width > 0 {
+		line = styles.NormalStyle.MaxWidth(p.width).Render(line)
+	}
+	return line
+}
+
+// formatCount renders the done and total counts in the bar's unit
+func (p *ProgressBar) formatCount() string {
+	format := func(n int64) string { return fmt.Sprintf("%d", n) }
+	if p.unit == ProgressUnitBytes {
+		format = formatBytes
+	}
+	if p.progress.Total <= 0 {
+		return format(p.progress.Done)
+	}
+	return format(p.progress.Done) + "/" + format(p.progress.Total)
+}
+
+// formatBytes renders a byte count as "512 B", "1.5 KB" or "3.2 MB"
+func formatBytes(n int64) string {
+	switch {
+	case n < 1024:
+		return fmt.Sprintf("%d B", n)
+	case n < 1024*1024:
+		return fmt.Sprintf("%.1f KB", float64(n)/1024)
+	default:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1024*1024))
+	}
+}
+
+// ProgressMsg delivers a progress update of the operation with the given ID.
+// Finished is set on the last message, once the operation has ended.
+type ProgressMsg struct {
+	ID       string
+	Progress models.Progress
+	Finished bool
+}
+
+// progressReporterCount numbers the reporters so their messages can be told apart
+var progressReporterCount int64
+
+// ProgressReporter turns the progress callback of a use case running in a
+// goroutine into ProgressMsg messages. Updates that arrive faster than they
+// are rendered are coalesced so only the latest one is delivered.
+type ProgressReporter struct {
+	id    string
+	ch    chan models.Progress
+	final models.Progress
+}
+
+// NewProgressReporter creates a reporter for a new operation of the given kind
+func NewProgressReporter(kind string) *ProgressReporter {
+	n := atomic.AddInt64(&progressReporterCount, 1)
+	return &ProgressReporter{
+		id: fmt.Sprintf("%s-%d", kind, n),
+		ch: make(chan models.Progress, 32),
+	}
+}
+
+// ID returns the ID carried by the reporter's messages
+func (r *ProgressReporter) ID() string {
+	return r.id
+}
+
+// Report records a progress update. It never blocks; it is meant to be passed
+// to a use case as its progress callback.
+func (r *ProgressReporter) Report(progress models.Progress) {
+	select {
+	case r.ch <- progress:
+	default:
+	}
+}
+
+// Finish ends the operation with its final progress. It must be called exactly
+// once, after the last Report.
+func (r *ProgressReporter) Finish(final models.Progress) {
+	r.final = final
+	close(r.ch)
+}
+
+// Listen waits for the next update. Call it again after each message that is
+// not Finished.
+func (r *ProgressReporter) Listen() tea.Cmd {
+	return func() tea.Msg {
+		progress, ok := <-r.ch
+		if !ok {
+			return ProgressMsg{ID: r.id, Progress: r.final, Finished: true}
+		}
+		for {
+			select {
+			case latest, ok := <-r.ch:
+				if !ok {
+					return ProgressMsg{ID: r.id, Progress: r.final, Finished: true}
+				}
+				progress = latest
+			default:
+				return ProgressMsg{ID: r.id, Progress: progress}
+			}
+		}
+	}
+}
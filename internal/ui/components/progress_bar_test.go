@@ -0,0 +1,70 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func TestProgressBar_View(t *testing.T) {
+	p := NewProgressBar()
+	if p.View() != "" {
+		t.Fatal("expected an inactive bar to render nothing")
+	}
+
+	p.Start("Labeling issues", ProgressUnitItems, "x")
+	p.SetProgress(models.Progress{Done: 3, Total: 10, Failed: 1, Current: "#12"})
+	out := p.View()
+	for _, want := range []string{"Labeling issues", "3/10", "30%", "1 failed", "#12", "cancel"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in %q", want, out)
+		}
+	}
+
+	p.Cancel()
+	if out := p.View(); !strings.Contains(out, "cancelling...") {
+		t.Errorf("expected the bar to show the cancellation, got %q", out)
+	}
+
+	p.Stop()
+	if p.IsActive() || p.View() != "" {
+		t.Error("expected Stop to hide the bar")
+	}
+}
+
+func TestProgressBar_Bytes(t *testing.T) {
+	p := NewProgressBar()
+	p.Start("Downloading tig-gh.tar.gz", ProgressUnitBytes, "")
+	p.SetProgress(models.Progress{Done: 1536, Total: 2 * 1024 * 1024})
+	if out := p.View(); !strings.Contains(out, "1.5 KB/2.0 MB") || strings.Contains(out, "cancel") {
+		t.Errorf("unexpected bar %q", out)
+	}
+
+	// Without a total only the count is shown
+	p.SetProgress(models.Progress{Done: 512})
+	if out := p.View(); !strings.Contains(out, "512 B") || strings.Contains(out, "%") {
+		t.Errorf("unexpected bar %q", out)
+	}
+}
+
+func TestProgressReporter_CoalescesAndFinishes(t *testing.T) {
+	r := NewProgressReporter("test")
+	r.Report(models.Progress{Done: 1, Total: 3})
+	r.Report(models.Progress{Done: 2, Total: 3})
+
+	msg := r.Listen()().(ProgressMsg)
+	if msg.ID != r.ID() || msg.Finished || msg.Progress.Done != 2 {
+		t.Fatalf("Listen() = %+v, want the latest update", msg)
+	}
+
+	r.Finish(models.Progress{Done: 3, Total: 3})
+	msg = r.Listen()().(ProgressMsg)
+	if !msg.Finished || msg.Progress.Done != 3 {
+		t.Fatalf("Listen() = %+v, want the final progress", msg)
+	}
+
+	if other := NewProgressReporter("test"); other.ID() == r.ID() {
+		t.Error("expected reporters to have distinct IDs")
+	}
+}
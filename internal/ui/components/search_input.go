@@ -57,8 +57,8 @@ func (s *SearchInput) IsActive() bool {
 func (s *SearchInput) SetValue(value string) {
 	s.value = value
 	// Keep cursor in bounds
-	if s.cursor > len(s.value) {
-		s.cursor = len(s.value)
+	if n := len([]rune(s.value)); s.cursor > n {
+		s.cursor = n
 	}
 }
 
@@ -89,39 +89,38 @@ func (s *SearchInput) Update(msg tea.Msg) {
 	case tea.KeyMsg:
 		switch msg.Type {
 		case tea.KeyRunes:
-			// Insert character at cursor position
+			// Insert character (or pasted text) at cursor position
+			text := typedText(msg, false)
 			runes := []rune(s.value)
 			before := string(runes[:s.cursor])
 			after := string(runes[s.cursor:])
-			s.value = before + string(msg.Runes) + after
-			s.cursor += len(msg.Runes)
+			s.value = before + text + after
+			s.cursor += len([]rune(text))
+
+		case tea.KeySpace:
+			s.Insert(" ")
 
 		case tea.KeyBackspace:
+			// Delete the whole character before the cursor, not just its last rune
 			if s.cursor > 0 {
 				runes := []rune(s.value)
-				before := string(runes[:s.cursor-1])
-				after := string(runes[s.cursor:])
-				s.value = before + after
-				s.cursor--
+				start := prevBound(s.value, s.cursor)
+				s.value = string(runes[:start]) + string(runes[s.cursor:])
+				s.cursor = start
 			}
 
 		case tea.KeyDelete:
 			if s.cursor < len([]rune(s.value)) {
 				runes := []rune(s.value)
-				before := string(runes[:s.cursor])
-				after := string(runes[s.cursor+1:])
-				s.value = before + after
+				end := nextBound(s.value, s.cursor)
+				s.value = string(runes[:s.cursor]) + string(runes[end:])
 			}
 
 		case tea.KeyLeft:
-			if s.cursor > 0 {
-				s.cursor--
-			}
+			s.cursor = prevBound(s.value, s.cursor)
 
 		case tea.KeyRight:
-			if s.cursor < len([]rune(s.value)) {
-				s.cursor++
-			}
+			s.cursor = nextBound(s.value, s.cursor)
 
 		case tea.KeyHome:
 			s.cursor = 0
@@ -162,29 +161,32 @@ func (s *SearchInput) View() string {
 			BorderForeground(styles.ColorBorder)
 	}
 
+	// Cells left for the text inside the border, padding and prompt. The
+	// text scrolls horizontally by display width, so wide (CJK) characters
+	// neither wrap the box nor leave the cursor out of view.
+	textWidth := s.width - 8
+	if textWidth < 1 {
+		textWidth = 1
+	}
+
 	// Add cursor if active
 	var content string
 	if s.active && s.cursor <= len([]rune(s.value)) {
-		runes := []rune(displayValue)
-		before := string(runes[:s.cursor])
+		before, after := fitAroundCursor(displayValue, s.cursor, textWidth)
 		cursor := "│"
-		after := ""
-		if s.cursor < len(runes) {
-			after = string(runes[s.cursor:])
-		}
 
 		cursorStyle := lipgloss.NewStyle().Foreground(styles.ColorPrimary)
 		content = before + cursorStyle.Render(cursor) + after
 	} else {
 		if displayValue == "" && !s.active {
-			content = styles.MutedStyle.Render(s.placeholder)
+			content = styles.MutedStyle.Render(truncateCells(s.placeholder, textWidth))
 		} else {
-			content = displayValue
+			content = truncateCells(displayValue, textWidth)
 		}
 	}
 
 	// Render the search icon
-	searchIcon := "🔍 "
+	var searchIcon string
 	if s.active {
 		searchIcon = styles.StatusKeyStyle.Render("/ ")
 	} else {
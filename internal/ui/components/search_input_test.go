@@ -1,9 +1,11 @@
 package components
 
 import (
+	"strings"
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 )
 
 func TestNewSearchInput(t *testing.T) {
@@ -476,3 +478,92 @@ func TestSearchInput_View_WithCursor(t *testing.T) {
 		t.Error("View should not be empty with cursor in middle")
 	}
 }
+
+func TestSearchInput_PasteJoinsLines(t *testing.T) {
+	si := NewSearchInput()
+	si.Activate()
+	si.SetValue("is:open ")
+	si.cursor = len("is:open ")
+
+	si.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("label:bug\r\nauthor:octocat\n"), Paste: true})
+	if want := "is:open label:bug author:octocat"; si.GetValue() != want {
+		t.Errorf("value = %q, want %q", si.GetValue(), want)
+	}
+	if si.cursor != len([]rune(si.GetValue())) {
+		t.Errorf("cursor = %d, want it after the pasted text", si.cursor)
+	}
+}
+
+func TestSearchInput_MovesOverWholeCharacters(t *testing.T) {
+	si := NewSearchInput()
+	si.Activate()
+	// "が" typed as か + combining dakuten, then a skin-toned emoji
+	si.SetValue("日本が👍🏽")
+	si.MoveCursorToEnd()
+
+	si.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	if want := len([]rune("日本が")); si.cursor != want {
+		t.Errorf("left should skip the whole emoji: cursor = %d, want %d", si.cursor, want)
+	}
+
+	si.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	if want := "日本👍🏽"; si.GetValue() != want {
+		t.Errorf("backspace should delete the whole character: got %q, want %q", si.GetValue(), want)
+	}
+
+	si.Update(tea.KeyMsg{Type: tea.KeyDelete})
+	if want := "日本"; si.GetValue() != want {
+		t.Errorf("delete should delete the whole emoji: got %q, want %q", si.GetValue(), want)
+	}
+}
+
+func TestSearchInput_InsertsIMECommit(t *testing.T) {
+	si := NewSearchInput()
+	si.Activate()
+	si.SetValue("is:open ")
+	si.MoveCursorToEnd()
+
+	// IMEs commit the composed text as one message
+	si.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("検索")})
+	si.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	if want := "is:open 検索 "; si.GetValue() != want {
+		t.Errorf("value = %q, want %q", si.GetValue(), want)
+	}
+	if si.cursor != len([]rune(si.GetValue())) {
+		t.Errorf("cursor = %d, want it after the committed text", si.cursor)
+	}
+}
+
+func TestSearchInput_View_ScrollsWideText(t *testing.T) {
+	si := NewSearchInput()
+	si.SetSize(20, 1)
+	si.Activate()
+	si.SetValue(strings.Repeat("漢字", 10))
+	si.MoveCursorToEnd()
+
+	view := si.View()
+	if lines := strings.Split(view, "\n"); len(lines) != 3 {
+		t.Fatalf("wide text should scroll instead of wrapping, got %d lines:\n%s", len(lines), view)
+	}
+	if !strings.Contains(view, "漢字│") {
+		t.Errorf("cursor should stay visible at the end of the text:\n%s", view)
+	}
+	lines := strings.Split(view, "\n")
+	for _, line := range lines {
+		if w, want := lipgloss.Width(line), lipgloss.Width(lines[0]); w != want {
+			t.Errorf("line width = %d, want %d like the border: %q", w, want, line)
+		}
+	}
+}
+
+func TestFitAroundCursor(t *testing.T) {
+	before, after := fitAroundCursor("あいうえお", 1, 6)
+	if before != "あ" || after != "い" {
+		t.Errorf("got %q|%q, want the cursor near the start with no half characters", before, after)
+	}
+
+	before, after = fitAroundCursor("あいうえお", 5, 6)
+	if before != "えお" || after != "" {
+		t.Errorf("got %q|%q, want the end of the text before the cursor", before, after)
+	}
+}
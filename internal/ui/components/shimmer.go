@@ -0,0 +1,44 @@
+package components
+
+import (
+	"strings"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ShimmerInterval is how often a shimmer moves its highlight
+const ShimmerInterval = 120 * time.Millisecond
+
+// ShimmerTickMsg advances every shimmer on screen by one frame
+type ShimmerTickMsg struct{}
+
+// ShimmerTick schedules the next frame of the shimmers
+func ShimmerTick() tea.Cmd {
+	return tea.Tick(ShimmerInterval, func(time.Time) tea.Msg {
+		return ShimmerTickMsg{}
+	})
+}
+
+// RenderShimmer renders width placeholder cells standing in for a value that
+// is still loading. The highlight sweeps across them as frame advances.
+func RenderShimmer(width, frame int) string {
+	if width <= 0 {
+		return ""
+	}
+	if frame < 0 {
+		frame = 0
+	}
+	// The highlight leaves the row for a frame before sweeping again
+	peak := frame % (width + 1)
+	var s strings.Builder
+	for i := 0; i < width; i++ {
+		if i == peak {
+			s.WriteString(styles.IconShimmerPeak)
+		} else {
+			s.WriteString(styles.IconShimmer)
+		}
+	}
+	return styles.MutedStyle.Render(s.String())
+}
@@ -0,0 +1,26 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+)
+
+func TestRenderShimmer(t *testing.T) {
+	if got := RenderShimmer(0, 3); got != "" {
+		t.Errorf("expected nothing for zero width, got %q", got)
+	}
+
+	peak, cell := styles.IconShimmerPeak, styles.IconShimmer
+	for frame, want := range []string{
+		peak + cell + cell,
+		cell + peak + cell,
+		cell + cell + peak,
+		cell + cell + cell, // the highlight leaves the row
+		peak + cell + cell,
+	} {
+		if got := RenderShimmer(3, frame); got != want {
+			t.Errorf("frame %d: got %q, want %q", frame, got, want)
+		}
+	}
+}
@@ -2,11 +2,51 @@ package components
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/a1yama/tig-gh/internal/ui/styles"
 	"github.com/charmbracelet/lipgloss"
 )
 
+var (
+	usageMu      sync.RWMutex
+	usageSummary func() string
+	updateHint   string
+)
+
+// SetUsageSummary sets the function whose result every status bar shows at
+// its right end (the session's API calls and remaining budget). Nil hides it.
+func SetUsageSummary(summary func() string) {
+	usageMu.Lock()
+	defer usageMu.Unlock()
+	usageSummary = summary
+}
+
+// usage returns the text set by SetUsageSummary, if any
+func usage() string {
+	usageMu.RLock()
+	defer usageMu.RUnlock()
+	if usageSummary == nil {
+		return ""
+	}
+	return usageSummary()
+}
+
+// SetUpdateHint sets the newer release every status bar mentions at its
+// right end. An empty version hides it.
+func SetUpdateHint(version string) {
+	usageMu.Lock()
+	defer usageMu.Unlock()
+	updateHint = version
+}
+
+// newerRelease returns the version set by SetUpdateHint, if any
+func newerRelease() string {
+	usageMu.RLock()
+	defer usageMu.RUnlock()
+	return updateHint
+}
+
 // StatusBar represents a status bar component
 type StatusBar struct {
 	width   int
@@ -85,9 +125,16 @@ func (s *StatusBar) Render() string {
 
 	leftContent := lipgloss.JoinHorizontal(lipgloss.Top, leftParts...)
 
-	// Right side: status items
+	// Right side: status items, then the API usage and a newer release
+	items := s.items[:len(s.items):len(s.items)]
+	if summary := usage(); summary != "" {
+		items = append(items, StatusItem{Key: "API", Value: summary})
+	}
+	if version := newerRelease(); version != "" {
+		items = append(items, StatusItem{Key: "Update", Value: version})
+	}
 	rightParts := []string{}
-	for _, item := range s.items {
+	for _, item := range items {
 		keyStyle := styles.StatusKeyStyle.Copy().Padding(0, 1)
 		valueStyle := styles.StatusValueStyle.Copy()
 
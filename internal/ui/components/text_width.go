@@ -0,0 +1,97 @@
+package components
+
+import (
+	"github.com/rivo/uniseg"
+)
+
+// graphemeBounds returns the rune offsets where the user-perceived characters
+// of s start, followed by the rune length of s. Cursors move and delete over
+// whole characters so that combining marks, emoji sequences and characters
+// committed by an IME are never split.
+func graphemeBounds(s string) []int {
+	bounds := []int{0}
+	offset := 0
+	state := -1
+	for s != "" {
+		var cluster string
+		cluster, s, _, state = uniseg.FirstGraphemeClusterInString(s, state)
+		offset += len([]rune(cluster))
+		bounds = append(bounds, offset)
+	}
+	return bounds
+}
+
+// prevBound returns the start of the character before rune offset i
+func prevBound(s string, i int) int {
+	prev := 0
+	for _, b := range graphemeBounds(s) {
+		if b >= i {
+			break
+		}
+		prev = b
+	}
+	return prev
+}
+
+// nextBound returns the end of the character after rune offset i
+func nextBound(s string, i int) int {
+	bounds := graphemeBounds(s)
+	for _, b := range bounds {
+		if b > i {
+			return b
+		}
+	}
+	return bounds[len(bounds)-1]
+}
+
+// dropLastGrapheme removes the last character of s
+func dropLastGrapheme(s string) string {
+	runes := []rune(s)
+	return string(runes[:prevBound(s, len(runes))])
+}
+
+// cellWidth returns the number of terminal cells s occupies; CJK characters
+// and most emoji take two
+func cellWidth(s string) int {
+	return uniseg.StringWidth(s)
+}
+
+// fitAroundCursor returns the parts of value before and after rune offset
+// cursor that fit in width cells together with a one-cell cursor. Text is
+// scrolled horizontally so the cursor stays visible instead of the line
+// wrapping, and a wide character is never cut in half.
+func fitAroundCursor(value string, cursor, width int) (before, after string) {
+	runes := []rune(value)
+	before = string(runes[:cursor])
+	after = string(runes[cursor:])
+
+	budget := width - 1
+	if budget < 0 {
+		budget = 0
+	}
+
+	// Drop characters from the left until the text before the cursor fits
+	for cellWidth(before) > budget {
+		before = string([]rune(before)[nextBound(before, 0):])
+	}
+
+	// Fill the remaining cells with the text after the cursor
+	return before, truncateCells(after, budget-cellWidth(before))
+}
+
+// truncateCells returns the longest prefix of s that fits in width cells
+func truncateCells(s string, width int) string {
+	fitted := ""
+	state := -1
+	for s != "" {
+		var cluster string
+		var w int
+		cluster, s, w, state = uniseg.FirstGraphemeClusterInString(s, state)
+		if w > width {
+			break
+		}
+		width -= w
+		fitted += cluster
+	}
+	return fitted
+}
@@ -0,0 +1,97 @@
+package components
+
+// Viewport renders the rows of a long list or document that fit on screen.
+// Rows are rendered through a callback only when they come into view and are
+// kept until the content changes, so scrolling a diff of thousands of lines
+// styles one screenful at a time.
+type Viewport struct {
+	height int
+	total  int
+	offset int
+	key    any
+	rows   map[int]string
+}
+
+// NewViewport creates a new viewport. The zero value is ready to use too.
+func NewViewport() *Viewport {
+	return &Viewport{}
+}
+
+// SetHeight sets how many rows fit on screen
+func (v *Viewport) SetHeight(height int) {
+	v.height = max(height, 1)
+	v.SetOffset(v.offset)
+}
+
+// SetContent sets the number of rows and a key identifying what they show.
+// The rows rendered for another key are dropped; the key must be comparable.
+func (v *Viewport) SetContent(key any, total int) {
+	if key != v.key || total != v.total {
+		v.Invalidate()
+	}
+	v.key = key
+	v.total = total
+	v.SetOffset(v.offset)
+}
+
+// Invalidate drops the rendered rows, e.g. after the width or theme changed
+func (v *Viewport) Invalidate() {
+	clear(v.rows)
+}
+
+// Offset returns the first row on screen
+func (v *Viewport) Offset() int {
+	return v.offset
+}
+
+// SetOffset scrolls to the row, keeping the last screenful full
+func (v *Viewport) SetOffset(offset int) {
+	v.offset = min(max(offset, 0), v.MaxOffset())
+}
+
+// MaxOffset returns the offset showing the last row at the bottom
+func (v *Viewport) MaxOffset() int {
+	return max(v.total-v.height, 0)
+}
+
+// Rows returns the rows on screen, rendering those not rendered yet
+func (v *Viewport) Rows(render func(i int) string) []string {
+	start, end := v.offset, min(v.offset+v.height, v.total)
+	if start >= end {
+		return nil
+	}
+	if v.rows == nil {
+		v.rows = make(map[int]string)
+	}
+
+	// Keep the rows near the screen only, so long scrolls don't pile them up
+	if len(v.rows) > 4*v.height {
+		for i := range v.rows {
+			if i < start-v.height || i >= end+v.height {
+				delete(v.rows, i)
+			}
+		}
+	}
+
+	rows := make([]string, 0, end-start)
+	for i := start; i < end; i++ {
+		row, ok := v.rows[i]
+		if !ok {
+			row = render(i)
+			v.rows[i] = row
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// VisibleRange returns the rows of a list to show around the cursor: the
+// whole list when it fits, otherwise a screenful with the cursor centered
+func VisibleRange(cursor, total, height int) (start, end int) {
+	height = max(height, 0)
+	if total <= height {
+		return 0, total
+	}
+	start = min(max(cursor-height/2, 0), total-height)
+	return start, start + height
+}
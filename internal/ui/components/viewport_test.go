@@ -0,0 +1,63 @@
+package components
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestViewport_RendersVisibleRowsOnce(t *testing.T) {
+	v := NewViewport()
+	v.SetHeight(3)
+	v.SetContent("a.go", 5000)
+
+	var rendered []int
+	render := func(i int) string {
+		rendered = append(rendered, i)
+		return fmt.Sprintf("line %d", i)
+	}
+
+	rows := v.Rows(render)
+	if len(rows) != 3 || rows[0] != "line 0" || len(rendered) != 3 {
+		t.Fatalf("rows = %v, rendered = %v; want the first screenful only", rows, rendered)
+	}
+
+	v.SetOffset(1)
+	rows = v.Rows(render)
+	if rows[2] != "line 3" || len(rendered) != 4 {
+		t.Errorf("rows = %v, rendered = %v; want only line 3 rendered on scroll", rows, rendered)
+	}
+
+	v.SetContent("b.go", 5000)
+	v.Rows(render)
+	if len(rendered) != 7 {
+		t.Errorf("expected new content to be rendered again, rendered = %v", rendered)
+	}
+
+	v.SetOffset(10000)
+	if v.Offset() != 4997 {
+		t.Errorf("Offset() = %d, want the last screenful at 4997", v.Offset())
+	}
+}
+
+func TestVisibleRange(t *testing.T) {
+	tests := []struct {
+		name                  string
+		cursor, total, height int
+		start, end            int
+	}{
+		{"fits on screen", 2, 5, 10, 0, 5},
+		{"cursor centered", 50, 1000, 10, 45, 55},
+		{"top of the list", 1, 1000, 10, 0, 10},
+		{"bottom of the list", 999, 1000, 10, 990, 1000},
+		{"no room", 3, 10, -2, 3, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := VisibleRange(tt.cursor, tt.total, tt.height)
+			if start != tt.start || end != tt.end {
+				t.Errorf("VisibleRange() = %d, %d; want %d, %d", start, end, tt.start, tt.end)
+			}
+		})
+	}
+}
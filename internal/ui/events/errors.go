@@ -0,0 +1,21 @@
+package events
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// ErrorReported asks the App to show an error in its error banner, so views
+// report failures the same way instead of each rendering its own message
+type ErrorReported struct {
+	// Source names what failed, e.g. "issues"
+	Source string
+	Err    error
+	// Retry is broadcast to the views when the user retries; nil when the
+	// failure cannot be retried
+	Retry tea.Msg
+}
+
+// ReportError returns a command that shows err in the error banner
+func ReportError(source string, err error, retry tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		return ErrorReported{Source: source, Err: err, Retry: retry}
+	}
+}
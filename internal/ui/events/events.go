@@ -0,0 +1,61 @@
+// Package events defines the in-app domain events shared between views.
+//
+// A view that changes an issue or pull request publishes an EntityChanged
+// event; the App broadcasts it to every view (not just the active one) so
+// lists, the review queue and detail views can replace the affected row
+// without refetching everything.
+package events
+
+import (
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Action describes what happened to an entity
+type Action string
+
+const (
+	ActionUpdated  Action = "updated"
+	ActionMerged   Action = "merged"
+	ActionClosed   Action = "closed"
+	ActionReopened Action = "reopened"
+	ActionLabeled  Action = "labeled"
+)
+
+// EntityChanged is broadcast to all views when an issue or pull request changes.
+// Exactly one of Issue and PullRequest is set.
+type EntityChanged struct {
+	Action      Action
+	Owner       string
+	Repo        string
+	Issue       *models.Issue
+	PullRequest *models.PullRequest
+}
+
+// Publish returns a command that delivers the event to the application
+func Publish(event EntityChanged) tea.Cmd {
+	return func() tea.Msg {
+		return event
+	}
+}
+
+// IssueChanged builds an event for an issue
+func IssueChanged(action Action, owner, repo string, issue *models.Issue) EntityChanged {
+	return EntityChanged{Action: action, Owner: owner, Repo: repo, Issue: issue}
+}
+
+// PullRequestChanged builds an event for a pull request
+func PullRequestChanged(action Action, owner, repo string, pr *models.PullRequest) EntityChanged {
+	return EntityChanged{Action: action, Owner: owner, Repo: repo, PullRequest: pr}
+}
+
+// Matches reports whether the event concerns the given repository.
+// Events without repository information match every repository.
+func (e EntityChanged) Matches(owner, repo string) bool {
+	if e.Owner == "" || e.Repo == "" || owner == "" || repo == "" {
+		return true
+	}
+	return strings.EqualFold(e.Owner, owner) && strings.EqualFold(e.Repo, repo)
+}
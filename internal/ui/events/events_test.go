@@ -0,0 +1,44 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func TestPublish(t *testing.T) {
+	pr := &models.PullRequest{Number: 1}
+	cmd := Publish(PullRequestChanged(ActionMerged, "owner", "repo", pr))
+
+	event, ok := cmd().(EntityChanged)
+	if !ok {
+		t.Fatal("expected EntityChanged message")
+	}
+	if event.Action != ActionMerged || event.PullRequest != pr || event.Issue != nil {
+		t.Errorf("unexpected event %+v", event)
+	}
+}
+
+func TestEntityChanged_Matches(t *testing.T) {
+	tests := []struct {
+		name  string
+		event EntityChanged
+		owner string
+		repo  string
+		want  bool
+	}{
+		{name: "same repo", event: IssueChanged(ActionClosed, "owner", "repo", nil), owner: "owner", repo: "repo", want: true},
+		{name: "case insensitive", event: IssueChanged(ActionClosed, "Owner", "Repo", nil), owner: "owner", repo: "repo", want: true},
+		{name: "other repo", event: IssueChanged(ActionClosed, "owner", "other", nil), owner: "owner", repo: "repo", want: false},
+		{name: "event without repo", event: IssueChanged(ActionClosed, "", "", nil), owner: "owner", repo: "repo", want: true},
+		{name: "view without repo", event: IssueChanged(ActionClosed, "owner", "repo", nil), owner: "", repo: "", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.event.Matches(tt.owner, tt.repo); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
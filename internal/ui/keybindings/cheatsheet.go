@@ -0,0 +1,146 @@
+package keybindings
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// cheatSheetSections はチートシートのカテゴリと見出し（表示順）
+var cheatSheetSections = []struct {
+	category string
+	title    string
+}{
+	{"global", "Global"},
+	{"navigation", "Navigation"},
+	{"action", "Actions"},
+	{"view", "Views"},
+	{"issue", "Issues"},
+	{"pr", "Pull Requests"},
+	{"commit", "Commits"},
+	{"notification", "Notifications"},
+	{"metrics", "Metrics"},
+	{"custom", "Custom"},
+}
+
+// EffectiveKeyBindings は既定のキーバインディング（グローバルと各ビュー）に custom
+// （設定の ui.key_bindings）を適用した実際のキーマップを、カテゴリ順・アクション名順で返す。
+// 既定にないアクションは custom カテゴリに加える
+func EffectiveKeyBindings(custom map[string]string) ([]KeyBinding, error) {
+	sets := []*KeyBindings{
+		DefaultKeyBindings(),
+		GetIssueViewKeyBindings(),
+		GetPRViewKeyBindings(),
+		GetCommitViewKeyBindings(),
+		GetNotificationViewKeyBindings(),
+		GetMetricsViewKeyBindings(),
+	}
+
+	extra := NewKeyBindings()
+	for action, keys := range custom {
+		if strings.TrimSpace(keys) == "" {
+			return nil, fmt.Errorf("empty key binding for action %q", action)
+		}
+		// 同じアクション名は複数のビューにあり得るため、すべてに適用する
+		// 既定のキーのどれかと同じなら変更していないものとして既定のまま残す
+		found := false
+		for _, set := range sets {
+			binding, ok := set.bindings[action]
+			if !ok {
+				continue
+			}
+			found = true
+			if !containsKey(binding.Keys, keys) {
+				binding.Keys = []string{keys}
+				binding.Custom = true
+				set.bindings[action] = binding
+			}
+		}
+		if !found {
+			extra.bindings[action] = KeyBinding{
+				Keys:        []string{keys},
+				Action:      action,
+				Description: action,
+				Category:    "custom",
+				Custom:      true,
+			}
+		}
+	}
+	sets = append(sets, extra)
+
+	order := make(map[string]int, len(cheatSheetSections))
+	for i, section := range cheatSheetSections {
+		order[section.category] = i
+	}
+	var bindings []KeyBinding
+	for _, set := range sets {
+		bindings = append(bindings, set.GetHelp()...)
+	}
+	sort.SliceStable(bindings, func(i, j int) bool {
+		a, b := bindings[i], bindings[j]
+		if order[a.Category] != order[b.Category] {
+			return order[a.Category] < order[b.Category]
+		}
+		return a.Action < b.Action
+	})
+	return bindings, nil
+}
+
+// containsKey は keys に key が含まれるかを返す
+func containsKey(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// CheatSheetMarkdown はキーバインディングをカテゴリごとの Markdown の表にする
+// ui.key_bindings で変更・追加したキーには印を付ける
+func CheatSheetMarkdown(bindings []KeyBinding) string {
+	byCategory := make(map[string][]KeyBinding)
+	for _, binding := range bindings {
+		byCategory[binding.Category] = append(byCategory[binding.Category], binding)
+	}
+
+	var b strings.Builder
+	b.WriteString("# tig-gh key bindings\n")
+	for _, section := range cheatSheetSections {
+		list := byCategory[section.category]
+		if len(list) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n", section.title)
+		b.WriteString("| Key | Action | Description |\n")
+		b.WriteString("| --- | --- | --- |\n")
+		for _, binding := range list {
+			keys := make([]string, len(binding.Keys))
+			for i, key := range binding.Keys {
+				keys[i] = markdownCode(key)
+			}
+			key := strings.Join(keys, " / ")
+			if binding.Custom {
+				key += " *"
+			}
+			fmt.Fprintf(&b, "| %s | %s | %s |\n", key, binding.Action, strings.ReplaceAll(binding.Description, "|", `\|`))
+		}
+	}
+
+	for _, binding := range bindings {
+		if binding.Custom {
+			b.WriteString("\n\\* set in `ui.key_bindings`\n")
+			break
+		}
+	}
+	return b.String()
+}
+
+// markdownCode はキーをインラインコードにする（"`" を含むキーは区切りを長くし、表の "|" はエスケープする）
+func markdownCode(key string) string {
+	key = strings.ReplaceAll(key, "|", `\|`)
+	if strings.Contains(key, "`") {
+		return "`` " + key + " ``"
+	}
+	return "`" + key + "`"
+}
@@ -0,0 +1,81 @@
+package keybindings
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEffectiveKeyBindings(t *testing.T) {
+	bindings, err := EffectiveKeyBindings(map[string]string{
+		"quit":       "Q",
+		"down":       "j", // 既定のキーと同じなら変更なし
+		"diff":       "D", // PR とコミットの両方に適用される
+		"open_issue": "O",
+	})
+	if err != nil {
+		t.Fatalf("EffectiveKeyBindings() error = %v", err)
+	}
+
+	find := func(category, action string) KeyBinding {
+		t.Helper()
+		for _, binding := range bindings {
+			if binding.Category == category && binding.Action == action {
+				return binding
+			}
+		}
+		t.Fatalf("no %s binding for %s", category, action)
+		return KeyBinding{}
+	}
+
+	if quit := find("global", "quit"); strings.Join(quit.Keys, ",") != "Q" || !quit.Custom {
+		t.Errorf("quit = %+v, want remapped to Q", quit)
+	}
+	if down := find("navigation", "down"); strings.Join(down.Keys, ",") != "j,down" || down.Custom {
+		t.Errorf("down = %+v, want the default keys", down)
+	}
+	for _, category := range []string{"pr", "commit"} {
+		if diff := find(category, "diff"); strings.Join(diff.Keys, ",") != "D" {
+			t.Errorf("%s diff = %+v, want D", category, diff)
+		}
+	}
+	if extra := find("custom", "open_issue"); !extra.Custom {
+		t.Errorf("open_issue = %+v, want a custom binding", extra)
+	}
+	if bindings[0].Category != "global" || bindings[len(bindings)-1].Category != "custom" {
+		t.Errorf("bindings should be ordered by category, got %s first and %s last", bindings[0].Category, bindings[len(bindings)-1].Category)
+	}
+
+	if _, err := EffectiveKeyBindings(map[string]string{"quit": " "}); err == nil {
+		t.Error("expected an error for an empty key")
+	}
+}
+
+func TestCheatSheetMarkdown(t *testing.T) {
+	bindings, err := EffectiveKeyBindings(map[string]string{"quit": "Q"})
+	if err != nil {
+		t.Fatalf("EffectiveKeyBindings() error = %v", err)
+	}
+	sheet := CheatSheetMarkdown(bindings)
+
+	for _, want := range []string{
+		"# tig-gh key bindings\n",
+		"## Global\n\n| Key | Action | Description |\n| --- | --- | --- |\n",
+		"| `Q` * | quit | 終了 / 前の画面に戻る |\n",
+		"| `j` / `down` | down | 下に移動 |\n",
+		"## Metrics\n",
+		"\\* set in `ui.key_bindings`\n",
+	} {
+		if !strings.Contains(sheet, want) {
+			t.Errorf("cheat sheet is missing %q:\n%s", want, sheet)
+		}
+	}
+	if strings.Contains(sheet, "## Custom") {
+		t.Error("the custom section should only be shown for added actions")
+	}
+
+	// Keys that break Markdown tables or code spans are escaped
+	sheet = CheatSheetMarkdown([]KeyBinding{{Keys: []string{"|", "`"}, Action: "odd", Description: "a|b", Category: "global"}})
+	if !strings.Contains(sheet, "| `\\|` / `` ` `` | odd | a\\|b |") {
+		t.Errorf("unexpected escaping:\n%s", sheet)
+	}
+}
@@ -19,6 +19,9 @@ type KeyBinding struct {
 
 	// Category はこのキーバインディングのカテゴリ（グローバル、ビュー固有など）
 	Category string
+
+	// Custom は設定の ui.key_bindings でキーを変更したかどうか
+	Custom bool
 }
 
 // KeyBindings はキーバインディングの管理を行う
@@ -223,6 +226,7 @@ func (kb *KeyBindings) LoadCustom(custom map[string]string) error {
 
 		// キーを更新
 		binding.Keys = []string{keys}
+		binding.Custom = true
 		kb.bindings[action] = binding
 	}
 
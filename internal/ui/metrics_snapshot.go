@@ -0,0 +1,44 @@
+package ui
+
+import (
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/views"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// MetricsSnapshotApp shows an exported metrics report on its own, without
+// the other views (which need a repository and a token)
+type MetricsSnapshotApp struct {
+	view *views.MetricsView
+}
+
+// NewMetricsSnapshotApp creates an app showing the metrics returned by useCase
+func NewMetricsSnapshotApp(useCase views.LeadTimeMetricsUseCase, config *models.MetricsConfig) *MetricsSnapshotApp {
+	return &MetricsSnapshotApp{view: views.NewMetricsViewWithUseCase(useCase, config)}
+}
+
+// Init loads the report
+func (a *MetricsSnapshotApp) Init() tea.Cmd {
+	return a.view.Init()
+}
+
+// Update forwards messages to the metrics view; leaving it quits
+func (a *MetricsSnapshotApp) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case views.MetricsExitMsg:
+		return a, tea.Quit
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+z" {
+			return a, tea.Suspend
+		}
+	}
+
+	model, cmd := a.view.Update(msg)
+	a.view = model.(*views.MetricsView)
+	return a, cmd
+}
+
+// View renders the metrics view
+func (a *MetricsSnapshotApp) View() string {
+	return a.view.View()
+}
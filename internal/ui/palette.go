@@ -0,0 +1,42 @@
+package ui
+
+import "github.com/a1yama/tig-gh/internal/ui/styles"
+
+// paletteViews maps the view names of ui.view_palettes to the views
+var paletteViews = map[string]ViewType{
+	"issues":   IssueListView,
+	"prs":      PullRequestListView,
+	"commits":  CommitListView,
+	"search":   SearchView,
+	"queue":    ReviewQueueView,
+	"metrics":  MetricsView,
+	"releases": ReleaseListView,
+	"gists":    GistListView,
+	"actions":  ActionsView,
+	"watch":    WatchListView,
+	"my_work":  MyWorkView,
+	"starred":  StarredListView,
+}
+
+// SetPalettes sets the color palette of every view (ui.palette) and those of
+// single views (ui.view_palettes, keyed by view name). Detail views use the
+// palette of the list they were opened from.
+func (a *App) SetPalettes(palette string, perView map[string]string) {
+	a.palette = styles.Palette(palette)
+	a.viewPalettes = make(map[ViewType]styles.Palette, len(perView))
+	for name, palette := range perView {
+		if view, ok := paletteViews[name]; ok {
+			a.viewPalettes[view] = styles.Palette(palette)
+		}
+	}
+	a.applyPalette()
+}
+
+// applyPalette switches the styles to the palette of the current view
+func (a *App) applyPalette() {
+	palette := a.palette
+	if viewPalette, ok := a.viewPalettes[a.currentView]; ok {
+		palette = viewPalette
+	}
+	styles.ApplyPalette(palette)
+}
@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// profilePicker lists the configured profiles so the session can be
+// restarted with another account or GitHub host
+type profilePicker struct {
+	names   []string
+	current string
+	cursor  int
+	visible bool
+}
+
+// Open shows the picker with the cursor on the current profile
+func (p *profilePicker) Open() {
+	p.visible = true
+	p.cursor = 0
+	for i, name := range p.names {
+		if name == p.current {
+			p.cursor = i
+		}
+	}
+}
+
+// HandleKey moves the cursor or picks a profile. It returns the picked
+// profile when it differs from the current one.
+func (p *profilePicker) HandleKey(msg tea.KeyMsg) string {
+	switch msg.String() {
+	case "j", "down":
+		if p.cursor < len(p.names)-1 {
+			p.cursor++
+		}
+	case "k", "up":
+		if p.cursor > 0 {
+			p.cursor--
+		}
+	case "enter":
+		p.visible = false
+		if picked := p.names[p.cursor]; picked != p.current {
+			return picked
+		}
+	case "esc", "q", "P":
+		p.visible = false
+	}
+	return ""
+}
+
+// View renders the picker
+func (p *profilePicker) View() string {
+	var s strings.Builder
+	s.WriteString(styles.HeaderStyle.Render("Switch profile"))
+	s.WriteString("\n\n")
+	for i, name := range p.names {
+		line := name
+		if name == p.current {
+			line += styles.MutedStyle.Render(" (current)")
+		}
+		if i == p.cursor {
+			s.WriteString(styles.SelectedStyle.Render("> " + line))
+		} else {
+			s.WriteString("  " + line)
+		}
+		s.WriteString("\n")
+	}
+	s.WriteString("\n")
+	s.WriteString(styles.FormatKeyBinding("enter", "switch") + "  " + styles.FormatKeyBinding("esc", "cancel"))
+	return styles.BorderStyle.Render(s.String())
+}
@@ -0,0 +1,94 @@
+package ui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// DefaultFPS is the maximum number of frames rendered per second.
+const DefaultFPS = 30
+
+// renderFrameMsg is sent when a deferred frame should be rendered.
+type renderFrameMsg struct{}
+
+// renderThrottle caches the rendered view and limits how often it is rebuilt.
+// Input (keys, resizes) always renders immediately; background messages such as
+// progress updates or review loads are coalesced into at most one frame per interval.
+type renderThrottle struct {
+	interval       time.Duration
+	now            func() time.Time
+	lastRender     time.Time
+	cached         string
+	hasCache       bool
+	dirty          bool
+	forced         bool
+	frameScheduled bool
+}
+
+// newRenderThrottle creates a throttle capped at the given frames per second.
+func newRenderThrottle(fps int) *renderThrottle {
+	if fps <= 0 {
+		fps = DefaultFPS
+	}
+	return &renderThrottle{
+		interval: time.Second / time.Duration(fps),
+		now:      time.Now,
+	}
+}
+
+// invalidate marks the view as changed. Urgent invalidations render on the next
+// View call; others may be deferred, in which case a frame tick is returned.
+func (t *renderThrottle) invalidate(urgent bool) tea.Cmd {
+	t.dirty = true
+	if urgent || !t.hasCache {
+		t.forced = true
+		return nil
+	}
+	if t.frameScheduled {
+		return nil
+	}
+
+	elapsed := t.now().Sub(t.lastRender)
+	if elapsed >= t.interval {
+		t.forced = true
+		return nil
+	}
+
+	t.frameScheduled = true
+	return tea.Tick(t.interval-elapsed, func(time.Time) tea.Msg {
+		return renderFrameMsg{}
+	})
+}
+
+// frame handles a deferred frame tick.
+func (t *renderThrottle) frame() {
+	t.frameScheduled = false
+	if t.dirty {
+		t.forced = true
+	}
+}
+
+// render returns the cached view unless a new frame is due.
+func (t *renderThrottle) render(draw func() string) string {
+	if t.hasCache && (!t.dirty || !t.forced) {
+		return t.cached
+	}
+
+	t.cached = draw()
+	t.hasCache = true
+	t.dirty = false
+	t.forced = false
+	t.lastRender = t.now()
+	return t.cached
+}
+
+// isUrgentMsg reports whether a message comes from direct user interaction.
+func isUrgentMsg(msg tea.Msg) bool {
+	switch msg.(type) {
+	case tea.KeyMsg, tea.WindowSizeMsg, tea.MouseMsg:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,113 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestRenderThrottle_CoalescesBackgroundMessages(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	throttle := newRenderThrottle(30)
+	throttle.now = func() time.Time { return now }
+
+	draws := 0
+	draw := func() string {
+		draws++
+		return "frame"
+	}
+
+	// First render always draws
+	throttle.invalidate(false)
+	throttle.render(draw)
+	if draws != 1 {
+		t.Fatalf("expected 1 draw, got %d", draws)
+	}
+
+	// A burst of background messages within the frame interval is deferred
+	cmd := throttle.invalidate(false)
+	if cmd == nil {
+		t.Fatal("expected a frame tick to be scheduled")
+	}
+	for i := 0; i < 10; i++ {
+		if extra := throttle.invalidate(false); extra != nil {
+			t.Fatal("expected only one frame tick per interval")
+		}
+		throttle.render(draw)
+	}
+	if draws != 1 {
+		t.Fatalf("expected burst to be coalesced, got %d draws", draws)
+	}
+
+	// The deferred frame renders once
+	throttle.frame()
+	throttle.render(draw)
+	throttle.render(draw)
+	if draws != 2 {
+		t.Fatalf("expected 2 draws after frame tick, got %d", draws)
+	}
+}
+
+func TestRenderThrottle_UrgentRendersImmediately(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	throttle := newRenderThrottle(30)
+	throttle.now = func() time.Time { return now }
+
+	draws := 0
+	draw := func() string {
+		draws++
+		return "frame"
+	}
+
+	throttle.render(draw)
+	if cmd := throttle.invalidate(isUrgentMsg(tea.KeyMsg{Type: tea.KeyDown})); cmd != nil {
+		t.Fatal("urgent invalidation should not schedule a tick")
+	}
+	throttle.render(draw)
+	if draws != 2 {
+		t.Fatalf("expected key press to render immediately, got %d draws", draws)
+	}
+}
+
+func TestRenderThrottle_RendersAfterInterval(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	throttle := newRenderThrottle(30)
+	throttle.now = func() time.Time { return now }
+
+	draws := 0
+	draw := func() string {
+		draws++
+		return "frame"
+	}
+
+	throttle.render(draw)
+	now = now.Add(time.Second)
+	if cmd := throttle.invalidate(false); cmd != nil {
+		t.Fatal("expected no tick once the interval has elapsed")
+	}
+	throttle.render(draw)
+	if draws != 2 {
+		t.Fatalf("expected 2 draws, got %d", draws)
+	}
+}
+
+func TestApp_ViewUsesCachedFrame(t *testing.T) {
+	app := NewApp()
+	app.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	first := app.View()
+	if first == "" {
+		t.Fatal("expected rendered view")
+	}
+
+	// Unknown background messages within the same frame reuse the cached output
+	app.throttle.now = func() time.Time { return app.throttle.lastRender }
+	_, cmd := app.Update(struct{}{})
+	if cmd == nil {
+		t.Fatal("expected deferred frame command")
+	}
+	if got := app.View(); got != first {
+		t.Fatalf("expected cached frame, got %q", got)
+	}
+}
@@ -0,0 +1,69 @@
+package styles
+
+// Icons used in rows, badges and markers. Some fonts draw the emoji as
+// double-width boxes, which breaks column alignment; SetASCIIIcons swaps
+// them all for ASCII equivalents.
+var (
+	IconComment   = "💬"
+	IconIssue     = "📄"
+	IconPR        = "🔀"
+	IconDot       = "●"
+	IconCheck     = "✓"
+	IconCross     = "✗"
+	IconWaiting   = "⋯"
+	IconCursor    = "▶"
+	IconWarning   = "⚠"
+	IconFreeze    = "❄"
+	IconBranch    = "⎇"
+	IconFlag      = "⚑"
+	IconExpanded  = "▾"
+	IconCollapsed = "▸"
+	IconAhead     = "↑"
+	IconBehind    = "↓"
+	IconMergeInto = "←"
+	IconWatch     = "◉"
+	IconStar      = "★"
+	IconTreeEdge  = "├─"
+	IconTreeLast  = "└─"
+
+	// Placeholder cells for a value still loading, with the moving highlight
+	IconShimmer     = "░"
+	IconShimmerPeak = "▓"
+
+	// Reaction emoji offered by the reaction picker
+	IconThumbsUp = "👍"
+	IconHeart    = "❤️"
+	IconRocket   = "🚀"
+)
+
+// SetASCIIIcons replaces every icon with an ASCII equivalent (ui.ascii_icons).
+// Call it before the first render.
+func SetASCIIIcons() {
+	IconComment = "c"
+	IconIssue = "I"
+	IconPR = "P"
+	IconDot = "*"
+	IconCheck = "v"
+	IconCross = "x"
+	IconWaiting = "..."
+	IconCursor = ">"
+	IconWarning = "!"
+	IconFreeze = "#"
+	IconBranch = "@"
+	IconFlag = "!"
+	IconExpanded = "-"
+	IconCollapsed = "+"
+	IconAhead = "+"
+	IconBehind = "-"
+	IconMergeInto = "<-"
+	IconWatch = "(w)"
+	IconStar = "*"
+	IconTreeEdge = "|-"
+	IconTreeLast = "`-"
+	IconShimmer = "."
+	IconShimmerPeak = ":"
+
+	IconThumbsUp = ":+1:"
+	IconHeart = ":heart:"
+	IconRocket = ":rocket:"
+}
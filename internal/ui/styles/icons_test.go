@@ -0,0 +1,32 @@
+package styles
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSetASCIIIcons(t *testing.T) {
+	SetASCIIIcons()
+
+	icons := []string{
+		IconComment, IconIssue, IconPR, IconDot, IconCheck, IconCross, IconWaiting,
+		IconCursor, IconWarning, IconFreeze, IconBranch, IconFlag, IconExpanded,
+		IconCollapsed, IconAhead, IconBehind, IconMergeInto, IconWatch, IconStar,
+		IconTreeEdge, IconTreeLast, IconShimmer, IconShimmerPeak,
+		IconThumbsUp, IconHeart, IconRocket,
+	}
+	for i, icon := range icons {
+		if icon == "" {
+			t.Errorf("icon %d is empty", i)
+		}
+		for _, r := range icon {
+			if r > 0x7e {
+				t.Errorf("icon %d (%q) is not ASCII", i, icon)
+				break
+			}
+		}
+	}
+	if got := GetStateBadge("open"); !strings.Contains(got, "* OPEN") {
+		t.Errorf("expected an ASCII state badge, got %q", got)
+	}
+}
@@ -0,0 +1,100 @@
+package styles
+
+import "github.com/charmbracelet/lipgloss"
+
+// Palette is a set of colors for the states the views show (ui.palette)
+type Palette string
+
+const (
+	// PaletteDefault tells states apart with green and red
+	PaletteDefault Palette = "default"
+	// PaletteColorBlind tells states apart with blue and orange, which stay
+	// distinct with the common color vision deficiencies, and adds a symbol
+	// to every state badge
+	PaletteColorBlind Palette = "colorblind"
+)
+
+// Colors of the review, merge and change statuses drawn with ANSI colors
+var (
+	ColorPositive = lipgloss.Color("35")  // approved, mergeable, resolved, added
+	ColorNegative = lipgloss.Color("196") // changes requested, conflicts, deleted
+	ColorPending  = lipgloss.Color("220") // waiting on reviews or checks
+)
+
+// paletteColors are the colors a palette sets
+type paletteColors struct {
+	success, warning, error, info lipgloss.Color
+	open, closed, merged          lipgloss.Color
+	positive, negative, pending   lipgloss.Color
+}
+
+var palettes = map[Palette]paletteColors{
+	PaletteDefault: {
+		success: ColorSuccess, warning: ColorWarning, error: ColorError, info: ColorInfo,
+		open: ColorOpen, closed: ColorClosed, merged: ColorMerged,
+		positive: ColorPositive, negative: ColorNegative, pending: ColorPending,
+	},
+	// Okabe-Ito colors: sky blue for good, vermillion for bad, yellow for
+	// waiting and reddish purple for merged
+	PaletteColorBlind: {
+		success: "#56B4E9", warning: "#F0E442", error: "#D55E00", info: "#0072B2",
+		open: "#56B4E9", closed: "#D55E00", merged: "#CC79A7",
+		positive: "39", negative: "208", pending: "227",
+	},
+}
+
+var currentPalette = PaletteDefault
+
+// CurrentPalette returns the palette the styles use
+func CurrentPalette() Palette {
+	return currentPalette
+}
+
+// ApplyPalette switches the state colors and the styles drawn with them.
+// Unknown palettes fall back to the default one.
+func ApplyPalette(palette Palette) {
+	colors, ok := palettes[palette]
+	if !ok {
+		palette, colors = PaletteDefault, palettes[PaletteDefault]
+	}
+	if palette == currentPalette {
+		return
+	}
+	currentPalette = palette
+
+	ColorSuccess, ColorWarning, ColorError, ColorInfo = colors.success, colors.warning, colors.error, colors.info
+	ColorOpen, ColorClosed, ColorMerged = colors.open, colors.closed, colors.merged
+	ColorPositive, ColorNegative, ColorPending = colors.positive, colors.negative, colors.pending
+
+	ErrorStyle = ErrorStyle.Foreground(ColorError)
+	ErrorBannerStyle = ErrorBannerStyle.Foreground(ColorError)
+	SuccessStyle = SuccessStyle.Foreground(ColorSuccess)
+	WarningStyle = WarningStyle.Foreground(ColorWarning)
+	InfoStyle = InfoStyle.Foreground(ColorInfo)
+	IssueOpenStyle = IssueOpenStyle.Foreground(ColorOpen)
+	IssueClosedStyle = IssueClosedStyle.Foreground(ColorClosed)
+	PRApprovedStyle = PRApprovedStyle.Foreground(ColorSuccess)
+	PRChangesRequestedStyle = PRChangesRequestedStyle.Foreground(ColorError)
+	PRPendingStyle = PRPendingStyle.Foreground(ColorWarning)
+	CIPassStyle = CIPassStyle.Foreground(ColorSuccess)
+	CIFailStyle = CIFailStyle.Foreground(ColorError)
+	CIRunningStyle = CIRunningStyle.Foreground(ColorInfo)
+	AddedLineStyle = AddedLineStyle.Foreground(ColorSuccess)
+	DeletedLineStyle = DeletedLineStyle.Foreground(ColorError)
+}
+
+// stateIcon returns the symbol of a state badge. The color-blind palette
+// gives each state its own symbol so the color is never the only cue.
+func stateIcon(state string) string {
+	if currentPalette != PaletteColorBlind {
+		return IconDot
+	}
+	switch state {
+	case "closed":
+		return IconCross
+	case "merged":
+		return IconCheck
+	default:
+		return IconDot
+	}
+}
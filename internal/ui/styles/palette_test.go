@@ -0,0 +1,36 @@
+package styles
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestApplyPalette(t *testing.T) {
+	defaults := palettes[PaletteDefault]
+	defer ApplyPalette(PaletteDefault)
+
+	ApplyPalette(PaletteColorBlind)
+	if CurrentPalette() != PaletteColorBlind {
+		t.Fatalf("expected the color-blind palette, got %q", CurrentPalette())
+	}
+	if ColorSuccess == defaults.success || ColorError == defaults.error || ColorPositive == defaults.positive {
+		t.Error("expected the state colors to change")
+	}
+	if PRApprovedStyle.GetForeground() != ColorSuccess || DeletedLineStyle.GetForeground() != ColorError {
+		t.Error("expected the styles to follow the palette")
+	}
+	for state, icon := range map[string]string{"open": IconDot, "closed": IconCross, "merged": IconCheck} {
+		if badge := GetStateBadge(state); !strings.Contains(badge, icon+" "+strings.ToUpper(state)) {
+			t.Errorf("expected %q in the %s badge, got %q", icon, state, badge)
+		}
+	}
+
+	// Unknown palettes fall back to the default colors
+	ApplyPalette("sepia")
+	if CurrentPalette() != PaletteDefault || ColorSuccess != defaults.success || IssueClosedStyle.GetForeground() != defaults.closed {
+		t.Errorf("expected the default palette, got %q", CurrentPalette())
+	}
+	if badge := GetStateBadge("closed"); !strings.Contains(badge, IconDot+" CLOSED") {
+		t.Errorf("expected the default badge, got %q", badge)
+	}
+}
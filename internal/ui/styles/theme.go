@@ -101,6 +101,11 @@ var (
 			Bold(true).
 			Padding(1, 2)
 
+	// エラーバナースタイル（余白なしの 1 行）
+	ErrorBannerStyle = lipgloss.NewStyle().
+				Foreground(ColorError).
+				Bold(true)
+
 	// 成功スタイル
 	SuccessStyle = lipgloss.NewStyle().
 			Foreground(ColorSuccess).
@@ -211,15 +216,16 @@ func GetStateStyle(state string) lipgloss.Style {
 // GetStateBadge returns a styled badge for the given state
 func GetStateBadge(state string) string {
 	style := GetStateStyle(state)
+	icon := stateIcon(state)
 	switch state {
 	case "open":
-		return style.Render("● OPEN")
+		return style.Render(icon + " OPEN")
 	case "closed":
-		return style.Render("● CLOSED")
+		return style.Render(icon + " CLOSED")
 	case "merged":
-		return style.Render("● MERGED")
+		return style.Render(icon + " MERGED")
 	default:
-		return style.Render("● " + state)
+		return style.Render(icon + " " + state)
 	}
 }
 
@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"github.com/a1yama/tig-gh/internal/ui/events"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// UsageRecorder counts the views opened and the actions used in a session.
+// The counts stay on this machine (see tig-gh stats).
+type UsageRecorder interface {
+	ViewOpened(name string)
+	ActionUsed(name string)
+}
+
+// viewNames names the views in the usage stats
+var viewNames = map[ViewType]string{
+	IssueListView:       "Issues",
+	PullRequestListView: "Pull requests",
+	CommitListView:      "Commits",
+	SearchView:          "Search",
+	ReviewQueueView:     "Review queue",
+	MetricsView:         "Metrics",
+	ReleaseListView:     "Releases",
+	GistListView:        "Gists",
+	ActionsView:         "Actions",
+	WatchListView:       "Watching",
+	MyWorkView:          "My work",
+	StarredListView:     "Starred",
+}
+
+// SetUsageRecorder sets where views opened and actions used are counted
+func (a *App) SetUsageRecorder(recorder UsageRecorder) {
+	a.stats = recorder
+}
+
+// recordView counts the view as opened
+func (a *App) recordView(view ViewType) {
+	if a.stats != nil {
+		a.stats.ViewOpened(viewNames[view])
+	}
+}
+
+// recordUsage counts a switch away from the previous view and the changes
+// views made to issues and pull requests
+func (a *App) recordUsage(msg tea.Msg, previous ViewType) {
+	if a.stats == nil {
+		return
+	}
+	if a.currentView != previous {
+		a.recordView(a.currentView)
+	}
+	if event, ok := msg.(events.EntityChanged); ok {
+		kind := "issue"
+		if event.PullRequest != nil {
+			kind = "pull request"
+		}
+		a.stats.ActionUsed(kind + " " + string(event.Action))
+	}
+}
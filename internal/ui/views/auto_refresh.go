@@ -0,0 +1,97 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/infra/clock"
+)
+
+// AutoRefreshMsg asks the list view on screen to reload quietly (ui.auto_refresh)
+type AutoRefreshMsg struct{}
+
+var (
+	autoRefreshMu       sync.RWMutex
+	autoRefreshInterval time.Duration
+)
+
+// SetAutoRefresh sets the interval the app reloads the list on screen at.
+// The lists show when they were updated only while it is set.
+func SetAutoRefresh(interval time.Duration) {
+	autoRefreshMu.Lock()
+	defer autoRefreshMu.Unlock()
+	autoRefreshInterval = interval
+}
+
+// autoRefreshEnabled reports whether the lists are reloaded on an interval
+func autoRefreshEnabled() bool {
+	autoRefreshMu.RLock()
+	defer autoRefreshMu.RUnlock()
+	return autoRefreshInterval > 0
+}
+
+// liveList tracks the quiet reloads of a list view. A quiet reload keeps the
+// list on screen while it runs and the cursor on the same item afterwards.
+type liveList struct {
+	quiet     bool // the running load was started by AutoRefreshMsg
+	keep      any  // key of the item under the cursor when the quiet load started
+	updatedAt time.Time
+}
+
+// start marks a quiet reload keeping the cursor on the item with the key
+// (an issue or PR number, a commit SHA)
+func (l *liveList) start(keep any) {
+	l.quiet = true
+	l.keep = keep
+}
+
+// finish records a finished load and reports whether it was a quiet one
+func (l *liveList) finish(ok bool) bool {
+	quiet := l.quiet
+	l.quiet = false
+	if ok {
+		l.updatedAt = clock.Now()
+	}
+	return quiet
+}
+
+// context returns the context of a load; quiet reloads skip the response
+// cache, which would otherwise hide changes until it expires
+func (l *liveList) context(ctx context.Context) context.Context {
+	if l.quiet {
+		return freshContext(ctx)
+	}
+	return ctx
+}
+
+// cursorAfter returns the cursor keeping the item a quiet reload started on,
+// or the old cursor clamped to the list when the item is gone
+func (l *liveList) cursorAfter(cursor, count int, key func(int) any) int {
+	for i := 0; i < count; i++ {
+		if key(i) == l.keep {
+			return i
+		}
+	}
+	if cursor >= count {
+		return max(count-1, 0)
+	}
+	return cursor
+}
+
+// indicator returns e.g. "updated 12s ago", or "" without auto refresh
+func (l *liveList) indicator() string {
+	if !autoRefreshEnabled() || l.updatedAt.IsZero() {
+		return ""
+	}
+	ago := clock.Since(l.updatedAt)
+	switch {
+	case ago < 5*time.Second:
+		return "updated just now"
+	case ago < time.Minute:
+		return fmt.Sprintf("updated %ds ago", int(ago.Seconds()))
+	default:
+		return fmt.Sprintf("updated %dm ago", int(ago.Minutes()))
+	}
+}
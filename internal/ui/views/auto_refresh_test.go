@@ -0,0 +1,72 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/infra/cache"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestIssueView_AutoRefreshKeepsCursorAndList(t *testing.T) {
+	SetAutoRefresh(2 * time.Minute)
+	defer SetAutoRefresh(0)
+
+	var issues []*models.Issue
+	var fetchErr error
+	skippedCache := false
+	view := NewIssueViewWithUseCase(&mockFetchIssuesUseCase{
+		executeFunc: func(ctx context.Context, owner, repo string, opts *models.IssueOptions) ([]*models.Issue, error) {
+			skippedCache = cache.OptionsFromContext(ctx).SkipCache
+			return issues, fetchErr
+		},
+	}, "owner", "repo")
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	view.Update(issuesLoadedMsg{issues: []*models.Issue{
+		{Number: 3, Title: "Third", State: models.IssueStateOpen},
+		{Number: 2, Title: "Second", State: models.IssueStateOpen},
+	}})
+	press(view, "j")
+
+	// A new issue arrives at the top; the cursor stays on #2
+	issues = []*models.Issue{
+		{Number: 4, Title: "Fourth", State: models.IssueStateOpen},
+		{Number: 3, Title: "Third", State: models.IssueStateOpen},
+		{Number: 2, Title: "Second", State: models.IssueStateOpen},
+	}
+	_, cmd := view.Update(AutoRefreshMsg{})
+	if cmd == nil {
+		t.Fatal("expected the list to be reloaded")
+	}
+	if out := view.View(); strings.Contains(out, "Loading") || !strings.Contains(out, "Second") {
+		t.Errorf("expected the list to stay on screen while reloading\n%s", out)
+	}
+	view.Update(cmd())
+	if !skippedCache {
+		t.Error("expected the reload to skip the response cache")
+	}
+	if len(view.issues) != 3 || view.issues[view.cursor].Number != 2 {
+		t.Errorf("cursor = %d on %v, want it on #2", view.cursor, view.issues)
+	}
+	if out := view.View(); !strings.Contains(out, "updated just now") {
+		t.Errorf("expected the updated indicator\n%s", out)
+	}
+
+	// A failed reload keeps the list
+	fetchErr = errors.New("offline")
+	_, cmd = view.Update(AutoRefreshMsg{})
+	view.Update(cmd())
+	if view.err != nil || len(view.issues) != 3 {
+		t.Errorf("expected the list kept after a failed reload, err = %v, issues = %d", view.err, len(view.issues))
+	}
+
+	// Nothing reloads while an issue is open
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if _, cmd := view.Update(AutoRefreshMsg{}); cmd != nil {
+		t.Error("expected no reload while the detail view is open")
+	}
+}
@@ -0,0 +1,548 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/events"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// batchAction is an operation applied to every selected issue or pull request
+type batchAction string
+
+const (
+	batchActionLabel     batchAction = "label"
+	batchActionUnlabel   batchAction = "unlabel"
+	batchActionClose     batchAction = "close"
+	batchActionAssign    batchAction = "assign"
+	batchActionMilestone batchAction = "milestone"
+)
+
+// batchRequest is a batch action together with the values entered for it
+type batchRequest struct {
+	action    batchAction
+	values    []string
+	milestone int
+}
+
+// batchApplyFunc applies a batch request to one item and returns the event
+// announcing the updated item
+type batchApplyFunc func(ctx context.Context, number int) (*events.EntityChanged, error)
+
+// batchWorkers is how many items of a batch are updated at the same time
+var batchWorkers = 4
+
+// batchResult is what a finished batch did. It is written by the goroutines
+// running the batch and read once its progress reporter has finished.
+type batchResult struct {
+	action    batchAction
+	events    []events.EntityChanged
+	succeeded []int
+	failed    []int
+	errs      map[int]error
+	lastErr   error
+	cancelled bool
+}
+
+// batchActions drives the batch action menu, the form asking for the action's
+// values, the confirmation, the progress of a running batch and the report of
+// the finished one. Each item is updated on its own so that a failure only
+// affects its own item.
+type batchActions struct {
+	noun     string
+	menu     bool
+	numbers  []int
+	form     *components.FormModal
+	formFor  batchAction
+	title    string
+	confirm  *components.ConfirmModal
+	pending  *batchRequest
+	request  *batchRequest
+	bar      *components.ProgressBar
+	reporter *components.ProgressReporter
+	cancel   context.CancelFunc
+	result   *batchResult
+	report   *batchResult
+}
+
+// newBatchActions creates the batch actions for a list of the given items (e.g. "issues")
+func newBatchActions(noun string) *batchActions {
+	return &batchActions{
+		noun:    noun,
+		form:    components.NewFormModal(),
+		confirm: components.NewConfirmModal(),
+		bar:     components.NewProgressBar(),
+	}
+}
+
+// SetSize sets the size of the menu and modals
+func (b *batchActions) SetSize(width, height int) {
+	b.form.SetSize(width, height)
+	b.confirm.SetSize(width, height)
+	b.bar.SetWidth(width)
+}
+
+// Open shows the action menu for the given items
+func (b *batchActions) Open(numbers []int) {
+	b.numbers = numbers
+	b.menu = true
+}
+
+// IsCapturingInput returns true while the menu or one of its modals is open
+func (b *batchActions) IsCapturingInput() bool {
+	return b.menu || b.form.IsVisible() || b.confirm.IsVisible()
+}
+
+// Running returns true while a batch is being applied
+func (b *batchActions) Running() bool {
+	return b.request != nil
+}
+
+// HandleKey handles a key while the menu or a modal is open. It returns the
+// request once the action and its values are complete and confirmed.
+func (b *batchActions) HandleKey(msg tea.KeyMsg) (*batchRequest, error) {
+	if b.menu {
+		b.menu = false
+		count := fmt.Sprintf("%d %s", len(b.numbers), b.noun)
+		switch msg.String() {
+		case "l":
+			b.showForm(batchActionLabel, "Add labels to "+count, "Labels", "bug, help wanted")
+		case "L":
+			b.showForm(batchActionUnlabel, "Remove labels from "+count, "Labels", "needs triage")
+		case "a":
+			b.showForm(batchActionAssign, "Assign "+count, "Assignees", "octocat, hubot")
+		case "m":
+			b.showForm(batchActionMilestone, "Add "+count+" to a milestone", "Milestone number", "3")
+		case "c":
+			b.title = "Close " + count
+			b.showConfirm(&batchRequest{action: batchActionClose})
+		}
+		return nil, nil
+	}
+
+	if b.confirm.IsVisible() {
+		b.confirm.Update(msg)
+		if b.confirm.Confirmed() {
+			request := b.pending
+			b.pending = nil
+			return request, nil
+		}
+		if !b.confirm.IsVisible() {
+			b.pending = nil
+		}
+		return nil, nil
+	}
+
+	b.form.Update(msg)
+	if !b.form.Submitted() {
+		return nil, nil
+	}
+	request := &batchRequest{action: b.formFor}
+	if b.formFor == batchActionMilestone {
+		milestone, err := strconv.Atoi(strings.TrimPrefix(b.form.Value(0), "#"))
+		if err != nil || milestone <= 0 {
+			return nil, fmt.Errorf("invalid milestone number %q", b.form.Value(0))
+		}
+		request.milestone = milestone
+	} else {
+		request.values = splitBatchValues(b.form.Value(0))
+	}
+	b.showConfirm(request)
+	return nil, nil
+}
+
+// showForm asks for the single value an action needs
+func (b *batchActions) showForm(action batchAction, title, label, placeholder string) {
+	b.formFor = action
+	b.title = title
+	b.form.Show(title, []components.FormField{{Label: label, Placeholder: placeholder, Required: true}})
+}
+
+// showConfirm asks once for the whole batch before anything is changed.
+// Closing is confirmed with "close", the other actions with "apply".
+func (b *batchActions) showConfirm(request *batchRequest) {
+	b.pending = request
+	word := "apply"
+	if request.action == batchActionClose {
+		word = "close"
+	}
+	var summary []string
+	switch request.action {
+	case batchActionLabel, batchActionUnlabel:
+		summary = append(summary, "Labels: "+strings.Join(request.values, ", "))
+	case batchActionAssign:
+		summary = append(summary, "Assignees: "+strings.Join(request.values, ", "))
+	case batchActionMilestone:
+		summary = append(summary, fmt.Sprintf("Milestone: #%d", request.milestone))
+	}
+	summary = append(summary, formatBatchNumbers(b.numbers))
+	b.confirm.Show(b.title, word, summary, false)
+}
+
+// Start applies the request to the items the menu was opened for in the
+// background and returns the command listening for its progress. parent
+// carries the API call source of the list the menu belongs to.
+func (b *batchActions) Start(parent context.Context, request *batchRequest, apply batchApplyFunc) tea.Cmd {
+	ctx, cancel := context.WithCancel(parent)
+	reporter := components.NewProgressReporter("batch")
+	result := &batchResult{action: request.action}
+	result.errs = make(map[int]error)
+	b.request = request
+	b.cancel = cancel
+	b.reporter = reporter
+	b.result = result
+	b.report = nil
+	b.bar.Start(fmt.Sprintf("%s %s", batchVerbs[request.action], b.noun), components.ProgressUnitItems, "x")
+	b.bar.SetProgress(models.Progress{Total: int64(len(b.numbers))})
+
+	numbers := b.numbers
+	queue := make(chan int, len(numbers))
+	for _, number := range numbers {
+		queue <- number
+	}
+	close(queue)
+
+	// The workers share the progress and the result
+	var mu sync.Mutex
+	progress := models.Progress{Total: int64(len(numbers))}
+	work := func() {
+		for number := range queue {
+			if ctx.Err() != nil {
+				mu.Lock()
+				result.cancelled = true
+				mu.Unlock()
+				return
+			}
+			mu.Lock()
+			progress.Current = fmt.Sprintf("#%d", number)
+			reporter.Report(progress)
+			mu.Unlock()
+
+			event, err := applySafely(ctx, apply, number)
+
+			mu.Lock()
+			switch {
+			case err != nil && ctx.Err() != nil:
+				// Cancelled while in flight; the item is left as it was
+				result.cancelled = true
+			case err != nil:
+				result.failed = append(result.failed, number)
+				result.errs[number] = err
+				result.lastErr = err
+				progress.Failed++
+				progress.Done++
+			default:
+				result.succeeded = append(result.succeeded, number)
+				if event != nil {
+					result.events = append(result.events, *event)
+				}
+				progress.Done++
+			}
+			reporter.Report(progress)
+			mu.Unlock()
+		}
+	}
+
+	workers := batchWorkers
+	if workers > len(numbers) {
+		workers = len(numbers)
+	}
+	go func() {
+		defer cancel()
+		var wg sync.WaitGroup
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				work()
+			}()
+		}
+		wg.Wait()
+
+		sort.Ints(result.succeeded)
+		sort.Ints(result.failed)
+		progress.Current = ""
+		reporter.Finish(progress)
+	}()
+
+	return reporter.Listen()
+}
+
+// applySafely applies the batch to one item, reporting a panic as its failure
+func applySafely(ctx context.Context, apply batchApplyFunc, number int) (event *events.EntityChanged, err error) {
+	defer recoverPanic(&err)
+	return apply(ctx, number)
+}
+
+// Cancel stops the running batch after the item in flight
+func (b *batchActions) Cancel() {
+	if b.cancel != nil && !b.bar.IsCancelled() {
+		b.bar.Cancel()
+		b.cancel()
+	}
+}
+
+// HandleProgress applies a progress message of the running batch. It returns
+// the result once the batch has finished, or the command waiting for the next update.
+func (b *batchActions) HandleProgress(msg components.ProgressMsg) (*batchResult, tea.Cmd) {
+	b.bar.SetProgress(msg.Progress)
+	if !msg.Finished {
+		return nil, b.reporter.Listen()
+	}
+
+	result := b.result
+	b.bar.Stop()
+	b.request = nil
+	b.cancel = nil
+	b.reporter = nil
+	b.result = nil
+	b.report = result
+	return result, nil
+}
+
+// DismissReport hides the report of the finished batch
+func (b *batchActions) DismissReport() {
+	b.report = nil
+}
+
+// HasReport returns true while the report of the finished batch is shown
+func (b *batchActions) HasReport() bool {
+	return b.report != nil
+}
+
+// maxReportLines is how many items the report lists; failures come first
+const maxReportLines = 8
+
+// ReportView renders how each item of the finished batch went, until the next key
+func (b *batchActions) ReportView() string {
+	if b.report == nil {
+		return ""
+	}
+	var lines []string
+	for _, number := range b.report.failed {
+		lines = append(lines, fmt.Sprintf("%s #%d %s", styles.ErrorBannerStyle.Render(styles.IconCross), number,
+			styles.ErrorBannerStyle.Render(b.report.errs[number].Error())))
+	}
+	for _, number := range b.report.succeeded {
+		lines = append(lines, fmt.Sprintf("%s #%d", styles.SuccessStyle.Render(styles.IconCheck), number))
+	}
+	if skipped := len(b.numbers) - len(b.report.failed) - len(b.report.succeeded); skipped > 0 {
+		lines = append(lines, styles.MutedStyle.Render(fmt.Sprintf("%d skipped", skipped)))
+	}
+	if len(lines) > maxReportLines {
+		more := len(lines) - maxReportLines + 1
+		lines = append(lines[:maxReportLines-1], styles.MutedStyle.Render(fmt.Sprintf("... and %d more", more)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ReportHeight returns the number of lines the report takes
+func (b *batchActions) ReportHeight() int {
+	if b.report == nil {
+		return 0
+	}
+	return strings.Count(b.ReportView(), "\n") + 1
+}
+
+// OwnsProgress reports whether a progress message belongs to the running batch
+func (b *batchActions) OwnsProgress(msg components.ProgressMsg) bool {
+	return b.reporter != nil && msg.ID == b.reporter.ID()
+}
+
+// ProgressView renders the progress bar of the running batch
+func (b *batchActions) ProgressView() string {
+	return b.bar.View()
+}
+
+// Summary describes a finished batch
+func (b *batchActions) Summary(result *batchResult) string {
+	verb := batchVerbs[result.action]
+	total := len(b.numbers)
+	done := len(result.succeeded)
+
+	status := fmt.Sprintf("%s %s: %d/%d done", verb, b.noun, done, total)
+	if result.cancelled {
+		status = fmt.Sprintf("%s %s: cancelled after %d/%d", verb, b.noun, done+len(result.failed), total)
+	}
+	if len(result.failed) > 0 {
+		status += fmt.Sprintf(", %d failed (%s): %v", len(result.failed), formatBatchNumbers(result.failed), result.lastErr)
+	}
+	return status
+}
+
+// batchVerbs describes the running actions
+var batchVerbs = map[batchAction]string{
+	batchActionLabel:     "Labeling",
+	batchActionUnlabel:   "Unlabeling",
+	batchActionClose:     "Closing",
+	batchActionAssign:    "Assigning",
+	batchActionMilestone: "Adding to milestone",
+}
+
+// View renders the menu or the open modal
+func (b *batchActions) View() string {
+	if b.form.IsVisible() {
+		return b.form.View()
+	}
+	if b.confirm.IsVisible() {
+		return b.confirm.View()
+	}
+
+	var s strings.Builder
+	s.WriteString(styles.HeaderStyle.Render(fmt.Sprintf("Batch action for %d %s", len(b.numbers), b.noun)))
+	s.WriteString("\n\n")
+	for _, item := range []struct{ key, label string }{
+		{"l", "Add labels"},
+		{"L", "Remove labels"},
+		{"a", "Assign"},
+		{"m", "Add to milestone"},
+		{"c", "Close"},
+		{"esc", "Cancel"},
+	} {
+		s.WriteString("  " + styles.FormatKeyBinding(item.key, item.label) + "\n")
+	}
+	return styles.BorderStyle.Render(strings.TrimRight(s.String(), "\n"))
+}
+
+// toggleSelection selects or deselects one item
+func toggleSelection(selected map[int]struct{}, number int) {
+	if _, ok := selected[number]; ok {
+		delete(selected, number)
+	} else {
+		selected[number] = struct{}{}
+	}
+}
+
+// selectionRange returns the cursor indexes between the range anchor and the cursor
+func selectionRange(anchor, cursor int) (int, int) {
+	if anchor > cursor {
+		return cursor, anchor
+	}
+	return anchor, cursor
+}
+
+// pruneSelection drops selected items that are no longer listed
+func pruneSelection(selected map[int]struct{}, listed map[int]struct{}) {
+	for number := range selected {
+		if _, ok := listed[number]; !ok {
+			delete(selected, number)
+		}
+	}
+}
+
+// sortedSelection returns the selected item numbers in ascending order
+func sortedSelection(selected map[int]struct{}) []int {
+	numbers := make([]int, 0, len(selected))
+	for number := range selected {
+		numbers = append(numbers, number)
+	}
+	sort.Ints(numbers)
+	return numbers
+}
+
+// renderSelectionMark renders the selection column of a list line
+func renderSelectionMark(selected, inRange bool) string {
+	switch {
+	case selected:
+		return styles.SuccessStyle.Render(styles.IconCheck + " ")
+	case inRange:
+		return styles.MutedStyle.Render("· ")
+	default:
+		return "  "
+	}
+}
+
+// mergeBatchValues adds values to existing ones, skipping duplicates
+func mergeBatchValues(existing, added []string) []string {
+	merged := append([]string{}, existing...)
+	for _, value := range added {
+		duplicate := false
+		for _, current := range merged {
+			if strings.EqualFold(current, value) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			merged = append(merged, value)
+		}
+	}
+	return merged
+}
+
+// removeBatchValues removes values from existing ones, ignoring case
+func removeBatchValues(existing, removed []string) []string {
+	remaining := make([]string, 0, len(existing))
+	for _, current := range existing {
+		keep := true
+		for _, value := range removed {
+			if strings.EqualFold(current, value) {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			remaining = append(remaining, current)
+		}
+	}
+	return remaining
+}
+
+// splitBatchValues splits a comma separated list, dropping empty entries and leading @
+func splitBatchValues(text string) []string {
+	var values []string
+	for _, value := range strings.Split(text, ",") {
+		value = strings.TrimPrefix(strings.TrimSpace(value), "@")
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// formatBatchNumbers renders item numbers as "#1, #2, #3"
+func formatBatchNumbers(numbers []int) string {
+	parts := make([]string, len(numbers))
+	for i, number := range numbers {
+		parts[i] = fmt.Sprintf("#%d", number)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// batchEventAction maps a batch action to the event published for each item
+func batchEventAction(action batchAction) events.Action {
+	switch action {
+	case batchActionLabel:
+		return events.ActionLabeled
+	case batchActionClose:
+		return events.ActionClosed
+	default:
+		return events.ActionUpdated
+	}
+}
+
+// labelNames returns the names of labels
+func labelNames(labels []models.Label) []string {
+	names := make([]string, 0, len(labels))
+	for _, label := range labels {
+		names = append(names, label.Name)
+	}
+	return names
+}
+
+// userLogins returns the logins of users
+func userLogins(users []models.User) []string {
+	logins := make([]string, 0, len(users))
+	for _, user := range users {
+		logins = append(logins, user.Login)
+	}
+	return logins
+}
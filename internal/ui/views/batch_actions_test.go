@@ -0,0 +1,373 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/infra/readonly"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// batchIssueRepo records issue updates and fails for the numbers in failFor
+type batchIssueRepo struct {
+	repository.IssueRepository
+	mu      sync.Mutex
+	updates map[int]*models.UpdateIssueInput
+	failFor map[int]bool
+}
+
+func (r *batchIssueRepo) Update(ctx context.Context, owner, repo string, number int, input *models.UpdateIssueInput) (*models.Issue, error) {
+	if r.failFor[number] {
+		return nil, errors.New("forbidden")
+	}
+	r.mu.Lock()
+	r.updates[number] = input
+	r.mu.Unlock()
+	issue := &models.Issue{Number: number, Title: "updated", State: models.IssueStateOpen}
+	if input.Labels != nil {
+		for _, name := range *input.Labels {
+			issue.Labels = append(issue.Labels, models.Label{Name: name})
+		}
+	}
+	return issue, nil
+}
+
+// gatedIssueRepo announces each update and blocks it until it is released
+type gatedIssueRepo struct {
+	repository.IssueRepository
+	started chan int
+	release chan struct{}
+	updated []int
+}
+
+func (r *gatedIssueRepo) Update(ctx context.Context, owner, repo string, number int, input *models.UpdateIssueInput) (*models.Issue, error) {
+	r.started <- number
+	<-r.release
+	r.updated = append(r.updated, number)
+	return &models.Issue{Number: number, State: models.IssueStateClosed}, nil
+}
+
+// batchPRRepo records pull request updates
+type batchPRRepo struct {
+	repository.PullRequestRepository
+	mu      sync.Mutex
+	updates map[int]*models.UpdatePRInput
+}
+
+func (r *batchPRRepo) Update(ctx context.Context, owner, repo string, number int, input *models.UpdatePRInput) (*models.PullRequest, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.updates[number] = input
+	return &models.PullRequest{Number: number, Title: "closed", State: models.PRStateClosed}, nil
+}
+
+// loadedBatchIssueView returns a sized issue view listing issues #3, #2 and #1
+func loadedBatchIssueView(t *testing.T, repo repository.IssueRepository) *IssueView {
+	t.Helper()
+	view := NewIssueViewWithUseCase(&mockFetchIssuesUseCase{
+		getRepositoryFunc: func() repository.IssueRepository { return repo },
+	}, "owner", "repo")
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	view.Update(issuesLoadedMsg{issues: []*models.Issue{
+		{Number: 3, Title: "Third", State: models.IssueStateOpen, Labels: []models.Label{{Name: "bug"}}},
+		{Number: 2, Title: "Second", State: models.IssueStateOpen},
+		{Number: 1, Title: "First", State: models.IssueStateOpen},
+	}})
+	return view
+}
+
+// runBatchSteps feeds the messages of a running batch back into the view until it finishes
+func runBatchSteps(view tea.Model, cmd tea.Cmd) {
+	for cmd != nil {
+		var next []tea.Cmd
+		for _, msg := range runBatch(cmd) {
+			_, c := view.Update(msg)
+			if c != nil {
+				next = append(next, c)
+			}
+		}
+		cmd = tea.Batch(next...)
+	}
+}
+
+func press(view tea.Model, key string) tea.Cmd {
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+	return cmd
+}
+
+func TestIssueView_RangeSelectAndBatchLabel(t *testing.T) {
+	repo := &batchIssueRepo{updates: map[int]*models.UpdateIssueInput{}}
+	view := loadedBatchIssueView(t, repo)
+
+	// V on #3, move down twice, V again selects all three
+	press(view, "V")
+	press(view, "j")
+	press(view, "j")
+	if out := view.View(); !strings.Contains(out, "-- RANGE --") {
+		t.Errorf("expected the range indicator\n%s", out)
+	}
+	press(view, "V")
+	if len(view.selected) != 3 {
+		t.Fatalf("selected = %v, want 3 issues", view.selected)
+	}
+	// v toggles a single issue back off
+	press(view, "v")
+	if _, ok := view.selected[1]; ok || len(view.selected) != 2 {
+		t.Fatalf("selected = %v, want #2 and #3", view.selected)
+	}
+
+	press(view, "b")
+	if !view.IsCapturingInput() || !strings.Contains(view.View(), "Batch action for 2 issues") {
+		t.Fatalf("expected the batch menu\n%s", view.View())
+	}
+	press(view, "l")
+	press(view, "bug, help wanted")
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if out := view.View(); !strings.Contains(out, "Labels: bug, help wanted") || !strings.Contains(out, "#2, #3") {
+		t.Fatalf("expected the batch to be confirmed first\n%s", out)
+	}
+	press(view, "apply")
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected the batch to start")
+	}
+	runBatchSteps(view, cmd)
+
+	if got := *repo.updates[3].Labels; strings.Join(got, ",") != "bug,help wanted" {
+		t.Errorf("labels of #3 = %v, want the new label added once", got)
+	}
+	if got := *repo.updates[2].Labels; strings.Join(got, ",") != "bug,help wanted" {
+		t.Errorf("labels of #2 = %v", got)
+	}
+	if _, ok := repo.updates[1]; ok {
+		t.Error("expected the deselected issue to be left alone")
+	}
+	if len(view.selected) != 0 {
+		t.Errorf("selected = %v, want the finished issues deselected", view.selected)
+	}
+	if out := view.View(); !strings.Contains(out, "Labeling issues: 2/2 done") || !strings.Contains(out, "help wanted") {
+		t.Errorf("expected the batch summary and the updated rows\n%s", out)
+	}
+}
+
+func TestIssueView_BatchReportsFailures(t *testing.T) {
+	repo := &batchIssueRepo{updates: map[int]*models.UpdateIssueInput{}, failFor: map[int]bool{2: true}}
+	view := loadedBatchIssueView(t, repo)
+
+	press(view, "V")
+	press(view, "G")
+	press(view, "V")
+	press(view, "b")
+	press(view, "m")
+	press(view, "4")
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	press(view, "apply")
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	runBatchSteps(view, cmd)
+
+	if len(repo.updates) != 2 || *repo.updates[1].Milestone != 4 {
+		t.Errorf("updates = %v, want milestone 4 on #1 and #3", repo.updates)
+	}
+	if _, ok := view.selected[2]; !ok || len(view.selected) != 1 {
+		t.Errorf("selected = %v, want only the failed issue kept", view.selected)
+	}
+	out := view.View()
+	if !strings.Contains(out, "2/3 done, 1 failed (#2)") {
+		t.Errorf("expected the failure in the summary\n%s", out)
+	}
+	if !strings.Contains(out, "#2 forbidden") || !strings.Contains(out, "#1") || !strings.Contains(out, "#3") {
+		t.Errorf("expected how each issue went\n%s", out)
+	}
+
+	// The report goes away with the next key
+	press(view, "j")
+	if view.batch.HasReport() {
+		t.Error("expected the report to be dismissed")
+	}
+}
+
+func TestIssueView_BatchRemoveLabels(t *testing.T) {
+	repo := &batchIssueRepo{updates: map[int]*models.UpdateIssueInput{}}
+	view := loadedBatchIssueView(t, repo)
+
+	press(view, "b")
+	press(view, "L")
+	press(view, "BUG")
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if repo.updates[3] != nil {
+		t.Fatal("expected nothing to change before confirming")
+	}
+	press(view, "apply")
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	runBatchSteps(view, cmd)
+
+	if input := repo.updates[3]; input == nil || len(*input.Labels) != 0 {
+		t.Errorf("update of #3 = %+v, want the bug label removed", input)
+	}
+	if out := view.View(); !strings.Contains(out, "Unlabeling issues: 1/1 done") {
+		t.Errorf("expected the batch summary\n%s", out)
+	}
+}
+
+// concurrentIssueRepo blocks every update until all of them are in flight
+type concurrentIssueRepo struct {
+	repository.IssueRepository
+	inFlight sync.WaitGroup
+}
+
+func (r *concurrentIssueRepo) Update(ctx context.Context, owner, repo string, number int, input *models.UpdateIssueInput) (*models.Issue, error) {
+	r.inFlight.Done()
+	r.inFlight.Wait()
+	return &models.Issue{Number: number, State: models.IssueStateClosed}, nil
+}
+
+func TestIssueView_BatchRunsConcurrently(t *testing.T) {
+	repo := &concurrentIssueRepo{}
+	repo.inFlight.Add(3)
+	view := loadedBatchIssueView(t, repo)
+
+	press(view, "V")
+	press(view, "G")
+	press(view, "V")
+	press(view, "b")
+	press(view, "c")
+	press(view, "close")
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	// Applied one at a time, the first update would never return
+	runBatchSteps(view, cmd)
+
+	if out := view.View(); !strings.Contains(out, "Closing issues: 3/3 done") {
+		t.Errorf("expected all issues closed\n%s", out)
+	}
+}
+
+func TestPRView_BatchCloseNeedsConfirmation(t *testing.T) {
+	repo := &batchPRRepo{updates: map[int]*models.UpdatePRInput{}}
+	view := NewPRViewWithUseCase(&mockFetchPRsUseCase{
+		getRepositoryFunc: func() repository.PullRequestRepository { return repo },
+	}, "owner", "repo")
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	view.Update(prsLoadedMsg{prs: []*models.PullRequest{
+		{Number: 8, Title: "Second", State: models.PRStateOpen},
+		{Number: 7, Title: "First", State: models.PRStateOpen},
+	}})
+
+	press(view, "v")
+	press(view, "j")
+	press(view, "v")
+	press(view, "b")
+	press(view, "c")
+	if !strings.Contains(view.View(), "#7, #8") {
+		t.Errorf("expected the PRs to close in the confirmation\n%s", view.View())
+	}
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil || len(repo.updates) != 0 {
+		t.Fatal("expected nothing to close before confirming")
+	}
+	press(view, "close")
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	runBatchSteps(view, cmd)
+
+	for _, number := range []int{7, 8} {
+		if input := repo.updates[number]; input == nil || *input.State != models.PRStateClosed {
+			t.Errorf("update of #%d = %+v, want closed", number, input)
+		}
+	}
+	if out := view.View(); !strings.Contains(out, "Closing pull requests: 2/2 done") {
+		t.Errorf("expected the batch summary\n%s", out)
+	}
+}
+
+func TestIssueView_BatchReadOnly(t *testing.T) {
+	view := loadedBatchIssueView(t, readonly.NewIssueRepository(&batchIssueRepo{}))
+
+	press(view, "v")
+	press(view, "b")
+	if view.IsCapturingInput() {
+		t.Fatal("expected no batch menu in guest mode")
+	}
+	if out := view.View(); !strings.Contains(out, readOnlyStatus) {
+		t.Errorf("expected the read-only message\n%s", out)
+	}
+}
+
+func TestIssueView_CancelBatch(t *testing.T) {
+	// One issue at a time, so that the others are still waiting when cancelled
+	batchWorkers = 1
+	defer func() { batchWorkers = 4 }()
+
+	repo := &gatedIssueRepo{started: make(chan int, 1), release: make(chan struct{})}
+	view := loadedBatchIssueView(t, repo)
+
+	press(view, "V")
+	press(view, "G")
+	press(view, "V")
+	press(view, "b")
+	press(view, "c")
+	press(view, "close")
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if out := view.View(); !strings.Contains(out, "Closing issues") || !strings.Contains(out, "0/3") {
+		t.Errorf("expected the progress bar\n%s", out)
+	}
+
+	// The issue in flight is finished, the rest are left alone
+	<-repo.started
+	press(view, "x")
+	if out := view.View(); !strings.Contains(out, "cancelling...") {
+		t.Errorf("expected the cancellation in the progress bar\n%s", out)
+	}
+	close(repo.release)
+	runBatchSteps(view, cmd)
+
+	if len(repo.updated) != 1 || repo.updated[0] != 1 {
+		t.Fatalf("updated = %v, want only the first issue", repo.updated)
+	}
+	if len(view.selected) != 2 {
+		t.Errorf("selected = %v, want the skipped issues kept", view.selected)
+	}
+	if out := view.View(); !strings.Contains(out, "Closing issues: cancelled after 1/3") {
+		t.Errorf("expected the cancellation summary\n%s", out)
+	}
+}
+
+// panickingIssueRepo panics on updates of the numbers in panicFor
+type panickingIssueRepo struct {
+	batchIssueRepo
+	panicFor map[int]bool
+}
+
+func (r *panickingIssueRepo) Update(ctx context.Context, owner, repo string, number int, input *models.UpdateIssueInput) (*models.Issue, error) {
+	if r.panicFor[number] {
+		panic("nil map")
+	}
+	return r.batchIssueRepo.Update(ctx, owner, repo, number, input)
+}
+
+func TestIssueView_BatchRecoversFromPanics(t *testing.T) {
+	repo := &panickingIssueRepo{
+		batchIssueRepo: batchIssueRepo{updates: map[int]*models.UpdateIssueInput{}},
+		panicFor:       map[int]bool{2: true},
+	}
+	view := loadedBatchIssueView(t, repo)
+
+	press(view, "V")
+	press(view, "G")
+	press(view, "V")
+	press(view, "b")
+	press(view, "c")
+	press(view, "close")
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	runBatchSteps(view, cmd)
+
+	if len(repo.updates) != 2 {
+		t.Errorf("updates = %v, want the other issues closed", repo.updates)
+	}
+	if out := view.View(); !strings.Contains(out, "1 failed (#2): unexpected error: nil map") {
+		t.Errorf("expected the panic reported as a failure\n%s", out)
+	}
+}
@@ -0,0 +1,408 @@
+package views
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/infra/git"
+	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// checkoutCommit checks out a bisect candidate locally (overridable in tests)
+var checkoutCommit = git.CheckoutCommit
+
+// bisectRangeLoadedMsg is sent when the commits between good and bad are loaded
+type bisectRangeLoadedMsg struct {
+	comparison *models.Comparison
+	err        error
+}
+
+// bisectStatusesLoadedMsg is sent when the CI statuses of the range are loaded
+type bisectStatusesLoadedMsg struct {
+	statuses map[string]models.CheckState
+}
+
+// bisectCheckedOutMsg is sent when a candidate checkout has finished
+type bisectCheckedOutMsg struct {
+	sha string
+	err error
+}
+
+// bisectState narrows down the first bad commit of a range.
+// commits are oldest first and end with the known bad commit; the first
+// bad commit is always within commits[lo:hi+1].
+type bisectState struct {
+	commits []*models.Commit
+	lo      int
+	hi      int
+	history [][2]int
+}
+
+// newBisectState starts a bisect over commits (oldest first, last one bad)
+func newBisectState(commits []*models.Commit) *bisectState {
+	return &bisectState{commits: commits, hi: len(commits) - 1}
+}
+
+// done reports whether the first bad commit has been found
+func (b *bisectState) done() bool {
+	return b.lo >= b.hi
+}
+
+// midpoint returns the index of the candidate to test next
+func (b *bisectState) midpoint() int {
+	return (b.lo + b.hi) / 2
+}
+
+// remaining returns the number of untested candidates
+func (b *bisectState) remaining() int {
+	return b.hi - b.lo
+}
+
+// steps estimates how many more tests are needed
+func (b *bisectState) steps() int {
+	if b.done() {
+		return 0
+	}
+	return bits.Len(uint(b.remaining()))
+}
+
+// markGood records that the candidate at i is good; the first bad commit comes after it
+func (b *bisectState) markGood(i int) bool {
+	if i < b.lo || i >= b.hi {
+		return false
+	}
+	b.history = append(b.history, [2]int{b.lo, b.hi})
+	b.lo = i + 1
+	return true
+}
+
+// markBad records that the candidate at i is bad; the first bad commit is at or before it
+func (b *bisectState) markBad(i int) bool {
+	if i < b.lo || i >= b.hi {
+		return false
+	}
+	b.history = append(b.history, [2]int{b.lo, b.hi})
+	b.hi = i
+	return true
+}
+
+// undo reverts the last mark
+func (b *bisectState) undo() bool {
+	if len(b.history) == 0 {
+		return false
+	}
+	last := b.history[len(b.history)-1]
+	b.history = b.history[:len(b.history)-1]
+	b.lo, b.hi = last[0], last[1]
+	return true
+}
+
+// BisectView steps through the commits between a good and a bad commit,
+// using CI statuses and local checkouts to find the one that broke the build
+type BisectView struct {
+	commitRepo repository.CommitRepository
+	owner      string
+	repo       string
+	good       *models.Commit
+	bad        *models.Commit
+	state      *bisectState
+	statuses   map[string]models.CheckState
+	truncated  bool
+	cursor     int
+	loading    bool
+	err        error
+	width      int
+	height     int
+	statusBar  *components.StatusBar
+	showHelp   bool
+	loads      loadGroup
+}
+
+// NewBisectView creates a bisect view between a good and a bad commit
+func NewBisectView(commitRepo repository.CommitRepository, owner, repo string, good, bad *models.Commit) *BisectView {
+	return &BisectView{
+		loads:      loadGroup{source: sourceBisect},
+		commitRepo: commitRepo,
+		owner:      owner,
+		repo:       repo,
+		good:       good,
+		bad:        bad,
+		loading:    true,
+		statusBar:  components.NewStatusBar(),
+	}
+}
+
+// Init loads the commit range
+func (m *BisectView) Init() tea.Cmd {
+	return m.loadRange()
+}
+
+// Close cancels the fetches still in flight when the view is closed
+func (m *BisectView) Close() {
+	m.loads.Cancel()
+}
+
+// loadRange fetches the commits between good and bad
+func (m *BisectView) loadRange() tea.Cmd {
+	ctx := m.loads.Context()
+	return func() tea.Msg {
+		if m.commitRepo == nil {
+			return bisectRangeLoadedMsg{err: fmt.Errorf("commit repository not initialized")}
+		}
+		comparison, err := m.commitRepo.Compare(ctx, m.owner, m.repo, m.good.SHA, m.bad.SHA)
+		return bisectRangeLoadedMsg{comparison: comparison, err: err}
+	}
+}
+
+// loadStatuses fetches the CI status of every commit in the range
+func (m *BisectView) loadStatuses(commits []*models.Commit) tea.Cmd {
+	ctx := m.loads.Context()
+	return func() tea.Msg {
+		return bisectStatusesLoadedMsg{statuses: loadCommitStatuses(ctx, m.commitRepo, m.owner, m.repo, commits)}
+	}
+}
+
+// Update handles messages
+func (m *BisectView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case bisectRangeLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		if msg.comparison == nil || msg.comparison.Status != models.ComparisonStatusAhead || len(msg.comparison.Commits) == 0 {
+			m.err = fmt.Errorf("%s is not an ancestor of %s", shortSHA(m.good.SHA), shortSHA(m.bad.SHA))
+			return m, nil
+		}
+		m.state = newBisectState(msg.comparison.Commits)
+		m.truncated = msg.comparison.TotalCommits > len(msg.comparison.Commits)
+		m.cursor = m.state.midpoint()
+		return m, m.loadStatuses(msg.comparison.Commits)
+
+	case bisectStatusesLoadedMsg:
+		m.statuses = msg.statuses
+		return m, nil
+
+	case bisectCheckedOutMsg:
+		if msg.err != nil {
+			m.statusBar.SetMessage(fmt.Sprintf("Checkout failed: %v", msg.err))
+		} else {
+			m.statusBar.SetMessage(fmt.Sprintf("Checked out %s (detached HEAD)", shortSHA(msg.sha)))
+		}
+		return m, nil
+
+	case openBrowserMsg:
+		m.statusBar.SetMessage(browserStatusMessage(msg))
+		return m, nil
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.statusBar.SetSize(msg.Width, 1)
+		return m, nil
+
+	case tea.KeyMsg:
+		return m.handleKeyPress(msg)
+	}
+
+	return m, nil
+}
+
+// handleKeyPress handles keyboard input
+func (m *BisectView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "esc":
+		return m, func() tea.Msg { return backMsg{} }
+
+	case "?":
+		m.showHelp = !m.showHelp
+		return m, nil
+	}
+
+	if m.state == nil {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "j", "down":
+		// The list is newest first, so moving down goes back in history
+		if m.cursor > m.state.lo {
+			m.cursor--
+		}
+
+	case "k", "up":
+		if m.cursor < m.state.hi {
+			m.cursor++
+		}
+
+	case "g":
+		if m.state.markGood(m.cursor) {
+			m.afterStep()
+		} else {
+			m.statusBar.SetMessage("Only untested commits can be marked")
+		}
+
+	case "b":
+		if m.state.markBad(m.cursor) {
+			m.afterStep()
+		} else {
+			m.statusBar.SetMessage("Only untested commits can be marked")
+		}
+
+	case "u":
+		if m.state.undo() {
+			m.afterStep()
+		}
+
+	case "ctrl+o":
+		sha := m.state.commits[m.cursor].SHA
+		m.statusBar.SetMessage(fmt.Sprintf("Checking out %s...", shortSHA(sha)))
+		return m, m.checkout(sha)
+
+	case "o":
+		sha := m.state.commits[m.cursor].SHA
+		return m, openInBrowser(fmt.Sprintf("https://github.com/%s/%s/commit/%s", m.owner, m.repo, sha))
+	}
+
+	return m, nil
+}
+
+// afterStep moves the cursor to the next candidate and reports progress
+func (m *BisectView) afterStep() {
+	if m.state.done() {
+		m.cursor = m.state.hi
+		m.statusBar.SetMessage(fmt.Sprintf("First bad commit: %s", shortSHA(m.state.commits[m.state.hi].SHA)))
+		return
+	}
+	m.cursor = m.state.midpoint()
+	m.statusBar.SetMessage(fmt.Sprintf("%d candidates left (~%d steps)", m.state.remaining(), m.state.steps()))
+}
+
+// checkout checks out a candidate in the local clone of the repository
+func (m *BisectView) checkout(sha string) tea.Cmd {
+	return func() tea.Msg {
+		owner, repo, err := currentRepository()
+		if err != nil || !strings.EqualFold(owner, m.owner) || !strings.EqualFold(repo, m.repo) {
+			return bisectCheckedOutMsg{sha: sha, err: fmt.Errorf("not in a local clone of %s/%s", m.owner, m.repo)}
+		}
+		return bisectCheckedOutMsg{sha: sha, err: checkoutCommit(sha)}
+	}
+}
+
+// View renders the bisect view
+func (m *BisectView) View() string {
+	var s strings.Builder
+
+	s.WriteString(styles.HeaderStyle.Render("Bisect"))
+	s.WriteString(" ")
+	s.WriteString(styles.MutedStyle.Render(fmt.Sprintf("good %s → bad %s", shortSHA(m.good.SHA), shortSHA(m.bad.SHA))))
+	s.WriteString("\n")
+
+	switch {
+	case m.loading:
+		s.WriteString(styles.LoadingStyle.Render("Loading commit range..."))
+	case m.err != nil:
+		s.WriteString(styles.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+	default:
+		s.WriteString(m.renderProgress())
+		s.WriteString("\n")
+		s.WriteString(m.renderRange())
+	}
+
+	if m.showHelp {
+		s.WriteString("\n")
+		s.WriteString(m.renderHelp())
+	}
+
+	s.WriteString("\n")
+	m.statusBar.ClearItems()
+	m.statusBar.SetMode("Bisect")
+	if m.owner != "" && m.repo != "" {
+		m.statusBar.AddItem("Repo", fmt.Sprintf("%s/%s", m.owner, m.repo))
+	}
+	s.WriteString(m.statusBar.View())
+
+	return s.String()
+}
+
+// renderProgress renders the bisect summary line
+func (m *BisectView) renderProgress() string {
+	if m.state.done() {
+		commit := m.state.commits[m.state.hi]
+		return styles.ErrorStyle.Render(fmt.Sprintf("First bad commit: %s %s", shortSHA(commit.SHA), firstLine(commit.Message)))
+	}
+
+	progress := fmt.Sprintf("%d candidates left, about %d steps · test the midpoint, then g: good  b: bad", m.state.remaining(), m.state.steps())
+	if m.truncated {
+		progress += " · range truncated by GitHub, narrow it to see every commit"
+	}
+	return styles.MutedStyle.Render(progress)
+}
+
+// renderRange renders the remaining range, newest first like the commit list
+func (m *BisectView) renderRange() string {
+	var lines []string
+	mid := m.state.midpoint()
+	for i := m.state.hi; i >= m.state.lo; i-- {
+		commit := m.state.commits[i]
+
+		cursor := "  "
+		if i == m.cursor {
+			cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
+		}
+
+		var tag string
+		switch {
+		case i == m.state.hi && m.state.done():
+			tag = styles.ErrorStyle.Render(" ← first bad")
+		case i == m.state.hi:
+			tag = styles.PRChangesRequestedStyle.Render(" ← bad")
+		case i == mid:
+			tag = styles.PRPendingStyle.Render(" ← midpoint")
+		}
+
+		message := firstLine(commit.Message)
+		if i == m.cursor {
+			message = styles.SelectedStyle.Render(message)
+		}
+		lines = append(lines, fmt.Sprintf("%s%s %s  %s%s",
+			cursor,
+			renderCommitStatus(m.statuses[commit.SHA]),
+			styles.IssueNumberStyle.Render(shortSHA(commit.SHA)),
+			message,
+			tag,
+		))
+	}
+	lines = append(lines, styles.MutedStyle.Render(fmt.Sprintf("    %s  (good)", shortSHA(m.good.SHA))))
+	return strings.Join(lines, "\n")
+}
+
+// renderHelp renders the help section
+func (m *BisectView) renderHelp() string {
+	helpText := `
+Navigation:
+  ↑/k     Newer commit
+  ↓/j     Older commit
+
+Bisect:
+  g       Mark commit as good
+  b       Mark commit as bad
+  u       Undo last mark
+  ctrl+o  Checkout commit locally
+  o       Open commit in browser
+
+General:
+  ?       Toggle help
+  q/esc   Back to commits
+`
+
+	return styles.BorderStyle.Render(
+		styles.HelpStyle.Render(strings.TrimSpace(helpText)),
+	)
+}
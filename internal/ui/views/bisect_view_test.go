@@ -0,0 +1,207 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// bisectCommits returns n commits, oldest first, with SHAs c00, c01, ...
+func bisectCommits(n int) []*models.Commit {
+	commits := make([]*models.Commit, n)
+	for i := range commits {
+		commits[i] = &models.Commit{SHA: fmt.Sprintf("c%02d", i), Message: fmt.Sprintf("commit %d", i)}
+	}
+	return commits
+}
+
+// bisectRepo serves a comparison of the given commits and their statuses
+type bisectRepo struct {
+	testCommitRepo
+	comparison *models.Comparison
+	base, head string
+}
+
+func (r *bisectRepo) Compare(ctx context.Context, owner, repo, base, head string) (*models.Comparison, error) {
+	r.base, r.head = base, head
+	return r.comparison, nil
+}
+
+func TestBisectState(t *testing.T) {
+	// Commits 0..9, first bad commit is 6
+	state := newBisectState(bisectCommits(10))
+	firstBad := 6
+
+	for steps := 0; !state.done(); steps++ {
+		if steps > 5 {
+			t.Fatal("bisect did not converge")
+		}
+		mid := state.midpoint()
+		if mid < firstBad {
+			state.markGood(mid)
+		} else {
+			state.markBad(mid)
+		}
+	}
+	if state.hi != firstBad {
+		t.Errorf("first bad = %d, want %d", state.hi, firstBad)
+	}
+
+	// Undo walks back to the start
+	for state.undo() {
+	}
+	if state.lo != 0 || state.hi != 9 {
+		t.Errorf("after undo lo=%d hi=%d, want 0 and 9", state.lo, state.hi)
+	}
+
+	// The known bad commit and tested commits cannot be marked
+	if state.markGood(9) || state.markBad(-1) {
+		t.Error("marks outside the untested range should be refused")
+	}
+}
+
+func TestBisectState_Steps(t *testing.T) {
+	tests := map[int]int{1: 0, 2: 1, 3: 2, 9: 4}
+	for n, want := range tests {
+		if got := newBisectState(bisectCommits(n)).steps(); got != want {
+			t.Errorf("steps for %d commits = %d, want %d", n, got, want)
+		}
+	}
+}
+
+func TestCommitView_StartBisect(t *testing.T) {
+	// The commit list is newest first
+	listed := []*models.Commit{{SHA: "newest"}, {SHA: "middle"}, {SHA: "oldest"}}
+	commitRepo := &bisectRepo{comparison: &models.Comparison{Status: models.ComparisonStatusAhead, Commits: bisectCommits(3)}}
+	view := NewCommitViewWithUseCase(&mockFetchCommitsUseCase{repo: commitRepo}, "owner", "repo")
+	view.Update(commitsLoadedMsg{commits: listed})
+
+	// B without a mark explains what to do
+	if _, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'B'}}); cmd != nil || view.showingBisect {
+		t.Fatal("B without a mark should not start a bisect")
+	}
+
+	view.cursor = 0
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	if view.bisectMark != "newest" {
+		t.Fatalf("bisect mark = %q, want newest", view.bisectMark)
+	}
+	view.cursor = 2
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'B'}})
+	if cmd == nil || !view.showingBisect || !view.IsShowingDetail() {
+		t.Fatal("B should open the bisect view")
+	}
+	view.Update(cmd())
+
+	if commitRepo.base != "oldest" || commitRepo.head != "newest" {
+		t.Errorf("compared %s...%s, want the older commit as good", commitRepo.base, commitRepo.head)
+	}
+	if view.bisectView.state == nil {
+		t.Fatal("bisect range should be loaded")
+	}
+
+	// q returns to the commit list
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	view.Update(cmd())
+	if view.showingBisect {
+		t.Error("q should close the bisect view")
+	}
+}
+
+func TestBisectView_Steps(t *testing.T) {
+	commits := bisectCommits(8)
+	commitRepo := &bisectRepo{
+		testCommitRepo: testCommitRepo{statuses: map[string]models.CheckState{
+			"c03": models.CheckStateSuccess,
+			"c05": models.CheckStateFailure,
+		}},
+		comparison: &models.Comparison{Status: models.ComparisonStatusAhead, Commits: commits},
+	}
+	view := NewBisectView(commitRepo, "owner", "repo", &models.Commit{SHA: "good"}, commits[7])
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+
+	_, cmd := view.Update(view.Init()())
+	if cmd == nil {
+		t.Fatal("loading the range should fetch CI statuses")
+	}
+	view.Update(cmd())
+
+	if view.cursor != 3 {
+		t.Fatalf("cursor should start at the midpoint, got %d", view.cursor)
+	}
+	rendered := view.View()
+	for _, want := range []string{"7 candidates left", "← midpoint", "← bad", "✓", "✗"} {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("expected %q in\n%s", want, rendered)
+		}
+	}
+
+	// c03 passed CI: good. The next midpoint is c05, which failed: bad.
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+	if view.cursor != 5 {
+		t.Fatalf("cursor should move to the next midpoint, got %d", view.cursor)
+	}
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	if !view.state.done() || view.state.hi != 4 {
+		t.Fatalf("expected c04 to be the first bad commit, state %+v", view.state)
+	}
+	if !strings.Contains(view.View(), "First bad commit: c04") {
+		t.Errorf("expected the result in\n%s", view.View())
+	}
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'u'}})
+	if view.state.done() {
+		t.Error("u should undo the last mark")
+	}
+}
+
+func TestBisectView_NotAncestor(t *testing.T) {
+	commitRepo := &bisectRepo{comparison: &models.Comparison{Status: models.ComparisonStatusDiverged, Commits: bisectCommits(2)}}
+	view := NewBisectView(commitRepo, "owner", "repo", &models.Commit{SHA: "good"}, &models.Commit{SHA: "bad"})
+
+	view.Update(view.Init()())
+	if view.err == nil || view.state != nil {
+		t.Error("diverged commits cannot be bisected")
+	}
+}
+
+func TestBisectView_Checkout(t *testing.T) {
+	stubLocalGit(t, "owner", "repo", nil, nil)
+	origCheckout := checkoutCommit
+	t.Cleanup(func() { checkoutCommit = origCheckout })
+
+	var checkedOut string
+	checkoutCommit = func(sha string) error {
+		checkedOut = sha
+		return nil
+	}
+
+	commits := bisectCommits(4)
+	commitRepo := &bisectRepo{comparison: &models.Comparison{Status: models.ComparisonStatusAhead, Commits: commits}}
+	view := NewBisectView(commitRepo, "owner", "repo", &models.Commit{SHA: "good"}, commits[3])
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	view.Update(view.Init()())
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyCtrlO})
+	view.Update(cmd())
+	if checkedOut != "c01" {
+		t.Errorf("checked out %q, want the midpoint c01", checkedOut)
+	}
+	if !strings.Contains(view.statusBar.View(), "Checked out c01") {
+		t.Errorf("status should report the checkout, got %q", view.statusBar.View())
+	}
+
+	// Outside a clone of the repository nothing is checked out
+	currentRepository = func() (string, string, error) { return "someone", "else", nil }
+	checkedOut = ""
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyCtrlO})
+	view.Update(cmd())
+	if checkedOut != "" || !strings.Contains(view.statusBar.View(), "not in a local clone") {
+		t.Errorf("checkout outside the clone should fail, status %q", view.statusBar.View())
+	}
+}
@@ -0,0 +1,55 @@
+package views
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/a1yama/tig-gh/internal/infra/browser"
+	"github.com/a1yama/tig-gh/internal/infra/clipboard"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// openBrowserMsg reports the result of opening a URL in the browser
+type openBrowserMsg struct {
+	url    string
+	err    error
+	copied clipboard.Method // how the URL was copied when it could not be opened
+}
+
+// openBrowser is the URL opener used by views (overridable in tests)
+var openBrowser = browser.Open
+
+// copyURL copies a URL that could not be opened (overridable in tests)
+var copyURL = clipboard.CopyWithFallback
+
+// openInBrowser returns a command that opens the URL without blocking the UI.
+// When that fails (no browser over SSH or in a devcontainer, or the opener
+// exits with an error) the URL is copied so it can be pasted locally.
+func openInBrowser(url string) tea.Cmd {
+	return func() tea.Msg {
+		msg := openBrowserMsg{url: url, err: openBrowser(url)}
+		if msg.err != nil && url != "" {
+			if method, err := copyURL(url); err == nil {
+				msg.copied = method
+			}
+		}
+		return msg
+	}
+}
+
+// browserStatusMessage describes the outcome of an openBrowserMsg.
+// When no browser is available (e.g. over SSH) the URL itself is shown.
+func browserStatusMessage(msg openBrowserMsg) string {
+	copied := ""
+	if msg.copied != "" {
+		copied = fmt.Sprintf(" (copied via %s)", msg.copied)
+	}
+	switch {
+	case errors.Is(msg.err, browser.ErrNoBrowser):
+		return fmt.Sprintf("Open in your browser%s: %s", copied, msg.url)
+	case msg.err != nil:
+		return fmt.Sprintf("Failed to open browser: %v%s (%s)", msg.err, copied, msg.url)
+	default:
+		return "Opened in browser"
+	}
+}
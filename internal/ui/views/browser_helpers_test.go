@@ -0,0 +1,94 @@
+package views
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/infra/browser"
+	"github.com/a1yama/tig-gh/internal/infra/clipboard"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func stubOpenBrowser(t *testing.T, fn func(string) error) {
+	t.Helper()
+	orig, origCopy := openBrowser, copyURL
+	t.Cleanup(func() { openBrowser, copyURL = orig, origCopy })
+	openBrowser = fn
+	copyURL = func(string) (clipboard.Method, error) { return "", errors.New("no clipboard") }
+}
+
+func TestBrowserStatusMessage(t *testing.T) {
+	url := "https://github.com/owner/repo/issues/1"
+
+	tests := []struct {
+		name   string
+		err    error
+		copied clipboard.Method
+		want   string
+	}{
+		{name: "opened", err: nil, want: "Opened in browser"},
+		{name: "no browser prints url", err: browser.ErrNoBrowser, want: "Open in your browser: " + url},
+		{name: "failure", err: errors.New("boom"), want: "Failed to open browser: boom"},
+		{name: "copied over ssh", err: browser.ErrNoBrowser, copied: clipboard.MethodOSC52, want: "Open in your browser (copied via OSC 52): " + url},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := browserStatusMessage(openBrowserMsg{url: url, err: tt.err, copied: tt.copied})
+			if !strings.Contains(got, tt.want) {
+				t.Errorf("expected %q to contain %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIssueDetailView_OpenInBrowserShowsURLOverSSH(t *testing.T) {
+	var opened string
+	stubOpenBrowser(t, func(url string) error {
+		opened = url
+		return browser.ErrNoBrowser
+	})
+
+	issue := &models.Issue{Number: 1, Title: "Bug", HTMLURL: "https://github.com/owner/repo/issues/1"}
+	view := NewIssueDetailView(issue, "owner", "repo", nil)
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+	if cmd == nil {
+		t.Fatal("expected open command")
+	}
+	view.Update(cmd())
+
+	if opened != issue.HTMLURL {
+		t.Fatalf("expected %s to be opened, got %s", issue.HTMLURL, opened)
+	}
+	if !strings.Contains(view.View(), issue.HTMLURL) {
+		t.Fatal("expected URL to be printed in the footer")
+	}
+}
+
+func TestOpenInBrowser_CopiesURLWhenItCannotOpen(t *testing.T) {
+	stubOpenBrowser(t, func(string) error { return browser.ErrNoBrowser })
+	var copied string
+	copyURL = func(url string) (clipboard.Method, error) {
+		copied = url
+		return clipboard.MethodOSC52, nil
+	}
+
+	url := "https://github.com/owner/repo/pull/2"
+	msg := openInBrowser(url)().(openBrowserMsg)
+	if copied != url || msg.copied != clipboard.MethodOSC52 {
+		t.Fatalf("expected the URL to be copied, got %q via %q", copied, msg.copied)
+	}
+
+	stubOpenBrowser(t, func(string) error { return nil })
+	copyURL = func(string) (clipboard.Method, error) {
+		t.Fatal("an opened URL should not be copied")
+		return "", nil
+	}
+	if msg := openInBrowser(url)().(openBrowserMsg); msg.copied != "" {
+		t.Errorf("unexpected copy %q", msg.copied)
+	}
+}
@@ -37,11 +37,13 @@ type CommitDetailView struct {
 	statusBar                *components.StatusBar
 	showHelp                 bool
 	scrollOffset             int
+	loads                    loadGroup
 }
 
 // NewCommitDetailView creates a new commit detail view with a commit
 func NewCommitDetailView(commit *models.Commit) *CommitDetailView {
 	return &CommitDetailView{
+		loads:                    loadGroup{source: sourceCommitDetail},
 		fetchCommitDetailUseCase: nil,
 		owner:                    "",
 		repo:                     "",
@@ -57,6 +59,7 @@ func NewCommitDetailView(commit *models.Commit) *CommitDetailView {
 // NewCommitDetailViewEmpty creates a new empty commit detail view
 func NewCommitDetailViewEmpty() *CommitDetailView {
 	return &CommitDetailView{
+		loads:                    loadGroup{source: sourceCommitDetail},
 		fetchCommitDetailUseCase: nil,
 		owner:                    "",
 		repo:                     "",
@@ -72,6 +75,7 @@ func NewCommitDetailViewEmpty() *CommitDetailView {
 // NewCommitDetailViewWithUseCase creates a new commit detail view with UseCase
 func NewCommitDetailViewWithUseCase(fetchCommitDetailUseCase FetchCommitDetailUseCase, owner, repo, sha string) *CommitDetailView {
 	return &CommitDetailView{
+		loads:                    loadGroup{source: sourceCommitDetail},
 		fetchCommitDetailUseCase: fetchCommitDetailUseCase,
 		owner:                    owner,
 		repo:                     repo,
@@ -119,8 +123,14 @@ func (m *CommitDetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// Close cancels the fetch still in flight when the view is closed
+func (m *CommitDetailView) Close() {
+	m.loads.Cancel()
+}
+
 // fetchCommitDetail fetches commit detail from the API
 func (m *CommitDetailView) fetchCommitDetail() tea.Cmd {
+	ctx := m.loads.Context()
 	return func() tea.Msg {
 		if m.fetchCommitDetailUseCase == nil {
 			return commitDetailLoadedMsg{
@@ -129,7 +139,7 @@ func (m *CommitDetailView) fetchCommitDetail() tea.Cmd {
 			}
 		}
 
-		commit, err := m.fetchCommitDetailUseCase.Execute(context.Background(), m.owner, m.repo, m.sha)
+		commit, err := m.fetchCommitDetailUseCase.Execute(ctx, m.owner, m.repo, m.sha)
 		return commitDetailLoadedMsg{
 			commit: commit,
 			err:    err,
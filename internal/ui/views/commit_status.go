@@ -0,0 +1,82 @@
+package views
+
+import (
+	"context"
+	"sync"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// commitStatusConcurrency caps the number of status requests in flight
+const commitStatusConcurrency = 8
+
+// commitStatusesLoadedMsg is sent when the CI statuses of the listed commits are loaded.
+// Commits whose status could not be fetched are left out.
+type commitStatusesLoadedMsg struct {
+	statuses map[string]models.CheckState
+}
+
+// fetchCommitStatuses fetches the combined status of each commit
+func fetchCommitStatuses(ctx context.Context, repo repository.CommitRepository, owner, name string, commits []*models.Commit) tea.Cmd {
+	if repo == nil || len(commits) == 0 {
+		return nil
+	}
+
+	return func() tea.Msg {
+		return commitStatusesLoadedMsg{statuses: loadCommitStatuses(ctx, repo, owner, name, commits)}
+	}
+}
+
+// loadCommitStatuses fetches the combined status of each commit, a few at a
+// time, until ctx is cancelled
+func loadCommitStatuses(ctx context.Context, repo repository.CommitRepository, owner, name string, commits []*models.Commit) map[string]models.CheckState {
+	statuses := make(map[string]models.CheckState, len(commits))
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, commitStatusConcurrency)
+	for _, commit := range commits {
+		if commit == nil || commit.SHA == "" {
+			continue
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(sha string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			defer recoverPanic(nil)
+
+			state, err := repo.GetCombinedStatus(ctx, owner, name, sha)
+			if err != nil || state == "" {
+				return
+			}
+			mu.Lock()
+			statuses[sha] = state
+			mu.Unlock()
+		}(commit.SHA)
+	}
+	wg.Wait()
+
+	return statuses
+}
+
+// renderCommitStatus renders the CI status column: ✓ passed, ✗ failed, ● running,
+// blank when the commit has no status
+func renderCommitStatus(state models.CheckState) string {
+	switch state {
+	case models.CheckStateSuccess:
+		return styles.PRApprovedStyle.Render(styles.IconCheck)
+	case models.CheckStateFailure:
+		return styles.PRChangesRequestedStyle.Render(styles.IconCross)
+	case models.CheckStatePending:
+		return styles.PRPendingStyle.Render(styles.IconDot)
+	default:
+		return " "
+	}
+}
@@ -0,0 +1,105 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+type testCommitRepo struct {
+	mu       sync.Mutex
+	statuses map[string]models.CheckState
+	calls    int
+}
+
+func (r *testCommitRepo) List(ctx context.Context, owner, repo string, opts *models.CommitOptions) ([]*models.Commit, error) {
+	return nil, nil
+}
+
+func (r *testCommitRepo) Get(ctx context.Context, owner, repo, sha string) (*models.Commit, error) {
+	return nil, nil
+}
+
+func (r *testCommitRepo) Compare(ctx context.Context, owner, repo, base, head string) (*models.Comparison, error) {
+	return nil, nil
+}
+
+func (r *testCommitRepo) ListBranches(ctx context.Context, owner, repo string) ([]*models.Branch, error) {
+	return nil, nil
+}
+
+func (r *testCommitRepo) GetBranch(ctx context.Context, owner, repo, branch string) (*models.Branch, error) {
+	return nil, nil
+}
+
+func (r *testCommitRepo) GetDefaultBranch(ctx context.Context, owner, repo string) (string, error) {
+	return "main", nil
+}
+
+func (r *testCommitRepo) GetFileContent(ctx context.Context, owner, repo, path, ref string) (string, error) {
+	return "", nil
+}
+
+func (r *testCommitRepo) GetCombinedStatus(ctx context.Context, owner, repo, ref string) (models.CheckState, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls++
+	state, ok := r.statuses[ref]
+	if !ok {
+		return "", errors.New("not found")
+	}
+	return state, nil
+}
+
+func TestCommitView_LoadsStatuses(t *testing.T) {
+	commitRepo := &testCommitRepo{statuses: map[string]models.CheckState{
+		"aaaaaaaaaa": models.CheckStateSuccess,
+		"bbbbbbbbbb": models.CheckStateFailure,
+		"cccccccccc": models.CheckStatePending,
+		"dddddddddd": "",
+	}}
+	view := NewCommitViewWithUseCase(&mockFetchCommitsUseCase{repo: commitRepo}, "owner", "repo")
+	view.width, view.height = 120, 30
+
+	commits := []*models.Commit{
+		{SHA: "aaaaaaaaaa", Message: "green"},
+		{SHA: "bbbbbbbbbb", Message: "red"},
+		{SHA: "cccccccccc", Message: "running"},
+		{SHA: "dddddddddd", Message: "no status"},
+		{SHA: "eeeeeeeeee", Message: "lookup failed"},
+	}
+	_, cmd := view.Update(commitsLoadedMsg{commits: commits})
+	if cmd == nil {
+		t.Fatal("loading commits should fetch their statuses")
+	}
+	view.Update(cmd())
+
+	if commitRepo.calls != len(commits) {
+		t.Errorf("expected %d status requests, got %d", len(commits), commitRepo.calls)
+	}
+
+	want := map[int]string{0: "✓", 1: "✗", 2: "●"}
+	for i, commit := range commits {
+		line := view.renderCommitLine(commit, i)
+		mark, ok := want[i]
+		if ok && !strings.Contains(line, mark) {
+			t.Errorf("%s: expected %s in %q", commit.Message, mark, line)
+		}
+		if !ok && strings.ContainsAny(line, "✓✗●") {
+			t.Errorf("%s: expected no status mark in %q", commit.Message, line)
+		}
+	}
+}
+
+func TestCommitView_StatusesWithoutRepository(t *testing.T) {
+	view := NewCommitViewWithUseCase(&mockFetchCommitsUseCase{}, "owner", "repo")
+
+	_, cmd := view.Update(commitsLoadedMsg{commits: []*models.Commit{{SHA: "abc"}}})
+	if cmd != nil {
+		t.Error("no status fetch should start without a repository")
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"strings"
 
 	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/infra/clipboard"
 	"github.com/a1yama/tig-gh/internal/ui/components"
 	"github.com/a1yama/tig-gh/internal/ui/styles"
 	tea "github.com/charmbracelet/bubbletea"
@@ -15,6 +17,7 @@ import (
 // FetchCommitsUseCase defines the interface for fetching commits
 type FetchCommitsUseCase interface {
 	Execute(ctx context.Context, owner, repo string, opts *models.CommitOptions) ([]*models.Commit, error)
+	GetRepository() repository.CommitRepository
 }
 
 // commitsLoadedMsg is sent when commits are loaded
@@ -38,11 +41,19 @@ type CommitView struct {
 	showHelp            bool
 	detailView          *CommitDetailView
 	showingDetail       bool
+	statuses            map[string]models.CheckState
+	bisectMark          string
+	bisectView          *BisectView
+	showingBisect       bool
+	dates               dateRangeFilter
+	loads               loadGroup
+	live                liveList
 }
 
 // NewCommitView creates a new commit view
 func NewCommitView() *CommitView {
 	return &CommitView{
+		loads:               loadGroup{source: sourceCommits},
 		fetchCommitsUseCase: nil,
 		owner:               "",
 		repo:                "",
@@ -57,6 +68,7 @@ func NewCommitView() *CommitView {
 // NewCommitViewWithUseCase creates a new commit view with UseCase
 func NewCommitViewWithUseCase(fetchCommitsUseCase FetchCommitsUseCase, owner, repo string) *CommitView {
 	return &CommitView{
+		loads:               loadGroup{source: sourceCommits},
 		fetchCommitsUseCase: fetchCommitsUseCase,
 		owner:               owner,
 		repo:                repo,
@@ -78,11 +90,37 @@ func (m *CommitView) Init() tea.Cmd {
 
 // Update handles messages
 func (m *CommitView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// A retry from the error banner reloads the list even while a detail view is open
+	if isRetryFor(msg, m) {
+		return m, m.refresh()
+	}
+
+	if _, ok := msg.(AutoRefreshMsg); ok {
+		return m, m.autoRefresh()
+	}
+
+	// The bisect view handles everything until it sends backMsg
+	if m.showingBisect && m.bisectView != nil {
+		if _, isBackMsg := msg.(backMsg); isBackMsg {
+			m.bisectView.Close()
+			m.showingBisect = false
+			m.bisectView = nil
+			return m, nil
+		}
+		if size, ok := msg.(tea.WindowSizeMsg); ok {
+			m.width = size.Width
+			m.height = size.Height
+			m.statusBar.SetSize(size.Width, 1)
+		}
+		updatedModel, cmd := m.bisectView.Update(msg)
+		m.bisectView = updatedModel.(*BisectView)
+		return m, cmd
+	}
+
 	switch msg := msg.(type) {
 	case backMsg:
 		// Return from detail view
-		m.showingDetail = false
-		m.detailView = nil
+		m.closeDetail()
 		return m, nil
 
 	case tea.KeyMsg:
@@ -91,8 +129,7 @@ func (m *CommitView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// If showing detail view, check for back navigation first
 		if m.showingDetail && m.detailView != nil {
 			if keyStr == "q" || keyStr == "esc" {
-				m.showingDetail = false
-				m.detailView = nil
+				m.closeDetail()
 				return m, nil
 			}
 			// Otherwise delegate to detail view
@@ -101,24 +138,44 @@ func (m *CommitView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.detailView = updatedModel.(*CommitDetailView)
 			return m, cmd
 		}
+		if m.dates.isOpen() {
+			return m, m.handleDateRangeKey(msg)
+		}
 		// Handle key press in list view
 		return m.handleKeyPress(msg)
 
 	case commitsLoadedMsg:
+		if isCancelled(msg.err) {
+			// Cancelled with esc or replaced by a newer fetch
+			return m, nil
+		}
+		// Loads started by the user show the loading screen; quiet ones do not
+		quiet := m.live.finish(msg.err == nil) && !m.loading
 		m.loading = false
+		if msg.err != nil && quiet {
+			// The list on screen stays; the banner tells what went wrong
+			return m, reportLoadError(m, "commits", msg.err)
+		}
 		if msg.err != nil {
 			m.err = msg.err
 			m.commits = []*models.Commit{}
 		} else {
 			m.err = nil
 			m.commits = msg.commits
-			// Reset cursor if it's out of bounds
-			if m.cursor >= len(m.commits) && len(m.commits) > 0 {
+			if quiet {
+				m.cursor = m.live.cursorAfter(m.cursor, len(m.commits), func(i int) any { return m.commits[i].SHA })
+			} else if m.cursor >= len(m.commits) && len(m.commits) > 0 {
+				// Reset cursor if it's out of bounds
 				m.cursor = len(m.commits) - 1
 			} else if len(m.commits) == 0 {
 				m.cursor = 0
 			}
+			return m, m.fetchStatuses()
 		}
+		return m, reportLoadError(m, "commits", msg.err)
+
+	case commitStatusesLoadedMsg:
+		m.statuses = msg.statuses
 		return m, nil
 
 	case tea.WindowSizeMsg:
@@ -134,8 +191,27 @@ func (m *CommitView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// handleDateRangeKey forwards input to the date range form and reloads the
+// commits in the submitted range
+func (m *CommitView) handleDateRangeKey(msg tea.KeyMsg) tea.Cmd {
+	changed, err := m.dates.handleKey(msg)
+	if err != nil {
+		m.statusBar.SetMessage(fmt.Sprintf("Invalid date range: %v", err))
+		return nil
+	}
+	if !changed || m.fetchCommitsUseCase == nil {
+		return nil
+	}
+	m.cursor = 0
+	m.loading = true
+	m.err = nil
+	return m.fetchCommits()
+}
+
 // fetchCommits fetches commits from the API
 func (m *CommitView) fetchCommits() tea.Cmd {
+	ctx := m.live.context(m.loads.Restart())
+	dates := m.dates.current
 	return func() tea.Msg {
 		if m.fetchCommitsUseCase == nil {
 			return commitsLoadedMsg{
@@ -144,11 +220,10 @@ func (m *CommitView) fetchCommits() tea.Cmd {
 			}
 		}
 
-		opts := &models.CommitOptions{
-			PerPage: 100,
-		}
-
-		commits, err := m.fetchCommitsUseCase.Execute(context.Background(), m.owner, m.repo, opts)
+		commits, err := fetchPages(func(page, perPage int) ([]*models.Commit, error) {
+			opts := &models.CommitOptions{PerPage: perPage, Page: page, Since: dates.Since, Until: dates.Until}
+			return m.fetchCommitsUseCase.Execute(ctx, m.owner, m.repo, opts)
+		})
 		return commitsLoadedMsg{
 			commits: commits,
 			err:     err,
@@ -156,6 +231,83 @@ func (m *CommitView) fetchCommits() tea.Cmd {
 	}
 }
 
+// fetchStatuses fetches the CI status of the listed commits
+func (m *CommitView) fetchStatuses() tea.Cmd {
+	if m.fetchCommitsUseCase == nil {
+		return nil
+	}
+	return fetchCommitStatuses(m.loads.Context(), m.fetchCommitsUseCase.GetRepository(), m.owner, m.repo, m.commits)
+}
+
+// startBisect opens a bisect between the marked and the selected commit.
+// The list is newest first, so the older of the two is taken as good.
+func (m *CommitView) startBisect() tea.Cmd {
+	if len(m.commits) == 0 || m.cursor >= len(m.commits) {
+		return nil
+	}
+	markIndex := -1
+	for i, commit := range m.commits {
+		if commit.SHA == m.bisectMark {
+			markIndex = i
+			break
+		}
+	}
+	if markIndex < 0 || markIndex == m.cursor {
+		m.statusBar.SetMessage("Mark one end with b, then select the other end and press B")
+		return nil
+	}
+
+	good, bad := m.commits[markIndex], m.commits[m.cursor]
+	if markIndex < m.cursor {
+		good, bad = bad, good
+	}
+
+	var commitRepo repository.CommitRepository
+	if m.fetchCommitsUseCase != nil {
+		commitRepo = m.fetchCommitsUseCase.GetRepository()
+	}
+	m.bisectMark = ""
+	m.bisectView = NewBisectView(commitRepo, m.owner, m.repo, good, bad)
+	m.bisectView.width = m.width
+	m.bisectView.height = m.height
+	m.bisectView.statusBar.SetSize(m.width, 1)
+	m.showingBisect = true
+	return m.bisectView.Init()
+}
+
+// closeDetail closes the detail view, cancelling its fetches
+func (m *CommitView) closeDetail() {
+	if m.detailView != nil {
+		m.detailView.Close()
+	}
+	m.showingDetail = false
+	m.detailView = nil
+}
+
+// refresh reloads the commits unless a load is already running
+func (m *CommitView) refresh() tea.Cmd {
+	if m.loading || m.fetchCommitsUseCase == nil {
+		return nil
+	}
+	m.loading = true
+	m.err = nil
+	return m.fetchCommits()
+}
+
+// autoRefresh reloads the commits quietly, keeping the list on screen and the
+// cursor on its commit. Nothing is reloaded while a detail or bisect view is open.
+func (m *CommitView) autoRefresh() tea.Cmd {
+	if m.loading || m.fetchCommitsUseCase == nil || m.IsShowingDetail() {
+		return nil
+	}
+	var keep any
+	if m.cursor < len(m.commits) {
+		keep = m.commits[m.cursor].SHA
+	}
+	m.live.start(keep)
+	return m.fetchCommits()
+}
+
 // handleKeyPress handles keyboard input
 func (m *CommitView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// Handle Enter key using Type check for reliability
@@ -177,18 +329,22 @@ func (m *CommitView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "ctrl+c", "q":
 		return m, tea.Quit
 
+	case "esc":
+		// Stop loading
+		if m.loading {
+			m.loads.Cancel()
+			m.loading = false
+			m.statusBar.SetMessage(loadCancelledStatus)
+		}
+		return m, nil
+
 	case "?":
 		m.showHelp = !m.showHelp
 		return m, nil
 
 	case "r":
 		// Refresh commits
-		if !m.loading && m.fetchCommitsUseCase != nil {
-			m.loading = true
-			m.err = nil
-			return m, m.fetchCommits()
-		}
-		return m, nil
+		return m, m.refresh()
 
 	case "j", "down":
 		if m.cursor < len(m.commits)-1 {
@@ -218,8 +374,38 @@ func (m *CommitView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// View diff (to be implemented)
 		return m, nil
 
+	case "b":
+		// Mark the selected commit as a bisect endpoint
+		if len(m.commits) > 0 && m.cursor < len(m.commits) {
+			sha := m.commits[m.cursor].SHA
+			if m.bisectMark == sha {
+				m.bisectMark = ""
+				m.statusBar.SetMessage("Bisect mark cleared")
+			} else {
+				m.bisectMark = sha
+				m.statusBar.SetMessage(fmt.Sprintf("Marked %s, select the other end and press B to bisect", shortSHA(sha)))
+			}
+		}
+		return m, nil
+
+	case "B":
+		return m, m.startBisect()
+
+	case "D":
+		// Narrow the list to a date range
+		m.dates.open(m.width, m.height)
+		return m, nil
+
 	case "y":
-		// Copy SHA to clipboard (to be implemented)
+		// Copy SHA to clipboard
+		if len(m.commits) > 0 && m.cursor < len(m.commits) {
+			sha := m.commits[m.cursor].SHA
+			if err := clipboard.Copy(sha); err != nil {
+				m.statusBar.SetMessage(fmt.Sprintf("Copy failed: %v", err))
+			} else {
+				m.statusBar.SetMessage(fmt.Sprintf("Copied %s", shortSHA(sha)))
+			}
+		}
 		return m, nil
 	}
 
@@ -236,6 +422,12 @@ func (m *CommitView) View() string {
 	if m.showingDetail && m.detailView != nil {
 		return m.detailView.View()
 	}
+	if m.showingBisect && m.bisectView != nil {
+		return m.bisectView.View()
+	}
+	if m.dates.isOpen() {
+		return m.dates.form.View()
+	}
 
 	var s strings.Builder
 
@@ -272,12 +464,11 @@ func (m *CommitView) renderHeader() string {
 	title := styles.HeaderStyle.Render("Commits")
 	count := styles.MutedStyle.Render(fmt.Sprintf("(%d)", len(m.commits)))
 
-	return lipgloss.JoinHorizontal(
-		lipgloss.Top,
-		title,
-		" ",
-		count,
-	)
+	parts := []string{title, " ", count}
+	if dates := m.dates.header(); dates != "" {
+		parts = append(parts, " ", dates)
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, parts...)
 }
 
 // renderCommitList renders the list of commits
@@ -290,26 +481,8 @@ func (m *CommitView) renderCommitList() string {
 		availableHeight -= 10 // Reserve space for help
 	}
 
-	// Calculate visible range
-	startIdx := 0
-	endIdx := len(m.commits)
-
-	if len(m.commits) > availableHeight {
-		// Show items around cursor
-		halfHeight := availableHeight / 2
-		startIdx = m.cursor - halfHeight
-		if startIdx < 0 {
-			startIdx = 0
-		}
-		endIdx = startIdx + availableHeight
-		if endIdx > len(m.commits) {
-			endIdx = len(m.commits)
-			startIdx = endIdx - availableHeight
-			if startIdx < 0 {
-				startIdx = 0
-			}
-		}
-	}
+	// Calculate visible range: only the rows on screen are rendered
+	startIdx, endIdx := components.VisibleRange(m.cursor, len(m.commits), availableHeight)
 
 	// Render visible commits
 	for i := startIdx; i < endIdx; i++ {
@@ -327,22 +500,25 @@ func (m *CommitView) renderCommitLine(commit *models.Commit, index int) string {
 	// Cursor indicator
 	cursor := "  "
 	if m.cursor == index {
-		cursor = styles.CursorStyle.Render("▶ ")
+		cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
 	}
 
 	// Commit graph symbol
 	graph := styles.MutedStyle.Render("*")
 
+	// CI status
+	status := renderCommitStatus(m.statuses[commit.SHA])
+
 	// SHA (short version - first 7 characters)
-	sha := commit.SHA
-	if len(sha) > 7 {
-		sha = sha[:7]
-	}
+	sha := shortSHA(commit.SHA)
 	shaStyle := styles.IssueNumberStyle
 	if m.cursor == index {
 		shaStyle = styles.SelectedStyle
 	}
 	shaText := shaStyle.Render(sha)
+	if commit.SHA != "" && commit.SHA == m.bisectMark {
+		shaText += styles.PRPendingStyle.Render(" " + styles.IconFlag)
+	}
 
 	// Message (first line only)
 	message := commit.Message
@@ -376,6 +552,8 @@ func (m *CommitView) renderCommitLine(commit *models.Commit, index int) string {
 		cursor,
 		graph,
 		" ",
+		status,
+		" ",
 		shaText,
 		"  ",
 		messageText,
@@ -388,6 +566,14 @@ func (m *CommitView) renderCommitLine(commit *models.Commit, index int) string {
 	return line
 }
 
+// shortSHA returns the abbreviated form of a commit SHA
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
+
 // renderLoading renders a loading state
 func (m *CommitView) renderLoading() string {
 	return styles.LoadingStyle.Render("Loading commits...")
@@ -411,12 +597,19 @@ Actions:
   enter   View commit details
   d       View diff
   y       Copy SHA to clipboard
+  b       Mark bisect endpoint
+  B       Bisect between mark and selection
+  D       Date range (since/until)
   r       Refresh
 
+CI status:
+  ✓ passed  ✗ failed  ● running
+
 General:
   ?       Toggle help
   q       Quit
   ctrl+c  Force quit
+  ctrl+z  Suspend (resume with fg)
 `
 
 	return styles.BorderStyle.Render(
@@ -441,4 +634,18 @@ func (m *CommitView) updateStatusBar() {
 	if m.owner != "" && m.repo != "" {
 		m.statusBar.AddItem("Repo", fmt.Sprintf("%s/%s", m.owner, m.repo))
 	}
+
+	if updated := m.live.indicator(); updated != "" {
+		m.statusBar.AddItem("", updated)
+	}
+}
+
+// IsCapturingInput returns true while the date range form is open
+func (m *CommitView) IsCapturingInput() bool {
+	return m.dates.isOpen()
+}
+
+// IsShowingDetail returns true while a detail or bisect view is open
+func (m *CommitView) IsShowingDetail() bool {
+	return (m.showingDetail && m.detailView != nil) || (m.showingBisect && m.bisectView != nil)
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
 	"github.com/a1yama/tig-gh/internal/ui/components"
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -15,6 +16,7 @@ import (
 // mockFetchCommitsUseCase is a mock implementation of FetchCommitsUseCase for testing
 type mockFetchCommitsUseCase struct {
 	executeFunc func(ctx context.Context, owner, repo string, opts *models.CommitOptions) ([]*models.Commit, error)
+	repo        repository.CommitRepository
 }
 
 func (m *mockFetchCommitsUseCase) Execute(ctx context.Context, owner, repo string, opts *models.CommitOptions) ([]*models.Commit, error) {
@@ -24,6 +26,10 @@ func (m *mockFetchCommitsUseCase) Execute(ctx context.Context, owner, repo strin
 	return nil, nil
 }
 
+func (m *mockFetchCommitsUseCase) GetRepository() repository.CommitRepository {
+	return m.repo
+}
+
 func TestCommitView_Init(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -0,0 +1,307 @@
+package views
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// crossRef is a reference to an issue or pull request found in a body or comment
+type crossRef struct {
+	Owner  string
+	Repo   string
+	Number int
+}
+
+// inRepo reports whether the reference points into owner/repo
+func (r crossRef) inRepo(owner, repo string) bool {
+	return strings.EqualFold(r.Owner, owner) && strings.EqualFold(r.Repo, repo)
+}
+
+// label renders the reference relative to the repository being viewed
+func (r crossRef) label(owner, repo string) string {
+	if r.inRepo(owner, repo) {
+		return fmt.Sprintf("#%d", r.Number)
+	}
+	return fmt.Sprintf("%s/%s#%d", r.Owner, r.Repo, r.Number)
+}
+
+var (
+	// crossRefURLPattern matches links to issues and pull requests on github.com
+	crossRefURLPattern = regexp.MustCompile(`https?://github\.com/([A-Za-z0-9][A-Za-z0-9-]*)/([A-Za-z0-9._-]+)/(?:issues|pull)/(\d+)`)
+	// crossRefShortPattern matches #123 and owner/repo#123 that are not part of a word, path or URL fragment
+	crossRefShortPattern = regexp.MustCompile(`(^|[^A-Za-z0-9_/#&.-])(?:([A-Za-z0-9][A-Za-z0-9-]*)/([A-Za-z0-9._-]+))?#(\d+)\b`)
+	// inlineCodePattern matches code spans, whose contents are not references
+	inlineCodePattern = regexp.MustCompile("`[^`\n]*`")
+)
+
+// parseCrossRefs returns the references in text in order of appearance, without
+// duplicates. #123 refers to owner/repo; references inside code are ignored.
+func parseCrossRefs(text, owner, repo string) []crossRef {
+	var refs []crossRef
+	seen := make(map[string]bool)
+	add := func(ref crossRef) {
+		key := strings.ToLower(ref.label("", ""))
+		if ref.Number <= 0 || seen[key] {
+			return
+		}
+		seen[key] = true
+		refs = append(refs, ref)
+	}
+
+	for _, line := range stripCode(text) {
+		// Each match is replaced by spaces so the shorthand pattern does not
+		// match the URL again, keeping the positions for ordering
+		type found struct {
+			pos int
+			ref crossRef
+		}
+		var matches []found
+		for _, m := range crossRefURLPattern.FindAllStringSubmatchIndex(line, -1) {
+			number, _ := strconv.Atoi(line[m[6]:m[7]])
+			matches = append(matches, found{m[0], crossRef{Owner: line[m[2]:m[3]], Repo: line[m[4]:m[5]], Number: number}})
+			line = line[:m[0]] + strings.Repeat(" ", m[1]-m[0]) + line[m[1]:]
+		}
+		for _, m := range crossRefShortPattern.FindAllStringSubmatchIndex(line, -1) {
+			ref := crossRef{Owner: owner, Repo: repo}
+			if m[4] >= 0 {
+				ref.Owner, ref.Repo = line[m[4]:m[5]], line[m[6]:m[7]]
+			}
+			ref.Number, _ = strconv.Atoi(line[m[8]:m[9]])
+			matches = append(matches, found{m[3], ref})
+		}
+		// Few matches per line, so an insertion sort keeps this simple
+		for i := 1; i < len(matches); i++ {
+			for j := i; j > 0 && matches[j].pos < matches[j-1].pos; j-- {
+				matches[j], matches[j-1] = matches[j-1], matches[j]
+			}
+		}
+		for _, m := range matches {
+			add(m.ref)
+		}
+	}
+	return refs
+}
+
+// stripCode returns the lines of text outside fenced code blocks, with code spans blanked
+func stripCode(text string) []string {
+	var lines []string
+	fenced := false
+	for _, line := range strings.Split(text, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fenced = !fenced
+			continue
+		}
+		if fenced {
+			continue
+		}
+		lines = append(lines, inlineCodePattern.ReplaceAllStringFunc(line, func(code string) string {
+			return strings.Repeat(" ", len(code))
+		}))
+	}
+	return lines
+}
+
+// crossRefCursor is the reference selected with Tab in a detail view
+type crossRefCursor struct {
+	refs     []crossRef
+	selected int // -1 while no reference is selected
+}
+
+// newCrossRefCursor returns a cursor with nothing selected
+func newCrossRefCursor() crossRefCursor {
+	return crossRefCursor{selected: -1}
+}
+
+// cycle refreshes the references from texts and moves the selection by delta,
+// wrapping around. References to self (the item being viewed) are skipped.
+// It returns false when there are no references.
+func (c *crossRefCursor) cycle(texts []string, owner, repo string, self int, delta int) bool {
+	var refs []crossRef
+	seen := make(map[string]bool)
+	for _, text := range texts {
+		for _, ref := range parseCrossRefs(text, owner, repo) {
+			key := strings.ToLower(ref.label("", ""))
+			if seen[key] || (ref.Number == self && ref.inRepo(owner, repo)) {
+				continue
+			}
+			seen[key] = true
+			refs = append(refs, ref)
+		}
+	}
+
+	c.refs = refs
+	if len(refs) == 0 {
+		c.selected = -1
+		return false
+	}
+	switch {
+	case c.selected < 0 && delta < 0:
+		c.selected = len(refs) - 1
+	case c.selected < 0:
+		c.selected = 0
+	default:
+		c.selected = ((c.selected+delta)%len(refs) + len(refs)) % len(refs)
+	}
+	return true
+}
+
+// current returns the selected reference
+func (c *crossRefCursor) current() (crossRef, bool) {
+	if c.selected < 0 || c.selected >= len(c.refs) {
+		return crossRef{}, false
+	}
+	return c.refs[c.selected], true
+}
+
+// status describes the selected reference
+func (c *crossRefCursor) status(owner, repo string) string {
+	ref, ok := c.current()
+	if !ok {
+		return "No references to issues or pull requests"
+	}
+	return fmt.Sprintf("Ref %d/%d: %s (enter to open)", c.selected+1, len(c.refs), ref.label(owner, repo))
+}
+
+// crossRefLoadedMsg is sent when a referenced issue or pull request has been
+// fetched. The view hosting the detail view opens it in place of the current one.
+type crossRefLoadedMsg struct {
+	ref   crossRef
+	issue *models.Issue
+	pr    *models.PullRequest
+	err   error
+}
+
+// crossRefFailedStatus describes a reference that could not be opened
+func crossRefFailedStatus(msg crossRefLoadedMsg, owner, repo string) string {
+	if isNotFound(msg.err) {
+		return fmt.Sprintf("%s was not found", msg.ref.label(owner, repo))
+	}
+	return fmt.Sprintf("Failed to open %s: %v", msg.ref.label(owner, repo), msg.err)
+}
+
+// staleLoad reports whether a detail view's fetch ended with err because the
+// view was left to open a reference. The result is dropped; the view loads it
+// again when shown.
+func staleLoad(err error) bool {
+	return isCancelled(err)
+}
+
+// openCrossRefDetail shows a referenced issue in place of the current detail
+// view, which esc returns to
+func (m *IssueView) openCrossRefDetail(msg crossRefLoadedMsg) tea.Cmd {
+	current := m.detailView
+	current.Close()
+	m.detailStack = append(m.detailStack, current)
+	m.detailView = NewIssueDetailView(msg.issue, msg.ref.Owner, msg.ref.Repo, current.issueRepo)
+	m.detailView.showRepo = !msg.ref.inRepo(m.owner, m.repo)
+	m.detailView.width = m.width
+	m.detailView.height = m.height
+	return m.detailView.Init()
+}
+
+// backFromDetail returns to the detail view a reference was opened from, or to the list
+func (m *IssueView) backFromDetail() tea.Cmd {
+	n := len(m.detailStack)
+	if n == 0 {
+		m.closeDetail()
+		return nil
+	}
+	m.detailView.Close()
+	m.detailView = m.detailStack[n-1]
+	m.detailStack = m.detailStack[:n-1]
+	m.detailView.width = m.width
+	m.detailView.height = m.height
+	// Its fetches were cancelled when it was left
+	return m.detailView.Init()
+}
+
+// openCrossRefDetail shows a referenced pull request in place of the current
+// detail view, which esc returns to. Referenced issues are shown above it.
+func (m *PRView) openCrossRefDetail(msg crossRefLoadedMsg) tea.Cmd {
+	current := m.detailView
+	current.Close()
+	if msg.issue != nil {
+		return m.pushIssueDetail(msg)
+	}
+	m.detailStack = append(m.detailStack, current)
+	m.detailView = NewPRDetailView(msg.pr, msg.ref.Owner, msg.ref.Repo, current.prRepo)
+	m.detailView.SetIssueRepository(m.issueRepo)
+	m.detailView.SetCommitRepository(m.commitRepo)
+	m.detailView.showRepo = !msg.ref.inRepo(m.owner, m.repo)
+	m.detailView.SetProtectedPaths(m.protectedPaths)
+	m.detailView.SetFreezeWindows(m.freezeWindows)
+	m.detailView.width = m.width
+	m.detailView.height = m.height
+	return m.detailView.Init()
+}
+
+// backFromDetail returns to the detail view a reference was opened from, or to the list
+func (m *PRView) backFromDetail() tea.Cmd {
+	n := len(m.detailStack)
+	if n == 0 {
+		m.closeDetail()
+		return nil
+	}
+	m.detailView.Close()
+	m.detailView = m.detailStack[n-1]
+	m.detailStack = m.detailStack[:n-1]
+	m.detailView.width = m.width
+	m.detailView.height = m.height
+	// Its fetches were cancelled when it was left
+	return m.detailView.Init()
+}
+
+// pushIssueDetail shows a referenced issue above the PR detail view (or the
+// issue it was referenced from)
+func (m *PRView) pushIssueDetail(msg crossRefLoadedMsg) tea.Cmd {
+	view := NewIssueDetailView(msg.issue, msg.ref.Owner, msg.ref.Repo, m.issueRepo)
+	view.showRepo = !msg.ref.inRepo(m.owner, m.repo)
+	view.width = m.width
+	view.height = m.height
+	m.issueDetails = append(m.issueDetails, view)
+	return view.Init()
+}
+
+// updateIssueDetail delegates a message to the issue shown above the PR detail view
+func (m *PRView) updateIssueDetail(msg tea.Msg) tea.Cmd {
+	n := len(m.issueDetails)
+	top := m.issueDetails[n-1]
+	if _, isBackMsg := msg.(backMsg); isBackMsg {
+		return m.backFromIssueDetail()
+	}
+	if ref, ok := msg.(crossRefLoadedMsg); ok && ref.err == nil {
+		top.Close()
+		return m.pushIssueDetail(ref)
+	}
+
+	// Keys answering the reaction picker must not close the issue
+	capturing := top.IsCapturingInput()
+	_, cmd := top.Update(msg)
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && !capturing {
+		if keyStr := keyMsg.String(); keyStr == "q" || keyStr == "esc" {
+			return m.backFromIssueDetail()
+		}
+	}
+	return cmd
+}
+
+// backFromIssueDetail closes the issue shown above the PR detail view and
+// returns to what it was opened from
+func (m *PRView) backFromIssueDetail() tea.Cmd {
+	n := len(m.issueDetails)
+	m.issueDetails[n-1].Close()
+	m.issueDetails = m.issueDetails[:n-1]
+	// The view returned to had its fetches cancelled when it was left
+	if n > 1 {
+		return m.issueDetails[n-2].Init()
+	}
+	m.detailView.width = m.width
+	m.detailView.height = m.height
+	return m.detailView.Init()
+}
@@ -0,0 +1,133 @@
+package views
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestParseCrossRefs(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []crossRef
+	}{
+		{
+			name: "short and qualified references in order",
+			text: "Fixes #12, see other/lib#3 and #12 again",
+			want: []crossRef{{"owner", "repo", 12}, {"other", "lib", 3}},
+		},
+		{
+			name: "issue and pull request URLs",
+			text: "Follow-up to https://github.com/other/lib/pull/7 (#8)\nand https://github.com/owner/repo/issues/9#issuecomment-1",
+			want: []crossRef{{"other", "lib", 7}, {"owner", "repo", 8}, {"owner", "repo", 9}},
+		},
+		{
+			name: "code, anchors and words are not references",
+			text: "Run `make #1` or see [docs](https://example.com/page#2) and issue#3\n```\n#4\n```\n&#5; C#6",
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseCrossRefs(tt.text, "owner", "repo")
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseCrossRefs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCrossRefCursor_CycleWrapsAndSkipsSelf(t *testing.T) {
+	cursor := newCrossRefCursor()
+	texts := []string{"Duplicate of #1, see #2", "and other/lib#3"}
+
+	var labels []string
+	for i := 0; i < 3; i++ {
+		cursor.cycle(texts, "owner", "repo", 1, 1)
+		ref, _ := cursor.current()
+		labels = append(labels, ref.label("owner", "repo"))
+	}
+	if want := []string{"#2", "other/lib#3", "#2"}; !reflect.DeepEqual(labels, want) {
+		t.Errorf("tab order = %v, want %v", labels, want)
+	}
+
+	cursor.cycle(texts, "owner", "repo", 1, -1)
+	if ref, _ := cursor.current(); ref.Number != 3 {
+		t.Errorf("shift+tab should go back to other/lib#3, got %v", ref)
+	}
+
+	if cursor.cycle([]string{"nothing here"}, "owner", "repo", 1, 1) {
+		t.Error("expected no references")
+	}
+	if _, ok := cursor.current(); ok {
+		t.Error("nothing should stay selected without references")
+	}
+}
+
+type crossRefIssueRepo struct {
+	repository.IssueRepository
+	issues map[string]*models.Issue
+}
+
+func (r *crossRefIssueRepo) Get(ctx context.Context, owner, repo string, number int) (*models.Issue, error) {
+	issue, ok := r.issues[crossRef{owner, repo, number}.label("", "")]
+	if !ok {
+		return nil, &models.APIError{Kind: models.APIErrorNotFound}
+	}
+	return issue, nil
+}
+
+func (r *crossRefIssueRepo) ListComments(ctx context.Context, owner, repo string, number int, opts *models.CommentOptions) ([]*models.Comment, error) {
+	return nil, nil
+}
+
+func TestIssueView_FollowsReferencesInPlace(t *testing.T) {
+	repo := &crossRefIssueRepo{issues: map[string]*models.Issue{
+		"other/lib#3": {Number: 3, Title: "Upstream bug", HTMLURL: "https://github.com/other/lib/pull/3"},
+	}}
+	view := NewIssueViewWithUseCase(&mockFetchIssuesUseCase{getRepositoryFunc: func() repository.IssueRepository { return repo }}, "owner", "repo")
+	view.loading = false
+	view.width, view.height = 100, 40
+	view.issues = []*models.Issue{{Number: 1, Title: "Our bug", Body: "Caused by other/lib#3, see #99"}}
+
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if msg := view.detailView.statusMessage; !strings.Contains(msg, "Ref 1/2: other/lib#3") {
+		t.Fatalf("status = %q", msg)
+	}
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected the reference to be fetched")
+	}
+	view.Update(cmd())
+	if view.detailView.issue.Number != 3 || view.detailView.owner != "other" {
+		t.Fatalf("expected other/lib#3 to be shown, got %s/%s#%d", view.detailView.owner, view.detailView.repo, view.detailView.issue.Number)
+	}
+	if header := view.detailView.renderHeader(); !strings.Contains(header, "other/lib Pull request #3") {
+		t.Errorf("header = %q", header)
+	}
+
+	// esc goes back to the issue the reference was opened from, then to the list
+	view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if !view.showingDetail || view.detailView.issue.Number != 1 {
+		t.Fatal("esc should return to the referencing issue")
+	}
+	view.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view.Update(cmd())
+	if view.detailView.issue.Number != 1 || !strings.Contains(view.detailView.statusMessage, "#99 was not found") {
+		t.Errorf("a missing reference should be reported in place, status %q", view.detailView.statusMessage)
+	}
+	view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if view.showingDetail {
+		t.Error("esc should close the detail view")
+	}
+}
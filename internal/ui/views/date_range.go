@@ -0,0 +1,63 @@
+package views
+
+import (
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/infra/clock"
+	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Indexes of the fields in the date range form
+const (
+	dateRangeFieldSince = iota
+	dateRangeFieldUntil
+)
+
+// dateRangeFilter is the since/until range a list is narrowed to, picked
+// with D in the commit and PR lists. The zero value is an open range.
+type dateRangeFilter struct {
+	current models.DateRange
+	form    *components.FormModal
+}
+
+// open shows the form with the current range filled in
+func (f *dateRangeFilter) open(width, height int) {
+	if f.form == nil {
+		f.form = components.NewFormModal()
+	}
+	f.form.SetSize(width, height)
+	f.form.Show("Date range (empty to clear)", []components.FormField{
+		{Label: "Since", Placeholder: "2026-10-01, 2026-10-01 14:00 or 3d", Value: f.current.SinceText()},
+		{Label: "Until", Placeholder: "2026-10-03 (includes the whole day)", Value: f.current.UntilText()},
+	})
+}
+
+// isOpen reports whether the form is shown
+func (f *dateRangeFilter) isOpen() bool {
+	return f.form != nil && f.form.IsVisible()
+}
+
+// handleKey forwards input to the form. Once it is submitted it reports
+// whether the range changed, or why the input was not taken.
+func (f *dateRangeFilter) handleKey(msg tea.KeyMsg) (changed bool, err error) {
+	f.form.Update(msg)
+	if !f.form.Submitted() {
+		return false, nil
+	}
+	parsed, err := models.ParseDateRange(f.form.Value(dateRangeFieldSince), f.form.Value(dateRangeFieldUntil), clock.Now())
+	if err != nil {
+		return false, err
+	}
+	changed = parsed.String() != f.current.String()
+	f.current = parsed
+	return changed, nil
+}
+
+// header renders the active range for the list header ("" when open)
+func (f *dateRangeFilter) header() string {
+	if f.current.IsZero() {
+		return ""
+	}
+	return styles.LabelStyle.Render("[" + f.current.String() + "]")
+}
@@ -0,0 +1,87 @@
+package views
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// typeInto types s into the focused field of a form
+func typeInto(view tea.Model, s string) {
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)})
+}
+
+func TestPRView_DateRange(t *testing.T) {
+	var requested *models.PROptions
+	view := NewPRViewWithUseCase(&mockFetchPRsUseCase{
+		executeFunc: func(ctx context.Context, owner, repo string, opts *models.PROptions) ([]*models.PullRequest, error) {
+			requested = opts
+			return []*models.PullRequest{{Number: 1, Title: "Add cache", State: models.PRStateOpen}}, nil
+		},
+	}, "owner", "repo")
+	view.loading = false
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'D'}})
+	if !view.IsCapturingInput() {
+		t.Fatal("expected D to open the date range form")
+	}
+	typeInto(view, "2026-10-01")
+	view.Update(tea.KeyMsg{Type: tea.KeyTab})
+	typeInto(view, "2026-10-03")
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected a new range to refetch")
+	}
+	view.Update(cmd())
+
+	if requested == nil || requested.Since == nil || requested.Until == nil {
+		t.Fatalf("expected the fetch to carry the range, got %+v", requested)
+	}
+	if want := time.Date(2026, 10, 4, 0, 0, 0, 0, time.Local); !requested.Until.Equal(want) {
+		t.Errorf("expected the whole last day to be included, got %v", requested.Until)
+	}
+	if out := view.View(); !strings.Contains(out, "[2026-10-01 to 2026-10-03]") {
+		t.Errorf("expected the header to show the range, got:\n%s", out)
+	}
+}
+
+func TestCommitView_DateRange(t *testing.T) {
+	var requested *models.CommitOptions
+	view := NewCommitViewWithUseCase(&mockFetchCommitsUseCase{
+		executeFunc: func(ctx context.Context, owner, repo string, opts *models.CommitOptions) ([]*models.Commit, error) {
+			requested = opts
+			return []*models.Commit{}, nil
+		},
+	}, "owner", "repo")
+	view.loading = false
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+
+	// An invalid range is reported and fetches nothing
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'D'}})
+	typeInto(view, "yesterday")
+	if _, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil {
+		t.Fatal("expected an invalid range not to refetch")
+	}
+	if status := view.statusBar.View(); !strings.Contains(status, "Invalid date range") {
+		t.Errorf("expected the error to be shown, got %q", status)
+	}
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'D'}})
+	typeInto(view, "3d")
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected a new range to refetch")
+	}
+	view.Update(cmd())
+	if requested == nil || requested.Since == nil || requested.Until != nil {
+		t.Fatalf("expected only the start of the range, got %+v", requested)
+	}
+	if out := view.View(); !strings.Contains(out, "[since ") {
+		t.Errorf("expected the header to show the range, got:\n%s", out)
+	}
+}
@@ -0,0 +1,291 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	// foldKeepLines is the number of context lines kept around a folded run
+	foldKeepLines = 3
+	// foldMinHidden is the smallest run worth folding away
+	foldMinHidden = 4
+)
+
+// FileContentFetcher fetches a file at a git ref, used to expand diff context
+type FileContentFetcher interface {
+	GetFileContent(ctx context.Context, owner, repo, path, ref string) (string, error)
+}
+
+// fileContentLoadedMsg is sent when a file has been fetched for context expansion
+type fileContentLoadedMsg struct {
+	path  string
+	gap   int
+	lines []string
+	err   error
+}
+
+// diffRowKind is the kind of a rendered diff row
+type diffRowKind int
+
+const (
+	diffRowLine diffRowKind = iota
+	diffRowGap
+	diffRowFold
+)
+
+// diffRow is a row of the rendered diff: a diff line, an unchanged region
+// omitted by the diff (gap) or a folded run of context lines
+type diffRow struct {
+	kind   diffRowKind
+	line   DiffLine
+	gap    int
+	hidden int
+}
+
+// diffGap is an unchanged region between hunks that the diff leaves out.
+// end is -1 when the length of the file is unknown.
+type diffGap struct {
+	start int
+	end   int
+	delta int
+}
+
+// diffGaps returns the regions before, between and after the hunks of a file
+func diffGaps(file DiffFile, content []string) []diffGap {
+	gaps := make([]diffGap, 0, len(file.Hunks)+1)
+
+	nextOld, nextNew := 1, 1
+	for i, hunk := range file.Hunks {
+		gaps = append(gaps, diffGap{
+			start: nextNew,
+			end:   hunk.NewStart - 1,
+			delta: hunk.OldStart - hunk.NewStart,
+		})
+
+		end := len(file.Lines)
+		if i+1 < len(file.Hunks) {
+			end = file.Hunks[i+1].Index
+		}
+		nextOld, nextNew = hunk.OldStart, hunk.NewStart
+		for _, line := range file.Lines[hunk.Index:end] {
+			if line.Type != DiffLineAdded {
+				nextOld++
+			}
+			if line.Type != DiffLineDeleted {
+				nextNew++
+			}
+		}
+	}
+
+	trailing := diffGap{start: nextNew, end: -1, delta: nextOld - nextNew}
+	if content != nil {
+		trailing.end = len(content)
+	}
+	return append(gaps, trailing)
+}
+
+// buildDiffRows lays out a file as rows, inserting expanded context or gap
+// markers between hunks and folding long context runs unless showAll is set.
+// Files without hunk information are shown as is.
+func buildDiffRows(file DiffFile, content []string, expanded map[int]bool, showAll bool) []diffRow {
+	if len(file.Hunks) == 0 {
+		rows := make([]diffRow, 0, len(file.Lines))
+		for _, line := range file.Lines {
+			rows = append(rows, diffRow{kind: diffRowLine, line: line})
+		}
+		return rows
+	}
+
+	// Deleted files no longer exist at the head ref, so there is nothing to expand
+	canExpand := file.Status != DiffFileDeleted
+
+	var rows []diffRow
+	gaps := diffGaps(file, content)
+	for i, gap := range gaps {
+		if canExpand {
+			rows = append(rows, gapRows(i, gap, content, expanded[i])...)
+		}
+		if i == len(file.Hunks) {
+			break
+		}
+
+		end := len(file.Lines)
+		if i+1 < len(file.Hunks) {
+			end = file.Hunks[i+1].Index
+		}
+		hunkRows := make([]diffRow, 0, end-file.Hunks[i].Index)
+		for _, line := range file.Lines[file.Hunks[i].Index:end] {
+			hunkRows = append(hunkRows, diffRow{kind: diffRowLine, line: line})
+		}
+		if !showAll {
+			hunkRows = foldContext(hunkRows)
+		}
+		rows = append(rows, hunkRows...)
+	}
+
+	return rows
+}
+
+// gapRows returns the expanded context lines of a gap, or a single marker row.
+// The region after the last hunk is only known once the file has been fetched.
+func gapRows(index int, gap diffGap, content []string, expanded bool) []diffRow {
+	if gap.end < gap.start {
+		return nil
+	}
+
+	if !expanded || content == nil {
+		return []diffRow{{kind: diffRowGap, gap: index, hidden: gap.end - gap.start + 1}}
+	}
+
+	end := gap.end
+	if end > len(content) {
+		end = len(content)
+	}
+	rows := make([]diffRow, 0, end-gap.start+1)
+	for n := gap.start; n <= end; n++ {
+		rows = append(rows, diffRow{
+			kind: diffRowLine,
+			line: DiffLine{
+				Type:       DiffLineContext,
+				Content:    content[n-1],
+				OldLineNum: n + gap.delta,
+				NewLineNum: n,
+			},
+		})
+	}
+	return rows
+}
+
+// foldContext replaces the middle of long unchanged runs with a fold marker
+func foldContext(rows []diffRow) []diffRow {
+	folded := make([]diffRow, 0, len(rows))
+	for i := 0; i < len(rows); {
+		j := i
+		for j < len(rows) && rows[j].line.Type == DiffLineContext {
+			j++
+		}
+		if j == i {
+			folded = append(folded, rows[i])
+			i++
+			continue
+		}
+
+		// Keep a few lines next to changes; runs at the hunk edges only need one side
+		keepBefore, keepAfter := foldKeepLines, foldKeepLines
+		if i == 0 {
+			keepBefore = 0
+		}
+		if j == len(rows) {
+			keepAfter = 0
+		}
+		if j-i-keepBefore-keepAfter >= foldMinHidden {
+			folded = append(folded, rows[i:i+keepBefore]...)
+			folded = append(folded, diffRow{kind: diffRowFold, hidden: j - i - keepBefore - keepAfter})
+			folded = append(folded, rows[j-keepAfter:j]...)
+		} else {
+			folded = append(folded, rows[i:j]...)
+		}
+		i = j
+	}
+	return folded
+}
+
+// splitFileContent splits file content into lines without a trailing empty line
+func splitFileContent(content string) []string {
+	lines := strings.Split(content, "\n")
+	if len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// fetchFileContent fetches the current file at the head ref to expand a gap
+func (m *DiffView) fetchFileContent(path string, gap int) tea.Cmd {
+	ctx := m.loads.Context()
+	return func() tea.Msg {
+		content, err := m.contentFetcher.GetFileContent(ctx, m.owner, m.repo, path, m.headRef)
+		if err != nil {
+			return fileContentLoadedMsg{path: path, gap: gap, err: err}
+		}
+		return fileContentLoadedMsg{path: path, gap: gap, lines: splitFileContent(content)}
+	}
+}
+
+// expandContext expands the first gap visible in the viewport
+func (m *DiffView) expandContext() tea.Cmd {
+	if m.currentFile >= len(m.files) {
+		return nil
+	}
+
+	file := m.files[m.currentFile]
+	rows := m.currentRows()
+	gap := -1
+	for i := m.scroll; i < len(rows) && i < m.scroll+m.diffHeight(); i++ {
+		if rows[i].kind == diffRowGap {
+			gap = rows[i].gap
+			break
+		}
+	}
+	if gap < 0 {
+		m.statusMessage = "No collapsed context in view"
+		return nil
+	}
+
+	if _, ok := m.contents[file.NewPath]; ok {
+		m.markExpanded(file.NewPath, gap)
+		return nil
+	}
+	if m.contentFetcher == nil || m.headRef == "" {
+		m.statusMessage = "Context expansion not available"
+		return nil
+	}
+
+	m.statusMessage = "Loading context..."
+	return m.fetchFileContent(file.NewPath, gap)
+}
+
+// markExpanded records that a gap of a file should show its lines
+func (m *DiffView) markExpanded(path string, gap int) {
+	if m.expanded == nil {
+		m.expanded = make(map[string]map[int]bool)
+	}
+	if m.expanded[path] == nil {
+		m.expanded[path] = make(map[int]bool)
+	}
+	m.expanded[path][gap] = true
+}
+
+// currentRows returns the rows of the current file
+func (m *DiffView) currentRows() []diffRow {
+	if m.currentFile >= len(m.files) {
+		return nil
+	}
+	file := m.files[m.currentFile]
+	if m.showingStructuralDiff(file) {
+		lines := m.structuralDiffs[file.NewPath]
+		rows := make([]diffRow, 0, len(lines))
+		for _, line := range lines {
+			rows = append(rows, diffRow{kind: diffRowLine, line: line})
+		}
+		return rows
+	}
+	return buildDiffRows(file, m.contents[file.NewPath], m.expanded[file.NewPath], m.showAllContext)
+}
+
+// diffHeight returns the number of diff rows that fit on screen
+func (m *DiffView) diffHeight() int {
+	return m.height - 5
+}
+
+// renderDiffMarker renders a gap or fold row
+func renderDiffMarker(row diffRow) string {
+	if row.kind == diffRowFold {
+		return styles.MutedStyle.Render(fmt.Sprintf("   %s %d unchanged lines (z: show all)", styles.IconWaiting, row.hidden))
+	}
+	return styles.MutedStyle.Render(fmt.Sprintf("   %s %d unchanged lines (e: expand)", styles.IconWaiting, row.hidden))
+}
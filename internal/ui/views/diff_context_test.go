@@ -0,0 +1,147 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/ui/components"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// twoHunkDiff changes line 3 and line 20 of a 25 line file
+const twoHunkDiff = `diff --git a/main.go b/main.go
+index 1111111..2222222 100644
+--- a/main.go
++++ b/main.go
+@@ -1,4 +1,4 @@
+ line 1
+ line 2
+-old 3
++line 3
+ line 4
+@@ -18,5 +18,5 @@
+ line 18
+ line 19
+-old 20
++line 20
+ line 21
+ line 22`
+
+type stubContentFetcher struct {
+	content string
+	calls   int
+}
+
+func (f *stubContentFetcher) GetFileContent(ctx context.Context, owner, repo, path, ref string) (string, error) {
+	f.calls++
+	return f.content, nil
+}
+
+func numberedLines(n int) string {
+	var s strings.Builder
+	for i := 1; i <= n; i++ {
+		fmt.Fprintf(&s, "line %d\n", i)
+	}
+	return s.String()
+}
+
+func TestBuildDiffRows_Gaps(t *testing.T) {
+	file := parseDiff(twoHunkDiff)[0]
+
+	rows := buildDiffRows(file, nil, nil, false)
+	var gaps []int
+	for _, row := range rows {
+		if row.kind == diffRowGap {
+			gaps = append(gaps, row.hidden)
+		}
+	}
+	if len(gaps) != 1 || gaps[0] != 13 {
+		t.Fatalf("expected one 13 line gap between hunks, got %v", gaps)
+	}
+
+	content := splitFileContent(numberedLines(25))
+	rows = buildDiffRows(file, content, map[int]bool{1: true}, false)
+	var expanded []DiffLine
+	for _, row := range rows {
+		if row.kind == diffRowLine && row.line.NewLineNum >= 5 && row.line.NewLineNum <= 17 {
+			expanded = append(expanded, row.line)
+		}
+	}
+	if len(expanded) != 13 || expanded[0].Content != "line 5" || expanded[0].OldLineNum != 5 {
+		t.Errorf("unexpected expanded context %+v", expanded)
+	}
+	last := rows[len(rows)-1]
+	if last.kind != diffRowGap || last.hidden != 3 {
+		t.Errorf("expected the 3 trailing lines to be collapsed once the file is known, got %+v", last)
+	}
+}
+
+func TestFoldContext(t *testing.T) {
+	var rows []diffRow
+	rows = append(rows, diffRow{line: DiffLine{Type: DiffLineAdded}})
+	for i := 0; i < 12; i++ {
+		rows = append(rows, diffRow{line: DiffLine{Type: DiffLineContext}})
+	}
+	rows = append(rows, diffRow{line: DiffLine{Type: DiffLineDeleted}})
+
+	folded := foldContext(rows)
+	if len(folded) != 1+3+1+3+1 {
+		t.Fatalf("expected run to fold to 3+marker+3 lines, got %d rows", len(folded))
+	}
+	if folded[4].kind != diffRowFold || folded[4].hidden != 6 {
+		t.Errorf("unexpected fold marker %+v", folded[4])
+	}
+
+	short := rows[:6]
+	if got := foldContext(short); len(got) != len(short) {
+		t.Errorf("expected short runs to stay visible, got %d rows", len(got))
+	}
+}
+
+func TestDiffView_ExpandContext(t *testing.T) {
+	fetcher := &stubContentFetcher{content: numberedLines(25)}
+	view := NewDiffView()
+	view.SetContentFetcher(fetcher, "base", "abc123")
+	view.statusBar = components.NewStatusBar()
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	view.Update(diffLoadedMsg{files: parseDiff(twoHunkDiff)})
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	if cmd == nil {
+		t.Fatal("expected file content to be fetched")
+	}
+	view.Update(cmd())
+
+	output := view.View()
+	if !strings.Contains(output, "line 10") {
+		t.Error("expected expanded context to be rendered")
+	}
+	if strings.Contains(output, "13 unchanged lines") {
+		t.Error("expected the gap marker to be replaced")
+	}
+
+	// The file is cached, so expanding the trailing gap does not refetch
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	if fetcher.calls != 1 {
+		t.Errorf("expected a single fetch, got %d", fetcher.calls)
+	}
+	if !strings.Contains(view.View(), "line 25") {
+		t.Error("expected trailing context to be expanded")
+	}
+}
+
+func TestDiffView_ExpandContextUnavailable(t *testing.T) {
+	view := NewDiffView()
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	view.Update(diffLoadedMsg{files: parseDiff(twoHunkDiff)})
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	if cmd != nil {
+		t.Fatal("expected no fetch without a content fetcher")
+	}
+	if view.statusMessage != "Context expansion not available" {
+		t.Errorf("unexpected status %q", view.statusMessage)
+	}
+}
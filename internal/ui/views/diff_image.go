@@ -0,0 +1,196 @@
+package views
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	_ "image/gif"  // register GIF for image.DecodeConfig
+	_ "image/jpeg" // register JPEG for image.DecodeConfig
+	_ "image/png"  // register PNG for image.DecodeConfig
+	"path"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// imageExtensions lists the file types shown with image metadata instead of a line diff
+var imageExtensions = map[string]bool{
+	".png":  true,
+	".jpg":  true,
+	".jpeg": true,
+	".gif":  true,
+	".webp": true,
+	".bmp":  true,
+	".ico":  true,
+	".svg":  true,
+}
+
+// imageInfo is the metadata of one version of an image
+type imageInfo struct {
+	Size   int
+	Width  int
+	Height int
+}
+
+// imageComparison holds the old and new versions of a changed image.
+// Old is nil for added images and New is nil for deleted ones.
+type imageComparison struct {
+	Old *imageInfo
+	New *imageInfo
+	Err error
+}
+
+// imageInfoLoadedMsg is sent when the versions of an image have been fetched
+type imageInfoLoadedMsg struct {
+	path       string
+	comparison *imageComparison
+}
+
+// showsImageInfo reports whether the file is an image without a text diff
+// (SVG changes still come with one and are shown as lines)
+func showsImageInfo(file DiffFile) bool {
+	return len(file.Lines) == 0 && imageExtensions[strings.ToLower(path.Ext(file.NewPath))]
+}
+
+// decodeImageInfo reads the size and, for formats Go can decode, the dimensions of an image
+func decodeImageInfo(data []byte) *imageInfo {
+	info := &imageInfo{Size: len(data)}
+	if config, _, err := image.DecodeConfig(bytes.NewReader(data)); err == nil {
+		info.Width = config.Width
+		info.Height = config.Height
+	}
+	return info
+}
+
+// formatByteSize formats a byte count as B, KB or MB
+func formatByteSize(size int) string {
+	switch {
+	case size >= 1024*1024:
+		return fmt.Sprintf("%.1f MB", float64(size)/(1024*1024))
+	case size >= 1024:
+		return fmt.Sprintf("%.1f KB", float64(size)/1024)
+	default:
+		return fmt.Sprintf("%d B", size)
+	}
+}
+
+// formatImageDimensions formats width×height, or "?" when unknown
+func formatImageDimensions(info *imageInfo) string {
+	if info.Width == 0 || info.Height == 0 {
+		return "?"
+	}
+	return fmt.Sprintf("%d×%d", info.Width, info.Height)
+}
+
+// renderImageComparison renders the size and dimension changes of an image
+func renderImageComparison(comparison *imageComparison) []string {
+	if comparison.Err != nil {
+		return []string{styles.ErrorStyle.Render(fmt.Sprintf("Failed to load image: %v", comparison.Err))}
+	}
+
+	side := func(info *imageInfo, f func(*imageInfo) string) string {
+		if info == nil {
+			return "—"
+		}
+		return f(info)
+	}
+	size := func(info *imageInfo) string { return formatByteSize(info.Size) }
+
+	sizeLine := fmt.Sprintf("Size:       %s → %s", side(comparison.Old, size), side(comparison.New, size))
+	if comparison.Old != nil && comparison.New != nil {
+		delta := comparison.New.Size - comparison.Old.Size
+		sign := "+"
+		if delta < 0 {
+			sign = "-"
+			delta = -delta
+		}
+		sizeLine += fmt.Sprintf(" (%s%s)", sign, formatByteSize(delta))
+	}
+
+	return []string{
+		sizeLine,
+		fmt.Sprintf("Dimensions: %s → %s",
+			side(comparison.Old, formatImageDimensions),
+			side(comparison.New, formatImageDimensions)),
+	}
+}
+
+// ensureImageInfo starts fetching both versions of the current file when it is an image
+func (m *DiffView) ensureImageInfo() tea.Cmd {
+	if m.currentFile >= len(m.files) || m.contentFetcher == nil {
+		return nil
+	}
+	file := m.files[m.currentFile]
+	if !showsImageInfo(file) {
+		return nil
+	}
+	if _, ok := m.images[file.NewPath]; ok {
+		return nil
+	}
+
+	if m.images == nil {
+		m.images = make(map[string]*imageComparison)
+	}
+	// A nil entry marks the fetch as in flight
+	m.images[file.NewPath] = nil
+	return m.fetchImageInfo(file)
+}
+
+// fetchImageInfo fetches the old and new versions of an image
+func (m *DiffView) fetchImageInfo(file DiffFile) tea.Cmd {
+	ctx := m.loads.Context()
+	return func() tea.Msg {
+		comparison := &imageComparison{}
+
+		if file.Status != DiffFileAdded {
+			content, err := m.contentFetcher.GetFileContent(ctx, m.owner, m.repo, file.OldPath, m.baseRef)
+			if err != nil {
+				comparison.Err = err
+				return imageInfoLoadedMsg{path: file.NewPath, comparison: comparison}
+			}
+			comparison.Old = decodeImageInfo([]byte(content))
+		}
+		if file.Status != DiffFileDeleted {
+			content, err := m.contentFetcher.GetFileContent(ctx, m.owner, m.repo, file.NewPath, m.headRef)
+			if err != nil {
+				comparison.Err = err
+				return imageInfoLoadedMsg{path: file.NewPath, comparison: comparison}
+			}
+			comparison.New = decodeImageInfo([]byte(content))
+		}
+
+		return imageInfoLoadedMsg{path: file.NewPath, comparison: comparison}
+	}
+}
+
+// renderImageInfo renders the metadata block shown in place of an image's diff
+func (m *DiffView) renderImageInfo(file DiffFile) string {
+	var lines []string
+
+	comparison, fetched := m.images[file.NewPath]
+	switch {
+	case m.contentFetcher == nil:
+		lines = append(lines, styles.MutedStyle.Render(emptyDiffReason(file)))
+	case !fetched || comparison == nil:
+		lines = append(lines, styles.MutedStyle.Render("Loading image details..."))
+	default:
+		lines = append(lines, renderImageComparison(comparison)...)
+	}
+
+	lines = append(lines, styles.MutedStyle.Render("o: open in browser to compare"))
+	return strings.Join(lines, "\n")
+}
+
+// fileURL returns the URL of the file in the PR's "Files changed" tab
+func (m *DiffView) fileURL(file DiffFile) string {
+	url := m.prURL
+	if url == "" {
+		url = fmt.Sprintf("https://github.com/%s/%s/pull/%d", m.owner, m.repo, m.prNumber)
+	}
+	// GitHub anchors each file by the SHA-256 of its path
+	sum := sha256.Sum256([]byte(file.NewPath))
+	return url + "/files#diff-" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,91 @@
+package views
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func encodePNG(t *testing.T, width, height int) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+const imageDiff = `diff --git a/assets/logo.png b/assets/logo.png
+index 1111111..2222222 100644
+Binary files a/assets/logo.png and b/assets/logo.png differ`
+
+func TestDiffView_ImageMetadata(t *testing.T) {
+	view := NewDiffView()
+	view.owner, view.repo, view.prNumber = "owner", "repo", 12
+	view.SetContentFetcher(refContentFetcher{
+		"base:assets/logo.png": encodePNG(t, 16, 16),
+		"head:assets/logo.png": encodePNG(t, 64, 32),
+	}, "base", "head")
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+
+	_, cmd := view.Update(diffLoadedMsg{files: parseDiff(imageDiff)})
+	if cmd == nil {
+		t.Fatal("expected image versions to be fetched")
+	}
+	if !strings.Contains(view.View(), "Loading image details") {
+		t.Error("expected loading state while fetching")
+	}
+	view.Update(cmd())
+
+	output := view.View()
+	for _, want := range []string{"Dimensions: 16×16 → 64×32", "Size:", "o: open in browser"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestDiffView_OpenFileInBrowser(t *testing.T) {
+	var opened string
+	original := openBrowser
+	openBrowser = func(url string) error {
+		opened = url
+		return nil
+	}
+	defer func() { openBrowser = original }()
+
+	view := NewDiffView()
+	view.SetPRURL("https://ghe.example.com/owner/repo/pull/12")
+	view.Update(diffLoadedMsg{files: parseDiff(imageDiff)})
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+	if cmd == nil {
+		t.Fatal("expected browser command")
+	}
+	view.Update(cmd())
+
+	want := "https://ghe.example.com/owner/repo/pull/12/files#diff-"
+	if !strings.HasPrefix(opened, want) || len(opened) != len(want)+64 {
+		t.Errorf("unexpected URL %q", opened)
+	}
+	if view.statusMessage != "Opened in browser" {
+		t.Errorf("unexpected status %q", view.statusMessage)
+	}
+}
+
+func TestFormatByteSize(t *testing.T) {
+	tests := map[int]string{
+		512:             "512 B",
+		2048:            "2.0 KB",
+		3 * 1024 * 1024: "3.0 MB",
+	}
+	for size, want := range tests {
+		if got := formatByteSize(size); got != want {
+			t.Errorf("formatByteSize(%d) = %q, want %q", size, got, want)
+		}
+	}
+}
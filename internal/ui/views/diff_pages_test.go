@@ -0,0 +1,132 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// pagedDiffUseCase serves changed files page by page like the files API
+type pagedDiffUseCase struct {
+	files []*models.DiffFile
+	pages []int
+}
+
+func (u *pagedDiffUseCase) Execute(ctx context.Context, owner, repo string, prNumber, page, perPage int) ([]*models.DiffFile, error) {
+	u.pages = append(u.pages, page)
+	start := min((page-1)*perPage, len(u.files))
+	end := min(start+perPage, len(u.files))
+	return u.files[start:end], nil
+}
+
+func TestDiffView_LoadsMorePagesNearTheEnd(t *testing.T) {
+	uc := &pagedDiffUseCase{}
+	for i := 0; i < diffFilesPerPage+3; i++ {
+		uc.files = append(uc.files, &models.DiffFile{
+			Filename: fmt.Sprintf("file%03d.go", i),
+			Status:   models.FileStatusModified,
+			Changes:  1,
+			Patch:    "@@ -1 +1 @@\n-a\n+b",
+		})
+	}
+
+	view := NewDiffViewWithUseCase(uc, "owner", "repo", 1)
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	view.Update(view.Init()())
+	if len(view.files) != diffFilesPerPage || len(uc.pages) != 1 {
+		t.Fatalf("expected only the first page, got %d files from pages %v", len(view.files), uc.pages)
+	}
+	if out := view.View(); !strings.Contains(out, fmt.Sprintf("(1/%d+ files)", diffFilesPerPage)) {
+		t.Errorf("expected the header to show more files may follow\n%s", out)
+	}
+
+	view.currentFile = diffFilesPerPage - diffPrefetchFiles - 1
+	cmd := press(view, "n")
+	if cmd == nil {
+		t.Fatal("expected moving near the last file to fetch the next page")
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		for _, c := range batch {
+			if c != nil {
+				if loaded, ok := c().(diffLoadedMsg); ok {
+					msg = loaded
+				}
+			}
+		}
+	}
+	view.Update(msg)
+	if len(uc.pages) != 2 || uc.pages[1] != 2 {
+		t.Errorf("expected page 2 fetched, got pages %v", uc.pages)
+	}
+	if len(view.files) != diffFilesPerPage+3 || view.nextPage != 0 {
+		t.Errorf("expected every file loaded, got %d (next page %d)", len(view.files), view.nextPage)
+	}
+	if view.files[diffFilesPerPage].NewPath != fmt.Sprintf("file%03d.go", diffFilesPerPage) {
+		t.Errorf("expected the second page appended in order, got %s", view.files[diffFilesPerPage].NewPath)
+	}
+	if out := view.View(); strings.Contains(out, "+ files") {
+		t.Errorf("expected the total once every page is loaded\n%s", out)
+	}
+}
+
+func TestDiffFileFromAPI(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     *models.DiffFile
+		status   DiffFileStatus
+		tooLarge bool
+		binary   bool
+		lines    int
+	}{
+		{
+			name:   "modified file with a patch",
+			file:   &models.DiffFile{Filename: "a.go", Status: models.FileStatusModified, Changes: 2, Patch: "@@ -1 +1 @@\n-a\n+b"},
+			status: DiffFileModified,
+			lines:  2,
+		},
+		{
+			name:     "patch left out for a large diff",
+			file:     &models.DiffFile{Filename: "gen.go", Status: models.FileStatusModified, Changes: 40000},
+			status:   DiffFileModified,
+			tooLarge: true,
+		},
+		{
+			name:   "binary file",
+			file:   &models.DiffFile{Filename: "logo.png", Status: models.FileStatusAdded},
+			status: DiffFileAdded,
+			binary: true,
+		},
+		{
+			name:   "rename without changes",
+			file:   &models.DiffFile{Filename: "new.go", PreviousFilename: "old.go", Status: models.FileStatusRenamed},
+			status: DiffFileRenamed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diffFileFromAPI(tt.file)
+			if got.NewPath != tt.file.Filename || got.Status != tt.status {
+				t.Errorf("path, status = %s, %v; want %s, %v", got.NewPath, got.Status, tt.file.Filename, tt.status)
+			}
+			if got.TooLarge != tt.tooLarge || got.Binary != tt.binary {
+				t.Errorf("too large, binary = %v, %v; want %v, %v", got.TooLarge, got.Binary, tt.tooLarge, tt.binary)
+			}
+			if len(got.Lines) != tt.lines {
+				t.Errorf("lines = %d, want %d", len(got.Lines), tt.lines)
+			}
+			if tt.file.PreviousFilename != "" && got.OldPath != tt.file.PreviousFilename {
+				t.Errorf("old path = %s, want %s", got.OldPath, tt.file.PreviousFilename)
+			}
+		})
+	}
+
+	if reason := emptyDiffReason(DiffFile{TooLarge: true}); !strings.Contains(reason, "too large") {
+		t.Errorf("emptyDiffReason() = %q", reason)
+	}
+}
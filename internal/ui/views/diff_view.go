@@ -6,17 +6,24 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/a1yama/tig-gh/internal/domain/models"
 	"github.com/a1yama/tig-gh/internal/ui/components"
 	"github.com/a1yama/tig-gh/internal/ui/styles"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
-// FetchDiffUseCase defines the interface for fetching diff
+// FetchDiffUseCase defines the interface for fetching diff, one page of changed files at a time
 type FetchDiffUseCase interface {
-	Execute(ctx context.Context, owner, repo string, prNumber int) (string, error)
+	Execute(ctx context.Context, owner, repo string, prNumber, page, perPage int) ([]*models.DiffFile, error)
 }
 
+// diffFilesPerPage is how many files a page of the diff holds (the API maximum)
+const diffFilesPerPage = 100
+
+// diffPrefetchFiles is how close to the last loaded file the next page is fetched
+const diffPrefetchFiles = 5
+
 // DiffLineType represents the type of a diff line
 type DiffLineType int
 
@@ -34,17 +41,45 @@ type DiffLine struct {
 	NewLineNum int
 }
 
+// DiffFileStatus represents how a file changed in a diff
+type DiffFileStatus int
+
+const (
+	DiffFileModified DiffFileStatus = iota
+	DiffFileAdded
+	DiffFileDeleted
+	DiffFileRenamed
+	DiffFileCopied
+)
+
+// DiffHunk marks where a hunk starts in DiffFile.Lines
+type DiffHunk struct {
+	OldStart int
+	NewStart int
+	Index    int
+}
+
 // DiffFile represents a file in a diff
 type DiffFile struct {
-	OldPath string
-	NewPath string
-	Lines   []DiffLine
+	OldPath    string
+	NewPath    string
+	Lines      []DiffLine
+	Hunks      []DiffHunk
+	Status     DiffFileStatus
+	Similarity int
+	OldMode    string
+	NewMode    string
+	Binary     bool
+	TooLarge   bool // the API left the patch out
 }
 
-// diffLoadedMsg is sent when diff is loaded
+// diffLoadedMsg is sent when a page of the diff is loaded
 type diffLoadedMsg struct {
-	diff string
-	err  error
+	page   int
+	files  []DiffFile
+	more   bool // more pages may follow
+	viewed *models.ViewedFiles
+	err    error
 }
 
 // DiffView is the model for the diff view
@@ -61,11 +96,33 @@ type DiffView struct {
 	width            int
 	height           int
 	statusBar        *components.StatusBar
+	contentFetcher   FileContentFetcher
+	baseRef          string
+	headRef          string
+	mergeBase        string // found with the first structural diff
+	contents         map[string][]string
+	expanded         map[string]map[int]bool
+	showAllContext   bool
+	statusMessage    string
+	structuralMode   map[string]bool
+	structuralDiffs  map[string][]DiffLine
+	structuralBusy   map[string]bool
+	images           map[string]*imageComparison
+	prURL            string
+	headSHA          string
+	viewed           map[string]string
+	storedViewed     *models.ViewedFiles
+	peeking          map[string]bool
+	nextPage         int // 0 once every page is loaded
+	loadingMore      bool
+	viewport         components.Viewport // styled rows of the current file
+	loads            loadGroup
 }
 
 // NewDiffView creates a new diff view
 func NewDiffView() *DiffView {
 	return &DiffView{
+		loads:            loadGroup{source: sourceDiff},
 		fetchDiffUseCase: nil,
 		owner:            "",
 		repo:             "",
@@ -81,6 +138,7 @@ func NewDiffView() *DiffView {
 // NewDiffViewWithUseCase creates a new diff view with UseCase
 func NewDiffViewWithUseCase(fetchDiffUseCase FetchDiffUseCase, owner, repo string, prNumber int) *DiffView {
 	return &DiffView{
+		loads:            loadGroup{source: sourceDiff},
 		fetchDiffUseCase: fetchDiffUseCase,
 		owner:            owner,
 		repo:             repo,
@@ -93,10 +151,38 @@ func NewDiffViewWithUseCase(fetchDiffUseCase FetchDiffUseCase, owner, repo strin
 	}
 }
 
+// SetContentFetcher enables fetching files at the base and head refs, used to
+// expand collapsed context and to build structural diffs
+func (m *DiffView) SetContentFetcher(fetcher FileContentFetcher, baseRef, headRef string) {
+	m.contentFetcher = fetcher
+	m.baseRef = baseRef
+	m.headRef = headRef
+}
+
+// SetPRURL sets the PR's web URL, used to open files in the browser
+func (m *DiffView) SetPRURL(url string) {
+	m.prURL = url
+}
+
+// loadFileDetails fetches what the current file needs beyond its line diff
+func (m *DiffView) loadFileDetails() tea.Cmd {
+	var cmds []tea.Cmd
+	if cmd := m.ensureStructuralDiff(); cmd != nil {
+		cmds = append(cmds, cmd)
+	}
+	if cmd := m.ensureImageInfo(); cmd != nil {
+		cmds = append(cmds, cmd)
+	}
+	if len(cmds) == 0 {
+		return nil
+	}
+	return tea.Batch(cmds...)
+}
+
 // Init initializes the diff view
 func (m *DiffView) Init() tea.Cmd {
 	if m.fetchDiffUseCase != nil {
-		return m.fetchDiff()
+		return m.fetchDiff(1)
 	}
 	return nil
 }
@@ -105,21 +191,78 @@ func (m *DiffView) Init() tea.Cmd {
 func (m *DiffView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case diffLoadedMsg:
+		if msg.page > 1 {
+			return m, m.appendFiles(msg)
+		}
 		m.loading = false
 		if msg.err != nil {
 			m.err = msg.err
 			m.files = []DiffFile{}
 		} else {
 			m.err = nil
-			m.files = parseDiff(msg.diff)
+			m.files = msg.files
+			m.nextPage = 0
+			if msg.more {
+				m.nextPage = 2
+			}
 			// Reset cursor if it's out of bounds
 			if m.currentFile >= len(m.files) && len(m.files) > 0 {
 				m.currentFile = len(m.files) - 1
 			} else if len(m.files) == 0 {
 				m.currentFile = 0
 			}
+			m.restoreViewed(msg.viewed)
 			m.scroll = 0
 		}
+		return m, tea.Batch(m.loadFileDetails(), m.ensureMoreFiles())
+
+	case viewedSavedMsg:
+		if msg.err != nil {
+			m.statusMessage = fmt.Sprintf("Failed to save viewed files: %v", msg.err)
+		}
+		return m, nil
+
+	case imageInfoLoadedMsg:
+		if m.images == nil {
+			m.images = make(map[string]*imageComparison)
+		}
+		m.images[msg.path] = msg.comparison
+		return m, nil
+
+	case openBrowserMsg:
+		m.statusMessage = browserStatusMessage(msg)
+		return m, nil
+
+	case structuralDiffLoadedMsg:
+		delete(m.structuralBusy, msg.path)
+		if msg.mergeBase != "" {
+			m.mergeBase = msg.mergeBase
+		}
+		if msg.err != nil {
+			// Fall back to the line diff
+			m.setStructuralMode(msg.path, false)
+			m.statusMessage = fmt.Sprintf("Structural diff failed: %v", msg.err)
+			return m, nil
+		}
+		if m.structuralDiffs == nil {
+			m.structuralDiffs = make(map[string][]DiffLine)
+		}
+		m.structuralDiffs[msg.path] = msg.lines
+		m.scroll = 0
+		m.statusMessage = ""
+		return m, nil
+
+	case fileContentLoadedMsg:
+		if msg.err != nil {
+			m.statusMessage = fmt.Sprintf("Failed to load context: %v", msg.err)
+			return m, nil
+		}
+		if m.contents == nil {
+			m.contents = make(map[string][]string)
+		}
+		m.contents[msg.path] = msg.lines
+		m.markExpanded(msg.path, msg.gap)
+		m.statusMessage = ""
 		return m, nil
 
 	case tea.KeyMsg:
@@ -135,26 +278,100 @@ func (m *DiffView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
-// fetchDiff fetches diff from the API
-func (m *DiffView) fetchDiff() tea.Cmd {
+// Close cancels the fetches still in flight when the view is closed
+func (m *DiffView) Close() {
+	m.loads.Cancel()
+}
+
+// fetchDiff fetches a page of the changed files from the API. Every file has
+// its own patch, so large PRs are not cut off like their combined diff, and
+// pages past the first are only fetched when the user gets near them.
+func (m *DiffView) fetchDiff(page int) tea.Cmd {
+	ctx := m.loads.Context()
 	return func() tea.Msg {
 		if m.fetchDiffUseCase == nil {
 			return diffLoadedMsg{
-				diff: "",
+				page: page,
 				err:  fmt.Errorf("fetch diff use case not initialized"),
 			}
 		}
 
-		diff, err := m.fetchDiffUseCase.Execute(context.Background(), m.owner, m.repo, m.prNumber)
-		return diffLoadedMsg{
-			diff: diff,
-			err:  err,
+		files, err := m.fetchDiffUseCase.Execute(ctx, m.owner, m.repo, m.prNumber, page, diffFilesPerPage)
+		msg := diffLoadedMsg{
+			page:  page,
+			files: make([]DiffFile, 0, len(files)),
+			more:  len(files) == diffFilesPerPage,
+			err:   err,
+		}
+		for _, file := range files {
+			msg.files = append(msg.files, diffFileFromAPI(file))
 		}
+		if page == 1 {
+			msg.viewed = m.loadViewed()
+		}
+		return msg
+	}
+}
+
+// ensureMoreFiles fetches the next page of files once the current file is
+// close to the last one loaded
+func (m *DiffView) ensureMoreFiles() tea.Cmd {
+	if m.nextPage == 0 || m.loadingMore || m.currentFile < len(m.files)-diffPrefetchFiles {
+		return nil
+	}
+	m.loadingMore = true
+	return m.fetchDiff(m.nextPage)
+}
+
+// appendFiles adds a later page of files to the diff
+func (m *DiffView) appendFiles(msg diffLoadedMsg) tea.Cmd {
+	m.loadingMore = false
+	if isCancelled(msg.err) {
+		return nil
 	}
+	if msg.err != nil {
+		// The page is fetched again when the user moves on
+		m.statusMessage = fmt.Sprintf("Failed to load more files: %v", msg.err)
+		return nil
+	}
+	m.nextPage = 0
+	if msg.more {
+		m.nextPage = msg.page + 1
+	}
+	m.restoreViewedFiles(msg.files)
+	m.files = append(m.files, msg.files...)
+	return m.ensureMoreFiles()
+}
+
+// diffFileFromAPI turns a file listed by the API into a diff file, parsing its patch
+func diffFileFromAPI(file *models.DiffFile) DiffFile {
+	oldPath := file.Filename
+	if file.PreviousFilename != "" {
+		oldPath = file.PreviousFilename
+	}
+	parsed := parseDiff(fmt.Sprintf("diff --git a/%s b/%s\n%s", oldPath, file.Filename, file.Patch))[0]
+
+	switch file.Status {
+	case models.FileStatusAdded:
+		parsed.Status = DiffFileAdded
+	case models.FileStatusRemoved:
+		parsed.Status = DiffFileDeleted
+	case models.FileStatusRenamed:
+		parsed.Status = DiffFileRenamed
+	}
+	if file.Patch == "" {
+		// The API leaves out the patch of binary files, and of text files
+		// whose diff is too large to show
+		parsed.TooLarge = file.Changes > 0
+		parsed.Binary = file.Changes == 0 && file.Status != models.FileStatusRenamed
+	}
+	return parsed
 }
 
 // handleKeyPress handles keyboard input
 func (m *DiffView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.statusMessage = ""
+
 	switch msg.String() {
 	case "ctrl+c", "q":
 		return m, tea.Quit
@@ -162,7 +379,7 @@ func (m *DiffView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "j", "down":
 		// Scroll down
 		if len(m.files) > 0 && m.currentFile < len(m.files) {
-			maxScroll := len(m.files[m.currentFile].Lines) - 1
+			maxScroll := len(m.currentRows()) - 1
 			if m.scroll < maxScroll {
 				m.scroll++
 			}
@@ -181,8 +398,10 @@ func (m *DiffView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if m.currentFile < len(m.files)-1 {
 			m.currentFile++
 			m.scroll = 0 // Reset scroll when changing files
+		} else if m.nextPage > 0 {
+			m.statusMessage = "Loading more files..."
 		}
-		return m, nil
+		return m, tea.Batch(m.loadFileDetails(), m.ensureMoreFiles())
 
 	case "p":
 		// Previous file
@@ -190,7 +409,7 @@ func (m *DiffView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.currentFile--
 			m.scroll = 0 // Reset scroll when changing files
 		}
-		return m, nil
+		return m, m.loadFileDetails()
 
 	case "g":
 		// Go to top
@@ -200,12 +419,44 @@ func (m *DiffView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "G":
 		// Go to bottom
 		if len(m.files) > 0 && m.currentFile < len(m.files) {
-			m.scroll = len(m.files[m.currentFile].Lines) - 1
+			m.scroll = len(m.currentRows()) - 1
 			if m.scroll < 0 {
 				m.scroll = 0
 			}
 		}
 		return m, nil
+
+	case "e":
+		// Expand the collapsed context in view
+		return m, m.expandContext()
+
+	case "o":
+		// Open the current file in the PR's "Files changed" tab
+		if m.currentFile < len(m.files) {
+			return m, openInBrowser(m.fileURL(m.files[m.currentFile]))
+		}
+		return m, nil
+
+	case "s":
+		// Toggle the structural diff for JSON files and notebooks
+		return m, m.toggleStructuralDiff()
+
+	case "v":
+		// Mark the current file as viewed (folding it away) or not viewed
+		return m, m.toggleViewed()
+
+	case " ":
+		// Show or fold the diff of a viewed file
+		m.togglePeek()
+		return m, nil
+
+	case "z":
+		// Toggle folding of long unchanged runs
+		m.showAllContext = !m.showAllContext
+		if rows := len(m.currentRows()); m.scroll >= rows && rows > 0 {
+			m.scroll = rows - 1
+		}
+		return m, nil
 	}
 
 	return m, nil
@@ -247,7 +498,16 @@ func (m *DiffView) View() string {
 func (m *DiffView) renderHeader() string {
 	title := styles.HeaderStyle.Render(fmt.Sprintf("Diff: PR #%d", m.prNumber))
 	if len(m.files) > 0 {
-		fileInfo := styles.MutedStyle.Render(fmt.Sprintf("(%d/%d files)", m.currentFile+1, len(m.files)))
+		total := fmt.Sprintf("%d", len(m.files))
+		if m.nextPage > 0 {
+			// Later pages are fetched as the user gets near them
+			total += "+"
+		}
+		info := fmt.Sprintf("(%d/%s files)", m.currentFile+1, total)
+		if viewed := m.viewedCount(); viewed > 0 {
+			info = fmt.Sprintf("(%d/%s files, %d viewed)", m.currentFile+1, total, viewed)
+		}
+		fileInfo := styles.MutedStyle.Render(info)
 		return lipgloss.JoinHorizontal(lipgloss.Top, title, " ", fileInfo)
 	}
 	return title
@@ -267,26 +527,69 @@ func (m *DiffView) renderDiff() string {
 	s.WriteString(fileHeader)
 	s.WriteString("\n")
 
-	// Calculate available height for diff (total - header - file header - status bar - margins)
-	availableHeight := m.height - 5
+	// Viewed files stay folded until shown again
+	if m.showsViewedCollapsed(file) {
+		s.WriteString(renderViewedCollapsed())
+		s.WriteString("\n")
+		return s.String()
+	}
+
+	// Images carry size and dimension changes instead of lines
+	if showsImageInfo(file) {
+		s.WriteString(m.renderImageInfo(file))
+		s.WriteString("\n")
+		return s.String()
+	}
 
-	// Calculate visible range
-	startIdx := m.scroll
-	endIdx := startIdx + availableHeight
-	if endIdx > len(file.Lines) {
-		endIdx = len(file.Lines)
+	// Explain files without any content lines instead of showing nothing
+	if len(file.Lines) == 0 {
+		s.WriteString(styles.MutedStyle.Render(emptyDiffReason(file)))
+		s.WriteString("\n")
+		return s.String()
 	}
 
-	// Render visible lines
-	for i := startIdx; i < endIdx; i++ {
-		line := m.renderDiffLine(file.Lines[i])
-		s.WriteString(line)
+	// Only the rows on screen are styled, and kept while the file's rows stay
+	// the same, so scrolling a huge diff stays smooth
+	rows := m.currentRows()
+	m.viewport.SetHeight(m.diffHeight())
+	m.viewport.SetContent(m.rowsKey(file), len(rows))
+	m.viewport.SetOffset(m.scroll)
+	for _, row := range m.viewport.Rows(func(i int) string {
+		if rows[i].kind == diffRowLine {
+			return m.renderDiffLine(rows[i].line)
+		}
+		return renderDiffMarker(rows[i])
+	}) {
+		s.WriteString(row)
 		s.WriteString("\n")
 	}
 
 	return s.String()
 }
 
+// diffRowsKey identifies the rows laid out for a file; the styled rows are
+// rendered again when it changes
+type diffRowsKey struct {
+	file       int
+	path       string
+	structural bool
+	showAll    bool
+	expanded   int
+	content    bool
+}
+
+// rowsKey returns the key of the rows currentRows lays out for the file
+func (m *DiffView) rowsKey(file DiffFile) diffRowsKey {
+	return diffRowsKey{
+		file:       m.currentFile,
+		path:       file.NewPath,
+		structural: m.showingStructuralDiff(file),
+		showAll:    m.showAllContext,
+		expanded:   len(m.expanded[file.NewPath]),
+		content:    m.contents[file.NewPath] != nil,
+	}
+}
+
 // renderFileHeader renders a file header
 func (m *DiffView) renderFileHeader() string {
 	if m.currentFile >= len(m.files) {
@@ -299,14 +602,80 @@ func (m *DiffView) renderFileHeader() string {
 		path = fmt.Sprintf("%s → %s", file.OldPath, file.NewPath)
 	}
 
-	return styles.TitleStyle.Render(path)
+	header := styles.TitleStyle.Render(path)
+	badges := renderDiffFileBadges(file)
+	if m.showingStructuralDiff(file) {
+		badges = strings.TrimSpace(badges + " " + styles.InfoStyle.Render("[structural]"))
+	}
+	if m.isViewed(file) {
+		badges = strings.TrimSpace(badges + " " + styles.SuccessStyle.Render("["+styles.IconCheck+" viewed]"))
+	}
+	if badges != "" {
+		header = lipgloss.JoinHorizontal(lipgloss.Top, header, " ", badges)
+	}
+	return header
+}
+
+// renderDiffFileBadges renders badges for new, deleted, renamed, binary and mode-changed files
+func renderDiffFileBadges(file DiffFile) string {
+	var badges []string
+
+	switch file.Status {
+	case DiffFileAdded:
+		badges = append(badges, styles.SuccessStyle.Render("[new]"))
+	case DiffFileDeleted:
+		badges = append(badges, styles.ErrorStyle.Render("[deleted]"))
+	case DiffFileRenamed, DiffFileCopied:
+		label := "renamed"
+		if file.Status == DiffFileCopied {
+			label = "copied"
+		}
+		if file.Similarity > 0 {
+			label = fmt.Sprintf("%s %d%%", label, file.Similarity)
+		}
+		badges = append(badges, styles.InfoStyle.Render("["+label+"]"))
+	}
+
+	if file.OldMode != "" && file.NewMode != "" && file.OldMode != file.NewMode {
+		badges = append(badges, styles.WarningStyle.Render(fmt.Sprintf("[mode %s → %s]", file.OldMode, file.NewMode)))
+	}
+
+	if file.Binary {
+		badges = append(badges, styles.MutedStyle.Render("[binary]"))
+	}
+
+	return strings.Join(badges, " ")
+}
+
+// emptyDiffReason describes why a file has no diff lines
+func emptyDiffReason(file DiffFile) string {
+	switch {
+	case file.TooLarge:
+		return "Diff too large to show here. Press o to open it on GitHub"
+	case file.Binary:
+		return "Binary file not shown"
+	case file.Status == DiffFileRenamed:
+		return "File renamed without content changes"
+	case file.Status == DiffFileCopied:
+		return "File copied without content changes"
+	case file.OldMode != "" && file.NewMode != "" && file.OldMode != file.NewMode:
+		return "File mode changed without content changes"
+	case file.Status == DiffFileAdded:
+		return "Empty file added"
+	case file.Status == DiffFileDeleted:
+		return "Empty file deleted"
+	default:
+		return "No content changes"
+	}
 }
 
 // renderDiffLine renders a single diff line
 func (m *DiffView) renderDiffLine(line DiffLine) string {
-	// Line number
+	// Line number (structural diff lines have none)
 	lineNum := ""
-	if line.Type == DiffLineAdded {
+	if line.OldLineNum == 0 && line.NewLineNum == 0 {
+		lineNum = strings.Repeat(" ", 7)
+	} else if line.Type == DiffLineAdded {
 		lineNum = fmt.Sprintf("  +%-4d", line.NewLineNum)
 	} else if line.Type == DiffLineDeleted {
 		lineNum = fmt.Sprintf("  -%-4d", line.OldLineNum)
@@ -357,9 +726,8 @@ func (m *DiffView) updateStatusBar() {
 
 	// Add current position
 	if len(m.files) > 0 && m.currentFile < len(m.files) {
-		file := m.files[m.currentFile]
-		if len(file.Lines) > 0 {
-			position := fmt.Sprintf("%d/%d lines", m.scroll+1, len(file.Lines))
+		if rows := m.currentRows(); len(rows) > 0 {
+			position := fmt.Sprintf("%d/%d lines", m.scroll+1, len(rows))
 			m.statusBar.AddItem("", position)
 		}
 		filePosition := fmt.Sprintf("file %d/%d", m.currentFile+1, len(m.files))
@@ -376,8 +744,12 @@ func (m *DiffView) updateStatusBar() {
 		m.statusBar.AddItem("Repo", fmt.Sprintf("%s/%s", m.owner, m.repo))
 	}
 
+	if m.statusMessage != "" {
+		m.statusBar.AddItem("", m.statusMessage)
+	}
+
 	// Add key hints
-	m.statusBar.AddItem("", "j/k: scroll | n/p: file | q: quit")
+	m.statusBar.AddItem("", "j/k: scroll | n/p: file | v: viewed | e: expand | z: fold | s: structural | o: open | q: quit")
 }
 
 // parseDiff parses a unified diff string into DiffFile structures
@@ -390,6 +762,7 @@ func parseDiff(diffText string) []DiffFile {
 	lines := strings.Split(diffText, "\n")
 
 	var currentFile *DiffFile
+	inHeader := false
 	oldLineNum := 0
 	newLineNum := 0
 
@@ -410,19 +783,23 @@ func parseDiff(diffText string) []DiffFile {
 				NewPath: matches[2],
 				Lines:   []DiffLine{},
 			}
+			inHeader = true
 			continue
 		}
 
-		// Check for old file path
-		if matches := oldFilePattern.FindStringSubmatch(line); matches != nil && currentFile != nil {
-			currentFile.OldPath = matches[1]
-			continue
-		}
-
-		// Check for new file path
-		if matches := newFilePattern.FindStringSubmatch(line); matches != nil && currentFile != nil {
-			currentFile.NewPath = matches[1]
-			continue
+		// Extended header lines between "diff --git" and the first hunk
+		if inHeader && currentFile != nil {
+			if matches := oldFilePattern.FindStringSubmatch(line); matches != nil {
+				currentFile.OldPath = matches[1]
+				continue
+			}
+			if matches := newFilePattern.FindStringSubmatch(line); matches != nil {
+				currentFile.NewPath = matches[1]
+				continue
+			}
+			if parseExtendedHeader(currentFile, line) {
+				continue
+			}
 		}
 
 		// Check for hunk header
@@ -431,6 +808,14 @@ func parseDiff(diffText string) []DiffFile {
 				fmt.Sscanf(matches[1], "%d", &oldLineNum)
 				fmt.Sscanf(matches[2], "%d", &newLineNum)
 			}
+			if currentFile != nil {
+				currentFile.Hunks = append(currentFile.Hunks, DiffHunk{
+					OldStart: oldLineNum,
+					NewStart: newLineNum,
+					Index:    len(currentFile.Lines),
+				})
+			}
+			inHeader = false
 			continue
 		}
 
@@ -485,3 +870,45 @@ func parseDiff(diffText string) []DiffFile {
 
 	return files
 }
+
+// parseExtendedHeader records git's extended header lines (new/deleted file,
+// renames, copies, mode changes, binary markers) on the file. It returns true
+// when the line belongs to the header and must not be treated as content.
+func parseExtendedHeader(file *DiffFile, line string) bool {
+	switch {
+	case strings.HasPrefix(line, "new file mode "):
+		file.Status = DiffFileAdded
+		file.NewMode = strings.TrimPrefix(line, "new file mode ")
+	case strings.HasPrefix(line, "deleted file mode "):
+		file.Status = DiffFileDeleted
+		file.OldMode = strings.TrimPrefix(line, "deleted file mode ")
+	case strings.HasPrefix(line, "old mode "):
+		file.OldMode = strings.TrimPrefix(line, "old mode ")
+	case strings.HasPrefix(line, "new mode "):
+		file.NewMode = strings.TrimPrefix(line, "new mode ")
+	case strings.HasPrefix(line, "similarity index "):
+		fmt.Sscanf(strings.TrimPrefix(line, "similarity index "), "%d%%", &file.Similarity)
+	case strings.HasPrefix(line, "rename from "):
+		file.Status = DiffFileRenamed
+		file.OldPath = strings.TrimPrefix(line, "rename from ")
+	case strings.HasPrefix(line, "rename to "):
+		file.Status = DiffFileRenamed
+		file.NewPath = strings.TrimPrefix(line, "rename to ")
+	case strings.HasPrefix(line, "copy from "):
+		file.Status = DiffFileCopied
+		file.OldPath = strings.TrimPrefix(line, "copy from ")
+	case strings.HasPrefix(line, "copy to "):
+		file.Status = DiffFileCopied
+		file.NewPath = strings.TrimPrefix(line, "copy to ")
+	case strings.HasPrefix(line, "Binary files ") || line == "GIT binary patch":
+		file.Binary = true
+	case strings.HasPrefix(line, "index "),
+		strings.HasPrefix(line, "dissimilarity index "),
+		line == "--- /dev/null",
+		line == "+++ /dev/null":
+		// Carries nothing we display
+	default:
+		return false
+	}
+	return true
+}
@@ -6,20 +6,66 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/a1yama/tig-gh/internal/domain/models"
 	"github.com/a1yama/tig-gh/internal/ui/components"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
-// mockFetchDiffUseCase is a mock implementation for testing
+// mockFetchDiffUseCase is a mock implementation for testing. It serves the
+// files of a unified diff the way the API lists them, all on the first page.
 type mockFetchDiffUseCase struct {
 	executeFunc func(ctx context.Context, owner, repo string, prNumber int) (string, error)
 }
 
-func (m *mockFetchDiffUseCase) Execute(ctx context.Context, owner, repo string, prNumber int) (string, error) {
-	if m.executeFunc != nil {
-		return m.executeFunc(ctx, owner, repo, prNumber)
+func (m *mockFetchDiffUseCase) Execute(ctx context.Context, owner, repo string, prNumber, page, perPage int) ([]*models.DiffFile, error) {
+	if m.executeFunc == nil || page > 1 {
+		return nil, nil
 	}
-	return "", nil
+	diff, err := m.executeFunc(ctx, owner, repo, prNumber)
+	if err != nil {
+		return nil, err
+	}
+	return apiDiffFiles(diff), nil
+}
+
+// apiDiffFiles splits a unified diff into files as the API lists them: the
+// hunks of each file are its patch, and git's headers become the status
+func apiDiffFiles(diff string) []*models.DiffFile {
+	var files []*models.DiffFile
+	var file *models.DiffFile
+	var patch []string
+	flush := func() {
+		if file != nil {
+			file.Patch = strings.Join(patch, "\n")
+			files = append(files, file)
+		}
+	}
+	for _, line := range strings.Split(diff, "\n") {
+		if strings.HasPrefix(line, "diff --git ") {
+			flush()
+			paths := strings.SplitN(strings.TrimPrefix(line, "diff --git a/"), " b/", 2)
+			file = &models.DiffFile{Filename: paths[1], Status: models.FileStatusModified}
+			patch = nil
+			continue
+		}
+		switch {
+		case file == nil:
+		case len(patch) > 0 || strings.HasPrefix(line, "@@"):
+			patch = append(patch, line)
+			if strings.HasPrefix(line, "+") || strings.HasPrefix(line, "-") {
+				file.Changes++
+			}
+		case strings.HasPrefix(line, "new file mode"):
+			file.Status = models.FileStatusAdded
+		case strings.HasPrefix(line, "deleted file mode"):
+			file.Status = models.FileStatusRemoved
+		case strings.HasPrefix(line, "rename from "):
+			file.Status = models.FileStatusRenamed
+			file.PreviousFilename = strings.TrimPrefix(line, "rename from ")
+		}
+	}
+	flush()
+	return files
 }
 
 // サンプル差分データ
@@ -91,7 +137,7 @@ func TestDiffView_Update_DiffLoaded(t *testing.T) {
 		{
 			name: "successful diff load",
 			msg: diffLoadedMsg{
-				diff: sampleDiff,
+				files: parseDiff(sampleDiff),
 				err:  nil,
 			},
 			initialState: &DiffView{
@@ -117,7 +163,7 @@ func TestDiffView_Update_DiffLoaded(t *testing.T) {
 		{
 			name: "error during load",
 			msg: diffLoadedMsg{
-				diff: "",
+				files: parseDiff(""),
 				err:  errors.New("API error"),
 			},
 			initialState: &DiffView{
@@ -142,7 +188,7 @@ func TestDiffView_Update_DiffLoaded(t *testing.T) {
 		{
 			name: "empty diff",
 			msg: diffLoadedMsg{
-				diff: "",
+				files: parseDiff(""),
 				err:  nil,
 			},
 			initialState: &DiffView{
@@ -702,3 +748,95 @@ func TestDiffView_RenderFileHeader_Renamed(t *testing.T) {
 		t.Error("expected both old and new file names in header for renamed file")
 	}
 }
+
+func TestDiffView_ParseDiff_ExtendedHeaders(t *testing.T) {
+	input := `diff --git a/old.go b/new.go
+similarity index 92%
+rename from old.go
+rename to new.go
+index 1111111..2222222 100644
+--- a/old.go
++++ b/new.go
+@@ -1,2 +1,2 @@
+ package main
+-var a = 1
++var a = 2
+diff --git a/script.sh b/script.sh
+old mode 100644
+new mode 100755
+diff --git a/added.txt b/added.txt
+new file mode 100644
+index 0000000..3333333
+--- /dev/null
++++ b/added.txt
+@@ -0,0 +1 @@
++hello
+diff --git a/gone.txt b/gone.txt
+deleted file mode 100644
+index 4444444..0000000
+--- a/gone.txt
++++ /dev/null
+@@ -1 +0,0 @@
+-bye
+diff --git a/logo.png b/logo.png
+index 5555555..6666666 100644
+Binary files a/logo.png and b/logo.png differ`
+
+	files := parseDiff(input)
+	if len(files) != 5 {
+		t.Fatalf("expected 5 files, got %d", len(files))
+	}
+
+	renamed := files[0]
+	if renamed.Status != DiffFileRenamed || renamed.Similarity != 92 || renamed.OldPath != "old.go" || renamed.NewPath != "new.go" {
+		t.Errorf("unexpected rename %+v", renamed)
+	}
+	if len(renamed.Lines) != 3 {
+		t.Errorf("expected 3 diff lines in renamed file, got %d", len(renamed.Lines))
+	}
+
+	if mode := files[1]; mode.OldMode != "100644" || mode.NewMode != "100755" || len(mode.Lines) != 0 {
+		t.Errorf("unexpected mode change %+v", mode)
+	}
+
+	added := files[2]
+	if added.Status != DiffFileAdded || added.NewPath != "added.txt" {
+		t.Errorf("unexpected new file %+v", added)
+	}
+	if len(added.Lines) != 1 || added.Lines[0].Type != DiffLineAdded {
+		t.Errorf("expected only the added line, got %+v", added.Lines)
+	}
+
+	deleted := files[3]
+	if deleted.Status != DiffFileDeleted || deleted.NewPath != "gone.txt" {
+		t.Errorf("unexpected deleted file %+v", deleted)
+	}
+	if len(deleted.Lines) != 1 || deleted.Lines[0].Type != DiffLineDeleted {
+		t.Errorf("expected only the deleted line, got %+v", deleted.Lines)
+	}
+
+	if !files[4].Binary {
+		t.Error("expected binary file to be detected")
+	}
+}
+
+func TestDiffView_RenderFileHeader_Badges(t *testing.T) {
+	view := &DiffView{
+		width:  100,
+		height: 30,
+		files: []DiffFile{
+			{OldPath: "a.sh", NewPath: "b.sh", Status: DiffFileRenamed, Similarity: 100, OldMode: "100644", NewMode: "100755"},
+		},
+		statusBar: components.NewStatusBar(),
+	}
+
+	header := view.renderFileHeader()
+	for _, want := range []string{"renamed 100%", "mode 100644 → 100755"} {
+		if !strings.Contains(header, want) {
+			t.Errorf("expected header to contain %q, got %q", want, header)
+		}
+	}
+	if !strings.Contains(view.renderDiff(), "File renamed without content changes") {
+		t.Error("expected empty renamed file to be explained")
+	}
+}
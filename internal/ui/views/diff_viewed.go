@@ -0,0 +1,228 @@
+package views
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sync"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ViewedFilesStore remembers the files of each PR marked as viewed
+type ViewedFilesStore interface {
+	Load(owner, repo string, number int) (*models.ViewedFiles, error)
+	Save(owner, repo string, number int, viewed *models.ViewedFiles) error
+}
+
+var (
+	viewedStoreMu sync.RWMutex
+	viewedStore   ViewedFilesStore
+)
+
+// SetViewedFilesStore sets where diff views keep the files marked as viewed.
+// Without a store the marks last until the view is closed.
+func SetViewedFilesStore(store ViewedFilesStore) {
+	viewedStoreMu.Lock()
+	defer viewedStoreMu.Unlock()
+	viewedStore = store
+}
+
+// viewedFilesStore returns the store set by SetViewedFilesStore
+func viewedFilesStore() ViewedFilesStore {
+	viewedStoreMu.RLock()
+	defer viewedStoreMu.RUnlock()
+	return viewedStore
+}
+
+// viewedSavedMsg reports a failed save of the viewed files
+type viewedSavedMsg struct {
+	err error
+}
+
+// SetHeadSHA sets the head commit of the PR, recorded with the viewed files
+func (m *DiffView) SetHeadSHA(sha string) {
+	m.headSHA = sha
+}
+
+// loadViewed reads the files marked as viewed in an earlier session; it runs
+// with the diff fetch
+func (m *DiffView) loadViewed() *models.ViewedFiles {
+	store := viewedFilesStore()
+	if store == nil || m.prNumber == 0 {
+		return nil
+	}
+	viewed, err := store.Load(m.owner, m.repo, m.prNumber)
+	if err != nil {
+		return nil
+	}
+	return viewed
+}
+
+// restoreViewed keeps the marks of the files whose diff is unchanged since
+// they were marked; files changed by new commits show up unviewed again.
+// The view then starts at the first file not viewed yet.
+func (m *DiffView) restoreViewed(stored *models.ViewedFiles) {
+	m.viewed = make(map[string]string)
+	m.storedViewed = stored
+	if stored == nil {
+		return
+	}
+	m.restoreViewedFiles(m.files)
+	for i, file := range m.files {
+		if !m.isViewed(file) {
+			m.currentFile = i
+			return
+		}
+	}
+}
+
+// restoreViewedFiles keeps the stored marks of the files, as far as their
+// diff is unchanged; later pages of the diff are restored as they load
+func (m *DiffView) restoreViewedFiles(files []DiffFile) {
+	if m.storedViewed == nil {
+		return
+	}
+	if m.viewed == nil {
+		m.viewed = make(map[string]string)
+	}
+	for _, file := range files {
+		path := diffFilePath(file)
+		if fingerprint, ok := m.storedViewed.Files[path]; ok && fingerprint == diffFingerprint(file) {
+			m.viewed[path] = fingerprint
+		}
+	}
+}
+
+// isViewed reports whether a file is marked as viewed
+func (m *DiffView) isViewed(file DiffFile) bool {
+	_, ok := m.viewed[diffFilePath(file)]
+	return ok
+}
+
+// showsViewedCollapsed reports whether the current file is viewed and folded away
+func (m *DiffView) showsViewedCollapsed(file DiffFile) bool {
+	return m.isViewed(file) && !m.peeking[diffFilePath(file)]
+}
+
+// toggleViewed marks the current file as viewed, or unmarks it, and saves the marks
+func (m *DiffView) toggleViewed() tea.Cmd {
+	if m.currentFile >= len(m.files) {
+		return nil
+	}
+	file := m.files[m.currentFile]
+	path := diffFilePath(file)
+	if m.viewed == nil {
+		m.viewed = make(map[string]string)
+	}
+	if m.isViewed(file) {
+		delete(m.viewed, path)
+	} else {
+		m.viewed[path] = diffFingerprint(file)
+	}
+	delete(m.peeking, path)
+	m.scroll = 0
+	return m.saveViewed()
+}
+
+// togglePeek shows the diff of a viewed file without unmarking it, or folds it again
+func (m *DiffView) togglePeek() {
+	if m.currentFile >= len(m.files) || !m.isViewed(m.files[m.currentFile]) {
+		return
+	}
+	if m.peeking == nil {
+		m.peeking = make(map[string]bool)
+	}
+	path := diffFilePath(m.files[m.currentFile])
+	m.peeking[path] = !m.peeking[path]
+	m.scroll = 0
+}
+
+// saveViewed writes the marks in the background
+func (m *DiffView) saveViewed() tea.Cmd {
+	store := viewedFilesStore()
+	if store == nil {
+		return nil
+	}
+	viewed := &models.ViewedFiles{HeadSHA: m.headSHA, Files: make(map[string]string, len(m.viewed))}
+	if m.storedViewed != nil && m.nextPage > 0 {
+		// Marks of files on pages not loaded yet stay as they were
+		loaded := make(map[string]bool, len(m.files))
+		for _, file := range m.files {
+			loaded[diffFilePath(file)] = true
+		}
+		for path, fingerprint := range m.storedViewed.Files {
+			if !loaded[path] {
+				viewed.Files[path] = fingerprint
+			}
+		}
+	}
+	for path, fingerprint := range m.viewed {
+		viewed.Files[path] = fingerprint
+	}
+	owner, repo, number := m.owner, m.repo, m.prNumber
+	return func() tea.Msg {
+		return viewedSavedMsg{err: store.Save(owner, repo, number, viewed)}
+	}
+}
+
+// renderViewedCollapsed stands in for the diff of a viewed file
+func renderViewedCollapsed() string {
+	return styles.MutedStyle.Render("Viewed. Press space to show the diff, v to mark it as not viewed.")
+}
+
+// viewedCount returns how many files of the diff are marked as viewed
+func (m *DiffView) viewedCount() int {
+	count := 0
+	for _, file := range m.files {
+		if m.isViewed(file) {
+			count++
+		}
+	}
+	return count
+}
+
+// diffFilePath is the path a file is remembered by
+func diffFilePath(file DiffFile) string {
+	if file.NewPath != "" {
+		return file.NewPath
+	}
+	return file.OldPath
+}
+
+// diffFingerprint identifies the changes of a file. It changes whenever a
+// commit changes the file, while commits to other files leave it alone. Only
+// the path and the changed lines count, so the patch of a file listed by the
+// API (see patchFingerprint) has the same fingerprint as its full diff.
+func diffFingerprint(file DiffFile) string {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s\n", diffFilePath(file))
+	for _, line := range file.Lines {
+		fmt.Fprintf(h, "%d%s\n", line.Type, line.Content)
+	}
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+// patchFingerprint returns the fingerprint of a file from its patch
+func patchFingerprint(path, patch string) string {
+	files := parseDiff(fmt.Sprintf("diff --git a/%s b/%s\n%s", path, path, patch))
+	if len(files) == 0 {
+		return diffFingerprint(DiffFile{NewPath: path})
+	}
+	return diffFingerprint(files[0])
+}
+
+// countViewed returns how many of the files are marked as viewed and unchanged since
+func countViewed(viewed *models.ViewedFiles, files []*models.DiffFile) int {
+	if viewed == nil {
+		return 0
+	}
+	count := 0
+	for _, file := range files {
+		if fingerprint, ok := viewed.Files[file.Filename]; ok && fingerprint == patchFingerprint(file.Filename, file.Patch) {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,114 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// memoryViewedStore keeps viewed files in memory
+type memoryViewedStore struct {
+	records map[string]*models.ViewedFiles
+}
+
+func (s *memoryViewedStore) Load(owner, repo string, number int) (*models.ViewedFiles, error) {
+	return s.records[fmt.Sprintf("%s/%s#%d", owner, repo, number)], nil
+}
+
+func (s *memoryViewedStore) Save(owner, repo string, number int, viewed *models.ViewedFiles) error {
+	s.records[fmt.Sprintf("%s/%s#%d", owner, repo, number)] = viewed
+	return nil
+}
+
+// twoFileDiff changes main.go and util.go; mainLine is the line added to main.go
+func twoFileDiff(mainLine string) string {
+	return `diff --git a/main.go b/main.go
+--- a/main.go
++++ b/main.go
+@@ -1,2 +1,3 @@
+ package main
++` + mainLine + `
+ func main() {}
+diff --git a/util.go b/util.go
+--- a/util.go
++++ b/util.go
+@@ -1,2 +1,2 @@
+ package main
+-func old() {}
++func helper() {}
+`
+}
+
+// openDiff opens the diff of PR #7 and waits for it to load
+func openDiff(t *testing.T, diff string) *DiffView {
+	t.Helper()
+	view := NewDiffViewWithUseCase(&mockFetchDiffUseCase{
+		executeFunc: func(ctx context.Context, owner, repo string, prNumber int) (string, error) {
+			return diff, nil
+		},
+	}, "owner", "repo", 7)
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	view.Update(view.Init()())
+	return view
+}
+
+func TestDiffView_ViewedFilesAreRememberedPerPR(t *testing.T) {
+	store := &memoryViewedStore{records: map[string]*models.ViewedFiles{}}
+	SetViewedFilesStore(store)
+	defer SetViewedFilesStore(nil)
+
+	view := openDiff(t, twoFileDiff("import \"fmt\""))
+	view.SetHeadSHA("aaa111")
+	cmd := press(view, "v")
+	if cmd == nil {
+		t.Fatal("expected the viewed files to be saved")
+	}
+	view.Update(cmd())
+	if out := view.View(); !strings.Contains(out, "1 viewed") || !strings.Contains(out, "Press space to show the diff") {
+		t.Errorf("expected main.go folded as viewed\n%s", out)
+	}
+	if saved := store.records["owner/repo#7"]; saved == nil || saved.HeadSHA != "aaa111" || len(saved.Files) != 1 {
+		t.Fatalf("saved = %+v, want main.go at aaa111", saved)
+	}
+
+	// Coming back starts at the first file not viewed, with main.go still folded
+	view = openDiff(t, twoFileDiff("import \"fmt\""))
+	if view.currentFile != 1 {
+		t.Errorf("currentFile = %d, want util.go", view.currentFile)
+	}
+	press(view, "p")
+	if out := view.View(); !strings.Contains(out, "Press space to show the diff") {
+		t.Errorf("expected main.go still viewed\n%s", out)
+	}
+	press(view, " ")
+	if out := view.View(); !strings.Contains(out, "import") {
+		t.Errorf("expected space to show the viewed diff\n%s", out)
+	}
+
+	// A new commit changing main.go marks it as not viewed again
+	view = openDiff(t, twoFileDiff("import \"os\""))
+	if view.viewedCount() != 0 || view.currentFile != 0 {
+		t.Errorf("viewed = %d, currentFile = %d; want main.go reset", view.viewedCount(), view.currentFile)
+	}
+}
+
+func TestDiffView_UnmarkViewed(t *testing.T) {
+	store := &memoryViewedStore{records: map[string]*models.ViewedFiles{}}
+	SetViewedFilesStore(store)
+	defer SetViewedFilesStore(nil)
+
+	view := openDiff(t, twoFileDiff("import \"fmt\""))
+	view.Update(press(view, "v")())
+	view.Update(press(view, "v")())
+
+	if view.viewedCount() != 0 {
+		t.Errorf("viewed = %d, want none", view.viewedCount())
+	}
+	if saved := store.records["owner/repo#7"]; saved == nil || len(saved.Files) != 0 {
+		t.Errorf("saved = %+v, want no files", saved)
+	}
+}
@@ -0,0 +1,76 @@
+package views
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// editorClosedMsg is sent when the external editor exits
+type editorClosedMsg struct {
+	purpose string
+	text    string
+	err     error
+}
+
+// editorCommand returns the user's editor from $VISUAL or $EDITOR, falling back to vi
+func editorCommand() []string {
+	for _, name := range []string{"VISUAL", "EDITOR"} {
+		if fields := strings.Fields(os.Getenv(name)); len(fields) > 0 {
+			return fields
+		}
+	}
+	return []string{"vi"}
+}
+
+// openEditor suspends the UI and edits text in the user's editor. The edited
+// text comes back as an editorClosedMsg tagged with purpose. Replaced in tests.
+var openEditor = func(purpose, text string) tea.Cmd {
+	file, err := os.CreateTemp("", "tig-gh-*.md")
+	if err != nil {
+		return editorFailed(purpose, err)
+	}
+	path := file.Name()
+	if _, err := file.WriteString(text); err != nil {
+		file.Close()
+		os.Remove(path)
+		return editorFailed(purpose, err)
+	}
+	if err := file.Close(); err != nil {
+		os.Remove(path)
+		return editorFailed(purpose, err)
+	}
+
+	args := editorCommand()
+	cmd := exec.Command(args[0], append(args[1:], path)...)
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		defer os.Remove(path)
+		if err != nil {
+			return editorClosedMsg{purpose: purpose, err: fmt.Errorf("editor %s failed: %w", args[0], err)}
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return editorClosedMsg{purpose: purpose, err: err}
+		}
+		return editorClosedMsg{purpose: purpose, text: string(data)}
+	})
+}
+
+// editorFailed reports an editor that could not be started
+func editorFailed(purpose string, err error) tea.Cmd {
+	return func() tea.Msg {
+		return editorClosedMsg{purpose: purpose, err: err}
+	}
+}
+
+// parseDraft splits an edited draft into its title (the first non-empty line)
+// and body
+func parseDraft(text string) (title, body string) {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.TrimLeft(text, " \t\n")
+	title, body, _ = strings.Cut(text, "\n")
+	return strings.TrimSpace(title), strings.Trim(body, "\n")
+}
@@ -0,0 +1,65 @@
+package views
+
+import (
+	"context"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/infra/cache"
+)
+
+// issueRefreshedMsg is sent when an issue and its comments are refetched
+type issueRefreshedMsg struct {
+	issue    *models.Issue
+	comments []*models.Comment
+	err      error
+}
+
+// prRefreshedMsg is sent when a PR with its reviews and comments is refetched
+type prRefreshedMsg struct {
+	pr           *models.PullRequest
+	reviews      []*models.Review
+	comments     []*models.Comment
+	threads      []*models.ReviewThread
+	files        []*models.DiffFile
+	requirements *models.MergeRequirements
+	approvals    *models.Approvals
+	pending      []*models.PendingReviewRequest
+	err          error
+}
+
+// freshContext returns a child of ctx that bypasses the response cache
+func freshContext(ctx context.Context) context.Context {
+	return cache.WithSkipCacheContext(ctx)
+}
+
+// replaceIssue swaps the issue with the same number in place
+func replaceIssue(issues []*models.Issue, updated *models.Issue) bool {
+	if updated == nil {
+		return false
+	}
+	for i, issue := range issues {
+		if issue != nil && issue.Number == updated.Number {
+			issues[i] = updated
+			return true
+		}
+	}
+	return false
+}
+
+// replacePR swaps the pull request with the same number in place
+func replacePR(prs []*models.PullRequest, updated *models.PullRequest) bool {
+	if updated == nil {
+		return false
+	}
+	number, ok := prDisplayNumber(updated)
+	if !ok {
+		return false
+	}
+	for i, pr := range prs {
+		if n, ok := prDisplayNumber(pr); ok && n == number {
+			prs[i] = updated
+			return true
+		}
+	}
+	return false
+}
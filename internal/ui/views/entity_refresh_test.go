@@ -0,0 +1,125 @@
+package views
+
+import (
+	"context"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/infra/cache"
+	"github.com/a1yama/tig-gh/internal/ui/events"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestPRDetailView_DeepRefreshUpdatesParentList(t *testing.T) {
+	stale := &models.PullRequest{Number: 5, Title: "Feature", State: models.PRStateOpen}
+	fresh := &models.PullRequest{Number: 5, Title: "Feature", State: models.PRStateClosed, Merged: true}
+	prRepo := &testPRRepo{pr: fresh}
+
+	view := NewPRViewWithUseCase(&mockFetchPRsUseCase{
+		getRepositoryFunc: func() repository.PullRequestRepository { return prRepo },
+	}, "owner", "repo")
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	view.Update(prsLoadedMsg{prs: []*models.PullRequest{stale}})
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !view.IsShowingDetail() {
+		t.Fatal("expected detail view to be open")
+	}
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("R")})
+	if cmd == nil {
+		t.Fatal("expected refresh command")
+	}
+
+	_, cmd = view.Update(cmd())
+	if view.detailView.pr != fresh {
+		t.Fatal("expected detail view to show refetched PR")
+	}
+	if cmd == nil {
+		t.Fatal("expected shared entity update")
+	}
+
+	view.Update(cmd())
+	if view.prs[0] != fresh {
+		t.Fatal("expected list row to be replaced")
+	}
+}
+
+type refreshIssueRepo struct {
+	repository.IssueRepository
+	issue     *models.Issue
+	skipCache bool
+}
+
+func (r *refreshIssueRepo) Get(ctx context.Context, owner, repo string, number int) (*models.Issue, error) {
+	r.skipCache = cache.OptionsFromContext(ctx).SkipCache
+	return r.issue, nil
+}
+
+func (r *refreshIssueRepo) ListComments(ctx context.Context, owner, repo string, number int, opts *models.CommentOptions) ([]*models.Comment, error) {
+	return []*models.Comment{{Body: "new comment"}}, nil
+}
+
+func TestIssueDetailView_DeepRefreshBypassesCache(t *testing.T) {
+	repo := &refreshIssueRepo{issue: &models.Issue{Number: 3, Title: "Renamed", State: models.IssueStateClosed}}
+	view := NewIssueDetailView(&models.Issue{Number: 3, Title: "Old"}, "owner", "repo", repo)
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("R")})
+	if cmd == nil {
+		t.Fatal("expected refresh command")
+	}
+	_, cmd = view.Update(cmd())
+
+	if !repo.skipCache {
+		t.Error("expected refresh to skip the cache")
+	}
+	if view.issue.Title != "Renamed" || len(view.comments) != 1 {
+		t.Errorf("expected refreshed issue and comments, got %+v / %d", view.issue, len(view.comments))
+	}
+
+	event, ok := cmd().(events.EntityChanged)
+	if !ok || event.Issue != repo.issue || event.Owner != "owner" || event.Repo != "repo" {
+		t.Fatalf("expected EntityChanged with refreshed issue, got %#v", event)
+	}
+}
+
+func TestPRQueueView_EntityChangedRemovesMergedPR(t *testing.T) {
+	view := NewPRQueueViewWithUseCase(nil, "owner", "repo")
+	view.entries = []*prQueueEntry{
+		{pr: &models.PullRequest{Number: 1, State: models.PRStateOpen}},
+		{pr: &models.PullRequest{Number: 2, State: models.PRStateOpen}},
+	}
+	view.cursor = 1
+
+	// Events for other repositories are ignored
+	view.Update(events.PullRequestChanged(events.ActionMerged, "owner", "other", &models.PullRequest{Number: 2, Merged: true}))
+	if len(view.entries) != 2 {
+		t.Fatalf("expected event for another repo to be ignored, got %d entries", len(view.entries))
+	}
+
+	view.Update(events.PullRequestChanged(events.ActionMerged, "owner", "repo", &models.PullRequest{Number: 2, Merged: true}))
+	if len(view.entries) != 1 || view.entries[0].pr.Number != 1 {
+		t.Fatalf("expected merged PR to leave the queue, got %+v", view.entries)
+	}
+	if view.cursor != 0 {
+		t.Errorf("expected cursor to be clamped, got %d", view.cursor)
+	}
+
+	labeled := &models.PullRequest{Number: 1, State: models.PRStateOpen, Labels: []models.Label{{Name: "ready"}}}
+	view.Update(events.PullRequestChanged(events.ActionLabeled, "owner", "repo", labeled))
+	if view.entries[0].pr != labeled {
+		t.Error("expected labeled PR to replace the queue row")
+	}
+}
+
+func TestIssueView_EntityChangedReplacesRow(t *testing.T) {
+	view := NewIssueViewWithUseCase(nil, "owner", "repo")
+	view.issues = []*models.Issue{{Number: 1, State: models.IssueStateOpen}, {Number: 2}}
+
+	closed := &models.Issue{Number: 1, State: models.IssueStateClosed}
+	view.Update(events.IssueChanged(events.ActionClosed, "owner", "repo", closed))
+
+	if view.issues[0] != closed {
+		t.Fatal("expected issue row to be replaced")
+	}
+}
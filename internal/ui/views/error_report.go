@@ -0,0 +1,28 @@
+package views
+
+import (
+	"github.com/a1yama/tig-gh/internal/ui/events"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// retryMsg asks the view that reported a failed load to repeat it. The App
+// broadcasts it to every view when the error banner's retry key is pressed;
+// only target acts on it.
+type retryMsg struct {
+	target tea.Model
+}
+
+// reportLoadError shows a failed load of view in the app's error banner with
+// a retry action. It returns nil when err is nil.
+func reportLoadError(view tea.Model, source string, err error) tea.Cmd {
+	if err == nil {
+		return nil
+	}
+	return events.ReportError(source, err, retryMsg{target: view})
+}
+
+// isRetryFor reports whether msg asks view to retry its load
+func isRetryFor(msg tea.Msg, view tea.Model) bool {
+	retry, ok := msg.(retryMsg)
+	return ok && retry.target == view
+}
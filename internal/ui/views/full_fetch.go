@@ -0,0 +1,57 @@
+package views
+
+import (
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/components"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// issueFullLoadedMsg carries the complete issue a detail view was opened with
+type issueFullLoadedMsg struct {
+	issue *models.Issue
+	err   error
+}
+
+// prFullLoadedMsg carries the complete PR a detail view was opened with
+type prFullLoadedMsg struct {
+	pr  *models.PullRequest
+	err error
+}
+
+// fullFetch tracks the background fetch of the complete issue or PR when a
+// detail view opens. Lists and search results leave fields out (a PR's line
+// counts and mergeability are only returned by Get), so those fields show a
+// shimmer until it is done.
+type fullFetch struct {
+	loading bool
+	frame   int
+}
+
+// start marks the fetch as running and starts the shimmer
+func (f *fullFetch) start() tea.Cmd {
+	f.loading = true
+	f.frame = 0
+	return components.ShimmerTick()
+}
+
+// done stops the shimmer
+func (f *fullFetch) done() {
+	f.loading = false
+}
+
+// tick advances the shimmer, scheduling the next frame while the fetch runs
+func (f *fullFetch) tick() tea.Cmd {
+	if !f.loading {
+		return nil
+	}
+	f.frame++
+	return components.ShimmerTick()
+}
+
+// value returns value, or a shimmer of width cells while the fetch runs
+func (f *fullFetch) value(value string, width int) string {
+	if f.loading {
+		return components.RenderShimmer(width, f.frame)
+	}
+	return value
+}
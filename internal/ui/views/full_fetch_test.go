@@ -0,0 +1,90 @@
+package views
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/events"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestPRDetailView_FullFetchMergesStats(t *testing.T) {
+	// The list copy has no line counts or mergeability
+	listed := &models.PullRequest{Number: 5, Title: "Add cache", State: models.PRStateOpen,
+		Reviews: []models.Review{{State: models.ReviewStateApproved}}}
+	full := &models.PullRequest{Number: 5, Title: "Add cache", State: models.PRStateOpen,
+		ChangedFiles: 7, Additions: 120, Deletions: 30, Commits: 4, Mergeable: true, MergeableState: "clean"}
+	view := NewPRDetailView(listed, "owner", "repo", &testPRRepo{pr: full})
+
+	if view.full.start() == nil {
+		t.Fatal("expected the shimmer to be scheduled")
+	}
+	if stats := view.renderStats(); !strings.Contains(stats, styles.IconShimmerPeak) || strings.Contains(stats, "+0") {
+		t.Errorf("expected a shimmer in place of the missing counts, got %q", stats)
+	}
+	if _, cmd := view.Update(components.ShimmerTickMsg{}); cmd == nil || view.full.frame != 1 {
+		t.Error("expected the shimmer to move on while loading")
+	}
+
+	_, cmd := view.Update(view.loadFull()())
+	if view.full.loading {
+		t.Fatal("expected the fetch to be done")
+	}
+	if stats := view.renderStats(); !strings.Contains(stats, "+120") || !strings.Contains(stats, "-30") || strings.Contains(stats, styles.IconShimmer) {
+		t.Errorf("expected the full counts, got %q", stats)
+	}
+	if len(view.pr.Reviews) != 1 {
+		t.Error("expected the loaded reviews to be kept")
+	}
+	if status := view.getMergeStatus(); strings.Contains(status, "Conflicts") {
+		t.Errorf("expected the mergeability of the full PR, got %q", status)
+	}
+	if cmd == nil {
+		t.Fatal("expected the list rows to be told about the full PR")
+	}
+	if changed, ok := cmd().(events.EntityChanged); !ok || changed.PullRequest != full {
+		t.Errorf("expected a PR change event, got %#v", changed)
+	}
+	if _, cmd := view.Update(components.ShimmerTickMsg{}); cmd != nil {
+		t.Error("expected the shimmer to stop once loaded")
+	}
+}
+
+func TestPRDetailView_FullFetchAfterReload(t *testing.T) {
+	view := NewPRDetailView(&models.PullRequest{Number: 5}, "owner", "repo", &testPRRepo{})
+	view.full.start()
+
+	// A reload finished first; the older copy must not replace it
+	reloaded := &models.PullRequest{Number: 5, Additions: 9}
+	view.Update(prRefreshedMsg{pr: reloaded})
+	view.Update(prFullLoadedMsg{pr: &models.PullRequest{Number: 5, Additions: 1}})
+	if view.pr != reloaded || view.full.loading {
+		t.Errorf("expected the reloaded PR to stay, got %+v", view.pr)
+	}
+}
+
+func TestIssueDetailView_FullFetch(t *testing.T) {
+	full := &models.Issue{Number: 3, Title: "Crash", Body: "Steps to reproduce",
+		Reactions: models.Reactions{TotalCount: 2, PlusOne: 2}}
+	view := NewIssueDetailView(&models.Issue{Number: 3, Title: "Crash"}, "owner", "repo", &refreshIssueRepo{issue: full})
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+
+	if view.Init() == nil {
+		t.Fatal("expected Init to load the issue")
+	}
+	if out := view.View(); strings.Contains(out, "No description provided.") || !strings.Contains(out, styles.IconShimmerPeak) {
+		t.Errorf("expected a shimmer while the body loads, got %q", out)
+	}
+
+	view.Update(view.loadFull()())
+	out := view.View()
+	if !strings.Contains(out, "reproduce") || !strings.Contains(out, styles.IconThumbsUp+" 2") {
+		t.Errorf("expected the body and reactions of the full issue, got %q", out)
+	}
+	if strings.Contains(out, styles.IconShimmer) {
+		t.Error("expected no shimmer once loaded")
+	}
+}
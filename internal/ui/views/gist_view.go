@@ -0,0 +1,598 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/infra/clipboard"
+	"github.com/a1yama/tig-gh/internal/infra/paths"
+	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// errGistsNeedToken is shown instead of the list in guest mode, where the API
+// would return everyone's public gists rather than the user's own
+var errGistsNeedToken = errors.New("listing your gists requires a GitHub token")
+
+// FetchGistsUseCase defines the interface for fetching gists
+type FetchGistsUseCase interface {
+	Execute(ctx context.Context, opts *models.GistOptions) ([]*models.Gist, error)
+	GetRepository() repository.GistRepository
+}
+
+// gistsLoadedMsg is sent when gists are loaded
+type gistsLoadedMsg struct {
+	gists []*models.Gist
+	err   error
+}
+
+// gistPreviewLoadedMsg is sent when a gist's file contents are loaded
+type gistPreviewLoadedMsg struct {
+	id   string
+	gist *models.Gist
+	err  error
+}
+
+// gistCreatedMsg is sent when a gist has been created
+type gistCreatedMsg struct {
+	gist *models.Gist
+	err  error
+}
+
+// Indexes of the fields in the new gist form
+const (
+	gistFieldPath = iota
+	gistFieldDescription
+	gistFieldPublic
+)
+
+// GistView is the model for the gist list view
+type GistView struct {
+	fetchGistsUseCase FetchGistsUseCase
+	gists             []*models.Gist
+	cursor            int
+	loading           bool
+	err               error
+	width             int
+	height            int
+	statusBar         *components.StatusBar
+	showHelp          bool
+	previews          map[string]*models.Gist
+	previewErrs       map[string]error
+	form              *components.FormModal
+	creating          bool
+	loads             loadGroup
+	previewLoads      loadGroup
+}
+
+// NewGistView creates a new gist view
+func NewGistView() *GistView {
+	return &GistView{
+		loads:        loadGroup{source: sourceGists},
+		previewLoads: loadGroup{source: sourceGists},
+		gists:        []*models.Gist{},
+		statusBar:    components.NewStatusBar(),
+		previews:     make(map[string]*models.Gist),
+		previewErrs:  make(map[string]error),
+		form:         components.NewFormModal(),
+	}
+}
+
+// NewGistViewWithUseCase creates a new gist view with UseCase
+func NewGistViewWithUseCase(fetchGistsUseCase FetchGistsUseCase) *GistView {
+	view := NewGistView()
+	view.fetchGistsUseCase = fetchGistsUseCase
+	view.loading = true // Start in loading state
+	return view
+}
+
+// Init initializes the gist view
+func (m *GistView) Init() tea.Cmd {
+	if m.fetchGistsUseCase != nil {
+		return m.fetchGists()
+	}
+	return nil
+}
+
+// Update handles messages
+func (m *GistView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// A retry from the error banner reloads the list
+	if isRetryFor(msg, m) {
+		return m, m.refresh()
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.form.IsVisible() {
+			return m.handleFormKey(msg)
+		}
+		return m.handleKeyPress(msg)
+
+	case gistsLoadedMsg:
+		if isCancelled(msg.err) {
+			// Cancelled with esc or replaced by a newer fetch
+			return m, nil
+		}
+		m.loading = false
+		if msg.err != nil {
+			m.err = msg.err
+			m.gists = []*models.Gist{}
+		} else {
+			m.err = nil
+			m.gists = msg.gists
+		}
+		if m.cursor >= len(m.gists) {
+			m.cursor = 0
+		}
+		return m, tea.Batch(m.loadPreview(), reportLoadError(m, "gists", msg.err))
+
+	case gistPreviewLoadedMsg:
+		if isCancelled(msg.err) {
+			// The cursor moved on; the preview is fetched again when it comes back
+			return m, nil
+		}
+		if msg.err != nil {
+			m.previewErrs[msg.id] = msg.err
+		} else {
+			m.previews[msg.id] = msg.gist
+		}
+		return m, nil
+
+	case gistCreatedMsg:
+		m.creating = false
+		if msg.err != nil {
+			m.statusBar.SetMessage(fmt.Sprintf("Create gist failed: %v", msg.err))
+			return m, nil
+		}
+		m.statusBar.SetMessage(fmt.Sprintf("Created gist %s", msg.gist.HTMLURL))
+		m.cursor = 0
+		m.loading = true
+		return m, m.fetchGists()
+
+	case openBrowserMsg:
+		m.statusBar.SetMessage(browserStatusMessage(msg))
+		return m, nil
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.statusBar.SetSize(msg.Width, 1)
+		m.form.SetSize(msg.Width, msg.Height)
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// gistRepository returns the repository used for previews and writes
+func (m *GistView) gistRepository() repository.GistRepository {
+	if m.fetchGistsUseCase == nil {
+		return nil
+	}
+	return m.fetchGistsUseCase.GetRepository()
+}
+
+// fetchGists fetches the user's gists from the API
+func (m *GistView) fetchGists() tea.Cmd {
+	ctx := m.loads.Restart()
+	return func() tea.Msg {
+		if m.fetchGistsUseCase == nil {
+			return gistsLoadedMsg{err: fmt.Errorf("fetch gists use case not initialized")}
+		}
+		if repo := m.gistRepository(); repo != nil && !canWrite(repo) {
+			return gistsLoadedMsg{err: errGistsNeedToken}
+		}
+
+		gists, err := fetchPages(func(page, perPage int) ([]*models.Gist, error) {
+			return m.fetchGistsUseCase.Execute(ctx, &models.GistOptions{PerPage: perPage, Page: page})
+		})
+		return gistsLoadedMsg{gists: gists, err: err}
+	}
+}
+
+// loadPreview fetches the file contents of the selected gist unless they are already loaded
+func (m *GistView) loadPreview() tea.Cmd {
+	gist := m.selectedGist()
+	repo := m.gistRepository()
+	if gist == nil || repo == nil {
+		return nil
+	}
+	if _, ok := m.previews[gist.ID]; ok {
+		return nil
+	}
+	if _, ok := m.previewErrs[gist.ID]; ok {
+		return nil
+	}
+
+	// Only the preview of the selected gist is still wanted
+	id := gist.ID
+	ctx := m.previewLoads.Restart()
+	return func() tea.Msg {
+		full, err := repo.Get(ctx, id)
+		return gistPreviewLoadedMsg{id: id, gist: full, err: err}
+	}
+}
+
+// selectedGist returns the gist under the cursor
+func (m *GistView) selectedGist() *models.Gist {
+	if m.cursor < 0 || m.cursor >= len(m.gists) {
+		return nil
+	}
+	return m.gists[m.cursor]
+}
+
+// openCreateForm shows the new gist form
+func (m *GistView) openCreateForm() {
+	repo := m.gistRepository()
+	if repo == nil {
+		return
+	}
+	if !canWrite(repo) {
+		m.statusBar.SetMessage(readOnlyStatus)
+		return
+	}
+
+	m.form.SetSize(m.width, m.height)
+	m.form.Show("New gist", []components.FormField{
+		gistFieldPath:        {Label: "File", Placeholder: "~/snippets/example.go", Required: true},
+		gistFieldDescription: {Label: "Description"},
+		gistFieldPublic:      {Label: "Public", Checkbox: true},
+	})
+}
+
+// handleFormKey forwards input to the new gist form and creates the gist on submit
+func (m *GistView) handleFormKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.String() == "ctrl+c" {
+		return m, tea.Quit
+	}
+
+	m.form.Update(msg)
+	if !m.form.Submitted() {
+		return m, nil
+	}
+
+	path := m.form.Value(gistFieldPath)
+	description := m.form.Value(gistFieldDescription)
+	public := m.form.Checked(gistFieldPublic)
+	repo, ctx := m.gistRepository(), m.loads.writeContext()
+
+	m.creating = true
+	m.statusBar.SetMessage(fmt.Sprintf("Creating gist from %s...", path))
+	return m, func() tea.Msg {
+		input, err := newGistInput(path, description, public)
+		if err != nil {
+			return gistCreatedMsg{err: err}
+		}
+		gist, err := repo.Create(ctx, input)
+		return gistCreatedMsg{gist: gist, err: err}
+	}
+}
+
+// newGistInput builds a single-file gist from a local file
+func newGistInput(path, description string, public bool) (*models.CreateGistInput, error) {
+	expanded := paths.ExpandPath(path)
+	content, err := os.ReadFile(expanded)
+	if err != nil {
+		return nil, err
+	}
+	// GitHub rejects files without content
+	if strings.TrimSpace(string(content)) == "" {
+		return nil, fmt.Errorf("%s is empty", path)
+	}
+
+	return &models.CreateGistInput{
+		Description: description,
+		Public:      public,
+		Files:       map[string]string{filepath.Base(expanded): string(content)},
+	}, nil
+}
+
+// refresh reloads the gists, dropping cached previews, unless a load is already running
+func (m *GistView) refresh() tea.Cmd {
+	if m.loading || m.fetchGistsUseCase == nil {
+		return nil
+	}
+	m.loading = true
+	m.err = nil
+	m.previews = make(map[string]*models.Gist)
+	m.previewErrs = make(map[string]error)
+	return m.fetchGists()
+}
+
+// handleKeyPress handles keyboard input
+func (m *GistView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c", "q":
+		return m, tea.Quit
+
+	case "esc":
+		// Stop loading
+		if m.loading {
+			m.loads.Cancel()
+			m.loading = false
+			m.statusBar.SetMessage(loadCancelledStatus)
+		}
+		return m, nil
+
+	case "?":
+		m.showHelp = !m.showHelp
+		return m, nil
+
+	case "r":
+		// Refresh gists and drop cached previews
+		return m, m.refresh()
+
+	case "j", "down":
+		if m.cursor < len(m.gists)-1 {
+			m.cursor++
+		}
+		return m, m.loadPreview()
+
+	case "k", "up":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+		return m, m.loadPreview()
+
+	case "g":
+		m.cursor = 0
+		return m, m.loadPreview()
+
+	case "G":
+		if len(m.gists) > 0 {
+			m.cursor = len(m.gists) - 1
+		}
+		return m, m.loadPreview()
+
+	case "o":
+		if gist := m.selectedGist(); gist != nil && gist.HTMLURL != "" {
+			return m, openInBrowser(gist.HTMLURL)
+		}
+		return m, nil
+
+	case "y":
+		// Copy the raw URL of the first file
+		gist := m.selectedGist()
+		if gist == nil || len(gist.Files) == 0 {
+			return m, nil
+		}
+		rawURL := gist.Files[0].RawURL
+		if err := clipboard.Copy(rawURL); err != nil {
+			m.statusBar.SetMessage(fmt.Sprintf("Copy failed: %v (%s)", err, rawURL))
+		} else {
+			m.statusBar.SetMessage(fmt.Sprintf("Copied %s", rawURL))
+		}
+		return m, nil
+
+	case "n":
+		// Create a gist from a local file
+		if !m.creating {
+			m.openCreateForm()
+		}
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// View renders the gist view
+func (m *GistView) View() string {
+	if m.width == 0 || m.height == 0 {
+		return "Initializing..."
+	}
+
+	if m.form.IsVisible() {
+		return m.form.View()
+	}
+
+	var s strings.Builder
+
+	s.WriteString(m.renderHeader())
+	s.WriteString("\n")
+
+	if m.loading {
+		s.WriteString(styles.LoadingStyle.Render("Loading gists..."))
+	} else if m.err != nil {
+		s.WriteString(styles.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+	} else if len(m.gists) == 0 {
+		s.WriteString(styles.MutedStyle.Render("No gists"))
+	} else {
+		s.WriteString(m.renderGistList())
+		s.WriteString(styles.Separator(m.width - 4))
+		s.WriteString("\n")
+		s.WriteString(m.renderPreview())
+	}
+
+	if m.showHelp {
+		s.WriteString("\n")
+		s.WriteString(m.renderHelp())
+	}
+
+	s.WriteString("\n")
+	m.updateStatusBar()
+	s.WriteString(m.statusBar.View())
+
+	return s.String()
+}
+
+// renderHeader renders the view header
+func (m *GistView) renderHeader() string {
+	title := styles.HeaderStyle.Render("Gists")
+	count := styles.MutedStyle.Render(fmt.Sprintf("(%d)", len(m.gists)))
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, title, " ", count)
+}
+
+// listHeight returns the number of list rows, leaving the rest for the preview
+func (m *GistView) listHeight() int {
+	height := (m.height - 4) / 3
+	if height < 3 {
+		height = 3
+	}
+	return height
+}
+
+// renderGistList renders the visible part of the gist list
+func (m *GistView) renderGistList() string {
+	var s strings.Builder
+
+	availableHeight := m.listHeight()
+	startIdx := 0
+	endIdx := len(m.gists)
+	if endIdx > availableHeight {
+		startIdx = m.cursor - availableHeight/2
+		if startIdx < 0 {
+			startIdx = 0
+		}
+		endIdx = startIdx + availableHeight
+		if endIdx > len(m.gists) {
+			endIdx = len(m.gists)
+			startIdx = endIdx - availableHeight
+		}
+	}
+
+	for i := startIdx; i < endIdx; i++ {
+		s.WriteString(m.renderGistLine(m.gists[i], i))
+		s.WriteString("\n")
+	}
+
+	return s.String()
+}
+
+// renderGistLine renders a single gist line
+func (m *GistView) renderGistLine(gist *models.Gist, index int) string {
+	cursor := "  "
+	titleStyle := styles.IssueTitleStyle
+	if m.cursor == index {
+		cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
+		titleStyle = styles.SelectedStyle
+	}
+
+	title := gistTitle(gist)
+	maxTitleLen := m.width - 50
+	if maxTitleLen < 20 {
+		maxTitleLen = 20
+	}
+	if len(title) > maxTitleLen {
+		title = title[:maxTitleLen-3] + "..."
+	}
+
+	visibility := styles.MutedStyle.Render("secret")
+	if gist.Public {
+		visibility = styles.SuccessStyle.Render("public")
+	}
+
+	return lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		cursor,
+		titleStyle.Render(title),
+		"  ",
+		visibility,
+		"  ",
+		styles.MutedStyle.Render(fmt.Sprintf("%d files", len(gist.Files))),
+		"  ",
+		styles.DateStyle.Render(formatRelativeTime(gist.UpdatedAt)),
+	)
+}
+
+// renderPreview renders the files of the selected gist and the first file's contents
+func (m *GistView) renderPreview() string {
+	gist := m.selectedGist()
+	if gist == nil {
+		return ""
+	}
+	if err, ok := m.previewErrs[gist.ID]; ok {
+		return styles.ErrorStyle.Render(fmt.Sprintf("Failed to load preview: %v", err))
+	}
+	full, ok := m.previews[gist.ID]
+	if !ok {
+		return styles.LoadingStyle.Render("Loading preview...")
+	}
+	if len(full.Files) == 0 {
+		return styles.MutedStyle.Render("No files")
+	}
+
+	var names []string
+	for _, file := range full.Files {
+		names = append(names, file.Filename)
+	}
+	lines := []string{styles.BoldStyle.Render(strings.Join(names, ", "))}
+
+	first := full.Files[0]
+	content := strings.Split(strings.TrimRight(first.Content, "\n"), "\n")
+	available := m.height - m.listHeight() - 7
+	if m.showHelp {
+		available -= 10
+	}
+	if available < 3 {
+		available = 3
+	}
+	if len(content) > available {
+		hidden := len(content) - available + 1
+		content = append(content[:available-1], styles.MutedStyle.Render(fmt.Sprintf("… %d more lines", hidden)))
+	}
+	lines = append(lines, content...)
+
+	return strings.Join(lines, "\n")
+}
+
+// gistTitle returns the gist description, falling back to its first file name
+func gistTitle(gist *models.Gist) string {
+	if gist.Description != "" {
+		return gist.Description
+	}
+	if len(gist.Files) > 0 {
+		return gist.Files[0].Filename
+	}
+	return gist.ID
+}
+
+// renderHelp renders the help section
+func (m *GistView) renderHelp() string {
+	helpText := `
+Navigation:
+  ↑/k     Move up
+  ↓/j     Move down
+  g       Go to top
+  G       Go to bottom
+
+Actions:
+  n       New gist from a local file
+  y       Copy raw URL to clipboard
+  o       Open in browser
+  r       Refresh
+
+General:
+  ?       Toggle help
+  q       Quit
+  ctrl+c  Force quit
+  ctrl+z  Suspend (resume with fg)
+`
+
+	return styles.BorderStyle.Render(
+		styles.HelpStyle.Render(strings.TrimSpace(helpText)),
+	)
+}
+
+// updateStatusBar updates the status bar with current state
+func (m *GistView) updateStatusBar() {
+	m.statusBar.ClearItems()
+	m.statusBar.SetMode("Gists")
+
+	if len(m.gists) > 0 {
+		m.statusBar.AddItem("", fmt.Sprintf("%d/%d", m.cursor+1, len(m.gists)))
+	}
+}
+
+// IsCapturingInput returns true while the new gist form is open
+func (m *GistView) IsCapturingInput() bool {
+	return m.form.IsVisible()
+}
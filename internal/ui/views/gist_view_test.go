@@ -0,0 +1,159 @@
+package views
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/infra/readonly"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// testGistRepo serves canned gists and records created gists
+type testGistRepo struct {
+	gists   []*models.Gist
+	full    map[string]*models.Gist
+	gets    int
+	created *models.CreateGistInput
+}
+
+func (r *testGistRepo) List(ctx context.Context, opts *models.GistOptions) ([]*models.Gist, error) {
+	return r.gists, nil
+}
+
+func (r *testGistRepo) Get(ctx context.Context, id string) (*models.Gist, error) {
+	r.gets++
+	return r.full[id], nil
+}
+
+func (r *testGistRepo) Create(ctx context.Context, input *models.CreateGistInput) (*models.Gist, error) {
+	r.created = input
+	return &models.Gist{ID: "new", HTMLURL: "https://gist.github.com/new"}, nil
+}
+
+// testGistsUseCase lists gists straight from the repository
+type testGistsUseCase struct {
+	repo repository.GistRepository
+}
+
+func (u *testGistsUseCase) Execute(ctx context.Context, opts *models.GistOptions) ([]*models.Gist, error) {
+	return u.repo.List(ctx, opts)
+}
+
+func (u *testGistsUseCase) GetRepository() repository.GistRepository {
+	return u.repo
+}
+
+func newTestGistRepo() *testGistRepo {
+	updated := time.Now().Add(-time.Hour)
+	return &testGistRepo{
+		gists: []*models.Gist{
+			{ID: "a1", Description: "retry helper", Public: true, UpdatedAt: updated,
+				Files: []*models.GistFile{{Filename: "retry.go", RawURL: "https://gist.githubusercontent.com/raw/retry.go"}}},
+			{ID: "b2", UpdatedAt: updated, Files: []*models.GistFile{{Filename: "notes.md"}}},
+		},
+		full: map[string]*models.Gist{
+			"a1": {ID: "a1", Files: []*models.GistFile{{Filename: "retry.go", Content: "package retry\n\nfunc Do() {}\n"}}},
+			"b2": {ID: "b2", Files: []*models.GistFile{{Filename: "notes.md", Content: "# Review notes"}}},
+		},
+	}
+}
+
+// runGistCmd runs cmd and feeds its message back into the view
+func runGistCmd(view *GistView, cmd tea.Cmd) {
+	if cmd != nil {
+		view.Update(cmd())
+	}
+}
+
+func loadedGistView(t *testing.T, repo repository.GistRepository) *GistView {
+	t.Helper()
+	view := NewGistViewWithUseCase(&testGistsUseCase{repo: repo})
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	_, cmd := view.Update(view.Init()())
+	runGistCmd(view, cmd)
+	return view
+}
+
+func TestGistView_ListAndPreview(t *testing.T) {
+	repo := newTestGistRepo()
+	view := loadedGistView(t, repo)
+
+	out := view.View()
+	for _, want := range []string{"Gists", "retry helper", "public", "notes.md", "package retry"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in gist view\n%s", want, out)
+		}
+	}
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	runGistCmd(view, cmd)
+	if out := view.View(); !strings.Contains(out, "# Review notes") {
+		t.Errorf("expected the second gist's preview\n%s", out)
+	}
+
+	// Previews are cached
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("k")})
+	if cmd != nil || repo.gets != 2 {
+		t.Errorf("expected the cached preview to be reused, got %d fetches", repo.gets)
+	}
+}
+
+func TestGistView_CreateFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snippet.sh")
+	if err := os.WriteFile(path, []byte("echo hi\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	repo := newTestGistRepo()
+	view := loadedGistView(t, repo)
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if !view.IsCapturingInput() {
+		t.Fatal("expected the new gist form to open")
+	}
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(path)})
+	view.Update(tea.KeyMsg{Type: tea.KeyTab})
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("deploy")})
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view.Update(cmd())
+
+	if repo.created == nil || repo.created.Files["snippet.sh"] != "echo hi\n" || repo.created.Description != "deploy" || repo.created.Public {
+		t.Fatalf("created = %+v", repo.created)
+	}
+	if out := view.View(); !strings.Contains(out, "Created gist https://gist.github.com/new") {
+		t.Errorf("expected a created message\n%s", out)
+	}
+}
+
+func TestNewGistInput_Errors(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := newGistInput(filepath.Join(dir, "missing.txt"), "", false); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+
+	empty := filepath.Join(dir, "empty.txt")
+	if err := os.WriteFile(empty, []byte("\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newGistInput(empty, "", false); err == nil || !strings.Contains(err.Error(), "is empty") {
+		t.Errorf("expected an empty file error, got %v", err)
+	}
+}
+
+func TestGistView_GuestMode(t *testing.T) {
+	view := loadedGistView(t, readonly.NewGistRepository(newTestGistRepo()))
+
+	if out := view.View(); !strings.Contains(out, errGistsNeedToken.Error()) {
+		t.Errorf("expected the token message\n%s", out)
+	}
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if view.IsCapturingInput() {
+		t.Error("expected the form to stay closed in guest mode")
+	}
+}
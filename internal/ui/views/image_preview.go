@@ -0,0 +1,166 @@
+package views
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	_ "image/gif" // decoded to PNG for kitty
+	_ "image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// imageProtocol is a terminal graphics protocol images can be shown with
+type imageProtocol int
+
+const (
+	imageProtocolNone imageProtocol = iota
+	imageProtocolKitty
+	imageProtocolITerm2
+)
+
+// maxPreviewImageBytes caps the size of an image downloaded for a preview
+const maxPreviewImageBytes = 20 << 20
+
+// detectImageProtocol returns the graphics protocol of the terminal, from its
+// environment. tmux passes neither protocol through, so images open in the
+// browser there. Replaced in tests.
+var detectImageProtocol = func() imageProtocol {
+	if os.Getenv("TMUX") != "" {
+		return imageProtocolNone
+	}
+	switch {
+	case os.Getenv("TERM") == "xterm-kitty", os.Getenv("KITTY_WINDOW_ID") != "", os.Getenv("TERM_PROGRAM") == "ghostty":
+		return imageProtocolKitty
+	case os.Getenv("TERM_PROGRAM") == "iTerm.app", os.Getenv("TERM_PROGRAM") == "WezTerm", os.Getenv("LC_TERMINAL") == "iTerm2":
+		return imageProtocolITerm2
+	}
+	return imageProtocolNone
+}
+
+// fetchImage downloads an image for a preview (replaced in tests)
+var fetchImage = func(url string) ([]byte, error) {
+	client := &http.Client{Timeout: 20 * time.Second}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("downloading the image failed: %s", resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxPreviewImageBytes))
+}
+
+// showImage shows an image on the whole screen with the terminal's graphics
+// protocol until enter is pressed. Without a protocol, or when the image
+// cannot be downloaded (e.g. attachments of private repositories), it opens
+// in the browser instead.
+func showImage(img markdownImage) tea.Cmd {
+	protocol := detectImageProtocol()
+	if protocol == imageProtocolNone {
+		return openInBrowser(img.URL)
+	}
+	return func() tea.Msg {
+		data, err := fetchImage(img.URL)
+		if err != nil {
+			return openInBrowser(img.URL)()
+		}
+		preview := &imagePreview{img: img, data: data, protocol: protocol}
+		return tea.Exec(preview, func(err error) tea.Msg {
+			if err != nil {
+				return openInBrowser(img.URL)()
+			}
+			return nil
+		})()
+	}
+}
+
+// imagePreview writes an image to the terminal while the UI is suspended
+type imagePreview struct {
+	img      markdownImage
+	data     []byte
+	protocol imageProtocol
+	stdin    io.Reader
+	stdout   io.Writer
+}
+
+func (p *imagePreview) SetStdin(r io.Reader)  { p.stdin = r }
+func (p *imagePreview) SetStdout(w io.Writer) { p.stdout = w }
+func (p *imagePreview) SetStderr(io.Writer)   {}
+
+// Run shows the image and waits for enter
+func (p *imagePreview) Run() error {
+	sequence, err := imageSequence(p.protocol, p.data)
+	if err != nil {
+		return err
+	}
+	out := p.stdout
+	if out == nil {
+		out = os.Stdout
+	}
+	// Clear the screen and draw the image from the top left
+	fmt.Fprint(out, "\x1b[2J\x1b[H")
+	fmt.Fprint(out, sequence)
+	fmt.Fprintf(out, "\r\n%s  Press enter to return", p.img.placeholder())
+
+	in := p.stdin
+	if in == nil {
+		in = os.Stdin
+	}
+	_, err = bufio.NewReader(in).ReadString('\n')
+	if err == io.EOF {
+		return nil
+	}
+	return err
+}
+
+// imageSequence returns the escape sequence drawing the image with the protocol
+func imageSequence(protocol imageProtocol, data []byte) (string, error) {
+	switch protocol {
+	case imageProtocolITerm2:
+		// iTerm2 decodes the image itself
+		return fmt.Sprintf("\x1b]1337;File=inline=1;size=%d;preserveAspectRatio=1:%s\a",
+			len(data), base64.StdEncoding.EncodeToString(data)), nil
+
+	case imageProtocolKitty:
+		// kitty takes PNG as is; other formats are converted first
+		decoded, format, err := image.Decode(bytes.NewReader(data))
+		if err != nil {
+			return "", fmt.Errorf("unsupported image: %w", err)
+		}
+		if format != "png" {
+			var buf bytes.Buffer
+			if err := png.Encode(&buf, decoded); err != nil {
+				return "", err
+			}
+			data = buf.Bytes()
+		}
+		// The data is sent in chunks of at most 4096 bytes
+		encoded := base64.StdEncoding.EncodeToString(data)
+		var s strings.Builder
+		for first := true; first || encoded != ""; first = false {
+			chunk := encoded[:min(4096, len(encoded))]
+			encoded = encoded[len(chunk):]
+			more := 0
+			if encoded != "" {
+				more = 1
+			}
+			if first {
+				fmt.Fprintf(&s, "\x1b_Gf=100,a=T,m=%d;%s\x1b\\", more, chunk)
+			} else {
+				fmt.Fprintf(&s, "\x1b_Gm=%d;%s\x1b\\", more, chunk)
+			}
+		}
+		return s.String(), nil
+	}
+	return "", fmt.Errorf("the terminal cannot show images")
+}
@@ -0,0 +1,203 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// editorPurposeNewIssue tags the editor session drafting a new issue
+const editorPurposeNewIssue = "new-issue"
+
+// issueTemplatesLoadedMsg is sent when the repository's issue templates are loaded
+type issueTemplatesLoadedMsg struct {
+	templates []*models.IssueTemplate
+	err       error
+}
+
+// issueCreatedMsg is sent when a new issue has been submitted
+type issueCreatedMsg struct {
+	issue *models.Issue
+	err   error
+}
+
+// issueCreator holds the state of creating an issue: picking a template,
+// editing the draft and submitting it
+type issueCreator struct {
+	picking    bool
+	loading    bool
+	templates  []*models.IssueTemplate // the last entry (nil) is a blank issue
+	cursor     int
+	template   *models.IssueTemplate // chosen template while the draft is edited
+	editing    bool
+	submitting bool
+}
+
+// startCreateIssue loads the issue templates and opens the template picker
+func (m *IssueView) startCreateIssue() tea.Cmd {
+	if m.fetchIssuesUseCase == nil {
+		return nil
+	}
+	issueRepo := m.fetchIssuesUseCase.GetRepository()
+	if !canWrite(issueRepo) {
+		m.statusBar.SetMessage(readOnlyStatus)
+		return nil
+	}
+	if m.creator.editing || m.creator.submitting {
+		m.statusBar.SetMessage("An issue is already being created")
+		return nil
+	}
+
+	m.creator = issueCreator{picking: true, loading: true}
+	ctx := m.loads.Context()
+	owner, repo := m.owner, m.repo
+	return func() tea.Msg {
+		templates, err := issueRepo.ListTemplates(ctx, owner, repo)
+		return issueTemplatesLoadedMsg{templates: templates, err: err}
+	}
+}
+
+// handleTemplatesLoaded fills the picker, or goes straight to a blank draft
+// when the repository has no templates
+func (m *IssueView) handleTemplatesLoaded(msg issueTemplatesLoadedMsg) tea.Cmd {
+	if !m.creator.picking || !m.creator.loading {
+		return nil
+	}
+	if isCancelled(msg.err) {
+		m.creator = issueCreator{}
+		return nil
+	}
+	if msg.err != nil {
+		m.creator = issueCreator{}
+		m.statusBar.SetMessage(fmt.Sprintf("Failed to load issue templates: %v", msg.err))
+		return nil
+	}
+	if len(msg.templates) == 0 {
+		return m.editDraft(nil)
+	}
+	m.creator.loading = false
+	m.creator.templates = append(msg.templates, nil)
+	m.creator.cursor = 0
+	return nil
+}
+
+// handleTemplatePickerKey handles a key while the template picker is open
+func (m *IssueView) handleTemplatePickerKey(msg tea.KeyMsg) tea.Cmd {
+	if msg.Type == tea.KeyEnter {
+		if m.creator.loading || len(m.creator.templates) == 0 {
+			return nil
+		}
+		return m.editDraft(m.creator.templates[m.creator.cursor])
+	}
+
+	switch msg.String() {
+	case "esc", "q":
+		m.creator = issueCreator{}
+	case "j", "down":
+		if m.creator.cursor < len(m.creator.templates)-1 {
+			m.creator.cursor++
+		}
+	case "k", "up":
+		if m.creator.cursor > 0 {
+			m.creator.cursor--
+		}
+	}
+	return nil
+}
+
+// editDraft opens the editor with a draft prefilled from template (nil for a blank issue)
+func (m *IssueView) editDraft(template *models.IssueTemplate) tea.Cmd {
+	m.creator = issueCreator{template: template, editing: true}
+	m.statusBar.SetMessage("")
+	return openEditor(editorPurposeNewIssue, issueDraft(template))
+}
+
+// handleDraftEdited submits the edited draft as a new issue
+func (m *IssueView) handleDraftEdited(msg editorClosedMsg) tea.Cmd {
+	if !m.creator.editing {
+		return nil
+	}
+	template := m.creator.template
+	m.creator = issueCreator{}
+	if msg.err != nil {
+		m.statusBar.SetMessage(fmt.Sprintf("Failed to edit issue: %v", msg.err))
+		return nil
+	}
+
+	title, body := parseDraft(msg.text)
+	if title == "" {
+		m.statusBar.SetMessage("Issue not created (empty title)")
+		return nil
+	}
+
+	input := &models.CreateIssueInput{Title: title, Body: body}
+	if template != nil {
+		input.Labels = template.Labels
+		input.Assignees = template.Assignees
+	}
+	m.creator.submitting = true
+	m.statusBar.SetMessage("Creating issue...")
+	issueRepo := m.fetchIssuesUseCase.GetRepository()
+	ctx := m.loads.writeContext()
+	owner, repo := m.owner, m.repo
+	return func() tea.Msg {
+		issue, err := issueRepo.Create(ctx, owner, repo, input)
+		return issueCreatedMsg{issue: issue, err: err}
+	}
+}
+
+// handleIssueCreated reports the new issue and reloads the list
+func (m *IssueView) handleIssueCreated(msg issueCreatedMsg) tea.Cmd {
+	m.creator.submitting = false
+	if msg.err != nil {
+		m.statusBar.SetMessage(fmt.Sprintf("Failed to create issue: %v", msg.err))
+		return nil
+	}
+	m.statusBar.SetMessage(fmt.Sprintf("Created issue #%d", msg.issue.Number))
+	return m.refresh()
+}
+
+// issueDraft returns the text edited for a new issue: the title on the first
+// line, then a blank line and the body
+func issueDraft(template *models.IssueTemplate) string {
+	if template == nil {
+		return "\n\n"
+	}
+	return template.Title + "\n\n" + template.Body
+}
+
+// renderTemplatePicker renders the list of issue templates
+func (m *IssueView) renderTemplatePicker() string {
+	var s strings.Builder
+	s.WriteString(styles.HeaderStyle.Render("New issue"))
+	s.WriteString("\n\n")
+
+	if m.creator.loading {
+		s.WriteString(styles.LoadingStyle.Render("Loading issue templates..."))
+		s.WriteString("\n")
+		return s.String()
+	}
+
+	for i, template := range m.creator.templates {
+		name, about := "Blank issue", "Start from an empty title and body"
+		if template != nil {
+			name, about = template.Name, template.About
+		}
+		line := "  " + name
+		if i == m.creator.cursor {
+			line = styles.SelectedStyle.Render("> " + name)
+		}
+		if about != "" {
+			line += "  " + styles.MutedStyle.Render(about)
+		}
+		s.WriteString(line)
+		s.WriteString("\n")
+	}
+	s.WriteString("\n")
+	s.WriteString(styles.MutedStyle.Render("j/k: move  enter: edit in $EDITOR  esc: cancel"))
+	s.WriteString("\n")
+	return s.String()
+}
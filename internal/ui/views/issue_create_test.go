@@ -0,0 +1,125 @@
+package views
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/infra/readonly"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// templateIssueRepo serves issue templates and records created issues
+type templateIssueRepo struct {
+	repository.IssueRepository
+	templates []*models.IssueTemplate
+	created   *models.CreateIssueInput
+}
+
+func (r *templateIssueRepo) ListTemplates(ctx context.Context, owner, repo string) ([]*models.IssueTemplate, error) {
+	return r.templates, nil
+}
+
+func (r *templateIssueRepo) Create(ctx context.Context, owner, repo string, input *models.CreateIssueInput) (*models.Issue, error) {
+	r.created = input
+	return &models.Issue{Number: 42, Title: input.Title}, nil
+}
+
+// stubEditor replaces the external editor with edit for the duration of the test
+func stubEditor(t *testing.T, edit func(text string) string) *string {
+	t.Helper()
+	var drafted string
+	original := openEditor
+	openEditor = func(purpose, text string) tea.Cmd {
+		drafted = text
+		return func() tea.Msg {
+			return editorClosedMsg{purpose: purpose, text: edit(text)}
+		}
+	}
+	t.Cleanup(func() { openEditor = original })
+	return &drafted
+}
+
+func TestIssueView_CreateIssueFromTemplate(t *testing.T) {
+	repo := &templateIssueRepo{templates: []*models.IssueTemplate{
+		{Name: "Bug report", About: "Report a problem", Title: "[Bug] ", Labels: []string{"bug"}, Body: "### Steps\n"},
+		{Name: "Feature request", Body: "### Motivation\n"},
+	}}
+	drafted := stubEditor(t, func(text string) string {
+		return strings.Replace(text, "[Bug] ", "[Bug] Crash on start", 1) + "1. open the app\n"
+	})
+	view := loadedBatchIssueView(t, repo)
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	if !view.IsCapturingInput() {
+		t.Fatal("template picker should capture input")
+	}
+	view.Update(cmd())
+	if out := view.View(); !strings.Contains(out, "Bug report") || !strings.Contains(out, "Blank issue") {
+		t.Errorf("picker should list the templates and a blank issue:\n%s", out)
+	}
+
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if view.IsCapturingInput() {
+		t.Error("picker should close once a template is chosen")
+	}
+	if *drafted != "[Bug] \n\n### Steps\n" {
+		t.Errorf("draft %q", *drafted)
+	}
+	_, cmd = view.Update(cmd())
+	view.Update(cmd())
+
+	if repo.created == nil {
+		t.Fatal("issue was not created")
+	}
+	if repo.created.Title != "[Bug] Crash on start" || repo.created.Body != "### Steps\n1. open the app" {
+		t.Errorf("unexpected input %+v", repo.created)
+	}
+	if strings.Join(repo.created.Labels, ",") != "bug" {
+		t.Errorf("labels %v, want the template's labels", repo.created.Labels)
+	}
+	if !strings.Contains(view.View(), "Created issue #42") {
+		t.Error("status bar should report the new issue")
+	}
+}
+
+func TestIssueView_CreateIssueWithoutTemplates(t *testing.T) {
+	repo := &templateIssueRepo{}
+	drafted := stubEditor(t, func(text string) string { return "" })
+	view := loadedBatchIssueView(t, repo)
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	_, cmd = view.Update(cmd())
+	if view.IsCapturingInput() || cmd == nil {
+		t.Fatal("a repository without templates should open a blank draft")
+	}
+	if *drafted != "\n\n" {
+		t.Errorf("draft %q", *drafted)
+	}
+
+	view.Update(cmd())
+	if repo.created != nil {
+		t.Error("an empty title should cancel the issue")
+	}
+	if !strings.Contains(view.View(), "empty title") {
+		t.Error("status bar should explain why nothing was created")
+	}
+}
+
+func TestIssueView_CreateIssueReadOnly(t *testing.T) {
+	view := loadedBatchIssueView(t, readonly.NewIssueRepository(&templateIssueRepo{}))
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	if cmd != nil || view.IsCapturingInput() {
+		t.Error("guest sessions must not create issues")
+	}
+}
+
+func TestParseDraft(t *testing.T) {
+	title, body := parseDraft("\n  Title here  \r\n\r\nBody line\n\n")
+	if title != "Title here" || body != "Body line" {
+		t.Errorf("got %q, %q", title, body)
+	}
+}
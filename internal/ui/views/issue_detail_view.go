@@ -1,14 +1,14 @@
 package views
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/a1yama/tig-gh/internal/domain/models"
 	"github.com/a1yama/tig-gh/internal/domain/repository"
-	"github.com/a1yama/tig-gh/internal/ui/browser"
+	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/events"
 	"github.com/a1yama/tig-gh/internal/ui/styles"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/glamour"
@@ -24,6 +24,24 @@ type issueCommentsLoadedMsg struct {
 	err      error
 }
 
+// reactionAddedMsg is a message when a reaction has been added to a comment
+type reactionAddedMsg struct {
+	commentID int64
+	content   models.ReactionContent
+	err       error
+}
+
+// reactionChoices are the reactions offered by the picker, in key order (1, 2, 3).
+// The emoji are read when rendering, as they may be swapped for ASCII.
+var reactionChoices = []struct {
+	content models.ReactionContent
+	emoji   *string
+}{
+	{models.ReactionPlusOne, &styles.IconThumbsUp},
+	{models.ReactionHeart, &styles.IconHeart},
+	{models.ReactionRocket, &styles.IconRocket},
+}
+
 // IssueDetailView is the model for the issue detail view
 type IssueDetailView struct {
 	issue           *models.Issue
@@ -39,12 +57,26 @@ type IssueDetailView struct {
 	width           int
 	height          int
 	renderer        *glamour.TermRenderer
+	statusMessage   string
+	refreshing      bool
+	liveNotice      string // shown once the reload started by a live update is done
+	selectedComment int
+	pickingReaction bool
+	showTimeline    bool
+	timeline        timeline
+	refs            crossRefCursor
+	graph           issueGraphState
+	images          imageCursor
+	showRepo        bool // opened from a reference to another repository
+	full            fullFetch
+	loads           loadGroup
 }
 
 // NewIssueDetailView creates a new issue detail view
 func NewIssueDetailView(issue *models.Issue, owner, repo string, issueRepo repository.IssueRepository) *IssueDetailView {
 	commentsLoading := issueRepo != nil
 	return &IssueDetailView{
+		loads:           loadGroup{source: sourceIssueDetail},
 		issue:           issue,
 		owner:           owner,
 		repo:            repo,
@@ -53,20 +85,32 @@ func NewIssueDetailView(issue *models.Issue, owner, repo string, issueRepo repos
 		loading:         false,
 		commentsLoading: commentsLoading,
 		renderer:        newMarkdownRenderer(80),
+		refs:            newCrossRefCursor(),
+		images:          newImageCursor(),
 	}
 }
 
 // Init initializes the issue detail view
 func (m *IssueDetailView) Init() tea.Cmd {
 	if m.issueRepo != nil {
-		return m.loadComments()
+		cmds := []tea.Cmd{m.loadComments(), m.loadFull(), m.full.start()}
+		if m.showTimeline {
+			cmds = append(cmds, m.loadTimeline(false))
+		}
+		return tea.Batch(cmds...)
 	}
 	m.commentsLoading = false
 	return nil
 }
 
+// Close cancels the fetches still in flight when the view is closed
+func (m *IssueDetailView) Close() {
+	m.loads.Cancel()
+}
+
 // loadComments loads comments for the issue
 func (m *IssueDetailView) loadComments() tea.Cmd {
+	ctx := m.loads.Context()
 	return func() tea.Msg {
 		if m.issueRepo == nil {
 			return issueCommentsLoadedMsg{
@@ -76,7 +120,7 @@ func (m *IssueDetailView) loadComments() tea.Cmd {
 		}
 
 		comments, err := m.issueRepo.ListComments(
-			context.Background(),
+			ctx,
 			m.owner,
 			m.repo,
 			m.issue.Number,
@@ -90,24 +134,220 @@ func (m *IssueDetailView) loadComments() tea.Cmd {
 	}
 }
 
+// loadFull fetches the complete issue in the background; search results and
+// other lists may leave out its body and reactions
+func (m *IssueDetailView) loadFull() tea.Cmd {
+	ctx := m.loads.Context()
+	return func() tea.Msg {
+		issue, err := m.issueRepo.Get(ctx, m.owner, m.repo, m.issue.Number)
+		return issueFullLoadedMsg{issue: issue, err: err}
+	}
+}
+
+// loadTimeline loads the issue's events, bypassing the cache when fresh
+func (m *IssueDetailView) loadTimeline(fresh bool) tea.Cmd {
+	ctx := m.loads.Context()
+	if fresh {
+		ctx = freshContext(ctx)
+	}
+	return m.timeline.load(ctx, m.issueRepo, m.owner, m.repo, m.issue.Number)
+}
+
+// refresh refetches the issue itself and its comments, bypassing the cache
+func (m *IssueDetailView) refresh() tea.Cmd {
+	parent := m.loads.Context()
+	return func() tea.Msg {
+		if m.issueRepo == nil {
+			return issueRefreshedMsg{err: fmt.Errorf("issue repository not available")}
+		}
+
+		ctx := freshContext(parent)
+		issue, err := m.issueRepo.Get(ctx, m.owner, m.repo, m.issue.Number)
+		if err != nil {
+			return issueRefreshedMsg{err: err}
+		}
+
+		comments, err := m.issueRepo.ListComments(ctx, m.owner, m.repo, m.issue.Number, nil)
+		return issueRefreshedMsg{issue: issue, comments: comments, err: err}
+	}
+}
+
+// applyLiveUpdate reloads the issue when someone changed it. While a reload
+// or an input is in progress the change is only reported.
+func (m *IssueDetailView) applyLiveUpdate(msg LiveUpdateMsg) tea.Cmd {
+	event := msg.latestFor(m.owner, m.repo, m.issue.Number)
+	if event == nil || m.issueRepo == nil {
+		return nil
+	}
+	if m.refreshing || m.IsCapturingInput() {
+		m.statusMessage = liveStatus(event) + " (R to reload)"
+		return nil
+	}
+	m.refreshing = true
+	m.liveNotice = liveStatus(event)
+	m.timeline.invalidate()
+	if m.showTimeline {
+		return tea.Batch(m.refresh(), m.loadTimeline(true))
+	}
+	return m.refresh()
+}
+
+// addReaction adds a reaction to the given comment
+func (m *IssueDetailView) addReaction(commentID int64, content models.ReactionContent) tea.Cmd {
+	return func() tea.Msg {
+		if m.issueRepo == nil {
+			return reactionAddedMsg{err: fmt.Errorf("issue repository not available")}
+		}
+
+		err := m.issueRepo.AddReaction(m.loads.writeContext(), m.owner, m.repo, m.issue.Number, commentID, content)
+		return reactionAddedMsg{commentID: commentID, content: content, err: err}
+	}
+}
+
+// openCrossRef fetches the selected reference; the issues API also returns
+// pull requests, which are shown with their conversation
+func (m *IssueDetailView) openCrossRef() tea.Cmd {
+	ref, ok := m.refs.current()
+	if !ok {
+		return nil
+	}
+	return m.openRef(ref)
+}
+
+// openRef fetches a referenced issue or pull request; the hosting view opens
+// it in place of this issue
+func (m *IssueDetailView) openRef(ref crossRef) tea.Cmd {
+	if m.issueRepo == nil {
+		m.statusMessage = "Cannot open " + ref.label(m.owner, m.repo) + " here"
+		return nil
+	}
+	m.statusMessage = "Opening " + ref.label(m.owner, m.repo) + "..."
+	ctx := m.loads.Context()
+	return func() tea.Msg {
+		issue, err := m.issueRepo.Get(ctx, ref.Owner, ref.Repo, ref.Number)
+		return crossRefLoadedMsg{ref: ref, issue: issue, err: err}
+	}
+}
+
+// refTexts returns the markdown the references are taken from, in display order
+func (m *IssueDetailView) refTexts() []string {
+	texts := []string{m.issue.Body}
+	for _, comment := range m.comments {
+		texts = append(texts, comment.Body)
+	}
+	return texts
+}
+
 // Update handles messages
 func (m *IssueDetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.pickingReaction {
+			return m.handleReactionKey(msg)
+		}
+		if m.graph.active {
+			return m.handleIssueGraphKey(msg)
+		}
 		return m.handleKeyPress(msg)
 
+	case reactionAddedMsg:
+		if msg.err != nil {
+			m.statusMessage = fmt.Sprintf("Reaction failed: %v", msg.err)
+			return m, nil
+		}
+		for _, comment := range m.comments {
+			if comment.ID == msg.commentID {
+				incrementReaction(&comment.Reactions, msg.content)
+			}
+		}
+		m.statusMessage = "Reacted " + reactionEmoji(msg.content)
+		return m, nil
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
 		return m, nil
 
+	case openBrowserMsg:
+		m.statusMessage = browserStatusMessage(msg)
+		return m, nil
+
+	case crossRefLoadedMsg:
+		// Opened references are handled by the hosting view; only failures get here
+		if msg.err != nil && !isCancelled(msg.err) {
+			m.statusMessage = crossRefFailedStatus(msg, m.owner, m.repo)
+		}
+		return m, nil
+
+	case LiveUpdateMsg:
+		return m, m.applyLiveUpdate(msg)
+
+	case events.EntityChanged:
+		// Another view changed this issue
+		if msg.Issue != nil && msg.Issue.Number == m.issue.Number && msg.Matches(m.owner, m.repo) {
+			m.issue = msg.Issue
+		}
+		return m, nil
+
+	case timelineLoadedMsg:
+		m.timeline.update(msg)
+		return m, nil
+
+	case issueGraphLoadedMsg:
+		m.handleIssueGraphLoaded(msg)
+		return m, nil
+
+	case components.ShimmerTickMsg:
+		return m, m.full.tick()
+
+	case issueFullLoadedMsg:
+		if isCancelled(msg.err) || !m.full.loading {
+			// Left to open a reference, or already replaced by a reload
+			return m, nil
+		}
+		m.full.done()
+		if msg.issue == nil {
+			// The fields the list left out stay as they came
+			m.statusMessage = fmt.Sprintf("Some details are unavailable: %v", msg.err)
+			return m, nil
+		}
+		m.issue = msg.issue
+		return m, events.Publish(events.IssueChanged(events.ActionUpdated, m.owner, m.repo, msg.issue))
+
+	case issueRefreshedMsg:
+		m.refreshing = false
+		notice := m.liveNotice
+		m.liveNotice = ""
+		if msg.issue == nil {
+			m.statusMessage = fmt.Sprintf("Reload failed: %v", msg.err)
+			return m, nil
+		}
+		m.full.done()
+		m.issue = msg.issue
+		if msg.err != nil {
+			m.commentsErr = msg.err
+		} else {
+			m.commentsErr = nil
+			m.comments = msg.comments
+			m.clampSelectedComment()
+		}
+		m.statusMessage = "Reloaded"
+		if notice != "" {
+			m.statusMessage = notice
+		}
+		return m, events.Publish(events.IssueChanged(events.ActionUpdated, m.owner, m.repo, msg.issue))
+
 	case issueCommentsLoadedMsg:
+		if staleLoad(msg.err) {
+			return m, nil
+		}
 		m.commentsLoading = false
 		if msg.err != nil {
 			m.commentsErr = msg.err
 		} else {
 			m.commentsErr = nil
 			m.comments = msg.comments
+			m.clampSelectedComment()
 		}
 		return m, nil
 	}
@@ -150,14 +390,158 @@ func (m *IssueDetailView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case "o":
-		// Open in browser
-		_ = browser.Open(m.issue.HTMLURL)
+		// Open the selected image, or else the issue, in browser
+		if img, ok := m.images.current(); ok {
+			return m, openInBrowser(img.URL)
+		}
+		return m, openInBrowser(m.issue.HTMLURL)
+
+	case "i":
+		// Select the next image in the issue or its comments
+		if m.images.cycle(m.refTexts(), 1) {
+			m.statusMessage = m.images.status()
+		} else {
+			m.statusMessage = "No images"
+		}
+		return m, nil
+
+	case "I":
+		// Show the selected image in the terminal, or in the browser
+		img, ok := m.images.current()
+		if !ok {
+			m.statusMessage = "Select an image with i first"
+			return m, nil
+		}
+		m.statusMessage = "Loading " + img.placeholder() + "..."
+		return m, showImage(img)
+
+	case "n":
+		// Select next comment
+		if m.selectedComment < len(m.comments)-1 {
+			m.selectedComment++
+		}
+		m.statusMessage = m.selectedCommentStatus()
+		return m, nil
+
+	case "N":
+		// Select previous comment
+		if m.selectedComment > 0 {
+			m.selectedComment--
+		}
+		m.statusMessage = m.selectedCommentStatus()
+		return m, nil
+
+	case "+":
+		// React to the selected comment
+		if m.issueRepo != nil && !canWrite(m.issueRepo) {
+			m.statusMessage = readOnlyStatus
+			return m, nil
+		}
+		if m.issueRepo != nil && len(m.comments) > 0 {
+			m.pickingReaction = true
+			m.statusMessage = m.reactionPrompt()
+		}
+		return m, nil
+
+	case "tab", "shift+tab":
+		// Select the next (previous) reference to an issue or pull request
+		delta := 1
+		if msg.String() == "shift+tab" {
+			delta = -1
+		}
+		m.images.clear()
+		m.refs.cycle(m.refTexts(), m.owner, m.repo, m.issue.Number, delta)
+		m.statusMessage = m.refs.status(m.owner, m.repo)
+		return m, nil
+
+	case "enter":
+		// Open the selected reference in place of this issue
+		return m, m.openCrossRef()
+
+	case "t":
+		// Toggle between the comments and the timeline of all events
+		m.showTimeline = !m.showTimeline
+		if m.showTimeline {
+			return m, m.loadTimeline(false)
+		}
+		return m, nil
+
+	case "L":
+		// Show how the issue connects to other issues and pull requests
+		return m, m.toggleIssueGraph()
+
+	case "R":
+		// Reload the issue itself (state, labels, ...) and its comments
+		if m.issueRepo != nil && !m.refreshing {
+			m.refreshing = true
+			m.statusMessage = "Reloading..."
+			m.timeline.invalidate()
+			if m.showTimeline {
+				return m, tea.Batch(m.refresh(), m.loadTimeline(true))
+			}
+			return m, m.refresh()
+		}
 		return m, nil
 	}
 
 	return m, nil
 }
 
+// handleReactionKey handles input while the reaction picker is open
+func (m *IssueDetailView) handleReactionKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+	if key == "ctrl+c" {
+		return m, tea.Quit
+	}
+
+	m.pickingReaction = false
+	for i, choice := range reactionChoices {
+		if key == fmt.Sprintf("%d", i+1) {
+			comment := m.comments[m.selectedComment]
+			m.statusMessage = "Reacting " + *choice.emoji + "..."
+			return m, m.addReaction(comment.ID, choice.content)
+		}
+	}
+
+	m.statusMessage = ""
+	return m, nil
+}
+
+// reactionPrompt describes the reaction picker keys
+func (m *IssueDetailView) reactionPrompt() string {
+	parts := make([]string, 0, len(reactionChoices))
+	for i, choice := range reactionChoices {
+		parts = append(parts, fmt.Sprintf("%d %s", i+1, *choice.emoji))
+	}
+	return fmt.Sprintf("React to @%s's comment: %s (other key to cancel)",
+		m.comments[m.selectedComment].User.Login, strings.Join(parts, "  "))
+}
+
+// selectedCommentStatus describes the selected comment
+func (m *IssueDetailView) selectedCommentStatus() string {
+	if len(m.comments) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Comment %d/%d by @%s", m.selectedComment+1, len(m.comments),
+		m.comments[m.selectedComment].User.Login)
+}
+
+// clampSelectedComment keeps the selection within the loaded comments
+func (m *IssueDetailView) clampSelectedComment() {
+	if m.selectedComment >= len(m.comments) {
+		m.selectedComment = len(m.comments) - 1
+	}
+	if m.selectedComment < 0 {
+		m.selectedComment = 0
+	}
+}
+
+// IsCapturingInput returns true while the reaction picker is waiting for a
+// choice or the issue graph is shown, which q and esc close first
+func (m *IssueDetailView) IsCapturingInput() bool {
+	return m.pickingReaction || m.graph.active
+}
+
 // View renders the issue detail view
 func (m *IssueDetailView) View() string {
 	if m.width == 0 || m.height == 0 {
@@ -172,6 +556,13 @@ func (m *IssueDetailView) View() string {
 		return m.renderError()
 	}
 
+	if m.graph.active {
+		graph, selectedLine := m.renderIssueGraph()
+		header := m.renderHeader() + "\n\n"
+		m.keepGraphSelectionVisible(strings.Count(header, "\n") + selectedLine)
+		return m.applyScrolling(header+graph) + "\n" + m.issueGraphFooter()
+	}
+
 	// Build the full content first
 	var content strings.Builder
 
@@ -190,9 +581,20 @@ func (m *IssueDetailView) View() string {
 	// Body (without internal scrolling)
 	content.WriteString(m.renderBodyContent())
 	content.WriteString("\n\n")
+	if reactions := m.full.value(renderReactions(m.issue.Reactions), 12); reactions != "" {
+		content.WriteString(reactions)
+		content.WriteString("\n\n")
+	}
 
-	// Comments
-	if len(m.comments) > 0 {
+	// Comments, or the timeline with comments interleaved
+	if m.showTimeline {
+		if m.issueRepo == nil {
+			content.WriteString(styles.MutedStyle.Render("The timeline is not available here."))
+		} else {
+			content.WriteString(renderTimeline(&m.timeline, m.comments, m.renderer, m.owner, m.repo))
+		}
+		content.WriteString("\n\n")
+	} else if len(m.comments) > 0 {
 		content.WriteString(m.renderComments())
 		content.WriteString("\n\n")
 	} else if m.commentsLoading {
@@ -222,7 +624,16 @@ func (m *IssueDetailView) View() string {
 func (m *IssueDetailView) renderHeader() string {
 	// Issue number and state
 	numberStyle := styles.IssueNumberStyle
-	number := numberStyle.Render(fmt.Sprintf("Issue #%d", m.issue.Number))
+	kind := "Issue"
+	if strings.Contains(m.issue.HTMLURL, "/pull/") {
+		// A pull request opened from a reference, shown with its conversation
+		kind = "Pull request"
+	}
+	numberText := fmt.Sprintf("%s #%d", kind, m.issue.Number)
+	if m.showRepo {
+		numberText = m.owner + "/" + m.repo + " " + numberText
+	}
+	number := numberStyle.Render(numberText)
 
 	stateBadge := styles.GetStateBadge(string(m.issue.State))
 
@@ -303,11 +714,14 @@ func (m *IssueDetailView) renderMetadata() string {
 // renderBodyContent renders the issue body with markdown (without scrolling)
 func (m *IssueDetailView) renderBodyContent() string {
 	if m.issue.Body == "" {
+		if m.full.loading {
+			return m.full.value("", 24)
+		}
 		return styles.MutedStyle.Render("No description provided.")
 	}
 
 	// Render markdown
-	rendered, err := m.renderer.Render(m.issue.Body)
+	rendered, err := m.renderer.Render(withImagePlaceholders(m.issue.Body))
 	if err != nil {
 		// Fallback to plain text if rendering fails
 		return m.issue.Body
@@ -367,10 +781,37 @@ func (m *IssueDetailView) renderFooter() string {
 	helpItems := []string{
 		styles.FormatKeyBinding("j/k", "scroll"),
 		styles.FormatKeyBinding("o", "open in browser"),
+		styles.FormatKeyBinding("n/N", "select comment"),
+		styles.FormatKeyBinding("tab/enter", "follow reference"),
+		styles.FormatKeyBinding("t", m.timelineHelp()),
+	}
+	if m.issueRepo != nil {
+		helpItems = append(helpItems, styles.FormatKeyBinding("L", "issue graph"))
+	}
+	if hasMarkdownImages(m.refTexts()) {
+		helpItems = append(helpItems, styles.FormatKeyBinding("i/I", "select/show image"))
+	}
+	if canWrite(m.issueRepo) {
+		helpItems = append(helpItems, styles.FormatKeyBinding("+", "react"))
+	}
+	helpItems = append(helpItems,
+		styles.FormatKeyBinding("R", "reload"),
 		styles.FormatKeyBinding("q", "back"),
+	)
+
+	footer := styles.HelpStyle.Render(strings.Join(helpItems, " • "))
+	if m.statusMessage != "" {
+		return styles.MutedStyle.Render(m.statusMessage) + "\n" + footer
 	}
+	return footer
+}
 
-	return styles.HelpStyle.Render(strings.Join(helpItems, " • "))
+// timelineHelp describes what the t key does
+func (m *IssueDetailView) timelineHelp() string {
+	if m.showTimeline {
+		return "comments"
+	}
+	return "timeline"
 }
 
 // renderLoading renders a loading state
@@ -415,12 +856,16 @@ func (m *IssueDetailView) renderComments() string {
 		author := authorStyle.Render(comment.User.Login)
 		timeStr := styles.MutedStyle.Render(formatTime(comment.CreatedAt))
 
-		s.WriteString(fmt.Sprintf("%s commented %s", author, timeStr))
+		marker := "  "
+		if i == m.selectedComment {
+			marker = styles.CursorStyle.Render(styles.IconCursor + " ")
+		}
+		s.WriteString(fmt.Sprintf("%s%s commented %s", marker, author, timeStr))
 		s.WriteString("\n\n")
 
 		// Comment body (with markdown rendering)
 		if m.renderer != nil && comment.Body != "" {
-			rendered, err := m.renderer.Render(comment.Body)
+			rendered, err := m.renderer.Render(withImagePlaceholders(comment.Body))
 			if err == nil {
 				s.WriteString(rendered)
 			} else {
@@ -429,7 +874,67 @@ func (m *IssueDetailView) renderComments() string {
 		} else {
 			s.WriteString(comment.Body)
 		}
+
+		// Reactions
+		if reactions := renderReactions(comment.Reactions); reactions != "" {
+			s.WriteString("\n")
+			s.WriteString(reactions)
+			s.WriteString("\n")
+		}
 	}
 
 	return s.String()
 }
+
+// renderReactions renders the counts of the reactions offered by the picker
+func renderReactions(r models.Reactions) string {
+	var parts []string
+	for _, choice := range reactionChoices {
+		if count := reactionCount(r, choice.content); count > 0 {
+			parts = append(parts, fmt.Sprintf("%s %d", *choice.emoji, count))
+		}
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return styles.MutedStyle.Render(strings.Join(parts, "  "))
+}
+
+// reactionCount returns the count for a reaction type
+func reactionCount(r models.Reactions, content models.ReactionContent) int {
+	switch content {
+	case models.ReactionPlusOne:
+		return r.PlusOne
+	case models.ReactionHeart:
+		return r.Heart
+	case models.ReactionRocket:
+		return r.Rocket
+	default:
+		return 0
+	}
+}
+
+// incrementReaction records a reaction added locally
+func incrementReaction(r *models.Reactions, content models.ReactionContent) {
+	switch content {
+	case models.ReactionPlusOne:
+		r.PlusOne++
+	case models.ReactionHeart:
+		r.Heart++
+	case models.ReactionRocket:
+		r.Rocket++
+	default:
+		return
+	}
+	r.TotalCount++
+}
+
+// reactionEmoji returns the emoji for a reaction type
+func reactionEmoji(content models.ReactionContent) string {
+	for _, choice := range reactionChoices {
+		if choice.content == content {
+			return *choice.emoji
+		}
+	}
+	return string(content)
+}
@@ -1,12 +1,14 @@
 package views
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -322,3 +324,61 @@ func contains(s, substr string) bool {
 	}
 	return false
 }
+
+type reactionIssueRepo struct {
+	repository.IssueRepository
+	commentID int64
+	content   models.ReactionContent
+}
+
+func (r *reactionIssueRepo) AddReaction(ctx context.Context, owner, repo string, number int, commentID int64, content models.ReactionContent) error {
+	r.commentID = commentID
+	r.content = content
+	return nil
+}
+
+func TestIssueDetailView_AddReactionToSelectedComment(t *testing.T) {
+	repo := &reactionIssueRepo{}
+	view := NewIssueDetailView(&models.Issue{Number: 1, Title: "Bug"}, "owner", "repo", repo)
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	view.Update(issueCommentsLoadedMsg{comments: []*models.Comment{
+		{ID: 10, User: models.User{Login: "alice"}, Body: "first"},
+		{ID: 20, User: models.User{Login: "bob"}, Body: "second", Reactions: models.Reactions{PlusOne: 2, TotalCount: 2}},
+	}})
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("+")})
+	if !view.IsCapturingInput() {
+		t.Fatal("expected reaction picker to be open")
+	}
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	if cmd == nil {
+		t.Fatal("expected reaction command")
+	}
+	view.Update(cmd())
+
+	if repo.commentID != 20 || repo.content != models.ReactionPlusOne {
+		t.Fatalf("expected +1 on comment 20, got %d %q", repo.commentID, repo.content)
+	}
+	if view.comments[1].Reactions.PlusOne != 3 {
+		t.Errorf("expected local count to be incremented, got %d", view.comments[1].Reactions.PlusOne)
+	}
+	if !strings.Contains(view.renderComments(), "👍 3") {
+		t.Error("expected reaction count to be rendered")
+	}
+}
+
+func TestIssueDetailView_ReactionPickerCancel(t *testing.T) {
+	view := NewIssueDetailView(&models.Issue{Number: 1}, "owner", "repo", &reactionIssueRepo{})
+	view.Update(issueCommentsLoadedMsg{comments: []*models.Comment{{ID: 1}}})
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("+")})
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd != nil {
+		t.Error("expected esc to cancel the picker without going back")
+	}
+	if view.IsCapturingInput() {
+		t.Error("expected picker to be closed")
+	}
+}
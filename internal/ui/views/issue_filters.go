@@ -0,0 +1,135 @@
+package views
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// openFilterModal shows the filters (state, labels, sort) of the issue list.
+// The labels offered are those of the loaded issues plus the selected ones.
+func (m *IssueView) openFilterModal() {
+	if m.filterModal == nil {
+		m.filterModal = components.NewFilterModal()
+	}
+	m.filterModal.SetSize(m.width, m.height)
+	m.filterModal.SetLabels(issueLabelNames(m.issues, m.filterLabels))
+	m.filterModal.ApplyOptions(&models.IssueOptions{
+		State:     m.filterState,
+		Labels:    m.filterLabels,
+		Sort:      m.sortField,
+		Direction: m.sortDirection,
+	})
+	m.filterModal.Show()
+}
+
+// handleFilterKey forwards a key to the filter modal and applies the filters once it is closed
+func (m *IssueView) handleFilterKey(msg tea.KeyMsg) tea.Cmd {
+	if msg.String() == "ctrl+c" {
+		return tea.Quit
+	}
+	m.filterModal.Update(msg)
+	if m.filterModal.IsVisible() {
+		return nil
+	}
+	return m.applyFilters(m.filterModal.GetOptions())
+}
+
+// applyFilters refetches the issues when the state or labels changed, and
+// otherwise re-sorts the loaded issues
+func (m *IssueView) applyFilters(opts *models.IssueOptions) tea.Cmd {
+	labels := append([]string(nil), opts.Labels...)
+	sort.Strings(labels)
+	refetch := opts.State != m.filterState || strings.Join(labels, ",") != strings.Join(m.filterLabels, ",") ||
+		serverSorted(opts.Sort) != serverSorted(m.sortField) ||
+		(serverSorted(opts.Sort) && (opts.Sort != m.sortField || opts.Direction != m.sortDirection))
+
+	m.filterState = opts.State
+	m.filterLabels = labels
+	m.sortField = opts.Sort
+	m.sortDirection = opts.Direction
+	if refetch {
+		return m.refresh()
+	}
+	m.issues = sortIssuesBy(m.issues, m.sortField, m.sortDirection)
+	return nil
+}
+
+// serverSorted reports whether the issues API sorts by the field; other
+// fields sort the loaded issues
+func serverSorted(field models.IssueSort) bool {
+	return field != models.IssueSortReactions
+}
+
+// sortIssuesBy orders issues by the field, ties broken by number (newest first)
+func sortIssuesBy(issues []*models.Issue, field models.IssueSort, direction models.SortDirection) []*models.Issue {
+	key := func(issue *models.Issue) int64 {
+		switch field {
+		case models.IssueSortCreated:
+			return issue.CreatedAt.UnixNano()
+		case models.IssueSortComments:
+			return int64(issue.Comments)
+		case models.IssueSortReactions:
+			return int64(issue.Reactions.PlusOne)
+		default:
+			return issue.UpdatedAt.UnixNano()
+		}
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		left, right := key(issues[i]), key(issues[j])
+		if left != right {
+			if direction == models.SortDirectionAsc {
+				return left < right
+			}
+			return left > right
+		}
+		return issues[i].Number > issues[j].Number
+	})
+	return issues
+}
+
+// issueLabelNames returns the label names used by the issues and the selected
+// labels, sorted without duplicates
+func issueLabelNames(issues []*models.Issue, selected []string) []string {
+	seen := make(map[string]bool)
+	var names []string
+	add := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	for _, issue := range issues {
+		for _, label := range issue.Labels {
+			add(label.Name)
+		}
+	}
+	for _, name := range selected {
+		add(name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// filterSummary describes the filters that differ from the defaults for the status bar
+func (m *IssueView) filterSummary() string {
+	parts := []string{string(m.filterState)}
+	if len(m.filterLabels) > 0 {
+		parts = append(parts, strings.Join(m.filterLabels, "+"))
+	}
+	if m.sortField == models.IssueSortReactions {
+		parts = append(parts, "by "+styles.IconThumbsUp)
+	} else if m.sortField != models.IssueSortUpdated {
+		parts = append(parts, "by "+string(m.sortField))
+	}
+	if m.sortDirection == models.SortDirectionAsc {
+		parts = append(parts, "asc")
+	}
+	return fmt.Sprintf("Issues (%s)", strings.Join(parts, ", "))
+}
@@ -0,0 +1,76 @@
+package views
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestSortIssuesBy_Reactions(t *testing.T) {
+	now := time.Now()
+	issues := []*models.Issue{
+		{Number: 1, UpdatedAt: now, Reactions: models.Reactions{PlusOne: 2}},
+		{Number: 2, UpdatedAt: now, Reactions: models.Reactions{PlusOne: 9}},
+		{Number: 3, UpdatedAt: now},
+		{Number: 4, UpdatedAt: now, Reactions: models.Reactions{PlusOne: 2}},
+	}
+
+	sorted := sortIssuesBy(issues, models.IssueSortReactions, models.SortDirectionDesc)
+	want := []int{2, 4, 1, 3}
+	for i, number := range want {
+		if sorted[i].Number != number {
+			t.Fatalf("position %d: expected #%d, got #%d", i, number, sorted[i].Number)
+		}
+	}
+
+	sorted = sortIssuesBy(issues, models.IssueSortReactions, models.SortDirectionAsc)
+	if sorted[0].Number != 3 || sorted[3].Number != 2 {
+		t.Fatalf("expected fewest reactions first when ascending, got #%d ... #%d", sorted[0].Number, sorted[3].Number)
+	}
+}
+
+func TestIssueView_FilterModalSortsByReactions(t *testing.T) {
+	var requested *models.IssueOptions
+	view := NewIssueViewWithUseCase(&mockFetchIssuesUseCase{
+		executeFunc: func(ctx context.Context, owner, repo string, opts *models.IssueOptions) ([]*models.Issue, error) {
+			requested = opts
+			return []*models.Issue{
+				{Number: 1, Title: "quiet", State: models.IssueStateOpen},
+				{Number: 2, Title: "popular", State: models.IssueStateOpen, Reactions: models.Reactions{PlusOne: 12}},
+			}, nil
+		},
+	}, "owner", "repo")
+	view.loading = false
+	view.width, view.height = 120, 40
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'F'}})
+	if view.filterModal == nil || !view.filterModal.IsVisible() {
+		t.Fatal("expected F to open the filter modal")
+	}
+	if !view.IsCapturingInput() {
+		t.Error("expected the filter modal to capture input")
+	}
+
+	view.filterModal.SetSort(models.IssueSortReactions)
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd == nil {
+		t.Fatal("expected closing the modal with a new sort to refetch")
+	}
+	view.Update(cmd())
+
+	if requested == nil || requested.Sort != models.IssueSortReactions {
+		t.Fatalf("expected the fetch to carry the reactions sort, got %+v", requested)
+	}
+	if len(view.issues) != 2 || view.issues[0].Number != 2 {
+		t.Fatalf("expected the most upvoted issue first, got %+v", view.issues)
+	}
+
+	out := view.View()
+	if !strings.Contains(out, "👍 12") {
+		t.Errorf("expected the row to show the thumbs-up count, got:\n%s", out)
+	}
+}
@@ -0,0 +1,273 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// issueGraphState is the graph mode of the issue detail view, which shows
+// how the focused issue connects to others. Moving into a neighbour focuses
+// the graph on it; the issues focused before are kept to go back to.
+type issueGraphState struct {
+	active   bool
+	loading  bool
+	err      error
+	focus    models.IssueGraphNode
+	graph    *models.IssueGraph
+	edges    []*models.IssueRelation // the edges in display order
+	selected int
+	history  []models.IssueGraphNode // the issues focused before, oldest first
+}
+
+// issueGraphLoadedMsg carries the graph of an issue
+type issueGraphLoadedMsg struct {
+	node  models.IssueGraphNode
+	graph *models.IssueGraph
+	err   error
+}
+
+// toggleIssueGraph opens the graph focused on the issue being viewed, or closes it
+func (m *IssueDetailView) toggleIssueGraph() tea.Cmd {
+	if m.graph.active {
+		m.graph.active = false
+		m.scrollOffset = 0
+		return nil
+	}
+	if m.issueRepo == nil {
+		m.statusMessage = "The issue graph is not available here"
+		return nil
+	}
+	m.graph = issueGraphState{active: true}
+	m.scrollOffset = 0
+	return m.focusIssueGraph(models.IssueGraphNode{
+		Owner:         m.owner,
+		Repo:          m.repo,
+		Number:        m.issue.Number,
+		Title:         m.issue.Title,
+		State:         string(m.issue.State),
+		IsPullRequest: strings.Contains(m.issue.HTMLURL, "/pull/"),
+	}, false)
+}
+
+// focusIssueGraph loads the graph of node, bypassing the cache when fresh
+func (m *IssueDetailView) focusIssueGraph(node models.IssueGraphNode, fresh bool) tea.Cmd {
+	m.graph.focus = node
+	m.graph.graph = nil
+	m.graph.edges = nil
+	m.graph.err = nil
+	m.graph.selected = 0
+	m.graph.loading = true
+	m.scrollOffset = 0
+
+	ctx := m.loads.Context()
+	if fresh {
+		ctx = freshContext(ctx)
+	}
+	repo := m.issueRepo
+	return func() tea.Msg {
+		graph, err := repo.GetIssueGraph(ctx, node.Owner, node.Repo, node.Number)
+		return issueGraphLoadedMsg{node: node, graph: graph, err: err}
+	}
+}
+
+// handleIssueGraphLoaded applies a loaded graph unless another issue was focused meanwhile
+func (m *IssueDetailView) handleIssueGraphLoaded(msg issueGraphLoadedMsg) {
+	if !m.graph.active || !msg.node.SameAs(m.graph.focus) || isCancelled(msg.err) {
+		return
+	}
+	m.graph.loading = false
+	m.graph.err = msg.err
+	if msg.err == nil {
+		m.graph.graph = msg.graph
+		m.graph.focus = msg.graph.Node
+		m.graph.edges = issueGraphEdges(msg.graph)
+	}
+}
+
+// issueGraphEdges returns the edges of graph grouped by kind, adding the
+// references in the body that are not connected in another way
+func issueGraphEdges(graph *models.IssueGraph) []*models.IssueRelation {
+	// The graph may be shared with the cache, so the references go into a copy
+	withRefs := &models.IssueGraph{Node: graph.Node, Relations: append([]*models.IssueRelation(nil), graph.Relations...)}
+	for _, ref := range parseCrossRefs(graph.Body, graph.Node.Owner, graph.Node.Repo) {
+		node := models.IssueGraphNode{Owner: ref.Owner, Repo: ref.Repo, Number: ref.Number}
+		if !withRefs.Connected(node) {
+			withRefs.AddRelation(models.IssueRelationReferences, node)
+		}
+	}
+
+	var edges []*models.IssueRelation
+	for _, kind := range models.IssueRelationKinds {
+		edges = append(edges, withRefs.RelationsOf(kind)...)
+	}
+	return edges
+}
+
+// selectedGraphEdge returns the selected edge of the graph
+func (m *IssueDetailView) selectedGraphEdge() (*models.IssueRelation, bool) {
+	if m.graph.loading || m.graph.selected < 0 || m.graph.selected >= len(m.graph.edges) {
+		return nil, false
+	}
+	return m.graph.edges[m.graph.selected], true
+}
+
+// handleIssueGraphKey handles input while the graph is shown
+func (m *IssueDetailView) handleIssueGraphKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "L", "q", "esc":
+		return m, m.toggleIssueGraph()
+
+	case "j", "down":
+		if m.graph.selected < len(m.graph.edges)-1 {
+			m.graph.selected++
+		}
+
+	case "k", "up":
+		if m.graph.selected > 0 {
+			m.graph.selected--
+		}
+
+	case "l", "right":
+		// Focus the graph on the selected issue
+		edge, ok := m.selectedGraphEdge()
+		if !ok {
+			return m, nil
+		}
+		m.graph.history = append(m.graph.history, m.graph.focus)
+		return m, m.focusIssueGraph(edge.Node, false)
+
+	case "h", "left", "backspace":
+		// Focus the issue the graph was moved from
+		n := len(m.graph.history)
+		if n == 0 {
+			m.statusMessage = "Already at the issue the graph was opened from"
+			return m, nil
+		}
+		previous := m.graph.history[n-1]
+		m.graph.history = m.graph.history[:n-1]
+		return m, m.focusIssueGraph(previous, false)
+
+	case "enter":
+		// Open the selected issue in place of this one
+		edge, ok := m.selectedGraphEdge()
+		if !ok {
+			return m, nil
+		}
+		return m, m.openRef(crossRef{Owner: edge.Node.Owner, Repo: edge.Node.Repo, Number: edge.Node.Number})
+
+	case "R":
+		return m, m.focusIssueGraph(m.graph.focus, true)
+	}
+	return m, nil
+}
+
+// renderIssueGraph renders the focused issue with its edges as a tree and
+// returns the line of the selected edge, to keep it scrolled into view
+func (m *IssueDetailView) renderIssueGraph() (string, int) {
+	var s strings.Builder
+	path := make([]string, 0, len(m.graph.history)+1)
+	for _, node := range append(append([]models.IssueGraphNode(nil), m.graph.history...), m.graph.focus) {
+		path = append(path, m.graphNodeRef(node))
+	}
+	s.WriteString(styles.BoldStyle.Render("Issue graph"))
+	s.WriteString(styles.MutedStyle.Render(" " + strings.Join(path, " › ")))
+	s.WriteString("\n\n")
+	s.WriteString(m.renderGraphNode(m.graph.focus, true))
+	s.WriteString("\n")
+
+	switch {
+	case m.graph.loading:
+		s.WriteString(styles.MutedStyle.Render("Loading connected issues..."))
+		return s.String(), 0
+	case m.graph.err != nil:
+		s.WriteString(styles.ErrorStyle.Render(fmt.Sprintf("Failed to load connected issues: %v", m.graph.err)))
+		return s.String(), 0
+	case len(m.graph.edges) == 0:
+		s.WriteString(styles.MutedStyle.Render("Not connected to other issues or pull requests"))
+		return s.String(), 0
+	}
+
+	labelWidth := 0
+	for _, edge := range m.graph.edges {
+		labelWidth = max(labelWidth, len(edge.Kind.Label()))
+	}
+	selectedLine := strings.Count(s.String(), "\n")
+	for i, edge := range m.graph.edges {
+		connector := styles.IconTreeEdge
+		if i == len(m.graph.edges)-1 {
+			connector = styles.IconTreeLast
+		}
+		label := fmt.Sprintf("%-*s", labelWidth, edge.Kind.Label())
+		if i == m.graph.selected {
+			selectedLine += i
+			s.WriteString(styles.SelectedStyle.Render(fmt.Sprintf("%s %s %s ", connector, label, styles.IconCursor)))
+		} else {
+			s.WriteString(styles.MutedStyle.Render(fmt.Sprintf("%s %s ", connector, label)))
+			s.WriteString(strings.Repeat(" ", lipgloss.Width(styles.IconCursor)+1))
+		}
+		s.WriteString(m.renderGraphNode(edge.Node, false))
+		s.WriteString("\n")
+	}
+	return strings.TrimRight(s.String(), "\n"), selectedLine
+}
+
+// graphNodeRef renders a node as #12, or owner/repo#12 in another repository
+func (m *IssueDetailView) graphNodeRef(node models.IssueGraphNode) string {
+	return crossRef{Owner: node.Owner, Repo: node.Repo, Number: node.Number}.label(m.owner, m.repo)
+}
+
+// renderGraphNode renders a node with its kind, title and state
+func (m *IssueDetailView) renderGraphNode(node models.IssueGraphNode, focused bool) string {
+	ref := m.graphNodeRef(node)
+	if node.IsPullRequest {
+		ref = "PR " + ref
+	}
+	parts := []string{styles.IssueNumberStyle.Render(ref)}
+	if node.Title != "" {
+		title := node.Title
+		if focused {
+			title = styles.BoldStyle.Render(title)
+		}
+		parts = append(parts, title)
+	}
+	if node.State != "" {
+		parts = append(parts, styles.GetStateBadge(node.State))
+	}
+	return strings.Join(parts, " ")
+}
+
+// keepGraphSelectionVisible scrolls so that the selected edge is on screen
+func (m *IssueDetailView) keepGraphSelectionVisible(line int) {
+	available := max(m.height-2, 5)
+	if line < m.scrollOffset {
+		m.scrollOffset = line
+	}
+	if line >= m.scrollOffset+available {
+		m.scrollOffset = line - available + 1
+	}
+}
+
+// issueGraphFooter describes the keys of the graph
+func (m *IssueDetailView) issueGraphFooter() string {
+	helpItems := []string{
+		styles.FormatKeyBinding("j/k", "select"),
+		styles.FormatKeyBinding("l/→", "focus"),
+		styles.FormatKeyBinding("h/←", "back"),
+		styles.FormatKeyBinding("enter", "open"),
+		styles.FormatKeyBinding("R", "reload"),
+		styles.FormatKeyBinding("L/q", "close graph"),
+	}
+	footer := styles.HelpStyle.Render(strings.Join(helpItems, " • "))
+	if m.statusMessage != "" {
+		return styles.MutedStyle.Render(m.statusMessage) + "\n" + footer
+	}
+	return footer
+}
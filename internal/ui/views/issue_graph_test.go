@@ -0,0 +1,113 @@
+package views
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type graphIssueRepo struct {
+	repository.IssueRepository
+	graphs map[int]*models.IssueGraph
+}
+
+func (r *graphIssueRepo) GetIssueGraph(ctx context.Context, owner, repo string, number int) (*models.IssueGraph, error) {
+	return r.graphs[number], nil
+}
+
+func (r *graphIssueRepo) Get(ctx context.Context, owner, repo string, number int) (*models.Issue, error) {
+	return &models.Issue{Number: number}, nil
+}
+
+func (r *graphIssueRepo) ListComments(ctx context.Context, owner, repo string, number int, opts *models.CommentOptions) ([]*models.Comment, error) {
+	return nil, nil
+}
+
+func graphNode(number int, title, state string, pr bool) models.IssueGraphNode {
+	return models.IssueGraphNode{Owner: "owner", Repo: "repo", Number: number, Title: title, State: state, IsPullRequest: pr}
+}
+
+func TestIssueDetailView_IssueGraph(t *testing.T) {
+	issue := graphNode(42, "Crash on start", "open", false)
+	fix := graphNode(50, "Fix crash", "merged", true)
+	repo := &graphIssueRepo{graphs: map[int]*models.IssueGraph{
+		42: {
+			Node: issue,
+			Body: "Same as #7, tracked in #10",
+			Relations: []*models.IssueRelation{
+				{Kind: models.IssueRelationMentionedIn, Node: graphNode(3, "Release notes", "open", false)},
+				{Kind: models.IssueRelationClosedBy, Node: fix},
+				{Kind: models.IssueRelationTrackedBy, Node: graphNode(10, "Epic: stability", "open", false)},
+			},
+		},
+		50: {Node: fix, Relations: []*models.IssueRelation{{Kind: models.IssueRelationCloses, Node: issue}}},
+	}}
+	view := NewIssueDetailView(&models.Issue{Number: 42, Title: "Crash on start", State: models.IssueStateOpen}, "owner", "repo", repo)
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+
+	press := func(key string) tea.Cmd {
+		msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+		if key == "enter" {
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		}
+		_, cmd := view.Update(msg)
+		return cmd
+	}
+	load := func(cmd tea.Cmd) {
+		if cmd == nil {
+			t.Fatal("expected the graph to be loaded")
+		}
+		view.Update(cmd())
+	}
+
+	load(press("L"))
+	if !view.IsCapturingInput() {
+		t.Fatal("expected the graph to capture q and esc")
+	}
+	out := view.View()
+	tracked := strings.Index(out, "tracked by")
+	closed := strings.Index(out, "closed by")
+	refs := strings.Index(out, "references")
+	mentioned := strings.Index(out, "mentioned in")
+	if tracked < 0 || !(tracked < closed && closed < refs && refs < mentioned) {
+		t.Errorf("expected the edges grouped by kind, got:\n%s", out)
+	}
+	for _, want := range []string{"Epic: stability", "PR #50 Fix crash", "#7", "Release notes"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in the graph, got:\n%s", want, out)
+		}
+	}
+	if strings.Count(out, "#10") != 1 {
+		t.Errorf("expected #10 in the body to be shown only as the parent, got:\n%s", out)
+	}
+
+	// Move into the pull request and back
+	press("j")
+	load(press("l"))
+	out = view.View()
+	if !strings.Contains(out, "#42 › #50") || !strings.Contains(out, "closes") {
+		t.Errorf("expected the graph focused on the pull request, got:\n%s", out)
+	}
+	load(press("h"))
+	if view.graph.focus.Number != 42 || len(view.graph.history) != 0 {
+		t.Errorf("expected to be back at #42, got #%d with history %v", view.graph.focus.Number, view.graph.history)
+	}
+
+	// enter opens the selected issue in place of this one
+	cmd := press("enter")
+	if cmd == nil {
+		t.Fatal("expected the selected issue to be opened")
+	}
+	if msg, ok := cmd().(crossRefLoadedMsg); !ok || msg.ref.Number != 10 {
+		t.Errorf("expected #10 to be opened, got %#v", msg)
+	}
+
+	press("q")
+	if view.graph.active || view.IsCapturingInput() {
+		t.Error("expected q to close the graph only")
+	}
+}
@@ -3,13 +3,14 @@ package views
 import (
 	"context"
 	"fmt"
-	"sort"
 	"strings"
 	"time"
 
 	"github.com/a1yama/tig-gh/internal/domain/models"
 	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/infra/clock"
 	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/events"
 	"github.com/a1yama/tig-gh/internal/ui/styles"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -45,13 +46,27 @@ type IssueView struct {
 	statusBar          *components.StatusBar
 	showHelp           bool
 	filterState        models.IssueState
+	filterLabels       []string
+	sortField          models.IssueSort
+	sortDirection      models.SortDirection
+	filterModal        *components.FilterModal
 	detailView         *IssueDetailView
+	detailStack        []*IssueDetailView // detail views left to open a reference, innermost last
 	showingDetail      bool
+	milestoneView      *MilestoneView
+	showingMilestones  bool
+	rangeActive        bool
+	rangeAnchor        int
+	batch              *batchActions
+	creator            issueCreator
+	loads              loadGroup
+	live               liveList
 }
 
 // NewIssueView creates a new issue view (for backward compatibility)
 func NewIssueView() *IssueView {
 	return &IssueView{
+		loads:              loadGroup{source: sourceIssues},
 		fetchIssuesUseCase: nil,
 		owner:              "",
 		repo:               "",
@@ -62,12 +77,16 @@ func NewIssueView() *IssueView {
 		statusBar:          components.NewStatusBar(),
 		showHelp:           false,
 		filterState:        models.IssueStateOpen,
+		sortField:          models.IssueSortUpdated,
+		sortDirection:      models.SortDirectionDesc,
+		batch:              newBatchActions("issues"),
 	}
 }
 
 // NewIssueViewWithUseCase creates a new issue view with UseCase
 func NewIssueViewWithUseCase(fetchIssuesUseCase FetchIssuesUseCase, owner, repo string) *IssueView {
 	return &IssueView{
+		loads:              loadGroup{source: sourceIssues},
 		fetchIssuesUseCase: fetchIssuesUseCase,
 		owner:              owner,
 		repo:               repo,
@@ -78,6 +97,9 @@ func NewIssueViewWithUseCase(fetchIssuesUseCase FetchIssuesUseCase, owner, repo
 		statusBar:          components.NewStatusBar(),
 		showHelp:           false,
 		filterState:        models.IssueStateOpen,
+		sortField:          models.IssueSortUpdated,
+		sortDirection:      models.SortDirectionDesc,
+		batch:              newBatchActions("issues"),
 	}
 }
 
@@ -91,26 +113,79 @@ func (m *IssueView) Init() tea.Cmd {
 
 // Update handles messages
 func (m *IssueView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// A retry from the error banner reloads the list even while a detail view is open
+	if isRetryFor(msg, m) {
+		return m, m.refresh()
+	}
+
+	if _, ok := msg.(AutoRefreshMsg); ok {
+		return m, m.autoRefresh()
+	}
+
+	// Changes made elsewhere reload the list quietly; an open detail view
+	// reloads its issue instead
+	if live, ok := msg.(LiveUpdateMsg); ok && !m.IsShowingDetail() {
+		if live.touches(m.owner, m.repo, false) {
+			return m, m.autoRefresh()
+		}
+		return m, nil
+	}
+
+	if event, ok := msg.(events.EntityChanged); ok {
+		if event.Matches(m.owner, m.repo) {
+			replaceIssue(m.issues, event.Issue)
+		}
+		if m.detailView != nil {
+			m.detailView.Update(event)
+		}
+		return m, nil
+	}
+
+	// Batches keep running while a detail view is open or another view is shown
+	if progress, ok := msg.(components.ProgressMsg); ok {
+		return m, m.handleBatchProgress(progress)
+	}
+
+	// The milestone view handles everything until it sends backMsg
+	if m.showingMilestones && m.milestoneView != nil {
+		if _, isBackMsg := msg.(backMsg); isBackMsg {
+			m.milestoneView.Close()
+			m.showingMilestones = false
+			m.milestoneView = nil
+			return m, nil
+		}
+		if size, ok := msg.(tea.WindowSizeMsg); ok {
+			m.width = size.Width
+			m.height = size.Height
+			m.statusBar.SetSize(size.Width, 1)
+		}
+		updatedModel, cmd := m.milestoneView.Update(msg)
+		m.milestoneView = updatedModel.(*MilestoneView)
+		return m, cmd
+	}
+
 	// If showing detail view and not a window size message, delegate to detail view first
 	if m.showingDetail && m.detailView != nil {
 		// Let detail view handle all messages except backMsg
 		if _, isBackMsg := msg.(backMsg); isBackMsg {
-			m.showingDetail = false
-			m.detailView = nil
-			return m, nil
+			return m, m.backFromDetail()
 		}
+		if ref, ok := msg.(crossRefLoadedMsg); ok && ref.err == nil {
+			return m, m.openCrossRefDetail(ref)
+		}
+
+		// Keys answering the reaction picker must not close the detail view
+		capturing := m.detailView.IsCapturingInput()
 
 		// Delegate to detail view
 		updatedModel, cmd := m.detailView.Update(msg)
 		m.detailView = updatedModel.(*IssueDetailView)
 
 		// Check if it's a KeyMsg for back navigation
-		if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if keyMsg, ok := msg.(tea.KeyMsg); ok && !capturing {
 			keyStr := keyMsg.String()
 			if keyStr == "q" || keyStr == "esc" {
-				m.showingDetail = false
-				m.detailView = nil
-				return m, nil
+				return m, m.backFromDetail()
 			}
 		}
 
@@ -124,8 +199,7 @@ func (m *IssueView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case backMsg:
 		// Return from detail view
-		m.showingDetail = false
-		m.detailView = nil
+		m.closeDetail()
 		return m, nil
 
 	case tea.KeyMsg:
@@ -139,30 +213,76 @@ func (m *IssueView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		if m.batch != nil && m.batch.IsCapturingInput() {
+			return m, m.handleBatchKey(msg)
+		}
+		if m.creator.picking {
+			return m, m.handleTemplatePickerKey(msg)
+		}
+		if m.filterModal != nil && m.filterModal.IsVisible() {
+			return m, m.handleFilterKey(msg)
+		}
+		// The report of a finished batch stays until the next key
+		if m.batch != nil {
+			m.batch.DismissReport()
+		}
+
 		// Handle key press in list view
 		return m.handleKeyPress(msg)
 
+	case openBrowserMsg:
+		m.statusBar.SetMessage(browserStatusMessage(msg))
+		return m, nil
+
+	case issueTemplatesLoadedMsg:
+		return m, m.handleTemplatesLoaded(msg)
+
+	case editorClosedMsg:
+		if msg.purpose == editorPurposeNewIssue {
+			return m, m.handleDraftEdited(msg)
+		}
+		return m, nil
+
+	case issueCreatedMsg:
+		return m, m.handleIssueCreated(msg)
+
 	case issuesLoadedMsg:
+		if isCancelled(msg.err) {
+			// Cancelled with esc or replaced by a newer fetch
+			return m, nil
+		}
+		// Loads started by the user show the loading screen; quiet ones do not
+		quiet := m.live.finish(msg.err == nil) && !m.loading
 		m.loading = false
+		if msg.err != nil && quiet {
+			// The list on screen stays; the banner tells what went wrong
+			return m, reportLoadError(m, "issues", msg.err)
+		}
 		if msg.err != nil {
 			m.err = msg.err
 			m.issues = []*models.Issue{}
 		} else {
 			m.err = nil
-			m.issues = sortIssues(filterOutPullRequests(msg.issues))
-			// Reset cursor if it's out of bounds
-			if m.cursor >= len(m.issues) && len(m.issues) > 0 {
+			m.issues = sortIssuesBy(filterOutPullRequests(msg.issues), m.sortField, m.sortDirection)
+			m.pruneSelection()
+			if quiet {
+				m.cursor = m.live.cursorAfter(m.cursor, len(m.issues), func(i int) any { return m.issues[i].Number })
+			} else if m.cursor >= len(m.issues) && len(m.issues) > 0 {
+				// Reset cursor if it's out of bounds
 				m.cursor = len(m.issues) - 1
 			} else if len(m.issues) == 0 {
 				m.cursor = 0
 			}
 		}
-		return m, nil
+		return m, reportLoadError(m, "issues", msg.err)
 
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
 		m.statusBar.SetSize(msg.Width, 1)
+		if m.batch != nil {
+			m.batch.SetSize(msg.Width, msg.Height)
+		}
 		if m.detailView != nil {
 			m.detailView.Update(msg)
 		}
@@ -174,6 +294,7 @@ func (m *IssueView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 // fetchIssues fetches issues from the API
 func (m *IssueView) fetchIssues() tea.Cmd {
+	ctx := m.live.context(m.loads.Restart())
 	return func() tea.Msg {
 		if m.fetchIssuesUseCase == nil {
 			return issuesLoadedMsg{
@@ -182,14 +303,17 @@ func (m *IssueView) fetchIssues() tea.Cmd {
 			}
 		}
 
-		opts := &models.IssueOptions{
-			State:     m.filterState,
-			Sort:      models.IssueSortUpdated,
-			Direction: models.SortDirectionDesc,
-			PerPage:   100,
-		}
-
-		issues, err := m.fetchIssuesUseCase.Execute(context.Background(), m.owner, m.repo, opts)
+		issues, err := fetchPages(func(page, perPage int) ([]*models.Issue, error) {
+			opts := &models.IssueOptions{
+				State:     m.filterState,
+				Labels:    m.filterLabels,
+				Sort:      m.sortField,
+				Direction: m.sortDirection,
+				PerPage:   perPage,
+				Page:      page,
+			}
+			return m.fetchIssuesUseCase.Execute(ctx, m.owner, m.repo, opts)
+		})
 		return issuesLoadedMsg{
 			issues: issues,
 			err:    err,
@@ -197,6 +321,44 @@ func (m *IssueView) fetchIssues() tea.Cmd {
 	}
 }
 
+// closeDetail closes the detail view, cancelling its fetches
+func (m *IssueView) closeDetail() {
+	if m.detailView != nil {
+		m.detailView.Close()
+	}
+	for _, view := range m.detailStack {
+		view.Close()
+	}
+	m.showingDetail = false
+	m.detailView = nil
+	m.detailStack = nil
+}
+
+// refresh reloads the issues unless a load is already running
+func (m *IssueView) refresh() tea.Cmd {
+	if m.loading || m.fetchIssuesUseCase == nil {
+		return nil
+	}
+	m.loading = true
+	m.err = nil
+	return m.fetchIssues()
+}
+
+// autoRefresh reloads the issues quietly, keeping the list on screen and the
+// cursor on its issue. Nothing is reloaded while the user is busy with the view.
+func (m *IssueView) autoRefresh() tea.Cmd {
+	if m.loading || m.fetchIssuesUseCase == nil || m.IsShowingDetail() || m.IsCapturingInput() ||
+		(m.batch != nil && m.batch.Running()) {
+		return nil
+	}
+	var keep any
+	if m.cursor < len(m.issues) {
+		keep = m.issues[m.cursor].Number
+	}
+	m.live.start(keep)
+	return m.fetchIssues()
+}
+
 // handleKeyPress handles keyboard input
 func (m *IssueView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// Handle Enter key using Type check for reliability
@@ -230,12 +392,7 @@ func (m *IssueView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	case "r":
 		// Refresh issues
-		if !m.loading && m.fetchIssuesUseCase != nil {
-			m.loading = true
-			m.err = nil
-			return m, m.fetchIssues()
-		}
-		return m, nil
+		return m, m.refresh()
 
 	case "f":
 		// Toggle filter between open, closed, all
@@ -257,6 +414,13 @@ func (m *IssueView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case "F":
+		// Filter by state and labels, and sort (also by reactions)
+		if !m.loading {
+			m.openFilterModal()
+		}
+		return m, nil
+
 	case "j", "down":
 		if m.cursor < len(m.issues)-1 {
 			m.cursor++
@@ -281,30 +445,249 @@ func (m *IssueView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
-	case " ":
-		// Toggle selection (for future use)
-		if _, ok := m.selected[m.cursor]; ok {
-			delete(m.selected, m.cursor)
+	case "o":
+		// Open selected issue in browser
+		if len(m.issues) > 0 && m.cursor < len(m.issues) {
+			return m, openInBrowser(m.issues[m.cursor].HTMLURL)
+		}
+		return m, nil
+
+	case "v", " ":
+		// Toggle selection of the issue under the cursor
+		if len(m.issues) > 0 && m.cursor < len(m.issues) {
+			toggleSelection(m.selected, m.issues[m.cursor].Number)
+		}
+		return m, nil
+
+	case "V":
+		// Start a range selection, or select everything between its start and the cursor
+		if len(m.issues) == 0 {
+			return m, nil
+		}
+		if !m.rangeActive {
+			m.rangeActive = true
+			m.rangeAnchor = m.cursor
+			m.statusBar.SetMessage("Range select: move the cursor and press V again")
+			return m, nil
+		}
+		m.rangeActive = false
+		from, to := selectionRange(m.rangeAnchor, m.cursor)
+		for i := from; i <= to && i < len(m.issues); i++ {
+			m.selected[m.issues[i].Number] = struct{}{}
+		}
+		m.statusBar.SetMessage("")
+		return m, nil
+
+	case "esc":
+		// Stop loading, then cancel a range selection, then clear the selection
+		if m.loading {
+			m.loads.Cancel()
+			m.loading = false
+			m.statusBar.SetMessage(loadCancelledStatus)
+		} else if m.rangeActive {
+			m.rangeActive = false
+			m.statusBar.SetMessage("")
 		} else {
-			m.selected[m.cursor] = struct{}{}
+			m.selected = make(map[int]struct{})
+		}
+		return m, nil
+
+	case "x":
+		// Cancel the running batch action
+		if m.batch != nil && m.batch.Running() {
+			m.batch.Cancel()
+		}
+		return m, nil
+
+	case "W":
+		// Watch the issue under the cursor for new activity, or stop watching it
+		if len(m.issues) > 0 && m.cursor < len(m.issues) {
+			issue := m.issues[m.cursor]
+			m.statusBar.SetMessage(toggleWatch(&models.WatchedItem{
+				Owner:   m.owner,
+				Repo:    m.repo,
+				Number:  issue.Number,
+				Title:   issue.Title,
+				HTMLURL: issue.HTMLURL,
+			}))
 		}
 		return m, nil
+
+	case "b":
+		// Apply an action to every selected issue (or the one under the cursor)
+		return m, m.openBatchMenu()
+
+	case "n":
+		// Create an issue from one of the repository's templates
+		return m, m.startCreateIssue()
+
+	case "B":
+		// Burndown of a milestone
+		if m.fetchIssuesUseCase == nil {
+			return m, nil
+		}
+		m.milestoneView = NewMilestoneView(m.fetchIssuesUseCase.GetRepository(), m.owner, m.repo)
+		m.milestoneView.Update(tea.WindowSizeMsg{Width: m.width, Height: m.height})
+		m.showingMilestones = true
+		return m, m.milestoneView.Init()
 	}
 
 	return m, nil
 }
 
+// openBatchMenu opens the batch action menu for the selected issues
+func (m *IssueView) openBatchMenu() tea.Cmd {
+	if len(m.issues) == 0 || m.fetchIssuesUseCase == nil {
+		return nil
+	}
+	if !canWrite(m.fetchIssuesUseCase.GetRepository()) {
+		m.statusBar.SetMessage(readOnlyStatus)
+		return nil
+	}
+	if m.batch == nil {
+		m.batch = newBatchActions("issues")
+		m.batch.SetSize(m.width, m.height)
+	}
+	if m.batch.Running() {
+		m.statusBar.SetMessage("A batch action is still running (x to cancel)")
+		return nil
+	}
+
+	numbers := sortedSelection(m.selected)
+	if len(numbers) == 0 && m.cursor < len(m.issues) {
+		numbers = []int{m.issues[m.cursor].Number}
+	}
+	m.rangeActive = false
+	m.batch.Open(numbers)
+	return nil
+}
+
+// handleBatchKey forwards a key to the batch menu and starts the batch once it is complete
+func (m *IssueView) handleBatchKey(msg tea.KeyMsg) tea.Cmd {
+	request, err := m.batch.HandleKey(msg)
+	if err != nil {
+		m.statusBar.SetMessage(err.Error())
+		return nil
+	}
+	if request == nil {
+		return nil
+	}
+	m.statusBar.SetMessage("")
+	return m.batch.Start(m.loads.writeContext(), request, m.batchApplyFunc(request))
+}
+
+// handleBatchProgress updates the progress of the running batch and reports its result
+func (m *IssueView) handleBatchProgress(msg components.ProgressMsg) tea.Cmd {
+	if m.batch == nil || !m.batch.OwnsProgress(msg) {
+		return nil
+	}
+	result, cmd := m.batch.HandleProgress(msg)
+	if result == nil {
+		return cmd
+	}
+
+	// Failed and skipped issues stay selected so the batch can be retried
+	for _, number := range result.succeeded {
+		delete(m.selected, number)
+	}
+	m.statusBar.SetMessage(m.batch.Summary(result))
+	cmds := make([]tea.Cmd, 0, len(result.events))
+	for _, event := range result.events {
+		cmds = append(cmds, events.Publish(event))
+	}
+	return tea.Batch(cmds...)
+}
+
+// batchApplyFunc returns the function applying a batch request to one issue
+func (m *IssueView) batchApplyFunc(request *batchRequest) batchApplyFunc {
+	issueRepo := m.fetchIssuesUseCase.GetRepository()
+	owner, repo := m.owner, m.repo
+
+	// Labels and assignees are added to (or removed from) the ones each issue already has
+	labels := make(map[int][]string, len(m.issues))
+	assignees := make(map[int][]string, len(m.issues))
+	for _, issue := range m.issues {
+		labels[issue.Number] = labelNames(issue.Labels)
+		assignees[issue.Number] = userLogins(issue.Assignees)
+	}
+
+	return func(ctx context.Context, number int) (*events.EntityChanged, error) {
+		input := &models.UpdateIssueInput{}
+		switch request.action {
+		case batchActionLabel:
+			merged := mergeBatchValues(labels[number], request.values)
+			input.Labels = &merged
+		case batchActionUnlabel:
+			remaining := removeBatchValues(labels[number], request.values)
+			input.Labels = &remaining
+		case batchActionAssign:
+			merged := mergeBatchValues(assignees[number], request.values)
+			input.Assignees = &merged
+		case batchActionMilestone:
+			milestone := request.milestone
+			input.Milestone = &milestone
+		case batchActionClose:
+			state := models.IssueStateClosed
+			input.State = &state
+		}
+
+		issue, err := issueRepo.Update(ctx, owner, repo, number, input)
+		if err != nil {
+			return nil, err
+		}
+		event := events.IssueChanged(batchEventAction(request.action), owner, repo, issue)
+		return &event, nil
+	}
+}
+
+// pruneSelection drops selected issues that are no longer listed
+func (m *IssueView) pruneSelection() {
+	listed := make(map[int]struct{}, len(m.issues))
+	for _, issue := range m.issues {
+		listed[issue.Number] = struct{}{}
+	}
+	pruneSelection(m.selected, listed)
+	m.rangeActive = false
+}
+
+// renderSelectionMark renders whether an issue is selected or inside the pending range
+func (m *IssueView) renderSelectionMark(issue *models.Issue, index int) string {
+	_, selected := m.selected[issue.Number]
+	inRange := false
+	if m.rangeActive {
+		from, to := selectionRange(m.rangeAnchor, m.cursor)
+		inRange = index >= from && index <= to
+	}
+	return renderSelectionMark(selected, inRange)
+}
+
 // View renders the issue view
 func (m *IssueView) View() string {
 	if m.width == 0 || m.height == 0 {
 		return "Initializing..."
 	}
 
+	if m.showingMilestones && m.milestoneView != nil {
+		return m.milestoneView.View()
+	}
+
 	// If showing detail view, render it
 	if m.showingDetail && m.detailView != nil {
 		return m.detailView.View()
 	}
 
+	if m.batch != nil && m.batch.IsCapturingInput() {
+		return m.batch.View()
+	}
+
+	if m.creator.picking {
+		return m.renderTemplatePicker()
+	}
+
+	if m.filterModal != nil && m.filterModal.IsVisible() {
+		return m.filterModal.View()
+	}
+
 	var s strings.Builder
 
 	// Header
@@ -327,6 +710,15 @@ func (m *IssueView) View() string {
 		s.WriteString(m.renderHelp())
 	}
 
+	// Progress of a running batch action, or how each item of the finished one went
+	if m.batch != nil && m.batch.Running() {
+		s.WriteString("\n")
+		s.WriteString(m.batch.ProgressView())
+	} else if m.batch != nil && m.batch.HasReport() {
+		s.WriteString("\n")
+		s.WriteString(m.batch.ReportView())
+	}
+
 	// Status bar
 	s.WriteString("\n")
 	m.updateStatusBar()
@@ -357,28 +749,15 @@ func (m *IssueView) renderIssueList() string {
 	if m.showHelp {
 		availableHeight -= 10 // Reserve space for help
 	}
-
-	// Calculate visible range
-	startIdx := 0
-	endIdx := len(m.issues)
-
-	if len(m.issues) > availableHeight {
-		// Show items around cursor
-		halfHeight := availableHeight / 2
-		startIdx = m.cursor - halfHeight
-		if startIdx < 0 {
-			startIdx = 0
-		}
-		endIdx = startIdx + availableHeight
-		if endIdx > len(m.issues) {
-			endIdx = len(m.issues)
-			startIdx = endIdx - availableHeight
-			if startIdx < 0 {
-				startIdx = 0
-			}
-		}
+	if m.batch != nil && m.batch.Running() {
+		availableHeight-- // Reserve space for the progress bar
+	} else if m.batch != nil {
+		availableHeight -= m.batch.ReportHeight() // Reserve space for the batch report
 	}
 
+	// Calculate visible range: only the rows on screen are rendered
+	startIdx, endIdx := components.VisibleRange(m.cursor, len(m.issues), availableHeight)
+
 	// Render visible issues
 	for i := startIdx; i < endIdx; i++ {
 		issue := m.issues[i]
@@ -395,7 +774,10 @@ func (m *IssueView) renderIssueLine(issue *models.Issue, index int) string {
 	// Cursor indicator
 	cursor := "  "
 	if m.cursor == index {
-		cursor = styles.CursorStyle.Render("▶ ")
+		cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
+	}
+	if len(m.selected) > 0 || m.rangeActive {
+		cursor += m.renderSelectionMark(issue, index)
 	}
 
 	// State badge
@@ -425,7 +807,11 @@ func (m *IssueView) renderIssueLine(issue *models.Issue, index int) string {
 	author := styles.AuthorStyle.Render("@" + issue.Author.Login)
 	comments := ""
 	if issue.Comments > 0 {
-		comments = styles.MutedStyle.Render(fmt.Sprintf("💬 %d", issue.Comments))
+		comments = styles.MutedStyle.Render(fmt.Sprintf("%s %d", styles.IconComment, issue.Comments))
+	}
+	reactions := ""
+	if issue.Reactions.PlusOne > 0 {
+		reactions = styles.MutedStyle.Render(fmt.Sprintf("%s %d", styles.IconThumbsUp, issue.Reactions.PlusOne))
 	}
 	relativeTime := formatRelativeTime(issue.UpdatedAt)
 	date := styles.DateStyle.Render(relativeTime)
@@ -444,9 +830,15 @@ func (m *IssueView) renderIssueLine(issue *models.Issue, index int) string {
 		author,
 	)
 
+	if reactions != "" {
+		line = lipgloss.JoinHorizontal(lipgloss.Top, line, " ", reactions)
+	}
 	if comments != "" {
 		line = lipgloss.JoinHorizontal(lipgloss.Top, line, " ", comments)
 	}
+	if watched := renderWatchMark(m.owner, m.repo, issue.Number); watched != "" {
+		line = lipgloss.JoinHorizontal(lipgloss.Top, line, " ", watched)
+	}
 
 	line = lipgloss.JoinHorizontal(lipgloss.Top, line, " ", date)
 
@@ -474,13 +866,25 @@ Navigation:
 
 Actions:
   enter   View issue details
-  space   Toggle selection
+  n       New issue (from a template)
+  o       Open in browser
   r       Refresh
+  F       Filters and sort (state, labels, reactions)
+  W       Watch/unwatch (notify on new activity)
+  B       Milestone burndown
+
+Selection:
+  v/space Toggle selection
+  V       Start/end range selection
+  b       Batch action (add/remove labels, close, assign, milestone)
+  x       Cancel the running batch action
+  esc     Clear selection
 
 General:
   ?       Toggle help
   q       Quit
   ctrl+c  Force quit
+  ctrl+z  Suspend (resume with fg)
 `
 
 	return styles.BorderStyle.Render(
@@ -492,9 +896,8 @@ General:
 func (m *IssueView) updateStatusBar() {
 	m.statusBar.ClearItems()
 
-	// Set mode based on filter state
-	modeText := fmt.Sprintf("Issues (%s)", m.filterState)
-	m.statusBar.SetMode(modeText)
+	// Set mode based on the filters and sort
+	m.statusBar.SetMode(m.filterSummary())
 
 	// Add current position
 	if len(m.issues) > 0 {
@@ -506,16 +909,23 @@ func (m *IssueView) updateStatusBar() {
 	if len(m.selected) > 0 {
 		m.statusBar.AddItem("Selected", fmt.Sprintf("%d", len(m.selected)))
 	}
+	if m.rangeActive {
+		m.statusBar.AddItem("", "-- RANGE --")
+	}
 
 	// Add repository info
 	if m.owner != "" && m.repo != "" {
 		m.statusBar.AddItem("Repo", fmt.Sprintf("%s/%s", m.owner, m.repo))
 	}
+
+	if updated := m.live.indicator(); updated != "" {
+		m.statusBar.AddItem("", updated)
+	}
 }
 
 // formatRelativeTime formats a time as relative (e.g., "2 hours ago")
 func formatRelativeTime(t time.Time) string {
-	now := time.Now()
+	now := clock.Now()
 	diff := now.Sub(t)
 
 	switch {
@@ -580,20 +990,23 @@ func filterOutPullRequests(issues []*models.Issue) []*models.Issue {
 }
 
 func sortIssues(issues []*models.Issue) []*models.Issue {
-	if len(issues) == 0 {
-		return issues
-	}
-
-	sort.SliceStable(issues, func(i, j int) bool {
-		left := issues[i]
-		right := issues[j]
-
-		if !left.UpdatedAt.Equal(right.UpdatedAt) {
-			return left.UpdatedAt.After(right.UpdatedAt)
-		}
+	return sortIssuesBy(issues, models.IssueSortUpdated, models.SortDirectionDesc)
+}
 
-		return left.Number > right.Number
-	})
+// IsShowingDetail returns true while a detail view or the milestone view is open
+func (m *IssueView) IsShowingDetail() bool {
+	return (m.showingDetail && m.detailView != nil) || (m.showingMilestones && m.milestoneView != nil)
+}
 
-	return issues
+// IsCapturingInput returns true while the open detail view, the batch menu,
+// the issue template picker or the filter modal is waiting for input
+func (m *IssueView) IsCapturingInput() bool {
+	if m.showingMilestones && m.milestoneView != nil {
+		return false
+	}
+	if m.IsShowingDetail() {
+		return m.detailView.IsCapturingInput()
+	}
+	return m.creator.picking || (m.batch != nil && m.batch.IsCapturingInput()) ||
+		(m.filterModal != nil && m.filterModal.IsVisible())
 }
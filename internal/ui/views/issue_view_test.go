@@ -3,13 +3,16 @@ package views
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/a1yama/tig-gh/internal/domain/models"
 	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/infra/clock"
 	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/events"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -557,3 +560,126 @@ func TestSortIssues(t *testing.T) {
 		t.Fatalf("expected oldest issue last, got %d", sorted[3].Number)
 	}
 }
+
+func TestIssueView_EscCancelsLoading(t *testing.T) {
+	started := make(chan context.Context, 1)
+	mockUseCase := &mockFetchIssuesUseCase{
+		executeFunc: func(ctx context.Context, owner, repo string, opts *models.IssueOptions) ([]*models.Issue, error) {
+			started <- ctx
+			<-ctx.Done()
+			return nil, fmt.Errorf("github api error: %w", ctx.Err())
+		},
+	}
+
+	view := NewIssueViewWithUseCase(mockUseCase, "testowner", "testrepo")
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 24})
+	result := make(chan tea.Msg, 1)
+	cmd := view.Init()
+	go func() { result <- cmd() }()
+	ctx := <-started
+
+	view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if ctx.Err() == nil {
+		t.Fatal("esc should cancel the fetch in flight")
+	}
+	if view.loading {
+		t.Error("esc should stop loading")
+	}
+	if !strings.Contains(view.statusBar.View(), loadCancelledStatus) {
+		t.Errorf("status should report the cancellation, got %q", view.statusBar.View())
+	}
+
+	// The cancelled result is dropped instead of shown as an error
+	view.Update(<-result)
+	if view.err != nil {
+		t.Errorf("cancelled fetch should not set an error, got %v", view.err)
+	}
+}
+
+func TestIssueView_StaleFetchIsDropped(t *testing.T) {
+	view := NewIssueViewWithUseCase(&mockFetchIssuesUseCase{}, "testowner", "testrepo")
+	view.loading = true
+	view.Update(issuesLoadedMsg{err: fmt.Errorf("github api error: %w", context.Canceled)})
+	if !view.loading {
+		t.Error("a fetch replaced by a newer one should not end loading")
+	}
+}
+
+func TestIssueView_ClosingDetailCancelsItsFetches(t *testing.T) {
+	view := NewIssueViewWithUseCase(nil, "testowner", "testrepo")
+	view.loading = false
+	view.issues = []*models.Issue{{Number: 1, Title: "Test Title", State: models.IssueStateOpen}}
+
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if view.detailView == nil {
+		t.Fatal("expected detail view to open")
+	}
+	ctx := view.detailView.loads.Context()
+
+	view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if view.showingDetail {
+		t.Fatal("esc should close the detail view")
+	}
+	if ctx.Err() == nil {
+		t.Error("closing the detail view should cancel its fetches")
+	}
+}
+
+func TestIssueView_LoadErrorIsReportedWithRetry(t *testing.T) {
+	calls := 0
+	mockUseCase := &mockFetchIssuesUseCase{
+		executeFunc: func(ctx context.Context, owner, repo string, opts *models.IssueOptions) ([]*models.Issue, error) {
+			calls++
+			return nil, errors.New("rate limit exceeded")
+		},
+	}
+	view := NewIssueViewWithUseCase(mockUseCase, "testowner", "testrepo")
+
+	_, cmd := view.Update(view.Init()())
+	if cmd == nil {
+		t.Fatal("expected the failure to be reported")
+	}
+	reported, ok := cmd().(events.ErrorReported)
+	if !ok || reported.Source != "issues" || reported.Retry == nil {
+		t.Fatalf("expected an ErrorReported with a retry, got %#v", reported)
+	}
+
+	// Retries reach the list even while a detail view is open
+	view.issues = []*models.Issue{{Number: 1, Title: "Test Title", State: models.IssueStateOpen}}
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	_, cmd = view.Update(reported.Retry)
+	if cmd == nil || !view.loading {
+		t.Fatal("expected the retry to reload the issues")
+	}
+	cmd()
+	if calls != 2 {
+		t.Errorf("expected the issues to be fetched again, got %d calls", calls)
+	}
+
+	// Retries for other views are ignored
+	view.loading = false
+	if _, cmd := view.Update(retryMsg{target: NewIssueView()}); cmd != nil || view.loading {
+		t.Error("expected a retry for another view to be ignored")
+	}
+}
+
+func TestFormatRelativeTime_FixedClock(t *testing.T) {
+	now := time.Date(2025, 1, 22, 12, 0, 0, 0, time.UTC)
+	t.Cleanup(clock.Set(clock.Fixed(now)))
+
+	tests := []struct {
+		at   time.Time
+		want string
+	}{
+		{now.Add(-30 * time.Second), "just now"},
+		{now.Add(-5 * time.Minute), "5 minutes ago"},
+		{now.Add(-time.Hour), "1 hour ago"},
+		{now.Add(-3 * 24 * time.Hour), "3 days ago"},
+		{now.Add(-14 * 24 * time.Hour), "2 weeks ago"},
+	}
+	for _, tt := range tests {
+		if got := formatRelativeTime(tt.at); got != tt.want {
+			t.Errorf("formatRelativeTime(%v) = %q, want %q", tt.at, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,347 @@
+package views
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	// structuralDiffMinLines is the raw diff size from which JSON files open in structural mode
+	structuralDiffMinLines = 200
+	// structuralValueMaxLines caps how much of an added or removed value is shown
+	structuralValueMaxLines = 20
+)
+
+// structuralDiffLoadedMsg is sent when a structural diff has been computed
+type structuralDiffLoadedMsg struct {
+	path      string
+	lines     []DiffLine
+	mergeBase string // the commit the old side was read at
+	err       error
+}
+
+// mergeBaseFinder is implemented by content fetchers that can compare refs.
+// The old side of a structural diff is then read at the merge base, so changes
+// merged into the base branch after the PR branched off are not shown as its own.
+type mergeBaseFinder interface {
+	Compare(ctx context.Context, owner, repo, base, head string) (*models.Comparison, error)
+}
+
+// findMergeBase returns the commit the old side of a structural diff is read
+// at: the merge base of the base and head refs, or the base ref when the
+// fetcher cannot compare them
+func findMergeBase(ctx context.Context, fetcher FileContentFetcher, owner, repo, baseRef, headRef string) (string, error) {
+	finder, ok := fetcher.(mergeBaseFinder)
+	if !ok {
+		return baseRef, nil
+	}
+	comparison, err := finder.Compare(ctx, owner, repo, baseRef, headRef)
+	if err != nil {
+		return "", err
+	}
+	if comparison.MergeCommit == nil || comparison.MergeCommit.SHA == "" {
+		return baseRef, nil
+	}
+	return comparison.MergeCommit.SHA, nil
+}
+
+// isNotebook reports whether the path is a Jupyter notebook
+func isNotebook(filePath string) bool {
+	return strings.EqualFold(path.Ext(filePath), ".ipynb")
+}
+
+// supportsStructuralDiff reports whether the file can be shown as a structural diff
+func supportsStructuralDiff(file DiffFile) bool {
+	return isNotebook(file.NewPath) || strings.EqualFold(path.Ext(file.NewPath), ".json")
+}
+
+// prefersStructuralDiff reports whether the file opens in structural mode by default:
+// notebooks always do, JSON files once their line diff gets too long to follow
+func prefersStructuralDiff(file DiffFile) bool {
+	if isNotebook(file.NewPath) {
+		return true
+	}
+	return supportsStructuralDiff(file) && len(file.Lines) >= structuralDiffMinLines
+}
+
+// structuralDiff compares two JSON documents key by key and renders the
+// changes as diff lines. Either side may be nil for added or deleted files.
+// Notebook outputs and execution counts are stripped before comparing.
+func structuralDiff(filePath string, oldData, newData []byte) ([]DiffLine, error) {
+	oldValue, err := decodeJSONDocument(oldData)
+	if err != nil {
+		return nil, fmt.Errorf("old version: %w", err)
+	}
+	newValue, err := decodeJSONDocument(newData)
+	if err != nil {
+		return nil, fmt.Errorf("new version: %w", err)
+	}
+
+	if isNotebook(filePath) {
+		oldValue = normalizeNotebook(oldValue)
+		newValue = normalizeNotebook(newValue)
+	}
+
+	var lines []DiffLine
+	diffJSONValues("", oldValue, newValue, &lines)
+	if len(lines) == 0 {
+		message := "No structural changes"
+		if isNotebook(filePath) {
+			message += " (outputs and execution counts are ignored)"
+		}
+		lines = append(lines, DiffLine{Type: DiffLineContext, Content: message})
+	}
+	return lines, nil
+}
+
+// decodeJSONDocument decodes a JSON document; nil data stands for a missing file
+func decodeJSONDocument(data []byte) (interface{}, error) {
+	if data == nil {
+		return nil, nil
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// normalizeNotebook drops cell outputs and execution counts and joins cell
+// sources, leaving only what a reviewer edits
+func normalizeNotebook(value interface{}) interface{} {
+	notebook, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+	cells, ok := notebook["cells"].([]interface{})
+	if !ok {
+		return value
+	}
+
+	for _, c := range cells {
+		cell, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		delete(cell, "outputs")
+		delete(cell, "execution_count")
+		if source, ok := cell["source"].([]interface{}); ok {
+			parts := make([]string, 0, len(source))
+			for _, part := range source {
+				if s, ok := part.(string); ok {
+					parts = append(parts, s)
+				}
+			}
+			cell["source"] = strings.Join(parts, "")
+		}
+	}
+	return notebook
+}
+
+// diffJSONValues appends the key-level changes between two values
+func diffJSONValues(keyPath string, oldValue, newValue interface{}, lines *[]DiffLine) {
+	switch {
+	case oldValue == nil && newValue == nil:
+		return
+	case oldValue == nil:
+		appendJSONChange(lines, keyPath, "added", nil, newValue)
+		return
+	case newValue == nil:
+		appendJSONChange(lines, keyPath, "removed", oldValue, nil)
+		return
+	}
+
+	oldMap, oldIsMap := oldValue.(map[string]interface{})
+	newMap, newIsMap := newValue.(map[string]interface{})
+	if oldIsMap && newIsMap {
+		keys := make([]string, 0, len(oldMap)+len(newMap))
+		for key := range oldMap {
+			keys = append(keys, key)
+		}
+		for key := range newMap {
+			if _, ok := oldMap[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			diffJSONValues(joinJSONKey(keyPath, key), oldMap[key], newMap[key], lines)
+		}
+		return
+	}
+
+	oldList, oldIsList := oldValue.([]interface{})
+	newList, newIsList := newValue.([]interface{})
+	if oldIsList && newIsList {
+		for i := 0; i < len(oldList) || i < len(newList); i++ {
+			var oldItem, newItem interface{}
+			if i < len(oldList) {
+				oldItem = oldList[i]
+			}
+			if i < len(newList) {
+				newItem = newList[i]
+			}
+			diffJSONValues(fmt.Sprintf("%s[%d]", keyPath, i), oldItem, newItem, lines)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(oldValue, newValue) {
+		appendJSONChange(lines, keyPath, "changed", oldValue, newValue)
+	}
+}
+
+// joinJSONKey appends an object key to a path
+func joinJSONKey(keyPath, key string) string {
+	if keyPath == "" {
+		return key
+	}
+	return keyPath + "." + key
+}
+
+// appendJSONChange appends a path header followed by the old and new values
+func appendJSONChange(lines *[]DiffLine, keyPath, kind string, oldValue, newValue interface{}) {
+	if keyPath == "" {
+		keyPath = "(root)"
+	}
+	*lines = append(*lines, DiffLine{Type: DiffLineContext, Content: fmt.Sprintf("%s (%s)", keyPath, kind)})
+	if oldValue != nil {
+		for _, line := range formatJSONValue(oldValue) {
+			*lines = append(*lines, DiffLine{Type: DiffLineDeleted, Content: "  " + line})
+		}
+	}
+	if newValue != nil {
+		for _, line := range formatJSONValue(newValue) {
+			*lines = append(*lines, DiffLine{Type: DiffLineAdded, Content: "  " + line})
+		}
+	}
+}
+
+// formatJSONValue renders a value for display: strings as text, anything else as indented JSON
+func formatJSONValue(value interface{}) []string {
+	var lines []string
+	if s, ok := value.(string); ok {
+		lines = strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+	} else {
+		data, err := json.MarshalIndent(value, "", "  ")
+		if err != nil {
+			return []string{fmt.Sprint(value)}
+		}
+		lines = strings.Split(string(data), "\n")
+	}
+
+	if len(lines) > structuralValueMaxLines {
+		hidden := len(lines) - structuralValueMaxLines
+		lines = append(lines[:structuralValueMaxLines], fmt.Sprintf("… %d more lines", hidden))
+	}
+	return lines
+}
+
+// wantsStructuralDiff reports whether the file should be shown structurally,
+// honouring an explicit toggle over the default
+func (m *DiffView) wantsStructuralDiff(file DiffFile) bool {
+	if m.contentFetcher == nil || !supportsStructuralDiff(file) {
+		return false
+	}
+	if mode, ok := m.structuralMode[file.NewPath]; ok {
+		return mode
+	}
+	return prefersStructuralDiff(file)
+}
+
+// showingStructuralDiff reports whether the structural diff of the file is on screen
+func (m *DiffView) showingStructuralDiff(file DiffFile) bool {
+	_, loaded := m.structuralDiffs[file.NewPath]
+	return loaded && m.wantsStructuralDiff(file)
+}
+
+// setStructuralMode records an explicit structural mode choice for a file
+func (m *DiffView) setStructuralMode(filePath string, on bool) {
+	if m.structuralMode == nil {
+		m.structuralMode = make(map[string]bool)
+	}
+	m.structuralMode[filePath] = on
+}
+
+// toggleStructuralDiff switches the current file between line and structural diff
+func (m *DiffView) toggleStructuralDiff() tea.Cmd {
+	if m.currentFile >= len(m.files) {
+		return nil
+	}
+	file := m.files[m.currentFile]
+	if !supportsStructuralDiff(file) {
+		m.statusMessage = "Structural diff is only available for JSON files and notebooks"
+		return nil
+	}
+	if m.contentFetcher == nil {
+		m.statusMessage = "Structural diff not available"
+		return nil
+	}
+
+	m.setStructuralMode(file.NewPath, !m.wantsStructuralDiff(file))
+	m.scroll = 0
+	return m.ensureStructuralDiff()
+}
+
+// ensureStructuralDiff starts building the structural diff of the current file when it is wanted
+func (m *DiffView) ensureStructuralDiff() tea.Cmd {
+	if m.currentFile >= len(m.files) {
+		return nil
+	}
+	file := m.files[m.currentFile]
+	if !m.wantsStructuralDiff(file) || m.structuralBusy[file.NewPath] {
+		return nil
+	}
+	if _, ok := m.structuralDiffs[file.NewPath]; ok {
+		return nil
+	}
+
+	if m.structuralBusy == nil {
+		m.structuralBusy = make(map[string]bool)
+	}
+	m.structuralBusy[file.NewPath] = true
+	m.statusMessage = "Loading structural diff..."
+	return m.fetchStructuralDiff(file)
+}
+
+// fetchStructuralDiff fetches the file at the merge base and at the head and
+// compares them
+func (m *DiffView) fetchStructuralDiff(file DiffFile) tea.Cmd {
+	ctx := m.loads.Context()
+	mergeBase := m.mergeBase
+	return func() tea.Msg {
+		var oldData, newData []byte
+		if file.Status != DiffFileAdded {
+			if mergeBase == "" {
+				var err error
+				mergeBase, err = findMergeBase(ctx, m.contentFetcher, m.owner, m.repo, m.baseRef, m.headRef)
+				if err != nil {
+					return structuralDiffLoadedMsg{path: file.NewPath, err: err}
+				}
+			}
+			content, err := m.contentFetcher.GetFileContent(ctx, m.owner, m.repo, file.OldPath, mergeBase)
+			if err != nil {
+				return structuralDiffLoadedMsg{path: file.NewPath, err: err}
+			}
+			oldData = []byte(content)
+		}
+		if file.Status != DiffFileDeleted {
+			content, err := m.contentFetcher.GetFileContent(ctx, m.owner, m.repo, file.NewPath, m.headRef)
+			if err != nil {
+				return structuralDiffLoadedMsg{path: file.NewPath, err: err}
+			}
+			newData = []byte(content)
+		}
+
+		lines, err := structuralDiff(file.NewPath, oldData, newData)
+		return structuralDiffLoadedMsg{path: file.NewPath, lines: lines, mergeBase: mergeBase, err: err}
+	}
+}
@@ -0,0 +1,113 @@
+package views
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func diffLineContents(lines []DiffLine) string {
+	var s strings.Builder
+	for _, line := range lines {
+		switch line.Type {
+		case DiffLineAdded:
+			s.WriteString("+")
+		case DiffLineDeleted:
+			s.WriteString("-")
+		default:
+			s.WriteString(" ")
+		}
+		s.WriteString(line.Content)
+		s.WriteString("\n")
+	}
+	return s.String()
+}
+
+func TestStructuralDiff_JSON(t *testing.T) {
+	oldData := []byte(`{"name":"app","version":"1.0.0","deps":{"a":"1","b":"2"}}`)
+	newData := []byte(`{"name":"app","version":"1.1.0","deps":{"a":"1","c":"3"}}`)
+
+	lines, err := structuralDiff("package.json", oldData, newData)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := ` deps.b (removed)
+-  2
+ deps.c (added)
++  3
+ version (changed)
+-  1.0.0
++  1.1.0
+`
+	if got := diffLineContents(lines); got != want {
+		t.Errorf("unexpected structural diff:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestStructuralDiff_NotebookIgnoresOutputs(t *testing.T) {
+	oldData := []byte(`{"cells":[{"cell_type":"code","execution_count":1,"source":["x = 1\n","print(x)"],"outputs":[{"text":"1"}]}]}`)
+	newData := []byte(`{"cells":[{"cell_type":"code","execution_count":7,"source":["x = 2\n","print(x)"],"outputs":[{"text":"2"}]}]}`)
+
+	lines, err := structuralDiff("analysis.ipynb", oldData, newData)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := ` cells[0].source (changed)
+-  x = 1
+-  print(x)
++  x = 2
++  print(x)
+`
+	if got := diffLineContents(lines); got != want {
+		t.Errorf("unexpected notebook diff:\n%s\nwant:\n%s", got, want)
+	}
+
+	unchanged, _ := structuralDiff("analysis.ipynb", oldData, []byte(strings.ReplaceAll(string(oldData), `"1"`, `"42"`)))
+	if len(unchanged) != 1 || !strings.Contains(unchanged[0].Content, "No structural changes") {
+		t.Errorf("expected output-only changes to be ignored, got %+v", unchanged)
+	}
+}
+
+type refContentFetcher map[string]string
+
+func (f refContentFetcher) GetFileContent(ctx context.Context, owner, repo, path, ref string) (string, error) {
+	return f[ref+":"+path], nil
+}
+
+func TestDiffView_NotebookOpensStructural(t *testing.T) {
+	diff := `diff --git a/nb.ipynb b/nb.ipynb
+index 1111111..2222222 100644
+--- a/nb.ipynb
++++ b/nb.ipynb
+@@ -1,1 +1,1 @@
+-{"cells":[{"source":["a"],"outputs":[]}]}
++{"cells":[{"source":["b"],"outputs":[]}]}`
+
+	view := NewDiffView()
+	view.SetContentFetcher(refContentFetcher{
+		"base:nb.ipynb": `{"cells":[{"source":["a"],"outputs":[]}]}`,
+		"head:nb.ipynb": `{"cells":[{"source":["b"],"outputs":[]}]}`,
+	}, "base", "head")
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+
+	_, cmd := view.Update(diffLoadedMsg{files: parseDiff(diff)})
+	if cmd == nil {
+		t.Fatal("expected notebooks to load a structural diff")
+	}
+	view.Update(cmd())
+
+	output := view.View()
+	if !strings.Contains(output, "cells[0].source (changed)") || !strings.Contains(output, "[structural]") {
+		t.Errorf("expected structural diff to be shown, got:\n%s", output)
+	}
+
+	// s switches back to the line diff
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	if strings.Contains(view.View(), "[structural]") {
+		t.Error("expected line diff after toggling")
+	}
+}
@@ -0,0 +1,64 @@
+package views
+
+import (
+	"context"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// LiveUpdateMsg carries the changes the live updates (features.live) found in
+// the repository since the last poll. The app delivers it to the view on
+// screen: lists reload quietly and detail views reload their item.
+type LiveUpdateMsg struct {
+	Events []*models.RepositoryEvent // oldest first
+}
+
+// touches reports whether an event is about an issue (or, with
+// pullRequests, a pull request) of owner/repo
+func (msg LiveUpdateMsg) touches(owner, repo string, pullRequests bool) bool {
+	for _, event := range msg.Events {
+		if event.IsPullRequest == pullRequests &&
+			strings.EqualFold(event.Owner, owner) && strings.EqualFold(event.Repo, repo) {
+			return true
+		}
+	}
+	return false
+}
+
+// latestFor returns the latest event about owner/repo#number, or nil
+func (msg LiveUpdateMsg) latestFor(owner, repo string, number int) *models.RepositoryEvent {
+	for i := len(msg.Events) - 1; i >= 0; i-- {
+		if msg.Events[i].Concerns(owner, repo, number) {
+			return msg.Events[i]
+		}
+	}
+	return nil
+}
+
+// liveStatus is the status line a detail view shows once the reload started
+// by a live update is done
+func liveStatus(event *models.RepositoryEvent) string {
+	return "Updated: " + event.Summary()
+}
+
+// LiveUpdates polls the repository on screen for changes made elsewhere
+type LiveUpdates interface {
+	Poll(ctx context.Context) ([]*models.RepositoryEvent, error)
+}
+
+// LivePolledMsg carries the result of a poll of the live updates
+type LivePolledMsg struct {
+	Events []*models.RepositoryEvent
+	Err    error
+}
+
+// PollLiveUpdates returns a command polling for changes made elsewhere
+func PollLiveUpdates(live LiveUpdates) tea.Cmd {
+	ctx := sourceContext(sourceLive)
+	return func() tea.Msg {
+		events, err := live.Poll(ctx)
+		return LivePolledMsg{Events: events, Err: err}
+	}
+}
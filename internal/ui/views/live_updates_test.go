@@ -0,0 +1,82 @@
+package views
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func liveComment(owner, repo string, number int, pullRequest bool) *models.RepositoryEvent {
+	return &models.RepositoryEvent{
+		ID:            "1",
+		Kind:          models.RepositoryEventComment,
+		Action:        "created",
+		Owner:         owner,
+		Repo:          repo,
+		Number:        number,
+		IsPullRequest: pullRequest,
+		Actor:         "bob",
+	}
+}
+
+func TestIssueDetailView_LiveUpdateReloads(t *testing.T) {
+	repo := &refreshIssueRepo{issue: &models.Issue{Number: 3, Title: "Old", State: models.IssueStateOpen}}
+	view := NewIssueDetailView(&models.Issue{Number: 3, Title: "Old"}, "owner", "repo", repo)
+
+	// Changes to other issues leave the view alone
+	if _, cmd := view.Update(LiveUpdateMsg{Events: []*models.RepositoryEvent{liveComment("owner", "repo", 4, false)}}); cmd != nil {
+		t.Fatal("expected no reload for another issue")
+	}
+
+	_, cmd := view.Update(LiveUpdateMsg{Events: []*models.RepositoryEvent{liveComment("owner", "repo", 3, false)}})
+	if cmd == nil {
+		t.Fatal("expected the issue to reload")
+	}
+	view.Update(cmd())
+	if !repo.skipCache || len(view.comments) != 1 {
+		t.Errorf("expected the new comment to be fetched past the cache, got %d comments", len(view.comments))
+	}
+	if view.statusMessage != "Updated: @bob commented on #3" {
+		t.Errorf("statusMessage = %q", view.statusMessage)
+	}
+
+	// A change arriving while a reload runs is only reported
+	view.refreshing = true
+	if _, cmd := view.Update(LiveUpdateMsg{Events: []*models.RepositoryEvent{liveComment("owner", "repo", 3, false)}}); cmd != nil {
+		t.Error("expected no second reload while one is running")
+	}
+	if !strings.Contains(view.statusMessage, "R to reload") {
+		t.Errorf("expected the change to be reported, got %q", view.statusMessage)
+	}
+}
+
+func TestIssueView_LiveUpdateReloadsList(t *testing.T) {
+	fetched := 0
+	view := NewIssueViewWithUseCase(&mockFetchIssuesUseCase{
+		executeFunc: func(ctx context.Context, owner, repo string, opts *models.IssueOptions) ([]*models.Issue, error) {
+			fetched++
+			return []*models.Issue{{Number: 1, Title: "First", State: models.IssueStateOpen}}, nil
+		},
+	}, "owner", "repo")
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	view.Update(issuesLoadedMsg{issues: []*models.Issue{{Number: 1, Title: "First", State: models.IssueStateOpen}}})
+
+	// Pull requests and other repositories do not concern the issue list
+	for _, event := range []*models.RepositoryEvent{liveComment("owner", "repo", 2, true), liveComment("owner", "other", 2, false)} {
+		if _, cmd := view.Update(LiveUpdateMsg{Events: []*models.RepositoryEvent{event}}); cmd != nil {
+			t.Errorf("expected no reload for %+v", event)
+		}
+	}
+
+	_, cmd := view.Update(LiveUpdateMsg{Events: []*models.RepositoryEvent{liveComment("owner", "repo", 2, false)}})
+	if cmd == nil {
+		t.Fatal("expected the list to reload")
+	}
+	view.Update(cmd())
+	if fetched != 1 {
+		t.Errorf("fetched %d times, want 1", fetched)
+	}
+}
@@ -0,0 +1,110 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/a1yama/tig-gh/internal/infra/apiusage"
+)
+
+var (
+	baseMu  sync.RWMutex
+	baseCtx = context.Background()
+)
+
+// SetBaseContext sets the context every API call of the views derives from.
+// The app passes the program's context so calls still in flight are cancelled
+// when it exits.
+func SetBaseContext(ctx context.Context) {
+	baseMu.Lock()
+	defer baseMu.Unlock()
+	baseCtx = ctx
+}
+
+// baseContext returns the context set by SetBaseContext. Writes (merges,
+// reviews, reruns) use it directly: leaving a view must not abort a change
+// halfway, so they are only cancelled when the program exits.
+func baseContext() context.Context {
+	baseMu.RLock()
+	defer baseMu.RUnlock()
+	return baseCtx
+}
+
+// Sources the API calls of each view are counted under (see apiusage)
+const (
+	sourceIssues        = "Issues"
+	sourceIssueDetail   = "Issue detail"
+	sourceMilestones    = "Milestones"
+	sourcePullRequests  = "Pull requests"
+	sourcePRDetail      = "PR detail"
+	sourceReviewQueue   = "Review queue"
+	sourceCommits       = "Commits"
+	sourceCommitDetail  = "Commit detail"
+	sourceDiff          = "Diff"
+	sourceBisect        = "Bisect"
+	sourceSearch        = "Search"
+	sourceMetrics       = "Metrics"
+	sourceReleases      = "Releases"
+	sourceReleaseDetail = "Release detail"
+	sourceReleaseTrain  = "Release train"
+	sourceGists         = "Gists"
+	sourceWorkflows     = "Actions"
+	sourceWorkflowRun   = "Workflow run"
+	sourceWatch         = "Watch"
+	sourceLive          = "Live updates"
+	sourceMyWork        = "My work"
+	sourceStarred       = "Starred"
+)
+
+// sourceContext returns the base context with its API calls counted under source
+func sourceContext(source string) context.Context {
+	return apiusage.WithSource(baseContext(), source)
+}
+
+// loadGroup tracks the fetches a view has in flight so they can be cancelled
+// when the view is closed or the user stops waiting. The zero value is ready
+// to use. Contexts must be taken in Update, not inside the tea.Cmd.
+type loadGroup struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	source string // where the API calls are counted; empty counts them as "other"
+}
+
+// Context returns the context for a new fetch, shared with the fetches already in flight
+func (g *loadGroup) Context() context.Context {
+	if g.ctx == nil || g.ctx.Err() != nil {
+		g.ctx, g.cancel = context.WithCancel(sourceContext(g.source))
+	}
+	return g.ctx
+}
+
+// writeContext returns the context for a write made from the group's view.
+// Like baseContext it is only cancelled when the program exits.
+func (g *loadGroup) writeContext() context.Context {
+	return sourceContext(g.source)
+}
+
+// Restart cancels the fetches in flight and returns the context for the
+// fetch replacing them, so a refresh never races a stale response
+func (g *loadGroup) Restart() context.Context {
+	g.Cancel()
+	return g.Context()
+}
+
+// Cancel cancels the fetches in flight
+func (g *loadGroup) Cancel() {
+	if g.cancel != nil {
+		g.cancel()
+		g.ctx, g.cancel = nil, nil
+	}
+}
+
+// isCancelled reports whether err comes from a fetch cancelled by a loadGroup.
+// Such results are dropped: the user has moved on or another fetch replaced it.
+func isCancelled(err error) bool {
+	return errors.Is(err, context.Canceled)
+}
+
+// loadCancelledStatus is shown when esc stops a list from loading
+const loadCancelledStatus = "Loading cancelled (r to retry)"
@@ -0,0 +1,69 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/infra/apiusage"
+)
+
+func TestLoadGroup_RestartCancelsPrevious(t *testing.T) {
+	var loads loadGroup
+
+	first := loads.Context()
+	if loads.Context() != first {
+		t.Fatal("Context should share the fetches in flight")
+	}
+
+	second := loads.Restart()
+	if first.Err() == nil {
+		t.Error("Restart should cancel the previous context")
+	}
+	if second.Err() != nil {
+		t.Error("Restart should return a live context")
+	}
+
+	loads.Cancel()
+	if second.Err() == nil {
+		t.Error("Cancel should cancel the context in flight")
+	}
+	if loads.Context().Err() != nil {
+		t.Error("Context after Cancel should return a fresh context")
+	}
+}
+
+func TestLoadGroup_DerivesFromBaseContext(t *testing.T) {
+	base, cancel := context.WithCancel(context.Background())
+	SetBaseContext(base)
+	defer SetBaseContext(context.Background())
+
+	var loads loadGroup
+	ctx := loads.Context()
+	cancel()
+	if ctx.Err() == nil {
+		t.Error("cancelling the base context should cancel the fetches")
+	}
+}
+
+func TestLoadGroup_TagsSource(t *testing.T) {
+	loads := loadGroup{source: sourceIssues}
+	if got := apiusage.Source(loads.Context()); got != sourceIssues {
+		t.Errorf("fetch source = %q, want %q", got, sourceIssues)
+	}
+	if got := apiusage.Source(loads.writeContext()); got != sourceIssues {
+		t.Errorf("write source = %q, want %q", got, sourceIssues)
+	}
+	if got := apiusage.Source(NewPRView().loads.Context()); got != sourcePullRequests {
+		t.Errorf("PR view source = %q, want %q", got, sourcePullRequests)
+	}
+}
+
+func TestIsCancelled(t *testing.T) {
+	if !isCancelled(fmt.Errorf("github api error: %w", context.Canceled)) {
+		t.Error("wrapped context.Canceled should count as cancelled")
+	}
+	if isCancelled(context.DeadlineExceeded) || isCancelled(nil) {
+		t.Error("only cancellation should count as cancelled")
+	}
+}
@@ -0,0 +1,195 @@
+package views
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+// markdownImage is an image embedded in a body or comment
+type markdownImage struct {
+	Alt string
+	URL string
+}
+
+// placeholder is the text shown in place of the image
+func (img markdownImage) placeholder() string {
+	if img.Alt == "" {
+		return "[image]"
+	}
+	return fmt.Sprintf("[image: %s]", img.Alt)
+}
+
+var (
+	// markdownImagePattern matches ![alt](url) and ![alt](url "title")
+	markdownImagePattern = regexp.MustCompile(`!\[([^\]\n]*)\]\(\s*<?([^)\s>]+)>?(?:\s+"[^"\n]*")?\s*\)`)
+	// htmlImagePattern matches <img> tags, which GitHub inserts for uploaded screenshots
+	htmlImagePattern = regexp.MustCompile(`(?i)<img\s[^>]*>`)
+	// htmlAttrPattern matches the attributes of a tag
+	htmlAttrPattern = regexp.MustCompile(`(?i)\b(src|alt)\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+)
+
+// parseMarkdownImages returns the images in text in order of appearance;
+// images inside code are ignored
+func parseMarkdownImages(text string) []markdownImage {
+	var images []markdownImage
+	replaceMarkdownImages(text, func(img markdownImage) string {
+		images = append(images, img)
+		return ""
+	})
+	return images
+}
+
+// hasMarkdownImages reports whether any of the texts embeds an image
+func hasMarkdownImages(texts []string) bool {
+	for _, text := range texts {
+		if len(parseMarkdownImages(text)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// withImagePlaceholders replaces the images in markdown with text naming
+// them. Terminals cannot show them inline, and glamour would otherwise
+// print their URLs or drop <img> tags altogether.
+func withImagePlaceholders(text string) string {
+	return replaceMarkdownImages(text, func(img markdownImage) string {
+		return img.placeholder()
+	})
+}
+
+// replaceMarkdownImages replaces each image outside code with the result of replace
+func replaceMarkdownImages(text string, replace func(markdownImage) string) string {
+	lines := strings.Split(text, "\n")
+	fenced := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fenced = !fenced
+			continue
+		}
+		if fenced || !strings.Contains(line, "![") && !strings.Contains(strings.ToLower(line), "<img") {
+			continue
+		}
+		lines[i] = replaceImagesInLine(line, replace)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// replaceImagesInLine replaces the images of a line that are not in code spans
+func replaceImagesInLine(line string, replace func(markdownImage) string) string {
+	code := inlineCodePattern.FindAllStringIndex(line, -1)
+	inCode := func(pos int) bool {
+		for _, span := range code {
+			if pos >= span[0] && pos < span[1] {
+				return true
+			}
+		}
+		return false
+	}
+
+	type found struct {
+		start, end int
+		img        markdownImage
+	}
+	var matches []found
+	for _, m := range markdownImagePattern.FindAllStringSubmatchIndex(line, -1) {
+		matches = append(matches, found{m[0], m[1], markdownImage{Alt: line[m[2]:m[3]], URL: line[m[4]:m[5]]}})
+	}
+	for _, m := range htmlImagePattern.FindAllStringIndex(line, -1) {
+		img := markdownImage{}
+		for _, attr := range htmlAttrPattern.FindAllStringSubmatch(line[m[0]:m[1]], -1) {
+			value := html.UnescapeString(attr[2] + attr[3])
+			if strings.EqualFold(attr[1], "src") {
+				img.URL = value
+			} else {
+				img.Alt = value
+			}
+		}
+		if img.URL != "" {
+			matches = append(matches, found{m[0], m[1], img})
+		}
+	}
+	// Few images per line, so an insertion sort keeps this simple
+	for i := 1; i < len(matches); i++ {
+		for j := i; j > 0 && matches[j].start < matches[j-1].start; j-- {
+			matches[j], matches[j-1] = matches[j-1], matches[j]
+		}
+	}
+
+	var s strings.Builder
+	last := 0
+	for _, m := range matches {
+		if m.start < last || inCode(m.start) {
+			continue
+		}
+		s.WriteString(line[last:m.start])
+		s.WriteString(replace(m.img))
+		last = m.end
+	}
+	s.WriteString(line[last:])
+	return s.String()
+}
+
+// imageCursor is the image selected with i in a detail view
+type imageCursor struct {
+	images   []markdownImage
+	selected int // -1 while no image is selected
+}
+
+// newImageCursor returns a cursor with nothing selected
+func newImageCursor() imageCursor {
+	return imageCursor{selected: -1}
+}
+
+// cycle refreshes the images from texts and moves the selection by delta,
+// wrapping around. It returns false when there are no images.
+func (c *imageCursor) cycle(texts []string, delta int) bool {
+	var images []markdownImage
+	for _, text := range texts {
+		images = append(images, parseMarkdownImages(text)...)
+	}
+
+	c.images = images
+	if len(images) == 0 {
+		c.selected = -1
+		return false
+	}
+	switch {
+	case c.selected < 0 && delta < 0:
+		c.selected = len(images) - 1
+	case c.selected < 0:
+		c.selected = 0
+	default:
+		c.selected = ((c.selected+delta)%len(images) + len(images)) % len(images)
+	}
+	return true
+}
+
+// current returns the selected image
+func (c *imageCursor) current() (markdownImage, bool) {
+	if c.selected < 0 || c.selected >= len(c.images) {
+		return markdownImage{}, false
+	}
+	return c.images[c.selected], true
+}
+
+// clear drops the selection, so o opens the item again
+func (c *imageCursor) clear() {
+	c.selected = -1
+}
+
+// status describes the selected image and what can be done with it
+func (c *imageCursor) status() string {
+	img, ok := c.current()
+	if !ok {
+		return "No images"
+	}
+	action := "o to open"
+	if detectImageProtocol() != imageProtocolNone {
+		action = "I to show, o to open"
+	}
+	return fmt.Sprintf("Image %d/%d: %s (%s)", c.selected+1, len(c.images), img.placeholder(), action)
+}
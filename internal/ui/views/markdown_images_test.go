@@ -0,0 +1,126 @@
+package views
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/png"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestParseMarkdownImages(t *testing.T) {
+	text := strings.Join([]string{
+		"Before ![screenshot](https://example.com/a.png) and ![](https://example.com/b.gif \"title\")",
+		`<img width="300" alt="Crash dialog" src="https://github.com/user-attachments/assets/c1">`,
+		"Not in code: `![code](https://example.com/x.png)`",
+		"```",
+		"![fenced](https://example.com/y.png)",
+		"```",
+	}, "\n")
+
+	want := []markdownImage{
+		{Alt: "screenshot", URL: "https://example.com/a.png"},
+		{Alt: "", URL: "https://example.com/b.gif"},
+		{Alt: "Crash dialog", URL: "https://github.com/user-attachments/assets/c1"},
+	}
+	if got := parseMarkdownImages(text); !reflect.DeepEqual(got, want) {
+		t.Errorf("parseMarkdownImages() = %+v, want %+v", got, want)
+	}
+
+	replaced := withImagePlaceholders(text)
+	for _, want := range []string{"Before [image: screenshot] and [image]", "[image: Crash dialog]", "`![code](https://example.com/x.png)`", "![fenced]"} {
+		if !strings.Contains(replaced, want) {
+			t.Errorf("expected %q in\n%s", want, replaced)
+		}
+	}
+}
+
+func TestIssueDetailView_SelectAndOpenImages(t *testing.T) {
+	var opened []string
+	stubOpenBrowser(t, func(url string) error {
+		opened = append(opened, url)
+		return nil
+	})
+	original := detectImageProtocol
+	detectImageProtocol = func() imageProtocol { return imageProtocolNone }
+	defer func() { detectImageProtocol = original }()
+
+	issue := &models.Issue{Number: 1, Title: "Crash", Body: "![dialog](https://example.com/a.png)", HTMLURL: "https://github.com/owner/repo/issues/1"}
+	view := NewIssueDetailView(issue, "owner", "repo", nil)
+	view.comments = []*models.Comment{{Body: `<img alt="log" src="https://example.com/b.png">`}}
+	view.width, view.height = 100, 40
+
+	if out := ansi.Strip(view.View()); !strings.Contains(out, "[image: dialog]") || strings.Contains(out, "example.com/a.png") {
+		t.Errorf("expected a placeholder in place of the image\n%s", out)
+	}
+
+	press(view, "i")
+	press(view, "i")
+	if !strings.Contains(view.statusMessage, "Image 2/2: [image: log]") {
+		t.Fatalf("status = %q", view.statusMessage)
+	}
+	view.Update(press(view, "o")())
+	if len(opened) != 1 || opened[0] != "https://example.com/b.png" {
+		t.Errorf("expected o to open the selected image, opened %v", opened)
+	}
+
+	// Without a graphics protocol I opens the image in the browser too
+	view.Update(press(view, "I")())
+	if len(opened) != 2 || opened[1] != "https://example.com/b.png" {
+		t.Errorf("expected I to fall back to the browser, opened %v", opened)
+	}
+
+	// Selecting a reference goes back to opening the issue itself
+	press(view, "tab")
+	view.Update(press(view, "o")())
+	if opened[len(opened)-1] != issue.HTMLURL {
+		t.Errorf("expected o to open the issue again, opened %v", opened)
+	}
+}
+
+func TestShowImage_FallsBackToBrowserWhenDownloadFails(t *testing.T) {
+	var opened []string
+	stubOpenBrowser(t, func(url string) error {
+		opened = append(opened, url)
+		return nil
+	})
+	originalDetect, originalFetch := detectImageProtocol, fetchImage
+	detectImageProtocol = func() imageProtocol { return imageProtocolKitty }
+	fetchImage = func(string) ([]byte, error) { return nil, errors.New("404 Not Found") }
+	defer func() { detectImageProtocol, fetchImage = originalDetect, originalFetch }()
+
+	msg := showImage(markdownImage{URL: "https://example.com/private.png"})()
+	if _, ok := msg.(openBrowserMsg); !ok || len(opened) != 1 {
+		t.Errorf("expected the image opened in the browser, got %T, opened %v", msg, opened)
+	}
+}
+
+func TestImageSequence(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 64, 64))); err != nil {
+		t.Fatal(err)
+	}
+	data := append(buf.Bytes(), make([]byte, 8000)...) // trailing bytes make it span chunks
+
+	kitty, err := imageSequence(imageProtocolKitty, data)
+	if err != nil {
+		t.Fatalf("kitty: %v", err)
+	}
+	if !strings.HasPrefix(kitty, "\x1b_Gf=100,a=T,m=1;") || !strings.Contains(kitty, "\x1b_Gm=0;") {
+		t.Errorf("expected the PNG sent in chunks, got %q...", kitty[:40])
+	}
+
+	iterm, err := imageSequence(imageProtocolITerm2, []byte("GIF89a"))
+	if err != nil || !strings.HasPrefix(iterm, "\x1b]1337;File=inline=1;size=6;") {
+		t.Errorf("iTerm2 sequence = %q, %v", iterm, err)
+	}
+
+	if _, err := imageSequence(imageProtocolKitty, []byte("not an image")); err == nil {
+		t.Error("expected an error for data that is not an image")
+	}
+}
@@ -0,0 +1,39 @@
+package views
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/infra/clock"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+)
+
+// freezeNow returns the time freeze windows are checked against (overridable in tests)
+var freezeNow = clock.Now
+
+// activeFreeze returns the freeze window in effect right now, if any
+func activeFreeze(windows models.FreezeWindows) (*models.FreezeWindow, time.Time, bool) {
+	if len(windows) == 0 {
+		return nil, time.Time{}, false
+	}
+	return windows.Active(freezeNow())
+}
+
+// freezeDescription describes a freeze window and when it ends
+func freezeDescription(window *models.FreezeWindow, end time.Time) string {
+	return fmt.Sprintf("Merge freeze: %s until %s", window.Label(), end.Format("Mon Jan 2 15:04"))
+}
+
+// renderFreezeBanner renders a banner while a merge freeze is in effect
+func renderFreezeBanner(windows models.FreezeWindows) string {
+	window, end, ok := activeFreeze(windows)
+	if !ok {
+		return ""
+	}
+
+	if window.Blocks() {
+		return styles.ErrorStyle.Render(styles.IconFreeze + " " + freezeDescription(window, end) + " — merges need an override")
+	}
+	return styles.WarningStyle.Render(styles.IconFreeze + " " + freezeDescription(window, end))
+}
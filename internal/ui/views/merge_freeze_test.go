@@ -0,0 +1,140 @@
+package views
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// setFreezeNow pins the time freeze windows are checked against
+func setFreezeNow(t *testing.T, now time.Time) {
+	t.Helper()
+	original := freezeNow
+	freezeNow = func() time.Time { return now }
+	t.Cleanup(func() { freezeNow = original })
+}
+
+// Fri 2026-10-16 18:30 local time, inside the weekend freeze below
+var fridayEvening = time.Date(2026, 10, 16, 18, 30, 0, 0, time.Local)
+
+var testFreezeWindows = models.FreezeWindows{
+	{Name: "weekend", Start: "Fri 16:00", End: "Mon 09:00"},
+	{Name: "release week", Start: "2026-12-01", End: "2026-12-07", Mode: models.FreezeModeWarn},
+}
+
+func TestFreezeWindows_Active(t *testing.T) {
+	tests := []struct {
+		name    string
+		now     time.Time
+		want    string
+		wantEnd time.Time
+	}{
+		{"friday before the freeze", time.Date(2026, 10, 16, 15, 59, 0, 0, time.Local), "", time.Time{}},
+		{"friday evening", fridayEvening, "weekend", time.Date(2026, 10, 19, 9, 0, 0, 0, time.Local)},
+		{"sunday wraps around the week", time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local), "weekend", time.Date(2026, 10, 19, 9, 0, 0, 0, time.Local)},
+		{"monday after the freeze", time.Date(2026, 10, 19, 9, 0, 0, 0, time.Local), "", time.Time{}},
+		{"last day of the release week", time.Date(2026, 12, 7, 23, 0, 0, 0, time.Local), "release week", time.Date(2026, 12, 8, 0, 0, 0, 0, time.Local)},
+		{"after the release week", time.Date(2026, 12, 8, 10, 0, 0, 0, time.Local), "", time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			window, end, ok := testFreezeWindows.Active(tt.now)
+			if tt.want == "" {
+				if ok {
+					t.Errorf("expected no freeze, got %q", window.Name)
+				}
+				return
+			}
+			if !ok || window.Name != tt.want || !end.Equal(tt.wantEnd) {
+				t.Errorf("got %v until %v, want %q until %v", window, end, tt.want, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestFreezeWindows_Validate(t *testing.T) {
+	if err := testFreezeWindows.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	invalid := []models.FreezeWindow{
+		{Start: "Fri 16:00", End: "2026-12-01"},
+		{Start: "Someday", End: "Mon"},
+		{Start: "2026-12-07", End: "2026-12-01"},
+		{Start: "Fri 25:00", End: "Mon 09:00"},
+		{Start: "Fri", End: "Mon", Mode: "ignore"},
+	}
+	for _, window := range invalid {
+		if err := (models.FreezeWindows{window}).Validate(); err == nil {
+			t.Errorf("expected %+v to be rejected", window)
+		}
+	}
+}
+
+func TestPRDetailView_MergeDuringFreezeNeedsOverride(t *testing.T) {
+	setFreezeNow(t, fridayEvening)
+
+	pr := createTestPullRequest()
+	repo := &testPRRepo{pr: pr}
+	view := NewPRDetailView(pr, "owner", "repo", repo)
+	view.SetFreezeWindows(testFreezeWindows)
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'m'}})
+	typeText(view, "merge")
+	if _, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil {
+		t.Fatal("expected an override confirmation during the freeze")
+	}
+	if out := view.View(); !strings.Contains(out, "Merge freeze: weekend") {
+		t.Fatalf("expected the freeze to be explained\n%s", out)
+	}
+
+	typeText(view, "override")
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected merge command after the override")
+	}
+	cmd()
+	if repo.merge == nil {
+		t.Error("expected the PR to be merged")
+	}
+}
+
+func TestPRDetailView_MergeDuringWarnFreeze(t *testing.T) {
+	setFreezeNow(t, time.Date(2026, 12, 2, 10, 0, 0, 0, time.Local))
+
+	pr := createTestPullRequest()
+	repo := &testPRRepo{pr: pr}
+	view := NewPRDetailView(pr, "owner", "repo", repo)
+	view.SetFreezeWindows(testFreezeWindows)
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'m'}})
+	if out := view.View(); !strings.Contains(out, "Merge freeze: release week") {
+		t.Fatalf("expected a freeze warning in the confirmation\n%s", out)
+	}
+	typeText(view, "merge")
+	if _, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd == nil {
+		t.Fatal("expected a warn-only freeze to merge after the usual confirmation")
+	}
+}
+
+func TestPRQueueView_FreezeBanner(t *testing.T) {
+	view := NewPRQueueView()
+	view.SetFreezeWindows(testFreezeWindows)
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+
+	setFreezeNow(t, time.Date(2026, 10, 14, 12, 0, 0, 0, time.Local))
+	if strings.Contains(view.View(), "Merge freeze") {
+		t.Error("expected no banner outside a freeze")
+	}
+
+	setFreezeNow(t, fridayEvening)
+	if out := view.View(); !strings.Contains(out, "Merge freeze: weekend until Mon Oct 19 09:00") {
+		t.Errorf("expected the freeze banner\n%s", out)
+	}
+}
@@ -0,0 +1,65 @@
+package views
+
+import (
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// mergeablePollInterval is how often a PR whose mergeability GitHub is still
+// computing is fetched again (overridable in tests)
+var mergeablePollInterval = 3 * time.Second
+
+// mergeablePollLimit caps the fetches, so a PR GitHub never settles on does
+// not keep the view polling
+const mergeablePollLimit = 20
+
+// mergeablePollTickMsg asks the PR detail view to fetch the PR again
+type mergeablePollTickMsg struct{}
+
+// prMergeableLoadedMsg carries the PR fetched while polling its mergeability
+type prMergeableLoadedMsg struct {
+	pr  *models.PullRequest
+	err error
+}
+
+// mergeableUnknown reports whether GitHub has yet to compute whether pr can
+// be merged. Until it has, mergeable is null and reads as false.
+func mergeableUnknown(pr *models.PullRequest) bool {
+	return pr.State == models.PRStateOpen && !pr.Merged && pr.MergeableState == "unknown"
+}
+
+// mergeablePoll re-fetches a PR while its mergeability is unknown, so the
+// status line converges instead of showing conflicts that are not there
+type mergeablePoll struct {
+	active   bool
+	attempts int
+}
+
+// start begins polling unless it is already running
+func (p *mergeablePoll) start() tea.Cmd {
+	if p.active {
+		return nil
+	}
+	p.active = true
+	p.attempts = 0
+	return p.next()
+}
+
+// next schedules another fetch, stopping once the limit is reached
+func (p *mergeablePoll) next() tea.Cmd {
+	if p.attempts >= mergeablePollLimit {
+		p.active = false
+		return nil
+	}
+	p.attempts++
+	return tea.Tick(mergeablePollInterval, func(time.Time) tea.Msg {
+		return mergeablePollTickMsg{}
+	})
+}
+
+// stop ends polling
+func (p *mergeablePoll) stop() {
+	p.active = false
+}
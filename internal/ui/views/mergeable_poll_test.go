@@ -0,0 +1,76 @@
+package views
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/events"
+)
+
+func TestPRDetailView_PollsUnknownMergeability(t *testing.T) {
+	original := mergeablePollInterval
+	mergeablePollInterval = time.Millisecond
+	defer func() { mergeablePollInterval = original }()
+
+	// GitHub has not computed mergeability yet: mergeable is null
+	repo := &testPRRepo{pr: &models.PullRequest{Number: 5, State: models.PRStateOpen, MergeableState: "unknown"}}
+	view := NewPRDetailView(&models.PullRequest{Number: 5, State: models.PRStateOpen}, "owner", "repo", repo)
+	view.full.start()
+
+	_, cmd := view.Update(view.loadFull()())
+	if !view.mergeable.active || cmd == nil {
+		t.Fatal("expected an unknown mergeability to be polled")
+	}
+	if status := view.getMergeStatus(); strings.Contains(status, "Conflicts") || !strings.Contains(status, "Checking mergeability") {
+		t.Errorf("expected a pending status instead of conflicts, got %q", status)
+	}
+
+	// Still computing on the next fetch
+	view.Update(mergeablePollTickMsg{})
+	if _, cmd := view.Update(view.loadMergeable()()); cmd == nil || !view.mergeable.active {
+		t.Fatal("expected polling to go on while unknown")
+	}
+
+	repo.pr = &models.PullRequest{Number: 5, State: models.PRStateOpen, Mergeable: true, MergeableState: "clean"}
+	_, cmd = view.Update(view.loadMergeable()())
+	if view.mergeable.active {
+		t.Error("expected polling to stop once computed")
+	}
+	if !view.pr.Mergeable || view.pr.MergeableState != "clean" {
+		t.Errorf("expected the computed mergeability, got %+v", view.pr)
+	}
+	if status := view.getMergeStatus(); strings.Contains(status, "Conflicts") || strings.Contains(status, "Checking") {
+		t.Errorf("expected the real status, got %q", status)
+	}
+	if cmd == nil {
+		t.Fatal("expected the list rows to be told")
+	}
+	if _, ok := cmd().(events.EntityChanged); !ok {
+		t.Error("expected a PR change event")
+	}
+	if _, cmd := view.Update(mergeablePollTickMsg{}); cmd != nil {
+		t.Error("expected a late tick to be ignored")
+	}
+}
+
+func TestMergeablePoll_GivesUp(t *testing.T) {
+	var poll mergeablePoll
+	if poll.start() == nil {
+		t.Fatal("expected the first fetch to be scheduled")
+	}
+	for i := 1; i < mergeablePollLimit; i++ {
+		if poll.next() == nil {
+			t.Fatalf("expected fetch %d to be scheduled", i+1)
+		}
+	}
+	if poll.next() != nil || poll.active {
+		t.Error("expected polling to stop at the limit")
+	}
+
+	view := &PRDetailView{pr: &models.PullRequest{State: models.PRStateOpen, MergeableState: "unknown"}}
+	if status := view.getMergeStatus(); !strings.Contains(status, "Mergeability unknown") {
+		t.Errorf("expected an unknown status after giving up, got %q", status)
+	}
+}
@@ -0,0 +1,123 @@
+package views
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+// metricsFilterKind はメトリクスビューのフィルタの種類
+type metricsFilterKind int
+
+const (
+	metricsFilterRepository metricsFilterKind = iota
+	metricsFilterAuthor
+	metricsFilterReviewer
+)
+
+// metricsFilterKinds はフィルタモードで tab を押したときに切り替わる順
+var metricsFilterKinds = []metricsFilterKind{metricsFilterRepository, metricsFilterAuthor, metricsFilterReviewer}
+
+// String はフィルタモードの見出しに使う名前
+func (k metricsFilterKind) String() string {
+	switch k {
+	case metricsFilterAuthor:
+		return "Author"
+	case metricsFilterReviewer:
+		return "Reviewer"
+	default:
+		return "Repository"
+	}
+}
+
+// next は tab で切り替わる次の種類
+func (k metricsFilterKind) next() metricsFilterKind {
+	return metricsFilterKinds[(int(k)+1)%len(metricsFilterKinds)]
+}
+
+// filterCandidates は kind で絞り込める値（リポジトリ名またはログイン名）を名前順に返す
+func (m *MetricsView) filterCandidates(kind metricsFilterKind) []string {
+	if m.metrics == nil {
+		return nil
+	}
+	switch kind {
+	case metricsFilterAuthor:
+		return sortedStatKeys(m.metrics.ByAuthor)
+	case metricsFilterReviewer:
+		return sortedStatKeys(m.metrics.ByReviewer)
+	default:
+		return m.getRepositoryList()
+	}
+}
+
+// sortedStatKeys は stats のキーを名前順に返す
+func sortedStatKeys(stats map[string]models.LeadTimeStat) []string {
+	keys := make([]string, 0, len(stats))
+	for key := range stats {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// applyFilter は kind の value で絞り込む。ほかの種類のフィルタは外す
+func (m *MetricsView) applyFilter(kind metricsFilterKind, value string) {
+	m.clearFilter()
+	switch kind {
+	case metricsFilterAuthor:
+		m.filteredAuthor = value
+	case metricsFilterReviewer:
+		m.filteredReviewer = value
+	default:
+		m.filteredRepo = value
+	}
+}
+
+// clearFilter はすべてのフィルタを外して全体表示に戻す
+func (m *MetricsView) clearFilter() {
+	m.filteredRepo = ""
+	m.filteredAuthor = ""
+	m.filteredReviewer = ""
+}
+
+// filtered はいずれかのフィルタが有効かどうかを返す
+func (m *MetricsView) filtered() bool {
+	return m.filteredRepo != "" || m.personFiltered()
+}
+
+// personFiltered は作成者またはレビュアーで絞り込んでいるかどうかを返す
+func (m *MetricsView) personFiltered() bool {
+	return m.filteredAuthor != "" || m.filteredReviewer != ""
+}
+
+// filterLabel はヘッダーやステータスバーに表示するフィルタの説明（フィルタなしなら空）
+func (m *MetricsView) filterLabel() string {
+	switch {
+	case m.filteredAuthor != "":
+		return "author @" + m.filteredAuthor
+	case m.filteredReviewer != "":
+		return "reviewer @" + m.filteredReviewer
+	default:
+		return m.filteredRepo
+	}
+}
+
+// personStats は作成者・レビュアーのフィルタ中のリードタイムとレビューフェーズを返す
+// 該当するデータがなければ ok は false
+func (m *MetricsView) personStats() (stat models.LeadTimeStat, phases models.ReviewPhaseMetrics, ok bool) {
+	if m.filteredAuthor != "" {
+		stat, ok = m.metrics.ByAuthor[m.filteredAuthor]
+		phases = m.metrics.ByAuthorPhaseBreakdown[m.filteredAuthor]
+		return stat, phases, ok
+	}
+	stat, ok = m.metrics.ByReviewer[m.filteredReviewer]
+	phases = m.metrics.ByReviewerPhaseBreakdown[m.filteredReviewer]
+	return stat, phases, ok
+}
+
+// personSectionsNote は作成者・レビュアーのフィルタ中に省くセクションについての説明
+// 作成者・レビュアーごとの集計はリードタイムとレビューフェーズのみ
+func (m *MetricsView) personSectionsNote() string {
+	return fmt.Sprintf("Showing lead time and review phases for %s only. Press 'a' to show all.", m.filterLabel())
+}
@@ -0,0 +1,302 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// MetricsRepositoryPicker は計測対象のリポジトリを提案・保存できるユースケース
+// LeadTimeMetricsUseCase がこれを実装していればメトリクスビューで p を押して追加できる
+type MetricsRepositoryPicker interface {
+	// UsingDetectedRepositories は設定が空で、開いているリポジトリを代わりに計測しているかどうか
+	UsingDetectedRepositories() bool
+	// Repositories は計測対象のリポジトリ
+	Repositories() []string
+	// SuggestRepositories は追加できるリポジトリの候補（主要言語・トピックで絞り込める）
+	SuggestRepositories(ctx context.Context) ([]*models.RepositorySummary, error)
+	// AddRepositories は計測対象に repos を加えて設定ファイルに保存する
+	AddRepositories(repos []string) error
+}
+
+type metricsSuggestionsMsg struct {
+	repos []*models.RepositorySummary
+	err   error
+}
+
+type metricsRepositoriesSavedMsg struct {
+	count int
+	err   error
+}
+
+// repoPicker はメトリクス対象に追加するリポジトリの選択状態
+type repoPicker struct {
+	active     bool
+	loading    bool
+	err        error
+	candidates []*models.RepositorySummary
+	visible    []*models.RepositorySummary // candidates を filter で絞り込んだもの
+	filter     string                      // 名前・言語・トピックの絞り込み（f で入力）
+	filtering  bool                        // 絞り込みを入力中かどうか
+	selected   map[string]bool
+	cursor     int
+}
+
+// applyFilter は候補を絞り込み、カーソルを範囲内に収める
+// 選択済みのリポジトリは絞り込みで隠れても選択されたまま
+func (p *repoPicker) applyFilter() {
+	p.visible = p.candidates
+	if filter := parseRepoFilter(p.filter); !filter.empty() {
+		p.visible = nil
+		for _, repo := range p.candidates {
+			if filter.match(repo.FullName, "", repo.Language, repo.Topics) {
+				p.visible = append(p.visible, repo)
+			}
+		}
+	}
+	if p.cursor >= len(p.visible) {
+		p.cursor = len(p.visible) - 1
+	}
+	if p.cursor < 0 {
+		p.cursor = 0
+	}
+}
+
+// selectedCount は選択中のリポジトリ数を返す
+func (p repoPicker) selectedCount() int {
+	count := 0
+	for _, selected := range p.selected {
+		if selected {
+			count++
+		}
+	}
+	return count
+}
+
+// picker はユースケースがリポジトリの追加に対応していれば返す
+func (m *MetricsView) picker() (MetricsRepositoryPicker, bool) {
+	picker, ok := m.useCase.(MetricsRepositoryPicker)
+	return picker, ok
+}
+
+// openRepoPicker はリポジトリ選択を開き、候補を取得する
+func (m *MetricsView) openRepoPicker() tea.Cmd {
+	picker, ok := m.picker()
+	if !ok || m.loading {
+		return nil
+	}
+
+	m.repoPicker = repoPicker{active: true, loading: true, selected: map[string]bool{}}
+	m.scroll = 0
+	ctx := m.loads.Context()
+	return func() tea.Msg {
+		repos, err := picker.SuggestRepositories(ctx)
+		return metricsSuggestionsMsg{repos: repos, err: err}
+	}
+}
+
+// handleRepoPickerKey はリポジトリ選択中のキー入力を処理する
+func (m *MetricsView) handleRepoPickerKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	p := &m.repoPicker
+	if p.filtering {
+		return m.handleRepoPickerFilterKey(msg)
+	}
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc", "q":
+		// 絞り込んでいる場合の esc はまず絞り込みを解除する
+		if msg.String() == "esc" && p.filter != "" {
+			p.filter = ""
+			p.applyFilter()
+			return m, nil
+		}
+		m.loads.Cancel()
+		m.repoPicker = repoPicker{}
+		return m, nil
+	case "f":
+		if len(p.candidates) > 0 {
+			p.filtering = true
+		}
+	case "j", "down":
+		if p.cursor < len(p.visible)-1 {
+			p.cursor++
+		}
+	case "k", "up":
+		if p.cursor > 0 {
+			p.cursor--
+		}
+	case " ", "space":
+		if p.cursor < len(p.visible) {
+			repo := p.visible[p.cursor].FullName
+			p.selected[repo] = !p.selected[repo]
+		}
+	case "enter":
+		return m, m.saveRepoPicker()
+	}
+	return m, nil
+}
+
+// handleRepoPickerFilterKey は絞り込みの入力中のキー入力を処理する
+func (m *MetricsView) handleRepoPickerFilterKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	p := &m.repoPicker
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	case tea.KeyEsc:
+		p.filtering = false
+		p.filter = ""
+	case tea.KeyEnter:
+		p.filtering = false
+	case tea.KeyBackspace:
+		if p.filter != "" {
+			runes := []rune(p.filter)
+			p.filter = string(runes[:len(runes)-1])
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		p.filter += string(msg.Runes)
+	default:
+		return m, nil
+	}
+	p.applyFilter()
+	return m, nil
+}
+
+// saveRepoPicker は選択したリポジトリ（未選択ならカーソル位置のもの）を保存する
+func (m *MetricsView) saveRepoPicker() tea.Cmd {
+	picker, ok := m.picker()
+	p := &m.repoPicker
+	if !ok || p.loading || len(p.candidates) == 0 {
+		return nil
+	}
+
+	var repos []string
+	for _, repo := range p.candidates {
+		if p.selected[repo.FullName] {
+			repos = append(repos, repo.FullName)
+		}
+	}
+	if len(repos) == 0 {
+		if len(p.visible) == 0 {
+			return nil
+		}
+		repos = []string{p.visible[p.cursor].FullName}
+	}
+
+	p.loading = true
+	return func() tea.Msg {
+		return metricsRepositoriesSavedMsg{count: len(repos), err: picker.AddRepositories(repos)}
+	}
+}
+
+// handleRepoPickerMsg はリポジトリ選択の非同期処理の結果を反映する
+func (m *MetricsView) handleRepoPickerMsg(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case metricsSuggestionsMsg:
+		if !m.repoPicker.active || isCancelled(msg.err) {
+			return nil
+		}
+		m.repoPicker.loading = false
+		m.repoPicker.err = msg.err
+		m.repoPicker.candidates = msg.repos
+		m.repoPicker.cursor = 0
+		m.repoPicker.applyFilter()
+
+	case metricsRepositoriesSavedMsg:
+		m.repoPicker.loading = false
+		if msg.err != nil {
+			m.repoPicker.err = msg.err
+			return nil
+		}
+		m.repoPicker = repoPicker{}
+		m.clearFilter()
+		cmd := m.refresh()
+		noun := "repositories"
+		if msg.count == 1 {
+			noun = "repository"
+		}
+		m.notice = fmt.Sprintf("Added %d %s to github.repositories", msg.count, noun)
+		return cmd
+	}
+	return nil
+}
+
+// renderRepoPickerUI はリポジトリ選択画面を描画する
+func (m *MetricsView) renderRepoPickerUI() []string {
+	p := m.repoPicker
+	lines := []string{
+		styles.TitleStyle.Render("Lead Time Metrics"),
+		"",
+		styles.HeaderStyle.Render("Add Repositories to Metrics"),
+	}
+	if picker, ok := m.picker(); ok {
+		lines = append(lines, styles.MutedStyle.Render("Measuring: "+strings.Join(picker.Repositories(), ", ")))
+	}
+	if p.filtering {
+		lines = append(lines, "Filter: "+p.filter+"█")
+	} else if p.filter != "" {
+		lines = append(lines, styles.LabelStyle.Render("Filter: "+p.filter))
+	}
+	lines = append(lines, "")
+
+	help := styles.HelpStyle.Render("Controls: j/k navigate • Space select • f filter (language:go topic:cli) • Enter add and save • Esc cancel")
+	switch {
+	case p.loading && len(p.candidates) == 0:
+		return append(lines, styles.LoadingStyle.Render("Loading repositories..."))
+	case p.err != nil && len(p.candidates) == 0:
+		return append(lines, styles.ErrorStyle.Render(p.err.Error()), "", help)
+	case len(p.candidates) == 0:
+		return append(lines, styles.MutedStyle.Render("No other repositories found."), "", help)
+	case len(p.visible) == 0:
+		return append(lines, styles.MutedStyle.Render("No repositories match "+p.filter), "", help)
+	}
+
+	// 候補が画面に収まらない場合はカーソルの周りだけを表示する
+	footer := []string{"", help}
+	if p.err != nil {
+		footer = append([]string{"", styles.ErrorStyle.Render(p.err.Error())}, footer...)
+	}
+	visible := m.height - 1 - len(lines) - len(footer)
+	if visible < 1 {
+		visible = 1
+	}
+	start := 0
+	if p.cursor >= visible {
+		start = p.cursor - visible + 1
+	}
+	end := start + visible
+	if end > len(p.visible) {
+		end = len(p.visible)
+	}
+
+	for idx := start; idx < end; idx++ {
+		repo := p.visible[idx]
+		prefix := "  "
+		repoStyle := lipgloss.NewStyle()
+		if idx == p.cursor {
+			prefix = "> "
+			repoStyle = repoStyle.Foreground(lipgloss.Color("2")).Bold(true)
+		}
+		check := "[ ] "
+		if p.selected[repo.FullName] {
+			check = "[x] "
+		}
+		line := prefix + check + repoStyle.Render(repo.FullName)
+		if repo.Language != "" {
+			line += "  " + styles.MutedStyle.Render(repo.Language)
+		}
+		if topics := formatTopics(repo.Topics); topics != "" {
+			if room := m.width - lipgloss.Width(line) - 2; room > 10 {
+				line += "  " + styles.LabelStyle.Render(trimColumnText(topics, room))
+			}
+		}
+		lines = append(lines, line)
+	}
+
+	return append(lines, footer...)
+}
@@ -0,0 +1,69 @@
+package views
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// MetricsFailureRetrier は取得に失敗したリポジトリだけを再取得できるユースケース
+// LeadTimeMetricsUseCase がこれを実装していればメトリクスビューで F を押して再試行できる
+type MetricsFailureRetrier interface {
+	// RetryFailedRepositories は previous で失敗したリポジトリだけを取得し直したメトリクスを返す
+	RetryFailedRepositories(ctx context.Context, previous *models.LeadTimeMetrics, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error)
+}
+
+// failedRepositories は直前の取得で失敗したリポジトリを返す
+func (m *MetricsView) failedRepositories() []string {
+	if m.metrics == nil {
+		return nil
+	}
+	return m.metrics.FailedRepositories()
+}
+
+// retrier は失敗したリポジトリがあり、ユースケースが再試行に対応していれば返す
+func (m *MetricsView) retrier() (MetricsFailureRetrier, bool) {
+	retrier, ok := m.useCase.(MetricsFailureRetrier)
+	return retrier, ok && len(m.failedRepositories()) > 0
+}
+
+// retryFailedRepositories は失敗したリポジトリだけを取得し直す
+func (m *MetricsView) retryFailedRepositories() tea.Cmd {
+	retrier, ok := m.retrier()
+	if !ok || m.loading {
+		return nil
+	}
+	previous := m.metrics
+	m.loading = true
+	m.err = nil
+	m.progress = nil
+	m.updateStatusBar()
+	return m.startFetch(func(ctx context.Context, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error) {
+		return retrier.RetryFailedRepositories(ctx, previous, progressFn)
+	})
+}
+
+// renderRepositoryStatusSection はリポジトリごとの取得結果（成功・失敗と理由）を表で返す
+func (m *MetricsView) renderRepositoryStatusSection() []string {
+	statuses := m.metrics.RepositoryStatuses
+	failed := len(m.failedRepositories())
+	lines := []string{
+		styles.HeaderStyle.Render(fmt.Sprintf("Repository Status (%d of %d failed)", failed, len(statuses))),
+		styles.MutedStyle.Render(fmt.Sprintf("%-40s %-7s %s", "Repository", "Status", "Reason")),
+	}
+	for _, status := range statuses {
+		name := fmt.Sprintf("%-40s ", trimColumnText(status.Repository, 40))
+		if status.OK() {
+			lines = append(lines, name+styles.SuccessStyle.Render("ok"))
+			continue
+		}
+		lines = append(lines, name+styles.ErrorStyle.Render(fmt.Sprintf("%-7s %s", "failed", singleLineText(status.Error))))
+	}
+	if _, ok := m.retrier(); ok {
+		lines = append(lines, styles.HelpStyle.Render("Press 'F' to retry the failed repositories."))
+	}
+	return lines
+}
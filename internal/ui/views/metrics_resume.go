@@ -0,0 +1,59 @@
+package views
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// MetricsRunResumer は途中で終わった取得を再開できるユースケース
+// LeadTimeMetricsUseCase がこれを実装していれば、メトリクスビューで F を押して再開できる
+type MetricsRunResumer interface {
+	// InterruptedRun は直前の取得が途中で終わっていればその記録を返す（なければ nil）
+	InterruptedRun() *models.InterruptedMetricsRun
+	// ResumeInterruptedRun は済んでいないリポジトリだけを取得したメトリクスを返す
+	ResumeInterruptedRun(ctx context.Context, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error)
+}
+
+// resumer はユースケースが取得の再開に対応していれば返す
+func (m *MetricsView) resumer() (MetricsRunResumer, bool) {
+	resumer, ok := m.useCase.(MetricsRunResumer)
+	return resumer, ok
+}
+
+// checkInterruptedRun は再開できる取得があるかを確認する
+func (m *MetricsView) checkInterruptedRun() {
+	m.interrupted = nil
+	if resumer, ok := m.resumer(); ok {
+		m.interrupted = resumer.InterruptedRun()
+	}
+}
+
+// resumeInterruptedRun は途中で終わった取得を、済んでいないリポジトリから再開する
+func (m *MetricsView) resumeInterruptedRun() tea.Cmd {
+	resumer, ok := m.resumer()
+	if !ok || m.interrupted == nil || m.loading {
+		return nil
+	}
+	m.interrupted = nil
+	m.loading = true
+	m.err = nil
+	m.progress = nil
+	m.updateStatusBar()
+	return m.startFetch(resumer.ResumeInterruptedRun)
+}
+
+// renderInterruptedRunLine は再開できる取得の案内を返す
+func (m *MetricsView) renderInterruptedRunLine() string {
+	run := m.interrupted
+	done := len(run.Completed)
+	return styles.WarningStyle.Render(fmt.Sprintf(
+		"Interrupted run (last fetched %s): %d of %d repositories done. Press 'F' to resume or 'r' to start over.",
+		run.UpdatedAt.Local().Format("2006-01-02 15:04"),
+		done,
+		done+len(run.Remaining),
+	))
+}
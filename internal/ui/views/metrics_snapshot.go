@@ -0,0 +1,22 @@
+package views
+
+import "fmt"
+
+// MetricsSnapshot はエクスポート済みのメトリクス JSON を返すユースケース
+// LeadTimeMetricsUseCase がこれを実装していれば、計測期間やレート制限など
+// API から取得したときだけ意味のある表示を省く
+type MetricsSnapshot interface {
+	// SnapshotName は読み込んだファイル名
+	SnapshotName() string
+}
+
+// snapshot はユースケースがスナップショットであれば返す
+func (m *MetricsView) snapshot() (MetricsSnapshot, bool) {
+	snapshot, ok := m.useCase.(MetricsSnapshot)
+	return snapshot, ok
+}
+
+// snapshotHeaderLine はスナップショットの読み込み元を示す見出し行を返す
+func snapshotHeaderLine(snapshot MetricsSnapshot) string {
+	return fmt.Sprintf("Snapshot: %s (exported with `tig-gh metrics --json`, read-only)", snapshot.SnapshotName())
+}
@@ -3,22 +3,28 @@ package views
 import (
 	"context"
 	"fmt"
+	"math"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/infra/clipboard"
+	"github.com/a1yama/tig-gh/internal/infra/clock"
 	"github.com/a1yama/tig-gh/internal/ui/components"
 	"github.com/a1yama/tig-gh/internal/ui/styles"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/google/go-github/v57/github"
+	"github.com/charmbracelet/x/ansi"
 )
 
+// copyToClipboard はメトリクスのコピー先（テストで差し替える）
+var copyToClipboard = clipboard.Copy
+
 // LeadTimeMetricsUseCase はメトリクス取得ユースケースの必要インターフェース
 type LeadTimeMetricsUseCase interface {
 	Execute(ctx context.Context, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error)
-	GetRateLimit(ctx context.Context) (*github.Rate, error)
+	GetRateLimit(ctx context.Context) (*models.RateLimit, error)
 }
 
 // MetricsExitMsg はメトリクスビューからの戻る要求を表す
@@ -26,7 +32,7 @@ type MetricsExitMsg struct{}
 
 type metricsLoadedMsg struct {
 	metrics   *models.LeadTimeMetrics
-	rateLimit *github.Rate
+	rateLimit *models.RateLimit
 	err       error
 }
 
@@ -35,7 +41,7 @@ type metricsProgressMsg struct {
 }
 
 type rateLimitFetchedMsg struct {
-	rateLimit *github.Rate
+	rateLimit *models.RateLimit
 	err       error
 }
 
@@ -50,13 +56,26 @@ type MetricsView struct {
 	scroll            int
 	statusBar         *components.StatusBar
 	lastUpdated       time.Time
-	rateLimit         *github.Rate // GitHub API rate limit info
+	rateLimit         *models.RateLimit // GitHub API rate limit info
 	progress          *models.MetricsProgress
 	progressCh        chan models.MetricsProgress
-	filterMode        bool   // フィルタモード中かどうか
-	filteredRepo      string // フィルタ中のリポジトリ（空なら全体表示）
-	selectedRepoIndex int    // フィルタモード中の選択インデックス
+	filterMode        bool              // フィルタモード中かどうか
+	filterKind        metricsFilterKind // フィルタモードで選んでいる種類（tab で切り替え）
+	filteredRepo      string            // フィルタ中のリポジトリ（空なら全体表示）
+	filteredAuthor    string            // フィルタ中のPR作成者（フィルタは1つだけ）
+	filteredReviewer  string            // フィルタ中のレビュアー（フィルタは1つだけ）
+	selectedRepoIndex int               // フィルタモード中の選択インデックス
 	config            *models.MetricsConfig
+	loads             loadGroup           // 実行中の取得（q や esc でキャンセル）
+	notice            string              // コピー結果などの一時的なメッセージ（次のキー入力で消える）
+	repoPicker        repoPicker          // 計測対象に追加するリポジトリの選択（p）
+	content           []string            // 描画済みの行（スクロール以外のメッセージで作り直す）
+	contentKey        metricsContentKey   // content を描画したときの状態
+	contentVersion    int                 // content を作り直した回数
+	viewport          components.Viewport // content のうち画面に収まる行
+
+	// interrupted は再開できる途中で終わった取得（F で再開、r で最初から取得）
+	interrupted *models.InterruptedMetricsRun
 }
 
 func defaultMetricsConfig() *models.MetricsConfig {
@@ -75,6 +94,7 @@ func cloneMetricsConfig(cfg *models.MetricsConfig) *models.MetricsConfig {
 // NewMetricsView は空のメトリクスビューを返す
 func NewMetricsView() *MetricsView {
 	return &MetricsView{
+		loads:     loadGroup{source: sourceMetrics},
 		statusBar: components.NewStatusBar(),
 		loading:   false,
 		scroll:    0,
@@ -97,6 +117,10 @@ func (m *MetricsView) Init() tea.Cmd {
 	if m.useCase == nil {
 		return nil
 	}
+	// 前回の取得が途中で終わっていれば、再開するか最初からやり直すかを選んでもらう
+	if m.checkInterruptedRun(); m.interrupted != nil {
+		return nil
+	}
 	m.loading = true
 	m.err = nil
 	m.progress = nil
@@ -110,8 +134,16 @@ func (m *MetricsView) fetchMetrics() tea.Cmd {
 			return metricsLoadedMsg{metrics: nil, err: fmt.Errorf("metrics use case not initialized")}
 		}
 	}
+	return m.startFetch(m.useCase.Execute)
+}
+
+// metricsExecuteFunc はメトリクスを計算する処理（全体の取得または失敗分の再取得）
+type metricsExecuteFunc func(ctx context.Context, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error)
 
-	progressCh := make(chan models.MetricsProgress, 1)
+// startFetch は execute をバックグラウンドで実行し、結果と進捗を待つコマンドを返す
+func (m *MetricsView) startFetch(execute metricsExecuteFunc) tea.Cmd {
+	ctx := m.loads.Restart()
+	progressCh := make(chan models.MetricsProgress, 32)
 	resultCh := make(chan metricsLoadedMsg, 1)
 	m.progressCh = progressCh
 
@@ -125,28 +157,29 @@ func (m *MetricsView) fetchMetrics() tea.Cmd {
 			}
 		}
 
-		metrics, err := m.useCase.Execute(context.Background(), progressFn)
-		var rateLimit *github.Rate
-
-		if err == nil {
-			// Fetch rate limit info (best effort)
-			rate, rateLimitErr := m.useCase.GetRateLimit(context.Background())
-			if rateLimitErr == nil {
-				rateLimit = rate
-			}
-		}
-
-		resultCh <- metricsLoadedMsg{
-			metrics:   metrics,
-			rateLimit: rateLimit,
-			err:       err,
-		}
+		resultCh <- loadMetrics(ctx, m.useCase, execute, progressFn)
 		close(resultCh)
 	}()
 
 	return tea.Batch(waitForMetrics(resultCh), m.listenForProgress(progressCh))
 }
 
+// loadMetrics computes the metrics and the rate limit, reporting a panic as an error
+func loadMetrics(ctx context.Context, useCase LeadTimeMetricsUseCase, execute metricsExecuteFunc, progressFn func(models.MetricsProgress)) (msg metricsLoadedMsg) {
+	defer recoverPanic(&msg.err)
+
+	metrics, err := execute(ctx, progressFn)
+	msg = metricsLoadedMsg{metrics: metrics, err: err}
+
+	if err == nil {
+		// Fetch rate limit info (best effort)
+		if rate, rateLimitErr := useCase.GetRateLimit(ctx); rateLimitErr == nil {
+			msg.rateLimit = rate
+		}
+	}
+	return msg
+}
+
 func waitForMetrics(ch <-chan metricsLoadedMsg) tea.Cmd {
 	return func() tea.Msg {
 		msg, ok := <-ch
@@ -167,7 +200,18 @@ func (m *MetricsView) listenForProgress(ch <-chan models.MetricsProgress) tea.Cm
 		if !ok {
 			return nil
 		}
-		return metricsProgressMsg{progress: progress}
+		// 溜まっている進捗は最新のものだけを使う
+		for {
+			select {
+			case latest, ok := <-ch:
+				if !ok {
+					return metricsProgressMsg{progress: progress}
+				}
+				progress = latest
+			default:
+				return metricsProgressMsg{progress: progress}
+			}
+		}
 	}
 }
 
@@ -178,8 +222,9 @@ func (m *MetricsView) fetchRateLimitCmd() tea.Cmd {
 		}
 	}
 
+	ctx := m.loads.Context()
 	return func() tea.Msg {
-		rate, err := m.useCase.GetRateLimit(context.Background())
+		rate, err := m.useCase.GetRateLimit(ctx)
 		return rateLimitFetchedMsg{
 			rateLimit: rate,
 			err:       err,
@@ -189,27 +234,50 @@ func (m *MetricsView) fetchRateLimitCmd() tea.Cmd {
 
 // Update はBubble Teaメッセージを処理する
 func (m *MetricsView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// スクロールでは内容が変わらないため、描画済みの行をそのまま使う
+	if !m.isScrollKey(msg) {
+		m.content = nil
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		return m.handleKey(msg)
 
+	case retryMsg:
+		// エラーバナーからの再試行
+		if isRetryFor(msg, m) {
+			return m, m.refresh()
+		}
+		return m, nil
+
 	case metricsLoadedMsg:
+		if isCancelled(msg.err) {
+			// esc や再取得でキャンセルされた結果は捨てる
+			return m, nil
+		}
 		m.loading = false
 		m.rateLimit = msg.rateLimit
 		m.progress = nil
 		m.progressCh = nil
+		m.interrupted = nil
 		if msg.err != nil {
 			m.err = msg.err
 			m.metrics = nil
 			m.rateLimit = nil
+			m.checkInterruptedRun()
 		} else {
 			m.err = nil
 			m.metrics = msg.metrics
-			m.lastUpdated = time.Now()
+			m.lastUpdated = clock.Now()
 			m.scroll = 0
 		}
 		m.updateStatusBar()
-		return m, nil
+		return m, reportLoadError(m, "metrics", msg.err)
+
+	case metricsSuggestionsMsg, metricsRepositoriesSavedMsg:
+		cmd := m.handleRepoPickerMsg(msg)
+		m.updateStatusBar()
+		return m, cmd
 
 	case metricsProgressMsg:
 		progress := msg.progress
@@ -238,37 +306,86 @@ func (m *MetricsView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// refresh は読み込み中でなければメトリクスを再取得する
+func (m *MetricsView) refresh() tea.Cmd {
+	if m.loading {
+		return nil
+	}
+	m.interrupted = nil
+	m.loading = true
+	m.err = nil
+	m.progress = nil
+	m.updateStatusBar()
+	return m.fetchMetrics()
+}
+
 func (m *MetricsView) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// フィルタモード中の処理
 	if m.filterMode {
 		return m.handleFilterModeKey(msg)
 	}
+	if m.repoPicker.active {
+		return m.handleRepoPickerKey(msg)
+	}
 
 	// 通常モードの処理
+	m.notice = ""
 	switch msg.String() {
 	case "ctrl+c":
 		return m, tea.Quit
 	case "q":
+		m.loads.Cancel()
 		return m, func() tea.Msg { return MetricsExitMsg{} }
+	case "esc":
+		// 読み込み中ならキャンセルする
+		if m.loading {
+			m.loads.Cancel()
+			m.loading = false
+			m.progress = nil
+			m.progressCh = nil
+			m.notice = loadCancelledStatus
+			m.checkInterruptedRun()
+			m.updateStatusBar()
+		}
+		return m, nil
+	case "p":
+		// 計測対象のリポジトリを追加する
+		return m, m.openRepoPicker()
 	case "f":
 		// フィルタモードに入る
 		m.enterFilterMode()
 		return m, nil
 	case "a":
 		// 全体表示に戻る
-		m.filteredRepo = ""
+		m.clearFilter()
 		m.scroll = 0
 		return m, nil
 	case "r":
-		if !m.loading {
-			m.loading = true
-			m.err = nil
-			m.progress = nil
-			m.updateStatusBar()
-			return m, m.fetchMetrics()
+		// スナップショットは読み直しても変わらない
+		if _, ok := m.snapshot(); ok {
+			return m, nil
+		}
+		return m, m.refresh()
+	case "F":
+		// 途中で終わった取得を再開するか、取得に失敗したリポジトリだけを再取得する
+		if m.interrupted != nil {
+			return m, m.resumeInterruptedRun()
 		}
+		return m, m.retryFailedRepositories()
+	case "y":
+		// 表示中のセクションをコピー
+		m.copyReport(false)
+		m.updateStatusBar()
+		return m, nil
+	case "Y":
+		// レポート全体をコピー
+		m.copyReport(true)
+		m.updateStatusBar()
 		return m, nil
 	case "l": // Show rate limit
+		if _, ok := m.snapshot(); ok {
+			return m, nil
+		}
 		return m, m.fetchRateLimitCmd()
 	case "j", "down":
 		maxScroll := m.maxScroll()
@@ -293,40 +410,45 @@ func (m *MetricsView) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 }
 
 func (m *MetricsView) handleFilterModeKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	repoList := m.getRepositoryList()
-	if len(repoList) == 0 {
+	if len(m.getRepositoryList()) == 0 {
 		m.filterMode = false
 		return m, nil
 	}
+	candidates := m.filterCandidates(m.filterKind)
 
 	switch msg.String() {
 	case "esc", "ctrl+c":
 		// フィルタモードをキャンセル
 		m.filterMode = false
 		return m, nil
+	case "tab":
+		// リポジトリ・作成者・レビュアーを切り替える
+		m.filterKind = m.filterKind.next()
+		m.selectedRepoIndex = 0
+		return m, nil
 	case "j", "down":
-		// 次のリポジトリを選択
-		if m.selectedRepoIndex < len(repoList)-1 {
+		// 次の候補を選択
+		if m.selectedRepoIndex < len(candidates)-1 {
 			m.selectedRepoIndex++
 		}
 		return m, nil
 	case "k", "up":
-		// 前のリポジトリを選択
+		// 前の候補を選択
 		if m.selectedRepoIndex > 0 {
 			m.selectedRepoIndex--
 		}
 		return m, nil
 	case "enter":
 		// フィルタを適用
-		if m.selectedRepoIndex >= 0 && m.selectedRepoIndex < len(repoList) {
-			m.filteredRepo = repoList[m.selectedRepoIndex]
+		if m.selectedRepoIndex >= 0 && m.selectedRepoIndex < len(candidates) {
+			m.applyFilter(m.filterKind, candidates[m.selectedRepoIndex])
 			m.scroll = 0
 		}
 		m.filterMode = false
 		return m, nil
 	case "a":
 		// 全体表示に戻る
-		m.filteredRepo = ""
+		m.clearFilter()
 		m.scroll = 0
 		m.filterMode = false
 		return m, nil
@@ -338,6 +460,15 @@ func (m *MetricsView) handleFilterModeKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 func (m *MetricsView) enterFilterMode() {
 	m.filterMode = true
 	m.selectedRepoIndex = 0
+	// 適用中のフィルタと同じ種類から選び直せるようにする
+	switch {
+	case m.filteredAuthor != "":
+		m.filterKind = metricsFilterAuthor
+	case m.filteredReviewer != "":
+		m.filterKind = metricsFilterReviewer
+	default:
+		m.filterKind = metricsFilterRepository
+	}
 }
 
 func (m *MetricsView) getRepositoryList() []string {
@@ -359,27 +490,13 @@ func (m *MetricsView) View() string {
 		return "Initializing metrics view..."
 	}
 
-	contentLines := m.renderContentLines()
-	availableHeight := m.height - 1
-	if availableHeight < 1 {
-		availableHeight = 1
-	}
-
-	maxScroll := len(contentLines) - availableHeight
-	if maxScroll < 0 {
-		maxScroll = 0
-	}
-	if m.scroll > maxScroll {
-		m.scroll = maxScroll
-	}
-
-	start := m.scroll
-	end := start + availableHeight
-	if end > len(contentLines) {
-		end = len(contentLines)
-	}
+	contentLines := m.contentLines()
+	m.viewport.SetHeight(m.height - 1)
+	m.viewport.SetContent(m.contentVersion, len(contentLines))
+	m.viewport.SetOffset(m.scroll)
+	m.scroll = m.viewport.Offset()
 
-	body := strings.Join(contentLines[start:end], "\n")
+	body := strings.Join(m.viewport.Rows(func(i int) string { return contentLines[i] }), "\n")
 
 	m.updateStatusBar()
 	return lipgloss.JoinVertical(
@@ -389,35 +506,64 @@ func (m *MetricsView) View() string {
 	)
 }
 
-func (m *MetricsView) renderContentLines() []string {
-	lines := []string{
-		styles.TitleStyle.Render("Lead Time Metrics"),
-	}
+// metricsContentKey は描画内容を左右する主な状態。変わったら描画し直す
+type metricsContentKey struct {
+	metrics           *models.LeadTimeMetrics
+	loading           bool
+	width             int
+	filterMode        bool
+	filterKind        metricsFilterKind
+	filteredRepo      string
+	filteredAuthor    string
+	filteredReviewer  string
+	selectedRepoIndex int
+}
 
-	// 計測期間を別行で表示
-	if m.config != nil && m.config.CalculationPeriod > 0 {
-		days := int(m.config.CalculationPeriod.Hours() / 24)
-		endDate := time.Now()
-		startDate := endDate.Add(-m.config.CalculationPeriod)
-		periodLine := fmt.Sprintf("Period: %s ~ %s (%d days)",
-			startDate.Format("2006-01-02"),
-			endDate.Format("2006-01-02"),
-			days)
-		lines = append(lines, styles.MutedStyle.Render(periodLine))
-	}
+// contentLines は描画済みの行を返す。無ければ描画する
+func (m *MetricsView) contentLines() []string {
+	key := metricsContentKey{
+		metrics:           m.metrics,
+		loading:           m.loading,
+		width:             m.width,
+		filterMode:        m.filterMode,
+		filterKind:        m.filterKind,
+		filteredRepo:      m.filteredRepo,
+		filteredAuthor:    m.filteredAuthor,
+		filteredReviewer:  m.filteredReviewer,
+		selectedRepoIndex: m.selectedRepoIndex,
+	}
+	if m.content == nil || key != m.contentKey {
+		m.content = m.renderContentLines()
+		m.contentKey = key
+		m.contentVersion++
+	}
+	return m.content
+}
 
-	// フィルタ状態を表示
-	if m.filteredRepo != "" {
-		lines = append(lines, styles.WarningStyle.Render(fmt.Sprintf("Filtered: %s", m.filteredRepo)))
+// IsCapturingInput はリポジトリ選択の絞り込みを入力中かどうかを返す
+func (m *MetricsView) IsCapturingInput() bool {
+	return m.repoPicker.active && m.repoPicker.filtering
+}
+
+// isScrollKey はメッセージが内容を変えないスクロールキーかどうかを返す
+func (m *MetricsView) isScrollKey(msg tea.Msg) bool {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok || m.filterMode || m.repoPicker.active {
+		return false
+	}
+	switch key.String() {
+	case "j", "down", "k", "up", "g", "G":
+		return true
 	}
+	return false
+}
 
-	if m.lastUpdated.IsZero() {
-		lines = append(lines, styles.MutedStyle.Render("No data fetched yet. Press 'r' to load metrics."))
-	} else {
-		lines = append(lines, styles.MutedStyle.Render(fmt.Sprintf("Last updated: %s", m.lastUpdated.Format("2006-01-02 15:04:05"))))
+func (m *MetricsView) renderContentLines() []string {
+	if m.repoPicker.active {
+		return m.renderRepoPickerUI()
 	}
 
-	lines = append(lines, "")
+	lines := m.renderHeaderLines()
 
 	if m.loading {
 		lines = append(lines, styles.LoadingStyle.Render("Fetching lead time metrics..."))
@@ -427,7 +573,15 @@ func (m *MetricsView) renderContentLines() []string {
 	if m.err != nil {
 		lines = append(lines, styles.ErrorStyle.Render(m.err.Error()))
 		lines = append(lines, "")
-		lines = append(lines, styles.HelpStyle.Render("Press 'r' to retry or 'q' to go back."))
+		if _, ok := m.picker(); ok {
+			lines = append(lines, styles.HelpStyle.Render("Press 'p' to pick repositories to measure, 'r' to retry or 'q' to go back."))
+		} else {
+			lines = append(lines, styles.HelpStyle.Render("Press 'r' to retry or 'q' to go back."))
+		}
+		return lines
+	}
+
+	if m.metrics == nil && m.interrupted != nil {
 		return lines
 	}
 
@@ -443,39 +597,204 @@ func (m *MetricsView) renderContentLines() []string {
 		return m.renderFilterModeUI()
 	}
 
-	lines = append(lines, m.renderOverallSection()...)
-	lines = append(lines, "")
+	for _, section := range m.renderSections() {
+		lines = append(lines, section...)
+		lines = append(lines, "")
+	}
+
+	// ヘルプテキストを更新
+	helpText := "Controls: j/k scroll • r refresh • f filter • a show all • y copy section • Y copy report • q back"
+	if _, ok := m.picker(); ok {
+		helpText = "Controls: j/k scroll • r refresh • f filter • a show all • p add repositories • y copy section • Y copy report • q back"
+	}
+	if _, ok := m.retrier(); ok {
+		helpText = strings.Replace(helpText, "r refresh", "r refresh • F retry failed", 1)
+	}
+	if _, ok := m.snapshot(); ok {
+		helpText = "Controls: j/k scroll • f filter • a show all • y copy section • Y copy report • q quit"
+	}
+	lines = append(lines, styles.HelpStyle.Render(helpText))
 
+	return lines
+}
+
+// renderHeaderLines はタイトル・計測期間・フィルタ状態・更新時刻の行を返す（末尾は空行）
+func (m *MetricsView) renderHeaderLines() []string {
+	lines := []string{
+		styles.TitleStyle.Render("Lead Time Metrics"),
+	}
+
+	// スナップショットは読み込み元を示し、現在時刻から求める計測期間は表示しない
+	snapshot, isSnapshot := m.snapshot()
+	if isSnapshot {
+		lines = append(lines, styles.WarningStyle.Render(snapshotHeaderLine(snapshot)))
+	} else if m.config != nil && m.config.CalculationPeriod > 0 {
+		days := int(m.config.CalculationPeriod.Hours() / 24)
+		endDate := clock.Now()
+		startDate := endDate.Add(-m.config.CalculationPeriod)
+		periodLine := fmt.Sprintf("Period: %s ~ %s (%d days)",
+			startDate.Format("2006-01-02"),
+			endDate.Format("2006-01-02"),
+			days)
+		lines = append(lines, styles.MutedStyle.Render(periodLine))
+	}
+
+	// github.repositories が空なら、開いているリポジトリを計測していることを示す
+	if picker, ok := m.picker(); ok && picker.UsingDetectedRepositories() {
+		repos := picker.Repositories()
+		if len(repos) > 0 {
+			lines = append(lines, styles.WarningStyle.Render(fmt.Sprintf("Repositories: %s (no github.repositories configured)", strings.Join(repos, ", "))))
+		}
+	}
+
+	// フィルタ状態を表示
+	if m.filtered() {
+		lines = append(lines, styles.WarningStyle.Render(fmt.Sprintf("Filtered: %s", m.filterLabel())))
+	}
+	if m.personFiltered() {
+		lines = append(lines, styles.MutedStyle.Render(m.personSectionsNote()))
+	}
+
+	if m.interrupted != nil && !m.loading {
+		lines = append(lines, m.renderInterruptedRunLine())
+	} else if m.lastUpdated.IsZero() {
+		if !isSnapshot {
+			lines = append(lines, styles.MutedStyle.Render("No data fetched yet. Press 'r' to load metrics."))
+		}
+	} else if !isSnapshot {
+		lines = append(lines, styles.MutedStyle.Render(fmt.Sprintf("Last updated: %s", m.lastUpdated.Format("2006-01-02 15:04:05"))))
+	}
+
+	return append(lines, "")
+}
+
+// renderSections は設定で有効なセクションを表示順に返す（各セクションの先頭行が見出し）
+func (m *MetricsView) renderSections() [][]string {
+	var sections [][]string
+	// 一部のリポジトリの取得に失敗した場合は、どのリポジトリがなぜ失敗したかを最初に示す
+	if len(m.failedRepositories()) > 0 {
+		sections = append(sections, m.renderRepositoryStatusSection())
+	}
+	sections = append(sections, m.renderOverallSection())
+	// 作成者・レビュアーごとに集計しているのはリードタイムとレビューフェーズのみ
+	if m.personFiltered() {
+		if m.config.ShowReviewPhases {
+			sections = append(sections, m.renderReviewPhaseSection())
+		}
+		return sections
+	}
+	if m.config.ShowTrend {
+		sections = append(sections, m.renderTrendSection())
+	}
+	if m.config.ShowIssueBacklog {
+		sections = append(sections, m.renderIssueBacklogSection())
+	}
+	if m.config.ShowLabelInsights {
+		sections = append(sections, m.renderLabelInsightsSection())
+	}
 	if m.config.ShowReviewPhases {
-		lines = append(lines, m.renderReviewPhaseSection()...)
-		lines = append(lines, "")
+		sections = append(sections, m.renderReviewPhaseSection())
 	}
 	if m.config.ShowDayOfWeek {
-		lines = append(lines, m.renderDayOfWeekSection()...)
-		lines = append(lines, "")
+		sections = append(sections, m.renderDayOfWeekSection())
 	}
 	if m.config.ShowWeeklyComparison {
-		lines = append(lines, m.renderWeeklyComparisonSection()...)
-		lines = append(lines, "")
+		sections = append(sections, m.renderWeeklyComparisonSection())
 	}
 	if m.config.ShowQualityIssues {
-		lines = append(lines, m.renderPRQualitySection()...)
-		lines = append(lines, "")
+		sections = append(sections, m.renderPRQualitySection())
 	}
 	if m.config.ShowStagnantPRs {
-		lines = append(lines, m.renderStagnantPRSection()...)
-		lines = append(lines, "")
+		sections = append(sections, m.renderStagnantPRSection())
 	}
 	if m.config.ShowRepositoryStats {
-		lines = append(lines, m.renderRepositorySection()...)
-		lines = append(lines, "")
+		sections = append(sections, m.renderRepositorySection())
 	}
+	return sections
+}
 
-	// ヘルプテキストを更新
-	helpText := "Controls: j/k scroll • r refresh • f filter • a show all • q back"
-	lines = append(lines, styles.HelpStyle.Render(helpText))
+// sectionAtScroll は表示領域の先頭にあるセクションの番号を返す
+func (m *MetricsView) sectionAtScroll(sections [][]string) int {
+	line := len(m.renderHeaderLines())
+	current := 0
+	for i, section := range sections {
+		if line > m.scroll {
+			break
+		}
+		current = i
+		line += len(section) + 1
+	}
+	return current
+}
 
-	return lines
+// copyReport は表示中のセクション（all なら全体）を Markdown でクリップボードにコピーする
+func (m *MetricsView) copyReport(all bool) {
+	if m.loading || m.err != nil || m.metrics == nil || m.filterMode {
+		m.notice = "Nothing to copy"
+		return
+	}
+
+	sections := m.renderSections()
+	var text, what string
+	if all {
+		text = m.reportMarkdown(sections)
+		what = "report"
+	} else {
+		section := sections[m.sectionAtScroll(sections)]
+		text = sectionMarkdown(section)
+		what = fmt.Sprintf("%q", plainTitle(section[0]))
+	}
+
+	if err := copyToClipboard(text); err != nil {
+		m.notice = fmt.Sprintf("Copy failed: %v", err)
+		return
+	}
+	m.notice = fmt.Sprintf("Copied %s as Markdown", what)
+}
+
+// reportMarkdown はヘッダとすべてのセクションを Markdown にする
+func (m *MetricsView) reportMarkdown(sections [][]string) string {
+	var b strings.Builder
+	header := m.renderHeaderLines()
+	b.WriteString("## " + plainTitle(header[0]) + "\n\n")
+	for _, line := range header[1:] {
+		if text := plainTitle(line); text != "" {
+			b.WriteString("- " + text + "\n")
+		}
+	}
+	for _, section := range sections {
+		b.WriteString("\n" + sectionMarkdown(section))
+	}
+	return b.String()
+}
+
+// sectionMarkdown はセクションを見出しと整形済みテキストのブロックにする。
+// 表の列揃えを保つため本文はコードブロックに入れる。
+func sectionMarkdown(section []string) string {
+	var b strings.Builder
+	b.WriteString("### " + plainTitle(section[0]) + "\n")
+
+	var body []string
+	for _, line := range section[1:] {
+		body = append(body, strings.Split(plainLine(line), "\n")...)
+	}
+	for len(body) > 0 && strings.TrimSpace(body[len(body)-1]) == "" {
+		body = body[:len(body)-1]
+	}
+	if len(body) > 0 {
+		b.WriteString("\n```text\n" + strings.Join(body, "\n") + "\n```\n")
+	}
+	return b.String()
+}
+
+// plainLine は描画済みの行から装飾と行末の空白を取り除く（字下げは残す）
+func plainLine(line string) string {
+	return strings.TrimRight(ansi.Strip(line), " ")
+}
+
+// plainTitle は描画済みの見出しから装飾と前後の余白を取り除く
+func plainTitle(line string) string {
+	return strings.TrimSpace(ansi.Strip(line))
 }
 
 func (m *MetricsView) renderFilterModeUI() []string {
@@ -483,10 +802,13 @@ func (m *MetricsView) renderFilterModeUI() []string {
 		styles.TitleStyle.Render("Lead Time Metrics"),
 	}
 
-	// 計測期間を別行で表示
-	if m.config != nil && m.config.CalculationPeriod > 0 {
+	// スナップショットは読み込み元を示し、現在時刻から求める計測期間は表示しない
+	snapshot, isSnapshot := m.snapshot()
+	if isSnapshot {
+		lines = append(lines, styles.WarningStyle.Render(snapshotHeaderLine(snapshot)))
+	} else if m.config != nil && m.config.CalculationPeriod > 0 {
 		days := int(m.config.CalculationPeriod.Hours() / 24)
-		endDate := time.Now()
+		endDate := clock.Now()
 		startDate := endDate.Add(-m.config.CalculationPeriod)
 		periodLine := fmt.Sprintf("Period: %s ~ %s (%d days)",
 			startDate.Format("2006-01-02"),
@@ -495,20 +817,32 @@ func (m *MetricsView) renderFilterModeUI() []string {
 		lines = append(lines, styles.MutedStyle.Render(periodLine))
 	}
 
+	if !isSnapshot {
+		lines = append(lines, styles.MutedStyle.Render(fmt.Sprintf("Last updated: %s", m.lastUpdated.Format("2006-01-02 15:04:05"))))
+	}
+	kinds := make([]string, 0, len(metricsFilterKinds))
+	for _, kind := range metricsFilterKinds {
+		if kind == m.filterKind {
+			kinds = append(kinds, "["+kind.String()+"]")
+		} else {
+			kinds = append(kinds, kind.String())
+		}
+	}
 	lines = append(lines,
-		styles.MutedStyle.Render(fmt.Sprintf("Last updated: %s", m.lastUpdated.Format("2006-01-02 15:04:05"))),
 		"",
-		styles.HeaderStyle.Render("Select Repository to Filter"),
+		styles.HeaderStyle.Render(fmt.Sprintf("Select %s to Filter", m.filterKind)),
+		styles.MutedStyle.Render("Filter by: "+strings.Join(kinds, "  ")),
 		"",
 	)
 
-	repoList := m.getRepositoryList()
-	if len(repoList) == 0 {
-		lines = append(lines, styles.MutedStyle.Render("No repositories available."))
+	candidates := m.filterCandidates(m.filterKind)
+	if len(candidates) == 0 {
+		lines = append(lines, styles.MutedStyle.Render(fmt.Sprintf("No %s available.", strings.ToLower(m.filterKind.String())+"s")))
+		lines = append(lines, "", styles.HelpStyle.Render("Controls: tab repository/author/reviewer • a show all • Esc cancel"))
 		return lines
 	}
 
-	for idx, repo := range repoList {
+	for idx, repo := range candidates {
 		prefix := "  "
 		repoStyle := lipgloss.NewStyle()
 		if idx == m.selectedRepoIndex {
@@ -519,7 +853,7 @@ func (m *MetricsView) renderFilterModeUI() []string {
 	}
 
 	lines = append(lines, "")
-	helpText := "Controls: j/k navigate • Enter apply filter • a show all • Esc cancel"
+	helpText := "Controls: j/k navigate • tab repository/author/reviewer • Enter apply filter • a show all • Esc cancel"
 	lines = append(lines, styles.HelpStyle.Render(helpText))
 
 	return lines
@@ -529,7 +863,17 @@ func (m *MetricsView) renderOverallSection() []string {
 	header := "Overall Lead Time"
 	stat := m.metrics.Overall
 
-	if m.filteredRepo != "" {
+	if m.personFiltered() {
+		header = fmt.Sprintf("Lead Time - %s", m.filterLabel())
+		personStat, _, ok := m.personStats()
+		if !ok {
+			return []string{
+				styles.HeaderStyle.Render(header),
+				styles.MutedStyle.Render(fmt.Sprintf("No lead time data for %s.", m.filterLabel())),
+			}
+		}
+		stat = personStat
+	} else if m.filteredRepo != "" {
 		header = fmt.Sprintf("Lead Time - %s", m.filteredRepo)
 		if repoStat, ok := m.metrics.ByRepository[m.filteredRepo]; ok {
 			stat = repoStat
@@ -547,18 +891,290 @@ func (m *MetricsView) renderOverallSection() []string {
 
 	if stat.Count == 0 {
 		lines = append(lines, styles.MutedStyle.Render("No merged PRs in the selected period."))
-		return lines
+		return append(lines, m.renderExcludedPRsLine()...)
 	}
 
-	lines = append(lines, fmt.Sprintf("Average: %s  Median: %s  PRs: %d",
+	percentiles := ""
+	for _, p := range stat.Percentiles {
+		percentiles += fmt.Sprintf("p%d: %s  ", p.Percentile, formatDuration(p.Value))
+	}
+	lines = append(lines, fmt.Sprintf("Average: %s  Median: %s  %sPRs: %d",
 		formatDuration(stat.Average),
 		formatDuration(stat.Median),
+		percentiles,
 		stat.Count,
 	))
 
+	return append(lines, m.renderExcludedPRsLine()...)
+}
+
+// renderExcludedPRsLine は作成者で集計から除いたPRの数を返す（除外した数は全体でのみ持つ）
+func (m *MetricsView) renderExcludedPRsLine() []string {
+	if m.filtered() || m.metrics.ExcludedPRs == 0 {
+		return nil
+	}
+	noun := "PRs"
+	if m.metrics.ExcludedPRs == 1 {
+		noun = "PR"
+	}
+	return []string{styles.MutedStyle.Render(fmt.Sprintf(
+		"Excluded %d merged %s by bots or metrics.exclude_authors.", m.metrics.ExcludedPRs, noun))}
+}
+
+// percentileHeader はパーセンタイル列の見出し（" p50 p90" のように各列の前に空白を付ける）
+func percentileHeader(columns []models.LeadTimePercentile) string {
+	var b strings.Builder
+	for _, column := range columns {
+		fmt.Fprintf(&b, " %12s", fmt.Sprintf("p%d", column.Percentile))
+	}
+	return b.String()
+}
+
+// percentileCells は columns の順に stat のパーセンタイル値を並べる（ない列は "-"）
+func percentileCells(columns []models.LeadTimePercentile, stat models.LeadTimeStat) string {
+	var b strings.Builder
+	for _, column := range columns {
+		value := time.Duration(0)
+		for _, p := range stat.Percentiles {
+			if p.Percentile == column.Percentile {
+				value = p.Value
+				break
+			}
+		}
+		fmt.Fprintf(&b, " %12s", formatDuration(value))
+	}
+	return b.String()
+}
+
+// sparklineLevels はスパークラインの描画に使うブロック文字
+var sparklineLevels = []rune("▁▂▃▄▅▆▇█")
+
+// trendBarWidth はトレンドチャートの棒の最大幅
+const trendBarWidth = 30
+
+func (m *MetricsView) renderTrendSection() []string {
+	lines := []string{
+		styles.HeaderStyle.Render("Lead Time Trend"),
+	}
+
+	if m.filteredRepo != "" {
+		lines = append(lines, styles.MutedStyle.Render("Trend is available for all repositories only. Press 'a' to show all."))
+		return lines
+	}
+
+	trend := m.metrics.Trend
+	maxLeadTime := time.Duration(0)
+	for _, point := range trend {
+		if point.AverageLeadTime > maxLeadTime {
+			maxLeadTime = point.AverageLeadTime
+		}
+	}
+
+	if len(trend) == 0 || maxLeadTime == 0 {
+		lines = append(lines, styles.MutedStyle.Render("No trend data available."))
+		return lines
+	}
+
+	lines = append(lines, "  "+renderSparkline(trend))
+
+	for _, point := range trend {
+		if point.PRCount == 0 {
+			lines = append(lines, fmt.Sprintf("  %-10s %s", point.Period, styles.MutedStyle.Render("no merges")))
+			continue
+		}
+		barLen := int(float64(point.AverageLeadTime) / float64(maxLeadTime) * trendBarWidth)
+		if barLen < 1 {
+			barLen = 1
+		}
+		bar := strings.Repeat("█", barLen) + strings.Repeat(" ", trendBarWidth-barLen)
+		lines = append(lines, fmt.Sprintf("  %-10s %s %8s  (%d PRs)",
+			point.Period,
+			bar,
+			formatDuration(point.AverageLeadTime),
+			point.PRCount,
+		))
+	}
+
 	return lines
 }
 
+// renderSparkline は期間ごとの平均リードタイムを1行のスパークラインに変換する
+func renderSparkline(trend []models.TrendPoint) string {
+	maxLeadTime := time.Duration(0)
+	for _, point := range trend {
+		if point.AverageLeadTime > maxLeadTime {
+			maxLeadTime = point.AverageLeadTime
+		}
+	}
+
+	var b strings.Builder
+	for _, point := range trend {
+		if point.PRCount == 0 || maxLeadTime == 0 {
+			b.WriteRune(' ')
+			continue
+		}
+		level := int(float64(point.AverageLeadTime) / float64(maxLeadTime) * float64(len(sparklineLevels)-1))
+		b.WriteRune(sparklineLevels[level])
+	}
+	return b.String()
+}
+
+// backlogSymbols はラベル区分ごとの塗り文字（other は常に backlogOtherSymbol）
+var backlogSymbols = []rune("█▓▒")
+
+// backlogOtherSymbol はどのラベル区分にも当てはまらないIssueの塗り文字
+const backlogOtherSymbol = '░'
+
+// renderIssueBacklogSection は週ごとのオープンIssue数をラベル区分で積み上げた横棒で表示する
+func (m *MetricsView) renderIssueBacklogSection() []string {
+	backlog := m.metrics.IssueBacklog
+	weeks := backlog.Weeks
+	header := fmt.Sprintf("Open Issues (last %d weeks)", len(weeks))
+	if m.filteredRepo != "" {
+		weeks = backlog.ByRepository[m.filteredRepo]
+		header = fmt.Sprintf("Open Issues - %s (last %d weeks)", m.filteredRepo, len(weeks))
+	}
+	lines := []string{styles.HeaderStyle.Render(header)}
+
+	maxTotal := 0
+	for _, week := range weeks {
+		if total := week.Total(); total > maxTotal {
+			maxTotal = total
+		}
+	}
+	if len(weeks) == 0 || len(backlog.Buckets) == 0 {
+		lines = append(lines, styles.MutedStyle.Render("No issue data available."))
+		return lines
+	}
+
+	legend := make([]string, 0, len(backlog.Buckets))
+	for i, bucket := range backlog.Buckets {
+		legend = append(legend, fmt.Sprintf("%c %s", backlogSymbol(i, len(backlog.Buckets)), bucket))
+	}
+	lines = append(lines, "  "+styles.MutedStyle.Render(strings.Join(legend, "  ")))
+
+	for _, week := range weeks {
+		counts := make([]int, len(backlog.Buckets))
+		for i, bucket := range backlog.Buckets {
+			counts[i] = week.Open[bucket]
+		}
+		lines = append(lines, fmt.Sprintf("  %-10s %s %4d",
+			week.End.Format("01/02"),
+			renderStackedBar(counts, maxTotal, trendBarWidth),
+			week.Total(),
+		))
+	}
+
+	first, last := weeks[0].Total(), weeks[len(weeks)-1].Total()
+	lines = append(lines, styles.MutedStyle.Render(fmt.Sprintf("  %d open now (%+d since %s)", last, last-first, weeks[0].End.Format("01/02"))))
+
+	return lines
+}
+
+// labelInsightsLimit はよく使われるラベル・組み合わせを表示する件数
+const labelInsightsLimit = 8
+
+// renderLabelInsightsSection はラベルの使われ方を表示する。
+// ラベルなしのIssueの割合・よく使われるラベル・一緒に付くことが多いラベルの組み合わせを並べ、
+// 全リポジトリ表示ではリポジトリごとのラベルなしの割合も示す。
+func (m *MetricsView) renderLabelInsightsSection() []string {
+	backlog := m.metrics.IssueBacklog
+	insights := backlog.Labels
+	header := "Label Insights"
+	if m.filteredRepo != "" {
+		insights = backlog.LabelsByRepository[m.filteredRepo]
+		header = "Label Insights - " + m.filteredRepo
+	}
+	lines := []string{styles.HeaderStyle.Render(header)}
+
+	if insights.Issues == 0 {
+		lines = append(lines, styles.MutedStyle.Render("No issue data available."))
+		return lines
+	}
+
+	lines = append(lines, fmt.Sprintf("  %d of %d issues unlabeled (%.0f%%)",
+		insights.Unlabeled, insights.Issues, insights.UnlabeledRatio()*100))
+
+	top := insights.TopLabels(labelInsightsLimit)
+	if len(top) > 0 {
+		lines = append(lines, "", styles.MutedStyle.Render("  Most used labels"))
+		maxCount := top[0].Count
+		for _, label := range top {
+			barLen := int(float64(label.Count) / float64(maxCount) * trendBarWidth)
+			if barLen < 1 {
+				barLen = 1
+			}
+			lines = append(lines, fmt.Sprintf("  %-24s %s %4d",
+				trimColumnText(label.Labels[0], 24),
+				strings.Repeat("█", barLen)+strings.Repeat(" ", trendBarWidth-barLen),
+				label.Count,
+			))
+		}
+	}
+
+	if pairs := insights.TopPairs(labelInsightsLimit); len(pairs) > 0 {
+		lines = append(lines, "", styles.MutedStyle.Render("  Often used together"))
+		for _, pair := range pairs {
+			lines = append(lines, fmt.Sprintf("  %-40s %4d",
+				trimColumnText(strings.Join(pair.Labels, " + "), 40),
+				pair.Count,
+			))
+		}
+	}
+
+	// ラベル付けが手薄なリポジトリを見つけられるよう、ラベルなしの割合が高い順に並べる
+	if m.filteredRepo == "" && len(backlog.LabelsByRepository) > 1 {
+		repoNames := make([]string, 0, len(backlog.LabelsByRepository))
+		for name := range backlog.LabelsByRepository {
+			repoNames = append(repoNames, name)
+		}
+		sort.Slice(repoNames, func(i, j int) bool {
+			a, b := backlog.LabelsByRepository[repoNames[i]], backlog.LabelsByRepository[repoNames[j]]
+			if a.UnlabeledRatio() != b.UnlabeledRatio() {
+				return a.UnlabeledRatio() > b.UnlabeledRatio()
+			}
+			return repoNames[i] < repoNames[j]
+		})
+		lines = append(lines, "", styles.MutedStyle.Render(fmt.Sprintf("  %-40s %10s %6s", "Unlabeled by repository", "Unlabeled", "Issues")))
+		for _, name := range repoNames {
+			repo := backlog.LabelsByRepository[name]
+			lines = append(lines, fmt.Sprintf("  %-40s %9.0f%% %6d",
+				trimColumnText(name, 40),
+				repo.UnlabeledRatio()*100,
+				repo.Issues,
+			))
+		}
+	}
+
+	return lines
+}
+
+// backlogSymbol は n 区分中 i 番目の区分の塗り文字を返す（最後の区分は other）
+func backlogSymbol(i, n int) rune {
+	if i == n-1 {
+		return backlogOtherSymbol
+	}
+	return backlogSymbols[i%len(backlogSymbols)]
+}
+
+// renderStackedBar は区分ごとの件数を maxTotal が width になる縮尺で積み上げた棒にする。
+// 累積値で端を丸めるため、合計が同じ棒は区分の内訳によらず同じ長さになる。
+func renderStackedBar(counts []int, maxTotal, width int) string {
+	var b strings.Builder
+	drawn, cumulative := 0, 0
+	for i, count := range counts {
+		cumulative += count
+		end := 0
+		if maxTotal > 0 {
+			end = int(math.Round(float64(cumulative) / float64(maxTotal) * float64(width)))
+		}
+		b.WriteString(strings.Repeat(string(backlogSymbol(i, len(counts))), end-drawn))
+		drawn = end
+	}
+	b.WriteString(strings.Repeat(" ", width-drawn))
+	return b.String()
+}
+
 func (m *MetricsView) renderStagnantPRSection() []string {
 	stagnant := m.metrics.StagnantPRs
 	lines := []string{
@@ -618,7 +1234,17 @@ func (m *MetricsView) renderReviewPhaseSection() []string {
 	header := "Review Phase Breakdown"
 	phaseMetrics := m.metrics.PhaseBreakdown
 
-	if m.filteredRepo != "" {
+	if m.personFiltered() {
+		header = fmt.Sprintf("%s (Filtered: %s)", header, m.filterLabel())
+		_, personPhases, ok := m.personStats()
+		if !ok || personPhases.SampleCount == 0 {
+			return []string{
+				styles.HeaderStyle.Render(header),
+				styles.MutedStyle.Render(fmt.Sprintf("Not enough review phase data for %s.", m.filterLabel())),
+			}
+		}
+		phaseMetrics = personPhases
+	} else if m.filteredRepo != "" {
 		header = fmt.Sprintf("%s (Filtered: %s)", header, m.filteredRepo)
 		if m.metrics.ByRepositoryPhaseBreakdown != nil {
 			if repoPhase, ok := m.metrics.ByRepositoryPhaseBreakdown[m.filteredRepo]; ok {
@@ -766,9 +1392,10 @@ func (m *MetricsView) renderWeeklyComparisonSection() []string {
 	col2 := fmt.Sprintf("%10s", reviewChangeStr)
 	col3 := fmt.Sprintf("%10s", mergeChangeStr)
 
-	// Apply colors using ANSI codes for precise control
-	col2Colored := applyChangeColorANSI(col2, comparison.ReviewChangePercent)
-	col3Colored := applyChangeColorANSI(col3, comparison.MergeChangePercent)
+	// Apply colors through lipgloss so the terminal's color profile is respected
+	// (plain text on terminals without ANSI support, e.g. legacy Windows consoles)
+	col2Colored := applyChangeColor(col2, comparison.ReviewChangePercent)
+	col3Colored := applyChangeColor(col3, comparison.MergeChangePercent)
 
 	changeLine := fmt.Sprintf("%-25s %s %s", col1, col2Colored, col3Colored)
 	lines = append(lines, changeLine)
@@ -992,16 +1619,19 @@ func (m *MetricsView) renderRepositorySection() []string {
 		return lines
 	}
 
-	header := fmt.Sprintf("%-40s %12s %12s %6s", "Repository", "Avg", "Median", "PRs")
+	// パーセンタイルの列は全体と同じ（設定の metrics.lead_time_percentiles）
+	columns := m.metrics.Overall.Percentiles
+	header := fmt.Sprintf("%-40s %12s %12s%s %6s", "Repository", "Avg", "Median", percentileHeader(columns), "PRs")
 	lines = append(lines, styles.MutedStyle.Render(header))
 
 	for _, name := range repoNames {
 		stat := m.metrics.ByRepository[name]
 		line := fmt.Sprintf(
-			"%-40s %12s %12s %6d",
+			"%-40s %12s %12s%s %6d",
 			name,
 			formatDuration(stat.Average),
 			formatDuration(stat.Median),
+			percentileCells(columns, stat),
 			stat.Count,
 		)
 		lines = append(lines, line)
@@ -1019,20 +1649,24 @@ func (m *MetricsView) updateStatusBar() {
 
 	mode := "Metrics"
 	switch {
+	case m.repoPicker.active:
+		mode = "Repositories"
 	case m.filterMode:
 		mode = "Filter"
 	case m.loading:
 		mode = "Loading"
 	case m.err != nil:
 		mode = "Error"
-	case m.filteredRepo != "":
+	case m.filtered():
 		mode = "Filtered"
 	}
 	m.statusBar.SetMode(mode)
 
 	var status string
-	if m.filterMode {
-		status = "Select repository to filter"
+	if m.repoPicker.active {
+		status = fmt.Sprintf("Select repositories to add • %d selected", m.repoPicker.selectedCount())
+	} else if m.filterMode {
+		status = fmt.Sprintf("Select %s to filter", strings.ToLower(m.filterKind.String()))
 	} else if m.loading {
 		if m.progress != nil && m.progress.TotalRepos > 0 {
 			status = fmt.Sprintf("Loading metrics... (%d/%d repositories)",
@@ -1047,11 +1681,7 @@ func (m *MetricsView) updateStatusBar() {
 		}
 		// Show rate limit even during loading
 		if m.rateLimit != nil {
-			status = fmt.Sprintf("%s • API: %d/%d remaining",
-				status,
-				m.rateLimit.Remaining,
-				m.rateLimit.Limit,
-			)
+			status = fmt.Sprintf("%s • %s", status, formatRateLimit(m.rateLimit))
 		}
 	} else if m.err != nil {
 		status = "Error loading metrics"
@@ -1059,44 +1689,68 @@ func (m *MetricsView) updateStatusBar() {
 			status = fmt.Sprintf("%s: %s", status, errMsg)
 		}
 	} else if m.metrics != nil {
-		if m.filteredRepo != "" {
-			status = fmt.Sprintf("Filtered: %s", m.filteredRepo)
+		if m.filtered() {
+			status = fmt.Sprintf("Filtered: %s", m.filterLabel())
 		} else {
 			repoCount := len(m.metrics.ByRepository)
 			status = fmt.Sprintf("Metrics loaded • %d repositories", repoCount)
+			if failed := len(m.failedRepositories()); failed > 0 {
+				status = fmt.Sprintf("%s • %d failed", status, failed)
+			}
 		}
 
-		if m.rateLimit != nil {
-			status = fmt.Sprintf("%s • API: %d/%d remaining",
-				status,
-				m.rateLimit.Remaining,
-				m.rateLimit.Limit,
-			)
+		if _, ok := m.snapshot(); !ok {
+			status = fmt.Sprintf("%s • %s", status, formatRateLimit(m.rateLimit))
 		}
 	} else {
 		status = "Press 'r' to load metrics"
 	}
+	if m.notice != "" && !m.filterMode && !m.repoPicker.active {
+		status = m.notice
+	}
 
 	m.statusBar.SetMessage(status)
 
 	m.statusBar.ClearItems()
-	if m.filterMode {
+	if m.repoPicker.active {
+		m.statusBar.AddItem("Space", "select")
+		m.statusBar.AddItem("f", "filter")
+		m.statusBar.AddItem("Enter", "add")
+		m.statusBar.AddItem("Esc", "cancel")
+	} else if m.filterMode {
 		m.statusBar.AddItem("j/k", "navigate")
+		m.statusBar.AddItem("tab", "kind")
 		m.statusBar.AddItem("Enter", "apply")
 		m.statusBar.AddItem("a", "show all")
 		m.statusBar.AddItem("Esc", "cancel")
 	} else {
+		_, isSnapshot := m.snapshot()
 		m.statusBar.AddItem("j/k", "scroll")
-		m.statusBar.AddItem("r", "refresh")
+		if !isSnapshot {
+			m.statusBar.AddItem("r", "refresh")
+		}
+		if m.interrupted != nil && !m.loading {
+			m.statusBar.AddItem("F", "resume")
+		} else if _, ok := m.retrier(); ok {
+			m.statusBar.AddItem("F", "retry failed")
+		}
 		m.statusBar.AddItem("f", "filter")
-		if m.filteredRepo != "" {
+		if m.filtered() {
 			m.statusBar.AddItem("a", "show all")
 		}
-		m.statusBar.AddItem("l", "rate limit")
-		m.statusBar.AddItem("q", "back")
+		if _, ok := m.picker(); ok {
+			m.statusBar.AddItem("p", "add repos")
+		}
+		m.statusBar.AddItem("y/Y", "copy")
+		if isSnapshot {
+			m.statusBar.AddItem("q", "quit")
+		} else {
+			m.statusBar.AddItem("l", "rate limit")
+			m.statusBar.AddItem("q", "back")
+		}
 	}
 
-	if !m.loading && m.err == nil && !m.lastUpdated.IsZero() && !m.filterMode {
+	if _, isSnapshot := m.snapshot(); !isSnapshot && !m.loading && m.err == nil && !m.lastUpdated.IsZero() && !m.filterMode && !m.repoPicker.active {
 		m.statusBar.AddItem("Updated", m.lastUpdated.Format("15:04:05"))
 	}
 
@@ -1106,7 +1760,7 @@ func (m *MetricsView) updateStatusBar() {
 }
 
 func (m *MetricsView) maxScroll() int {
-	lines := m.renderContentLines()
+	lines := m.contentLines()
 	available := m.height - 1
 	if available < 1 {
 		return 0
@@ -1188,20 +1842,11 @@ func applyChangeColor(paddedStr string, value float64) string {
 	}
 }
 
-func applyChangeColorANSI(text string, value float64) string {
-	const (
-		reset = "\033[0m"
-		green = "\033[32;1m" // bold green
-		red   = "\033[31;1m" // bold red
-		gray  = "\033[90m"   // dark gray
-	)
-
-	switch {
-	case value > 0:
-		return green + text + reset
-	case value < 0:
-		return red + text + reset
-	default:
-		return gray + text + reset
+// formatRateLimit はAPIレート制限の表示文字列を返す
+// サーバーがレート制限を報告しない場合（GHES でレート制限が無効など）は "unknown" と表示する
+func formatRateLimit(rate *models.RateLimit) string {
+	if rate == nil || !rate.Known {
+		return "API: unknown"
 	}
+	return fmt.Sprintf("API: %d/%d remaining", rate.Remaining, rate.Limit)
 }
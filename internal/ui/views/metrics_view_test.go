@@ -2,20 +2,21 @@ package views
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/infra/clock"
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/google/go-github/v57/github"
 )
 
 type stubLeadTimeUseCase struct {
 	metrics   *models.LeadTimeMetrics
 	err       error
 	callCount int
-	rateLimit *github.Rate
+	rateLimit *models.RateLimit
 }
 
 func (s *stubLeadTimeUseCase) Execute(ctx context.Context, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error) {
@@ -26,10 +27,11 @@ func (s *stubLeadTimeUseCase) Execute(ctx context.Context, progressFn func(model
 	return s.metrics, nil
 }
 
-func (s *stubLeadTimeUseCase) GetRateLimit(ctx context.Context) (*github.Rate, error) {
+func (s *stubLeadTimeUseCase) GetRateLimit(ctx context.Context) (*models.RateLimit, error) {
 	if s.rateLimit == nil {
 		// Return a default rate limit for testing
-		return &github.Rate{
+		return &models.RateLimit{
+			Known:     true,
 			Limit:     5000,
 			Remaining: 4850,
 		}, nil
@@ -102,6 +104,267 @@ func TestMetricsViewViewContainsSections(t *testing.T) {
 	assertContains(t, output, "High Priority:")
 }
 
+func TestMetricsViewTrendSection(t *testing.T) {
+	cfg := models.DefaultConfig()
+	view := NewMetricsViewWithUseCase(nil, &cfg.Metrics)
+	view.metrics = sampleMetrics()
+	view.lastUpdated = time.Now()
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 80})
+
+	output := view.View()
+	assertContains(t, output, "Lead Time Trend")
+	assertContains(t, output, "2025-W02")
+	assertContains(t, output, "(5 PRs)")
+
+	cfg.Metrics.ShowTrend = false
+	hidden := NewMetricsViewWithUseCase(nil, &cfg.Metrics)
+	hidden.metrics = sampleMetrics()
+	hidden.lastUpdated = time.Now()
+	hidden.Update(tea.WindowSizeMsg{Width: 100, Height: 80})
+	if strings.Contains(hidden.View(), "Lead Time Trend") {
+		t.Fatal("expected trend section to be hidden")
+	}
+}
+
+func TestMetricsViewLeadTimePercentiles(t *testing.T) {
+	metrics := sampleMetrics()
+	metrics.Overall.Percentiles = []models.LeadTimePercentile{
+		{Percentile: 50, Value: 24 * time.Hour},
+		{Percentile: 90, Value: 72 * time.Hour},
+	}
+	metrics.ByRepository["owner/repo-a"] = models.LeadTimeStat{
+		Average:     24 * time.Hour,
+		Median:      18 * time.Hour,
+		Count:       6,
+		Percentiles: []models.LeadTimePercentile{{Percentile: 50, Value: 18 * time.Hour}, {Percentile: 90, Value: 50 * time.Hour}},
+	}
+
+	cfg := models.DefaultConfig()
+	view := NewMetricsViewWithUseCase(nil, &cfg.Metrics)
+	view.metrics = metrics
+
+	overall := strings.Join(view.renderOverallSection(), "\n")
+	assertContains(t, overall, "p90")
+	assertContains(t, overall, "3d")
+
+	repos := strings.Join(view.renderRepositorySection(), "\n")
+	assertContains(t, repos, "p50")
+	assertContains(t, repos, "2d 2h")
+	// 古いスナップショットなどパーセンタイルのないリポジトリは "-"
+	for _, line := range view.renderRepositorySection() {
+		if strings.HasPrefix(line, "owner/repo-b") && !strings.Contains(line, " - ") {
+			t.Fatalf("expected missing percentiles to render as '-', got %q", line)
+		}
+	}
+}
+
+func TestMetricsViewExcludedPRs(t *testing.T) {
+	metrics := sampleMetrics()
+	metrics.ExcludedPRs = 3
+
+	cfg := models.DefaultConfig()
+	view := NewMetricsViewWithUseCase(nil, &cfg.Metrics)
+	view.metrics = metrics
+
+	assertContains(t, strings.Join(view.renderOverallSection(), "\n"), "Excluded 3 merged PRs by bots or metrics.exclude_authors.")
+
+	// The count covers all repositories, so a filtered view leaves it out
+	view.filteredRepo = "owner/repo-a"
+	if overall := strings.Join(view.renderOverallSection(), "\n"); strings.Contains(overall, "Excluded") {
+		t.Fatalf("expected no excluded count for a single repository:\n%s", overall)
+	}
+}
+
+func TestMetricsViewFilterByAuthorAndReviewer(t *testing.T) {
+	cfg := models.DefaultConfig()
+	view := NewMetricsViewWithUseCase(nil, &cfg.Metrics)
+	view.metrics = sampleMetrics()
+	view.metrics.ByAuthor = map[string]models.LeadTimeStat{
+		"alice": {Average: 5 * time.Hour, Median: 5 * time.Hour, Count: 3},
+		"bob":   {Average: 50 * time.Hour, Median: 50 * time.Hour, Count: 9},
+	}
+	view.metrics.ByAuthorPhaseBreakdown = map[string]models.ReviewPhaseMetrics{
+		"alice": {CreatedToFirstReview: time.Hour, TotalLeadTime: 5 * time.Hour, SampleCount: 3},
+	}
+	view.metrics.ByReviewer = map[string]models.LeadTimeStat{
+		"carol": {Average: 20 * time.Hour, Median: 20 * time.Hour, Count: 7},
+	}
+	view.lastUpdated = time.Now()
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 120})
+
+	// tab switches the filter mode from repositories to authors
+	press(view, "f")
+	press(view, "tab")
+	output := view.View()
+	assertContains(t, output, "Select Author to Filter")
+	assertContains(t, output, "Filter by: Repository  [Author]  Reviewer")
+	assertContains(t, output, "> alice")
+
+	press(view, "enter")
+	if view.filteredAuthor != "alice" || view.filteredRepo != "" {
+		t.Fatalf("expected the author filter, got author %q repo %q", view.filteredAuthor, view.filteredRepo)
+	}
+	output = view.View()
+	assertContains(t, output, "Filtered: author @alice")
+	assertContains(t, output, "Lead Time - author @alice")
+	assertContains(t, output, "PRs: 3")
+	assertContains(t, output, "Review Phase Breakdown (Filtered: author @alice)")
+	if strings.Contains(output, "Per Repository") {
+		t.Fatalf("expected sections without per-author data to be left out:\n%s", output)
+	}
+
+	// Choosing a reviewer replaces the author filter
+	press(view, "f")
+	press(view, "tab")
+	press(view, "enter")
+	if view.filteredReviewer != "carol" || view.filteredAuthor != "" {
+		t.Fatalf("expected only the reviewer filter, got reviewer %q author %q", view.filteredReviewer, view.filteredAuthor)
+	}
+	output = view.View()
+	assertContains(t, output, "Lead Time - reviewer @carol")
+	assertContains(t, output, "Not enough review phase data for reviewer @carol.")
+
+	press(view, "a")
+	if view.filtered() {
+		t.Fatal("expected a to clear the filter")
+	}
+	assertContains(t, view.View(), "Per Repository")
+}
+
+func TestMetricsViewFixedClock(t *testing.T) {
+	now := time.Date(2025, 1, 22, 12, 0, 0, 0, time.UTC)
+	t.Cleanup(clock.Set(clock.Fixed(now)))
+
+	render := func() string {
+		cfg := models.DefaultConfig()
+		cfg.Metrics.CalculationPeriod = 14 * 24 * time.Hour
+		view := NewMetricsViewWithUseCase(nil, &cfg.Metrics)
+		view.metrics = sampleMetrics()
+		view.lastUpdated = now
+		view.Update(tea.WindowSizeMsg{Width: 100, Height: 60})
+		return view.View()
+	}
+
+	output := render()
+	assertContains(t, output, "Period: 2025-01-08 ~ 2025-01-22 (14 days)")
+	if again := render(); again != output {
+		t.Fatalf("expected identical output with a fixed clock:\n%s\n---\n%s", output, again)
+	}
+}
+
+func TestMetricsViewIssueBacklogSection(t *testing.T) {
+	end := time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC)
+	week := func(offset int, bug, enhancement, other int) models.IssueBacklogWeek {
+		return models.IssueBacklogWeek{
+			End:  end.AddDate(0, 0, 7*offset),
+			Open: map[string]int{"bug": bug, "enhancement": enhancement, "other": other},
+		}
+	}
+
+	cfg := models.DefaultConfig()
+	view := NewMetricsViewWithUseCase(nil, &cfg.Metrics)
+	view.metrics = sampleMetrics()
+	view.metrics.IssueBacklog = models.IssueBacklogMetrics{
+		Buckets: []string{"bug", "enhancement", "other"},
+		Weeks:   []models.IssueBacklogWeek{week(0, 2, 1, 1), week(1, 4, 2, 4)},
+		ByRepository: map[string][]models.IssueBacklogWeek{
+			"owner/repo-a": {week(0, 1, 0, 0), week(1, 3, 0, 0)},
+		},
+	}
+	view.lastUpdated = time.Now()
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 120})
+
+	output := view.View()
+	assertContains(t, output, "Open Issues (last 2 weeks)")
+	assertContains(t, output, "█ bug  ▓ enhancement  ░ other")
+	assertContains(t, output, "03/10      ")
+	assertContains(t, output, strings.Repeat("█", 12)+strings.Repeat("▓", 6)+strings.Repeat("░", 12)+"   10")
+	assertContains(t, output, "10 open now (+6 since 03/03)")
+
+	view.filteredRepo = "owner/repo-a"
+	assertContains(t, view.View(), "Open Issues - owner/repo-a (last 2 weeks)")
+	assertContains(t, view.View(), "3 open now (+2 since 03/03)")
+
+	view.filteredRepo = "owner/unknown"
+	assertContains(t, view.View(), "No issue data available.")
+}
+
+func TestMetricsViewLabelInsightsSection(t *testing.T) {
+	var repoA, repoB models.LabelInsights
+	repoA.Add([]string{"bug", "ui"})
+	repoA.Add([]string{"bug", "ui"})
+	repoA.Add([]string{"docs"})
+	repoA.Add(nil)
+	repoB.Add(nil)
+	repoB.Add(nil)
+	var all models.LabelInsights
+	all.Merge(repoA)
+	all.Merge(repoB)
+
+	cfg := models.DefaultConfig()
+	view := NewMetricsViewWithUseCase(nil, &cfg.Metrics)
+	view.metrics = sampleMetrics()
+	view.metrics.IssueBacklog = models.IssueBacklogMetrics{
+		Labels: all,
+		LabelsByRepository: map[string]models.LabelInsights{
+			"owner/repo-a": repoA,
+			"owner/repo-b": repoB,
+		},
+	}
+	view.lastUpdated = time.Now()
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 200})
+
+	output := view.View()
+	assertContains(t, output, "Label Insights")
+	assertContains(t, output, "3 of 6 issues unlabeled (50%)")
+	assertContains(t, output, "bug                      "+strings.Repeat("█", trendBarWidth)+"    2")
+	assertContains(t, output, "bug + ui")
+	// ラベルなしの割合が高いリポジトリが先に並ぶ
+	if a, b := strings.Index(output, "owner/repo-b  "), strings.Index(output, "owner/repo-a  "); a < 0 || b < 0 || a > b {
+		t.Fatalf("expected repositories ordered by unlabeled ratio:\n%s", output)
+	}
+
+	view.filteredRepo = "owner/repo-a"
+	output = view.View()
+	assertContains(t, output, "Label Insights - owner/repo-a")
+	assertContains(t, output, "1 of 4 issues unlabeled (25%)")
+
+	cfg.Metrics.ShowLabelInsights = false
+	view = NewMetricsViewWithUseCase(nil, &cfg.Metrics)
+	view.metrics = sampleMetrics()
+	view.lastUpdated = time.Now()
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 200})
+	if strings.Contains(view.View(), "Label Insights") {
+		t.Fatalf("label insights should be hidden")
+	}
+}
+
+func TestRenderStackedBar(t *testing.T) {
+	// 合計が同じなら内訳の丸めによらず同じ長さになる
+	for _, counts := range [][]int{{1, 1, 1}, {3, 0, 0}, {0, 2, 1}} {
+		bar := renderStackedBar(counts, 6, 10)
+		if filled := len([]rune(strings.TrimRight(bar, " "))); filled != 5 {
+			t.Fatalf("renderStackedBar(%v) = %q, want 5 filled cells", counts, bar)
+		}
+		if len([]rune(bar)) != 10 {
+			t.Fatalf("renderStackedBar(%v) = %q, want width 10", counts, bar)
+		}
+	}
+}
+
+func TestRenderSparkline(t *testing.T) {
+	trend := []models.TrendPoint{
+		{Period: "a", AverageLeadTime: time.Hour, PRCount: 1},
+		{Period: "b", PRCount: 0},
+		{Period: "c", AverageLeadTime: 8 * time.Hour, PRCount: 2},
+	}
+
+	got := renderSparkline(trend)
+	if got != "▁ █" {
+		t.Fatalf("unexpected sparkline %q", got)
+	}
+}
+
 func TestMetricsViewErrorState(t *testing.T) {
 	cfg := models.DefaultConfig()
 	view := NewMetricsViewWithUseCase(nil, &cfg.Metrics)
@@ -239,3 +502,437 @@ func assertContains(t *testing.T, output, substr string) {
 		t.Fatalf("expected output to contain %q\n%s", substr, output)
 	}
 }
+
+func TestFormatRateLimit(t *testing.T) {
+	if got := formatRateLimit(nil); got != "API: unknown" {
+		t.Errorf("expected unknown for nil, got %q", got)
+	}
+	if got := formatRateLimit(&models.RateLimit{}); got != "API: unknown" {
+		t.Errorf("expected unknown for unreported limit, got %q", got)
+	}
+	if got := formatRateLimit(&models.RateLimit{Known: true, Limit: 5000, Remaining: 10}); got != "API: 10/5000 remaining" {
+		t.Errorf("unexpected rate limit %q", got)
+	}
+}
+
+// blockingLeadTimeUseCase blocks Execute until its context is cancelled
+type blockingLeadTimeUseCase struct {
+	started chan context.Context
+}
+
+func (b *blockingLeadTimeUseCase) Execute(ctx context.Context, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error) {
+	b.started <- ctx
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (b *blockingLeadTimeUseCase) GetRateLimit(ctx context.Context) (*models.RateLimit, error) {
+	return nil, ctx.Err()
+}
+
+func TestMetricsViewEscCancelsLoading(t *testing.T) {
+	useCase := &blockingLeadTimeUseCase{started: make(chan context.Context, 1)}
+	view := NewMetricsViewWithUseCase(useCase)
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+
+	cmds := view.Init()().(tea.BatchMsg)
+	ctx := <-useCase.started
+
+	view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if ctx.Err() == nil {
+		t.Fatal("esc should cancel the metrics fetch")
+	}
+	if view.loading {
+		t.Fatal("esc should stop loading")
+	}
+	assertContains(t, view.statusBar.View(), loadCancelledStatus)
+
+	// The cancelled result is dropped instead of shown as an error
+	view.Update(cmds[0]())
+	if view.err != nil {
+		t.Fatalf("cancelled fetch should not set an error, got %v", view.err)
+	}
+}
+
+func TestMetricsViewCopiesSections(t *testing.T) {
+	var copied string
+	original := copyToClipboard
+	copyToClipboard = func(text string) error {
+		copied = text
+		return nil
+	}
+	defer func() { copyToClipboard = original }()
+
+	cfg := models.DefaultConfig()
+	view := NewMetricsViewWithUseCase(nil, &cfg.Metrics)
+	view.metrics = sampleMetrics()
+	view.lastUpdated = time.Now()
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 20})
+
+	// At the top the first section is copied
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if !strings.HasPrefix(copied, "### Overall Lead Time\n\n```text\nAverage:") {
+		t.Fatalf("unexpected section markdown:\n%s", copied)
+	}
+	if strings.Contains(copied, "\x1b[") {
+		t.Fatal("expected the copied text to have no escape sequences")
+	}
+	assertContains(t, view.View(), `Copied "Overall Lead Time" as Markdown`)
+
+	// Scrolled down, the section at the top of the screen is copied
+	sections := view.renderSections()
+	start := len(view.renderHeaderLines())
+	for _, section := range sections[:2] {
+		start += len(section) + 1
+	}
+	view.scroll = start
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if want := "### " + plainTitle(sections[2][0]); !strings.HasPrefix(copied, want) {
+		t.Fatalf("expected %q to be copied, got:\n%s", want, copied)
+	}
+
+	// Y copies the whole report
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'Y'}})
+	if !strings.HasPrefix(copied, "## Lead Time Metrics\n\n- ") {
+		t.Fatalf("unexpected report markdown:\n%s", copied)
+	}
+	for _, title := range []string{"### Overall Lead Time", "### Per Repository", "owner/repo-a"} {
+		assertContains(t, copied, title)
+	}
+
+	// The message is cleared by the next key
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	if strings.Contains(view.View(), "Copied") {
+		t.Error("expected the copy message to be cleared")
+	}
+}
+
+func TestMetricsViewCopyFailures(t *testing.T) {
+	original := copyToClipboard
+	copyToClipboard = func(string) error { return assertError("clipboard is not available") }
+	defer func() { copyToClipboard = original }()
+
+	cfg := models.DefaultConfig()
+	view := NewMetricsViewWithUseCase(nil, &cfg.Metrics)
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 20})
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	assertContains(t, view.View(), "Nothing to copy")
+
+	view.metrics = sampleMetrics()
+	view.lastUpdated = time.Now()
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'Y'}})
+	assertContains(t, view.View(), "Copy failed: clipboard is not available")
+}
+
+// pickerLeadTimeUseCase suggests and records metrics repositories
+type pickerLeadTimeUseCase struct {
+	stubLeadTimeUseCase
+	repos       []string
+	detected    bool
+	suggestions []*models.RepositorySummary
+	saveErr     error
+}
+
+func (p *pickerLeadTimeUseCase) UsingDetectedRepositories() bool { return p.detected }
+
+func (p *pickerLeadTimeUseCase) Repositories() []string { return p.repos }
+
+func (p *pickerLeadTimeUseCase) SuggestRepositories(ctx context.Context) ([]*models.RepositorySummary, error) {
+	return p.suggestions, nil
+}
+
+func (p *pickerLeadTimeUseCase) AddRepositories(repos []string) error {
+	if p.saveErr != nil {
+		return p.saveErr
+	}
+	p.repos = append(p.repos, repos...)
+	p.detected = false
+	return nil
+}
+
+func TestMetricsViewRepoPicker(t *testing.T) {
+	useCase := &pickerLeadTimeUseCase{
+		stubLeadTimeUseCase: stubLeadTimeUseCase{metrics: sampleMetrics()},
+		repos:               []string{"acme/app"},
+		detected:            true,
+		suggestions:         []*models.RepositorySummary{{FullName: "acme/api"}, {FullName: "acme/web"}, {FullName: "me/dotfiles"}},
+	}
+	cfg := models.DefaultConfig()
+	view := NewMetricsViewWithUseCase(useCase, &cfg.Metrics)
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 60})
+	view.Update(view.Init()().(tea.BatchMsg)[0]())
+
+	// With no repositories configured the detected ones are named
+	assertContains(t, view.View(), "Repositories: acme/app (no github.repositories configured)")
+	assertContains(t, view.View(), "p add repositories")
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
+	assertContains(t, view.View(), "Loading repositories...")
+	view.Update(cmd())
+	assertContains(t, view.View(), "Measuring: acme/app")
+	assertContains(t, view.View(), "> [ ] acme/api")
+
+	view.Update(tea.KeyMsg{Type: tea.KeyDown})
+	view.Update(tea.KeyMsg{Type: tea.KeySpace})
+	view.Update(tea.KeyMsg{Type: tea.KeyDown})
+	view.Update(tea.KeyMsg{Type: tea.KeySpace})
+	assertContains(t, view.View(), "[x] acme/web")
+	assertContains(t, view.statusBar.View(), "2 selected")
+
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	_, cmd = view.Update(cmd())
+	if want := []string{"acme/app", "acme/web", "me/dotfiles"}; strings.Join(useCase.repos, ",") != strings.Join(want, ",") {
+		t.Fatalf("repositories = %v, want %v", useCase.repos, want)
+	}
+	if view.repoPicker.active || !view.loading || cmd == nil {
+		t.Fatal("saving should close the picker and reload the metrics")
+	}
+	assertContains(t, view.statusBar.View(), "Added 2 repositories to github.repositories")
+
+	view.Update(cmd().(tea.BatchMsg)[0]())
+	if strings.Contains(view.View(), "no github.repositories configured") {
+		t.Fatal("saved repositories are no longer detected")
+	}
+}
+
+func TestMetricsViewRepoPickerSaveError(t *testing.T) {
+	useCase := &pickerLeadTimeUseCase{
+		stubLeadTimeUseCase: stubLeadTimeUseCase{metrics: sampleMetrics()},
+		repos:               []string{"acme/app"},
+		suggestions:         []*models.RepositorySummary{{FullName: "acme/api"}},
+		saveErr:             errors.New("read-only file system"),
+	}
+	view := NewMetricsViewWithUseCase(useCase)
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
+	view.Update(cmd())
+	// Enter with nothing selected adds the repository under the cursor
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view.Update(cmd())
+	assertContains(t, view.View(), "read-only file system")
+	if !view.repoPicker.active {
+		t.Fatal("the picker should stay open after a failed save")
+	}
+
+	view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if view.repoPicker.active {
+		t.Fatal("esc should close the picker")
+	}
+
+	// Use cases that cannot add repositories do not offer the picker
+	plain := NewMetricsViewWithUseCase(&stubLeadTimeUseCase{metrics: sampleMetrics()})
+	if _, cmd := plain.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}}); cmd != nil || plain.repoPicker.active {
+		t.Fatal("expected no picker")
+	}
+}
+
+type retryingLeadTimeUseCase struct {
+	stubLeadTimeUseCase
+	retried  *models.LeadTimeMetrics
+	previous *models.LeadTimeMetrics
+}
+
+func (r *retryingLeadTimeUseCase) RetryFailedRepositories(ctx context.Context, previous *models.LeadTimeMetrics, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error) {
+	r.previous = previous
+	return r.retried, nil
+}
+
+func TestMetricsViewRepositoryStatus(t *testing.T) {
+	partial := sampleMetrics()
+	partial.RepositoryStatuses = []models.MetricsRepositoryStatus{
+		{Repository: "owner/repo1"},
+		{Repository: "owner/private", Error: "resource not found (404)"},
+	}
+	recovered := sampleMetrics()
+	recovered.RepositoryStatuses = []models.MetricsRepositoryStatus{
+		{Repository: "owner/repo1"},
+		{Repository: "owner/private"},
+	}
+	useCase := &retryingLeadTimeUseCase{
+		stubLeadTimeUseCase: stubLeadTimeUseCase{metrics: partial},
+		retried:             recovered,
+	}
+	view := NewMetricsViewWithUseCase(useCase)
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 80})
+	view.Update(view.Init()().(tea.BatchMsg)[0]())
+
+	output := view.View()
+	assertContains(t, output, "Repository Status (1 of 2 failed)")
+	assertContains(t, output, "resource not found (404)")
+	assertContains(t, output, "Press 'F' to retry the failed repositories.")
+	assertContains(t, view.statusBar.View(), "1 failed")
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'F'}})
+	if !view.loading || cmd == nil {
+		t.Fatal("F should retry the failed repositories")
+	}
+	view.Update(cmd().(tea.BatchMsg)[0]())
+	if useCase.previous != partial {
+		t.Error("the retry should be given the metrics with the failures")
+	}
+	if strings.Contains(view.View(), "Repository Status") {
+		t.Error("the status table is only shown while repositories fail")
+	}
+
+	// Without failures F does nothing
+	if _, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'F'}}); cmd != nil || view.loading {
+		t.Error("F should do nothing when no repository failed")
+	}
+}
+
+type resumingLeadTimeUseCase struct {
+	stubLeadTimeUseCase
+	run     *models.InterruptedMetricsRun
+	resumed *models.LeadTimeMetrics
+}
+
+func (r *resumingLeadTimeUseCase) InterruptedRun() *models.InterruptedMetricsRun {
+	return r.run
+}
+
+func (r *resumingLeadTimeUseCase) ResumeInterruptedRun(ctx context.Context, progressFn func(models.MetricsProgress)) (*models.LeadTimeMetrics, error) {
+	r.run = nil
+	return r.resumed, nil
+}
+
+func TestMetricsViewResumesInterruptedRun(t *testing.T) {
+	resumed := sampleMetrics()
+	useCase := &resumingLeadTimeUseCase{
+		stubLeadTimeUseCase: stubLeadTimeUseCase{metrics: sampleMetrics()},
+		run: &models.InterruptedMetricsRun{
+			UpdatedAt: time.Now(),
+			Completed: []string{"owner/repo1", "owner/repo2"},
+			Remaining: []string{"owner/repo3"},
+		},
+		resumed: resumed,
+	}
+	view := NewMetricsViewWithUseCase(useCase)
+	view.Update(tea.WindowSizeMsg{Width: 160, Height: 80})
+
+	// An interrupted run waits for the choice instead of starting over
+	if cmd := view.Init(); cmd != nil || view.loading {
+		t.Fatal("Init should not fetch while a run can be resumed")
+	}
+	assertContains(t, view.View(), "2 of 3 repositories done. Press 'F' to resume or 'r' to start over.")
+	assertContains(t, view.statusBar.View(), "resume")
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'F'}})
+	if !view.loading || cmd == nil {
+		t.Fatal("F should resume the interrupted run")
+	}
+	view.Update(cmd().(tea.BatchMsg)[0]())
+	if view.metrics != resumed || view.interrupted != nil {
+		t.Fatalf("expected the resumed metrics, got %+v", view.metrics)
+	}
+	if strings.Contains(view.View(), "Interrupted run") {
+		t.Error("the resume prompt should be gone after resuming")
+	}
+
+	// r starts over even when a run could be resumed
+	useCase.run = &models.InterruptedMetricsRun{Completed: []string{"owner/repo1"}, Remaining: []string{"owner/repo3"}}
+	view = NewMetricsViewWithUseCase(useCase)
+	view.Init()
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	if cmd == nil || view.interrupted != nil {
+		t.Fatal("r should fetch everything again")
+	}
+	view.Update(cmd().(tea.BatchMsg)[0]())
+	if view.metrics != useCase.metrics {
+		t.Error("r should show the metrics of a full run")
+	}
+}
+
+// snapshotLeadTimeUseCase serves an exported report
+type snapshotLeadTimeUseCase struct {
+	stubLeadTimeUseCase
+}
+
+func (s *snapshotLeadTimeUseCase) SnapshotName() string { return "report.json" }
+
+func TestMetricsViewSnapshot(t *testing.T) {
+	useCase := &snapshotLeadTimeUseCase{stubLeadTimeUseCase{metrics: sampleMetrics()}}
+	cfg := models.DefaultConfig()
+	view := NewMetricsViewWithUseCase(useCase, &cfg.Metrics)
+	view.Update(tea.WindowSizeMsg{Width: 160, Height: 60})
+	view.Update(metricsLoadedMsg{metrics: useCase.metrics})
+
+	output := view.View()
+	assertContains(t, output, "Snapshot: report.json")
+	assertContains(t, output, "Overall Lead Time")
+	for _, hidden := range []string{"Period:", "API:", "rate limit", "refresh"} {
+		if strings.Contains(output, hidden) {
+			t.Errorf("snapshot should not show %q:\n%s", hidden, output)
+		}
+	}
+
+	if _, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}}); cmd != nil || view.loading {
+		t.Error("r should not reload a snapshot")
+	}
+}
+
+func TestMetricsViewRepoPickerFilter(t *testing.T) {
+	useCase := &pickerLeadTimeUseCase{
+		stubLeadTimeUseCase: stubLeadTimeUseCase{metrics: sampleMetrics()},
+		repos:               []string{"acme/app"},
+		suggestions: []*models.RepositorySummary{
+			{FullName: "acme/api", Language: "Go", Topics: []string{"backend"}},
+			{FullName: "acme/web", Language: "TypeScript", Topics: []string{"frontend"}},
+			{FullName: "acme/worker", Language: "Go", Topics: []string{"backend", "queue"}},
+		},
+	}
+	view := NewMetricsViewWithUseCase(useCase)
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
+	view.Update(cmd())
+	assertContains(t, view.View(), "acme/web  TypeScript")
+	assertContains(t, view.View(), "#backend #queue")
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	if !view.IsCapturingInput() {
+		t.Fatal("f should start typing the filter")
+	}
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("language:go topic:queue")})
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	content := view.View()
+	assertContains(t, content, "Filter: language:go topic:queue")
+	assertContains(t, content, "> [ ] acme/worker")
+	if strings.Contains(content, "acme/api") || strings.Contains(content, "acme/web") {
+		t.Fatalf("expected only acme/worker to match, got %q", content)
+	}
+
+	// Enter adds the matching repository under the cursor
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view.Update(cmd())
+	if want := "acme/app,acme/worker"; strings.Join(useCase.repos, ",") != want {
+		t.Fatalf("repositories = %v, want %s", useCase.repos, want)
+	}
+}
+
+func TestMetricsViewRepoPickerFilterEsc(t *testing.T) {
+	useCase := &pickerLeadTimeUseCase{
+		stubLeadTimeUseCase: stubLeadTimeUseCase{metrics: sampleMetrics()},
+		suggestions:         []*models.RepositorySummary{{FullName: "acme/api", Language: "Go"}, {FullName: "acme/web"}},
+	}
+	view := NewMetricsViewWithUseCase(useCase)
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
+	view.Update(cmd())
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("rust")})
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assertContains(t, view.View(), "No repositories match rust")
+
+	// The first esc clears the filter, the second closes the picker
+	view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if !view.repoPicker.active || len(view.repoPicker.visible) != 2 {
+		t.Fatalf("expected esc to clear the filter, got %d visible", len(view.repoPicker.visible))
+	}
+	view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if view.repoPicker.active {
+		t.Fatal("expected esc to close the picker")
+	}
+}
@@ -0,0 +1,379 @@
+package views
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/infra/clock"
+	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// burndownHeight is the number of rows of the burndown chart
+const burndownHeight = 12
+
+// milestonesLoadedMsg is sent when the open milestones are loaded
+type milestonesLoadedMsg struct {
+	milestones []*models.Milestone
+	err        error
+}
+
+// burndownLoadedMsg is sent when the issues of a milestone are loaded
+type burndownLoadedMsg struct {
+	burndown *models.Burndown
+	err      error
+}
+
+// MilestoneView lists the open milestones and draws the burndown of one
+type MilestoneView struct {
+	issueRepo  repository.IssueRepository
+	owner      string
+	repo       string
+	milestones []*models.Milestone
+	cursor     int
+	loading    bool
+	err        error
+	burndown   *models.Burndown
+	charting   bool // the burndown of the milestone under the cursor is shown
+	width      int
+	height     int
+	statusBar  *components.StatusBar
+	now        func() time.Time
+	loads      loadGroup
+}
+
+// NewMilestoneView creates a new milestone view
+func NewMilestoneView(issueRepo repository.IssueRepository, owner, repo string) *MilestoneView {
+	return &MilestoneView{
+		loads:     loadGroup{source: sourceMilestones},
+		issueRepo: issueRepo,
+		owner:     owner,
+		repo:      repo,
+		loading:   true,
+		statusBar: components.NewStatusBar(),
+		now:       clock.Now,
+	}
+}
+
+// Init starts loading the milestones
+func (m *MilestoneView) Init() tea.Cmd {
+	return m.loadMilestones(false)
+}
+
+// Close cancels the fetch still in flight when the view is closed
+func (m *MilestoneView) Close() {
+	m.loads.Cancel()
+}
+
+// loadMilestones fetches the open milestones, bypassing the cache when fresh
+func (m *MilestoneView) loadMilestones(fresh bool) tea.Cmd {
+	issueRepo, owner, repo := m.issueRepo, m.owner, m.repo
+	ctx := m.loads.Restart()
+	if fresh {
+		ctx = freshContext(ctx)
+	}
+	return func() tea.Msg {
+		milestones, err := issueRepo.ListMilestones(ctx, owner, repo)
+		return milestonesLoadedMsg{milestones: milestones, err: err}
+	}
+}
+
+// loadBurndown fetches the issues of the milestone under the cursor
+func (m *MilestoneView) loadBurndown(fresh bool) tea.Cmd {
+	if m.cursor >= len(m.milestones) {
+		return nil
+	}
+	milestone := m.milestones[m.cursor]
+	issueRepo, owner, repo, now := m.issueRepo, m.owner, m.repo, m.now()
+	ctx := m.loads.Restart()
+	if fresh {
+		ctx = freshContext(ctx)
+	}
+	return func() tea.Msg {
+		issues, err := issueRepo.ListMilestoneIssues(ctx, owner, repo, milestone.Number)
+		if err != nil {
+			return burndownLoadedMsg{err: err}
+		}
+		return burndownLoadedMsg{burndown: models.NewBurndown(milestone, issues, now)}
+	}
+}
+
+// Update handles messages
+func (m *MilestoneView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case milestonesLoadedMsg:
+		if isCancelled(msg.err) {
+			return m, nil
+		}
+		m.loading = false
+		m.err = msg.err
+		m.milestones = msg.milestones
+		if m.cursor >= len(m.milestones) {
+			m.cursor = 0
+		}
+		return m, nil
+
+	case burndownLoadedMsg:
+		if isCancelled(msg.err) || !m.charting {
+			return m, nil
+		}
+		m.loading = false
+		m.err = msg.err
+		m.burndown = msg.burndown
+		return m, nil
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.statusBar.SetSize(msg.Width, 1)
+		return m, nil
+
+	case tea.KeyMsg:
+		return m.handleKeyPress(msg)
+	}
+
+	return m, nil
+}
+
+// handleKeyPress handles keyboard input
+func (m *MilestoneView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "esc":
+		if m.charting {
+			m.loads.Cancel()
+			m.charting = false
+			m.burndown = nil
+			m.loading = false
+			m.err = nil
+			return m, nil
+		}
+		return m, func() tea.Msg { return backMsg{} }
+
+	case "r":
+		m.loading = true
+		m.err = nil
+		if m.charting {
+			return m, m.loadBurndown(true)
+		}
+		return m, m.loadMilestones(true)
+
+	case "j", "down":
+		if !m.charting && m.cursor < len(m.milestones)-1 {
+			m.cursor++
+		}
+
+	case "k", "up":
+		if !m.charting && m.cursor > 0 {
+			m.cursor--
+		}
+
+	case "enter":
+		if !m.charting && !m.loading && m.cursor < len(m.milestones) {
+			m.charting = true
+			m.loading = true
+			m.err = nil
+			return m, m.loadBurndown(false)
+		}
+	}
+
+	return m, nil
+}
+
+// View renders the milestone list or the burndown chart
+func (m *MilestoneView) View() string {
+	var s strings.Builder
+
+	s.WriteString(styles.HeaderStyle.Render("Milestones"))
+	if m.charting && m.cursor < len(m.milestones) {
+		s.WriteString(" ")
+		s.WriteString(styles.MutedStyle.Render("burndown of " + m.milestones[m.cursor].Title))
+	}
+	s.WriteString("\n\n")
+
+	switch {
+	case m.loading && m.charting:
+		s.WriteString(styles.LoadingStyle.Render("Loading milestone issues..."))
+	case m.loading:
+		s.WriteString(styles.LoadingStyle.Render("Loading milestones..."))
+	case m.err != nil:
+		s.WriteString(styles.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+	case m.charting && m.burndown != nil:
+		s.WriteString(m.renderBurndown())
+	case len(m.milestones) == 0:
+		s.WriteString(styles.MutedStyle.Render("No open milestones."))
+	default:
+		s.WriteString(m.renderMilestones())
+	}
+
+	s.WriteString("\n\n")
+	if m.charting {
+		s.WriteString(styles.HelpStyle.Render("r reload • esc back to milestones"))
+	} else {
+		s.WriteString(styles.HelpStyle.Render("j/k navigate • Enter burndown • r reload • esc back"))
+	}
+
+	s.WriteString("\n")
+	m.statusBar.ClearItems()
+	m.statusBar.SetMode("Milestones")
+	if m.owner != "" && m.repo != "" {
+		m.statusBar.AddItem("Repo", fmt.Sprintf("%s/%s", m.owner, m.repo))
+	}
+	s.WriteString(m.statusBar.View())
+
+	return s.String()
+}
+
+// renderMilestones renders one line per milestone: title, progress and due date
+func (m *MilestoneView) renderMilestones() string {
+	width := 0
+	for _, milestone := range m.milestones {
+		width = max(width, lipgloss.Width(milestone.Title))
+	}
+
+	height := max(m.height-6, 3)
+	start, end := components.VisibleRange(m.cursor, len(m.milestones), height)
+	lines := make([]string, 0, end-start)
+	for i := start; i < end; i++ {
+		milestone := m.milestones[i]
+		cursor := "  "
+		title := milestone.Title
+		if i == m.cursor {
+			cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
+			title = styles.SelectedStyle.Render(title)
+		}
+		title += strings.Repeat(" ", width-lipgloss.Width(milestone.Title))
+		progress := fmt.Sprintf("%d open, %d closed", milestone.OpenIssues, milestone.ClosedIssues)
+		lines = append(lines, fmt.Sprintf("%s%s  %s  %s", cursor, title, progress, m.dueLabel(milestone)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// dueLabel describes the due date relative to today
+func (m *MilestoneView) dueLabel(milestone *models.Milestone) string {
+	if milestone.DueOn == nil {
+		return styles.MutedStyle.Render("no due date")
+	}
+	date := milestone.DueOn.Format("Jan 2")
+	days := int(math.Round(milestone.DueOn.Sub(m.now()).Hours() / 24))
+	switch {
+	case days < 0:
+		return styles.ErrorStyle.Render(fmt.Sprintf("due %s (%d days overdue)", date, -days))
+	case days == 0:
+		return styles.WarningStyle.Render(fmt.Sprintf("due %s (today)", date))
+	default:
+		return styles.MutedStyle.Render(fmt.Sprintf("due %s (in %d days)", date, days))
+	}
+}
+
+// renderBurndown renders the summary and the chart of open issues per day
+func (m *MilestoneView) renderBurndown() string {
+	b := m.burndown
+	milestone := b.Milestone
+	summary := fmt.Sprintf("%d of %d issues open", b.Open(), b.Total)
+	if milestone.DueOn != nil {
+		summary += ", " + m.dueLabel(milestone)
+		if ideal, ok := b.Ideal(b.Points[len(b.Points)-1].Day); ok && float64(b.Open()) > math.Ceil(ideal) {
+			summary += "  " + styles.WarningStyle.Render(fmt.Sprintf("%d behind the ideal line", b.Open()-int(math.Ceil(ideal))))
+		}
+	}
+	if b.Total == 0 {
+		return summary + "\n\n" + styles.MutedStyle.Render("The milestone has no issues yet.")
+	}
+
+	legend := styles.MutedStyle.Render("* open issues  . ideal  | due date")
+	return summary + "\n\n" + renderBurndownChart(b, max(m.width-8, 20), burndownHeight) + "\n\n" + legend
+}
+
+// renderBurndownChart draws open issues over time as ASCII: actual counts as
+// "*", the ideal line as "." and the due date as "|". The x axis runs from the
+// milestone's first day to the due date or today, whichever is later. Short
+// milestones get up to four columns a day; long ones show the last day each
+// column covers.
+func renderBurndownChart(b *models.Burndown, width, height int) string {
+	first := b.Points[0].Day
+	last := b.Points[len(b.Points)-1].Day
+	var due time.Time
+	if b.Milestone.DueOn != nil {
+		due = time.Date(b.Milestone.DueOn.Year(), b.Milestone.DueOn.Month(), b.Milestone.DueOn.Day(), 0, 0, 0, 0, first.Location())
+		if due.After(last) {
+			last = due
+		}
+	}
+	days := int(last.Sub(first).Hours()/24+0.5) + 1
+	if days < width {
+		width = days * min(width/days, 4)
+	}
+
+	top := 1
+	for _, p := range b.Points {
+		top = max(top, p.Open)
+	}
+	row := func(v float64) int {
+		return height - 1 - int(math.Round(v*float64(height-1)/float64(top)))
+	}
+
+	grid := make([][]rune, height)
+	for i := range grid {
+		grid[i] = []rune(strings.Repeat(" ", width))
+	}
+	dueColumn := -1
+	for col := 0; col < width; col++ {
+		// The last day covered by the column
+		index := ((col+1)*days - 1) / width
+		day := first.AddDate(0, 0, index)
+		if !due.IsZero() && dueColumn < 0 && !day.Before(due) {
+			dueColumn = col
+			for r := range grid {
+				grid[r][col] = '|'
+			}
+		}
+		if ideal, ok := b.Ideal(day); ok {
+			grid[row(ideal)][col] = '.'
+		}
+		if index < len(b.Points) {
+			grid[row(float64(b.Points[index].Open))][col] = '*'
+		}
+	}
+
+	label := len(fmt.Sprint(top))
+	lines := make([]string, 0, height+2)
+	for r, cells := range grid {
+		axis := strings.Repeat(" ", label)
+		switch r {
+		case 0:
+			axis = fmt.Sprintf("%*d", label, top)
+		case height - 1:
+			axis = fmt.Sprintf("%*d", label, 0)
+		}
+		lines = append(lines, axis+" ┤"+string(cells))
+	}
+	lines = append(lines, strings.Repeat(" ", label)+" └"+strings.Repeat("─", width))
+
+	// Dates under the axis: the first day, the due date and the last day
+	dates := []rune(strings.Repeat(" ", width+label+2))
+	put := func(col int, text string) {
+		col = min(max(col, 0), len(dates)-len(text))
+		for i, r := range text {
+			if dates[col+i] != ' ' {
+				return
+			}
+			dates[col+i] = r
+		}
+	}
+	put(label+2, first.Format("Jan 2"))
+	if dueColumn >= 0 {
+		put(label+2+dueColumn-2, "due "+due.Format("Jan 2"))
+	}
+	if !last.Equal(due) {
+		put(label+2+width-5, last.Format("Jan 2"))
+	}
+	lines = append(lines, strings.TrimRight(string(dates), " "))
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,92 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type milestoneIssueRepo struct {
+	repository.IssueRepository
+	milestones []*models.Milestone
+	issues     []*models.Issue
+	number     int
+}
+
+func (r *milestoneIssueRepo) ListMilestones(ctx context.Context, owner, repo string) ([]*models.Milestone, error) {
+	return r.milestones, nil
+}
+
+func (r *milestoneIssueRepo) ListMilestoneIssues(ctx context.Context, owner, repo string, number int) ([]*models.Issue, error) {
+	r.number = number
+	return r.issues, nil
+}
+
+func TestMilestoneView_Burndown(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 12, 0, 0, 0, time.UTC) }
+	closed := func(d int) *time.Time { t := day(d); return &t }
+	due := day(11)
+	repo := &milestoneIssueRepo{
+		milestones: []*models.Milestone{
+			{Number: 3, Title: "Sprint 12", OpenIssues: 2, ClosedIssues: 2, CreatedAt: day(1), DueOn: &due},
+			{Number: 4, Title: "Backlog"},
+		},
+		issues: []*models.Issue{
+			{Number: 1, CreatedAt: day(1), ClosedAt: closed(2)},
+			{Number: 2, CreatedAt: day(1), ClosedAt: closed(4)},
+			{Number: 3, CreatedAt: day(1)},
+			// Added to the sprint later
+			{Number: 4, CreatedAt: day(3)},
+		},
+	}
+	view := NewMilestoneView(repo, "owner", "repo")
+	view.now = func() time.Time { return day(8) }
+	view.Update(tea.WindowSizeMsg{Width: 80, Height: 30})
+	view.Update(view.Init()())
+
+	out := view.View()
+	if !strings.Contains(out, "Sprint 12") || !strings.Contains(out, "due Mar 11 (in 3 days)") || !strings.Contains(out, "no due date") {
+		t.Fatalf("unexpected milestone list:\n%s", out)
+	}
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected enter to load the burndown")
+	}
+	view.Update(cmd())
+	if repo.number != 3 {
+		t.Errorf("loaded milestone %d, want 3", repo.number)
+	}
+
+	var open []int
+	for _, p := range view.burndown.Points {
+		open = append(open, p.Open)
+	}
+	if fmt.Sprint(open) != "[3 2 3 2 2 2 2 2]" {
+		t.Errorf("open issues per day = %v", open)
+	}
+
+	out = view.View()
+	for _, want := range []string{"2 of 4 issues open", "1 behind the ideal line", "due Mar 11", "Mar 1", "*", "|"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in\n%s", want, out)
+		}
+	}
+
+	// esc returns to the list, a second esc leaves the view
+	view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if view.charting {
+		t.Fatal("expected esc to return to the milestone list")
+	}
+	if _, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEsc}); cmd == nil {
+		t.Fatal("expected esc to leave the milestone view")
+	} else if _, ok := cmd().(backMsg); !ok {
+		t.Fatal("expected backMsg")
+	}
+}
@@ -0,0 +1,706 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/events"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// MyWorkUseCase gathers the open issues and pull requests involving the user
+type MyWorkUseCase interface {
+	Execute(ctx context.Context, repositories []string) (*models.MyWork, error)
+}
+
+// myWorkLoadedMsg is sent when the sections are loaded
+type myWorkLoadedMsg struct {
+	work *models.MyWork
+	err  error
+}
+
+// myWorkReadySection is the section of the user's pull requests that can be
+// merged now, listed above the others
+const myWorkReadySection = -1
+
+// myWorkItem is a selectable row of the view
+type myWorkItem struct {
+	section int // index into the sections, or myWorkReadySection
+	result  models.SearchResult
+}
+
+// myWorkRow is a line of the list: a section header or an item
+type myWorkRow struct {
+	header string
+	item   int // index into items; -1 for headers
+}
+
+// MyWorkView lists the issues assigned to the user, their pull requests, the
+// pull requests waiting for their review and the items mentioning them, in
+// the current repository or across the configured repositories
+type MyWorkView struct {
+	useCase      MyWorkUseCase
+	owner        string
+	repo         string
+	repositories []string // github.repositories, searched when allRepos is set
+	allRepos     bool
+	issueRepo    repository.IssueRepository
+	prRepo       repository.PullRequestRepository
+	commitRepo   repository.CommitRepository
+
+	work   *models.MyWork
+	ready  map[string]bool // "owner/repo#number" of the PRs ready to merge
+	items  []myWorkItem
+	rows   []myWorkRow
+	cursor int
+
+	loading bool
+	err     error
+
+	width     int
+	height    int
+	statusBar *components.StatusBar
+	showHelp  bool
+
+	detailView    tea.Model // IssueDetailView or PRDetailView
+	showingDetail bool
+
+	loads loadGroup
+}
+
+// NewMyWorkView creates a new My Work view
+func NewMyWorkView() *MyWorkView {
+	return &MyWorkView{
+		loads:     loadGroup{source: sourceMyWork},
+		statusBar: components.NewStatusBar(),
+	}
+}
+
+// NewMyWorkViewWithUseCase creates a My Work view for the repository.
+// Without a current repository the configured repositories are searched.
+func NewMyWorkViewWithUseCase(useCase MyWorkUseCase, owner, repo string, repositories []string) *MyWorkView {
+	view := NewMyWorkView()
+	view.useCase = useCase
+	view.owner = owner
+	view.repo = repo
+	view.repositories = repositories
+	view.allRepos = owner == "" || repo == ""
+	return view
+}
+
+// SetRepositories sets the repositories the detail views load comments,
+// reviews and files with
+func (m *MyWorkView) SetRepositories(issueRepo repository.IssueRepository, prRepo repository.PullRequestRepository) {
+	m.issueRepo = issueRepo
+	m.prRepo = prRepo
+}
+
+// SetCommitRepository sets the repository the diffs of the pull requests read files from
+func (m *MyWorkView) SetCommitRepository(repo repository.CommitRepository) {
+	m.commitRepo = repo
+}
+
+// Init starts loading
+func (m *MyWorkView) Init() tea.Cmd {
+	return m.load()
+}
+
+// guest reports whether the session has no token; @me needs one
+func (m *MyWorkView) guest() bool {
+	return m.issueRepo != nil && repository.IsReadOnly(m.issueRepo)
+}
+
+// searchedRepositories returns the repositories of the current scope
+func (m *MyWorkView) searchedRepositories() []string {
+	if m.allRepos {
+		return m.repositories
+	}
+	return []string{m.owner + "/" + m.repo}
+}
+
+// scopeLabel describes the repositories searched
+func (m *MyWorkView) scopeLabel() string {
+	switch {
+	case !m.allRepos:
+		return m.owner + "/" + m.repo
+	case len(m.repositories) > 0:
+		return fmt.Sprintf("%d configured repositories", len(m.repositories))
+	default:
+		return "all repositories"
+	}
+}
+
+// load runs the searches of the current scope
+func (m *MyWorkView) load() tea.Cmd {
+	if m.useCase == nil || m.guest() {
+		return nil
+	}
+	ctx := m.loads.Restart()
+	m.loading = true
+	repositories := m.searchedRepositories()
+	return func() tea.Msg {
+		work, err := m.useCase.Execute(ctx, repositories)
+		return myWorkLoadedMsg{work: work, err: err}
+	}
+}
+
+// setWork replaces the sections and rebuilds the rows, listing the user's
+// pull requests that are ready to merge first
+func (m *MyWorkView) setWork(work *models.MyWork) {
+	selected := ""
+	if item := m.selectedItem(); item != nil {
+		selected = resultURL(item.result)
+	}
+
+	m.work = work
+	m.items = nil
+	m.rows = nil
+	var ready []models.SearchResult
+	for _, section := range work.Sections {
+		for _, result := range section.Items {
+			if m.isReady(section, result) {
+				ready = append(ready, result)
+			}
+		}
+	}
+	if len(ready) > 0 {
+		m.rows = append(m.rows, myWorkRow{header: renderReadyHeader(len(ready)), item: -1})
+		for _, result := range ready {
+			m.rows = append(m.rows, myWorkRow{item: len(m.items)})
+			m.items = append(m.items, myWorkItem{section: myWorkReadySection, result: result})
+		}
+	}
+	for i, section := range work.Sections {
+		m.rows = append(m.rows, myWorkRow{header: sectionHeader(section), item: -1})
+		for _, result := range section.Items {
+			if m.isReady(section, result) {
+				continue
+			}
+			m.rows = append(m.rows, myWorkRow{item: len(m.items)})
+			m.items = append(m.items, myWorkItem{section: i, result: result})
+		}
+	}
+
+	for i, item := range m.items {
+		if selected != "" && resultURL(item.result) == selected {
+			m.cursor = i
+			return
+		}
+	}
+	if m.cursor >= len(m.items) {
+		m.cursor = max(len(m.items)-1, 0)
+	}
+}
+
+// readyCandidate reports whether result is one of the user's open pull
+// requests, which are promoted once they can be merged
+func readyCandidate(section models.MyWorkSection, result models.SearchResult) bool {
+	if section.Category != models.MyWorkAuthored && section.Category != models.MyWorkAssigned {
+		return false
+	}
+	return mayBeReady(result.PullRequest)
+}
+
+// isReady reports whether result is one of the user's pull requests that can be merged now
+func (m *MyWorkView) isReady(section models.MyWorkSection, result models.SearchResult) bool {
+	if !readyCandidate(section, result) {
+		return false
+	}
+	owner, repo := m.itemRepository(result)
+	return m.ready[readyKey(owner, repo, result.PullRequest.Number)]
+}
+
+// readyKey identifies a pull request across repositories
+func readyKey(owner, repo string, number int) string {
+	return fmt.Sprintf("%s/%s#%d", strings.ToLower(owner), strings.ToLower(repo), number)
+}
+
+// checkReadyToMerge asks which of the user's pull requests can be merged
+// now, one query per repository
+func (m *MyWorkView) checkReadyToMerge() tea.Cmd {
+	if m.prRepo == nil || m.work == nil {
+		return nil
+	}
+	type repoRef struct{ owner, repo string }
+	var repos []repoRef
+	numbers := make(map[repoRef][]int)
+	for _, section := range m.work.Sections {
+		for _, result := range section.Items {
+			if !readyCandidate(section, result) {
+				continue
+			}
+			owner, repo := m.itemRepository(result)
+			ref := repoRef{owner, repo}
+			if _, seen := numbers[ref]; !seen {
+				repos = append(repos, ref)
+			}
+			numbers[ref] = append(numbers[ref], result.PullRequest.Number)
+		}
+	}
+
+	ctx := m.loads.Context()
+	cmds := make([]tea.Cmd, 0, len(repos))
+	for _, ref := range repos {
+		cmds = append(cmds, loadReadyToMerge(ctx, m.prRepo, ref.owner, ref.repo, numbers[ref]))
+	}
+	return tea.Batch(cmds...)
+}
+
+// setReady records which pull requests of a repository are ready to merge
+// and rebuilds the rows
+func (m *MyWorkView) setReady(loaded prReadyLoadedMsg) {
+	if m.ready == nil {
+		m.ready = make(map[string]bool)
+	}
+	prefix := strings.ToLower(loaded.owner+"/"+loaded.repo) + "#"
+	for key := range m.ready {
+		if strings.HasPrefix(key, prefix) {
+			delete(m.ready, key)
+		}
+	}
+	for number := range loaded.ready {
+		m.ready[readyKey(loaded.owner, loaded.repo, number)] = true
+	}
+	if m.work != nil {
+		m.setWork(m.work)
+	}
+}
+
+// sectionTitle returns the title of the section of an item
+func (m *MyWorkView) sectionTitle(section int) string {
+	if section == myWorkReadySection {
+		return "Ready to merge"
+	}
+	return m.work.Sections[section].Title
+}
+
+// sectionHeader renders the title and count of a section
+func sectionHeader(section models.MyWorkSection) string {
+	count := fmt.Sprintf("(%d)", section.TotalCount)
+	if section.TotalCount > len(section.Items) {
+		count = fmt.Sprintf("(%d of %d)", len(section.Items), section.TotalCount)
+	}
+	return section.Title + " " + count
+}
+
+// applyEntityChanged replaces the issue or PR of matching items; items span
+// repositories, so they are matched by URL
+func (m *MyWorkView) applyEntityChanged(event events.EntityChanged) {
+	for i := range m.items {
+		result := &m.items[i].result
+		switch {
+		case event.Issue != nil && result.Issue != nil && event.Issue.HTMLURL != "" && result.Issue.HTMLURL == event.Issue.HTMLURL:
+			result.Issue = event.Issue
+		case event.PullRequest != nil && result.PullRequest != nil && event.PullRequest.HTMLURL != "" && result.PullRequest.HTMLURL == event.PullRequest.HTMLURL:
+			result.PullRequest = event.PullRequest
+		}
+	}
+}
+
+// Update handles messages
+func (m *MyWorkView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if event, ok := msg.(events.EntityChanged); ok {
+		m.applyEntityChanged(event)
+		if m.detailView != nil {
+			m.detailView, _ = m.detailView.Update(event)
+		}
+		return m, nil
+	}
+
+	// Readiness checks finish in the background, possibly while a detail view is open
+	if loaded, ok := msg.(prReadyLoadedMsg); ok {
+		m.setReady(loaded)
+		return m, nil
+	}
+
+	// A retry from the error banner reloads even while a detail view is open
+	if isRetryFor(msg, m) {
+		return m, m.load()
+	}
+
+	if m.showingDetail && m.detailView != nil {
+		if _, isBack := msg.(backMsg); isBack {
+			m.closeDetail()
+			return m, nil
+		}
+		if keyMsg, ok := msg.(tea.KeyMsg); ok && !m.IsCapturingInput() {
+			if key := keyMsg.String(); key == "q" || key == "esc" {
+				m.closeDetail()
+				return m, nil
+			}
+		}
+		var cmd tea.Cmd
+		m.detailView, cmd = m.detailView.Update(msg)
+		return m, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return m.handleKeyPress(msg)
+
+	case myWorkLoadedMsg:
+		if isCancelled(msg.err) {
+			return m, nil
+		}
+		m.loading = false
+		m.err = msg.err
+		if msg.err == nil {
+			m.setWork(msg.work)
+			return m, m.checkReadyToMerge()
+		}
+		return m, reportLoadError(m, "my work", msg.err)
+
+	case openBrowserMsg:
+		m.statusBar.SetMessage(browserStatusMessage(msg))
+		return m, nil
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.statusBar.SetSize(msg.Width, 1)
+		if m.detailView != nil {
+			m.detailView.Update(msg)
+		}
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// handleKeyPress handles keyboard input
+func (m *MyWorkView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c", "q":
+		return m, tea.Quit
+
+	case "?":
+		m.showHelp = !m.showHelp
+		return m, nil
+
+	case "esc":
+		if m.loading {
+			m.loads.Cancel()
+			m.loading = false
+			m.statusBar.SetMessage(loadCancelledStatus)
+		}
+		return m, nil
+
+	case "r":
+		if m.loading {
+			return m, nil
+		}
+		return m, m.load()
+
+	case "a":
+		// Switch between the current repository and the configured ones
+		if m.owner == "" || m.repo == "" {
+			return m, nil
+		}
+		m.allRepos = !m.allRepos
+		m.cursor = 0
+		m.statusBar.SetMessage("Showing " + m.scopeLabel())
+		return m, m.load()
+
+	case "j", "down":
+		if m.cursor < len(m.items)-1 {
+			m.cursor++
+		}
+		return m, nil
+
+	case "k", "up":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+		return m, nil
+
+	case "g":
+		m.cursor = 0
+		return m, nil
+
+	case "G":
+		if len(m.items) > 0 {
+			m.cursor = len(m.items) - 1
+		}
+		return m, nil
+
+	case "tab":
+		m.jumpSection(1)
+		return m, nil
+
+	case "shift+tab":
+		m.jumpSection(-1)
+		return m, nil
+
+	case "enter":
+		return m, m.showDetail()
+
+	case "o":
+		if item := m.selectedItem(); item != nil {
+			if url := resultURL(item.result); url != "" {
+				return m, openInBrowser(url)
+			}
+		}
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// jumpSection moves the cursor to the first item of the next (or previous)
+// section that has items
+func (m *MyWorkView) jumpSection(delta int) {
+	item := m.selectedItem()
+	if item == nil {
+		return
+	}
+	for i := m.cursor; i >= 0 && i < len(m.items); i += delta {
+		if m.items[i].section == item.section {
+			continue
+		}
+		// Going back lands on the first item of that section too
+		section := m.items[i].section
+		for i > 0 && m.items[i-1].section == section {
+			i--
+		}
+		m.cursor = i
+		return
+	}
+}
+
+// selectedItem returns the item under the cursor
+func (m *MyWorkView) selectedItem() *myWorkItem {
+	if m.cursor < 0 || m.cursor >= len(m.items) {
+		return nil
+	}
+	return &m.items[m.cursor]
+}
+
+// resultURL returns the web URL of a search result
+func resultURL(result models.SearchResult) string {
+	switch {
+	case result.Issue != nil:
+		return result.Issue.HTMLURL
+	case result.PullRequest != nil:
+		return result.PullRequest.HTMLURL
+	}
+	return ""
+}
+
+// itemRepository returns the owner and name of the repository of an item
+func (m *MyWorkView) itemRepository(result models.SearchResult) (string, string) {
+	if owner, repo, ok := strings.Cut(result.Repository, "/"); ok {
+		return owner, repo
+	}
+	return m.owner, m.repo
+}
+
+// showDetail opens the detail view of the selected item
+func (m *MyWorkView) showDetail() tea.Cmd {
+	item := m.selectedItem()
+	if item == nil {
+		return nil
+	}
+	owner, repo := m.itemRepository(item.result)
+
+	switch {
+	case item.result.Issue != nil:
+		view := NewIssueDetailView(item.result.Issue, owner, repo, m.issueRepo)
+		view.width, view.height = m.width, m.height
+		m.detailView = view
+	case item.result.PullRequest != nil:
+		ensurePRNumber(item.result.PullRequest)
+		view := NewPRDetailView(item.result.PullRequest, owner, repo, m.prRepo)
+		view.SetCommitRepository(m.commitRepo)
+		view.width, view.height = m.width, m.height
+		m.detailView = view
+	default:
+		return nil
+	}
+	m.showingDetail = true
+	return m.detailView.Init()
+}
+
+// closeDetail closes the detail view, cancelling its fetches
+func (m *MyWorkView) closeDetail() {
+	if closer, ok := m.detailView.(interface{ Close() }); ok {
+		closer.Close()
+	}
+	m.showingDetail = false
+	m.detailView = nil
+}
+
+// IsShowingDetail reports whether a detail view is open
+func (m *MyWorkView) IsShowingDetail() bool {
+	return m.showingDetail && m.detailView != nil
+}
+
+// IsCapturingInput reports whether the open detail view takes text input
+func (m *MyWorkView) IsCapturingInput() bool {
+	if capturer, ok := m.detailView.(interface{ IsCapturingInput() bool }); ok && m.showingDetail {
+		return capturer.IsCapturingInput()
+	}
+	return false
+}
+
+// View renders the view
+func (m *MyWorkView) View() string {
+	if m.width == 0 || m.height == 0 {
+		return "Initializing..."
+	}
+	if m.showingDetail && m.detailView != nil {
+		return m.detailView.View()
+	}
+
+	var s strings.Builder
+	s.WriteString(m.renderHeader())
+	s.WriteString("\n")
+
+	switch {
+	case m.guest():
+		s.WriteString(styles.MutedStyle.Render("My Work needs a GitHub token (guest mode)"))
+	case m.loading && m.work == nil:
+		s.WriteString(styles.MutedStyle.Render("Loading..."))
+	case m.err != nil:
+		s.WriteString(styles.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+	case m.work != nil && len(m.items) == 0:
+		s.WriteString(styles.MutedStyle.Render("Nothing needs you right now."))
+	default:
+		s.WriteString(m.renderList())
+	}
+
+	if m.showHelp {
+		s.WriteString("\n")
+		s.WriteString(m.renderHelp())
+	}
+
+	s.WriteString("\n")
+	m.updateStatusBar()
+	s.WriteString(m.statusBar.View())
+	return s.String()
+}
+
+// renderHeader renders the title and the scope
+func (m *MyWorkView) renderHeader() string {
+	title := styles.HeaderStyle.Render("My Work")
+	scope := styles.MutedStyle.Render(m.scopeLabel())
+	return lipgloss.JoinHorizontal(lipgloss.Top, title, " ", scope)
+}
+
+// renderList renders the rows around the cursor
+func (m *MyWorkView) renderList() string {
+	availableHeight := m.height - 4
+	if m.showHelp {
+		availableHeight -= 14
+	}
+	if availableHeight < 3 {
+		availableHeight = 3
+	}
+
+	cursorRow := 0
+	for i, row := range m.rows {
+		if row.item == m.cursor {
+			cursorRow = i
+			break
+		}
+	}
+	start, end := components.VisibleRange(cursorRow, len(m.rows), availableHeight)
+
+	var s strings.Builder
+	for _, row := range m.rows[start:end] {
+		if row.item < 0 {
+			s.WriteString(styles.BoldStyle.Render(row.header))
+		} else {
+			s.WriteString(m.renderItemLine(m.items[row.item], row.item))
+		}
+		s.WriteString("\n")
+	}
+	return s.String()
+}
+
+// renderItemLine renders an item: its kind, reference, title, author and last update
+func (m *MyWorkView) renderItemLine(item myWorkItem, index int) string {
+	cursor := "  "
+	titleStyle := styles.IssueTitleStyle
+	if m.cursor == index {
+		cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
+		titleStyle = styles.SelectedStyle
+	}
+
+	var (
+		icon, title, author string
+		number              int
+		updatedAt           time.Time
+	)
+	switch r := item.result; {
+	case r.PullRequest != nil:
+		icon, title, author, number, updatedAt = styles.IconPR, r.PullRequest.Title, r.PullRequest.Author.Login, r.PullRequest.Number, r.PullRequest.UpdatedAt
+	case r.Issue != nil:
+		icon, title, author, number, updatedAt = styles.IconIssue, r.Issue.Title, r.Issue.Author.Login, r.Issue.Number, r.Issue.UpdatedAt
+	}
+
+	ref := fmt.Sprintf("#%d", number)
+	if m.allRepos && item.result.Repository != "" {
+		ref = item.result.Repository + ref
+	}
+
+	maxTitleLen := m.width - lipgloss.Width(ref) - 40
+	if maxTitleLen < 20 {
+		maxTitleLen = 20
+	}
+	if len(title) > maxTitleLen {
+		title = title[:maxTitleLen-3] + "..."
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		cursor,
+		icon, " ",
+		styles.IssueNumberStyle.Render(ref), " ",
+		titleStyle.Render(title), " ",
+		styles.AuthorStyle.Render("@"+author), " ",
+		styles.DateStyle.Render(formatRelativeTime(updatedAt)),
+	)
+}
+
+// renderHelp renders the help section
+func (m *MyWorkView) renderHelp() string {
+	helpText := `
+Navigation:
+  ↑/k         Move up
+  ↓/j         Move down
+  g/G         Go to top/bottom
+  tab         Next section
+  shift+tab   Previous section
+
+Actions:
+  enter       View details
+  o           Open in browser
+  a           Toggle current/configured repositories
+  r           Refresh
+
+General:
+  ?           Toggle help
+  q           Quit
+`
+	return styles.BorderStyle.Render(
+		styles.HelpStyle.Render(strings.TrimSpace(helpText)),
+	)
+}
+
+// updateStatusBar updates the status bar with the current state
+func (m *MyWorkView) updateStatusBar() {
+	m.statusBar.ClearItems()
+	m.statusBar.SetMode("My Work")
+	if m.loading {
+		m.statusBar.SetMode("Loading")
+	}
+	if item := m.selectedItem(); item != nil {
+		m.statusBar.AddItem(m.sectionTitle(item.section), fmt.Sprintf("%d/%d", m.cursor+1, len(m.items)))
+	}
+}
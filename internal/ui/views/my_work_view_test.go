@@ -0,0 +1,97 @@
+package views
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// stubMyWork returns the same sections for every scope and records the scopes
+type stubMyWork struct {
+	work   *models.MyWork
+	scopes [][]string
+}
+
+func (s *stubMyWork) Execute(ctx context.Context, repositories []string) (*models.MyWork, error) {
+	s.scopes = append(s.scopes, repositories)
+	return s.work, nil
+}
+
+func newLoadedMyWorkView(t *testing.T, uc *stubMyWork) *MyWorkView {
+	t.Helper()
+	view := NewMyWorkViewWithUseCase(uc, "owner", "repo", []string{"owner/repo", "acme/api"})
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	view.Update(view.Init()())
+	return view
+}
+
+func TestMyWorkView_SectionsAndScope(t *testing.T) {
+	uc := &stubMyWork{work: &models.MyWork{Sections: []models.MyWorkSection{
+		{Category: models.MyWorkAssigned, Title: "Assigned to me", TotalCount: 1, Items: []models.SearchResult{
+			{Type: models.SearchTypeIssue, Repository: "owner/repo", Issue: &models.Issue{Number: 1, Title: "Crash on start", HTMLURL: "https://github.com/owner/repo/issues/1"}},
+		}},
+		{Category: models.MyWorkAuthored, Title: "My pull requests"},
+		{Category: models.MyWorkReviewRequested, Title: "Review requested", TotalCount: 120, Items: []models.SearchResult{
+			{Type: models.SearchTypePR, Repository: "acme/api", PullRequest: &models.PullRequest{Number: 7, Title: "Add cache", HTMLURL: "https://github.com/acme/api/pull/7"}},
+			{Type: models.SearchTypePR, Repository: "acme/api", PullRequest: &models.PullRequest{Number: 8, Title: "Fix cache"}},
+		}},
+	}}}
+	view := newLoadedMyWorkView(t, uc)
+
+	out := view.View()
+	for _, want := range []string{"Assigned to me (1)", "My pull requests (0)", "Review requested (2 of 120)", "#1", "Crash on start", "Add cache"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in\n%s", want, out)
+		}
+	}
+	if len(uc.scopes) != 1 || len(uc.scopes[0]) != 1 || uc.scopes[0][0] != "owner/repo" {
+		t.Errorf("expected the current repository searched first, got %v", uc.scopes)
+	}
+
+	// tab skips the empty section and lands on the next item
+	press(view, "tab")
+	if view.cursor != 1 {
+		t.Errorf("expected tab to jump to the review requests, cursor = %d", view.cursor)
+	}
+	press(view, "j")
+	press(view, "tab")
+	press(view, "shift+tab")
+	if view.cursor != 0 {
+		t.Errorf("expected shift+tab to go back to the assigned issues, cursor = %d", view.cursor)
+	}
+
+	// a searches the configured repositories and shows where items come from
+	view.Update(press(view, "a")())
+	if len(uc.scopes) != 2 || len(uc.scopes[1]) != 2 {
+		t.Errorf("expected the configured repositories searched, got %v", uc.scopes)
+	}
+	if out := view.View(); !strings.Contains(out, "acme/api#7") || !strings.Contains(out, "2 configured repositories") {
+		t.Errorf("expected items labelled with their repository\n%s", out)
+	}
+}
+
+func TestMyWorkView_OpensDetailInTheItemsRepository(t *testing.T) {
+	uc := &stubMyWork{work: &models.MyWork{Sections: []models.MyWorkSection{
+		{Category: models.MyWorkReviewRequested, Title: "Review requested", TotalCount: 1, Items: []models.SearchResult{
+			{Type: models.SearchTypePR, Repository: "acme/api", PullRequest: &models.PullRequest{Number: 7, Title: "Add cache"}},
+		}},
+	}}}
+	view := newLoadedMyWorkView(t, uc)
+
+	press(view, "enter")
+	detail, ok := view.detailView.(*PRDetailView)
+	if !ok || !view.IsShowingDetail() {
+		t.Fatalf("expected a PR detail view, got %T", view.detailView)
+	}
+	if detail.owner != "acme" || detail.repo != "api" {
+		t.Errorf("expected the detail view for acme/api, got %s/%s", detail.owner, detail.repo)
+	}
+
+	press(view, "q")
+	if view.IsShowingDetail() {
+		t.Error("expected q to close the detail view")
+	}
+}
@@ -0,0 +1,62 @@
+package views
+
+import "sync"
+
+// Defaults matching ui.page_size and ui.max_items
+const (
+	defaultPageSize = 100
+	defaultMaxItems = 100
+)
+
+var (
+	listLimitsMu sync.RWMutex
+	pageSize     = defaultPageSize
+	maxItems     = defaultMaxItems
+)
+
+// SetListLimits sets how many items a list view requests per API call and
+// how many it loads in total. Values out of range keep the defaults.
+func SetListLimits(perPage, max int) {
+	listLimitsMu.Lock()
+	defer listLimitsMu.Unlock()
+	pageSize, maxItems = defaultPageSize, defaultMaxItems
+	if perPage > 0 && perPage <= 100 {
+		pageSize = perPage
+	}
+	if max > 0 {
+		maxItems = max
+	}
+}
+
+// listLimits returns the page size and the maximum number of items of a list.
+// The page size never exceeds the maximum, so small lists take one call.
+func listLimits() (perPage, max int) {
+	listLimitsMu.RLock()
+	defer listLimitsMu.RUnlock()
+	perPage, max = pageSize, maxItems
+	if perPage > max {
+		perPage = max
+	}
+	return perPage, max
+}
+
+// fetchPages calls fetch for pages 1, 2, ... until a page comes back short or
+// the maximum number of items is loaded. Fetches take the context of the view.
+func fetchPages[T any](fetch func(page, perPage int) ([]T, error)) ([]T, error) {
+	perPage, max := listLimits()
+	var items []T
+	for page := 1; len(items) < max; page++ {
+		batch, err := fetch(page, perPage)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, batch...)
+		if len(batch) < perPage {
+			break
+		}
+	}
+	if len(items) > max {
+		items = items[:max]
+	}
+	return items, nil
+}
@@ -0,0 +1,73 @@
+package views
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFetchPages(t *testing.T) {
+	t.Cleanup(func() { SetListLimits(0, 0) })
+
+	// pages returns a fetch over total items, recording the requested page sizes
+	pages := func(total int, sizes *[]int) func(page, perPage int) ([]int, error) {
+		return func(page, perPage int) ([]int, error) {
+			*sizes = append(*sizes, perPage)
+			var items []int
+			for i := (page - 1) * perPage; i < page*perPage && i < total; i++ {
+				items = append(items, i)
+			}
+			return items, nil
+		}
+	}
+
+	tests := []struct {
+		name      string
+		pageSize  int
+		maxItems  int
+		total     int
+		wantItems int
+		wantCalls int
+		wantSize  int
+	}{
+		{"defaults load one page", 0, 0, 250, 100, 1, 100},
+		{"stops at a short page", 30, 100, 45, 45, 2, 30},
+		{"stops at max items", 30, 100, 500, 100, 4, 30},
+		{"page size capped by max items", 100, 20, 500, 20, 1, 20},
+		{"page size above 100 keeps default", 500, 300, 1000, 300, 3, 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetListLimits(tt.pageSize, tt.maxItems)
+			var sizes []int
+			items, err := fetchPages(pages(tt.total, &sizes))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(items) != tt.wantItems {
+				t.Errorf("items = %d, want %d", len(items), tt.wantItems)
+			}
+			if len(sizes) != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", len(sizes), tt.wantCalls)
+			}
+			if len(sizes) > 0 && sizes[0] != tt.wantSize {
+				t.Errorf("per page = %d, want %d", sizes[0], tt.wantSize)
+			}
+		})
+	}
+}
+
+func TestFetchPages_Error(t *testing.T) {
+	t.Cleanup(func() { SetListLimits(0, 0) })
+	SetListLimits(10, 50)
+
+	want := errors.New("rate limited")
+	items, err := fetchPages(func(page, perPage int) ([]int, error) {
+		if page == 2 {
+			return nil, want
+		}
+		return make([]int, perPage), nil
+	})
+	if !errors.Is(err, want) || items != nil {
+		t.Errorf("got %v, %v; want the error and no items", items, err)
+	}
+}
@@ -0,0 +1,138 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// prCodeOwnersLoadedMsg carries the CODEOWNERS rules of the base branch and
+// who currently approves the PR
+type prCodeOwnersLoadedMsg struct {
+	owners    models.CodeOwners
+	approvals *models.Approvals
+	err       error
+}
+
+// loadCodeOwners loads the CODEOWNERS rules and the approvals; reloading
+// the PR refreshes the approvals
+func (m *PRDetailView) loadCodeOwners() tea.Cmd {
+	if m.prRepo == nil {
+		return nil
+	}
+	ctx := m.loads.Context()
+	repo, owner, name, pr := m.prRepo, m.owner, m.repo, m.pr
+	return func() tea.Msg {
+		var (
+			wg          sync.WaitGroup
+			owners      models.CodeOwners
+			approvals   *models.Approvals
+			err         error
+			approvalErr error
+		)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			// GitHub applies the CODEOWNERS of the base branch
+			owners, err = repo.GetCodeOwners(ctx, owner, name, pr.Base.Name)
+		}()
+		go func() {
+			defer wg.Done()
+			// Guests cannot query GraphQL; the owners are shown without approvals
+			if !isGuest(repo) {
+				approvals, approvalErr = repo.ListApprovals(ctx, owner, name, pr.Number)
+			}
+		}()
+		wg.Wait()
+		if err == nil {
+			err = approvalErr
+		}
+		return prCodeOwnersLoadedMsg{owners: owners, approvals: approvals, err: err}
+	}
+}
+
+// handleCodeOwnersLoaded applies the loaded CODEOWNERS rules and approvals
+func (m *PRDetailView) handleCodeOwnersLoaded(msg prCodeOwnersLoadedMsg) {
+	if staleLoad(msg.err) {
+		return
+	}
+	m.ownersLoading = false
+	m.ownersErr = msg.err
+	if msg.err == nil {
+		m.codeOwners = msg.owners
+		m.approvals = msg.approvals
+	}
+}
+
+// renderCodeOwners renders the owners of each changed file, led by the owners
+// whose approval is still missing. Nothing is shown without a CODEOWNERS file.
+func (m *PRDetailView) renderCodeOwners() string {
+	switch {
+	case m.ownersLoading || m.filesLoading:
+		return styles.MutedStyle.Render("Loading code owners...")
+	case m.ownersErr != nil:
+		return styles.MutedStyle.Render(fmt.Sprintf("Code owners unavailable: %v", m.ownersErr))
+	case len(m.codeOwners) == 0 || m.filesErr != nil:
+		return ""
+	}
+
+	files := diffFileNames(m.files)
+	groups := m.codeOwners.Groups(files, m.approvals)
+	if len(groups) == 0 {
+		return styles.BoldStyle.Render("Code owners") + "\n" + styles.MutedStyle.Render("No changed file has a code owner.")
+	}
+
+	var pending []string
+	groupOf := make(map[string]int)
+	for i, group := range groups {
+		if !group.Approved {
+			pending = append(pending, formatCodeOwners(group.Owners, " or "))
+		}
+		for _, file := range group.Files {
+			groupOf[file] = i
+		}
+	}
+
+	lines := []string{styles.BoldStyle.Render("Code owners")}
+	if len(pending) == 0 {
+		lines = append(lines, styles.SuccessStyle.Render(styles.IconCheck+" All code owners approved"))
+	} else {
+		lines = append(lines, styles.WarningStyle.Render("Awaiting approval from "+strings.Join(pending, ", ")))
+	}
+	lines = append(lines, "")
+
+	width := 0
+	for _, file := range files {
+		width = max(width, len(file))
+	}
+	for _, file := range files {
+		i, ok := groupOf[file]
+		if !ok {
+			lines = append(lines, fmt.Sprintf("%-*s  %s", width, file, styles.MutedStyle.Render("no owner")))
+			continue
+		}
+		owners := formatCodeOwners(groups[i].Owners, ", ")
+		if groups[i].Approved {
+			owners = styles.SuccessStyle.Render(owners + " " + styles.IconCheck)
+		}
+		lines = append(lines, fmt.Sprintf("%-*s  %s", width, file, owners))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatCodeOwners lists owners as mentions; e-mail owners are kept as they are
+func formatCodeOwners(owners []string, sep string) string {
+	names := make([]string, len(owners))
+	for i, owner := range owners {
+		if strings.Contains(owner, "@") {
+			names[i] = owner
+		} else {
+			names[i] = "@" + owner
+		}
+	}
+	return strings.Join(names, sep)
+}
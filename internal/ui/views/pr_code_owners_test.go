@@ -0,0 +1,61 @@
+package views
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestPRDetailView_CodeOwnersInFilesTab(t *testing.T) {
+	pr := createTestPullRequest()
+	repo := &testPRRepo{
+		pr:        pr,
+		owners:    models.ParseCodeOwners("/api/ @octo/backend @carol\n*.md @octo/docs\n"),
+		approvals: &models.Approvals{Users: []string{"dave"}, Teams: []string{"octo/docs"}},
+		files: []*models.DiffFile{
+			{Filename: "api/handler.go"},
+			{Filename: "api/README.md"},
+			{Filename: "main.go"},
+		},
+	}
+	view := NewPRDetailView(pr, "owner", "repo", repo)
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 60})
+	view.Update(view.loadFiles()())
+	view.Update(view.loadCodeOwners()())
+	press(view, "2")
+
+	out := view.View()
+	for _, want := range []string{
+		"Awaiting approval from @octo/backend or @carol",
+		"api/handler.go  @octo/backend, @carol",
+		"api/README.md   @octo/docs ✓",
+		"main.go         no owner",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in\n%s", want, out)
+		}
+	}
+
+	// Approving on behalf of the team settles the remaining files
+	repo.approvals.Teams = append(repo.approvals.Teams, "octo/backend")
+	view.Update(view.loadCodeOwners()())
+	if out := view.View(); !strings.Contains(out, "All code owners approved") {
+		t.Errorf("expected every owner approved, got\n%s", out)
+	}
+}
+
+func TestPRDetailView_NoCodeOwners(t *testing.T) {
+	pr := createTestPullRequest()
+	repo := &testPRRepo{pr: pr, files: []*models.DiffFile{{Filename: "main.go"}}}
+	view := NewPRDetailView(pr, "owner", "repo", repo)
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 60})
+	view.Update(view.loadFiles()())
+	view.Update(view.loadCodeOwners()())
+	press(view, "2")
+
+	if out := view.View(); strings.Contains(out, "Code owners") {
+		t.Errorf("expected no code owner section without CODEOWNERS, got\n%s", out)
+	}
+}
@@ -0,0 +1,103 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// prConflictsLoadedMsg carries the files a PR with merge conflicts may conflict in
+type prConflictsLoadedMsg struct {
+	headSHA   string
+	conflicts *models.ConflictFiles
+	err       error
+}
+
+// branchUpdatedMsg is sent once GitHub accepted merging the base branch into the head
+type branchUpdatedMsg struct {
+	err error
+}
+
+// prConflicts is the list of files shown for a PR with merge conflicts
+type prConflicts struct {
+	loading bool
+	headSHA string // head the files were loaded for
+	files   *models.ConflictFiles
+	err     error
+}
+
+// hasConflicts reports whether GitHub found merge conflicts in the PR
+func (m *PRDetailView) hasConflicts() bool {
+	return m.pr.State == models.PRStateOpen && !m.pr.Merged && m.pr.MergeableState == "dirty"
+}
+
+// loadConflicts lists the files the conflicts of the PR can be in, once per
+// head commit unless fresh
+func (m *PRDetailView) loadConflicts(fresh bool) tea.Cmd {
+	if m.prRepo == nil || !m.hasConflicts() || m.conflicts.loading {
+		return nil
+	}
+	headSHA := m.pr.Head.SHA
+	if !fresh && m.conflicts.files != nil && m.conflicts.headSHA == headSHA {
+		return nil
+	}
+	m.conflicts.loading = true
+	ctx := m.loads.Context()
+	if fresh {
+		ctx = freshContext(ctx)
+	}
+	return func() tea.Msg {
+		conflicts, err := m.prRepo.ListConflictFiles(ctx, m.owner, m.repo, m.pr.Number)
+		return prConflictsLoadedMsg{headSHA: headSHA, conflicts: conflicts, err: err}
+	}
+}
+
+// updateBranch merges the base branch into the head branch, refusing if
+// someone pushed since the PR was loaded
+func (m *PRDetailView) updateBranch() tea.Cmd {
+	headSHA := m.pr.Head.SHA
+	return func() tea.Msg {
+		return branchUpdatedMsg{err: m.prRepo.UpdateBranch(m.loads.writeContext(), m.owner, m.repo, m.pr.Number, headSHA)}
+	}
+}
+
+// renderConflicts renders the files the merge conflicts can be in
+func (m *PRDetailView) renderConflicts() string {
+	if !m.hasConflicts() {
+		return ""
+	}
+	switch {
+	case m.conflicts.loading && m.conflicts.files == nil:
+		return styles.MutedStyle.Render("Looking for conflicting files...") + "\n"
+	case m.conflicts.err != nil:
+		return styles.MutedStyle.Render(fmt.Sprintf("Failed to list conflicting files: %v", m.conflicts.err)) + "\n"
+	case m.conflicts.files == nil:
+		return ""
+	}
+
+	conflicts := m.conflicts.files
+	var s strings.Builder
+	s.WriteString(styles.ErrorStyle.Render(fmt.Sprintf("%s Merge conflicts with %s", styles.IconCross, conflicts.BaseRef)))
+	if conflicts.BehindBy > 0 {
+		s.WriteString(styles.MutedStyle.Render(fmt.Sprintf(" (%d commits behind)", conflicts.BehindBy)))
+	}
+	s.WriteString("\n")
+	if len(conflicts.Files) == 0 {
+		s.WriteString(styles.MutedStyle.Render("  No file was changed on both sides; the conflict may be in a rename or a deletion"))
+		s.WriteString("\n")
+	} else {
+		s.WriteString(styles.MutedStyle.Render("  Changed on both sides since the branch point:"))
+		s.WriteString("\n")
+		for _, file := range conflicts.Files {
+			s.WriteString("  " + styles.WarningStyle.Render(file) + "\n")
+		}
+	}
+	if canWrite(m.prRepo) {
+		s.WriteString(styles.MutedStyle.Render("  U to update the branch (GitHub refuses when the conflicts need resolving by hand)"))
+		s.WriteString("\n")
+	}
+	return s.String()
+}
@@ -0,0 +1,64 @@
+package views
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestPRDetailView_ListsConflictingFiles(t *testing.T) {
+	full := &models.PullRequest{Number: 5, State: models.PRStateOpen, MergeableState: "dirty",
+		Head: models.Branch{Name: "feature", SHA: "abc123"}, Base: models.Branch{Name: "main"}}
+	repo := &testPRRepo{pr: full, conflicts: &models.ConflictFiles{BaseRef: "main", BehindBy: 3, Files: []string{"go.mod", "internal/app/app.go"}}}
+	view := NewPRDetailView(&models.PullRequest{Number: 5, State: models.PRStateOpen}, "owner", "repo", repo)
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 60})
+	view.full.start()
+
+	view.Update(view.loadFull()())
+	if !view.conflicts.loading {
+		t.Fatal("expected the conflicting files to be looked up")
+	}
+	if view.loadConflicts(true) != nil {
+		t.Error("expected one lookup at a time")
+	}
+	view.Update(prConflictsLoadedMsg{headSHA: "abc123", conflicts: repo.conflicts})
+
+	out := view.renderOverviewTab()
+	for _, want := range []string{"Merge conflicts with main", "3 commits behind", "go.mod", "internal/app/app.go", "U to update the branch"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in the overview, got:\n%s", want, out)
+		}
+	}
+	if view.loadConflicts(false) != nil {
+		t.Error("expected the files to be loaded once per head commit")
+	}
+}
+
+func TestPRDetailView_UpdateBranch(t *testing.T) {
+	pr := &models.PullRequest{Number: 5, State: models.PRStateOpen, MergeableState: "behind",
+		Head: models.Branch{Name: "feature", SHA: "abc123"}, Base: models.Branch{Name: "main"}}
+	repo := &testPRRepo{pr: pr}
+	view := NewPRDetailView(pr, "owner", "repo", repo)
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'U'}})
+	if cmd == nil || !view.updatingBranch {
+		t.Fatal("expected U to update the branch")
+	}
+	if _, again := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'U'}}); again != nil {
+		t.Error("expected a second U to wait for the first")
+	}
+	_, reload := view.Update(cmd())
+	if repo.updated == nil || *repo.updated != "abc123" {
+		t.Fatalf("expected the update to be guarded by the loaded head, got %v", repo.updated)
+	}
+	if reload == nil || !view.refreshing || !strings.Contains(view.statusMessage, "merging main into feature") {
+		t.Errorf("expected a reload after the update, status %q", view.statusMessage)
+	}
+
+	closed := NewPRDetailView(&models.PullRequest{Number: 6, State: models.PRStateClosed}, "owner", "repo", repo)
+	if _, cmd := closed.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'U'}}); cmd != nil {
+		t.Error("expected a closed PR not to be updated")
+	}
+}
@@ -0,0 +1,281 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// editorPurposeNewPR tags the editor session drafting a new pull request
+const editorPurposeNewPR = "new-pr"
+
+// prTemplatePaths are the locations GitHub reads a pull request template from, in order
+var prTemplatePaths = []string{
+	".github/PULL_REQUEST_TEMPLATE.md",
+	".github/pull_request_template.md",
+	"PULL_REQUEST_TEMPLATE.md",
+	"pull_request_template.md",
+	"docs/PULL_REQUEST_TEMPLATE.md",
+	"docs/pull_request_template.md",
+}
+
+// prDraftLoadedMsg is sent when the base branch, template and commits of a new PR are loaded
+type prDraftLoadedMsg struct {
+	base         string
+	template     string
+	templatePath string
+	commits      []*models.Commit
+	err          error
+}
+
+// prCreatedMsg is sent when a new pull request has been submitted
+type prCreatedMsg struct {
+	pr  *models.PullRequest
+	err error
+}
+
+// prCreator holds the state of opening a pull request from the checked-out
+// branch: confirming the branches, editing the draft and submitting it
+type prCreator struct {
+	confirming   bool
+	loading      bool
+	head         string
+	base         string
+	template     string
+	templatePath string
+	commits      []*models.Commit // commits on head that are not on base, oldest first
+	summary      bool             // add a summary section generated from the commits
+	editing      bool
+	submitting   bool
+}
+
+// SetCommitRepository sets the repository used to draft new pull requests
+func (m *PRView) SetCommitRepository(repo repository.CommitRepository) {
+	m.commitRepo = repo
+}
+
+// startCreatePR loads what is needed to draft a pull request for the checked-out branch
+func (m *PRView) startCreatePR() tea.Cmd {
+	if m.fetchPRsUseCase == nil || m.commitRepo == nil {
+		return nil
+	}
+	if !canWrite(m.fetchPRsUseCase.GetRepository()) {
+		m.statusBar.SetMessage(readOnlyStatus)
+		return nil
+	}
+	if m.prCreator.editing || m.prCreator.submitting {
+		m.statusBar.SetMessage("A pull request is already being created")
+		return nil
+	}
+	if m.localBranch == nil || m.localBranch.status == nil || m.localBranch.status.Branch == "" {
+		m.statusBar.SetMessage("Check out a branch in a clone of this repository to open a pull request")
+		return nil
+	}
+
+	head := m.localBranch.status.Branch
+	m.prCreator = prCreator{confirming: true, loading: true, head: head}
+	ctx := m.loads.Context()
+	commitRepo, owner, repo := m.commitRepo, m.owner, m.repo
+	return func() tea.Msg {
+		return loadPRDraft(ctx, commitRepo, owner, repo, head)
+	}
+}
+
+// loadPRDraft fetches the default branch as the base, the PR template and the
+// commits head adds to the base
+func loadPRDraft(ctx context.Context, commitRepo repository.CommitRepository, owner, repo, head string) prDraftLoadedMsg {
+	base, err := commitRepo.GetDefaultBranch(ctx, owner, repo)
+	if err != nil {
+		return prDraftLoadedMsg{err: err}
+	}
+	if base == head {
+		return prDraftLoadedMsg{err: fmt.Errorf("%s is the default branch; check out a topic branch first", head)}
+	}
+
+	comparison, err := commitRepo.Compare(ctx, owner, repo, base, head)
+	if err != nil {
+		if isNotFound(err) {
+			return prDraftLoadedMsg{err: fmt.Errorf("%s was not found on GitHub; push it first", head)}
+		}
+		return prDraftLoadedMsg{err: err}
+	}
+	if len(comparison.Commits) == 0 {
+		return prDraftLoadedMsg{err: fmt.Errorf("%s has no commits that are not on %s", head, base)}
+	}
+
+	msg := prDraftLoadedMsg{base: base, commits: comparison.Commits}
+	for _, path := range prTemplatePaths {
+		content, err := commitRepo.GetFileContent(ctx, owner, repo, path, base)
+		if err == nil {
+			msg.template, msg.templatePath = content, path
+			break
+		}
+		if !isNotFound(err) {
+			return prDraftLoadedMsg{err: err}
+		}
+	}
+	return msg
+}
+
+// isNotFound reports whether err is a 404 from the API
+func isNotFound(err error) bool {
+	var apiErr *models.APIError
+	return errors.As(err, &apiErr) && apiErr.Kind == models.APIErrorNotFound
+}
+
+// handlePRDraftLoaded shows the branches and commits to confirm
+func (m *PRView) handlePRDraftLoaded(msg prDraftLoadedMsg) tea.Cmd {
+	if !m.prCreator.confirming || !m.prCreator.loading {
+		return nil
+	}
+	if isCancelled(msg.err) {
+		m.prCreator = prCreator{}
+		return nil
+	}
+	if msg.err != nil {
+		m.prCreator = prCreator{}
+		m.statusBar.SetMessage(fmt.Sprintf("Cannot open a pull request: %v", msg.err))
+		return nil
+	}
+	m.prCreator.loading = false
+	m.prCreator.base = msg.base
+	m.prCreator.template = msg.template
+	m.prCreator.templatePath = msg.templatePath
+	m.prCreator.commits = msg.commits
+	// Without a template the commit summary is the only prefilled content
+	m.prCreator.summary = msg.template == ""
+	return nil
+}
+
+// handlePRConfirmKey handles a key while the new pull request is being confirmed
+func (m *PRView) handlePRConfirmKey(msg tea.KeyMsg) tea.Cmd {
+	if msg.Type == tea.KeyEnter {
+		if m.prCreator.loading {
+			return nil
+		}
+		m.prCreator.confirming = false
+		m.prCreator.editing = true
+		m.statusBar.SetMessage("")
+		return openEditor(editorPurposeNewPR, prDraft(m.prCreator.head, m.prCreator.template, m.prCreator.commits, m.prCreator.summary))
+	}
+
+	switch msg.String() {
+	case "esc", "q":
+		m.prCreator = prCreator{}
+	case "s":
+		m.prCreator.summary = !m.prCreator.summary
+	}
+	return nil
+}
+
+// handlePRDraftEdited submits the edited draft as a new pull request
+func (m *PRView) handlePRDraftEdited(msg editorClosedMsg) tea.Cmd {
+	if !m.prCreator.editing {
+		return nil
+	}
+	head, base := m.prCreator.head, m.prCreator.base
+	m.prCreator = prCreator{}
+	if msg.err != nil {
+		m.statusBar.SetMessage(fmt.Sprintf("Failed to edit pull request: %v", msg.err))
+		return nil
+	}
+
+	title, body := parseDraft(msg.text)
+	if title == "" {
+		m.statusBar.SetMessage("Pull request not created (empty title)")
+		return nil
+	}
+
+	input := &models.CreatePRInput{Title: title, Body: body, Head: head, Base: base}
+	m.prCreator.submitting = true
+	m.statusBar.SetMessage("Creating pull request...")
+	prRepo := m.fetchPRsUseCase.GetRepository()
+	ctx := m.loads.writeContext()
+	owner, repo := m.owner, m.repo
+	return func() tea.Msg {
+		pr, err := prRepo.Create(ctx, owner, repo, input)
+		return prCreatedMsg{pr: pr, err: err}
+	}
+}
+
+// handlePRCreated reports the new pull request and reloads the list
+func (m *PRView) handlePRCreated(msg prCreatedMsg) tea.Cmd {
+	m.prCreator.submitting = false
+	if msg.err != nil {
+		m.statusBar.SetMessage(fmt.Sprintf("Failed to create pull request: %v", msg.err))
+		return nil
+	}
+	m.statusBar.SetMessage(fmt.Sprintf("Created pull request #%d", msg.pr.Number))
+	return m.refresh()
+}
+
+// prDraft returns the text edited for a new pull request. The title is the
+// subject of a single commit, or the branch name; the body is the template,
+// preceded by a summary of the commits when requested.
+func prDraft(head, template string, commits []*models.Commit, summary bool) string {
+	title := head
+	if len(commits) == 1 {
+		title = firstLine(commits[0].Message)
+	}
+
+	var body strings.Builder
+	if summary {
+		body.WriteString(commitSummary(commits))
+		if template != "" {
+			body.WriteString("\n")
+		}
+	}
+	body.WriteString(template)
+	return title + "\n\n" + body.String()
+}
+
+// commitSummary renders a "## Summary" section listing the commit subjects, oldest first
+func commitSummary(commits []*models.Commit) string {
+	var s strings.Builder
+	s.WriteString("## Summary\n\n")
+	for _, commit := range commits {
+		fmt.Fprintf(&s, "- %s (%s)\n", firstLine(commit.Message), shortSHA(commit.SHA))
+	}
+	return s.String()
+}
+
+// renderPRConfirm renders the branches, template and commits of the new pull request
+func (m *PRView) renderPRConfirm() string {
+	var s strings.Builder
+	s.WriteString(styles.HeaderStyle.Render("New pull request"))
+	s.WriteString("\n\n")
+
+	if m.prCreator.loading {
+		s.WriteString(styles.LoadingStyle.Render(fmt.Sprintf("Comparing %s with the default branch...", m.prCreator.head)))
+		s.WriteString("\n")
+		return s.String()
+	}
+
+	fmt.Fprintf(&s, "%s %s %s\n", m.prCreator.base, styles.IconMergeInto, m.prCreator.head)
+	template := "none"
+	if m.prCreator.templatePath != "" {
+		template = m.prCreator.templatePath
+	}
+	s.WriteString(styles.MutedStyle.Render("Template: " + template))
+	s.WriteString("\n\n")
+
+	fmt.Fprintf(&s, "%d commits:\n", len(m.prCreator.commits))
+	for _, commit := range m.prCreator.commits {
+		fmt.Fprintf(&s, "  %s %s\n", styles.MutedStyle.Render(shortSHA(commit.SHA)), firstLine(commit.Message))
+	}
+
+	mark := "[ ]"
+	if m.prCreator.summary {
+		mark = "[x]"
+	}
+	fmt.Fprintf(&s, "\n%s Add a summary section from the commit messages\n\n", mark)
+	s.WriteString(styles.MutedStyle.Render("s: toggle summary  enter: edit in $EDITOR  esc: cancel"))
+	s.WriteString("\n")
+	return s.String()
+}
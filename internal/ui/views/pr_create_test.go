@@ -0,0 +1,168 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/infra/git"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// draftCommitRepo serves the default branch, files and a comparison for drafting PRs
+type draftCommitRepo struct {
+	repository.CommitRepository
+	files   map[string]string
+	commits []*models.Commit
+}
+
+func (r *draftCommitRepo) GetDefaultBranch(ctx context.Context, owner, repo string) (string, error) {
+	return "main", nil
+}
+
+func (r *draftCommitRepo) Compare(ctx context.Context, owner, repo, base, head string) (*models.Comparison, error) {
+	return &models.Comparison{Commits: r.commits, TotalCommits: len(r.commits)}, nil
+}
+
+func (r *draftCommitRepo) GetFileContent(ctx context.Context, owner, repo, path, ref string) (string, error) {
+	if content, ok := r.files[path]; ok {
+		return content, nil
+	}
+	return "", &models.APIError{Kind: models.APIErrorNotFound, Err: errors.New("not found")}
+}
+
+// createPRRepo records created pull requests
+type createPRRepo struct {
+	repository.PullRequestRepository
+	created *models.CreatePRInput
+}
+
+func (r *createPRRepo) Create(ctx context.Context, owner, repo string, input *models.CreatePRInput) (*models.PullRequest, error) {
+	r.created = input
+	return &models.PullRequest{Number: 15, Title: input.Title}, nil
+}
+
+// newPRCreateView returns a PR view on branch "feature" of a local clone
+func newPRCreateView(prRepo repository.PullRequestRepository, commitRepo repository.CommitRepository) *PRView {
+	view := NewPRViewWithUseCase(&mockFetchPRsUseCase{
+		getRepositoryFunc: func() repository.PullRequestRepository { return prRepo },
+	}, "owner", "repo")
+	view.SetCommitRepository(commitRepo)
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	view.Update(prsLoadedMsg{prs: []*models.PullRequest{}})
+	view.Update(localBranchLoadedMsg{state: &localBranchState{status: &git.BranchStatus{Branch: "feature"}}})
+	return view
+}
+
+func TestPRView_CreatePRWithTemplateAndSummary(t *testing.T) {
+	prRepo := &createPRRepo{}
+	commitRepo := &draftCommitRepo{
+		files: map[string]string{".github/pull_request_template.md": "## Checklist\n\n- [ ] Tests\n"},
+		commits: []*models.Commit{
+			{SHA: "aaaaaaa111", Message: "Add parser\n\nDetails"},
+			{SHA: "bbbbbbb222", Message: "Wire parser into the view"},
+		},
+	}
+	drafted := stubEditor(t, func(text string) string {
+		return strings.Replace(text, "feature", "Add the parser", 1)
+	})
+	view := newPRCreateView(prRepo, commitRepo)
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	if !view.IsCapturingInput() {
+		t.Fatal("the confirmation should capture input")
+	}
+	view.Update(cmd())
+	out := view.View()
+	if !strings.Contains(out, "main ← feature") || !strings.Contains(out, "Wire parser into the view") {
+		t.Errorf("confirmation should show the branches and commits:\n%s", out)
+	}
+	if !strings.Contains(out, "[ ] Add a summary") {
+		t.Errorf("the summary should be off when a template exists:\n%s", out)
+	}
+
+	press(view, "s")
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	want := "feature\n\n## Summary\n\n- Add parser (aaaaaaa)\n- Wire parser into the view (bbbbbbb)\n\n## Checklist\n\n- [ ] Tests\n"
+	if *drafted != want {
+		t.Errorf("draft:\n%q\nwant:\n%q", *drafted, want)
+	}
+	_, cmd = view.Update(cmd())
+	view.Update(cmd())
+
+	if prRepo.created == nil {
+		t.Fatal("pull request was not created")
+	}
+	if prRepo.created.Title != "Add the parser" || prRepo.created.Head != "feature" || prRepo.created.Base != "main" {
+		t.Errorf("unexpected input %+v", prRepo.created)
+	}
+	if !strings.HasPrefix(prRepo.created.Body, "## Summary") || !strings.HasSuffix(prRepo.created.Body, "- [ ] Tests") {
+		t.Errorf("body %q", prRepo.created.Body)
+	}
+	if !strings.Contains(view.View(), "Created pull request #15") {
+		t.Error("status bar should report the new pull request")
+	}
+}
+
+func TestPRView_CreatePRWithoutTemplate(t *testing.T) {
+	commitRepo := &draftCommitRepo{commits: []*models.Commit{{SHA: "ccccccc333", Message: "Fix typo"}}}
+	drafted := stubEditor(t, func(text string) string { return text })
+	view := newPRCreateView(&createPRRepo{}, commitRepo)
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	view.Update(cmd())
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if want := "Fix typo\n\n## Summary\n\n- Fix typo (ccccccc)\n"; *drafted != want {
+		t.Errorf("draft %q, want %q", *drafted, want)
+	}
+}
+
+func TestPRView_CreatePRNeedsTopicBranch(t *testing.T) {
+	tests := []struct {
+		name   string
+		state  *localBranchState
+		status string
+	}{
+		{name: "not a clone", state: nil, status: "Check out a branch"},
+		{name: "default branch", state: &localBranchState{status: &git.BranchStatus{Branch: "main"}}, status: "main is the default branch"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			view := newPRCreateView(&createPRRepo{}, &draftCommitRepo{})
+			view.localBranch = tt.state
+
+			_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+			if cmd != nil {
+				view.Update(cmd())
+			}
+			if view.IsCapturingInput() {
+				t.Error("nothing should be left to confirm")
+			}
+			if out := view.View(); !strings.Contains(out, tt.status) {
+				t.Errorf("status should contain %q:\n%s", tt.status, out)
+			}
+		})
+	}
+}
+
+func TestLoadPRDraft_UnpushedBranch(t *testing.T) {
+	commitRepo := &unpushedCommitRepo{draftCommitRepo{}}
+	msg := loadPRDraft(context.Background(), commitRepo, "owner", "repo", "feature")
+	if msg.err == nil || !strings.Contains(msg.err.Error(), "push it first") {
+		t.Errorf("err = %v, want a hint to push the branch", msg.err)
+	}
+}
+
+// unpushedCommitRepo fails comparisons as GitHub does for unknown branches
+type unpushedCommitRepo struct {
+	draftCommitRepo
+}
+
+func (r *unpushedCommitRepo) Compare(ctx context.Context, owner, repo, base, head string) (*models.Comparison, error) {
+	return nil, &models.APIError{Kind: models.APIErrorNotFound, Err: fmt.Errorf("no branch %s", head)}
+}
@@ -1,13 +1,14 @@
 package views
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
 	"github.com/a1yama/tig-gh/internal/domain/models"
 	"github.com/a1yama/tig-gh/internal/domain/repository"
-	"github.com/a1yama/tig-gh/internal/ui/browser"
+	"github.com/a1yama/tig-gh/internal/infra/clock"
+	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/events"
 	"github.com/a1yama/tig-gh/internal/ui/styles"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/glamour"
@@ -22,16 +23,23 @@ const (
 	tabFiles
 	tabCommits
 	tabComments
+	tabTimeline
 )
 
-// mergeMsg is a message to merge the PR
-type mergeMsg struct {
-	pr *models.PullRequest
-}
+// mergeStage tracks which merge confirmation is on screen
+type mergeStage int
+
+const (
+	mergeStageNone mergeStage = iota
+	mergeStageConfirm
+	mergeStageProtected
+	mergeStageFreeze
+)
 
-// diffMsg is a message to show diff
-type diffMsg struct {
-	pr *models.PullRequest
+// prMergedMsg is a message when the PR has been merged
+type prMergedMsg struct {
+	pr  *models.PullRequest
+	err error
 }
 
 // prCommentsLoadedMsg is a message when comments are loaded
@@ -40,12 +48,50 @@ type prCommentsLoadedMsg struct {
 	err      error
 }
 
+// prThreadsLoadedMsg is a message when review threads are loaded
+type prThreadsLoadedMsg struct {
+	threads []*models.ReviewThread
+	err     error
+}
+
+// prFilesLoadedMsg is a message when the changed files are loaded
+type prFilesLoadedMsg struct {
+	files []*models.DiffFile
+	err   error
+}
+
+// prRequirementsLoadedMsg is a message when the merge requirements are loaded
+type prRequirementsLoadedMsg struct {
+	requirements *models.MergeRequirements
+	err          error
+}
+
 // prReviewsLoadedMsg is a message when reviews are loaded
 type prReviewsLoadedMsg struct {
 	reviews []*models.Review
 	err     error
 }
 
+// reviewSubmittedMsg is a message when a review has been submitted
+type reviewSubmittedMsg struct {
+	event models.ReviewEvent
+	err   error
+}
+
+// sizeLabelAppliedMsg is a message when the size label has been applied
+type sizeLabelAppliedMsg struct {
+	pr     *models.PullRequest
+	size   prSize
+	labels []models.Label
+	err    error
+}
+
+// draftToggledMsg is a message when the draft state has been changed
+type draftToggledMsg struct {
+	pr  *models.PullRequest
+	err error
+}
+
 // PRDetailView is the model for the PR detail view
 type PRDetailView struct {
 	pr              *models.PullRequest
@@ -57,6 +103,8 @@ type PRDetailView struct {
 	owner           string
 	repo            string
 	prRepo          repository.PullRequestRepository
+	issueRepo       repository.IssueRepository
+	commitRepo      repository.CommitRepository
 	currentTab      prTab
 	scrollOffset    int
 	loading         bool
@@ -64,6 +112,52 @@ type PRDetailView struct {
 	width           int
 	height          int
 	renderer        *glamour.TermRenderer
+	statusMessage   string
+	refreshing      bool
+	liveNotice      string // shown once the reload started by a live update is done
+	reviewModal     *components.ConfirmModal
+	reviewEvent     models.ReviewEvent
+	submitting      bool
+	labeling        bool
+	togglingDraft   bool
+	updatingBranch  bool
+	// committingSuggestion is set while a suggested change is being committed
+	committingSuggestion bool
+	threads              []*models.ReviewThread
+	threadsLoading       bool
+	threadsErr           error
+	collapsed            map[string]bool
+	selectedThread       int
+	files                []*models.DiffFile
+	viewedFiles          *models.ViewedFiles
+	filesLoading         bool
+	filesErr             error
+	linked               []*models.LinkedIssue
+	linkedLoading        bool
+	linkedErr            error
+	selectedLinked       int
+	requirements         *models.MergeRequirements
+	codeOwners           models.CodeOwners
+	approvals            *models.Approvals
+	ownersLoading        bool
+	ownersErr            error
+	protectedPaths       models.ProtectedPaths
+	freezeWindows        models.FreezeWindows
+	mergeStage           mergeStage
+	merging              bool
+	timeline             timeline
+	refs                 crossRefCursor
+	images               imageCursor
+	reviewers            reviewerPicker
+	sinceReview          sinceReviewState
+	pendingRequests      []*models.PendingReviewRequest
+	showRepo             bool // opened from a reference to another repository
+	full                 fullFetch
+	mergeable            mergeablePoll
+	conflicts            prConflicts
+	testRun              testRun
+	loads                loadGroup
+	diff                 *DiffView // the diff of the PR, shown in place of the details
 }
 
 // NewPRDetailView creates a new PR detail view
@@ -72,6 +166,7 @@ func NewPRDetailView(pr *models.PullRequest, owner, repo string, prRepo reposito
 	reviewsLoading := prRepo != nil
 	ensurePRNumber(pr)
 	return &PRDetailView{
+		loads:           loadGroup{source: sourcePRDetail},
 		pr:              pr,
 		owner:           owner,
 		repo:            repo,
@@ -81,7 +176,15 @@ func NewPRDetailView(pr *models.PullRequest, owner, repo string, prRepo reposito
 		loading:         false,
 		commentsLoading: commentsLoading,
 		reviewsLoading:  reviewsLoading,
+		threadsLoading:  prRepo != nil && !isGuest(prRepo),
+		filesLoading:    prRepo != nil,
+		linkedLoading:   prRepo != nil && !isGuest(prRepo),
+		ownersLoading:   prRepo != nil,
+		collapsed:       make(map[string]bool),
 		renderer:        newMarkdownRenderer(80),
+		reviewModal:     components.NewConfirmModal(),
+		refs:            newCrossRefCursor(),
+		images:          newImageCursor(),
 	}
 }
 
@@ -95,17 +198,133 @@ func (m *PRDetailView) Init() tea.Cmd {
 		if m.reviewsLoading {
 			cmds = append(cmds, m.loadReviews())
 		}
+		if m.threadsLoading {
+			cmds = append(cmds, m.loadThreads())
+		}
+		if m.filesLoading {
+			cmds = append(cmds, m.loadFiles())
+		}
+		if m.linkedLoading {
+			cmds = append(cmds, m.loadLinkedIssues())
+		}
+		if !isGuest(m.prRepo) {
+			cmds = append(cmds, m.loadRequirements())
+		}
+		cmds = append(cmds, m.loadPendingRequests())
+		if _, ok := prDisplayNumber(m.pr); ok {
+			cmds = append(cmds, m.loadFull(), m.full.start())
+		}
+		if m.ownersLoading {
+			cmds = append(cmds, m.loadCodeOwners())
+		}
+		if cmd := m.loadViewedFiles(); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+		if m.currentTab == tabTimeline {
+			cmds = append(cmds, m.loadTimeline(false))
+		}
 		if len(cmds) > 0 {
 			return tea.Batch(cmds...)
 		}
 	}
 	m.commentsLoading = false
 	m.reviewsLoading = false
+	m.threadsLoading = false
+	m.filesLoading = false
+	m.linkedLoading = false
+	m.ownersLoading = false
 	return nil
 }
 
+// Close cancels the fetches still in flight when the view is closed
+func (m *PRDetailView) Close() {
+	m.loads.Cancel()
+	if m.diff != nil {
+		m.diff.Close()
+	}
+	m.stopTestRun()
+}
+
+// loadFull fetches the complete PR in the background; the lists leave out
+// its line counts, commits and mergeability
+func (m *PRDetailView) loadFull() tea.Cmd {
+	ctx := m.loads.Context()
+	return func() tea.Msg {
+		pr, err := m.prRepo.Get(ctx, m.owner, m.repo, m.pr.Number)
+		return prFullLoadedMsg{pr: pr, err: err}
+	}
+}
+
+// loadMergeable fetches the PR again, bypassing the cache, to see whether
+// GitHub has computed its mergeability
+func (m *PRDetailView) loadMergeable() tea.Cmd {
+	ctx := freshContext(m.loads.Context())
+	return func() tea.Msg {
+		pr, err := m.prRepo.Get(ctx, m.owner, m.repo, m.pr.Number)
+		return prMergeableLoadedMsg{pr: pr, err: err}
+	}
+}
+
+// pollMergeable starts polling while the mergeability of the PR is unknown
+func (m *PRDetailView) pollMergeable() tea.Cmd {
+	if !mergeableUnknown(m.pr) || m.prRepo == nil {
+		m.mergeable.stop()
+		return nil
+	}
+	return m.mergeable.start()
+}
+
+// loadTimeline loads the PR's events for the timeline tab, bypassing the cache when fresh
+func (m *PRDetailView) loadTimeline(fresh bool) tea.Cmd {
+	ctx := m.loads.Context()
+	if fresh {
+		ctx = freshContext(ctx)
+	}
+	return m.timeline.load(ctx, m.issueRepo, m.owner, m.repo, m.pr.Number)
+}
+
+// loadThreads loads review comment threads for the PR
+func (m *PRDetailView) loadThreads() tea.Cmd {
+	ctx := m.loads.Context()
+	return func() tea.Msg {
+		if m.prRepo == nil {
+			return prThreadsLoadedMsg{err: fmt.Errorf("PR repository not available")}
+		}
+
+		threads, err := m.prRepo.ListReviewThreads(ctx, m.owner, m.repo, m.pr.Number)
+		return prThreadsLoadedMsg{threads: threads, err: err}
+	}
+}
+
+// loadFiles loads the files changed by the PR
+func (m *PRDetailView) loadFiles() tea.Cmd {
+	ctx := m.loads.Context()
+	return func() tea.Msg {
+		if m.prRepo == nil {
+			return prFilesLoadedMsg{err: fmt.Errorf("PR repository not available")}
+		}
+
+		files, err := m.prRepo.ListFiles(ctx, m.owner, m.repo, m.pr.Number)
+		return prFilesLoadedMsg{files: files, err: err}
+	}
+}
+
+// loadRequirements loads the branch protection requirements for the PR
+func (m *PRDetailView) loadRequirements() tea.Cmd {
+	ctx := m.loads.Context()
+	return func() tea.Msg {
+		if m.prRepo == nil {
+			return prRequirementsLoadedMsg{err: fmt.Errorf("PR repository not available")}
+		}
+
+		requirements, err := m.prRepo.GetMergeRequirements(ctx, m.owner, m.repo, m.pr.Number)
+		return prRequirementsLoadedMsg{requirements: requirements, err: err}
+	}
+}
+
 // loadComments loads comments for the PR
 func (m *PRDetailView) loadComments() tea.Cmd {
+	ctx := m.loads.Context()
 	return func() tea.Msg {
 		if m.prRepo == nil {
 			return prCommentsLoadedMsg{
@@ -115,7 +334,7 @@ func (m *PRDetailView) loadComments() tea.Cmd {
 		}
 
 		comments, err := m.prRepo.ListComments(
-			context.Background(),
+			ctx,
 			m.owner,
 			m.repo,
 			m.pr.Number,
@@ -131,6 +350,7 @@ func (m *PRDetailView) loadComments() tea.Cmd {
 
 // loadReviews loads reviews for the PR
 func (m *PRDetailView) loadReviews() tea.Cmd {
+	ctx := m.loads.Context()
 	return func() tea.Msg {
 		if m.prRepo == nil {
 			return prReviewsLoadedMsg{
@@ -140,7 +360,7 @@ func (m *PRDetailView) loadReviews() tea.Cmd {
 		}
 
 		reviews, err := m.prRepo.ListReviews(
-			context.Background(),
+			ctx,
 			m.owner,
 			m.repo,
 			m.pr.Number,
@@ -153,18 +373,301 @@ func (m *PRDetailView) loadReviews() tea.Cmd {
 	}
 }
 
+// refresh refetches the PR itself with its reviews and comments, bypassing the cache
+func (m *PRDetailView) refresh() tea.Cmd {
+	parent := m.loads.Context()
+	return func() tea.Msg {
+		if m.prRepo == nil {
+			return prRefreshedMsg{err: fmt.Errorf("PR repository not available")}
+		}
+
+		ctx := freshContext(parent)
+		pr, err := m.prRepo.Get(ctx, m.owner, m.repo, m.pr.Number)
+		if err != nil {
+			return prRefreshedMsg{err: err}
+		}
+
+		reviews, err := m.prRepo.ListReviews(ctx, m.owner, m.repo, m.pr.Number)
+		if err != nil {
+			return prRefreshedMsg{pr: pr, err: err}
+		}
+
+		comments, err := m.prRepo.ListComments(ctx, m.owner, m.repo, m.pr.Number, nil)
+		if err != nil {
+			return prRefreshedMsg{pr: pr, reviews: reviews, err: err}
+		}
+
+		files, err := m.prRepo.ListFiles(ctx, m.owner, m.repo, m.pr.Number)
+		if err != nil {
+			return prRefreshedMsg{pr: pr, reviews: reviews, comments: comments, err: err}
+		}
+
+		// Guests cannot query GraphQL, which the threads, approvals and
+		// merge requirements come from
+		if isGuest(m.prRepo) {
+			pending, _ := m.prRepo.ListPendingReviewRequests(ctx, m.owner, m.repo, m.pr.Number)
+			return prRefreshedMsg{pr: pr, reviews: reviews, comments: comments, files: files, pending: pending}
+		}
+
+		threads, err := m.prRepo.ListReviewThreads(ctx, m.owner, m.repo, m.pr.Number)
+		if err != nil {
+			return prRefreshedMsg{pr: pr, reviews: reviews, comments: comments, files: files, err: err}
+		}
+
+		// Approvals only feed the code owner summary and the waiting requests only
+		// the request ages, so the reload goes on without them
+		approvals, _ := m.prRepo.ListApprovals(ctx, m.owner, m.repo, m.pr.Number)
+		pending, _ := m.prRepo.ListPendingReviewRequests(ctx, m.owner, m.repo, m.pr.Number)
+
+		requirements, err := m.prRepo.GetMergeRequirements(ctx, m.owner, m.repo, m.pr.Number)
+		return prRefreshedMsg{pr: pr, reviews: reviews, comments: comments, threads: threads, files: files, requirements: requirements, approvals: approvals, pending: pending, err: err}
+	}
+}
+
+// applyLiveUpdate reloads the PR when someone changed it. While a reload
+// or an input is in progress the change is only reported.
+func (m *PRDetailView) applyLiveUpdate(msg LiveUpdateMsg) tea.Cmd {
+	event := msg.latestFor(m.owner, m.repo, m.pr.Number)
+	if event == nil || m.prRepo == nil {
+		return nil
+	}
+	if m.refreshing || m.IsCapturingInput() {
+		m.statusMessage = liveStatus(event) + " (R to reload)"
+		return nil
+	}
+	m.refreshing = true
+	m.liveNotice = liveStatus(event)
+	m.timeline.invalidate()
+	if m.currentTab == tabTimeline {
+		return tea.Batch(m.refresh(), m.loadViewedFiles(), m.loadTimeline(true))
+	}
+	return tea.Batch(m.refresh(), m.loadViewedFiles())
+}
+
+// submitReview submits the confirmed review
+func (m *PRDetailView) submitReview(event models.ReviewEvent, body string) tea.Cmd {
+	return func() tea.Msg {
+		if m.prRepo == nil {
+			return reviewSubmittedMsg{event: event, err: fmt.Errorf("PR repository not available")}
+		}
+
+		_, err := m.prRepo.CreateReview(
+			m.loads.writeContext(),
+			m.owner,
+			m.repo,
+			m.pr.Number,
+			&models.CreateReviewInput{Event: event, Body: body},
+		)
+		return reviewSubmittedMsg{event: event, err: err}
+	}
+}
+
+// applySizeLabel classifies the PR and replaces its size/* label
+func (m *PRDetailView) applySizeLabel() tea.Cmd {
+	return func() tea.Msg {
+		if m.prRepo == nil {
+			return sizeLabelAppliedMsg{err: fmt.Errorf("PR repository not available")}
+		}
+
+		ctx := m.loads.writeContext()
+		pr := m.pr
+		if !hasLineCounts(pr) {
+			// PRs from the list API have no diff stats; fetch them first
+			fetched, err := m.prRepo.Get(ctx, m.owner, m.repo, m.pr.Number)
+			if err != nil {
+				return sizeLabelAppliedMsg{err: err}
+			}
+			ensurePRNumber(fetched)
+			pr = fetched
+		}
+
+		size := classifyPRSize(pr.Additions, pr.Deletions)
+		labels, err := m.prRepo.SetLabels(ctx, m.owner, m.repo, m.pr.Number, labelsWithSize(pr.Labels, size))
+		return sizeLabelAppliedMsg{pr: pr, size: size, labels: labels, err: err}
+	}
+}
+
+// toggleDraft converts the PR to a draft or marks it ready for review
+func (m *PRDetailView) toggleDraft() tea.Cmd {
+	draft := !m.pr.Draft
+	return func() tea.Msg {
+		if m.prRepo == nil {
+			return draftToggledMsg{err: fmt.Errorf("PR repository not available")}
+		}
+		pr, err := m.prRepo.ConvertDraft(m.loads.writeContext(), m.owner, m.repo, m.pr.Number, draft)
+		return draftToggledMsg{pr: pr, err: err}
+	}
+}
+
+// mergePR merges the PR and reloads it so the merged state is shown everywhere
+func (m *PRDetailView) mergePR() tea.Cmd {
+	return func() tea.Msg {
+		if m.prRepo == nil {
+			return prMergedMsg{err: fmt.Errorf("PR repository not available")}
+		}
+
+		ctx := m.loads.writeContext()
+		// The method is left to the repository's default, as with MergePRUseCase
+		opts := &models.MergeOptions{SHA: m.pr.Head.SHA}
+		if err := m.prRepo.Merge(ctx, m.owner, m.repo, m.pr.Number, opts); err != nil {
+			return prMergedMsg{err: err}
+		}
+
+		pr, err := m.prRepo.Get(ctx, m.owner, m.repo, m.pr.Number)
+		if err != nil {
+			// The merge went through; reflect it locally
+			merged := *m.pr
+			merged.Merged = true
+			merged.State = models.PRStateClosed
+			pr = &merged
+		}
+		return prMergedMsg{pr: pr}
+	}
+}
+
+// SetProtectedPaths sets the path patterns whose changes need an extra merge confirmation
+func (m *PRDetailView) SetProtectedPaths(patterns models.ProtectedPaths) {
+	m.protectedPaths = patterns
+}
+
+// SetFreezeWindows sets the merge freeze windows checked before merging
+func (m *PRDetailView) SetFreezeWindows(windows models.FreezeWindows) {
+	m.freezeWindows = windows
+}
+
+// openCrossRef fetches the selected reference as a pull request, or as an
+// issue when there is no such pull request
+func (m *PRDetailView) openCrossRef() tea.Cmd {
+	ref, _ := m.refs.current()
+	if m.prRepo == nil {
+		m.statusMessage = "Cannot open " + ref.label(m.owner, m.repo) + " here"
+		return nil
+	}
+	m.statusMessage = "Opening " + ref.label(m.owner, m.repo) + "..."
+	ctx := m.loads.Context()
+	issueRepo := m.issueRepo
+	return func() tea.Msg {
+		pr, err := m.prRepo.Get(ctx, ref.Owner, ref.Repo, ref.Number)
+		if isNotFound(err) && issueRepo != nil {
+			// The pulls API has no issues; the reference may be one
+			issue, err := issueRepo.Get(ctx, ref.Owner, ref.Repo, ref.Number)
+			return crossRefLoadedMsg{ref: ref, issue: issue, err: err}
+		}
+		return crossRefLoadedMsg{ref: ref, pr: pr, err: err}
+	}
+}
+
+// refTexts returns the markdown the references are taken from, in display order
+func (m *PRDetailView) refTexts() []string {
+	texts := []string{m.pr.Body}
+	for _, comment := range m.comments {
+		texts = append(texts, comment.Body)
+	}
+	for _, thread := range m.threads {
+		for _, comment := range thread.Comments {
+			texts = append(texts, comment.Body)
+		}
+	}
+	return texts
+}
+
 // Update handles messages
 func (m *PRDetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if m.diff != nil {
+		if cmd, handled := m.updateDiff(msg); handled {
+			return m, cmd
+		}
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.reviewModal.IsVisible() {
+			if m.mergeStage != mergeStageNone {
+				return m.handleMergeModalKey(msg)
+			}
+			return m.handleReviewModalKey(msg)
+		}
+		if m.reviewers.active {
+			return m.handleReviewerPickerKey(msg)
+		}
+		if m.testRun.active {
+			return m.handleTestRunKey(msg)
+		}
 		return m.handleKeyPress(msg)
 
+	case reviewerCandidatesLoadedMsg, reviewersRequestedMsg:
+		return m, m.handleReviewerPickerMsg(msg)
+
+	case prWorktreeReadyMsg, testRunOutputMsg:
+		return m, m.handleTestRunMsg(msg)
+
+	case prMergedMsg:
+		m.merging = false
+		if msg.err != nil {
+			m.statusMessage = fmt.Sprintf("Merge failed: %v", msg.err)
+			return m, nil
+		}
+		ensurePRNumber(msg.pr)
+		msg.pr.Reviews = m.pr.Reviews
+		m.pr = msg.pr
+		m.statusMessage = fmt.Sprintf("Merged #%d", m.pr.Number)
+		return m, events.Publish(events.PullRequestChanged(events.ActionMerged, m.owner, m.repo, m.pr))
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		m.reviewModal.SetSize(msg.Width, msg.Height)
 		return m, nil
 
+	case sizeLabelAppliedMsg:
+		m.labeling = false
+		if msg.err != nil {
+			m.statusMessage = fmt.Sprintf("Size label failed: %v", msg.err)
+			return m, nil
+		}
+		if msg.pr != m.pr {
+			msg.pr.Reviews = m.pr.Reviews
+			m.pr = msg.pr
+		}
+		m.pr.Labels = msg.labels
+		m.statusMessage = fmt.Sprintf("Labeled %s", sizeLabelName(msg.size))
+		return m, events.Publish(events.PullRequestChanged(events.ActionLabeled, m.owner, m.repo, m.pr))
+
+	case draftToggledMsg:
+		m.togglingDraft = false
+		if msg.err != nil {
+			m.statusMessage = fmt.Sprintf("Draft toggle failed: %v", msg.err)
+			return m, nil
+		}
+		ensurePRNumber(msg.pr)
+		msg.pr.Reviews = m.pr.Reviews
+		m.pr = msg.pr
+		if m.pr.Draft {
+			m.statusMessage = fmt.Sprintf("Converted #%d to draft", m.pr.Number)
+		} else {
+			m.statusMessage = fmt.Sprintf("Marked #%d ready for review", m.pr.Number)
+		}
+		return m, events.Publish(events.PullRequestChanged(events.ActionUpdated, m.owner, m.repo, m.pr))
+
+	case reviewSubmittedMsg:
+		m.submitting = false
+		if msg.err != nil {
+			m.statusMessage = fmt.Sprintf("Review failed: %v", msg.err)
+			return m, nil
+		}
+		if msg.event == models.ReviewEventApprove {
+			m.statusMessage = fmt.Sprintf("Approved #%d", m.pr.Number)
+		} else {
+			m.statusMessage = fmt.Sprintf("Requested changes on #%d", m.pr.Number)
+		}
+		// Reload so the new review shows up here and in the other views
+		m.refreshing = true
+		return m, m.refresh()
+
 	case prCommentsLoadedMsg:
+		if staleLoad(msg.err) {
+			return m, nil
+		}
 		m.commentsLoading = false
 		if msg.err != nil {
 			m.commentsErr = msg.err
@@ -174,7 +677,236 @@ func (m *PRDetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case openBrowserMsg:
+		m.statusMessage = browserStatusMessage(msg)
+		return m, nil
+
+	case crossRefLoadedMsg:
+		// Opened references are handled by the hosting view; only failures get here
+		if msg.err != nil && !isCancelled(msg.err) {
+			m.statusMessage = crossRefFailedStatus(msg, m.owner, m.repo)
+		}
+		return m, nil
+
+	case LiveUpdateMsg:
+		return m, m.applyLiveUpdate(msg)
+
+	case events.EntityChanged:
+		// Another view changed this PR
+		if msg.PullRequest != nil && msg.PullRequest != m.pr && msg.Matches(m.owner, m.repo) {
+			if n, ok := prDisplayNumber(msg.PullRequest); ok && n == m.pr.Number {
+				if len(msg.PullRequest.Reviews) == 0 {
+					msg.PullRequest.Reviews = m.pr.Reviews
+				}
+				m.pr = msg.PullRequest
+			}
+		}
+		return m, nil
+
+	case timelineLoadedMsg:
+		m.timeline.update(msg)
+		return m, nil
+
+	case components.ShimmerTickMsg:
+		return m, m.full.tick()
+
+	case prFullLoadedMsg:
+		if isCancelled(msg.err) || !m.full.loading {
+			// Left to open a reference, or already replaced by a reload
+			return m, nil
+		}
+		m.full.done()
+		if msg.pr == nil {
+			// The fields the list left out stay as they came
+			m.statusMessage = fmt.Sprintf("Some details are unavailable: %v", msg.err)
+			return m, nil
+		}
+		ensurePRNumber(msg.pr)
+		if len(msg.pr.Reviews) == 0 {
+			msg.pr.Reviews = m.pr.Reviews
+		}
+		m.pr = msg.pr
+		return m, tea.Batch(
+			events.Publish(events.PullRequestChanged(events.ActionUpdated, m.owner, m.repo, msg.pr)),
+			m.pollMergeable(),
+			m.loadConflicts(false),
+		)
+
+	case mergeablePollTickMsg:
+		if !m.mergeable.active {
+			return m, nil
+		}
+		return m, m.loadMergeable()
+
+	case prMergeableLoadedMsg:
+		if isCancelled(msg.err) || !m.mergeable.active {
+			m.mergeable.stop()
+			return m, nil
+		}
+		if msg.pr == nil || mergeableUnknown(msg.pr) {
+			// Still computing, or the fetch failed; try again
+			return m, m.mergeable.next()
+		}
+		m.mergeable.stop()
+		m.pr.Mergeable = msg.pr.Mergeable
+		m.pr.MergeableState = msg.pr.MergeableState
+		return m, tea.Batch(
+			events.Publish(events.PullRequestChanged(events.ActionUpdated, m.owner, m.repo, m.pr)),
+			m.loadConflicts(false),
+		)
+
+	case prConflictsLoadedMsg:
+		m.conflicts.loading = false
+		if isCancelled(msg.err) {
+			return m, nil
+		}
+		m.conflicts.err = msg.err
+		if msg.err == nil {
+			m.conflicts.files = msg.conflicts
+			m.conflicts.headSHA = msg.headSHA
+		}
+		return m, nil
+
+	case branchUpdatedMsg:
+		m.updatingBranch = false
+		if msg.err != nil {
+			m.statusMessage = fmt.Sprintf("Update branch failed: %v", msg.err)
+			return m, nil
+		}
+		m.statusMessage = fmt.Sprintf("GitHub is merging %s into %s", formatBranchName(m.pr.Base), formatBranchName(m.pr.Head))
+		// The merge runs in the background; the reload polls the
+		// mergeability it leaves unknown
+		m.refreshing = true
+		return m, m.refresh()
+
+	case suggestionCommittedMsg:
+		m.committingSuggestion = false
+		if msg.err != nil {
+			m.statusMessage = fmt.Sprintf("Commit suggestion failed: %v", msg.err)
+			return m, nil
+		}
+		m.statusMessage = fmt.Sprintf("Committed the suggestion to %s", formatBranchName(m.pr.Head))
+		m.refreshing = true
+		return m, m.refresh()
+
+	case prRefreshedMsg:
+		m.refreshing = false
+		notice := m.liveNotice
+		m.liveNotice = ""
+		if msg.pr == nil {
+			m.statusMessage = fmt.Sprintf("Reload failed: %v", msg.err)
+			return m, nil
+		}
+		m.full.done()
+		ensurePRNumber(msg.pr)
+		if msg.reviews != nil {
+			msg.pr.Reviews = flattenReviews(msg.reviews)
+		} else {
+			msg.pr.Reviews = m.pr.Reviews
+		}
+		m.pr = msg.pr
+		if msg.comments != nil {
+			m.comments = msg.comments
+		}
+		if msg.threads != nil {
+			m.setThreads(msg.threads)
+		}
+		if msg.files != nil {
+			m.files = msg.files
+			m.filesErr = nil
+		}
+		if msg.requirements != nil {
+			m.requirements = msg.requirements
+		}
+		if msg.approvals != nil {
+			m.approvals = msg.approvals
+		}
+		if msg.pending != nil {
+			m.pendingRequests = msg.pending
+		}
+		if msg.err != nil {
+			m.statusMessage = fmt.Sprintf("Reloaded with errors: %v", msg.err)
+		} else if notice != "" {
+			m.statusMessage = notice
+		} else {
+			m.statusMessage = "Reloaded"
+		}
+		return m, tea.Batch(
+			events.Publish(events.PullRequestChanged(events.ActionUpdated, m.owner, m.repo, msg.pr)),
+			m.reloadSinceReview(),
+			m.pollMergeable(),
+			m.loadConflicts(true),
+		)
+
+	case prThreadsLoadedMsg:
+		if staleLoad(msg.err) {
+			return m, nil
+		}
+		m.threadsLoading = false
+		if msg.err != nil {
+			m.threadsErr = msg.err
+		} else {
+			m.threadsErr = nil
+			m.setThreads(msg.threads)
+		}
+		return m, nil
+
+	case prRequirementsLoadedMsg:
+		// Without protection data the status falls back to the review approximation
+		if msg.err == nil {
+			m.requirements = msg.requirements
+		}
+		return m, nil
+
+	case prViewedLoadedMsg:
+		m.viewedFiles = msg.viewed
+		return m, nil
+
+	case prFilesLoadedMsg:
+		if staleLoad(msg.err) {
+			return m, nil
+		}
+		m.filesLoading = false
+		if msg.err != nil {
+			m.filesErr = msg.err
+		} else {
+			m.filesErr = nil
+			m.files = msg.files
+		}
+		return m, nil
+
+	case prCodeOwnersLoadedMsg:
+		m.handleCodeOwnersLoaded(msg)
+		return m, nil
+
+	case prSinceReviewLoadedMsg:
+		m.handleSinceReviewLoaded(msg)
+		return m, nil
+
+	case prPendingRequestsLoadedMsg:
+		// Without the timeline the requested reviewers are shown without their wait
+		if msg.err == nil {
+			m.pendingRequests = msg.requests
+		}
+		return m, nil
+
+	case prLinkedIssuesLoadedMsg:
+		if staleLoad(msg.err) {
+			return m, nil
+		}
+		m.linkedLoading = false
+		if msg.err != nil {
+			m.linkedErr = msg.err
+		} else {
+			m.linkedErr = nil
+			m.linked = msg.issues
+		}
+		return m, nil
+
 	case prReviewsLoadedMsg:
+		if staleLoad(msg.err) {
+			return m, nil
+		}
 		m.reviewsLoading = false
 		if msg.err != nil {
 			m.reviewsErr = msg.err
@@ -246,33 +978,430 @@ func (m *PRDetailView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.scrollOffset = 0
 		return m, nil
 
+	case "5":
+		// Switch to timeline tab, fetching the events the first time
+		m.currentTab = tabTimeline
+		m.scrollOffset = 0
+		return m, m.loadTimeline(false)
+
 	case "m":
-		// Merge PR
-		return m, func() tea.Msg {
-			return mergeMsg{pr: m.pr}
-		}
+		// Merge PR after confirmation
+		return m, m.openMergeModal()
 
 	case "d":
-		// Show diff
-		return m, func() tea.Msg {
-			return diffMsg{pr: m.pr}
-		}
+		// Show the diff of the PR
+		return m, m.openDiff()
+
+	case "ctrl+t":
+		// Check the PR out into a worktree and run the test command there
+		return m, m.startTestRun()
 
 	case "o":
-		// Open in browser
-		_ = browser.Open(m.pr.HTMLURL)
+		// Open the selected image, or else the PR, in browser
+		if img, ok := m.images.current(); ok {
+			return m, openInBrowser(img.URL)
+		}
+		return m, openInBrowser(m.pr.HTMLURL)
+
+	case "i":
+		// Select the next image in the PR or its comments
+		if m.images.cycle(m.refTexts(), 1) {
+			m.statusMessage = m.images.status()
+		} else {
+			m.statusMessage = "No images"
+		}
+		return m, nil
+
+	case "I":
+		// Show the selected image in the terminal, or in the browser
+		img, ok := m.images.current()
+		if !ok {
+			m.statusMessage = "Select an image with i first"
+			return m, nil
+		}
+		m.statusMessage = "Loading " + img.placeholder() + "..."
+		return m, showImage(img)
+
+	case "a":
+		// Approve (after confirmation)
+		return m, m.openReviewModal(models.ReviewEventApprove)
+
+	case "x":
+		// Request changes (after confirmation)
+		return m, m.openReviewModal(models.ReviewEventRequestChanges)
+
+	case "r":
+		// Request reviews from users and teams, code owners first
+		return m, m.openReviewerPicker()
+
+	case "s":
+		// Toggle the Files tab between all changes and those since your last review
+		if m.currentTab == tabFiles {
+			return m, m.toggleSinceReview()
+		}
+		return m, nil
+
+	case "n":
+		// Select next review thread (linked issue on the overview tab); enter
+		// acts on it again instead of opening a reference
+		m.refs = newCrossRefCursor()
+		if m.currentTab == tabComments && m.selectedThread < len(m.threads)-1 {
+			m.selectedThread++
+		}
+		if m.currentTab == tabOverview && m.selectedLinked < len(m.linkedIssues())-1 {
+			m.selectedLinked++
+		}
+		return m, nil
+
+	case "N":
+		// Select previous review thread (linked issue on the overview tab)
+		m.refs = newCrossRefCursor()
+		if m.currentTab == tabComments && m.selectedThread > 0 {
+			m.selectedThread--
+		}
+		if m.currentTab == tabOverview && m.selectedLinked > 0 {
+			m.selectedLinked--
+		}
+		return m, nil
+
+	case "tab", "shift+tab":
+		// Select the next (previous) reference to an issue or pull request
+		delta := 1
+		if msg.String() == "shift+tab" {
+			delta = -1
+		}
+		m.images.clear()
+		m.refs.cycle(m.refTexts(), m.owner, m.repo, m.pr.Number, delta)
+		m.statusMessage = m.refs.status(m.owner, m.repo)
+		return m, nil
+
+	case "enter":
+		// Open the selected reference in place of this PR
+		if _, ok := m.refs.current(); ok {
+			return m, m.openCrossRef()
+		}
+		// Open the selected linked issue
+		if m.currentTab == tabOverview {
+			return m, m.openLinkedIssue()
+		}
+		// Review the changed files in the diff
+		if m.currentTab == tabFiles {
+			return m, m.openDiff()
+		}
+		// Expand or collapse the selected review thread
+		if m.currentTab == tabComments && m.selectedThread < len(m.threads) {
+			id := m.threads[m.selectedThread].ID
+			m.collapsed[id] = !m.collapsed[id]
+		}
+		return m, nil
+
+	case "E":
+		// Expand all threads, or collapse all when everything is expanded
+		if m.currentTab == tabComments {
+			m.toggleAllThreads()
+		}
+		return m, nil
+
+	case "L":
+		// Apply the size/* label matching the PR size
+		if m.prRepo != nil && !canWrite(m.prRepo) {
+			m.statusMessage = readOnlyStatus
+			return m, nil
+		}
+		if m.prRepo != nil && !m.labeling {
+			m.labeling = true
+			m.statusMessage = "Applying size label..."
+			return m, m.applySizeLabel()
+		}
+		return m, nil
+
+	case "D":
+		// Toggle between draft and ready for review
+		if m.prRepo != nil && !canWrite(m.prRepo) {
+			m.statusMessage = readOnlyStatus
+			return m, nil
+		}
+		if m.prRepo == nil || m.togglingDraft {
+			return m, nil
+		}
+		if m.pr.Merged || m.pr.State == models.PRStateClosed {
+			m.statusMessage = "Cannot change the draft state of a closed pull request"
+			return m, nil
+		}
+		m.togglingDraft = true
+		if m.pr.Draft {
+			m.statusMessage = "Marking ready for review..."
+		} else {
+			m.statusMessage = "Converting to draft..."
+		}
+		return m, m.toggleDraft()
+
+	case "U":
+		// Merge the base branch into the head branch
+		if m.prRepo != nil && !canWrite(m.prRepo) {
+			m.statusMessage = readOnlyStatus
+			return m, nil
+		}
+		if m.prRepo == nil || m.updatingBranch {
+			return m, nil
+		}
+		if m.pr.Merged || m.pr.State == models.PRStateClosed {
+			m.statusMessage = "Cannot update the branch of a closed pull request"
+			return m, nil
+		}
+		m.updatingBranch = true
+		m.statusMessage = fmt.Sprintf("Updating %s with %s...", formatBranchName(m.pr.Head), formatBranchName(m.pr.Base))
+		return m, m.updateBranch()
+
+	case "y":
+		// Copy the suggested change of the selected review thread
+		m.copySuggestion()
+		return m, nil
+
+	case "C":
+		// Commit the suggested change of the selected review thread to the head branch
+		if m.prRepo != nil && !canWrite(m.prRepo) {
+			m.statusMessage = readOnlyStatus
+			return m, nil
+		}
+		if m.prRepo == nil || m.committingSuggestion {
+			return m, nil
+		}
+		return m, m.commitSuggestion()
+
+	case "R":
+		// Reload the PR itself (state, labels, commits, ...) with reviews and comments
+		if m.prRepo != nil && !m.refreshing {
+			m.refreshing = true
+			m.statusMessage = "Reloading..."
+			m.timeline.invalidate()
+			if m.currentTab == tabTimeline {
+				return m, tea.Batch(m.refresh(), m.loadViewedFiles(), m.loadTimeline(true))
+			}
+			return m, tea.Batch(m.refresh(), m.loadViewedFiles())
+		}
 		return m, nil
 	}
 
 	return m, nil
 }
 
+// openReviewModal shows the confirmation modal for a review decision
+func (m *PRDetailView) openReviewModal(event models.ReviewEvent) tea.Cmd {
+	if m.prRepo == nil || m.submitting {
+		return nil
+	}
+	if !canWrite(m.prRepo) {
+		m.statusMessage = readOnlyStatus
+		return nil
+	}
+	if m.pr.Merged || m.pr.State == models.PRStateClosed {
+		m.statusMessage = "Cannot review a closed pull request"
+		return nil
+	}
+
+	m.reviewEvent = event
+	m.reviewModal.SetSize(m.width, m.height)
+	if event == models.ReviewEventApprove {
+		m.reviewModal.Show(fmt.Sprintf("Approve #%d?", m.pr.Number), "approve", m.reviewSummary(), false)
+	} else {
+		m.reviewModal.Show(fmt.Sprintf("Request changes on #%d?", m.pr.Number), "request", m.reviewSummary(), true)
+	}
+	return nil
+}
+
+// handleReviewModalKey routes input to the review confirmation modal
+func (m *PRDetailView) handleReviewModalKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.String() == "ctrl+c" {
+		return m, tea.Quit
+	}
+
+	m.reviewModal.Update(msg)
+	if !m.reviewModal.Confirmed() {
+		return m, nil
+	}
+
+	m.submitting = true
+	m.statusMessage = "Submitting review..."
+	return m, m.submitReview(m.reviewEvent, m.reviewModal.Body())
+}
+
+// openMergeModal asks for confirmation before merging the PR
+func (m *PRDetailView) openMergeModal() tea.Cmd {
+	if m.prRepo == nil || m.merging {
+		return nil
+	}
+	if !canWrite(m.prRepo) {
+		m.statusMessage = readOnlyStatus
+		return nil
+	}
+	switch {
+	case m.pr.Merged || m.pr.State == models.PRStateClosed:
+		m.statusMessage = "Cannot merge a closed pull request"
+		return nil
+	case m.pr.Draft:
+		m.statusMessage = "Cannot merge a draft pull request"
+		return nil
+	case len(m.protectedPaths) > 0 && m.filesLoading:
+		m.statusMessage = "Checking changed files against protected paths, try again shortly"
+		return nil
+	}
+
+	summary := m.reviewSummary()
+	if window, end, ok := activeFreeze(m.freezeWindows); ok && !window.Blocks() {
+		summary = append(summary, styles.WarningStyle.Render(styles.IconWarning+" "+freezeDescription(window, end)))
+	}
+
+	m.mergeStage = mergeStageConfirm
+	m.reviewModal.SetSize(m.width, m.height)
+	m.reviewModal.Show(fmt.Sprintf("Merge #%d?", m.pr.Number), "merge", summary, false)
+	return nil
+}
+
+// handleMergeModalKey routes input to the merge confirmations. PRs touching
+// protected paths need a second confirmation before they are merged, and a
+// blocking merge freeze needs an override.
+func (m *PRDetailView) handleMergeModalKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.String() == "ctrl+c" {
+		return m, tea.Quit
+	}
+
+	m.reviewModal.Update(msg)
+	if !m.reviewModal.IsVisible() && !m.reviewModal.Confirmed() {
+		m.mergeStage = mergeStageNone
+		return m, nil
+	}
+	if !m.reviewModal.Confirmed() {
+		return m, nil
+	}
+
+	if m.mergeStage == mergeStageConfirm && m.needsProtectedConfirmation() {
+		m.mergeStage = mergeStageProtected
+		m.reviewModal.Show(fmt.Sprintf("#%d touches protected paths", m.pr.Number), "protected", m.protectedSummary(), false)
+		return m, nil
+	}
+
+	if m.mergeStage != mergeStageFreeze {
+		if window, end, ok := activeFreeze(m.freezeWindows); ok && window.Blocks() {
+			m.mergeStage = mergeStageFreeze
+			m.reviewModal.Show("Merge freeze in effect", "override", []string{
+				styles.ErrorStyle.Render(styles.IconFreeze + " " + freezeDescription(window, end)),
+				fmt.Sprintf("Type override to merge #%d anyway", m.pr.Number),
+			}, false)
+			return m, nil
+		}
+	}
+
+	m.mergeStage = mergeStageNone
+	m.merging = true
+	m.statusMessage = "Merging..."
+	return m, m.mergePR()
+}
+
+// needsProtectedConfirmation reports whether merging needs the protected paths
+// confirmation. Files that failed to load cannot be cleared, so they need it too.
+func (m *PRDetailView) needsProtectedConfirmation() bool {
+	if len(m.protectedPaths) == 0 {
+		return false
+	}
+	return m.filesErr != nil || len(m.protectedFiles()) > 0
+}
+
+// protectedFiles returns the changed files matching a protected path
+func (m *PRDetailView) protectedFiles() []string {
+	var matched []string
+	for _, file := range m.files {
+		if file == nil {
+			continue
+		}
+		if _, ok := m.protectedPaths.Match(file.Filename); ok {
+			matched = append(matched, file.Filename)
+		}
+	}
+	return matched
+}
+
+// protectedSummary lists the protected files the merge would change
+func (m *PRDetailView) protectedSummary() []string {
+	if m.filesErr != nil {
+		return []string{
+			styles.WarningStyle.Render("Changed files could not be checked against protected paths"),
+			"Protected paths: " + strings.Join(m.protectedPaths, ", "),
+		}
+	}
+
+	const maxListed = 8
+	files := m.protectedFiles()
+	lines := []string{styles.WarningStyle.Render(fmt.Sprintf("%s %d protected file(s) changed:", styles.IconWarning, len(files)))}
+	for i, file := range files {
+		if i == maxListed {
+			lines = append(lines, fmt.Sprintf("  ... and %d more", len(files)-maxListed))
+			break
+		}
+		lines = append(lines, "  "+file)
+	}
+	return lines
+}
+
+// reviewSummary describes what is being reviewed so the decision is made knowingly
+func (m *PRDetailView) reviewSummary() []string {
+	return []string{
+		styles.BoldStyle.Render(fmt.Sprintf("#%d %s", m.pr.Number, m.pr.Title)),
+		"Files changed: " + m.full.value(fmt.Sprintf("%d (+%d -%d), %d commits",
+			m.pr.ChangedFiles, m.pr.Additions, m.pr.Deletions, m.pr.Commits), 16),
+		"Checks: " + m.full.value(checksStateLabel(m.pr.MergeableState), 8),
+		"Reviews: " + m.getReviewsSummary(),
+	}
+}
+
+// checksStateLabel describes a PR's mergeable state in terms of its checks
+func checksStateLabel(state string) string {
+	switch state {
+	case "clean":
+		return "passing"
+	case "unstable":
+		return "failing or pending"
+	case "blocked":
+		return "blocked by required checks or reviews"
+	case "dirty":
+		return "merge conflicts"
+	case "behind":
+		return "head branch is behind base"
+	case "draft":
+		return "draft"
+	default:
+		return "unknown"
+	}
+}
+
+// IsCapturingInput returns true while the review modal, the reviewer
+// selection, the checkout-and-test pane or the diff is taking input
+func (m *PRDetailView) IsCapturingInput() bool {
+	return m.reviewModal.IsVisible() || m.reviewers.active || m.testRun.active || m.diff != nil
+}
+
 // View renders the PR detail view
 func (m *PRDetailView) View() string {
 	if m.width == 0 || m.height == 0 {
 		return "Initializing..."
 	}
 
+	if m.diff != nil {
+		return m.diff.View()
+	}
+
+	if m.reviewModal.IsVisible() {
+		return m.reviewModal.View()
+	}
+
+	if m.reviewers.active {
+		return m.renderReviewerPicker()
+	}
+
+	if m.testRun.active {
+		return m.renderTestRun()
+	}
+
 	if m.loading {
 		return m.renderLoading()
 	}
@@ -313,7 +1442,11 @@ func (m *PRDetailView) View() string {
 func (m *PRDetailView) renderHeader() string {
 	// PR number and state
 	numberStyle := styles.IssueNumberStyle
-	number := numberStyle.Render(formatPRTitle(m.pr))
+	numberText := formatPRTitle(m.pr)
+	if m.showRepo {
+		numberText = m.owner + "/" + m.repo + " " + numberText
+	}
+	number := numberStyle.Render(numberText)
 
 	stateBadge := styles.GetStateBadge(string(m.pr.State))
 
@@ -342,12 +1475,18 @@ func (m *PRDetailView) renderHeader() string {
 	title := titleStyle.Render(m.pr.Title)
 
 	headerParts := []string{number, " ", stateBadge}
+	if size, ok := prSizeOf(m.pr); ok {
+		headerParts = append(headerParts, " ", renderPRSizeBadge(size))
+	}
 	if draftBadge != "" {
 		headerParts = append(headerParts, " ", draftBadge)
 	}
 	if mergedBadge != "" {
 		headerParts = append(headerParts, " ", mergedBadge)
 	}
+	if progress := m.renderReviewProgress(); progress != "" {
+		headerParts = append(headerParts, "  ", progress)
+	}
 
 	headerLine := lipgloss.JoinHorizontal(lipgloss.Top, headerParts...)
 
@@ -379,7 +1518,7 @@ func (m *PRDetailView) renderMetadata() string {
 
 	// Base and Head branches
 	branchLabel := styles.MutedStyle.Render("Base:")
-	branchValue := styles.NormalStyle.Render(formatBranchName(m.pr.Base) + " ← " + formatBranchName(m.pr.Head))
+	branchValue := styles.NormalStyle.Render(formatBranchName(m.pr.Base) + " " + styles.IconMergeInto + " " + formatBranchName(m.pr.Head))
 	parts = append(parts, lipgloss.JoinHorizontal(lipgloss.Top, branchLabel, " ", branchValue))
 
 	// Status
@@ -387,6 +1526,15 @@ func (m *PRDetailView) renderMetadata() string {
 	statusValue := m.getMergeStatus()
 	parts = append(parts, lipgloss.JoinHorizontal(lipgloss.Top, statusLabel, " ", statusValue))
 
+	// Protected paths touched by the PR
+	if len(m.protectedPaths) > 0 {
+		if touched := m.protectedPaths.Touched(diffFileNames(m.files)); len(touched) > 0 {
+			protectedLabel := styles.MutedStyle.Render("Protected:")
+			protectedValue := styles.WarningStyle.Render(styles.IconWarning + " " + strings.Join(touched, ", "))
+			parts = append(parts, lipgloss.JoinHorizontal(lipgloss.Top, protectedLabel, " ", protectedValue))
+		}
+	}
+
 	// Created date
 	createdLabel := styles.MutedStyle.Render("Created:")
 	createdValue := styles.DateStyle.Render(formatTime(m.pr.CreatedAt))
@@ -410,6 +1558,13 @@ func (m *PRDetailView) renderMetadata() string {
 	}
 	parts = append(parts, lipgloss.JoinHorizontal(lipgloss.Top, reviewsLabel, " ", reviewsValue))
 
+	// Pending review requests
+	if len(m.pr.RequestedReviewers) > 0 || len(m.pr.RequestedTeams) > 0 {
+		requestedLabel := styles.MutedStyle.Render("Requested:")
+		requestedValue := renderRequestedReviewers(m.pr, m.pendingRequests, clock.Now())
+		parts = append(parts, lipgloss.JoinHorizontal(lipgloss.Top, requestedLabel, " ", requestedValue))
+	}
+
 	// Assignees
 	assigneesLabel := styles.MutedStyle.Render("Assignees:")
 	if len(m.pr.Assignees) > 0 {
@@ -450,8 +1605,28 @@ func (m *PRDetailView) renderMetadata() string {
 func (m *PRDetailView) getMergeStatus() string {
 	if m.pr.Merged {
 		return lipgloss.NewStyle().
-			Foreground(lipgloss.Color("35")).
-			Render("✓ Merged")
+			Foreground(styles.ColorPositive).
+			Render(styles.IconCheck + " Merged")
+	}
+
+	// Mergeability is only known once the complete PR is loaded
+	if m.full.loading {
+		return m.full.value("", 12)
+	}
+
+	// GitHub computes mergeability in the background; false until then does
+	// not mean conflicts
+	if mergeableUnknown(m.pr) {
+		if m.mergeable.active {
+			return lipgloss.NewStyle().
+				Foreground(styles.ColorPending).
+				Render(styles.IconWaiting + " Checking mergeability")
+		}
+		return styles.MutedStyle.Render("? Mergeability unknown (R to reload)")
+	}
+
+	if m.pr.Mergeable && m.requirements != nil {
+		return renderMergeBlockers(mergeBlockers(m.pr, m.requirements))
 	}
 
 	if m.pr.Mergeable {
@@ -467,24 +1642,24 @@ func (m *PRDetailView) getMergeStatus() string {
 
 		if changesRequestedCount > 0 {
 			return lipgloss.NewStyle().
-				Foreground(lipgloss.Color("196")).
-				Render("✗ Changes requested")
+				Foreground(styles.ColorNegative).
+				Render(styles.IconCross + " Changes requested")
 		}
 
 		if approvedCount >= 2 {
 			return lipgloss.NewStyle().
-				Foreground(lipgloss.Color("35")).
-				Render("✓✓ Ready to merge")
+				Foreground(styles.ColorPositive).
+				Render(styles.IconCheck + styles.IconCheck + " Ready to merge")
 		}
 
 		return lipgloss.NewStyle().
-			Foreground(lipgloss.Color("220")).
-			Render("⋯ Awaiting review")
+			Foreground(styles.ColorPending).
+			Render(styles.IconWaiting + " Awaiting review")
 	}
 
 	return lipgloss.NewStyle().
-		Foreground(lipgloss.Color("196")).
-		Render("✗ Conflicts")
+		Foreground(styles.ColorNegative).
+		Render(styles.IconCross + " Conflicts")
 }
 
 // getReviewsSummary returns a summary of reviews
@@ -502,6 +1677,7 @@ func (m *PRDetailView) renderTabNavigation() string {
 		{"2: Files", tabFiles},
 		{"3: Commits", tabCommits},
 		{"4: Comments", tabComments},
+		{"5: Timeline", tabTimeline},
 	}
 
 	var tabStrings []string
@@ -535,6 +1711,8 @@ func (m *PRDetailView) renderTabContent() string {
 		return m.renderCommitsTab()
 	case tabComments:
 		return m.renderCommentsTab()
+	case tabTimeline:
+		return m.renderTimelineTab()
 	default:
 		return ""
 	}
@@ -553,6 +1731,18 @@ func (m *PRDetailView) renderOverviewTab() string {
 
 	s.WriteString("\n\n")
 
+	// Issues the PR closes
+	if linked := m.renderLinkedIssues(); linked != "" {
+		s.WriteString(linked)
+		s.WriteString("\n")
+	}
+
+	// Files the merge conflicts can be in
+	if conflicts := m.renderConflicts(); conflicts != "" {
+		s.WriteString(conflicts)
+		s.WriteString("\n")
+	}
+
 	// Stats
 	s.WriteString(m.renderStats())
 
@@ -566,7 +1756,7 @@ func (m *PRDetailView) renderBody() string {
 	}
 
 	// Render markdown
-	rendered, err := m.renderer.Render(m.pr.Body)
+	rendered, err := m.renderer.Render(withImagePlaceholders(m.pr.Body))
 	if err != nil {
 		// Fallback to plain text if rendering fails
 		return m.pr.Body
@@ -581,28 +1771,28 @@ func (m *PRDetailView) renderStats() string {
 
 	// Files changed
 	filesLabel := styles.MutedStyle.Render("Files Changed:")
-	filesValue := styles.NormalStyle.Render(fmt.Sprintf("%d", m.pr.ChangedFiles))
+	filesValue := m.full.value(styles.NormalStyle.Render(fmt.Sprintf("%d", m.pr.ChangedFiles)), 3)
 	parts = append(parts, lipgloss.JoinHorizontal(lipgloss.Top, filesLabel, " ", filesValue))
 
 	// Additions and deletions
 	changesLabel := styles.MutedStyle.Render("Changes:")
 	additions := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("35")).
+		Foreground(styles.ColorPositive).
 		Render(fmt.Sprintf("+%d", m.pr.Additions))
 	deletions := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("196")).
+		Foreground(styles.ColorNegative).
 		Render(fmt.Sprintf("-%d", m.pr.Deletions))
-	changesValue := lipgloss.JoinHorizontal(lipgloss.Top, additions, " ", deletions)
+	changesValue := m.full.value(lipgloss.JoinHorizontal(lipgloss.Top, additions, " ", deletions), 9)
 	parts = append(parts, lipgloss.JoinHorizontal(lipgloss.Top, changesLabel, " ", changesValue))
 
 	// Commits
 	commitsLabel := styles.MutedStyle.Render("Commits:")
-	commitsValue := styles.NormalStyle.Render(fmt.Sprintf("%d", m.pr.Commits))
+	commitsValue := m.full.value(styles.NormalStyle.Render(fmt.Sprintf("%d", m.pr.Commits)), 3)
 	parts = append(parts, lipgloss.JoinHorizontal(lipgloss.Top, commitsLabel, " ", commitsValue))
 
 	// Comments
 	commentsLabel := styles.MutedStyle.Render("Comments:")
-	commentsValue := styles.NormalStyle.Render(fmt.Sprintf("%d", m.pr.Comments))
+	commentsValue := m.full.value(styles.NormalStyle.Render(fmt.Sprintf("%d", m.pr.Comments)), 3)
 	parts = append(parts, lipgloss.JoinHorizontal(lipgloss.Top, commentsLabel, " ", commentsValue))
 
 	return lipgloss.JoinVertical(lipgloss.Left, parts...)
@@ -610,16 +1800,45 @@ func (m *PRDetailView) renderStats() string {
 
 // renderFilesTab renders the files tab
 func (m *PRDetailView) renderFilesTab() string {
-	content := fmt.Sprintf("Files Changed (%d)\n\n", m.pr.ChangedFiles)
-	content += styles.MutedStyle.Render("File diff view will be implemented here.\n")
-	content += styles.MutedStyle.Render(fmt.Sprintf("+%d -%d lines changed", m.pr.Additions, m.pr.Deletions))
+	if m.sinceReview.active {
+		return m.applyScroll(m.renderSinceReview())
+	}
 
-	return m.applyScroll(content)
+	var s strings.Builder
+
+	changed := m.pr.ChangedFiles
+	if changed == 0 {
+		changed = len(m.files)
+	}
+	s.WriteString(fmt.Sprintf("Files Changed (%d)\n\n", changed))
+
+	// Per-directory rollup to help decide where to start reviewing
+	s.WriteString(styles.BoldStyle.Render("By directory"))
+	s.WriteString("\n")
+	if m.filesLoading {
+		s.WriteString(styles.MutedStyle.Render("Loading files..."))
+	} else if m.filesErr != nil {
+		s.WriteString(styles.ErrorStyle.Render(fmt.Sprintf("Failed to load files: %v", m.filesErr)))
+	} else if len(m.files) == 0 {
+		s.WriteString(styles.MutedStyle.Render("No file changes."))
+	} else {
+		s.WriteString(renderDirectoryRollup(rollupByDirectory(m.files)))
+	}
+	s.WriteString("\n\n")
+
+	if owners := m.renderCodeOwners(); owners != "" {
+		s.WriteString(owners)
+		s.WriteString("\n\n")
+	}
+
+	s.WriteString(m.full.value(styles.MutedStyle.Render(fmt.Sprintf("+%d -%d lines changed", m.pr.Additions, m.pr.Deletions)), 9))
+
+	return m.applyScroll(s.String())
 }
 
 // renderCommitsTab renders the commits tab
 func (m *PRDetailView) renderCommitsTab() string {
-	content := fmt.Sprintf("Commits (%d)\n\n", m.pr.Commits)
+	content := "Commits (" + m.full.value(fmt.Sprintf("%d", m.pr.Commits), 2) + ")\n\n"
 	content += styles.MutedStyle.Render("Commit list will be implemented here.")
 
 	return m.applyScroll(content)
@@ -629,7 +1848,9 @@ func (m *PRDetailView) renderCommitsTab() string {
 func (m *PRDetailView) renderCommentsTab() string {
 	var s strings.Builder
 
-	s.WriteString(fmt.Sprintf("Comments (%d)\n\n", len(m.comments)))
+	// Issue comments (the PR conversation)
+	s.WriteString(styles.BoldStyle.Render(fmt.Sprintf("Conversation (%d)", len(m.comments))))
+	s.WriteString("\n\n")
 
 	if m.commentsLoading {
 		s.WriteString(styles.MutedStyle.Render("Loading comments..."))
@@ -641,9 +1862,25 @@ func (m *PRDetailView) renderCommentsTab() string {
 		s.WriteString(m.renderCommentsList())
 	}
 
+	// Review comments, grouped into threads
+	if m.prRepo != nil {
+		s.WriteString("\n\n")
+		s.WriteString(styles.Separator(m.width - 4))
+		s.WriteString("\n\n")
+		s.WriteString(m.renderReviewThreads())
+	}
+
 	return m.applyScroll(s.String())
 }
 
+// renderTimelineTab renders the PR's events interleaved with its conversation
+func (m *PRDetailView) renderTimelineTab() string {
+	if m.issueRepo == nil {
+		return styles.MutedStyle.Render("The timeline is not available here.")
+	}
+	return m.applyScroll(renderTimeline(&m.timeline, m.comments, m.renderer, m.owner, m.repo))
+}
+
 // renderCommentsList renders the list of comments
 func (m *PRDetailView) renderCommentsList() string {
 	var s strings.Builder
@@ -665,7 +1902,7 @@ func (m *PRDetailView) renderCommentsList() string {
 
 		// Comment body (with markdown rendering)
 		if m.renderer != nil && comment.Body != "" {
-			rendered, err := m.renderer.Render(comment.Body)
+			rendered, err := m.renderer.Render(withImagePlaceholders(comment.Body))
 			if err == nil {
 				s.WriteString(rendered)
 			} else {
@@ -725,14 +1962,66 @@ func (m *PRDetailView) applyScroll(content string) string {
 func (m *PRDetailView) renderFooter() string {
 	helpItems := []string{
 		styles.FormatKeyBinding("j/k", "scroll"),
-		styles.FormatKeyBinding("1-4", "tabs"),
-		styles.FormatKeyBinding("m", "merge"),
+		styles.FormatKeyBinding("1-5", "tabs"),
+	}
+	helpItems = append(helpItems, styles.FormatKeyBinding("tab/enter", "follow reference"))
+	if hasMarkdownImages(m.refTexts()) {
+		helpItems = append(helpItems, styles.FormatKeyBinding("i/I", "select/show image"))
+	}
+	if m.currentTab == tabOverview && len(m.linkedIssues()) > 0 {
+		helpItems = append(helpItems, styles.FormatKeyBinding("n/N", "linked issue"))
+	}
+	if m.currentTab == tabComments && len(m.threads) > 0 {
+		helpItems = append(helpItems,
+			styles.FormatKeyBinding("n/N", "thread"),
+			styles.FormatKeyBinding("enter", "expand"),
+			styles.FormatKeyBinding("E", "all"),
+			styles.FormatKeyBinding("y", "copy suggestion"),
+		)
+		if canWrite(m.prRepo) {
+			helpItems = append(helpItems, styles.FormatKeyBinding("C", "commit suggestion"))
+		}
+	}
+	if m.currentTab == tabFiles {
+		helpItems = append(helpItems, styles.FormatKeyBinding("enter", "review diff"))
+		if m.sinceReview.active {
+			helpItems = append(helpItems, styles.FormatKeyBinding("s", "all changes"))
+		} else {
+			helpItems = append(helpItems, styles.FormatKeyBinding("s", "since my review"))
+		}
+	}
+	if canWrite(m.prRepo) {
+		helpItems = append(helpItems,
+			styles.FormatKeyBinding("m", "merge"),
+			styles.FormatKeyBinding("a", "approve"),
+			styles.FormatKeyBinding("x", "request changes"),
+			styles.FormatKeyBinding("r", "reviewers"),
+			styles.FormatKeyBinding("L", "size label"),
+			styles.FormatKeyBinding("D", m.draftHelp()),
+			styles.FormatKeyBinding("U", "update branch"),
+		)
+	}
+	helpItems = append(helpItems,
 		styles.FormatKeyBinding("d", "diff"),
+		styles.FormatKeyBinding("ctrl+t", "check out & test"),
 		styles.FormatKeyBinding("o", "open"),
+		styles.FormatKeyBinding("R", "reload"),
 		styles.FormatKeyBinding("q", "back"),
+	)
+
+	footer := styles.HelpStyle.Render(strings.Join(helpItems, " • "))
+	if m.statusMessage != "" {
+		return styles.MutedStyle.Render(m.statusMessage) + "\n" + footer
 	}
+	return footer
+}
 
-	return styles.HelpStyle.Render(strings.Join(helpItems, " • "))
+// draftHelp describes what the D key does for the current PR
+func (m *PRDetailView) draftHelp() string {
+	if m.pr.Draft {
+		return "ready for review"
+	}
+	return "to draft"
 }
 
 // renderLoading renders a loading state
@@ -1,6 +1,7 @@
 package views
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -8,6 +9,8 @@ import (
 	"time"
 
 	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/infra/clock"
+	"github.com/a1yama/tig-gh/internal/infra/readonly"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -473,6 +476,27 @@ func TestPRDetailView_renderMetadata_ShowsNumberRow(t *testing.T) {
 	}
 }
 
+func TestPRDetailView_renderMetadata_ShowsReviewRequestAge(t *testing.T) {
+	now := time.Date(2024, time.May, 10, 12, 0, 0, 0, time.UTC)
+	t.Cleanup(clock.Set(clock.Fixed(now)))
+
+	pr := createTestPullRequest()
+	pr.RequestedReviewers = []models.User{{Login: "bob"}, {Login: "carol"}}
+	repo := &testPRRepo{pr: pr, pending: []*models.PendingReviewRequest{
+		{Reviewer: "Bob", RequestedAt: now.Add(-5 * time.Hour)},
+	}}
+	view := NewPRDetailView(pr, "owner", "repo", repo)
+	view.Update(view.loadPendingRequests()())
+
+	meta := view.renderMetadata()
+	if !containsString(meta, "@bob 5h") {
+		t.Fatalf("expected bob's request age, got %q", meta)
+	}
+	if !containsString(meta, "@carol") {
+		t.Fatalf("expected carol without an age, got %q", meta)
+	}
+}
+
 // NOTE: TestPRDetailView_OpenInBrowser has been removed to prevent
 // browser windows from opening during test runs. The 'o' key functionality
 // should be tested in integration/E2E tests instead.
@@ -790,3 +814,253 @@ func createTestPullRequest() *models.PullRequest {
 		UpdatedAt:    now.Add(-2 * time.Hour),
 	}
 }
+
+func typeText(view *PRDetailView, text string) {
+	for _, r := range text {
+		view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+}
+
+func TestPRDetailView_ApproveRequiresConfirmationWord(t *testing.T) {
+	pr := createTestPullRequest()
+	repo := &testPRRepo{pr: pr}
+	view := NewPRDetailView(pr, "owner", "repo", repo)
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	if !view.IsCapturingInput() {
+		t.Fatal("expected approve to open the confirmation modal")
+	}
+	if out := view.View(); !strings.Contains(out, "Files changed") || !strings.Contains(out, "Checks:") {
+		t.Fatalf("expected review summary in modal, got %q", out)
+	}
+
+	// A stray Enter does not submit
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil || repo.review != nil {
+		t.Fatal("expected review not to be submitted without confirmation")
+	}
+
+	typeText(view, "approve")
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected submit command after confirmation")
+	}
+	msg := cmd()
+	if repo.review == nil || repo.review.Event != models.ReviewEventApprove {
+		t.Fatalf("expected approve review to be submitted, got %+v", repo.review)
+	}
+
+	view.Update(msg)
+	if view.statusMessage != fmt.Sprintf("Approved #%d", pr.Number) {
+		t.Errorf("unexpected status message %q", view.statusMessage)
+	}
+}
+
+func TestPRDetailView_RequestChangesNeedsMessage(t *testing.T) {
+	pr := createTestPullRequest()
+	repo := &testPRRepo{pr: pr}
+	view := NewPRDetailView(pr, "owner", "repo", repo)
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	view.Update(tea.KeyMsg{Type: tea.KeyTab})
+	typeText(view, "request")
+	if _, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil {
+		t.Fatal("expected request changes without a message to be rejected")
+	}
+
+	// Focus moves back to the message; the typed confirmation word is kept
+	typeText(view, "Please add tests")
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected submit command")
+	}
+	cmd()
+	if repo.review == nil || repo.review.Event != models.ReviewEventRequestChanges || repo.review.Body != "Please add tests" {
+		t.Fatalf("unexpected review %+v", repo.review)
+	}
+}
+
+func TestPRDetailView_ReviewModalEscCancels(t *testing.T) {
+	pr := createTestPullRequest()
+	view := NewPRDetailView(pr, "owner", "repo", &testPRRepo{pr: pr})
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd != nil {
+		t.Fatal("expected esc to only close the modal")
+	}
+	if view.IsCapturingInput() {
+		t.Error("expected modal to be hidden")
+	}
+}
+
+func TestPRDetailView_GuestModeDisablesWrites(t *testing.T) {
+	pr := createTestPullRequest()
+	base := &testPRRepo{pr: pr}
+	view := NewPRDetailView(pr, "owner", "repo", readonly.NewPullRequestRepository(base))
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+
+	for _, key := range []string{"a", "x", "m", "L", "D"} {
+		_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		if cmd != nil || view.IsCapturingInput() {
+			t.Errorf("%s: expected write action to be disabled", key)
+		}
+		if view.statusMessage != readOnlyStatus {
+			t.Errorf("%s: expected read-only status, got %q", key, view.statusMessage)
+		}
+	}
+	if strings.Contains(view.renderFooter(), "approve") {
+		t.Error("expected write actions to be hidden from the footer")
+	}
+}
+
+// graphQLDeniedRepo fails the GraphQL queries as GitHub does without a token
+type graphQLDeniedRepo struct {
+	*testPRRepo
+	queries int
+}
+
+func (r *graphQLDeniedRepo) ListReviewThreads(ctx context.Context, owner, repo string, number int) ([]*models.ReviewThread, error) {
+	r.queries++
+	return nil, errors.New("401 Unauthorized")
+}
+
+func (r *graphQLDeniedRepo) GetMergeRequirements(ctx context.Context, owner, repo string, number int) (*models.MergeRequirements, error) {
+	r.queries++
+	return nil, errors.New("401 Unauthorized")
+}
+
+func (r *graphQLDeniedRepo) ListApprovals(ctx context.Context, owner, repo string, number int) (*models.Approvals, error) {
+	r.queries++
+	return nil, errors.New("401 Unauthorized")
+}
+
+func TestPRDetailView_GuestModeSkipsGraphQL(t *testing.T) {
+	pr := createTestPullRequest()
+	base := &graphQLDeniedRepo{testPRRepo: &testPRRepo{pr: pr}}
+	view := NewPRDetailView(pr, "owner", "repo", readonly.NewPullRequestRepository(base))
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+
+	if view.threadsLoading || view.linkedLoading {
+		t.Error("expected guests not to load review threads or linked issues")
+	}
+	view.currentTab = tabComments
+	if out := view.View(); strings.Contains(out, "Failed to load review threads") || !strings.Contains(out, "Sign in (set GITHUB_TOKEN) to see review threads") {
+		t.Errorf("expected a sign-in hint in place of the threads\n%s", out)
+	}
+
+	view.Update(view.refresh()())
+	view.Update(view.loadCodeOwners()())
+	if base.queries != 0 {
+		t.Errorf("expected no GraphQL queries from a guest, got %d", base.queries)
+	}
+	if view.statusMessage != "Reloaded" {
+		t.Errorf("expected the reload to succeed, got %q", view.statusMessage)
+	}
+}
+
+func TestPRDetailView_ToggleDraft(t *testing.T) {
+	pr := createTestPullRequest()
+	pr.Draft = true
+	repo := &testPRRepo{pr: pr}
+	view := NewPRDetailView(pr, "owner", "repo", repo)
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'D'}})
+	if cmd == nil {
+		t.Fatal("expected draft toggle command")
+	}
+	_, publish := view.Update(cmd())
+
+	if repo.draft == nil || *repo.draft {
+		t.Fatalf("expected PR to be marked ready for review, got %v", repo.draft)
+	}
+	if view.pr.Draft {
+		t.Error("expected detail view to drop the draft badge")
+	}
+	if view.statusMessage != "Marked #456 ready for review" {
+		t.Errorf("unexpected status %q", view.statusMessage)
+	}
+	if publish == nil {
+		t.Fatal("expected draft change to be published")
+	}
+
+	// The list view picks up the change from the published event
+	list := NewPRViewWithUseCase(nil, "owner", "repo")
+	list.prs = []*models.PullRequest{pr}
+	list.Update(publish())
+	if list.prs[0].Draft {
+		t.Error("expected list view to drop the draft badge")
+	}
+}
+
+func TestPRDetailView_ToggleDraftClosed(t *testing.T) {
+	pr := createTestPullRequest()
+	pr.State = models.PRStateClosed
+	view := NewPRDetailView(pr, "owner", "repo", &testPRRepo{pr: pr})
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'D'}})
+	if cmd != nil {
+		t.Fatal("expected closed PRs to be left alone")
+	}
+}
+
+func TestPRDetailView_SinceLastReview(t *testing.T) {
+	pr := createTestPullRequest()
+	repo := &testPRRepo{pr: pr, changes: &models.ChangesSinceReview{
+		ReviewedSHA: "aaaaaaa1111",
+		ReviewedAt:  time.Now().Add(-2 * time.Hour),
+		HeadSHA:     "bbbbbbb2222",
+		Commits:     []*models.Commit{{SHA: "ccccccc3333", Message: "Address review comments\n\ndetails"}},
+		Files: []*models.DiffFile{{
+			Filename:  "main.go",
+			Status:    models.FileStatusModified,
+			Additions: 1,
+			Deletions: 1,
+			Patch:     "@@ -1 +1 @@\n-old line\n+new line",
+		}},
+	}}
+	view := NewPRDetailView(pr, "owner", "repo", repo)
+	view.width, view.height = 120, 60
+
+	if cmd := press(view, "s"); cmd != nil {
+		t.Fatal("expected s to do nothing outside the Files tab")
+	}
+	press(view, "2")
+	cmd := press(view, "s")
+	if cmd == nil || !view.sinceReview.active {
+		t.Fatal("expected s to turn on the since-review mode and load the changes")
+	}
+	view.Update(cmd())
+
+	content := view.renderFilesTab()
+	for _, want := range []string{"aaaaaaa..bbbbbbb", "Address review comments", "main.go", "+new line", "-old line"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("expected %q in since-review diff:\n%s", want, content)
+		}
+	}
+	if strings.Contains(content, "details") {
+		t.Error("expected only the first line of commit messages")
+	}
+
+	// Toggling back shows all changes without reloading
+	if cmd := press(view, "s"); cmd != nil || view.sinceReview.active {
+		t.Fatal("expected s to return to all changes")
+	}
+	if !strings.Contains(view.renderFilesTab(), "By directory") {
+		t.Error("expected the full Files tab after toggling back")
+	}
+}
+
+func TestPRDetailView_SinceLastReviewNotReviewed(t *testing.T) {
+	pr := createTestPullRequest()
+	view := NewPRDetailView(pr, "owner", "repo", &testPRRepo{pr: pr})
+	view.currentTab = tabFiles
+
+	view.Update(press(view, "s")())
+	if content := view.renderFilesTab(); !strings.Contains(content, "You have not reviewed this pull request yet") {
+		t.Errorf("expected the not-reviewed message, got:\n%s", content)
+	}
+}
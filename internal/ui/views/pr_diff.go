@@ -0,0 +1,67 @@
+package views
+
+import (
+	"context"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// prDiffPages fetches the diff of a PR page by page from the PR repository
+type prDiffPages struct {
+	repo repository.PullRequestRepository
+}
+
+// Execute fetches one page of the files changed by the PR
+func (p prDiffPages) Execute(ctx context.Context, owner, repo string, number, page, perPage int) ([]*models.DiffFile, error) {
+	return p.repo.ListFilesPage(ctx, owner, repo, number, page, perPage)
+}
+
+// SetCommitRepository sets the repository the diff reads whole files from,
+// to expand context, build structural diffs and compare images
+func (m *PRDetailView) SetCommitRepository(repo repository.CommitRepository) {
+	m.commitRepo = repo
+}
+
+// openDiff shows the diff of the PR in place of the detail view
+func (m *PRDetailView) openDiff() tea.Cmd {
+	if m.prRepo == nil {
+		m.statusMessage = "Diff not available"
+		return nil
+	}
+	m.diff = NewDiffViewWithUseCase(prDiffPages{repo: m.prRepo}, m.owner, m.repo, m.pr.Number)
+	m.diff.SetHeadSHA(m.pr.Head.SHA)
+	m.diff.SetPRURL(m.pr.HTMLURL)
+	if m.commitRepo != nil {
+		m.diff.SetContentFetcher(m.commitRepo, m.pr.Base.SHA, m.pr.Head.SHA)
+	}
+	m.diff.Update(tea.WindowSizeMsg{Width: m.width, Height: m.height})
+	return m.diff.Init()
+}
+
+// closeDiff returns from the diff, reading the files marked as viewed in it
+func (m *PRDetailView) closeDiff() tea.Cmd {
+	m.diff.Close()
+	m.diff = nil
+	return m.loadViewedFiles()
+}
+
+// updateDiff passes keys and the diff's own messages to the open diff; it
+// reports false for the messages the detail view handles itself
+func (m *PRDetailView) updateDiff(msg tea.Msg) (tea.Cmd, bool) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if key := msg.String(); key == "q" || key == "esc" {
+			return m.closeDiff(), true
+		}
+	case tea.WindowSizeMsg:
+		m.diff.Update(msg)
+		return nil, false
+	case diffLoadedMsg, viewedSavedMsg, fileContentLoadedMsg, structuralDiffLoadedMsg, imageInfoLoadedMsg, openBrowserMsg:
+	default:
+		return nil, false
+	}
+	_, cmd := m.diff.Update(msg)
+	return cmd, true
+}
@@ -0,0 +1,146 @@
+package views
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// diffDetailView returns a sized PR detail view whose PR changes main.go
+func diffDetailView(repo *testPRRepo) *PRDetailView {
+	pr := createTestPullRequest()
+	pr.Head.SHA = "head123"
+	pr.Base.SHA = "base123"
+	view := NewPRDetailView(pr, "owner", "repo", repo)
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	return view
+}
+
+func TestPRDetailView_OpensDiff(t *testing.T) {
+	repo := &testPRRepo{files: []*models.DiffFile{
+		{Filename: "main.go", Status: models.FileStatusModified, Changes: 1, Patch: "@@ -1 +1 @@\n-old\n+new"},
+	}}
+	view := diffDetailView(repo)
+	view.currentTab = tabFiles
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if view.diff == nil || cmd == nil {
+		t.Fatal("expected enter on the Files tab to open the diff")
+	}
+	if !view.IsCapturingInput() {
+		t.Error("expected the diff to take the keys")
+	}
+	view.Update(cmd())
+	if output := view.View(); !strings.Contains(output, "main.go") || !strings.Contains(output, "+new") {
+		t.Fatalf("expected the diff of main.go, got:\n%s", output)
+	}
+
+	// q returns to the PR instead of leaving it
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	if view.diff != nil {
+		t.Fatal("expected q to close the diff")
+	}
+	if cmd != nil {
+		if _, ok := cmd().(backMsg); ok {
+			t.Error("expected closing the diff to stay on the PR")
+		}
+	}
+}
+
+// contentCommitRepo serves the files given as "ref:path", and any other file
+// as content, recording the refs asked for. Compare reports mergeBase.
+type contentCommitRepo struct {
+	repository.CommitRepository
+	files     map[string]string
+	content   string
+	mergeBase string
+	refs      []string
+}
+
+func (r *contentCommitRepo) Compare(ctx context.Context, owner, repo, base, head string) (*models.Comparison, error) {
+	return &models.Comparison{MergeCommit: &models.Commit{SHA: r.mergeBase}}, nil
+}
+
+func (r *contentCommitRepo) GetFileContent(ctx context.Context, owner, repo, path, ref string) (string, error) {
+	r.refs = append(r.refs, ref)
+	if content, ok := r.files[ref+":"+path]; ok {
+		return content, nil
+	}
+	return r.content, nil
+}
+
+func TestPRDetailView_DiffExpandsContext(t *testing.T) {
+	_, patch, _ := strings.Cut(twoHunkDiff, "+++ b/main.go\n")
+	repo := &testPRRepo{files: []*models.DiffFile{
+		{Filename: "main.go", Status: models.FileStatusModified, Changes: 4, Patch: patch},
+	}}
+	commits := &contentCommitRepo{content: numberedLines(25)}
+	view := diffDetailView(repo)
+	view.SetCommitRepository(commits)
+
+	view.Update(press(view, "d")())
+	cmd := press(view, "e")
+	if cmd == nil {
+		t.Fatal("expected e to fetch the file for context")
+	}
+	view.Update(cmd())
+
+	if output := view.View(); !strings.Contains(output, "line 10") {
+		t.Errorf("expected the expanded context\n%s", output)
+	}
+	if len(commits.refs) != 1 || commits.refs[0] != "head123" {
+		t.Errorf("expected the file at the head of the PR, got refs %v", commits.refs)
+	}
+}
+
+func TestPRDetailView_DiffShowsNotebooksStructurally(t *testing.T) {
+	repo := &testPRRepo{files: []*models.DiffFile{{
+		Filename: "nb.ipynb", Status: models.FileStatusModified, Changes: 2,
+		Patch: "@@ -1,1 +1,1 @@\n-{\"cells\":[{\"source\":[\"a\"],\"outputs\":[]}]}\n+{\"cells\":[{\"source\":[\"b\"],\"outputs\":[]}]}",
+	}}}
+	view := diffDetailView(repo)
+	// The base branch moved on after the PR branched off at fork123
+	view.SetCommitRepository(&contentCommitRepo{mergeBase: "fork123", files: map[string]string{
+		"fork123:nb.ipynb": `{"cells":[{"source":["a"],"outputs":[]}]}`,
+		"base123:nb.ipynb": `{"cells":[{"source":["a"],"outputs":[]},{"source":["c"],"outputs":[]}]}`,
+		"head123:nb.ipynb": `{"cells":[{"source":["b"],"outputs":[]}]}`,
+	}})
+
+	_, cmd := view.Update(press(view, "d")())
+	if cmd == nil {
+		t.Fatal("expected the notebook to load a structural diff")
+	}
+	view.Update(cmd())
+
+	if output := view.View(); !strings.Contains(output, "cells[0].source (changed)") || !strings.Contains(output, "[structural]") {
+		t.Errorf("expected the structural diff of the notebook\n%s", output)
+	}
+	if output := view.View(); strings.Contains(output, "cells[1]") {
+		t.Errorf("expected the old side at the merge base, not the base branch tip\n%s", output)
+	}
+}
+
+func TestPRDetailView_DiffShowsImageDetails(t *testing.T) {
+	repo := &testPRRepo{files: []*models.DiffFile{
+		{Filename: "assets/logo.png", Status: models.FileStatusModified},
+	}}
+	view := diffDetailView(repo)
+	view.SetCommitRepository(&contentCommitRepo{files: map[string]string{
+		"base123:assets/logo.png": encodePNG(t, 16, 16),
+		"head123:assets/logo.png": encodePNG(t, 64, 32),
+	}})
+
+	_, cmd := view.Update(press(view, "d")())
+	if cmd == nil {
+		t.Fatal("expected both versions of the image to be fetched")
+	}
+	view.Update(cmd())
+
+	if output := view.View(); !strings.Contains(output, "Dimensions: 16×16 → 64×32") {
+		t.Errorf("expected the image details\n%s", output)
+	}
+}
@@ -0,0 +1,122 @@
+package views
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+)
+
+// rootDirectory groups files that live at the top of the rollup
+const rootDirectory = "./"
+
+// dirStat is the diff stat rollup of one directory
+type dirStat struct {
+	Dir       string
+	Files     int
+	Additions int
+	Deletions int
+}
+
+// rollupByDirectory sums file changes per directory, biggest first.
+// Directories shared by every file are skipped so that a PR living entirely
+// under src/ is broken down by the subdirectories of src/ instead.
+func rollupByDirectory(files []*models.DiffFile) []dirStat {
+	prefix := commonDirectory(files)
+
+	index := make(map[string]int)
+	var stats []dirStat
+	for _, file := range files {
+		if file == nil {
+			continue
+		}
+
+		dir := rootDirectory
+		rest := strings.TrimPrefix(file.Filename, prefix)
+		if i := strings.Index(rest, "/"); i >= 0 {
+			dir = prefix + rest[:i+1]
+		} else if prefix != "" {
+			dir = prefix
+		}
+
+		i, ok := index[dir]
+		if !ok {
+			i = len(stats)
+			index[dir] = i
+			stats = append(stats, dirStat{Dir: dir})
+		}
+		stats[i].Files++
+		stats[i].Additions += file.Additions
+		stats[i].Deletions += file.Deletions
+	}
+
+	sort.SliceStable(stats, func(i, j int) bool {
+		ci := stats[i].Additions + stats[i].Deletions
+		cj := stats[j].Additions + stats[j].Deletions
+		if ci != cj {
+			return ci > cj
+		}
+		return stats[i].Dir < stats[j].Dir
+	})
+
+	return stats
+}
+
+// commonDirectory returns the directory prefix (with trailing slash) shared by all files
+func commonDirectory(files []*models.DiffFile) string {
+	prefix := ""
+	first := true
+	for _, file := range files {
+		if file == nil {
+			continue
+		}
+
+		dir := ""
+		if i := strings.LastIndex(file.Filename, "/"); i >= 0 {
+			dir = file.Filename[:i+1]
+		}
+		if first {
+			prefix = dir
+			first = false
+			continue
+		}
+		for !strings.HasPrefix(dir, prefix) {
+			trimmed := strings.TrimSuffix(prefix, "/")
+			if i := strings.LastIndex(trimmed, "/"); i >= 0 {
+				prefix = trimmed[:i+1]
+			} else {
+				prefix = ""
+			}
+		}
+	}
+	return prefix
+}
+
+// renderDirectoryRollup renders one line per directory: "src/  +400 -120  9 files"
+func renderDirectoryRollup(stats []dirStat) string {
+	width := 0
+	for _, stat := range stats {
+		if len(stat.Dir) > width {
+			width = len(stat.Dir)
+		}
+	}
+
+	lines := make([]string, 0, len(stats))
+	for _, stat := range stats {
+		files := "files"
+		if stat.Files == 1 {
+			files = "file"
+		}
+		lines = append(lines, fmt.Sprintf("%-*s  %s %s  %s",
+			width,
+			stat.Dir,
+			styles.AddedLineStyle.Render(fmt.Sprintf("+%d", stat.Additions)),
+			styles.DeletedLineStyle.Render(fmt.Sprintf("-%d", stat.Deletions)),
+			styles.MutedStyle.Render(fmt.Sprintf("across %d %s", stat.Files, files)),
+		))
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,64 @@
+package views
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestRollupByDirectory(t *testing.T) {
+	files := []*models.DiffFile{
+		{Filename: "src/a.go", Additions: 10, Deletions: 2},
+		{Filename: "src/b/c.go", Additions: 300, Deletions: 100},
+		{Filename: "docs/README.md", Additions: 5, Deletions: 0},
+		{Filename: "go.mod", Additions: 1, Deletions: 1},
+	}
+
+	got := rollupByDirectory(files)
+	want := []dirStat{
+		{Dir: "src/", Files: 2, Additions: 310, Deletions: 102},
+		{Dir: "docs/", Files: 1, Additions: 5, Deletions: 0},
+		{Dir: "./", Files: 1, Additions: 1, Deletions: 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("rollupByDirectory = %+v, want %+v", got, want)
+	}
+}
+
+func TestRollupByDirectory_SharedPrefix(t *testing.T) {
+	files := []*models.DiffFile{
+		{Filename: "internal/ui/app.go", Additions: 3},
+		{Filename: "internal/ui/views/pr.go", Additions: 20},
+		{Filename: "internal/ui/views/issue.go", Additions: 5},
+	}
+
+	got := rollupByDirectory(files)
+	want := []dirStat{
+		{Dir: "internal/ui/views/", Files: 2, Additions: 25},
+		{Dir: "internal/ui/", Files: 1, Additions: 3},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("rollupByDirectory = %+v, want %+v", got, want)
+	}
+}
+
+func TestPRDetailView_FilesTabShowsRollup(t *testing.T) {
+	pr := createTestPullRequest()
+	repo := &testPRRepo{pr: pr, files: []*models.DiffFile{
+		{Filename: "src/a.go", Additions: 400, Deletions: 120},
+	}}
+	view := NewPRDetailView(pr, "owner", "repo", repo)
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 50})
+	view.Update(view.loadFiles()())
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'2'}})
+
+	output := view.renderFilesTab()
+	for _, want := range []string{"By directory", "src/", "+400", "-120", "across 1 file"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected files tab to contain %q", want)
+		}
+	}
+}
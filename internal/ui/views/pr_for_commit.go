@@ -0,0 +1,100 @@
+package views
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/infra/git"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// headSHA reads the checked-out commit (overridable in tests)
+var headSHA = git.HeadSHA
+
+// prForHeadMsg is sent when the PRs containing the checked-out commit are found
+type prForHeadMsg struct {
+	sha string
+	prs []*models.PullRequest
+	err error
+}
+
+// findPRForHead looks up the PR that brought the checked-out commit in,
+// answering "why is this here" for the code in front of you
+func (m *PRView) findPRForHead() tea.Cmd {
+	if m.fetchPRsUseCase == nil {
+		return nil
+	}
+	repo := m.fetchPRsUseCase.GetRepository()
+	owner, name := m.owner, m.repo
+	// A PR opened since the last lookup must be found, so the cache is skipped
+	ctx := freshContext(m.loads.Context())
+
+	m.statusBar.SetMessage("Looking up the PR of HEAD...")
+	return func() tea.Msg {
+		localOwner, localRepo, err := currentRepository()
+		if err != nil || !strings.EqualFold(localOwner, owner) || !strings.EqualFold(localRepo, name) {
+			return prForHeadMsg{err: fmt.Errorf("the working directory is not a clone of %s/%s", owner, name)}
+		}
+		sha, err := headSHA()
+		if err != nil {
+			return prForHeadMsg{err: err}
+		}
+		prs, err := repo.ListForCommit(ctx, owner, name, sha)
+		return prForHeadMsg{sha: sha, prs: prs, err: err}
+	}
+}
+
+// handlePRForHead opens the PR that introduced the checked-out commit
+func (m *PRView) handlePRForHead(msg prForHeadMsg) tea.Cmd {
+	if isCancelled(msg.err) {
+		return nil
+	}
+	if msg.err != nil {
+		m.statusBar.SetMessage(fmt.Sprintf("Finding the PR of HEAD failed: %v", msg.err))
+		return nil
+	}
+
+	pr, others := introducingPR(msg.prs)
+	if pr == nil {
+		m.statusBar.SetMessage(fmt.Sprintf("No PR contains HEAD %s yet (push it and press n to open one)", shortSHA(msg.sha)))
+		return nil
+	}
+
+	status := fmt.Sprintf("HEAD %s came in with #%d", shortSHA(msg.sha), pr.Number)
+	if len(others) > 0 {
+		var numbers []string
+		for _, other := range others {
+			numbers = append(numbers, fmt.Sprintf("#%d", other.Number))
+		}
+		status += " (also in " + strings.Join(numbers, ", ") + ")"
+	}
+	m.statusBar.SetMessage(status)
+	return m.openDetail(pr)
+}
+
+// introducingPR picks the PR that introduced a commit: the first one merged,
+// or else the oldest open one. The others containing it are returned too.
+func introducingPR(prs []*models.PullRequest) (*models.PullRequest, []*models.PullRequest) {
+	if len(prs) == 0 {
+		return nil, nil
+	}
+
+	sorted := make([]*models.PullRequest, len(prs))
+	copy(sorted, prs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if (a.MergedAt != nil) != (b.MergedAt != nil) {
+			return a.MergedAt != nil
+		}
+		if a.MergedAt != nil {
+			return a.MergedAt.Before(*b.MergedAt)
+		}
+		if (a.State == models.PRStateOpen) != (b.State == models.PRStateOpen) {
+			return a.State == models.PRStateOpen
+		}
+		return a.Number < b.Number
+	})
+	return sorted[0], sorted[1:]
+}
@@ -0,0 +1,76 @@
+package views
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+)
+
+func TestIntroducingPR(t *testing.T) {
+	early := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	late := early.Add(24 * time.Hour)
+	prs := []*models.PullRequest{
+		{Number: 9, State: models.PRStateOpen},
+		{Number: 7, State: models.PRStateClosed, Merged: true, MergedAt: &late},
+		{Number: 5, State: models.PRStateClosed, Merged: true, MergedAt: &early},
+	}
+
+	pr, others := introducingPR(prs)
+	if pr == nil || pr.Number != 5 || len(others) != 2 {
+		t.Fatalf("introducingPR() = %v, %v; want #5 first merged", pr, others)
+	}
+	if pr, _ := introducingPR(prs[:1]); pr == nil || pr.Number != 9 {
+		t.Errorf("expected the open PR when none is merged, got %v", pr)
+	}
+	if pr, _ := introducingPR(nil); pr != nil {
+		t.Errorf("expected no PR, got %v", pr)
+	}
+}
+
+func TestPRView_OpenPRForHead(t *testing.T) {
+	stubLocalGit(t, "owner", "repo", nil, nil)
+	origHead := headSHA
+	t.Cleanup(func() { headSHA = origHead })
+	headSHA = func() (string, error) { return "0123456789abcdef", nil }
+
+	merged := time.Now()
+	pr := createTestPullRequest()
+	pr.MergedAt = &merged
+	prRepo := &testPRRepo{pr: pr}
+	view := NewPRViewWithUseCase(&mockFetchPRsUseCase{getRepositoryFunc: func() repository.PullRequestRepository { return prRepo }}, "owner", "repo")
+	view.loading = false
+	view.width, view.height = 120, 40
+	view.statusBar.SetSize(120, 1)
+
+	// Not pushed yet
+	view.Update(press(view, "H")())
+	if view.IsShowingDetail() || !strings.Contains(view.View(), "No PR contains HEAD 0123456") {
+		t.Fatalf("expected a message that no PR contains HEAD\n%s", view.View())
+	}
+
+	prRepo.forCommit = []*models.PullRequest{{Number: 99, State: models.PRStateOpen}, pr}
+	view.Update(press(view, "H")())
+	if !view.IsShowingDetail() || view.detailView.pr.Number != pr.Number {
+		t.Fatalf("expected the detail of #%d", pr.Number)
+	}
+	if !strings.Contains(view.statusBar.View(), "also in #99") {
+		t.Errorf("expected the other PR to be named, got %q", view.statusBar.View())
+	}
+}
+
+func TestPRView_PRForHeadNeedsClone(t *testing.T) {
+	stubLocalGit(t, "someone", "else", nil, nil)
+
+	view := NewPRViewWithUseCase(&mockFetchPRsUseCase{getRepositoryFunc: func() repository.PullRequestRepository { return &testPRRepo{} }}, "owner", "repo")
+	view.loading = false
+	view.width, view.height = 120, 40
+	view.statusBar.SetSize(120, 1)
+
+	view.Update(press(view, "H")())
+	if view.IsShowingDetail() || !strings.Contains(view.statusBar.View(), "not a clone of owner/repo") {
+		t.Errorf("expected an error about the working directory, got %q", view.statusBar.View())
+	}
+}
@@ -0,0 +1,151 @@
+package views
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// closingKeywordPattern matches a closing keyword followed by the issue it closes
+// (https://docs.github.com/en/issues/tracking-your-work-with-issues/linking-a-pull-request-to-an-issue)
+var closingKeywordPattern = regexp.MustCompile(`(?i)\b(?:close[sd]?|fix(?:e[sd])?|resolve[sd]?):?\s+(?:https?://github\.com/([A-Za-z0-9][A-Za-z0-9-]*)/([A-Za-z0-9._-]+)/issues/(\d+)|(?:([A-Za-z0-9][A-Za-z0-9-]*)/([A-Za-z0-9._-]+))?#(\d+))\b`)
+
+// prLinkedIssuesLoadedMsg is a message when the issues a PR closes are loaded
+type prLinkedIssuesLoadedMsg struct {
+	issues []*models.LinkedIssue
+	err    error
+}
+
+// closingRefs returns the issues body closes with keywords such as "Fixes #12",
+// in order and without duplicates. References inside code are ignored.
+func closingRefs(body, owner, repo string) []crossRef {
+	var refs []crossRef
+	seen := make(map[string]bool)
+	for _, line := range stripCode(body) {
+		for _, m := range closingKeywordPattern.FindAllStringSubmatch(line, -1) {
+			ref := crossRef{Owner: owner, Repo: repo}
+			switch {
+			case m[3] != "":
+				ref.Owner, ref.Repo = m[1], m[2]
+				ref.Number, _ = strconv.Atoi(m[3])
+			case m[4] != "":
+				ref.Owner, ref.Repo = m[4], m[5]
+				ref.Number, _ = strconv.Atoi(m[6])
+			default:
+				ref.Number, _ = strconv.Atoi(m[6])
+			}
+			if key := strings.ToLower(ref.label("", "")); !seen[key] {
+				seen[key] = true
+				refs = append(refs, ref)
+			}
+		}
+	}
+	return refs
+}
+
+// SetIssueRepository sets the repository used to open linked and referenced issues
+func (m *PRView) SetIssueRepository(repo repository.IssueRepository) {
+	m.issueRepo = repo
+}
+
+// SetIssueRepository sets the repository used to open linked and referenced issues
+func (m *PRDetailView) SetIssueRepository(repo repository.IssueRepository) {
+	m.issueRepo = repo
+}
+
+// loadLinkedIssues loads the issues the PR closes
+func (m *PRDetailView) loadLinkedIssues() tea.Cmd {
+	ctx := m.loads.Context()
+	return func() tea.Msg {
+		if m.prRepo == nil {
+			return prLinkedIssuesLoadedMsg{err: fmt.Errorf("pull request repository not available")}
+		}
+		issues, err := m.prRepo.ListLinkedIssues(ctx, m.owner, m.repo, m.pr.Number)
+		return prLinkedIssuesLoadedMsg{issues: issues, err: err}
+	}
+}
+
+// linkedIssues returns the issues reported by GitHub followed by those only
+// found as closing keywords in the body (GitHub ignores keywords when the
+// base is not the default branch, but reviewers still want to see them)
+func (m *PRDetailView) linkedIssues() []*models.LinkedIssue {
+	issues := append([]*models.LinkedIssue(nil), m.linked...)
+	for _, ref := range closingRefs(m.pr.Body, m.owner, m.repo) {
+		known := false
+		for _, issue := range m.linked {
+			if issue.Number == ref.Number && ref.inRepo(issue.Owner, issue.Repo) {
+				known = true
+				break
+			}
+		}
+		if !known {
+			issues = append(issues, &models.LinkedIssue{Owner: ref.Owner, Repo: ref.Repo, Number: ref.Number})
+		}
+	}
+	return issues
+}
+
+// openLinkedIssue fetches the selected linked issue to show its detail view
+func (m *PRDetailView) openLinkedIssue() tea.Cmd {
+	issues := m.linkedIssues()
+	if m.selectedLinked >= len(issues) {
+		return nil
+	}
+	linked := issues[m.selectedLinked]
+	ref := crossRef{Owner: linked.Owner, Repo: linked.Repo, Number: linked.Number}
+	if m.issueRepo == nil {
+		m.statusMessage = "Cannot open " + ref.label(m.owner, m.repo) + " here"
+		return nil
+	}
+	m.statusMessage = "Opening " + ref.label(m.owner, m.repo) + "..."
+	ctx := m.loads.Context()
+	issueRepo := m.issueRepo
+	return func() tea.Msg {
+		issue, err := issueRepo.Get(ctx, ref.Owner, ref.Repo, ref.Number)
+		return crossRefLoadedMsg{ref: ref, issue: issue, err: err}
+	}
+}
+
+// renderLinkedIssues renders the "Linked issues" section of the overview tab
+func (m *PRDetailView) renderLinkedIssues() string {
+	issues := m.linkedIssues()
+	if len(issues) == 0 && m.linkedErr == nil {
+		if m.linkedLoading {
+			return styles.MutedStyle.Render("Loading linked issues...")
+		}
+		return ""
+	}
+
+	var s strings.Builder
+	s.WriteString(styles.BoldStyle.Render(fmt.Sprintf("Linked issues (%d)", len(issues))))
+	s.WriteString("\n")
+	for i, issue := range issues {
+		marker := "  "
+		if i == m.selectedLinked {
+			marker = styles.CursorStyle.Render(styles.IconCursor + " ")
+		}
+		ref := crossRef{Owner: issue.Owner, Repo: issue.Repo, Number: issue.Number}
+		line := marker + styles.IssueNumberStyle.Render(ref.label(m.owner, m.repo))
+		if issue.State != "" {
+			line += " " + styles.GetStateBadge(string(issue.State))
+		}
+		if issue.Title != "" {
+			line += " " + issue.Title
+		} else {
+			line += " " + styles.MutedStyle.Render("(closing keyword)")
+		}
+		s.WriteString(line)
+		s.WriteString("\n")
+	}
+	if m.linkedErr != nil {
+		s.WriteString(styles.MutedStyle.Render(fmt.Sprintf("Failed to load linked issues: %v", m.linkedErr)))
+		s.WriteString("\n")
+	}
+	return s.String()
+}
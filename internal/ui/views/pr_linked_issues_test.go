@@ -0,0 +1,71 @@
+package views
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestClosingRefs(t *testing.T) {
+	body := "Fixes #12 and closes other/lib#3.\nResolved: https://github.com/owner/repo/issues/14\nSee #15, `fixes #16`, fix #12"
+	want := []crossRef{{"owner", "repo", 12}, {"other", "lib", 3}, {"owner", "repo", 14}}
+	if got := closingRefs(body, "owner", "repo"); !reflect.DeepEqual(got, want) {
+		t.Errorf("closingRefs() = %v, want %v", got, want)
+	}
+}
+
+func TestPRDetailView_LinkedIssues(t *testing.T) {
+	pr := createTestPullRequest()
+	pr.Body = "Fixes #12\nCloses #20"
+	repo := &testPRRepo{pr: pr}
+	view := NewPRDetailView(pr, "owner", "repo", repo)
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 60})
+	view.Update(prLinkedIssuesLoadedMsg{issues: []*models.LinkedIssue{
+		{Owner: "owner", Repo: "repo", Number: 12, Title: "Crash on start", State: models.IssueStateOpen},
+		{Owner: "other", Repo: "lib", Number: 3, Title: "Linked in the sidebar", State: models.IssueStateClosed},
+	}})
+
+	out := view.renderLinkedIssues()
+	for _, want := range []string{"Linked issues (3)", "#12", "Crash on start", "other/lib#3", "#20", "(closing keyword)"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("linked issues section missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Count(out, "#12") != 1 {
+		t.Error("an issue reported by GitHub and found in the body should be listed once")
+	}
+}
+
+func TestPRView_OpensLinkedIssueAndReturns(t *testing.T) {
+	pr := createTestPullRequest()
+	prRepo := &testPRRepo{pr: pr, linked: []*models.LinkedIssue{{Owner: "owner", Repo: "repo", Number: 12, Title: "Crash"}}}
+	issueRepo := &crossRefIssueRepo{issues: map[string]*models.Issue{"owner/repo#12": {Number: 12, Title: "Crash on start"}}}
+	view := NewPRViewWithUseCase(&mockFetchPRsUseCase{getRepositoryFunc: func() repository.PullRequestRepository { return prRepo }}, "owner", "repo")
+	view.SetIssueRepository(issueRepo)
+	view.loading = false
+	view.width, view.height = 100, 60
+	view.prs = []*models.PullRequest{pr}
+
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view.Update(prLinkedIssuesLoadedMsg{issues: prRepo.linked})
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected the linked issue to be fetched")
+	}
+	view.Update(cmd())
+	if out := view.View(); !strings.Contains(out, "Issue #12") || !strings.Contains(out, "Crash on start") {
+		t.Fatalf("expected the issue detail view, got:\n%s", out)
+	}
+
+	view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if !view.IsShowingDetail() || len(view.issueDetails) != 0 {
+		t.Fatal("esc should return to the PR detail view")
+	}
+	if out := view.View(); !strings.Contains(out, pr.Title) {
+		t.Errorf("expected the PR detail view, got:\n%s", out)
+	}
+}
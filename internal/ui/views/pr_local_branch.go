@@ -0,0 +1,112 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/infra/git"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Local git access used by the PR list (overridable in tests)
+var (
+	currentRepository   = git.GetCurrentRepository
+	currentBranchStatus = git.CurrentBranchStatus
+	localBranches       = git.LocalBranches
+	checkoutPullRequest = git.CheckoutPullRequest
+)
+
+// localBranchState is the state of the local clone of the viewed repository
+type localBranchState struct {
+	status   *git.BranchStatus
+	branches map[string]bool
+}
+
+// localBranchLoadedMsg is sent when the local branch state has been read.
+// state is nil when the working directory is not a clone of the repository.
+type localBranchLoadedMsg struct {
+	state *localBranchState
+	err   error
+}
+
+// prCheckedOutMsg is sent when a PR branch checkout has finished
+type prCheckedOutMsg struct {
+	number int
+	branch string
+	err    error
+}
+
+// loadLocalBranch reads the checked-out branch and the local branches,
+// provided the working directory is a clone of owner/repo
+func loadLocalBranch(owner, repo string) tea.Cmd {
+	return func() tea.Msg {
+		localOwner, localRepo, err := currentRepository()
+		if err != nil || !strings.EqualFold(localOwner, owner) || !strings.EqualFold(localRepo, repo) {
+			return localBranchLoadedMsg{}
+		}
+
+		status, err := currentBranchStatus()
+		if err != nil {
+			return localBranchLoadedMsg{err: err}
+		}
+		names, err := localBranches()
+		if err != nil {
+			return localBranchLoadedMsg{err: err}
+		}
+
+		branches := make(map[string]bool, len(names))
+		for _, name := range names {
+			branches[name] = true
+		}
+		return localBranchLoadedMsg{state: &localBranchState{status: status, branches: branches}}
+	}
+}
+
+// checkoutPR checks out the head of the PR in its own branch of the local clone
+func checkoutPR(pr *models.PullRequest) tea.Cmd {
+	return func() tea.Msg {
+		return prCheckedOutMsg{
+			number: pr.Number,
+			branch: git.PullRequestBranch(pr.Number),
+			err:    checkoutPullRequest(pr.Number),
+		}
+	}
+}
+
+// isCheckedOut reports whether the PR is checked out: its own branch is the
+// current one, or HEAD is at the PR's head commit. The head branch name alone
+// is not enough, as a fork's main is not the local main.
+func (s *localBranchState) isCheckedOut(pr *models.PullRequest) bool {
+	if s == nil || s.status == nil {
+		return false
+	}
+	if s.status.Branch == git.PullRequestBranch(pr.Number) {
+		return true
+	}
+	return pr.Head.SHA != "" && s.status.HeadSHA == pr.Head.SHA
+}
+
+// renderLocalBranchBadge marks the PR checked out locally ("● HEAD ↑2 ↓1")
+// and PRs already checked out into their own branch before ("⎇")
+func renderLocalBranchBadge(pr *models.PullRequest, state *localBranchState) string {
+	if state == nil {
+		return ""
+	}
+
+	if state.isCheckedOut(pr) {
+		badge := styles.IconDot + " HEAD"
+		if state.status.Ahead > 0 {
+			badge += fmt.Sprintf(" %s%d", styles.IconAhead, state.status.Ahead)
+		}
+		if state.status.Behind > 0 {
+			badge += fmt.Sprintf(" %s%d", styles.IconBehind, state.status.Behind)
+		}
+		return " " + styles.PRApprovedStyle.Render(badge)
+	}
+	if state.branches[git.PullRequestBranch(pr.Number)] {
+		return " " + styles.MutedStyle.Render(styles.IconBranch)
+	}
+	return ""
+}
@@ -0,0 +1,159 @@
+package views
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/infra/git"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// stubLocalGit replaces the local git functions for the duration of a test
+func stubLocalGit(t *testing.T, owner, repo string, status *git.BranchStatus, branches []string) {
+	t.Helper()
+	origRepo, origStatus, origBranches, origCheckout := currentRepository, currentBranchStatus, localBranches, checkoutPullRequest
+	t.Cleanup(func() {
+		currentRepository, currentBranchStatus, localBranches, checkoutPullRequest = origRepo, origStatus, origBranches, origCheckout
+	})
+
+	currentRepository = func() (string, string, error) { return owner, repo, nil }
+	currentBranchStatus = func() (*git.BranchStatus, error) { return status, nil }
+	localBranches = func() ([]string, error) { return branches, nil }
+}
+
+func localBranchTestView() *PRView {
+	view := NewPRView()
+	view.owner, view.repo = "owner", "repo"
+	view.width, view.height = 160, 30
+	view.statusBar.SetSize(160, 1)
+	view.prs = []*models.PullRequest{
+		{Number: 1, Title: "Current", State: models.PRStateOpen, Head: models.Branch{Name: "feature", SHA: "sha1"}},
+		{Number: 2, Title: "Fetched", State: models.PRStateOpen, Head: models.Branch{Name: "fix", SHA: "sha2"}},
+		{Number: 3, Title: "From a fork's main", State: models.PRStateOpen, Head: models.Branch{Name: "main", SHA: "sha3"}},
+	}
+	return view
+}
+
+func TestLoadLocalBranch(t *testing.T) {
+	stubLocalGit(t, "Owner", "Repo", &git.BranchStatus{Branch: "feature", Ahead: 2, Behind: 1}, []string{"main", "feature"})
+
+	msg := loadLocalBranch("owner", "repo")().(localBranchLoadedMsg)
+	if msg.err != nil || msg.state == nil {
+		t.Fatalf("loadLocalBranch() = %+v", msg)
+	}
+	if !msg.state.branches["feature"] || msg.state.branches["fix"] {
+		t.Errorf("branches = %v", msg.state.branches)
+	}
+
+	// A clone of another repository is not annotated
+	msg = loadLocalBranch("owner", "elsewhere")().(localBranchLoadedMsg)
+	if msg.state != nil {
+		t.Errorf("expected no state for a different repository, got %+v", msg.state)
+	}
+}
+
+func TestPRView_LocalBranchBadges(t *testing.T) {
+	stubLocalGit(t, "owner", "repo", &git.BranchStatus{Branch: "feature", HeadSHA: "sha1", Ahead: 2, Behind: 1}, []string{"main", "feature", "pr/2"})
+
+	view := localBranchTestView()
+	view.Update(loadLocalBranch("owner", "repo")())
+
+	current := view.renderPRLine(view.prs[0], 0)
+	if !strings.Contains(current, "● HEAD ↑2 ↓1") {
+		t.Errorf("checked-out PR should show HEAD with ahead/behind, got %q", current)
+	}
+	if fetched := view.renderPRLine(view.prs[1], 1); !strings.Contains(fetched, "⎇") || strings.Contains(fetched, "HEAD") {
+		t.Errorf("locally available PR should show ⎇, got %q", fetched)
+	}
+	if remote := view.renderPRLine(view.prs[2], 2); strings.Contains(remote, "⎇") || strings.Contains(remote, "HEAD") {
+		t.Errorf("remote-only PR should not be annotated, got %q", remote)
+	}
+}
+
+func TestPRView_LocalBranchIgnoresHeadBranchName(t *testing.T) {
+	// On the local main, a fork PR from its own main is not checked out
+	stubLocalGit(t, "owner", "repo", &git.BranchStatus{Branch: "main", HeadSHA: "local"}, []string{"main"})
+
+	view := localBranchTestView()
+	view.Update(loadLocalBranch("owner", "repo")())
+
+	if view.localBranch.isCheckedOut(view.prs[2]) {
+		t.Error("a PR should not be checked out just because its head branch has the current name")
+	}
+	if line := view.renderPRLine(view.prs[2], 2); strings.Contains(line, "HEAD") || strings.Contains(line, "⎇") {
+		t.Errorf("fork PR from main should not be annotated, got %q", line)
+	}
+}
+
+func TestPRView_CheckoutPR(t *testing.T) {
+	stubLocalGit(t, "owner", "repo", &git.BranchStatus{Branch: "feature"}, []string{"feature"})
+
+	var gotNumber int
+	checkoutPullRequest = func(number int) error {
+		gotNumber = number
+		return nil
+	}
+
+	view := localBranchTestView()
+	view.Update(loadLocalBranch("owner", "repo")())
+	view.cursor = 2
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyCtrlO})
+	if cmd == nil || !view.checkingOut {
+		t.Fatal("ctrl+o should start a checkout")
+	}
+	msg := cmd()
+	if gotNumber != 3 {
+		t.Errorf("checked out #%d, want #3", gotNumber)
+	}
+
+	currentBranchStatus = func() (*git.BranchStatus, error) { return &git.BranchStatus{Branch: "pr/3", HeadSHA: "sha3"}, nil }
+	_, cmd = view.Update(msg)
+	if view.checkingOut {
+		t.Error("checkingOut should be cleared")
+	}
+	if !strings.Contains(view.statusBar.View(), "Checked out pr/3 (#3)") {
+		t.Errorf("status should report the checkout, got %q", view.statusBar.View())
+	}
+	if cmd == nil {
+		t.Fatal("a successful checkout should reload the local branch state")
+	}
+	view.Update(cmd())
+	if !view.localBranch.isCheckedOut(view.prs[2]) {
+		t.Error("the checked-out PR should now be marked as HEAD")
+	}
+}
+
+func TestPRView_CheckoutPRFailure(t *testing.T) {
+	stubLocalGit(t, "owner", "repo", &git.BranchStatus{Branch: "feature"}, []string{"feature"})
+	checkoutPullRequest = func(int) error {
+		return errors.New("your local changes would be overwritten")
+	}
+
+	view := localBranchTestView()
+	view.Update(loadLocalBranch("owner", "repo")())
+	view.cursor = 1
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyCtrlO})
+	_, cmd = view.Update(cmd())
+	if cmd != nil {
+		t.Error("a failed checkout should not reload")
+	}
+	if !strings.Contains(view.statusBar.View(), "Checkout failed") {
+		t.Errorf("status should report the failure, got %q", view.statusBar.View())
+	}
+}
+
+func TestPRView_CheckoutPRWithoutLocalClone(t *testing.T) {
+	view := localBranchTestView()
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyCtrlO})
+	if cmd != nil {
+		t.Error("ctrl+o outside a local clone should do nothing")
+	}
+	if !strings.Contains(view.statusBar.View(), "Not in a local clone of owner/repo") {
+		t.Errorf("status should explain why, got %q", view.statusBar.View())
+	}
+}
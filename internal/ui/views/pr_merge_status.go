@@ -0,0 +1,115 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// mergeBlocker is one reason a pull request cannot be merged yet.
+// Waiting blockers resolve on their own (reviews, running checks);
+// the others need someone to act.
+type mergeBlocker struct {
+	text    string
+	waiting bool
+}
+
+// mergeBlockers lists what keeps the PR from merging under the base branch protection rules
+func mergeBlockers(pr *models.PullRequest, req *models.MergeRequirements) []mergeBlocker {
+	var blockers []mergeBlocker
+
+	approved := countApprovals(pr.Reviews)
+	if req.ReviewDecision == "CHANGES_REQUESTED" {
+		blockers = append(blockers, mergeBlocker{text: "Changes requested"})
+	}
+
+	var failing, pending []string
+	for _, check := range req.Checks {
+		if !check.Required {
+			continue
+		}
+		switch check.State {
+		case models.CheckStateFailure:
+			failing = append(failing, check.Name)
+		case models.CheckStatePending:
+			pending = append(pending, check.Name)
+		}
+	}
+	if len(failing) > 0 {
+		blockers = append(blockers, mergeBlocker{text: "Required checks failing: " + strings.Join(failing, ", ")})
+	}
+
+	switch {
+	case req.RequiredApprovals > approved:
+		missing := req.RequiredApprovals - approved
+		noun := "approvals"
+		if missing == 1 {
+			noun = "approval"
+		}
+		blockers = append(blockers, mergeBlocker{
+			text:    fmt.Sprintf("Needs %d more %s (%d/%d)", missing, noun, approved, req.RequiredApprovals),
+			waiting: true,
+		})
+	case req.ReviewDecision == "REVIEW_REQUIRED" && req.RequireCodeOwnerReviews:
+		blockers = append(blockers, mergeBlocker{text: "Code owner review required", waiting: true})
+	case req.ReviewDecision == "REVIEW_REQUIRED":
+		blockers = append(blockers, mergeBlocker{text: "Review required", waiting: true})
+	}
+
+	if len(pending) > 0 {
+		blockers = append(blockers, mergeBlocker{text: "Waiting on required checks: " + strings.Join(pending, ", "), waiting: true})
+	}
+
+	return blockers
+}
+
+// countApprovals counts the reviewers whose latest approval or change request
+// is an approval; comments and dismissed reviews do not change their verdict
+func countApprovals(reviews []models.Review) int {
+	latest := make(map[string]models.Review)
+	for _, review := range reviews {
+		if review.State != models.ReviewStateApproved && review.State != models.ReviewStateChangesRequested {
+			continue
+		}
+		if last, ok := latest[review.User.Login]; ok && review.SubmittedAt.Before(last.SubmittedAt) {
+			continue
+		}
+		latest[review.User.Login] = review
+	}
+
+	approved := 0
+	for _, review := range latest {
+		if review.State == models.ReviewStateApproved {
+			approved++
+		}
+	}
+	return approved
+}
+
+// renderMergeBlockers renders the Status line from the merge blockers
+func renderMergeBlockers(blockers []mergeBlocker) string {
+	if len(blockers) == 0 {
+		return lipgloss.NewStyle().
+			Foreground(styles.ColorPositive).
+			Render(styles.IconCheck + styles.IconCheck + " Ready to merge")
+	}
+
+	waiting := true
+	texts := make([]string, 0, len(blockers))
+	for _, blocker := range blockers {
+		waiting = waiting && blocker.waiting
+		texts = append(texts, blocker.text)
+	}
+
+	if waiting {
+		return lipgloss.NewStyle().
+			Foreground(styles.ColorPending).
+			Render(styles.IconWaiting + " " + strings.Join(texts, " · "))
+	}
+	return lipgloss.NewStyle().
+		Foreground(styles.ColorNegative).
+		Render(styles.IconCross + " " + strings.Join(texts, " · "))
+}
@@ -0,0 +1,109 @@
+package views
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+)
+
+func blockerTexts(blockers []mergeBlocker) []string {
+	texts := make([]string, 0, len(blockers))
+	for _, blocker := range blockers {
+		texts = append(texts, blocker.text)
+	}
+	return texts
+}
+
+func TestMergeBlockers(t *testing.T) {
+	approved := []models.Review{{State: models.ReviewStateApproved}}
+
+	tests := []struct {
+		name    string
+		reviews []models.Review
+		req     *models.MergeRequirements
+		want    []string
+	}{
+		{
+			name:    "unprotected branch with one approval is ready",
+			reviews: approved,
+			req:     &models.MergeRequirements{},
+			want:    nil,
+		},
+		{
+			name:    "missing approvals",
+			reviews: approved,
+			req:     &models.MergeRequirements{Protected: true, RequiredApprovals: 2, ReviewDecision: "REVIEW_REQUIRED"},
+			want:    []string{"Needs 1 more approval (1/2)"},
+		},
+		{
+			name:    "code owner review",
+			reviews: approved,
+			req:     &models.MergeRequirements{Protected: true, RequiredApprovals: 1, RequireCodeOwnerReviews: true, ReviewDecision: "REVIEW_REQUIRED"},
+			want:    []string{"Code owner review required"},
+		},
+		{
+			name:    "required checks",
+			reviews: approved,
+			req: &models.MergeRequirements{Checks: []models.CheckStatus{
+				{Name: "build", State: models.CheckStateFailure, Required: true},
+				{Name: "lint", State: models.CheckStatePending, Required: true},
+				{Name: "optional", State: models.CheckStateFailure},
+			}},
+			want: []string{"Required checks failing: build", "Waiting on required checks: lint"},
+		},
+		{
+			name: "changes requested",
+			req:  &models.MergeRequirements{ReviewDecision: "CHANGES_REQUESTED"},
+			want: []string{"Changes requested"},
+		},
+		{
+			name: "changes requested and then approved",
+			reviews: []models.Review{
+				{User: models.User{Login: "bob"}, State: models.ReviewStateChangesRequested, SubmittedAt: time.Unix(100, 0)},
+				{User: models.User{Login: "bob"}, State: models.ReviewStateApproved, SubmittedAt: time.Unix(200, 0)},
+			},
+			req:  &models.MergeRequirements{Protected: true, RequiredApprovals: 1, ReviewDecision: "APPROVED"},
+			want: nil,
+		},
+		{
+			name: "one reviewer approving twice",
+			reviews: []models.Review{
+				{User: models.User{Login: "bob"}, State: models.ReviewStateApproved, SubmittedAt: time.Unix(100, 0)},
+				{User: models.User{Login: "bob"}, State: models.ReviewStateCommented, SubmittedAt: time.Unix(150, 0)},
+				{User: models.User{Login: "bob"}, State: models.ReviewStateApproved, SubmittedAt: time.Unix(200, 0)},
+			},
+			req:  &models.MergeRequirements{Protected: true, RequiredApprovals: 2, ReviewDecision: "REVIEW_REQUIRED"},
+			want: []string{"Needs 1 more approval (1/2)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := &models.PullRequest{Reviews: tt.reviews}
+			got := blockerTexts(mergeBlockers(pr, tt.req))
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("mergeBlockers = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPRDetailView_getMergeStatus_Requirements(t *testing.T) {
+	pr := createTestPullRequest()
+	pr.Mergeable = true
+	pr.Reviews = []models.Review{{State: models.ReviewStateApproved}}
+	repo := &testPRRepo{pr: pr, reqs: &models.MergeRequirements{Protected: true, RequiredApprovals: 1}}
+	view := NewPRDetailView(pr, "owner", "repo", repo)
+
+	view.Update(view.loadRequirements()())
+	if status := view.getMergeStatus(); !strings.Contains(status, "Ready to merge") {
+		t.Errorf("expected a single required approval to be enough, got %q", status)
+	}
+
+	view.requirements.Checks = []models.CheckStatus{{Name: "ci", State: models.CheckStateFailure, Required: true}}
+	if status := view.getMergeStatus(); !strings.Contains(status, "Required checks failing: ci") {
+		t.Errorf("expected failing check blocker, got %q", status)
+	}
+}
@@ -0,0 +1,87 @@
+package views
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// protectedPathsConcurrency caps the number of file list requests in flight
+const protectedPathsConcurrency = 8
+
+// protectedPathsLoadedMsg is sent when the changed files of listed PRs have
+// been checked against the protected paths. touched maps PR numbers to the
+// patterns they touch; PRs touching none are left out.
+type protectedPathsLoadedMsg struct {
+	owner   string
+	repo    string
+	touched map[int][]string
+}
+
+// loadProtectedPaths checks the changed files of each open PR against the patterns
+func loadProtectedPaths(ctx context.Context, prRepo repository.PullRequestRepository, owner, repo string, patterns models.ProtectedPaths, prs []*models.PullRequest) tea.Cmd {
+	if prRepo == nil || len(patterns) == 0 || len(prs) == 0 {
+		return nil
+	}
+
+	return func() tea.Msg {
+		touched := make(map[int][]string)
+
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, protectedPathsConcurrency)
+		for _, pr := range prs {
+			if pr == nil || pr.Number <= 0 || pr.State != models.PRStateOpen || pr.Merged {
+				continue
+			}
+			if ctx.Err() != nil {
+				break
+			}
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(number int) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				defer recoverPanic(nil)
+
+				files, err := prRepo.ListFiles(ctx, owner, repo, number)
+				if err != nil {
+					return
+				}
+				if hits := patterns.Touched(diffFileNames(files)); len(hits) > 0 {
+					mu.Lock()
+					touched[number] = hits
+					mu.Unlock()
+				}
+			}(pr.Number)
+		}
+		wg.Wait()
+
+		return protectedPathsLoadedMsg{owner: owner, repo: repo, touched: touched}
+	}
+}
+
+// diffFileNames returns the paths of the changed files
+func diffFileNames(files []*models.DiffFile) []string {
+	names := make([]string, 0, len(files))
+	for _, file := range files {
+		if file == nil {
+			continue
+		}
+		names = append(names, file.Filename)
+	}
+	return names
+}
+
+// renderProtectedPathsBadge renders the warning shown next to PRs touching protected paths
+func renderProtectedPathsBadge(touched []string) string {
+	if len(touched) == 0 {
+		return ""
+	}
+	return " " + styles.WarningStyle.Render(styles.IconWarning+" "+strings.Join(touched, " "))
+}
@@ -0,0 +1,174 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var testProtectedPaths = []string{"infra/", "migrations/", ".github/workflows/"}
+
+// protectedPathsMsg runs cmd, expanding batches, and returns the protected paths result
+func protectedPathsMsg(t *testing.T, cmd tea.Cmd) protectedPathsLoadedMsg {
+	t.Helper()
+	if cmd == nil {
+		t.Fatal("expected a command checking protected paths")
+	}
+	switch msg := cmd().(type) {
+	case protectedPathsLoadedMsg:
+		return msg
+	case tea.BatchMsg:
+		for _, sub := range msg {
+			if sub == nil {
+				continue
+			}
+			if loaded, ok := sub().(protectedPathsLoadedMsg); ok {
+				return loaded
+			}
+		}
+	}
+	t.Fatal("protected paths were not checked")
+	return protectedPathsLoadedMsg{}
+}
+
+func TestLoadProtectedPaths(t *testing.T) {
+	prRepo := &testPRRepo{files: []*models.DiffFile{
+		{Filename: "infra/terraform/main.tf"},
+		{Filename: "cmd/main.go"},
+		{Filename: ".github/workflows/ci.yml"},
+	}}
+	prs := []*models.PullRequest{
+		{Number: 1, State: models.PRStateOpen},
+		{Number: 2, State: models.PRStateClosed, Merged: true},
+	}
+
+	if cmd := loadProtectedPaths(context.Background(), prRepo, "owner", "repo", nil, prs); cmd != nil {
+		t.Error("nothing should be checked without protected paths")
+	}
+
+	msg := loadProtectedPaths(context.Background(), prRepo, "owner", "repo", testProtectedPaths, prs)().(protectedPathsLoadedMsg)
+	if got := strings.Join(msg.touched[1], " "); got != "infra/ .github/workflows/" {
+		t.Errorf("touched patterns for #1 = %q", got)
+	}
+	if _, ok := msg.touched[2]; ok {
+		t.Error("merged PRs should not be checked")
+	}
+}
+
+func TestPRView_ProtectedPathsBadge(t *testing.T) {
+	prRepo := &testPRRepo{files: []*models.DiffFile{{Filename: "migrations/0042_add_index.sql"}}}
+	useCase := &mockFetchPRsUseCase{getRepositoryFunc: func() repository.PullRequestRepository { return prRepo }}
+	view := NewPRViewWithUseCase(useCase, "owner", "repo")
+	view.SetProtectedPaths(testProtectedPaths)
+	view.Update(tea.WindowSizeMsg{Width: 160, Height: 30})
+
+	_, cmd := view.Update(prsLoadedMsg{prs: []*models.PullRequest{{Number: 7, Title: "Add index", State: models.PRStateOpen}}})
+	view.Update(protectedPathsMsg(t, cmd))
+
+	if out := view.View(); !strings.Contains(out, "⚠ migrations/") {
+		t.Errorf("expected protected paths badge in\n%s", out)
+	}
+}
+
+func TestPRQueueView_ProtectedPathsBadge(t *testing.T) {
+	prRepo := &testPRRepo{files: []*models.DiffFile{{Filename: "infra/k8s/deploy.yaml"}}}
+	useCase := &mockFetchPRsUseCase{getRepositoryFunc: func() repository.PullRequestRepository { return prRepo }}
+	view := NewPRQueueViewWithUseCase(useCase, "owner", "repo")
+	view.SetProtectedPaths(testProtectedPaths)
+	view.Update(tea.WindowSizeMsg{Width: 160, Height: 30})
+
+	_, cmd := view.Update(prQueueLoadedMsg{prs: []*models.PullRequest{{Number: 3, Title: "Scale pods", State: models.PRStateOpen}}})
+	view.Update(protectedPathsMsg(t, cmd))
+
+	if out := view.View(); !strings.Contains(out, "⚠ infra/") {
+		t.Errorf("expected protected paths badge in\n%s", out)
+	}
+}
+
+func TestPRDetailView_MergeProtectedPathsNeedsSecondConfirmation(t *testing.T) {
+	pr := createTestPullRequest()
+	repo := &testPRRepo{pr: pr}
+	view := NewPRDetailView(pr, "owner", "repo", repo)
+	view.SetProtectedPaths(testProtectedPaths)
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	view.Update(prFilesLoadedMsg{files: []*models.DiffFile{
+		{Filename: ".github/workflows/release.yml"},
+		{Filename: "README.md"},
+	}})
+
+	if !strings.Contains(view.renderMetadata(), "⚠ .github/workflows/") {
+		t.Errorf("expected protected paths warning in metadata\n%s", view.renderMetadata())
+	}
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'m'}})
+	typeText(view, "merge")
+	if _, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil {
+		t.Fatal("expected a second confirmation before merging")
+	}
+	if !view.IsCapturingInput() || !strings.Contains(view.View(), ".github/workflows/release.yml") {
+		t.Fatalf("expected the protected files to be listed\n%s", view.View())
+	}
+
+	typeText(view, "protected")
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected merge command after both confirmations")
+	}
+	view.Update(cmd())
+	if repo.merge == nil || repo.merge.SHA != pr.Head.SHA || repo.merge.MergeMethod != "" {
+		t.Fatalf("expected the PR to be merged with the default method, got %+v", repo.merge)
+	}
+	if view.statusMessage != fmt.Sprintf("Merged #%d", pr.Number) {
+		t.Errorf("unexpected status message %q", view.statusMessage)
+	}
+}
+
+func TestPRDetailView_MergeWithoutProtectedPaths(t *testing.T) {
+	pr := createTestPullRequest()
+	repo := &testPRRepo{pr: pr}
+	view := NewPRDetailView(pr, "owner", "repo", repo)
+	view.SetProtectedPaths(testProtectedPaths)
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	view.Update(prFilesLoadedMsg{files: []*models.DiffFile{{Filename: "internal/app.go"}}})
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'m'}})
+	typeText(view, "merge")
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected a single confirmation to merge")
+	}
+	cmd()
+	if repo.merge == nil {
+		t.Error("expected the PR to be merged")
+	}
+}
+
+func TestPRDetailView_MergeEscCancels(t *testing.T) {
+	pr := createTestPullRequest()
+	repo := &testPRRepo{pr: pr}
+	view := NewPRDetailView(pr, "owner", "repo", repo)
+	view.Update(prFilesLoadedMsg{})
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'m'}})
+	view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if view.IsCapturingInput() || view.mergeStage != mergeStageNone {
+		t.Error("expected esc to cancel the merge")
+	}
+
+	// The review modal works normally afterwards
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	typeText(view, "approve")
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected approve to submit")
+	}
+	cmd()
+	if repo.merge != nil || repo.review == nil {
+		t.Errorf("expected a review and no merge, merge=%+v review=%+v", repo.merge, repo.review)
+	}
+}
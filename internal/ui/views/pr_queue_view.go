@@ -1,7 +1,6 @@
 package views
 
 import (
-	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -9,7 +8,9 @@ import (
 
 	"github.com/a1yama/tig-gh/internal/domain/models"
 	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/infra/clock"
 	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/events"
 	"github.com/a1yama/tig-gh/internal/ui/styles"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -23,9 +24,10 @@ type prQueueLoadedMsg struct {
 
 // prQueueReviewsLoadedMsg is sent after individual PR reviews are loaded.
 type prQueueReviewsLoadedMsg struct {
-	index   int
-	reviews []models.Review
-	err     error
+	index    int
+	reviews  []models.Review
+	requests []*models.PendingReviewRequest
+	err      error
 }
 
 // prQueueEntry keeps review metrics for a pull request in the queue.
@@ -34,6 +36,7 @@ type prQueueEntry struct {
 	reviews         []models.Review
 	firstReviewAt   *time.Time
 	firstApprovalAt *time.Time
+	pendingRequests []*models.PendingReviewRequest
 	reviewsLoaded   bool
 	reviewsErr      error
 }
@@ -60,13 +63,21 @@ type PRQueueView struct {
 	detailView    *PRDetailView
 
 	prRepo          repository.PullRequestRepository
+	commitRepo      repository.CommitRepository
 	reviewLoadIndex int
 	reviewLoading   bool
+
+	protectedPaths models.ProtectedPaths
+	freezeWindows  models.FreezeWindows
+	protectedHits  map[int][]string
+
+	loads loadGroup
 }
 
 // NewPRQueueView creates an empty queue view.
 func NewPRQueueView() *PRQueueView {
 	return &PRQueueView{
+		loads:         loadGroup{source: sourceReviewQueue},
 		entries:       []*prQueueEntry{},
 		cursor:        0,
 		statusBar:     components.NewStatusBar(),
@@ -100,19 +111,22 @@ func (m *PRQueueView) Init() tea.Cmd {
 }
 
 func (m *PRQueueView) fetchPRs() tea.Cmd {
+	ctx := m.loads.Restart()
 	return func() tea.Msg {
 		if m.fetchPRsUseCase == nil {
 			return prQueueLoadedMsg{prs: nil, err: fmt.Errorf("fetch PRs use case not initialized")}
 		}
 
-		opts := &models.PROptions{
-			State:     models.PRStateOpen,
-			Sort:      models.PRSortCreated,
-			Direction: models.SortDirectionAsc,
-			PerPage:   100,
-		}
-
-		prs, err := m.fetchPRsUseCase.Execute(context.Background(), m.owner, m.repo, opts)
+		prs, err := fetchPages(func(page, perPage int) ([]*models.PullRequest, error) {
+			opts := &models.PROptions{
+				State:     models.PRStateOpen,
+				Sort:      models.PRSortCreated,
+				Direction: models.SortDirectionAsc,
+				PerPage:   perPage,
+				Page:      page,
+			}
+			return m.fetchPRsUseCase.Execute(ctx, m.owner, m.repo, opts)
+		})
 		return prQueueLoadedMsg{prs: prs, err: err}
 	}
 }
@@ -125,30 +139,82 @@ func (m *PRQueueView) loadReviewsForEntry(index int) tea.Cmd {
 	owner := m.owner
 	repo := m.repo
 	number := entry.pr.Number
+	ctx := m.loads.Context()
 
 	return func() tea.Msg {
-		reviews, err := m.prRepo.ListReviews(context.Background(), owner, repo, number)
+		reviews, err := m.prRepo.ListReviews(ctx, owner, repo, number)
 		if err != nil {
 			return prQueueReviewsLoadedMsg{index: index, err: err}
 		}
-		return prQueueReviewsLoadedMsg{index: index, reviews: flattenReviews(reviews)}
+		msg := prQueueReviewsLoadedMsg{index: index, reviews: flattenReviews(reviews)}
+		// Only PRs waiting on requested reviewers need the timeline; without it
+		// the reviewers are shown without how long they have been requested
+		if len(entry.pr.RequestedReviewers) > 0 || len(entry.pr.RequestedTeams) > 0 {
+			msg.requests, _ = m.prRepo.ListPendingReviewRequests(ctx, owner, repo, number)
+		}
+		return msg
+	}
+}
+
+// applyEntityChanged replaces the PR of the matching queue entry.
+// Merged or closed PRs leave the queue since they no longer wait for review.
+func (m *PRQueueView) applyEntityChanged(event events.EntityChanged) {
+	if !event.Matches(m.owner, m.repo) {
+		return
+	}
+	number, ok := prDisplayNumber(event.PullRequest)
+	if !ok {
+		return
+	}
+
+	for i, entry := range m.entries {
+		if n, ok := prDisplayNumber(entry.pr); !ok || n != number {
+			continue
+		}
+		if event.PullRequest.Merged || event.PullRequest.State == models.PRStateClosed {
+			m.entries = append(m.entries[:i], m.entries[i+1:]...)
+			if m.cursor >= len(m.entries) && m.cursor > 0 {
+				m.cursor = len(m.entries) - 1
+			}
+			return
+		}
+		entry.pr = event.PullRequest
+		return
 	}
 }
 
 // Update handles Bubble Tea messages.
 func (m *PRQueueView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// A retry from the error banner reloads the list even while a detail view is open
+	if isRetryFor(msg, m) {
+		return m, m.refresh()
+	}
+
+	if event, ok := msg.(events.EntityChanged); ok {
+		m.applyEntityChanged(event)
+		if m.detailView != nil {
+			m.detailView.Update(event)
+		}
+		return m, nil
+	}
+
+	if loaded, ok := msg.(protectedPathsLoadedMsg); ok {
+		if strings.EqualFold(loaded.owner, m.owner) && strings.EqualFold(loaded.repo, m.repo) {
+			m.protectedHits = loaded.touched
+		}
+		return m, nil
+	}
+
 	if m.showingDetail && m.detailView != nil {
 		if _, isBack := msg.(backMsg); isBack {
-			m.showingDetail = false
-			m.detailView = nil
+			m.closeDetail()
 			return m, nil
 		}
 
-		if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if keyMsg, ok := msg.(tea.KeyMsg); ok && !m.detailView.IsCapturingInput() {
 			keyStr := keyMsg.String()
 			if keyStr == "q" || keyStr == "esc" {
-				m.showingDetail = false
-				m.detailView = nil
+				m.closeDetail()
 				return m, nil
 			}
 		}
@@ -163,12 +229,20 @@ func (m *PRQueueView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		return m.handleKeyPress(msg)
 
+	case openBrowserMsg:
+		m.statusBar.SetMessage(browserStatusMessage(msg))
+		return m, nil
+
 	case prQueueLoadedMsg:
+		if isCancelled(msg.err) {
+			// Cancelled with esc or replaced by a newer fetch
+			return m, nil
+		}
 		m.loading = false
 		if msg.err != nil {
 			m.err = msg.err
 			m.entries = []*prQueueEntry{}
-			return m, nil
+			return m, reportLoadError(m, "review queue", msg.err)
 		}
 		m.err = nil
 		m.entries = make([]*prQueueEntry, 0, len(msg.prs))
@@ -181,14 +255,18 @@ func (m *PRQueueView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		})
 		m.cursor = 0
 		m.reviewLoadIndex = 0
+		checkProtected := loadProtectedPaths(m.loads.Context(), m.prRepo, m.owner, m.repo, m.protectedPaths, msg.prs)
 		if m.prRepo != nil && len(m.entries) > 0 {
 			m.reviewLoading = true
-			return m, m.loadReviewsForEntry(0)
+			return m, tea.Batch(m.loadReviewsForEntry(0), checkProtected)
 		}
 		m.reviewLoading = false
-		return m, nil
+		return m, checkProtected
 
 	case prQueueReviewsLoadedMsg:
+		if isCancelled(msg.err) {
+			return m, nil
+		}
 		if msg.index < len(m.entries) {
 			entry := m.entries[msg.index]
 			entry.reviewsLoaded = true
@@ -197,6 +275,7 @@ func (m *PRQueueView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				entry.reviews = msg.reviews
 				entry.firstReviewAt = firstReviewSubmittedAt(entry.reviews)
 				entry.firstApprovalAt = firstApprovalSubmittedAt(entry.reviews)
+				entry.pendingRequests = msg.requests
 			}
 		}
 		m.reviewLoadIndex = msg.index + 1
@@ -219,20 +298,58 @@ func (m *PRQueueView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// SetProtectedPaths sets the path patterns whose changes need extra care.
+func (m *PRQueueView) SetProtectedPaths(patterns []string) {
+	m.protectedPaths = models.ProtectedPaths(patterns)
+}
+
+// SetFreezeWindows sets the merge freeze windows checked before merging
+func (m *PRQueueView) SetFreezeWindows(windows models.FreezeWindows) {
+	m.freezeWindows = windows
+}
+
+// SetCommitRepository sets the repository the diffs of the pull requests read files from
+func (m *PRQueueView) SetCommitRepository(repo repository.CommitRepository) {
+	m.commitRepo = repo
+}
+
+// closeDetail closes the detail view, cancelling its fetches
+func (m *PRQueueView) closeDetail() {
+	if m.detailView != nil {
+		m.detailView.Close()
+	}
+	m.showingDetail = false
+	m.detailView = nil
+}
+
+// refresh reloads the review queue unless a load is already running
+func (m *PRQueueView) refresh() tea.Cmd {
+	if m.loading || m.fetchPRsUseCase == nil {
+		return nil
+	}
+	m.loading = true
+	m.err = nil
+	return m.fetchPRs()
+}
+
 func (m *PRQueueView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "ctrl+c", "q":
 		return m, tea.Quit
+	case "esc":
+		// Stop loading the queue and its reviews
+		if m.loading || m.reviewLoading {
+			m.loads.Cancel()
+			m.loading = false
+			m.reviewLoading = false
+			m.statusBar.SetMessage(loadCancelledStatus)
+		}
+		return m, nil
 	case "?":
 		m.showHelp = !m.showHelp
 		return m, nil
 	case "r":
-		if !m.loading && m.fetchPRsUseCase != nil {
-			m.loading = true
-			m.err = nil
-			return m, m.fetchPRs()
-		}
-		return m, nil
+		return m, m.refresh()
 	case "j", "down":
 		if m.cursor < len(m.entries)-1 {
 			m.cursor++
@@ -243,6 +360,11 @@ func (m *PRQueueView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.cursor--
 		}
 		return m, nil
+	case "o":
+		if len(m.entries) > 0 && m.cursor < len(m.entries) {
+			return m, openInBrowser(m.entries[m.cursor].pr.HTMLURL)
+		}
+		return m, nil
 	case "g":
 		m.cursor = 0
 		return m, nil
@@ -257,6 +379,9 @@ func (m *PRQueueView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if len(m.entries) > 0 && m.cursor < len(m.entries) {
 			selected := m.entries[m.cursor].pr
 			m.detailView = NewPRDetailView(selected, m.owner, m.repo, m.prRepo)
+			m.detailView.SetProtectedPaths(m.protectedPaths)
+			m.detailView.SetFreezeWindows(m.freezeWindows)
+			m.detailView.SetCommitRepository(m.commitRepo)
 			m.detailView.width = m.width
 			m.detailView.height = m.height
 			m.showingDetail = true
@@ -280,6 +405,10 @@ func (m *PRQueueView) View() string {
 	var b strings.Builder
 	b.WriteString(m.renderHeader())
 	b.WriteString("\n")
+	if banner := renderFreezeBanner(m.freezeWindows); banner != "" {
+		b.WriteString(banner)
+		b.WriteString("\n")
+	}
 
 	if m.loading {
 		b.WriteString(m.renderLoading())
@@ -339,6 +468,9 @@ func (m *PRQueueView) renderQueueListWithReset(didReset bool) string {
 	if m.showHelp {
 		availableHeight -= 5
 	}
+	if renderFreezeBanner(m.freezeWindows) != "" {
+		availableHeight--
+	}
 
 	startIdx := 0
 	endIdx := len(m.entries)
@@ -384,10 +516,10 @@ func (m *PRQueueView) renderEntry(entry *prQueueEntry, index int) string {
 	selected := m.cursor == index
 	cursor := "  "
 	if selected {
-		cursor = styles.CursorStyle.Render("▶ ")
+		cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
 	}
 
-	now := time.Now()
+	now := clock.Now()
 	waitingDuration := now.Sub(entry.pr.CreatedAt)
 	waitingStyle := waitingDurationStyle(waitingDuration)
 	waitingLabel := waitingStyle.Render(formatDurationShort(waitingDuration))
@@ -404,7 +536,11 @@ func (m *PRQueueView) renderEntry(entry *prQueueEntry, index int) string {
 		title = styles.IssueTitleStyle.Render(titleText)
 	}
 	author := styles.AuthorStyle.Render(formatAuthorHandle(entry.pr.Author))
-	line := lipgloss.JoinHorizontal(lipgloss.Top, waitingLabel, " • ", author, " • ", title)
+	line := lipgloss.JoinHorizontal(lipgloss.Top, waitingLabel, " • ", author, " • ", title, renderProtectedPathsBadge(m.protectedHits[prNum]))
+	if len(entry.pr.RequestedReviewers) > 0 || len(entry.pr.RequestedTeams) > 0 {
+		requested := renderRequestedReviewers(entry.pr, entry.pendingRequests, now)
+		line = lipgloss.JoinHorizontal(lipgloss.Top, line, " • ", styles.MutedStyle.Render("waiting on "), requested)
+	}
 
 	var entryStyle lipgloss.Style
 	if selected {
@@ -463,6 +599,7 @@ func (m *PRQueueView) renderHelp() string {
 	helpItems := []string{
 		styles.FormatKeyBinding("j/k", "navigate"),
 		styles.FormatKeyBinding("enter", "open PR"),
+		styles.FormatKeyBinding("o", "browser"),
 		styles.FormatKeyBinding("r", "refresh"),
 		styles.FormatKeyBinding("?", "help"),
 	}
@@ -493,3 +630,13 @@ func (m *PRQueueView) updateStatusBar() {
 		m.statusBar.SetMessage("")
 	}
 }
+
+// IsShowingDetail returns true while a detail view is open
+func (m *PRQueueView) IsShowingDetail() bool {
+	return m.showingDetail && m.detailView != nil
+}
+
+// IsCapturingInput returns true while the open detail view is taking text input
+func (m *PRQueueView) IsCapturingInput() bool {
+	return m.IsShowingDetail() && m.detailView.IsCapturingInput()
+}
@@ -3,11 +3,13 @@ package views
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/a1yama/tig-gh/internal/domain/models"
 	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/infra/clock"
 	"github.com/a1yama/tig-gh/internal/ui/styles"
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -105,6 +107,36 @@ func TestPRQueueView_UpdateReviewsLoaded_SetsMetrics(t *testing.T) {
 	}
 }
 
+func TestPRQueueView_ShowsReviewRequestAge(t *testing.T) {
+	now := time.Date(2024, time.May, 10, 12, 0, 0, 0, time.UTC)
+	t.Cleanup(clock.Set(clock.Fixed(now)))
+
+	pr := &models.PullRequest{
+		Number:             1,
+		Title:              "Example",
+		CreatedAt:          now.Add(-30 * 24 * time.Hour),
+		RequestedReviewers: []models.User{{Login: "bob"}},
+		RequestedTeams:     []models.Team{{Org: "acme", Slug: "core"}},
+	}
+	repo := &testPRRepo{pending: []*models.PendingReviewRequest{
+		{Reviewer: "bob", RequestedAt: now.Add(-51 * time.Hour)},
+	}}
+	view := NewPRQueueView()
+	view.owner, view.repo, view.prRepo = "owner", "repo", repo
+	view.entries = []*prQueueEntry{{pr: pr}}
+
+	view.Update(view.loadReviewsForEntry(0)())
+
+	// The request age is shown per reviewer, apart from the PR's own age;
+	// requests missing from the timeline are listed without one
+	row := view.renderEntry(view.entries[0], 0)
+	for _, want := range []string{"30d", "waiting on", "@bob 2d 3h", "@acme/core"} {
+		if !strings.Contains(row, want) {
+			t.Errorf("expected %q in row %q", want, row)
+		}
+	}
+}
+
 func TestPRQueueView_handleKeyPress_EnterOpensDetail(t *testing.T) {
 	view := NewPRQueueView()
 	view.owner = "owner"
@@ -228,14 +260,35 @@ func TestPRQueueView_resetsCursorWhenOutOfRange(t *testing.T) {
 }
 
 // testPRRepo is a minimal pull request repository used for tests.
-type testPRRepo struct{}
+type testPRRepo struct {
+	pr        *models.PullRequest
+	review    *models.CreateReviewInput
+	labels    []string
+	threads   []*models.ReviewThread
+	draft     *bool
+	files     []*models.DiffFile
+	reqs      *models.MergeRequirements
+	merge     *models.MergeOptions
+	linked    []*models.LinkedIssue
+	forCommit []*models.PullRequest
+	reviewers *models.ReviewerCandidates
+	owners    models.CodeOwners
+	approvals *models.Approvals
+	requested *models.ReviewRequest
+	changes   *models.ChangesSinceReview
+	pending   []*models.PendingReviewRequest
+	readiness map[int]*models.MergeReadiness
+	conflicts *models.ConflictFiles
+	updated   *string                 // expected head SHA of the last branch update
+	suggested *models.SuggestionInput // last committed suggestion
+}
 
 func (r *testPRRepo) List(ctx context.Context, owner, repo string, opts *models.PROptions) ([]*models.PullRequest, error) {
 	return nil, nil
 }
 
 func (r *testPRRepo) Get(ctx context.Context, owner, repo string, number int) (*models.PullRequest, error) {
-	return nil, nil
+	return r.pr, nil
 }
 
 func (r *testPRRepo) Create(ctx context.Context, owner, repo string, input *models.CreatePRInput) (*models.PullRequest, error) {
@@ -247,6 +300,7 @@ func (r *testPRRepo) Update(ctx context.Context, owner, repo string, number int,
 }
 
 func (r *testPRRepo) Merge(ctx context.Context, owner, repo string, number int, opts *models.MergeOptions) error {
+	r.merge = opts
 	return nil
 }
 
@@ -274,4 +328,106 @@ func (r *testPRRepo) ListComments(ctx context.Context, owner, repo string, numbe
 	return []*models.Comment{}, nil
 }
 
+func (r *testPRRepo) ListReviewThreads(ctx context.Context, owner, repo string, number int) ([]*models.ReviewThread, error) {
+	return r.threads, nil
+}
+
+func (r *testPRRepo) ListLinkedIssues(ctx context.Context, owner, repo string, number int) ([]*models.LinkedIssue, error) {
+	return r.linked, nil
+}
+
+func (r *testPRRepo) ListForCommit(ctx context.Context, owner, repo, sha string) ([]*models.PullRequest, error) {
+	return r.forCommit, nil
+}
+
+func (r *testPRRepo) SetLabels(ctx context.Context, owner, repo string, number int, labels []string) ([]models.Label, error) {
+	r.labels = labels
+	result := make([]models.Label, 0, len(labels))
+	for _, name := range labels {
+		result = append(result, models.Label{Name: name})
+	}
+	return result, nil
+}
+
+func (r *testPRRepo) CreateReview(ctx context.Context, owner, repo string, number int, input *models.CreateReviewInput) (*models.Review, error) {
+	r.review = input
+	return &models.Review{Body: input.Body}, nil
+}
+
+func (r *testPRRepo) ListFiles(ctx context.Context, owner, repo string, number int) ([]*models.DiffFile, error) {
+	return r.files, nil
+}
+
+func (r *testPRRepo) ListFilesPage(ctx context.Context, owner, repo string, number, page, perPage int) ([]*models.DiffFile, error) {
+	start := min((page-1)*perPage, len(r.files))
+	return r.files[start:min(start+perPage, len(r.files))], nil
+}
+
+func (r *testPRRepo) ListMergeReadiness(ctx context.Context, owner, repo string, numbers []int) (map[int]*models.MergeReadiness, error) {
+	return r.readiness, nil
+}
+
+func (r *testPRRepo) ListConflictFiles(ctx context.Context, owner, repo string, number int) (*models.ConflictFiles, error) {
+	return r.conflicts, nil
+}
+
+func (r *testPRRepo) UpdateBranch(ctx context.Context, owner, repo string, number int, expectedHeadSHA string) error {
+	r.updated = &expectedHeadSHA
+	return nil
+}
+
+func (r *testPRRepo) CommitSuggestion(ctx context.Context, owner, repo string, number int, input *models.SuggestionInput) error {
+	r.suggested = input
+	return nil
+}
+
+func (r *testPRRepo) GetMergeRequirements(ctx context.Context, owner, repo string, number int) (*models.MergeRequirements, error) {
+	return r.reqs, nil
+}
+
+func (r *testPRRepo) ConvertDraft(ctx context.Context, owner, repo string, number int, draft bool) (*models.PullRequest, error) {
+	r.draft = &draft
+	pr := *r.pr
+	pr.Draft = draft
+	return &pr, nil
+}
+
+func (r *testPRRepo) ListReviewerCandidates(ctx context.Context, owner, repo string) (*models.ReviewerCandidates, error) {
+	if r.reviewers == nil {
+		return &models.ReviewerCandidates{}, nil
+	}
+	return r.reviewers, nil
+}
+
+func (r *testPRRepo) GetCodeOwners(ctx context.Context, owner, repo, ref string) (models.CodeOwners, error) {
+	return r.owners, nil
+}
+
+func (r *testPRRepo) ListApprovals(ctx context.Context, owner, repo string, number int) (*models.Approvals, error) {
+	return r.approvals, nil
+}
+
+func (r *testPRRepo) GetChangesSinceReview(ctx context.Context, owner, repo string, number int) (*models.ChangesSinceReview, error) {
+	if r.changes == nil {
+		return &models.ChangesSinceReview{}, nil
+	}
+	return r.changes, nil
+}
+
+func (r *testPRRepo) ListPendingReviewRequests(ctx context.Context, owner, repo string, number int) ([]*models.PendingReviewRequest, error) {
+	return r.pending, nil
+}
+
+func (r *testPRRepo) RequestReviewers(ctx context.Context, owner, repo string, number int, request *models.ReviewRequest) (*models.PullRequest, error) {
+	r.requested = request
+	pr := *r.pr
+	for _, login := range request.Users {
+		pr.RequestedReviewers = append(pr.RequestedReviewers, models.User{Login: login})
+	}
+	for _, slug := range request.Teams {
+		pr.RequestedTeams = append(pr.RequestedTeams, models.Team{Org: owner, Slug: slug})
+	}
+	return &pr, nil
+}
+
 var _ repository.PullRequestRepository = (*testPRRepo)(nil)
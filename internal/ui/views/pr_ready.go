@@ -0,0 +1,78 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// prReadyLoadedMsg is sent when the readiness of listed PRs is loaded. ready
+// holds the numbers of the PRs that are approved, green and free of
+// conflicts; the others, and all of them when the check failed, are left out.
+type prReadyLoadedMsg struct {
+	owner string
+	repo  string
+	ready map[int]bool
+}
+
+// loadReadyToMerge asks which of the PRs can be merged now
+func loadReadyToMerge(ctx context.Context, prRepo repository.PullRequestRepository, owner, repo string, numbers []int) tea.Cmd {
+	if prRepo == nil || len(numbers) == 0 {
+		return nil
+	}
+	return func() tea.Msg {
+		ready := make(map[int]bool)
+		readiness, err := prRepo.ListMergeReadiness(ctx, owner, repo, numbers)
+		if err == nil {
+			for number, r := range readiness {
+				if r != nil && r.Ready() {
+					ready[number] = true
+				}
+			}
+		}
+		return prReadyLoadedMsg{owner: owner, repo: repo, ready: ready}
+	}
+}
+
+// mayBeReady reports whether pr is open and not a draft; only those can be
+// ready to merge
+func mayBeReady(pr *models.PullRequest) bool {
+	return pr != nil && pr.Number > 0 && pr.State == models.PRStateOpen && !pr.Merged && !pr.Draft
+}
+
+// openPRNumbers returns the numbers of the PRs that may be ready to merge
+func openPRNumbers(prs []*models.PullRequest) []int {
+	var numbers []int
+	for _, pr := range prs {
+		if mayBeReady(pr) {
+			numbers = append(numbers, pr.Number)
+		}
+	}
+	return numbers
+}
+
+// promoteReady moves the PRs ready to merge to the top, keeping the order
+// within both groups, and returns how many there are
+func promoteReady(prs []*models.PullRequest, ready map[int]bool) int {
+	isReady := func(pr *models.PullRequest) bool {
+		return mayBeReady(pr) && ready[pr.Number]
+	}
+	sort.SliceStable(prs, func(i, j int) bool {
+		return isReady(prs[i]) && !isReady(prs[j])
+	})
+	count := 0
+	for count < len(prs) && isReady(prs[count]) {
+		count++
+	}
+	return count
+}
+
+// renderReadyHeader renders the title of the section of PRs ready to merge
+func renderReadyHeader(count int) string {
+	return styles.SuccessStyle.Render(fmt.Sprintf("%s Ready to merge (%d)", styles.IconCheck+styles.IconCheck, count))
+}
@@ -0,0 +1,82 @@
+package views
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestPRView_PromotesReadyToMerge(t *testing.T) {
+	prRepo := &testPRRepo{readiness: map[int]*models.MergeReadiness{
+		1: {Mergeable: "MERGEABLE", ReviewDecision: "APPROVED", ChecksState: models.CheckStateSuccess},
+		2: {Mergeable: "CONFLICTING", ReviewDecision: "APPROVED", ChecksState: models.CheckStateSuccess},
+		3: {Mergeable: "MERGEABLE", Approvals: 1},
+	}}
+	view := NewPRViewWithUseCase(&mockFetchPRsUseCase{
+		getRepositoryFunc: func() repository.PullRequestRepository { return prRepo },
+	}, "owner", "repo")
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	view.Update(prsLoadedMsg{prs: []*models.PullRequest{
+		{Number: 3, Title: "Approved without required reviews", State: models.PRStateOpen, UpdatedAt: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{Number: 2, Title: "Conflicting", State: models.PRStateOpen, UpdatedAt: time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC)},
+		{Number: 1, Title: "Ready", State: models.PRStateOpen, UpdatedAt: time.Date(2026, 10, 3, 0, 0, 0, 0, time.UTC)},
+	}})
+	view.cursor = 1 // #2
+
+	view.Update(view.checkReadyToMerge()())
+	if view.readyCount != 2 || view.prs[0].Number != 1 || view.prs[1].Number != 3 || view.prs[2].Number != 2 {
+		t.Fatalf("expected #1 and #3 promoted, got %d ready: #%d #%d #%d", view.readyCount, view.prs[0].Number, view.prs[1].Number, view.prs[2].Number)
+	}
+	if view.prs[view.cursor].Number != 2 {
+		t.Errorf("expected the cursor to stay on #2, got #%d", view.prs[view.cursor].Number)
+	}
+	out := view.View()
+	if !strings.Contains(out, "Ready to merge (2)") || !strings.Contains(out, "Other pull requests") {
+		t.Errorf("expected the ready section, got:\n%s", out)
+	}
+	if strings.Index(out, "Approved without required reviews") > strings.Index(out, "Other pull requests") {
+		t.Error("expected #3 above the other pull requests")
+	}
+}
+
+func TestMyWorkView_PromotesReadyToMerge(t *testing.T) {
+	uc := &stubMyWork{work: &models.MyWork{Sections: []models.MyWorkSection{
+		{Category: models.MyWorkAuthored, Title: "My pull requests", TotalCount: 2, Items: []models.SearchResult{
+			{Type: models.SearchTypePR, Repository: "acme/api", PullRequest: &models.PullRequest{Number: 7, Title: "Waiting on CI", State: models.PRStateOpen, HTMLURL: "https://github.com/acme/api/pull/7"}},
+			{Type: models.SearchTypePR, Repository: "acme/api", PullRequest: &models.PullRequest{Number: 8, Title: "Green and approved", State: models.PRStateOpen, HTMLURL: "https://github.com/acme/api/pull/8"}},
+		}},
+		{Category: models.MyWorkReviewRequested, Title: "Review requested", TotalCount: 1, Items: []models.SearchResult{
+			{Type: models.SearchTypePR, Repository: "acme/api", PullRequest: &models.PullRequest{Number: 9, Title: "Someone else's", State: models.PRStateOpen}},
+		}},
+	}}}
+	view := NewMyWorkViewWithUseCase(uc, "owner", "repo", nil)
+	view.SetRepositories(nil, &testPRRepo{readiness: map[int]*models.MergeReadiness{
+		7: {Mergeable: "MERGEABLE", ReviewDecision: "APPROVED", ChecksState: models.CheckStatePending},
+		8: {Mergeable: "MERGEABLE", ReviewDecision: "APPROVED", ChecksState: models.CheckStateSuccess},
+		9: {Mergeable: "MERGEABLE", ReviewDecision: "APPROVED"},
+	}})
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	_, cmd := view.Update(view.Init()())
+	if cmd == nil {
+		t.Fatal("expected the readiness of the user's pull requests to be checked")
+	}
+	view.cursor = 0 // #7
+	view.Update(cmd())
+
+	if len(view.items) != 3 || view.items[0].section != myWorkReadySection || view.items[0].result.PullRequest.Number != 8 {
+		t.Fatalf("expected #8 promoted to the ready section, got %+v", view.items)
+	}
+	if view.items[2].section == myWorkReadySection {
+		t.Error("expected review requests not to be promoted")
+	}
+	if view.selectedItem().result.PullRequest.Number != 7 {
+		t.Errorf("expected the cursor to stay on #7, got #%d", view.selectedItem().result.PullRequest.Number)
+	}
+	if out := view.View(); !strings.Contains(out, "Ready to merge (1)") {
+		t.Errorf("expected the ready section, got:\n%s", out)
+	}
+}
@@ -21,19 +21,19 @@ func renderReviewSummary(reviews []models.Review) string {
 
 	if count := reviewCounts[models.ReviewStateApproved]; count > 0 {
 		summary = append(summary, lipgloss.NewStyle().
-			Foreground(lipgloss.Color("35")).
-			Render(fmt.Sprintf("✓%d", count)))
+			Foreground(styles.ColorPositive).
+			Render(fmt.Sprintf("%s%d", styles.IconCheck, count)))
 	}
 
 	if count := reviewCounts[models.ReviewStateChangesRequested]; count > 0 {
 		summary = append(summary, lipgloss.NewStyle().
-			Foreground(lipgloss.Color("196")).
-			Render(fmt.Sprintf("✗%d", count)))
+			Foreground(styles.ColorNegative).
+			Render(fmt.Sprintf("%s%d", styles.IconCross, count)))
 	}
 
 	if count := reviewCounts[models.ReviewStatePending]; count > 0 {
 		summary = append(summary, lipgloss.NewStyle().
-			Foreground(lipgloss.Color("220")).
+			Foreground(styles.ColorPending).
 			Render(fmt.Sprintf("?%d", count)))
 	}
 
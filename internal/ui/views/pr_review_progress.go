@@ -0,0 +1,67 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// prViewedLoadedMsg is a message when the files marked as viewed are read
+type prViewedLoadedMsg struct {
+	viewed *models.ViewedFiles
+}
+
+// loadViewedFiles reads the files of the PR marked as viewed in the diff view
+func (m *PRDetailView) loadViewedFiles() tea.Cmd {
+	store := viewedFilesStore()
+	if store == nil {
+		return nil
+	}
+	owner, repo, number := m.owner, m.repo, m.pr.Number
+	return func() tea.Msg {
+		viewed, err := store.Load(owner, repo, number)
+		if err != nil {
+			// Without a readable record nothing counts as viewed
+			return prViewedLoadedMsg{}
+		}
+		return prViewedLoadedMsg{viewed: viewed}
+	}
+}
+
+// pendingReviewComments counts the comments of the review not submitted yet
+func pendingReviewComments(threads []*models.ReviewThread) int {
+	count := 0
+	for _, thread := range threads {
+		for _, comment := range thread.Comments {
+			if comment.Pending {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// renderReviewProgress shows how far the review has come, e.g. "12/30 files
+// viewed, 3 pending comments". Files count as viewed until a commit changes them.
+func (m *PRDetailView) renderReviewProgress() string {
+	if m.filesLoading || len(m.files) == 0 {
+		return ""
+	}
+
+	viewed := countViewed(m.viewedFiles, m.files)
+	text := fmt.Sprintf("%d/%d files viewed", viewed, len(m.files))
+	switch pending := pendingReviewComments(m.threads); pending {
+	case 0:
+	case 1:
+		text += ", 1 pending comment"
+	default:
+		text += fmt.Sprintf(", %d pending comments", pending)
+	}
+
+	if viewed == len(m.files) {
+		return styles.SuccessStyle.Render(styles.IconCheck + " " + text)
+	}
+	return styles.MutedStyle.Render(text)
+}
@@ -0,0 +1,75 @@
+package views
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestPatchFingerprintMatchesTheDiffView(t *testing.T) {
+	files := parseDiff(twoFileDiff("import \"fmt\""))
+	patch := "@@ -1,2 +1,3 @@\n package main\n+import \"fmt\"\n func main() {}"
+
+	if got, want := patchFingerprint("main.go", patch), diffFingerprint(files[0]); got != want {
+		t.Errorf("patchFingerprint = %s, want the fingerprint of the full diff %s", got, want)
+	}
+}
+
+func TestPRDetailView_ReviewProgress(t *testing.T) {
+	files := parseDiff(twoFileDiff("import \"fmt\""))
+	view := NewPRDetailView(createTestPullRequest(), "owner", "repo", nil)
+	view.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+
+	view.Update(prViewedLoadedMsg{viewed: &models.ViewedFiles{Files: map[string]string{
+		"main.go": diffFingerprint(files[0]),
+		// Marked before a commit changed it
+		"util.go": "stale",
+	}}})
+	view.Update(prFilesLoadedMsg{files: []*models.DiffFile{
+		{Filename: "main.go", Patch: "@@ -1,2 +1,3 @@\n package main\n+import \"fmt\"\n func main() {}"},
+		{Filename: "util.go", Patch: "@@ -1,2 +1,2 @@\n package main\n-func old() {}\n+func helper() {}"},
+	}})
+	view.Update(prThreadsLoadedMsg{threads: []*models.ReviewThread{
+		{Path: "main.go", Comments: []*models.Comment{{Body: "submitted"}, {Body: "draft", Pending: true}}},
+		{Path: "util.go", Comments: []*models.Comment{{Body: "draft too", Pending: true}}},
+	}})
+
+	if out := view.View(); !strings.Contains(out, "1/2 files viewed, 2 pending comments") {
+		t.Errorf("expected the review progress in the header\n%s", out)
+	}
+}
+
+func TestPRDetailView_ReviewProgressHiddenWithoutFiles(t *testing.T) {
+	view := NewPRDetailView(createTestPullRequest(), "owner", "repo", nil)
+	view.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+
+	if out := view.View(); strings.Contains(out, "files viewed") {
+		t.Errorf("expected no progress before the files are loaded\n%s", out)
+	}
+}
+
+func TestPRDetailView_ReviewProgressCountsFilesMarkedInTheDiff(t *testing.T) {
+	store := &memoryViewedStore{records: map[string]*models.ViewedFiles{}}
+	SetViewedFilesStore(store)
+	defer SetViewedFilesStore(nil)
+
+	files := []*models.DiffFile{
+		{Filename: "main.go", Status: models.FileStatusModified, Changes: 1, Patch: "@@ -1,2 +1,3 @@\n package main\n+import \"fmt\"\n func main() {}"},
+		{Filename: "util.go", Status: models.FileStatusModified, Changes: 2, Patch: "@@ -1,2 +1,2 @@\n package main\n-func old() {}\n+func helper() {}"},
+	}
+	view := diffDetailView(&testPRRepo{files: files})
+	view.Update(prFilesLoadedMsg{files: files})
+	if out := view.View(); !strings.Contains(out, "0/2 files viewed") {
+		t.Fatalf("expected nothing viewed yet\n%s", out)
+	}
+
+	view.Update(press(view, "d")())
+	view.Update(press(view, "v")())
+	view.Update(press(view, "q")())
+
+	if out := view.View(); !strings.Contains(out, "1/2 files viewed") {
+		t.Errorf("expected the file marked in the diff to count as viewed\n%s", out)
+	}
+}
@@ -0,0 +1,58 @@
+package views
+
+import (
+	"strings"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// prPendingRequestsLoadedMsg carries when the waiting review requests of a PR were made
+type prPendingRequestsLoadedMsg struct {
+	requests []*models.PendingReviewRequest
+	err      error
+}
+
+// loadPendingRequests loads when the PR's waiting review requests were made
+func (m *PRDetailView) loadPendingRequests() tea.Cmd {
+	if m.prRepo == nil {
+		return nil
+	}
+	ctx := m.loads.Context()
+	repo, owner, name, number := m.prRepo, m.owner, m.repo, m.pr.Number
+	return func() tea.Msg {
+		requests, err := repo.ListPendingReviewRequests(ctx, owner, name, number)
+		return prPendingRequestsLoadedMsg{requests: requests, err: err}
+	}
+}
+
+// renderRequestedReviewers renders the reviewers a pull request waits on with
+// how long each request has been pending, e.g. "@alice 2d 3h, @org/team 5h".
+// That is more accurate than the PR's age for review SLAs, as reviewers are
+// often requested later. Requests not in pending (not loaded, or made before
+// the fetched timeline) are shown without an age.
+func renderRequestedReviewers(pr *models.PullRequest, pending []*models.PendingReviewRequest, now time.Time) string {
+	requestedAt := make(map[string]time.Time, len(pending))
+	for _, request := range pending {
+		requestedAt[strings.ToLower(request.Reviewer)] = request.RequestedAt
+	}
+
+	var parts []string
+	add := func(handle, key string) {
+		part := styles.AuthorStyle.Render(handle)
+		if at, ok := requestedAt[strings.ToLower(key)]; ok {
+			age := now.Sub(at)
+			part += " " + waitingDurationStyle(age).Render(formatDurationShort(age))
+		}
+		parts = append(parts, part)
+	}
+	for _, user := range pr.RequestedReviewers {
+		add(formatAuthorHandle(user), user.Login)
+	}
+	for _, team := range pr.RequestedTeams {
+		add("@"+team.Key(), team.Key())
+	}
+	return strings.Join(parts, ", ")
+}
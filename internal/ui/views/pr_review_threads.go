@@ -0,0 +1,135 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// setThreads stores review threads; resolved threads start collapsed
+func (m *PRDetailView) setThreads(threads []*models.ReviewThread) {
+	m.threads = threads
+	for _, thread := range threads {
+		if _, seen := m.collapsed[thread.ID]; !seen {
+			m.collapsed[thread.ID] = thread.IsResolved
+		}
+	}
+	if m.selectedThread >= len(threads) {
+		m.selectedThread = len(threads) - 1
+	}
+	if m.selectedThread < 0 {
+		m.selectedThread = 0
+	}
+}
+
+// toggleAllThreads expands every thread, or collapses all if none is collapsed
+func (m *PRDetailView) toggleAllThreads() {
+	anyCollapsed := false
+	for _, thread := range m.threads {
+		if m.collapsed[thread.ID] {
+			anyCollapsed = true
+			break
+		}
+	}
+	for _, thread := range m.threads {
+		m.collapsed[thread.ID] = !anyCollapsed
+	}
+}
+
+// renderReviewThreads renders review comment threads grouped by file and line
+func (m *PRDetailView) renderReviewThreads() string {
+	var s strings.Builder
+
+	unresolved := 0
+	for _, thread := range m.threads {
+		if !thread.IsResolved {
+			unresolved++
+		}
+	}
+	s.WriteString(styles.BoldStyle.Render(fmt.Sprintf("Review threads (%d, %d unresolved)", len(m.threads), unresolved)))
+	s.WriteString("\n\n")
+
+	switch {
+	case isGuest(m.prRepo):
+		s.WriteString(styles.MutedStyle.Render(fmt.Sprintf(signInHint, "review threads")))
+		return s.String()
+	case m.threadsLoading:
+		s.WriteString(styles.MutedStyle.Render("Loading review threads..."))
+		return s.String()
+	case m.threadsErr != nil:
+		s.WriteString(styles.ErrorStyle.Render(fmt.Sprintf("Failed to load review threads: %v", m.threadsErr)))
+		return s.String()
+	case len(m.threads) == 0:
+		s.WriteString(styles.MutedStyle.Render("No review threads."))
+		return s.String()
+	}
+
+	for i, thread := range m.threads {
+		s.WriteString(m.renderThreadHeader(thread, i == m.selectedThread))
+		s.WriteString("\n")
+
+		if m.collapsed[thread.ID] {
+			// Collapsed threads show only the first line of the opening comment
+			if len(thread.Comments) > 0 {
+				first := thread.Comments[0]
+				s.WriteString("    ")
+				s.WriteString(styles.MutedStyle.Render(fmt.Sprintf("%s: %s", first.User.Login, firstLine(first.Body))))
+				s.WriteString("\n")
+			}
+			s.WriteString("\n")
+			continue
+		}
+
+		for _, comment := range thread.Comments {
+			author := styles.BoldStyle.Render(comment.User.Login)
+			timeStr := styles.MutedStyle.Render(formatTime(comment.CreatedAt))
+			s.WriteString(fmt.Sprintf("    %s %s\n", author, timeStr))
+			s.WriteString(renderCommentBody(comment.Body, "    "))
+			s.WriteString("\n")
+		}
+	}
+
+	return s.String()
+}
+
+// renderThreadHeader renders the file/line anchor and state of a thread
+func (m *PRDetailView) renderThreadHeader(thread *models.ReviewThread, selected bool) string {
+	cursor := "  "
+	if selected {
+		cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
+	}
+
+	toggle := styles.IconExpanded
+	if m.collapsed[thread.ID] {
+		toggle = styles.IconCollapsed
+	}
+
+	anchor := thread.Path
+	if thread.Line > 0 {
+		anchor = fmt.Sprintf("%s:%d", thread.Path, thread.Line)
+	}
+
+	parts := []string{cursor + toggle + " " + styles.BoldStyle.Render(anchor)}
+	if thread.IsResolved {
+		parts = append(parts, lipgloss.NewStyle().Foreground(styles.ColorPositive).Render(styles.IconCheck+" Resolved"))
+	}
+	if thread.IsOutdated {
+		parts = append(parts, styles.MutedStyle.Render("Outdated"))
+	}
+	parts = append(parts, styles.MutedStyle.Render(fmt.Sprintf("(%d comments)", len(thread.Comments))))
+
+	return strings.Join(parts, " ")
+}
+
+// firstLine returns the first non-empty line of a text
+func firstLine(text string) string {
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
@@ -0,0 +1,78 @@
+package views
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func sampleThreads() []*models.ReviewThread {
+	return []*models.ReviewThread{
+		{
+			ID:   "T1",
+			Path: "main.go",
+			Line: 12,
+			Comments: []*models.Comment{
+				{User: models.User{Login: "alice"}, Body: "Should this be exported?"},
+				{User: models.User{Login: "bob"}, Body: "Good point, fixed."},
+			},
+		},
+		{
+			ID:         "T2",
+			Path:       "README.md",
+			Line:       3,
+			IsResolved: true,
+			Comments: []*models.Comment{
+				{User: models.User{Login: "carol"}, Body: "Typo here\nand here"},
+			},
+		},
+	}
+}
+
+func TestPRDetailView_ReviewThreadsGroupedAndCollapsed(t *testing.T) {
+	pr := createTestPullRequest()
+	view := NewPRDetailView(pr, "owner", "repo", &testPRRepo{pr: pr})
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 80})
+	view.Update(prCommentsLoadedMsg{comments: []*models.Comment{{User: models.User{Login: "dave"}, Body: "LGTM overall"}}})
+	view.Update(prThreadsLoadedMsg{threads: sampleThreads()})
+	view.currentTab = tabComments
+
+	out := view.renderCommentsTab()
+	for _, want := range []string{"Conversation (1)", "Review threads (2, 1 unresolved)", "main.go:12", "README.md:3", "✓ Resolved", "Good point, fixed."} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in comments tab, got:\n%s", want, out)
+		}
+	}
+	// Resolved threads start collapsed, showing only the opening line
+	if strings.Contains(out, "and here") {
+		t.Error("expected resolved thread to be collapsed")
+	}
+
+	// Expand the resolved thread
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if out := view.renderCommentsTab(); !strings.Contains(out, "and here") {
+		t.Error("expected resolved thread to expand")
+	}
+
+	// Collapse all
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("E")})
+	if out := view.renderCommentsTab(); strings.Contains(out, "Good point, fixed.") {
+		t.Error("expected all threads to collapse")
+	}
+}
+
+func TestPRDetailView_ThreadStateSurvivesReload(t *testing.T) {
+	pr := createTestPullRequest()
+	view := NewPRDetailView(pr, "owner", "repo", &testPRRepo{pr: pr})
+
+	view.Update(prThreadsLoadedMsg{threads: sampleThreads()})
+	view.collapsed["T1"] = true
+	view.Update(prThreadsLoadedMsg{threads: sampleThreads()})
+
+	if !view.collapsed["T1"] {
+		t.Error("expected collapsed state to be kept across reloads")
+	}
+}
@@ -0,0 +1,387 @@
+package views
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/events"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// reviewerCandidate is a user or team reviews can be requested from
+type reviewerCandidate struct {
+	// key is the login of a user or "org/slug" of a team
+	key       string
+	name      string // team name
+	team      bool
+	codeOwner bool // owns files the PR changes
+	requested bool // review already requested
+}
+
+// label returns how the candidate is listed, e.g. "@alice" or "@octo/backend (Backend)"
+func (c reviewerCandidate) label() string {
+	if c.team && c.name != "" && !strings.EqualFold(c.name, c.key[strings.Index(c.key, "/")+1:]) {
+		return fmt.Sprintf("@%s (%s)", c.key, c.name)
+	}
+	return "@" + c.key
+}
+
+// reviewerPicker is the state of the reviewer selection
+type reviewerPicker struct {
+	active     bool
+	loading    bool
+	err        error
+	candidates []reviewerCandidate
+	selected   map[string]bool
+	cursor     int
+	filter     string
+	filtering  bool
+}
+
+// visible returns the candidates matching the filter
+func (p *reviewerPicker) visible() []reviewerCandidate {
+	if p.filter == "" {
+		return p.candidates
+	}
+	filter := strings.ToLower(p.filter)
+	var matched []reviewerCandidate
+	for _, c := range p.candidates {
+		if strings.Contains(strings.ToLower(c.key), filter) || strings.Contains(strings.ToLower(c.name), filter) {
+			matched = append(matched, c)
+		}
+	}
+	return matched
+}
+
+// reviewerCandidatesLoadedMsg carries who can review, with the code owners marked
+type reviewerCandidatesLoadedMsg struct {
+	candidates []reviewerCandidate
+	err        error
+}
+
+// reviewersRequestedMsg is sent when review requests were sent
+type reviewersRequestedMsg struct {
+	pr       *models.PullRequest
+	reviewer []string
+	err      error
+}
+
+// openReviewerPicker shows the reviewer selection and loads the candidates
+func (m *PRDetailView) openReviewerPicker() tea.Cmd {
+	if m.prRepo == nil || m.reviewers.active {
+		return nil
+	}
+	if !canWrite(m.prRepo) {
+		m.statusMessage = readOnlyStatus
+		return nil
+	}
+	if m.pr.Merged || m.pr.State == models.PRStateClosed {
+		m.statusMessage = "Cannot request reviews on a closed pull request"
+		return nil
+	}
+
+	m.reviewers = reviewerPicker{active: true, loading: true, selected: map[string]bool{}}
+	ctx := m.loads.Context()
+	repo, owner, name, pr := m.prRepo, m.owner, m.repo, m.pr
+	files := diffFileNames(m.files)
+	return func() tea.Msg {
+		var (
+			wg         sync.WaitGroup
+			candidates *models.ReviewerCandidates
+			owners     models.CodeOwners
+			ownersErr  error
+			err        error
+		)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			candidates, err = repo.ListReviewerCandidates(ctx, owner, name)
+		}()
+		go func() {
+			defer wg.Done()
+			// GitHub applies the CODEOWNERS of the base branch
+			owners, ownersErr = repo.GetCodeOwners(ctx, owner, name, pr.Base.Name)
+			if ownersErr == nil && len(owners) > 0 && len(files) == 0 {
+				var changed []*models.DiffFile
+				changed, ownersErr = repo.ListFiles(ctx, owner, name, pr.Number)
+				files = diffFileNames(changed)
+			}
+		}()
+		wg.Wait()
+		if err != nil {
+			return reviewerCandidatesLoadedMsg{err: err}
+		}
+		// Without code owners the picker still lists everyone
+		var suggested []string
+		if ownersErr == nil {
+			suggested = owners.Suggest(files)
+		}
+		return reviewerCandidatesLoadedMsg{candidates: buildReviewerCandidates(pr, candidates, suggested)}
+	}
+}
+
+// buildReviewerCandidates lists the code owners of the changed files first,
+// then the other users and teams by name. The author cannot review their own
+// pull request and is left out.
+func buildReviewerCandidates(pr *models.PullRequest, candidates *models.ReviewerCandidates, codeOwners []string) []reviewerCandidate {
+	requested := make(map[string]bool)
+	for _, user := range pr.RequestedReviewers {
+		requested[strings.ToLower(user.Login)] = true
+	}
+	for _, team := range pr.RequestedTeams {
+		requested[strings.ToLower(team.Key())] = true
+	}
+	author := strings.ToLower(pr.Author.Login)
+
+	byKey := make(map[string]*reviewerCandidate)
+	var list []*reviewerCandidate
+	add := func(c reviewerCandidate) {
+		lower := strings.ToLower(c.key)
+		if lower == author || byKey[lower] != nil {
+			return
+		}
+		c.requested = requested[lower]
+		byKey[lower] = &c
+		list = append(list, &c)
+	}
+	if candidates != nil {
+		for _, user := range candidates.Users {
+			add(reviewerCandidate{key: user.Login})
+		}
+		for _, team := range candidates.Teams {
+			add(reviewerCandidate{key: team.Key(), name: team.Name, team: true})
+		}
+	}
+
+	// Code owners not listed (e.g. teams hidden from this token) are still offered
+	rank := make(map[*reviewerCandidate]int)
+	for i, name := range codeOwners {
+		c := byKey[strings.ToLower(name)]
+		if c == nil {
+			add(reviewerCandidate{key: name, team: strings.Contains(name, "/")})
+			c = byKey[strings.ToLower(name)]
+			if c == nil {
+				continue
+			}
+		}
+		c.codeOwner = true
+		rank[c] = i
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		if a.codeOwner != b.codeOwner {
+			return a.codeOwner
+		}
+		if a.codeOwner {
+			return rank[a] < rank[b]
+		}
+		if a.team != b.team {
+			return !a.team
+		}
+		return strings.ToLower(a.key) < strings.ToLower(b.key)
+	})
+
+	result := make([]reviewerCandidate, len(list))
+	for i, c := range list {
+		result[i] = *c
+	}
+	return result
+}
+
+// handleReviewerPickerKey handles keys while the reviewer selection is shown
+func (m *PRDetailView) handleReviewerPickerKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	p := &m.reviewers
+	if p.filtering {
+		switch msg.Type {
+		case tea.KeyEnter, tea.KeyEsc:
+			p.filtering = false
+		case tea.KeyBackspace:
+			if p.filter != "" {
+				runes := []rune(p.filter)
+				p.filter = string(runes[:len(runes)-1])
+				p.cursor = 0
+			}
+		case tea.KeyRunes:
+			p.filter += string(msg.Runes)
+			p.cursor = 0
+		case tea.KeyCtrlC:
+			return m, tea.Quit
+		}
+		return m, nil
+	}
+
+	visible := p.visible()
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc", "q":
+		// A load still running is ignored when it finishes
+		m.reviewers = reviewerPicker{}
+		return m, nil
+	case "/":
+		p.filtering = true
+	case "j", "down":
+		if p.cursor < len(visible)-1 {
+			p.cursor++
+		}
+	case "k", "up":
+		if p.cursor > 0 {
+			p.cursor--
+		}
+	case " ", "space":
+		if p.cursor < len(visible) && !visible[p.cursor].requested {
+			key := visible[p.cursor].key
+			p.selected[key] = !p.selected[key]
+		}
+	case "enter":
+		return m, m.requestReviewers()
+	}
+	return m, nil
+}
+
+// requestReviewers requests reviews from the selected candidates, or the one
+// under the cursor when none is selected
+func (m *PRDetailView) requestReviewers() tea.Cmd {
+	p := &m.reviewers
+	if p.loading {
+		return nil
+	}
+
+	var chosen []reviewerCandidate
+	for _, c := range p.candidates {
+		if p.selected[c.key] {
+			chosen = append(chosen, c)
+		}
+	}
+	if visible := p.visible(); len(chosen) == 0 && p.cursor < len(visible) && !visible[p.cursor].requested {
+		chosen = []reviewerCandidate{visible[p.cursor]}
+	}
+	if len(chosen) == 0 {
+		return nil
+	}
+
+	request := &models.ReviewRequest{}
+	var names []string
+	for _, c := range chosen {
+		names = append(names, "@"+c.key)
+		if c.team {
+			// Teams are requested by slug within the repository's organization
+			request.Teams = append(request.Teams, c.key[strings.Index(c.key, "/")+1:])
+		} else {
+			request.Users = append(request.Users, c.key)
+		}
+	}
+
+	p.loading = true
+	m.statusMessage = "Requesting reviews..."
+	ctx := m.loads.Context()
+	repo, owner, name, number := m.prRepo, m.owner, m.repo, m.pr.Number
+	return func() tea.Msg {
+		pr, err := repo.RequestReviewers(ctx, owner, name, number, request)
+		return reviewersRequestedMsg{pr: pr, reviewer: names, err: err}
+	}
+}
+
+// handleReviewerPickerMsg applies the results of loading candidates and requesting reviews
+func (m *PRDetailView) handleReviewerPickerMsg(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case reviewerCandidatesLoadedMsg:
+		if !m.reviewers.active || isCancelled(msg.err) {
+			return nil
+		}
+		m.reviewers.loading = false
+		m.reviewers.err = msg.err
+		m.reviewers.candidates = msg.candidates
+		m.reviewers.cursor = 0
+
+	case reviewersRequestedMsg:
+		if isCancelled(msg.err) {
+			return nil
+		}
+		m.reviewers.loading = false
+		if msg.err != nil {
+			m.reviewers.err = msg.err
+			m.statusMessage = fmt.Sprintf("Review request failed: %v", msg.err)
+			return nil
+		}
+		m.reviewers = reviewerPicker{}
+		if msg.pr != nil {
+			ensurePRNumber(msg.pr)
+			msg.pr.Reviews = m.pr.Reviews
+			m.pr = msg.pr
+		}
+		m.statusMessage = "Requested reviews from " + strings.Join(msg.reviewer, ", ")
+		return tea.Batch(
+			events.Publish(events.PullRequestChanged(events.ActionUpdated, m.owner, m.repo, m.pr)),
+			m.loadPendingRequests(),
+		)
+	}
+	return nil
+}
+
+// renderReviewerPicker renders the reviewer selection
+func (m *PRDetailView) renderReviewerPicker() string {
+	p := &m.reviewers
+	lines := []string{
+		styles.HeaderStyle.Render(fmt.Sprintf("Request reviewers for #%d", m.pr.Number)),
+		styles.MutedStyle.Render(m.pr.Title),
+		"",
+	}
+	if p.filtering || p.filter != "" {
+		filter := "Filter: " + p.filter
+		if p.filtering {
+			filter += "_"
+		}
+		lines = append(lines, filter, "")
+	}
+
+	help := styles.HelpStyle.Render("Controls: j/k navigate • Space select • / filter • Enter request • Esc cancel")
+	visible := p.visible()
+	switch {
+	case p.loading && len(p.candidates) == 0:
+		return strings.Join(append(lines, styles.LoadingStyle.Render("Loading reviewers...")), "\n")
+	case p.err != nil && len(p.candidates) == 0:
+		return strings.Join(append(lines, styles.ErrorStyle.Render(p.err.Error()), "", help), "\n")
+	case len(visible) == 0:
+		return strings.Join(append(lines, styles.MutedStyle.Render("No matching reviewers."), "", help), "\n")
+	}
+
+	footer := []string{"", help}
+	if p.err != nil {
+		footer = append([]string{"", styles.ErrorStyle.Render(p.err.Error())}, footer...)
+	}
+	height := m.height - len(lines) - len(footer)
+	if height < 3 {
+		height = 3
+	}
+	start, end := components.VisibleRange(p.cursor, len(visible), height)
+	for i := start; i < end; i++ {
+		c := visible[i]
+		cursor := "  "
+		nameStyle := styles.AuthorStyle
+		if i == p.cursor {
+			cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
+			nameStyle = styles.SelectedStyle
+		}
+		mark := "[ ]"
+		if p.selected[c.key] {
+			mark = "[x]"
+		}
+		line := cursor + mark + " " + nameStyle.Render(c.label())
+		switch {
+		case c.requested:
+			line += "  " + styles.MutedStyle.Render("requested")
+		case c.codeOwner:
+			line += "  " + styles.InfoStyle.Render("code owner")
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.Join(append(lines, footer...), "\n")
+}
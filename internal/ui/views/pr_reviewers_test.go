@@ -0,0 +1,68 @@
+package views
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestPRDetailView_RequestReviewers(t *testing.T) {
+	pr := createTestPullRequest()
+	pr.RequestedReviewers = []models.User{{Login: "dave"}}
+	repo := &testPRRepo{
+		pr: pr,
+		reviewers: &models.ReviewerCandidates{
+			Users: []models.User{{Login: pr.Author.Login}, {Login: "dave"}, {Login: "bob"}, {Login: "alice"}},
+			Teams: []models.Team{{Org: "owner", Slug: "backend", Name: "Backend"}},
+		},
+		owners: models.ParseCodeOwners("* @bob\n/api/ @owner/backend @carol\n"),
+		files:  []*models.DiffFile{{Filename: "api/handler.go"}},
+	}
+	view := NewPRDetailView(pr, "owner", "repo", repo)
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+
+	cmd := press(view, "r")
+	if cmd == nil || !view.IsCapturingInput() {
+		t.Fatal("expected r to open the reviewer selection")
+	}
+	view.Update(cmd())
+
+	// Code owners of the changed files come first; the author is left out
+	var keys []string
+	for _, c := range view.reviewers.candidates {
+		keys = append(keys, c.key)
+	}
+	if strings.Join(keys, ",") != "owner/backend,carol,alice,bob,dave" {
+		t.Fatalf("candidates = %v", keys)
+	}
+	out := view.View()
+	for _, want := range []string{"@owner/backend  code owner", "@carol  code owner", "@dave  requested"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in\n%s", want, out)
+		}
+	}
+
+	// Filter down to alice and select her along with the team
+	press(view, " ")
+	press(view, "/")
+	typeText(view, "ali")
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	press(view, " ")
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected enter to request reviews")
+	}
+	view.Update(cmd())
+
+	if repo.requested == nil || strings.Join(repo.requested.Users, ",") != "alice" || strings.Join(repo.requested.Teams, ",") != "backend" {
+		t.Fatalf("requested = %+v", repo.requested)
+	}
+	if view.IsCapturingInput() {
+		t.Error("expected the selection closed after requesting")
+	}
+	if out := view.View(); !strings.Contains(out, "Requested: @dave, @alice, @owner/backend") {
+		t.Errorf("expected the requested reviewers in the header\n%s", out)
+	}
+}
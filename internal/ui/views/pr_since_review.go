@@ -0,0 +1,152 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// sinceReviewState is the "since your last review" mode of the Files tab,
+// which narrows the diff to what was pushed after the viewer's last review
+type sinceReviewState struct {
+	active  bool
+	loading bool
+	changes *models.ChangesSinceReview
+	err     error
+}
+
+// prSinceReviewLoadedMsg carries the changes since the viewer's last review
+type prSinceReviewLoadedMsg struct {
+	changes *models.ChangesSinceReview
+	err     error
+}
+
+// toggleSinceReview switches the Files tab between all changes and the
+// changes since the viewer's last review, loading them the first time
+func (m *PRDetailView) toggleSinceReview() tea.Cmd {
+	m.scrollOffset = 0
+	if m.sinceReview.active {
+		m.sinceReview.active = false
+		m.statusMessage = "Showing all changes"
+		return nil
+	}
+	if m.prRepo == nil {
+		m.statusMessage = "PR repository not available"
+		return nil
+	}
+	m.sinceReview.active = true
+	m.statusMessage = "Showing changes since your last review"
+	if m.sinceReview.changes != nil || m.sinceReview.loading {
+		return nil
+	}
+	return m.loadSinceReview()
+}
+
+// reloadSinceReview refetches the changes since the last review while the
+// mode is on; otherwise they are fetched again when it is turned on
+func (m *PRDetailView) reloadSinceReview() tea.Cmd {
+	m.sinceReview.changes = nil
+	m.sinceReview.err = nil
+	if !m.sinceReview.active || m.sinceReview.loading {
+		return nil
+	}
+	return m.loadSinceReview()
+}
+
+// loadSinceReview fetches the changes between the reviewed commit and the head
+func (m *PRDetailView) loadSinceReview() tea.Cmd {
+	m.sinceReview.loading = true
+	ctx := m.loads.Context()
+	repo, owner, name, number := m.prRepo, m.owner, m.repo, m.pr.Number
+	return func() tea.Msg {
+		changes, err := repo.GetChangesSinceReview(ctx, owner, name, number)
+		return prSinceReviewLoadedMsg{changes: changes, err: err}
+	}
+}
+
+// handleSinceReviewLoaded applies the loaded changes since the last review
+func (m *PRDetailView) handleSinceReviewLoaded(msg prSinceReviewLoadedMsg) {
+	m.sinceReview.loading = false
+	if staleLoad(msg.err) {
+		return
+	}
+	m.sinceReview.err = msg.err
+	if msg.err == nil {
+		m.sinceReview.changes = msg.changes
+	}
+}
+
+// renderSinceReview renders the commits and the diff pushed since the
+// viewer's last review
+func (m *PRDetailView) renderSinceReview() string {
+	state := m.sinceReview
+	changes := state.changes
+	switch {
+	case state.loading:
+		return styles.MutedStyle.Render("Loading changes since your last review...")
+	case state.err != nil:
+		return styles.ErrorStyle.Render(fmt.Sprintf("Failed to load changes since your last review: %v", state.err))
+	case !changes.Reviewed():
+		return styles.MutedStyle.Render("You have not reviewed this pull request yet. Press 's' to show all changes.")
+	case changes.UpToDate():
+		return styles.MutedStyle.Render(fmt.Sprintf("No new commits since your last review (%s, %s).",
+			shortSHA(changes.ReviewedSHA), formatRelativeTime(changes.ReviewedAt)))
+	}
+
+	var s strings.Builder
+	s.WriteString(styles.BoldStyle.Render(fmt.Sprintf("Changes since your last review (%s..%s, reviewed %s)",
+		shortSHA(changes.ReviewedSHA), shortSHA(changes.HeadSHA), formatRelativeTime(changes.ReviewedAt))))
+	s.WriteString("\n")
+	if changes.Rewritten {
+		s.WriteString(styles.WarningStyle.Render(styles.IconWarning + " The branch was force-pushed since your review; the diff includes the rewritten changes"))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(styles.BoldStyle.Render(fmt.Sprintf("New commits (%d)", len(changes.Commits))))
+	s.WriteString("\n")
+	for _, commit := range changes.Commits {
+		s.WriteString(fmt.Sprintf("  %s %s\n", styles.MutedStyle.Render(shortSHA(commit.SHA)), firstLine(commit.Message)))
+	}
+
+	s.WriteString("\n")
+	s.WriteString(styles.BoldStyle.Render(fmt.Sprintf("Files Changed (%d)", len(changes.Files))))
+	s.WriteString("\n")
+	for _, file := range changes.Files {
+		s.WriteString("\n")
+		s.WriteString(fmt.Sprintf("%s %s %s %s\n",
+			styles.AddedLineStyle.Render(fmt.Sprintf("+%d", file.Additions)),
+			styles.DeletedLineStyle.Render(fmt.Sprintf("-%d", file.Deletions)),
+			styles.BoldStyle.Render(file.Filename),
+			styles.MutedStyle.Render("("+string(file.Status)+")"),
+		))
+		s.WriteString(renderPatch(file.Patch))
+	}
+
+	return s.String()
+}
+
+// renderPatch colors the added and deleted lines of a file's patch
+func renderPatch(patch string) string {
+	if patch == "" {
+		return styles.MutedStyle.Render("  (no textual diff)") + "\n"
+	}
+	var s strings.Builder
+	for _, line := range strings.Split(strings.TrimRight(patch, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			s.WriteString(styles.MutedStyle.Render(line))
+		case strings.HasPrefix(line, "+"):
+			s.WriteString(styles.AddedLineStyle.Render(line))
+		case strings.HasPrefix(line, "-"):
+			s.WriteString(styles.DeletedLineStyle.Render(line))
+		default:
+			s.WriteString(line)
+		}
+		s.WriteString("\n")
+	}
+	return s.String()
+}
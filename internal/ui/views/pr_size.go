@@ -0,0 +1,114 @@
+package views
+
+import (
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// prSize is a coarse classification of a PR by the number of changed lines
+type prSize string
+
+const (
+	prSizeXS prSize = "XS"
+	prSizeS  prSize = "S"
+	prSizeM  prSize = "M"
+	prSizeL  prSize = "L"
+	prSizeXL prSize = "XL"
+)
+
+// sizeLabelPrefix is the prefix of labels that carry a PR size (e.g. "size/M")
+const sizeLabelPrefix = "size/"
+
+// prSizeThresholds holds the maximum changed lines (additions+deletions) of each size
+var prSizeThresholds = []struct {
+	max  int
+	size prSize
+}{
+	{9, prSizeXS},
+	{29, prSizeS},
+	{99, prSizeM},
+	{499, prSizeL},
+}
+
+// classifyPRSize classifies a PR by its additions and deletions
+func classifyPRSize(additions, deletions int) prSize {
+	changed := additions + deletions
+	for _, t := range prSizeThresholds {
+		if changed <= t.max {
+			return t.size
+		}
+	}
+	return prSizeXL
+}
+
+// hasLineCounts reports whether the PR carries diff statistics.
+// The list API omits them, so only PRs fetched individually have them.
+func hasLineCounts(pr *models.PullRequest) bool {
+	return pr.Additions > 0 || pr.Deletions > 0 || pr.ChangedFiles > 0
+}
+
+// prSizeOf returns the size of a PR, falling back to an existing size/* label
+// when line counts are unavailable.
+func prSizeOf(pr *models.PullRequest) (prSize, bool) {
+	if pr == nil {
+		return "", false
+	}
+	if hasLineCounts(pr) {
+		return classifyPRSize(pr.Additions, pr.Deletions), true
+	}
+	for _, label := range pr.Labels {
+		if size, ok := parseSizeLabel(label.Name); ok {
+			return size, true
+		}
+	}
+	return "", false
+}
+
+// sizeLabelName returns the label name for a size (e.g. "size/M")
+func sizeLabelName(size prSize) string {
+	return sizeLabelPrefix + string(size)
+}
+
+// parseSizeLabel parses a size/* label name
+func parseSizeLabel(name string) (prSize, bool) {
+	if !strings.HasPrefix(strings.ToLower(name), sizeLabelPrefix) {
+		return "", false
+	}
+	size := prSize(strings.ToUpper(name[len(sizeLabelPrefix):]))
+	switch size {
+	case prSizeXS, prSizeS, prSizeM, prSizeL, prSizeXL:
+		return size, true
+	}
+	return "", false
+}
+
+// labelsWithSize replaces any size/* labels with the label for the given size
+func labelsWithSize(labels []models.Label, size prSize) []string {
+	names := make([]string, 0, len(labels)+1)
+	for _, label := range labels {
+		if strings.HasPrefix(strings.ToLower(label.Name), sizeLabelPrefix) {
+			continue
+		}
+		names = append(names, label.Name)
+	}
+	return append(names, sizeLabelName(size))
+}
+
+// prSizeColors maps sizes to badge colors, from green (small) to red (large)
+var prSizeColors = map[prSize]lipgloss.Color{
+	prSizeXS: lipgloss.Color("35"),
+	prSizeS:  lipgloss.Color("71"),
+	prSizeM:  lipgloss.Color("220"),
+	prSizeL:  lipgloss.Color("208"),
+	prSizeXL: lipgloss.Color("196"),
+}
+
+// renderPRSizeBadge renders a size badge such as "[M]"
+func renderPRSizeBadge(size prSize) string {
+	return lipgloss.NewStyle().
+		Foreground(prSizeColors[size]).
+		Bold(true).
+		Render("[" + string(size) + "]")
+}
@@ -0,0 +1,89 @@
+package views
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/events"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestClassifyPRSize(t *testing.T) {
+	tests := []struct {
+		additions int
+		deletions int
+		want      prSize
+	}{
+		{0, 0, prSizeXS},
+		{5, 4, prSizeXS},
+		{10, 0, prSizeS},
+		{20, 9, prSizeS},
+		{30, 0, prSizeM},
+		{60, 39, prSizeM},
+		{100, 0, prSizeL},
+		{400, 99, prSizeL},
+		{500, 0, prSizeXL},
+	}
+
+	for _, tt := range tests {
+		if got := classifyPRSize(tt.additions, tt.deletions); got != tt.want {
+			t.Errorf("classifyPRSize(%d, %d) = %s, want %s", tt.additions, tt.deletions, got, tt.want)
+		}
+	}
+}
+
+func TestPRSizeOf_FallsBackToLabel(t *testing.T) {
+	pr := &models.PullRequest{Labels: []models.Label{{Name: "bug"}, {Name: "size/L"}}}
+	if size, ok := prSizeOf(pr); !ok || size != prSizeL {
+		t.Fatalf("expected size L from label, got %q (%v)", size, ok)
+	}
+
+	pr = &models.PullRequest{Labels: []models.Label{{Name: "bug"}}}
+	if _, ok := prSizeOf(pr); ok {
+		t.Fatal("expected unknown size without line counts or size label")
+	}
+
+	pr = &models.PullRequest{Additions: 3, ChangedFiles: 1, Labels: []models.Label{{Name: "size/XL"}}}
+	if size, _ := prSizeOf(pr); size != prSizeXS {
+		t.Fatalf("expected line counts to win over stale label, got %q", size)
+	}
+}
+
+func TestLabelsWithSize(t *testing.T) {
+	labels := []models.Label{{Name: "bug"}, {Name: "size/XS"}, {Name: "Size/M"}}
+	got := labelsWithSize(labels, prSizeL)
+	want := []string{"bug", "size/L"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("labelsWithSize = %v, want %v", got, want)
+	}
+}
+
+func TestPRDetailView_ApplySizeLabel(t *testing.T) {
+	listed := &models.PullRequest{Number: 7, State: models.PRStateOpen, Labels: []models.Label{{Name: "size/XS"}}}
+	fetched := &models.PullRequest{Number: 7, State: models.PRStateOpen, Additions: 120, Deletions: 30, ChangedFiles: 4, Labels: listed.Labels}
+	repo := &testPRRepo{pr: fetched}
+	view := NewPRDetailView(listed, "owner", "repo", repo)
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'L'}})
+	if cmd == nil {
+		t.Fatal("expected label command")
+	}
+	_, publish := view.Update(cmd())
+
+	if !reflect.DeepEqual(repo.labels, []string{"size/L"}) {
+		t.Fatalf("expected stale size label to be replaced, got %v", repo.labels)
+	}
+	if size, _ := prSizeOf(view.pr); size != prSizeL {
+		t.Errorf("expected detail view to show size L, got %q", size)
+	}
+	if view.statusMessage != "Labeled size/L" {
+		t.Errorf("unexpected status %q", view.statusMessage)
+	}
+	if publish == nil {
+		t.Fatal("expected label change to be published")
+	}
+	if ev, ok := publish().(events.EntityChanged); !ok || ev.Action != events.ActionLabeled {
+		t.Errorf("expected labeled event, got %#v", ev)
+	}
+}
@@ -0,0 +1,182 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// suggestionCommittedMsg is sent once a suggested change was committed to the head branch
+type suggestionCommittedMsg struct {
+	err error
+}
+
+// suggestionFence returns the fence opening a ```suggestion block, if the
+// line is one. Longer fences let suggestions contain ``` themselves.
+func suggestionFence(line string) (string, bool) {
+	trimmed := strings.TrimSpace(line)
+	fence := trimmed[:len(trimmed)-len(strings.TrimLeft(trimmed, "`~"))]
+	if len(fence) < 3 || strings.Trim(fence, fence[:1]) != "" {
+		return "", false
+	}
+	return fence, strings.TrimSpace(trimmed[len(fence):]) == "suggestion"
+}
+
+// isClosingFence reports whether the line closes a block opened by fence
+func isClosingFence(line, fence string) bool {
+	trimmed := strings.TrimSpace(line)
+	return strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == ""
+}
+
+// commentSegment is a part of a comment body: plain text or a suggestion block
+type commentSegment struct {
+	lines      []string
+	suggestion bool
+}
+
+// splitSuggestions splits a comment body into plain text and suggestion
+// blocks. An unclosed block runs to the end of the body, as GitHub shows it.
+func splitSuggestions(body string) []commentSegment {
+	var segments []commentSegment
+	var current commentSegment
+	fence := ""
+	flush := func(suggestion bool) {
+		if current.suggestion || len(current.lines) > 0 {
+			segments = append(segments, current)
+		}
+		current = commentSegment{suggestion: suggestion}
+	}
+
+	for _, line := range strings.Split(strings.TrimRight(body, "\n"), "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		switch {
+		case fence == "":
+			if opening, ok := suggestionFence(line); ok {
+				fence = opening
+				flush(true)
+				continue
+			}
+		case isClosingFence(line, fence):
+			fence = ""
+			flush(false)
+			continue
+		}
+		current.lines = append(current.lines, line)
+	}
+	flush(false)
+	return segments
+}
+
+// parseSuggestions returns the content of each suggestion block of a comment
+func parseSuggestions(body string) []string {
+	var suggestions []string
+	for _, segment := range splitSuggestions(body) {
+		if segment.suggestion {
+			suggestions = append(suggestions, strings.Join(segment.lines, "\n"))
+		}
+	}
+	return suggestions
+}
+
+// threadSuggestion returns the latest suggestion of a thread with its comment
+func threadSuggestion(thread *models.ReviewThread) (*models.Comment, string, bool) {
+	for i := len(thread.Comments) - 1; i >= 0; i-- {
+		if suggestions := parseSuggestions(thread.Comments[i].Body); len(suggestions) > 0 {
+			return thread.Comments[i], suggestions[0], true
+		}
+	}
+	return nil, "", false
+}
+
+// renderCommentBody renders a review comment, setting suggestion blocks apart
+// as the lines they would put in place of the commented ones
+func renderCommentBody(body, indent string) string {
+	var s strings.Builder
+	for _, segment := range splitSuggestions(body) {
+		if !segment.suggestion {
+			for _, line := range segment.lines {
+				s.WriteString(indent + line + "\n")
+			}
+			continue
+		}
+		s.WriteString(indent + styles.AddedLineStyle.Bold(true).Render("Suggested change") + "\n")
+		if len(segment.lines) == 0 {
+			s.WriteString(indent + styles.DeletedLineStyle.Render("- (removes the lines)") + "\n")
+		}
+		for _, line := range segment.lines {
+			s.WriteString(indent + styles.AddedLineStyle.Render("+ "+line) + "\n")
+		}
+	}
+	return s.String()
+}
+
+// selectedSuggestion returns the selected thread with its latest suggestion,
+// or sets the status saying why there is none
+func (m *PRDetailView) selectedSuggestion() (*models.ReviewThread, *models.Comment, string, bool) {
+	if m.currentTab != tabComments || m.selectedThread >= len(m.threads) {
+		return nil, nil, "", false
+	}
+	thread := m.threads[m.selectedThread]
+	comment, suggestion, ok := threadSuggestion(thread)
+	if !ok {
+		m.statusMessage = "The selected thread has no suggested change"
+		return nil, nil, "", false
+	}
+	return thread, comment, suggestion, true
+}
+
+// copySuggestion copies the latest suggestion of the selected thread
+func (m *PRDetailView) copySuggestion() {
+	_, comment, suggestion, ok := m.selectedSuggestion()
+	if !ok {
+		return
+	}
+	if err := copyToClipboard(suggestion); err != nil {
+		m.statusMessage = fmt.Sprintf("Copy failed: %v", err)
+		return
+	}
+	m.statusMessage = fmt.Sprintf("Copied the suggestion of %s", comment.User.Login)
+}
+
+// commitSuggestion commits the latest suggestion of the selected thread to
+// the head branch, refusing if someone pushed since the PR was loaded
+func (m *PRDetailView) commitSuggestion() tea.Cmd {
+	thread, comment, suggestion, ok := m.selectedSuggestion()
+	if !ok {
+		return nil
+	}
+	switch {
+	case m.pr.Merged || m.pr.State == models.PRStateClosed:
+		m.statusMessage = "Cannot commit to a closed pull request"
+		return nil
+	case thread.IsOutdated:
+		m.statusMessage = "The thread is outdated; its lines changed since the suggestion"
+		return nil
+	case thread.DiffSide == "LEFT":
+		m.statusMessage = "The suggestion is on removed lines"
+		return nil
+	case thread.Line <= 0:
+		m.statusMessage = "The suggestion is not on a line of the file"
+		return nil
+	}
+
+	input := &models.SuggestionInput{
+		Path:            thread.Path,
+		StartLine:       thread.StartLine,
+		Line:            thread.Line,
+		Replacement:     suggestion,
+		Message:         fmt.Sprintf("Apply suggestion from @%s\n\n%s", comment.User.Login, comment.HTMLURL),
+		ExpectedHeadSHA: m.pr.Head.SHA,
+	}
+	if input.StartLine <= 0 {
+		input.StartLine = input.Line
+	}
+	m.committingSuggestion = true
+	m.statusMessage = fmt.Sprintf("Committing the suggestion to %s...", formatBranchName(m.pr.Head))
+	return func() tea.Msg {
+		return suggestionCommittedMsg{err: m.prRepo.CommitSuggestion(m.loads.writeContext(), m.owner, m.repo, m.pr.Number, input)}
+	}
+}
@@ -0,0 +1,130 @@
+package views
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestParseSuggestions(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want []string
+	}{
+		{"none", "Looks good\n```go\nx := 1\n```", nil},
+		{"one line", "nit:\n```suggestion\nreturn nil\n```\nthanks", []string{"return nil"}},
+		{"several lines", "```suggestion\r\na\r\nb\r\n```", []string{"a\nb"}},
+		{"delete", "```suggestion\n```", []string{""}},
+		{"longer fence", "````suggestion\n```go\nx\n```\n````", []string{"```go\nx\n```"}},
+		{"unclosed", "```suggestion\nx", []string{"x"}},
+		{"two", "```suggestion\na\n```\n```suggestion\nb\n```", []string{"a", "b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseSuggestions(tt.body); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseSuggestions() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func suggestionDetailView(thread *models.ReviewThread) (*PRDetailView, *testPRRepo) {
+	pr := &models.PullRequest{Number: 5, State: models.PRStateOpen, Head: models.Branch{Name: "feature", SHA: "abc123"}}
+	repo := &testPRRepo{pr: pr}
+	view := NewPRDetailView(pr, "owner", "repo", repo)
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 60})
+	view.currentTab = tabComments
+	view.threadsLoading = false
+	view.setThreads([]*models.ReviewThread{thread})
+	return view, repo
+}
+
+func TestPRDetailView_RendersSuggestion(t *testing.T) {
+	view, _ := suggestionDetailView(&models.ReviewThread{ID: "T1", Path: "main.go", Line: 4, Comments: []*models.Comment{
+		{User: models.User{Login: "alice"}, Body: "Use the helper:\n```suggestion\nreturn helper()\n```"},
+	}})
+
+	out := view.renderReviewThreads()
+	for _, want := range []string{"Use the helper:", "Suggested change", "+ return helper()"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in the thread, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "```suggestion") {
+		t.Errorf("expected the fence to be replaced, got:\n%s", out)
+	}
+}
+
+func TestPRDetailView_CopySuggestion(t *testing.T) {
+	var copied string
+	original := copyToClipboard
+	copyToClipboard = func(text string) error {
+		copied = text
+		return nil
+	}
+	defer func() { copyToClipboard = original }()
+
+	view, _ := suggestionDetailView(&models.ReviewThread{ID: "T1", Path: "main.go", Line: 4, Comments: []*models.Comment{
+		{User: models.User{Login: "alice"}, Body: "```suggestion\nold()\n```"},
+		{User: models.User{Login: "bob"}, Body: "Better:\n```suggestion\nnew()\n```"},
+		{User: models.User{Login: "alice"}, Body: "Agreed"},
+	}})
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if copied != "new()" || !strings.Contains(view.statusMessage, "bob") {
+		t.Errorf("expected the latest suggestion to be copied, got %q (%q)", copied, view.statusMessage)
+	}
+}
+
+func TestPRDetailView_CommitSuggestion(t *testing.T) {
+	view, repo := suggestionDetailView(&models.ReviewThread{ID: "T1", Path: "main.go", StartLine: 3, Line: 4, DiffSide: "RIGHT", Comments: []*models.Comment{
+		{User: models.User{Login: "alice"}, Body: "```suggestion\na\nb\n```", HTMLURL: "https://github.com/owner/repo/pull/5#discussion_r1"},
+	}})
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'C'}})
+	if cmd == nil || !view.committingSuggestion {
+		t.Fatal("expected C to commit the suggestion")
+	}
+	_, reload := view.Update(cmd())
+	want := &models.SuggestionInput{
+		Path:            "main.go",
+		StartLine:       3,
+		Line:            4,
+		Replacement:     "a\nb",
+		Message:         "Apply suggestion from @alice\n\nhttps://github.com/owner/repo/pull/5#discussion_r1",
+		ExpectedHeadSHA: "abc123",
+	}
+	if !reflect.DeepEqual(repo.suggested, want) {
+		t.Fatalf("committed %+v, want %+v", repo.suggested, want)
+	}
+	if reload == nil || !view.refreshing || !strings.Contains(view.statusMessage, "Committed the suggestion") {
+		t.Errorf("expected a reload after the commit, status %q", view.statusMessage)
+	}
+}
+
+func TestPRDetailView_CommitSuggestionRefused(t *testing.T) {
+	tests := []struct {
+		name   string
+		thread *models.ReviewThread
+		status string
+	}{
+		{"no suggestion", &models.ReviewThread{ID: "T1", Path: "a.go", Line: 1, Comments: []*models.Comment{{Body: "nit"}}}, "no suggested change"},
+		{"outdated", &models.ReviewThread{ID: "T1", Path: "a.go", Line: 1, IsOutdated: true, Comments: []*models.Comment{{Body: "```suggestion\nx\n```"}}}, "outdated"},
+		{"removed lines", &models.ReviewThread{ID: "T1", Path: "a.go", Line: 1, DiffSide: "LEFT", Comments: []*models.Comment{{Body: "```suggestion\nx\n```"}}}, "removed lines"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			view, repo := suggestionDetailView(tt.thread)
+			if _, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'C'}}); cmd != nil || repo.suggested != nil {
+				t.Fatal("expected no commit")
+			}
+			if !strings.Contains(view.statusMessage, tt.status) {
+				t.Errorf("expected %q in the status, got %q", tt.status, view.statusMessage)
+			}
+		})
+	}
+}
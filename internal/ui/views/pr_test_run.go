@@ -0,0 +1,345 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/a1yama/tig-gh/internal/infra/git"
+	"github.com/a1yama/tig-gh/internal/infra/shell"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Local checkout and test run of ctrl+t (overridable in tests)
+var (
+	pullRequestWorktree = git.PullRequestWorktree
+	startTestCommand    = shell.Start
+)
+
+// testRunMaxLines caps the output kept in the pane; older lines are dropped
+const testRunMaxLines = 5000
+
+// testRunBatch is the most output lines handed to the view in one message
+const testRunBatch = 256
+
+var (
+	reviewCheckoutMu  sync.RWMutex
+	reviewTestCommand string
+	reviewWorktreeDir string
+)
+
+// SetReviewCheckout sets the command ctrl+t runs in the worktree of a PR
+// (none only checks it out) and the directory the worktrees are created in
+// (next to the clone when empty)
+func SetReviewCheckout(testCommand, worktreeDir string) {
+	reviewCheckoutMu.Lock()
+	defer reviewCheckoutMu.Unlock()
+	reviewTestCommand = strings.TrimSpace(testCommand)
+	reviewWorktreeDir = worktreeDir
+}
+
+// reviewCheckout returns the settings made with SetReviewCheckout
+func reviewCheckout() (testCommand, worktreeDir string) {
+	reviewCheckoutMu.RLock()
+	defer reviewCheckoutMu.RUnlock()
+	return reviewTestCommand, reviewWorktreeDir
+}
+
+// testRunPhase is how far a checkout-and-test run has got
+type testRunPhase int
+
+const (
+	testRunCheckingOut testRunPhase = iota
+	testRunRunning
+	testRunDone
+)
+
+// testRun is the state of the checkout-and-test pane of the PR detail view
+type testRun struct {
+	active    bool
+	id        int // tells the messages of an earlier run apart
+	phase     testRunPhase
+	command   string
+	path      string
+	run       *shell.Run
+	cancel    context.CancelFunc
+	lines     []string
+	dropped   int // lines dropped from the start of the output
+	err       error
+	offset    int
+	following bool
+}
+
+// prWorktreeReadyMsg is sent when the PR has been checked out and the test
+// command, if any, started
+type prWorktreeReadyMsg struct {
+	id   int
+	path string
+	run  *shell.Run
+	err  error
+}
+
+// testRunOutputMsg carries the next lines of the test output. done is set
+// once the command has exited.
+type testRunOutputMsg struct {
+	id    int
+	lines []string
+	done  bool
+}
+
+// startTestRun opens the pane and checks the PR out into its worktree,
+// running the test command there once it is ready
+func (m *PRDetailView) startTestRun() tea.Cmd {
+	command, dir := reviewCheckout()
+	m.stopTestRun()
+	id := m.testRun.id + 1
+	m.testRun = testRun{active: true, id: id, command: command, following: true}
+
+	ctx, cancel := context.WithCancel(baseContext())
+	m.testRun.cancel = cancel
+	owner, repo, number := m.owner, m.repo, m.pr.Number
+	return func() tea.Msg {
+		localOwner, localRepo, err := currentRepository()
+		if err != nil || !strings.EqualFold(localOwner, owner) || !strings.EqualFold(localRepo, repo) {
+			return prWorktreeReadyMsg{id: id, err: fmt.Errorf("the working directory is not a clone of %s/%s", owner, repo)}
+		}
+		path, err := pullRequestWorktree(number, dir)
+		if err != nil || command == "" {
+			return prWorktreeReadyMsg{id: id, path: path, err: err}
+		}
+		run, err := startTestCommand(ctx, path, command)
+		return prWorktreeReadyMsg{id: id, path: path, run: run, err: err}
+	}
+}
+
+// waitForTestOutput waits for the next lines of the test output
+func waitForTestOutput(id int, run *shell.Run) tea.Cmd {
+	return func() tea.Msg {
+		line, ok := <-run.Lines()
+		if !ok {
+			return testRunOutputMsg{id: id, done: true}
+		}
+		lines := []string{line}
+		// Take what else is buffered so a chatty command is not drawn line by line
+		for len(lines) < testRunBatch {
+			select {
+			case line, ok := <-run.Lines():
+				if !ok {
+					return testRunOutputMsg{id: id, lines: lines, done: true}
+				}
+				lines = append(lines, line)
+			default:
+				return testRunOutputMsg{id: id, lines: lines}
+			}
+		}
+		return testRunOutputMsg{id: id, lines: lines}
+	}
+}
+
+// handleTestRunMsg handles the progress of the checkout-and-test run
+func (m *PRDetailView) handleTestRunMsg(msg tea.Msg) tea.Cmd {
+	t := &m.testRun
+	switch msg := msg.(type) {
+	case prWorktreeReadyMsg:
+		if msg.id != t.id || !t.active {
+			// The pane was closed meanwhile
+			if msg.run != nil {
+				msg.run.Stop()
+			}
+			return nil
+		}
+		t.path = msg.path
+		if msg.err != nil || msg.run == nil {
+			t.phase = testRunDone
+			t.err = msg.err
+			return nil
+		}
+		t.phase = testRunRunning
+		t.run = msg.run
+		return waitForTestOutput(t.id, t.run)
+
+	case testRunOutputMsg:
+		if msg.id != t.id || t.run == nil {
+			return nil
+		}
+		t.append(msg.lines)
+		if !msg.done {
+			return waitForTestOutput(t.id, t.run)
+		}
+		t.phase = testRunDone
+		t.err = t.run.Err()
+		t.run = nil
+		t.cancel()
+		return nil
+	}
+	return nil
+}
+
+// append adds output lines, dropping the oldest beyond testRunMaxLines
+func (t *testRun) append(lines []string) {
+	t.lines = append(t.lines, lines...)
+	if over := len(t.lines) - testRunMaxLines; over > 0 {
+		t.lines = append([]string(nil), t.lines[over:]...)
+		t.dropped += over
+		t.offset -= over
+		if t.offset < 0 {
+			t.offset = 0
+		}
+	}
+}
+
+// stopTestRun kills the test command if it is still running
+func (m *PRDetailView) stopTestRun() {
+	if m.testRun.cancel != nil {
+		m.testRun.cancel()
+	}
+}
+
+// handleTestRunKey handles keys while the checkout-and-test pane is shown
+func (m *PRDetailView) handleTestRunKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	t := &m.testRun
+	page := m.testRunHeight() / 2
+
+	switch msg.String() {
+	case "ctrl+c":
+		m.stopTestRun()
+		return m, tea.Quit
+
+	case "q", "esc":
+		if t.phase == testRunRunning {
+			m.statusMessage = "Stopped " + t.command
+		}
+		m.stopTestRun()
+		t.active = false
+		t.run = nil
+
+	case "ctrl+t":
+		if t.phase == testRunDone {
+			return m, m.startTestRun()
+		}
+
+	case "j", "down":
+		m.scrollTestRun(1)
+
+	case "k", "up":
+		m.scrollTestRun(-1)
+
+	case "ctrl+d":
+		m.scrollTestRun(page)
+
+	case "ctrl+u":
+		m.scrollTestRun(-page)
+
+	case "g":
+		t.following = false
+		t.offset = 0
+
+	case "G", "F":
+		t.following = true
+	}
+	return m, nil
+}
+
+// scrollTestRun moves the output viewport; scrolling stops following the end
+func (m *PRDetailView) scrollTestRun(delta int) {
+	t := &m.testRun
+	if t.following {
+		t.offset = m.maxTestRunOffset()
+		t.following = false
+	}
+	t.offset += delta
+	if t.offset < 0 {
+		t.offset = 0
+	}
+	if max := m.maxTestRunOffset(); t.offset >= max {
+		t.offset = max
+		t.following = true
+	}
+}
+
+// testRunHeight returns the number of output lines that fit on screen
+func (m *PRDetailView) testRunHeight() int {
+	height := m.height - 7
+	if height < 5 {
+		height = 5
+	}
+	return height
+}
+
+// maxTestRunOffset returns the offset that shows the end of the output
+func (m *PRDetailView) maxTestRunOffset() int {
+	if max := len(m.testRun.lines) - m.testRunHeight(); max > 0 {
+		return max
+	}
+	return 0
+}
+
+// renderTestRun renders the checkout-and-test pane
+func (m *PRDetailView) renderTestRun() string {
+	t := &m.testRun
+	var s strings.Builder
+	s.WriteString(styles.TitleStyle.Render(fmt.Sprintf("Check out #%d", m.pr.Number)))
+	s.WriteString("  ")
+	s.WriteString(m.testRunStatus())
+	s.WriteString("\n")
+	if t.path != "" {
+		s.WriteString(styles.MutedStyle.Render("Worktree: " + t.path))
+	}
+	s.WriteString("\n\n")
+
+	if t.following {
+		t.offset = m.maxTestRunOffset()
+	}
+	end := t.offset + m.testRunHeight()
+	if end > len(t.lines) {
+		end = len(t.lines)
+	}
+	if len(t.lines) > 0 {
+		s.WriteString(strings.Join(t.lines[t.offset:end], "\n"))
+		s.WriteString("\n")
+		s.WriteString(styles.MutedStyle.Render(fmt.Sprintf("[%d-%d/%d]", t.dropped+t.offset+1, t.dropped+end, t.dropped+len(t.lines))))
+	}
+	s.WriteString("\n\n")
+
+	helpItems := []string{
+		styles.FormatKeyBinding("j/k", "scroll"),
+		styles.FormatKeyBinding("ctrl+u/d", "page"),
+		styles.FormatKeyBinding("G", "follow"),
+	}
+	if t.phase == testRunDone {
+		helpItems = append(helpItems, styles.FormatKeyBinding("ctrl+t", "run again"), styles.FormatKeyBinding("q", "close"))
+	} else {
+		helpItems = append(helpItems, styles.FormatKeyBinding("q", "stop"))
+	}
+	s.WriteString(styles.HelpStyle.Render(strings.Join(helpItems, " • ")))
+	return s.String()
+}
+
+// testRunStatus describes how far the run has got
+func (m *PRDetailView) testRunStatus() string {
+	t := &m.testRun
+	switch t.phase {
+	case testRunCheckingOut:
+		return styles.LoadingStyle.Render(fmt.Sprintf("Fetching pull/%d/head...", m.pr.Number))
+	case testRunRunning:
+		return styles.PRPendingStyle.Render("Running " + t.command)
+	}
+
+	var exitErr *exec.ExitError
+	switch {
+	case t.err == nil && t.command == "":
+		return styles.SuccessStyle.Render("Checked out (no review.test_command configured)")
+	case t.err == nil:
+		return styles.SuccessStyle.Render(styles.IconCheck + " " + t.command + " passed")
+	case errors.As(t.err, &exitErr):
+		return styles.ErrorStyle.Render(fmt.Sprintf("%s %s failed (exit status %d)", styles.IconCross, t.command, exitErr.ExitCode()))
+	case t.path != "" && t.command != "":
+		return styles.ErrorStyle.Render(fmt.Sprintf("%s could not run: %v", t.command, t.err))
+	default:
+		return styles.ErrorStyle.Render(fmt.Sprintf("Checkout failed: %v", t.err))
+	}
+}
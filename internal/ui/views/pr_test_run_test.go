@@ -0,0 +1,126 @@
+package views
+
+import (
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// stubTestRun checks PRs out into dir and runs command there
+func stubTestRun(t *testing.T, dir, command string) *[]int {
+	t.Helper()
+	origRepo, origWorktree := currentRepository, pullRequestWorktree
+	t.Cleanup(func() {
+		currentRepository, pullRequestWorktree = origRepo, origWorktree
+		SetReviewCheckout("", "")
+	})
+
+	var fetched []int
+	currentRepository = func() (string, string, error) { return "owner", "repo", nil }
+	pullRequestWorktree = func(number int, parent string) (string, error) {
+		fetched = append(fetched, number)
+		return dir, nil
+	}
+	SetReviewCheckout(command, "")
+	return &fetched
+}
+
+// runTestPane feeds the messages of the run back to the view until it is done
+func runTestPane(view *PRDetailView, cmd tea.Cmd) {
+	for cmd != nil {
+		_, cmd = view.Update(cmd())
+	}
+}
+
+func TestPRDetailView_TestRun(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses sh")
+	}
+	dir := t.TempDir()
+	fetched := stubTestRun(t, dir, "echo running tests; echo FAIL >&2; exit 1")
+	view := NewPRDetailView(&models.PullRequest{Number: 9, State: models.PRStateOpen}, "owner", "repo", &testPRRepo{})
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	if !view.testRun.active || !view.IsCapturingInput() {
+		t.Fatal("expected the pane to open and take the keys")
+	}
+	if out := view.View(); !strings.Contains(out, "Fetching pull/9/head") {
+		t.Errorf("expected the fetch in progress, got %q", out)
+	}
+
+	runTestPane(view, cmd)
+	if len(*fetched) != 1 || (*fetched)[0] != 9 {
+		t.Errorf("fetched %v, want #9", *fetched)
+	}
+	out := view.View()
+	for _, want := range []string{"Worktree: " + dir, "running tests", "FAIL", "failed (exit status 1)", "run again"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in the pane, got %q", want, out)
+		}
+	}
+
+	// Closing the pane goes back to the PR
+	view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if view.testRun.active || view.IsCapturingInput() {
+		t.Error("expected esc to close the pane")
+	}
+}
+
+func TestPRDetailView_TestRunStop(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses sh")
+	}
+	stubTestRun(t, t.TempDir(), "echo started; sleep 30")
+	view := NewPRDetailView(&models.PullRequest{Number: 9}, "owner", "repo", &testPRRepo{})
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	_, cmd = view.Update(cmd())
+	if view.testRun.phase != testRunRunning {
+		t.Fatalf("expected the command to run, got phase %d (%v)", view.testRun.phase, view.testRun.err)
+	}
+	run := view.testRun.run
+
+	view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if !strings.Contains(view.statusMessage, "Stopped") {
+		t.Errorf("status = %q, want the command stopped", view.statusMessage)
+	}
+	for range run.Lines() {
+	}
+	if run.Err() == nil {
+		t.Error("expected the command to be killed")
+	}
+	// Output still on its way is dropped
+	if _, cmd := view.Update(testRunOutputMsg{id: view.testRun.id, lines: []string{"late"}}); cmd != nil || len(view.testRun.lines) > 1 {
+		t.Error("expected no more output once closed")
+	}
+}
+
+func TestPRDetailView_TestRunCheckoutOnly(t *testing.T) {
+	stubTestRun(t, "/tmp/repo-pr-9", "")
+	view := NewPRDetailView(&models.PullRequest{Number: 9}, "owner", "repo", &testPRRepo{})
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	runTestPane(view, cmd)
+	if out := view.View(); !strings.Contains(out, "no review.test_command configured") || !strings.Contains(out, "/tmp/repo-pr-9") {
+		t.Errorf("expected the checkout without a test run, got %q", out)
+	}
+}
+
+func TestPRDetailView_TestRunOutsideClone(t *testing.T) {
+	stubTestRun(t, t.TempDir(), "make test")
+	currentRepository = func() (string, string, error) { return "", "", errors.New("not a git repository") }
+	view := NewPRDetailView(&models.PullRequest{Number: 9}, "owner", "repo", &testPRRepo{})
+	view.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	runTestPane(view, cmd)
+	if out := view.View(); !strings.Contains(out, "not a clone of owner/repo") {
+		t.Errorf("expected the checkout to be refused, got %q", out)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/a1yama/tig-gh/internal/domain/models"
 	"github.com/a1yama/tig-gh/internal/domain/repository"
 	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/events"
 	"github.com/a1yama/tig-gh/internal/ui/styles"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -42,12 +43,31 @@ type PRView struct {
 	showHelp        bool
 	filterState     models.PRState
 	detailView      *PRDetailView
+	detailStack     []*PRDetailView    // detail views left to open a reference, innermost last
+	issueDetails    []*IssueDetailView // issues opened from the detail view, shown above it, innermost last
+	issueRepo       repository.IssueRepository
 	showingDetail   bool
+	localBranch     *localBranchState
+	checkingOut     bool
+	protectedPaths  models.ProtectedPaths
+	freezeWindows   models.FreezeWindows
+	protectedHits   map[int][]string
+	ready           map[int]bool // PRs ready to merge, listed first
+	readyCount      int
+	rangeActive     bool
+	rangeAnchor     int
+	batch           *batchActions
+	commitRepo      repository.CommitRepository
+	prCreator       prCreator
+	dates           dateRangeFilter
+	loads           loadGroup
+	live            liveList
 }
 
 // NewPRView creates a new PR view (for backward compatibility)
 func NewPRView() *PRView {
 	return &PRView{
+		loads:           loadGroup{source: sourcePullRequests},
 		fetchPRsUseCase: nil,
 		owner:           "",
 		repo:            "",
@@ -58,12 +78,14 @@ func NewPRView() *PRView {
 		statusBar:       components.NewStatusBar(),
 		showHelp:        false,
 		filterState:     models.PRStateOpen,
+		batch:           newBatchActions("pull requests"),
 	}
 }
 
 // NewPRViewWithUseCase creates a new PR view with UseCase
 func NewPRViewWithUseCase(fetchPRsUseCase FetchPRsUseCase, owner, repo string) *PRView {
 	return &PRView{
+		loads:           loadGroup{source: sourcePullRequests},
 		fetchPRsUseCase: fetchPRsUseCase,
 		owner:           owner,
 		repo:            repo,
@@ -74,39 +96,94 @@ func NewPRViewWithUseCase(fetchPRsUseCase FetchPRsUseCase, owner, repo string) *
 		statusBar:       components.NewStatusBar(),
 		showHelp:        false,
 		filterState:     models.PRStateOpen,
+		batch:           newBatchActions("pull requests"),
 	}
 }
 
 // Init initializes the PR view
 func (m *PRView) Init() tea.Cmd {
 	if m.fetchPRsUseCase != nil {
-		return m.fetchPRs()
+		return tea.Batch(m.fetchPRs(), loadLocalBranch(m.owner, m.repo))
 	}
 	return nil
 }
 
 // Update handles messages
 func (m *PRView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// A retry from the error banner reloads the list even while a detail view is open
+	if isRetryFor(msg, m) {
+		return m, m.refresh()
+	}
+
+	if _, ok := msg.(AutoRefreshMsg); ok {
+		return m, m.autoRefresh()
+	}
+
+	// Changes made elsewhere reload the list quietly; an open detail view
+	// reloads its PR instead
+	if live, ok := msg.(LiveUpdateMsg); ok && !m.IsShowingDetail() {
+		if live.touches(m.owner, m.repo, true) {
+			return m, m.autoRefresh()
+		}
+		return m, nil
+	}
+
+	if event, ok := msg.(events.EntityChanged); ok {
+		if event.Matches(m.owner, m.repo) {
+			replacePR(m.prs, event.PullRequest)
+		}
+		if m.detailView != nil {
+			m.detailView.Update(event)
+		}
+		return m, nil
+	}
+
+	// File checks finish in the background, possibly while a detail view is open
+	if loaded, ok := msg.(protectedPathsLoadedMsg); ok {
+		if strings.EqualFold(loaded.owner, m.owner) && strings.EqualFold(loaded.repo, m.repo) {
+			m.protectedHits = loaded.touched
+		}
+		return m, nil
+	}
+	if loaded, ok := msg.(prReadyLoadedMsg); ok {
+		if strings.EqualFold(loaded.owner, m.owner) && strings.EqualFold(loaded.repo, m.repo) {
+			m.setReady(loaded.ready)
+		}
+		return m, nil
+	}
+
+	// Batches keep running while a detail view is open or another view is shown
+	if progress, ok := msg.(components.ProgressMsg); ok {
+		return m, m.handleBatchProgress(progress)
+	}
+
+	// Issues opened from the detail view take the messages while they are shown
+	if m.showingDetail && len(m.issueDetails) > 0 {
+		return m, m.updateIssueDetail(msg)
+	}
+
 	// If showing detail view, delegate to detail view first
 	if m.showingDetail && m.detailView != nil {
 		// Let detail view handle all messages except backMsg
 		if _, isBackMsg := msg.(backMsg); isBackMsg {
-			m.showingDetail = false
-			m.detailView = nil
-			return m, nil
+			return m, m.backFromDetail()
+		}
+		if ref, ok := msg.(crossRefLoadedMsg); ok && ref.err == nil {
+			return m, m.openCrossRefDetail(ref)
 		}
 
+		// Keys typed into the review modal must not close the detail view
+		capturing := m.detailView.IsCapturingInput()
+
 		// Delegate to detail view
 		updatedModel, cmd := m.detailView.Update(msg)
 		m.detailView = updatedModel.(*PRDetailView)
 
 		// Check if it's a KeyMsg for back navigation
-		if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if keyMsg, ok := msg.(tea.KeyMsg); ok && !capturing {
 			keyStr := keyMsg.String()
 			if keyStr == "q" || keyStr == "esc" {
-				m.showingDetail = false
-				m.detailView = nil
-				return m, nil
+				return m, m.backFromDetail()
 			}
 		}
 
@@ -116,8 +193,7 @@ func (m *PRView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case backMsg:
 		// Return from detail view
-		m.showingDetail = false
-		m.detailView = nil
+		m.closeDetail()
 		return m, nil
 
 	case tea.KeyMsg:
@@ -126,11 +202,70 @@ func (m *PRView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		if m.batch != nil && m.batch.IsCapturingInput() {
+			return m, m.handleBatchKey(msg)
+		}
+		if m.prCreator.confirming {
+			return m, m.handlePRConfirmKey(msg)
+		}
+		if m.dates.isOpen() {
+			return m, m.handleDateRangeKey(msg)
+		}
+		// The report of a finished batch stays until the next key
+		if m.batch != nil {
+			m.batch.DismissReport()
+		}
+
 		// Handle key press in list view
 		return m.handleKeyPress(msg)
 
+	case openBrowserMsg:
+		m.statusBar.SetMessage(browserStatusMessage(msg))
+		return m, nil
+
+	case prDraftLoadedMsg:
+		return m, m.handlePRDraftLoaded(msg)
+
+	case editorClosedMsg:
+		if msg.purpose == editorPurposeNewPR {
+			return m, m.handlePRDraftEdited(msg)
+		}
+		return m, nil
+
+	case prCreatedMsg:
+		return m, m.handlePRCreated(msg)
+
+	case localBranchLoadedMsg:
+		// Local git state is an annotation only; errors leave the list unannotated
+		if msg.err == nil {
+			m.localBranch = msg.state
+		}
+		return m, nil
+
+	case prForHeadMsg:
+		return m, m.handlePRForHead(msg)
+
+	case prCheckedOutMsg:
+		m.checkingOut = false
+		if msg.err != nil {
+			m.statusBar.SetMessage(fmt.Sprintf("Checkout failed: %v", msg.err))
+			return m, nil
+		}
+		m.statusBar.SetMessage(fmt.Sprintf("Checked out %s (#%d)", msg.branch, msg.number))
+		return m, loadLocalBranch(m.owner, m.repo)
+
 	case prsLoadedMsg:
+		if isCancelled(msg.err) {
+			// Cancelled with esc or replaced by a newer fetch
+			return m, nil
+		}
+		// Loads started by the user show the loading screen; quiet ones do not
+		quiet := m.live.finish(msg.err == nil) && !m.loading
 		m.loading = false
+		if msg.err != nil && quiet {
+			// The list on screen stays; the banner tells what went wrong
+			return m, reportLoadError(m, "pull requests", msg.err)
+		}
 		if msg.err != nil {
 			m.err = msg.err
 			m.prs = []*models.PullRequest{}
@@ -141,22 +276,33 @@ func (m *PRView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				ensurePRNumber(pr)
 			}
 			m.prs = sorted
-			// Reset cursor if it's out of bounds
-			if m.cursor >= len(m.prs) && len(m.prs) > 0 {
+			m.readyCount = promoteReady(m.prs, m.ready)
+			m.pruneSelection()
+			if quiet {
+				m.cursor = m.live.cursorAfter(m.cursor, len(m.prs), func(i int) any { return m.prs[i].Number })
+			} else if m.cursor >= len(m.prs) && len(m.prs) > 0 {
+				// Reset cursor if it's out of bounds
 				m.cursor = len(m.prs) - 1
 			} else if len(m.prs) == 0 {
 				m.cursor = 0
 			}
+			return m, tea.Batch(m.checkProtectedPaths(), m.checkReadyToMerge())
 		}
-		return m, nil
+		return m, reportLoadError(m, "pull requests", msg.err)
 
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
 		m.statusBar.SetSize(msg.Width, 1)
+		if m.batch != nil {
+			m.batch.SetSize(msg.Width, msg.Height)
+		}
 		if m.detailView != nil {
 			m.detailView.Update(msg)
 		}
+		for _, view := range m.issueDetails {
+			view.Update(msg)
+		}
 		return m, nil
 	}
 
@@ -165,6 +311,8 @@ func (m *PRView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 // fetchPRs fetches pull requests from the API
 func (m *PRView) fetchPRs() tea.Cmd {
+	ctx := m.live.context(m.loads.Restart())
+	dates := m.dates.current
 	return func() tea.Msg {
 		if m.fetchPRsUseCase == nil {
 			return prsLoadedMsg{
@@ -173,14 +321,18 @@ func (m *PRView) fetchPRs() tea.Cmd {
 			}
 		}
 
-		opts := &models.PROptions{
-			State:     m.filterState,
-			Sort:      models.PRSortUpdated,
-			Direction: models.SortDirectionDesc,
-			PerPage:   100,
-		}
-
-		prs, err := m.fetchPRsUseCase.Execute(context.Background(), m.owner, m.repo, opts)
+		prs, err := fetchPages(func(page, perPage int) ([]*models.PullRequest, error) {
+			opts := &models.PROptions{
+				State:     m.filterState,
+				Sort:      models.PRSortUpdated,
+				Direction: models.SortDirectionDesc,
+				PerPage:   perPage,
+				Page:      page,
+				Since:     dates.Since,
+				Until:     dates.Until,
+			}
+			return m.fetchPRsUseCase.Execute(ctx, m.owner, m.repo, opts)
+		})
 		return prsLoadedMsg{
 			prs: prs,
 			err: err,
@@ -188,6 +340,126 @@ func (m *PRView) fetchPRs() tea.Cmd {
 	}
 }
 
+// handleDateRangeKey forwards input to the date range form and reloads the
+// PRs merged, closed or opened in the submitted range
+func (m *PRView) handleDateRangeKey(msg tea.KeyMsg) tea.Cmd {
+	changed, err := m.dates.handleKey(msg)
+	if err != nil {
+		m.statusBar.SetMessage(fmt.Sprintf("Invalid date range: %v", err))
+		return nil
+	}
+	if !changed || m.fetchPRsUseCase == nil {
+		return nil
+	}
+	m.cursor = 0
+	m.loading = true
+	m.err = nil
+	return m.fetchPRs()
+}
+
+// SetProtectedPaths sets the path patterns whose changes need extra care
+func (m *PRView) SetProtectedPaths(patterns []string) {
+	m.protectedPaths = models.ProtectedPaths(patterns)
+}
+
+// SetFreezeWindows sets the merge freeze windows checked before merging
+func (m *PRView) SetFreezeWindows(windows models.FreezeWindows) {
+	m.freezeWindows = windows
+}
+
+// checkProtectedPaths checks the listed PRs against the protected paths
+func (m *PRView) checkProtectedPaths() tea.Cmd {
+	if m.fetchPRsUseCase == nil {
+		return nil
+	}
+	return loadProtectedPaths(m.loads.Context(), m.fetchPRsUseCase.GetRepository(), m.owner, m.repo, m.protectedPaths, m.prs)
+}
+
+// checkReadyToMerge asks which of the listed PRs can be merged now
+func (m *PRView) checkReadyToMerge() tea.Cmd {
+	if m.fetchPRsUseCase == nil {
+		return nil
+	}
+	return loadReadyToMerge(m.loads.Context(), m.fetchPRsUseCase.GetRepository(), m.owner, m.repo, openPRNumbers(m.prs))
+}
+
+// setReady moves the PRs ready to merge to the top, keeping the cursor on
+// the same PR
+func (m *PRView) setReady(ready map[int]bool) {
+	selected := 0
+	if m.cursor < len(m.prs) {
+		selected = m.prs[m.cursor].Number
+	}
+	m.ready = ready
+	m.readyCount = promoteReady(sortPullRequests(m.prs), ready)
+	for i, pr := range m.prs {
+		if pr.Number == selected {
+			m.cursor = i
+			break
+		}
+	}
+}
+
+// closeDetail closes the detail view, cancelling its fetches
+func (m *PRView) closeDetail() {
+	if m.detailView != nil {
+		m.detailView.Close()
+	}
+	for _, view := range m.detailStack {
+		view.Close()
+	}
+	for _, view := range m.issueDetails {
+		view.Close()
+	}
+	m.showingDetail = false
+	m.detailView = nil
+	m.detailStack = nil
+	m.issueDetails = nil
+}
+
+// refresh reloads the pull requests unless a load is already running
+func (m *PRView) refresh() tea.Cmd {
+	if m.loading || m.fetchPRsUseCase == nil {
+		return nil
+	}
+	m.loading = true
+	m.err = nil
+	return tea.Batch(m.fetchPRs(), loadLocalBranch(m.owner, m.repo))
+}
+
+// autoRefresh reloads the pull requests quietly, keeping the list on screen
+// and the cursor on its PR. Nothing is reloaded while the user is busy with the view.
+func (m *PRView) autoRefresh() tea.Cmd {
+	if m.loading || m.fetchPRsUseCase == nil || m.IsShowingDetail() || m.IsCapturingInput() ||
+		(m.batch != nil && m.batch.Running()) {
+		return nil
+	}
+	var keep any
+	if m.cursor < len(m.prs) {
+		keep = m.prs[m.cursor].Number
+	}
+	m.live.start(keep)
+	return m.fetchPRs()
+}
+
+// openDetail shows the detail view of a pull request
+func (m *PRView) openDetail(pr *models.PullRequest) tea.Cmd {
+	var prRepo repository.PullRequestRepository
+	if m.fetchPRsUseCase != nil {
+		prRepo = m.fetchPRsUseCase.GetRepository()
+	}
+	m.detailView = NewPRDetailView(pr, m.owner, m.repo, prRepo)
+	m.detailView.SetProtectedPaths(m.protectedPaths)
+	m.detailView.SetFreezeWindows(m.freezeWindows)
+	m.detailView.SetIssueRepository(m.issueRepo)
+	m.detailView.SetCommitRepository(m.commitRepo)
+	m.detailView.width = m.width
+	m.detailView.height = m.height
+	m.showingDetail = true
+	// Return detail view's Init command to trigger immediate update
+	return m.detailView.Init()
+}
+
 // handleKeyPress handles keyboard input
 func (m *PRView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	keyStr := msg.String()
@@ -196,17 +468,7 @@ func (m *PRView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if msg.Type == tea.KeyEnter {
 		// View PR detail
 		if len(m.prs) > 0 && m.cursor < len(m.prs) {
-			selectedPR := m.prs[m.cursor]
-			var prRepo repository.PullRequestRepository
-			if m.fetchPRsUseCase != nil {
-				prRepo = m.fetchPRsUseCase.GetRepository()
-			}
-			m.detailView = NewPRDetailView(selectedPR, m.owner, m.repo, prRepo)
-			m.detailView.width = m.width
-			m.detailView.height = m.height
-			m.showingDetail = true
-			// Return detail view's Init command to trigger immediate update
-			return m, m.detailView.Init()
+			return m, m.openDetail(m.prs[m.cursor])
 		}
 		return m, nil
 	}
@@ -221,12 +483,25 @@ func (m *PRView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	case "r":
 		// Refresh PRs
-		if !m.loading && m.fetchPRsUseCase != nil {
-			m.loading = true
-			m.err = nil
-			return m, m.fetchPRs()
+		return m, m.refresh()
+
+	case "ctrl+o":
+		// Check out the selected PR's branch in the local clone
+		if len(m.prs) == 0 || m.cursor >= len(m.prs) || m.checkingOut {
+			return m, nil
 		}
-		return m, nil
+		if m.localBranch == nil {
+			m.statusBar.SetMessage(fmt.Sprintf("Not in a local clone of %s/%s", m.owner, m.repo))
+			return m, nil
+		}
+		pr := m.prs[m.cursor]
+		if m.localBranch.isCheckedOut(pr) {
+			m.statusBar.SetMessage(fmt.Sprintf("#%d is already checked out", pr.Number))
+			return m, nil
+		}
+		m.checkingOut = true
+		m.statusBar.SetMessage(fmt.Sprintf("Checking out #%d...", pr.Number))
+		return m, checkoutPR(pr)
 
 	case "f":
 		// Toggle filter between open, closed, all
@@ -248,6 +523,13 @@ func (m *PRView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case "D":
+		// Narrow the list to a date range
+		if !m.loading {
+			m.dates.open(m.width, m.height)
+		}
+		return m, nil
+
 	case "j", "down":
 		if m.cursor < len(m.prs)-1 {
 			m.cursor++
@@ -272,6 +554,13 @@ func (m *PRView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case "o":
+		// Open selected PR in browser
+		if len(m.prs) > 0 && m.cursor < len(m.prs) {
+			return m, openInBrowser(m.prs[m.cursor].HTMLURL)
+		}
+		return m, nil
+
 	case "d":
 		// View diff (to be implemented)
 		return m, nil
@@ -280,11 +569,212 @@ func (m *PRView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Merge PR (to be implemented)
 		// TODO: Add merge functionality with proper use case
 		return m, nil
+
+	case "v", " ":
+		// Toggle selection of the PR under the cursor
+		if len(m.prs) > 0 && m.cursor < len(m.prs) {
+			toggleSelection(m.selected, m.prs[m.cursor].Number)
+		}
+		return m, nil
+
+	case "V":
+		// Start a range selection, or select everything between its start and the cursor
+		if len(m.prs) == 0 {
+			return m, nil
+		}
+		if !m.rangeActive {
+			m.rangeActive = true
+			m.rangeAnchor = m.cursor
+			m.statusBar.SetMessage("Range select: move the cursor and press V again")
+			return m, nil
+		}
+		m.rangeActive = false
+		from, to := selectionRange(m.rangeAnchor, m.cursor)
+		for i := from; i <= to && i < len(m.prs); i++ {
+			m.selected[m.prs[i].Number] = struct{}{}
+		}
+		m.statusBar.SetMessage("")
+		return m, nil
+
+	case "esc":
+		// Stop loading, then cancel a range selection, then clear the selection
+		if m.loading {
+			m.loads.Cancel()
+			m.loading = false
+			m.statusBar.SetMessage(loadCancelledStatus)
+		} else if m.rangeActive {
+			m.rangeActive = false
+			m.statusBar.SetMessage("")
+		} else {
+			m.selected = make(map[int]struct{})
+		}
+		return m, nil
+
+	case "x":
+		// Cancel the running batch action
+		if m.batch != nil && m.batch.Running() {
+			m.batch.Cancel()
+		}
+		return m, nil
+
+	case "H":
+		// Open the PR that introduced the locally checked-out commit
+		return m, m.findPRForHead()
+
+	case "W":
+		// Watch the PR under the cursor for new activity, or stop watching it
+		if len(m.prs) > 0 && m.cursor < len(m.prs) {
+			pr := m.prs[m.cursor]
+			m.statusBar.SetMessage(toggleWatch(&models.WatchedItem{
+				Owner:         m.owner,
+				Repo:          m.repo,
+				Number:        pr.Number,
+				IsPullRequest: true,
+				Title:         pr.Title,
+				HTMLURL:       pr.HTMLURL,
+			}))
+		}
+		return m, nil
+
+	case "b":
+		// Apply an action to every selected PR (or the one under the cursor)
+		return m, m.openBatchMenu()
+
+	case "n":
+		// Open a pull request for the checked-out branch
+		return m, m.startCreatePR()
 	}
 
 	return m, nil
 }
 
+// openBatchMenu opens the batch action menu for the selected PRs
+func (m *PRView) openBatchMenu() tea.Cmd {
+	if len(m.prs) == 0 || m.fetchPRsUseCase == nil {
+		return nil
+	}
+	if !canWrite(m.fetchPRsUseCase.GetRepository()) {
+		m.statusBar.SetMessage(readOnlyStatus)
+		return nil
+	}
+	if m.batch == nil {
+		m.batch = newBatchActions("pull requests")
+		m.batch.SetSize(m.width, m.height)
+	}
+	if m.batch.Running() {
+		m.statusBar.SetMessage("A batch action is still running (x to cancel)")
+		return nil
+	}
+
+	numbers := sortedSelection(m.selected)
+	if len(numbers) == 0 && m.cursor < len(m.prs) {
+		numbers = []int{m.prs[m.cursor].Number}
+	}
+	m.rangeActive = false
+	m.batch.Open(numbers)
+	return nil
+}
+
+// handleBatchKey forwards a key to the batch menu and starts the batch once it is complete
+func (m *PRView) handleBatchKey(msg tea.KeyMsg) tea.Cmd {
+	request, err := m.batch.HandleKey(msg)
+	if err != nil {
+		m.statusBar.SetMessage(err.Error())
+		return nil
+	}
+	if request == nil {
+		return nil
+	}
+	m.statusBar.SetMessage("")
+	return m.batch.Start(m.loads.writeContext(), request, m.batchApplyFunc(request))
+}
+
+// handleBatchProgress updates the progress of the running batch and reports its result
+func (m *PRView) handleBatchProgress(msg components.ProgressMsg) tea.Cmd {
+	if m.batch == nil || !m.batch.OwnsProgress(msg) {
+		return nil
+	}
+	result, cmd := m.batch.HandleProgress(msg)
+	if result == nil {
+		return cmd
+	}
+
+	// Failed and skipped PRs stay selected so the batch can be retried
+	for _, number := range result.succeeded {
+		delete(m.selected, number)
+	}
+	m.statusBar.SetMessage(m.batch.Summary(result))
+	cmds := make([]tea.Cmd, 0, len(result.events))
+	for _, event := range result.events {
+		cmds = append(cmds, events.Publish(event))
+	}
+	return tea.Batch(cmds...)
+}
+
+// batchApplyFunc returns the function applying a batch request to one PR
+func (m *PRView) batchApplyFunc(request *batchRequest) batchApplyFunc {
+	prRepo := m.fetchPRsUseCase.GetRepository()
+	owner, repo := m.owner, m.repo
+
+	// Labels and assignees are added to (or removed from) the ones each PR already has
+	labels := make(map[int][]string, len(m.prs))
+	assignees := make(map[int][]string, len(m.prs))
+	for _, pr := range m.prs {
+		labels[pr.Number] = labelNames(pr.Labels)
+		assignees[pr.Number] = userLogins(pr.Assignees)
+	}
+
+	return func(ctx context.Context, number int) (*events.EntityChanged, error) {
+		input := &models.UpdatePRInput{}
+		switch request.action {
+		case batchActionLabel:
+			merged := mergeBatchValues(labels[number], request.values)
+			input.Labels = &merged
+		case batchActionUnlabel:
+			remaining := removeBatchValues(labels[number], request.values)
+			input.Labels = &remaining
+		case batchActionAssign:
+			merged := mergeBatchValues(assignees[number], request.values)
+			input.Assignees = &merged
+		case batchActionMilestone:
+			milestone := request.milestone
+			input.Milestone = &milestone
+		case batchActionClose:
+			state := models.PRStateClosed
+			input.State = &state
+		}
+
+		pr, err := prRepo.Update(ctx, owner, repo, number, input)
+		if err != nil {
+			return nil, err
+		}
+		ensurePRNumber(pr)
+		event := events.PullRequestChanged(batchEventAction(request.action), owner, repo, pr)
+		return &event, nil
+	}
+}
+
+// pruneSelection drops selected PRs that are no longer listed
+func (m *PRView) pruneSelection() {
+	listed := make(map[int]struct{}, len(m.prs))
+	for _, pr := range m.prs {
+		listed[pr.Number] = struct{}{}
+	}
+	pruneSelection(m.selected, listed)
+	m.rangeActive = false
+}
+
+// renderSelectionMark renders whether a PR is selected or inside the pending range
+func (m *PRView) renderSelectionMark(pr *models.PullRequest, index int) string {
+	_, selected := m.selected[pr.Number]
+	inRange := false
+	if m.rangeActive {
+		from, to := selectionRange(m.rangeAnchor, m.cursor)
+		inRange = index >= from && index <= to
+	}
+	return renderSelectionMark(selected, inRange)
+}
+
 // View renders the PR view
 func (m *PRView) View() string {
 	if m.width == 0 || m.height == 0 {
@@ -292,10 +782,25 @@ func (m *PRView) View() string {
 	}
 
 	// If showing detail view, render it
+	if n := len(m.issueDetails); m.showingDetail && n > 0 {
+		return m.issueDetails[n-1].View()
+	}
 	if m.showingDetail && m.detailView != nil {
 		return m.detailView.View()
 	}
 
+	if m.batch != nil && m.batch.IsCapturingInput() {
+		return m.batch.View()
+	}
+
+	if m.prCreator.confirming {
+		return m.renderPRConfirm()
+	}
+
+	if m.dates.isOpen() {
+		return m.dates.form.View()
+	}
+
 	var s strings.Builder
 
 	// Header
@@ -318,6 +823,15 @@ func (m *PRView) View() string {
 		s.WriteString(m.renderHelp())
 	}
 
+	// Progress of a running batch action, or how each item of the finished one went
+	if m.batch != nil && m.batch.Running() {
+		s.WriteString("\n")
+		s.WriteString(m.batch.ProgressView())
+	} else if m.batch != nil && m.batch.HasReport() {
+		s.WriteString("\n")
+		s.WriteString(m.batch.ReportView())
+	}
+
 	// Status bar
 	s.WriteString("\n")
 	m.updateStatusBar()
@@ -331,12 +845,11 @@ func (m *PRView) renderHeader() string {
 	title := styles.HeaderStyle.Render("Pull Requests")
 	count := styles.MutedStyle.Render(fmt.Sprintf("(%d)", len(m.prs)))
 
-	return lipgloss.JoinHorizontal(
-		lipgloss.Top,
-		title,
-		" ",
-		count,
-	)
+	parts := []string{title, " ", count}
+	if dates := m.dates.header(); dates != "" {
+		parts = append(parts, " ", dates)
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, parts...)
 }
 
 // renderPRList renders the list of pull requests
@@ -353,30 +866,26 @@ func (m *PRView) renderPRList() string {
 	if m.showHelp {
 		availableHeight -= 10 // Reserve space for help
 	}
-
-	// Calculate visible range
-	startIdx := 0
-	endIdx := len(m.prs)
-
-	if len(m.prs) > availableHeight {
-		// Show items around cursor
-		halfHeight := availableHeight / 2
-		startIdx = m.cursor - halfHeight
-		if startIdx < 0 {
-			startIdx = 0
-		}
-		endIdx = startIdx + availableHeight
-		if endIdx > len(m.prs) {
-			endIdx = len(m.prs)
-			startIdx = endIdx - availableHeight
-			if startIdx < 0 {
-				startIdx = 0
-			}
-		}
+	if m.batch != nil && m.batch.Running() {
+		availableHeight-- // Reserve space for the progress bar
+	} else if m.batch != nil {
+		availableHeight -= m.batch.ReportHeight() // Reserve space for the batch report
+	}
+	if m.readyCount > 0 {
+		availableHeight -= 2 // Reserve space for the section titles
 	}
 
+	// Calculate visible range: only the rows on screen are rendered
+	startIdx, endIdx := components.VisibleRange(m.cursor, len(m.prs), availableHeight)
+
 	// Render visible PRs
 	for i := startIdx; i < endIdx; i++ {
+		switch {
+		case i == 0 && m.readyCount > 0:
+			s.WriteString(renderReadyHeader(m.readyCount) + "\n")
+		case i == m.readyCount && m.readyCount > 0:
+			s.WriteString(styles.MutedStyle.Render("Other pull requests") + "\n")
+		}
 		pr := m.prs[i]
 		line := m.renderPRLine(pr, i)
 		s.WriteString(line)
@@ -391,13 +900,16 @@ func (m *PRView) renderPRLine(pr *models.PullRequest, index int) string {
 	// Cursor indicator
 	cursor := "  "
 	if m.cursor == index {
-		cursor = styles.CursorStyle.Render("▶ ")
+		cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
+	}
+	if len(m.selected) > 0 || m.rangeActive {
+		cursor += m.renderSelectionMark(pr, index)
 	}
 
 	// State badge
 	var stateBadge string
 	if pr.Draft {
-		stateBadge = styles.MutedStyle.Render("● DRAFT")
+		stateBadge = styles.MutedStyle.Render(styles.IconDot + " DRAFT")
 	} else {
 		switch pr.State {
 		case models.PRStateOpen:
@@ -425,6 +937,12 @@ func (m *PRView) renderPRLine(pr *models.PullRequest, index int) string {
 		number = styles.IssueNumberStyle.Render("#????")
 	}
 
+	// Size badge (only when the size is known)
+	sizeBadge := ""
+	if size, ok := prSizeOf(pr); ok {
+		sizeBadge = renderPRSizeBadge(size) + " "
+	}
+
 	// Title (with max width to prevent wrapping)
 	titleStyle := styles.IssueTitleStyle
 	if m.cursor == index {
@@ -456,9 +974,9 @@ func (m *PRView) renderPRLine(pr *models.PullRequest, index int) string {
 	mergeableStatus := ""
 	if pr.State == models.PRStateOpen && !pr.Draft {
 		if pr.Mergeable {
-			mergeableStatus = " " + styles.PRApprovedStyle.Render("✓")
+			mergeableStatus = " " + styles.PRApprovedStyle.Render(styles.IconCheck)
 		} else {
-			mergeableStatus = " " + styles.PRChangesRequestedStyle.Render("✗")
+			mergeableStatus = " " + styles.PRChangesRequestedStyle.Render(styles.IconCross)
 		}
 	}
 
@@ -472,6 +990,18 @@ func (m *PRView) renderPRLine(pr *models.PullRequest, index int) string {
 		labels = " " + strings.Join(labelParts, " ")
 	}
 
+	// Local branch (checked out or available locally)
+	localBranch := renderLocalBranchBadge(pr, m.localBranch)
+
+	// Protected paths touched by the PR
+	protected := renderProtectedPathsBadge(m.protectedHits[pr.Number])
+
+	// Watched for new activity
+	watched := ""
+	if mark := renderWatchMark(m.owner, m.repo, pr.Number); mark != "" {
+		watched = " " + mark
+	}
+
 	// Metadata (author, date)
 	author := styles.AuthorStyle.Render(formatAuthorHandle(pr.Author))
 	relativeTime := formatRelativeTime(pr.UpdatedAt)
@@ -485,10 +1015,14 @@ func (m *PRView) renderPRLine(pr *models.PullRequest, index int) string {
 		" ",
 		number,
 		" ",
+		sizeBadge,
 		title,
 		labels,
 		reviewStatus,
 		mergeableStatus,
+		localBranch,
+		protected,
+		watched,
 		" ",
 		author,
 		" ",
@@ -518,10 +1052,10 @@ func (m *PRView) renderReviewStatus(approved, changesRequested, pending int) str
 	var parts []string
 
 	if approved > 0 {
-		parts = append(parts, styles.PRApprovedStyle.Render(fmt.Sprintf("✓%d", approved)))
+		parts = append(parts, styles.PRApprovedStyle.Render(fmt.Sprintf("%s%d", styles.IconCheck, approved)))
 	}
 	if changesRequested > 0 {
-		parts = append(parts, styles.PRChangesRequestedStyle.Render(fmt.Sprintf("✗%d", changesRequested)))
+		parts = append(parts, styles.PRChangesRequestedStyle.Render(fmt.Sprintf("%s%d", styles.IconCross, changesRequested)))
 	}
 	if pending > 0 {
 		parts = append(parts, styles.PRPendingStyle.Render(fmt.Sprintf("?%d", pending)))
@@ -555,15 +1089,29 @@ Navigation:
 
 Actions:
   enter   View PR details
+  n       New PR from the checked-out branch
+  o       Open in browser
+  ctrl+o  Checkout PR branch locally
+  H       Open the PR that introduced the local HEAD
   d       View diff
   m       Merge PR
   r       Refresh
   f       Toggle filter (open/closed/all)
+  D       Date range (merged, closed or opened in it)
+  W       Watch/unwatch (notify on new activity)
+
+Selection:
+  v/space Toggle selection
+  V       Start/end range selection
+  b       Batch action (add/remove labels, close, assign, milestone)
+  x       Cancel the running batch action
+  esc     Clear selection
 
 General:
   ?       Toggle help
   q       Quit
   ctrl+c  Force quit
+  ctrl+z  Suspend (resume with fg)
 `
 
 	return styles.BorderStyle.Render(
@@ -589,11 +1137,18 @@ func (m *PRView) updateStatusBar() {
 	if len(m.selected) > 0 {
 		m.statusBar.AddItem("Selected", fmt.Sprintf("%d", len(m.selected)))
 	}
+	if m.rangeActive {
+		m.statusBar.AddItem("", "-- RANGE --")
+	}
 
 	// Add repository info
 	if m.owner != "" && m.repo != "" {
 		m.statusBar.AddItem("Repo", fmt.Sprintf("%s/%s", m.owner, m.repo))
 	}
+
+	if updated := m.live.indicator(); updated != "" {
+		m.statusBar.AddItem("", updated)
+	}
 }
 
 func sortPullRequests(prs []*models.PullRequest) []*models.PullRequest {
@@ -614,3 +1169,20 @@ func sortPullRequests(prs []*models.PullRequest) []*models.PullRequest {
 
 	return prs
 }
+
+// IsShowingDetail returns true while a detail view is open
+func (m *PRView) IsShowingDetail() bool {
+	return m.showingDetail && m.detailView != nil
+}
+
+// IsCapturingInput returns true while the open detail view, the batch menu
+// or the new pull request confirmation is taking input
+func (m *PRView) IsCapturingInput() bool {
+	if n := len(m.issueDetails); m.IsShowingDetail() && n > 0 {
+		return m.issueDetails[n-1].IsCapturingInput()
+	}
+	if m.IsShowingDetail() {
+		return m.detailView.IsCapturingInput()
+	}
+	return m.prCreator.confirming || m.dates.isOpen() || (m.batch != nil && m.batch.IsCapturingInput())
+}
@@ -0,0 +1,20 @@
+package views
+
+import "github.com/a1yama/tig-gh/internal/domain/repository"
+
+// readOnlyStatus is shown when a write action is attempted in a guest session
+const readOnlyStatus = "Not available in guest mode (read-only)"
+
+// signInHint stands in for what GitHub only shows to signed-in users
+const signInHint = "Sign in (set GITHUB_TOKEN) to see %s"
+
+// canWrite reports whether write actions can be performed through the repository
+func canWrite(repo interface{}) bool {
+	return repo != nil && !repository.IsReadOnly(repo)
+}
+
+// isGuest reports whether the repository belongs to a guest session. GitHub
+// answers GraphQL queries only with a token, so guests skip what needs them.
+func isGuest(repo interface{}) bool {
+	return repo != nil && repository.IsReadOnly(repo)
+}
@@ -0,0 +1,14 @@
+package views
+
+import "fmt"
+
+// recoverPanic turns a panic in a goroutine started by a view into an error.
+// bubbletea only recovers panics in Update and in commands; a panic in any
+// other goroutine would kill the process with the terminal still in raw mode
+// on the alternate screen. Use it as `defer recoverPanic(&err)`; err may be
+// nil when the goroutine has no error to report.
+func recoverPanic(err *error) {
+	if r := recover(); r != nil && err != nil {
+		*err = fmt.Errorf("unexpected error: %v", r)
+	}
+}
@@ -0,0 +1,439 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// assetDownloadDir is where release assets are saved (overridable in tests)
+var assetDownloadDir = "."
+
+// assetDownloadedMsg is sent when a release asset has been saved
+type assetDownloadedMsg struct {
+	path  string
+	bytes int64
+	err   error
+}
+
+// ReleaseDetailView shows a release's notes and assets
+type ReleaseDetailView struct {
+	release       *models.Release
+	owner         string
+	repo          string
+	releaseRepo   repository.ReleaseRepository
+	scrollOffset  int
+	width         int
+	height        int
+	renderer      *glamour.TermRenderer
+	statusMessage string
+	selectedAsset int
+	downloading   bool
+	progress      *components.ProgressBar
+	reporter      *components.ProgressReporter
+	cancel        context.CancelFunc
+}
+
+// NewReleaseDetailView creates a new release detail view
+func NewReleaseDetailView(release *models.Release, owner, repo string, releaseRepo repository.ReleaseRepository) *ReleaseDetailView {
+	return &ReleaseDetailView{
+		release:     release,
+		owner:       owner,
+		repo:        repo,
+		releaseRepo: releaseRepo,
+		renderer:    newMarkdownRenderer(80),
+		progress:    components.NewProgressBar(),
+	}
+}
+
+// Init initializes the release detail view
+func (m *ReleaseDetailView) Init() tea.Cmd {
+	return nil
+}
+
+// Close cancels a download still running when the view is closed
+func (m *ReleaseDetailView) Close() {
+	if m.cancel != nil {
+		m.cancel()
+	}
+}
+
+// startDownload downloads the asset while reporting its progress
+func (m *ReleaseDetailView) startDownload(asset *models.ReleaseAsset) tea.Cmd {
+	ctx, cancel := context.WithCancel(sourceContext(sourceReleaseDetail))
+	reporter := components.NewProgressReporter("download")
+	m.downloading = true
+	m.cancel = cancel
+	m.reporter = reporter
+	m.statusMessage = ""
+	m.progress.Start("Downloading "+asset.Name, components.ProgressUnitBytes, "x")
+	m.progress.SetProgress(models.Progress{Total: int64(asset.Size)})
+
+	download := m.downloadAsset(ctx, asset, reporter)
+	return tea.Batch(func() tea.Msg {
+		defer cancel()
+		return download()
+	}, reporter.Listen())
+}
+
+// downloadAsset saves the asset into assetDownloadDir without overwriting existing files
+func (m *ReleaseDetailView) downloadAsset(ctx context.Context, asset *models.ReleaseAsset, reporter *components.ProgressReporter) tea.Cmd {
+	return func() tea.Msg {
+		progress := models.Progress{Total: int64(asset.Size)}
+		defer func() { reporter.Finish(progress) }()
+
+		if m.releaseRepo == nil {
+			return assetDownloadedMsg{err: fmt.Errorf("release repository not available")}
+		}
+
+		name := filepath.Base(filepath.Clean("/" + asset.Name))
+		if name == "/" || name == "." {
+			return assetDownloadedMsg{err: fmt.Errorf("invalid asset name %q", asset.Name)}
+		}
+		path := filepath.Join(assetDownloadDir, name)
+
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err != nil {
+			if errors.Is(err, os.ErrExist) {
+				return assetDownloadedMsg{path: path, err: fmt.Errorf("%s already exists", path)}
+			}
+			return assetDownloadedMsg{path: path, err: err}
+		}
+
+		rc, err := m.releaseRepo.DownloadAsset(ctx, m.owner, m.repo, asset.ID)
+		if err != nil {
+			file.Close()
+			os.Remove(path)
+			return assetDownloadedMsg{path: path, err: err}
+		}
+		defer rc.Close()
+
+		written, err := copyWithProgress(ctx, file, rc, func(done int64) {
+			progress.Done = done
+			reporter.Report(progress)
+		})
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			// Don't leave a truncated file behind
+			os.Remove(path)
+			return assetDownloadedMsg{path: path, err: err}
+		}
+		return assetDownloadedMsg{path: path, bytes: written}
+	}
+}
+
+// copyWithProgress copies src to dst, reporting the bytes copied so far and
+// stopping once ctx is cancelled
+func copyWithProgress(ctx context.Context, dst io.Writer, src io.Reader, report func(done int64)) (int64, error) {
+	buf := make([]byte, 32*1024)
+	var written int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return written, err
+		}
+		n, readErr := src.Read(buf)
+		if n > 0 {
+			if _, err := dst.Write(buf[:n]); err != nil {
+				return written, err
+			}
+			written += int64(n)
+			report(written)
+		}
+		if readErr == io.EOF {
+			return written, nil
+		}
+		if readErr != nil {
+			return written, readErr
+		}
+	}
+}
+
+// Update handles messages
+func (m *ReleaseDetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return m.handleKeyPress(msg)
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case components.ProgressMsg:
+		if m.reporter == nil || msg.ID != m.reporter.ID() || msg.Finished {
+			return m, nil
+		}
+		m.progress.SetProgress(msg.Progress)
+		return m, m.reporter.Listen()
+
+	case assetDownloadedMsg:
+		m.downloading = false
+		m.reporter = nil
+		m.cancel = nil
+		m.progress.Stop()
+		if errors.Is(msg.err, context.Canceled) {
+			m.statusMessage = "Download cancelled"
+			return m, nil
+		}
+		if msg.err != nil {
+			m.statusMessage = fmt.Sprintf("Download failed: %v", msg.err)
+			return m, nil
+		}
+		m.statusMessage = fmt.Sprintf("Saved %s (%s)", msg.path, formatByteSize(int(msg.bytes)))
+		return m, nil
+
+	case openBrowserMsg:
+		m.statusMessage = browserStatusMessage(msg)
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// handleKeyPress handles keyboard input
+func (m *ReleaseDetailView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "q", "esc":
+		return m, func() tea.Msg {
+			return backMsg{}
+		}
+
+	case "j", "down":
+		m.scrollOffset++
+		return m, nil
+
+	case "k", "up":
+		if m.scrollOffset > 0 {
+			m.scrollOffset--
+		}
+		return m, nil
+
+	case "g":
+		m.scrollOffset = 0
+		return m, nil
+
+	case "G":
+		// Capped in View
+		m.scrollOffset = 9999
+		return m, nil
+
+	case "n":
+		if m.selectedAsset < len(m.release.Assets)-1 {
+			m.selectedAsset++
+		}
+		m.statusMessage = m.selectedAssetStatus()
+		return m, nil
+
+	case "N":
+		if m.selectedAsset > 0 {
+			m.selectedAsset--
+		}
+		m.statusMessage = m.selectedAssetStatus()
+		return m, nil
+
+	case "s":
+		// Save the selected asset
+		if len(m.release.Assets) == 0 || m.downloading {
+			return m, nil
+		}
+		return m, m.startDownload(m.release.Assets[m.selectedAsset])
+
+	case "x":
+		// Cancel the running download
+		if m.downloading && m.cancel != nil {
+			m.progress.Cancel()
+			m.cancel()
+		}
+		return m, nil
+
+	case "o":
+		return m, openInBrowser(m.release.HTMLURL)
+	}
+
+	return m, nil
+}
+
+// selectedAssetStatus describes the selected asset
+func (m *ReleaseDetailView) selectedAssetStatus() string {
+	if len(m.release.Assets) == 0 {
+		return "No assets"
+	}
+	asset := m.release.Assets[m.selectedAsset]
+	return fmt.Sprintf("Asset %d/%d: %s", m.selectedAsset+1, len(m.release.Assets), asset.Name)
+}
+
+// View renders the release detail view
+func (m *ReleaseDetailView) View() string {
+	if m.width == 0 || m.height == 0 {
+		return "Initializing..."
+	}
+
+	var content strings.Builder
+
+	content.WriteString(m.renderHeader())
+	content.WriteString("\n\n")
+	content.WriteString(m.renderMetadata())
+	content.WriteString("\n\n")
+	content.WriteString(m.renderAssets())
+	content.WriteString("\n\n")
+	content.WriteString(styles.Separator(m.width - 4))
+	content.WriteString("\n\n")
+	content.WriteString(m.renderNotes())
+	content.WriteString("\n\n")
+
+	var s strings.Builder
+	s.WriteString(m.applyScrolling(content.String()))
+	s.WriteString("\n")
+	s.WriteString(m.renderFooter())
+
+	return s.String()
+}
+
+// renderHeader renders the release name, tag and badges
+func (m *ReleaseDetailView) renderHeader() string {
+	tag := styles.IssueNumberStyle.Render(m.release.TagName)
+	header := lipgloss.JoinHorizontal(lipgloss.Top, tag, renderReleaseBadges(m.release))
+	return lipgloss.JoinVertical(lipgloss.Left, header, styles.BoldStyle.Render(releaseTitle(m.release)))
+}
+
+// renderMetadata renders the author, target and dates
+func (m *ReleaseDetailView) renderMetadata() string {
+	var parts []string
+
+	authorLabel := styles.MutedStyle.Render("Author:")
+	authorValue := styles.AuthorStyle.Render(formatAuthorHandle(m.release.Author))
+	parts = append(parts, lipgloss.JoinHorizontal(lipgloss.Top, authorLabel, " ", authorValue))
+
+	if m.release.TargetCommitish != "" {
+		targetLabel := styles.MutedStyle.Render("Target:")
+		targetValue := styles.NormalStyle.Render(m.release.TargetCommitish)
+		parts = append(parts, lipgloss.JoinHorizontal(lipgloss.Top, targetLabel, " ", targetValue))
+	}
+
+	publishedLabel := styles.MutedStyle.Render("Published:")
+	publishedValue := styles.MutedStyle.Render("not published")
+	if m.release.PublishedAt != nil {
+		publishedValue = styles.DateStyle.Render(formatTime(*m.release.PublishedAt))
+	}
+	parts = append(parts, lipgloss.JoinHorizontal(lipgloss.Top, publishedLabel, " ", publishedValue))
+
+	return lipgloss.JoinVertical(lipgloss.Left, parts...)
+}
+
+// renderAssets lists the release assets with the selected one highlighted
+func (m *ReleaseDetailView) renderAssets() string {
+	header := styles.BoldStyle.Render(fmt.Sprintf("Assets (%d)", len(m.release.Assets)))
+	if len(m.release.Assets) == 0 {
+		return header + "\n" + styles.MutedStyle.Render("No assets")
+	}
+
+	lines := []string{header}
+	for i, asset := range m.release.Assets {
+		cursor := "  "
+		name := styles.NormalStyle.Render(asset.Name)
+		if i == m.selectedAsset {
+			cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
+			name = styles.SelectedStyle.Render(asset.Name)
+		}
+		details := styles.MutedStyle.Render(fmt.Sprintf("%s, %d downloads", formatByteSize(asset.Size), asset.DownloadCount))
+		lines = append(lines, cursor+name+"  "+details)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderNotes renders the release notes as markdown
+func (m *ReleaseDetailView) renderNotes() string {
+	if strings.TrimSpace(m.release.Body) == "" {
+		return styles.MutedStyle.Render("No release notes.")
+	}
+
+	rendered, err := m.renderer.Render(withImagePlaceholders(m.release.Body))
+	if err != nil {
+		return m.release.Body
+	}
+	return strings.TrimRight(rendered, "\n")
+}
+
+// applyScrolling applies scrolling to the entire content
+func (m *ReleaseDetailView) applyScrolling(content string) string {
+	lines := strings.Split(content, "\n")
+
+	availableHeight := m.height - 2
+	if availableHeight < 5 {
+		availableHeight = 5
+	}
+	if len(lines) <= availableHeight {
+		return content
+	}
+
+	maxOffset := len(lines) - availableHeight
+	if m.scrollOffset > maxOffset {
+		m.scrollOffset = maxOffset
+	}
+
+	start := m.scrollOffset
+	end := start + availableHeight
+	scrollInfo := styles.MutedStyle.Render(fmt.Sprintf("[%d-%d/%d]", start+1, end, len(lines)))
+
+	return strings.Join(lines[start:end], "\n") + "\n" + scrollInfo
+}
+
+// renderFooter renders the footer with help
+func (m *ReleaseDetailView) renderFooter() string {
+	helpItems := []string{
+		styles.FormatKeyBinding("j/k", "scroll"),
+		styles.FormatKeyBinding("n/N", "select asset"),
+		styles.FormatKeyBinding("s", "download asset"),
+		styles.FormatKeyBinding("o", "open in browser"),
+		styles.FormatKeyBinding("q", "back"),
+	}
+
+	footer := styles.HelpStyle.Render(strings.Join(helpItems, " • "))
+	if m.progress.IsActive() {
+		return m.progress.View() + "\n" + footer
+	}
+	if m.statusMessage != "" {
+		return styles.MutedStyle.Render(m.statusMessage) + "\n" + footer
+	}
+	return footer
+}
+
+// releaseTitle returns the release name, falling back to the tag
+func releaseTitle(release *models.Release) string {
+	if release.Name != "" {
+		return release.Name
+	}
+	return release.TagName
+}
+
+// renderReleaseBadges renders the draft and pre-release badges
+func renderReleaseBadges(release *models.Release) string {
+	var badges []string
+	if release.Draft {
+		badges = append(badges, styles.MutedStyle.Render("[draft]"))
+	}
+	if release.Prerelease {
+		badges = append(badges, styles.WarningStyle.Render("[pre-release]"))
+	}
+	if len(badges) == 0 {
+		return ""
+	}
+	return " " + strings.Join(badges, " ")
+}
@@ -0,0 +1,408 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ReleaseTrainUseCase defines the interface for tracking and cutting the next release
+type ReleaseTrainUseCase interface {
+	Execute(ctx context.Context, owner, repo string) (*models.ReleaseTrain, error)
+	CutRelease(ctx context.Context, owner, repo string, train *models.ReleaseTrain, tag string, draft bool) (*models.Release, error)
+	GetRepository() repository.ReleaseRepository
+}
+
+// releaseTrainLoadedMsg is sent when the state of the next release is loaded
+type releaseTrainLoadedMsg struct {
+	train *models.ReleaseTrain
+	err   error
+}
+
+// releaseCutMsg is sent when the release train has been cut
+type releaseCutMsg struct {
+	release *models.Release
+	err     error
+}
+
+// Indexes of the fields in the cut release form
+const (
+	cutFieldTag = iota
+	cutFieldDraft
+)
+
+// maxTrainCommits is the number of commits listed in the release train
+const maxTrainCommits = 20
+
+// releaseTrainItem is a selectable line of the release train
+type releaseTrainItem struct {
+	line string
+	url  string
+}
+
+// ReleaseTrainView shows what will go into the next release and cuts it
+type ReleaseTrainView struct {
+	useCase   ReleaseTrainUseCase
+	owner     string
+	repo      string
+	train     *models.ReleaseTrain
+	cursor    int
+	loading   bool
+	cutting   bool
+	err       error
+	width     int
+	height    int
+	statusBar *components.StatusBar
+	showHelp  bool
+	form      *components.FormModal
+	loads     loadGroup
+}
+
+// NewReleaseTrainView creates a new release train view
+func NewReleaseTrainView(useCase ReleaseTrainUseCase, owner, repo string) *ReleaseTrainView {
+	return &ReleaseTrainView{
+		loads:     loadGroup{source: sourceReleaseTrain},
+		useCase:   useCase,
+		owner:     owner,
+		repo:      repo,
+		loading:   true,
+		statusBar: components.NewStatusBar(),
+		form:      components.NewFormModal(),
+	}
+}
+
+// Init starts loading the release train
+func (m *ReleaseTrainView) Init() tea.Cmd {
+	return m.load()
+}
+
+// Close cancels the fetch still in flight when the view is closed
+func (m *ReleaseTrainView) Close() {
+	m.loads.Cancel()
+}
+
+// load fetches the state of the next release, replacing a load in flight
+func (m *ReleaseTrainView) load() tea.Cmd {
+	useCase, owner, repo := m.useCase, m.owner, m.repo
+	ctx := m.loads.Restart()
+	return func() tea.Msg {
+		train, err := useCase.Execute(ctx, owner, repo)
+		return releaseTrainLoadedMsg{train: train, err: err}
+	}
+}
+
+// Update handles messages
+func (m *ReleaseTrainView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case releaseTrainLoadedMsg:
+		if isCancelled(msg.err) {
+			// Replaced by a newer load
+			return m, nil
+		}
+		m.loading = false
+		m.err = msg.err
+		m.train = msg.train
+		if m.cursor >= len(m.items()) {
+			m.cursor = 0
+		}
+		return m, nil
+
+	case releaseCutMsg:
+		m.cutting = false
+		if msg.err != nil {
+			m.statusBar.SetMessage(fmt.Sprintf("Cut release failed: %v", msg.err))
+			return m, nil
+		}
+		if msg.release.Draft {
+			m.statusBar.SetMessage(fmt.Sprintf("Created draft release %s", msg.release.TagName))
+		} else {
+			m.statusBar.SetMessage(fmt.Sprintf("Released %s", msg.release.TagName))
+		}
+		m.loading = true
+		return m, m.load()
+
+	case openBrowserMsg:
+		m.statusBar.SetMessage(browserStatusMessage(msg))
+		return m, nil
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.statusBar.SetSize(msg.Width, 1)
+		m.form.SetSize(msg.Width, msg.Height)
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.form.IsVisible() {
+			return m.handleFormKey(msg)
+		}
+		return m.handleKeyPress(msg)
+	}
+
+	return m, nil
+}
+
+// handleKeyPress handles keyboard input
+func (m *ReleaseTrainView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "esc":
+		return m, func() tea.Msg { return backMsg{} }
+
+	case "?":
+		m.showHelp = !m.showHelp
+
+	case "r":
+		if !m.loading {
+			m.loading = true
+			m.err = nil
+			return m, m.load()
+		}
+
+	case "j", "down":
+		if m.cursor < len(m.items())-1 {
+			m.cursor++
+		}
+
+	case "k", "up":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+
+	case "o":
+		items := m.items()
+		if m.cursor < len(items) && items[m.cursor].url != "" {
+			return m, openInBrowser(items[m.cursor].url)
+		}
+
+	case "c":
+		return m, m.openCutForm()
+	}
+
+	return m, nil
+}
+
+// openCutForm asks for the tag to cut once everything is green
+func (m *ReleaseTrainView) openCutForm() tea.Cmd {
+	if m.train == nil || m.loading || m.cutting {
+		return nil
+	}
+	if !canWrite(m.useCase.GetRepository()) {
+		m.statusBar.SetMessage(readOnlyStatus)
+		return nil
+	}
+	if problems := m.train.Problems(); len(problems) > 0 {
+		m.statusBar.SetMessage("Not ready: " + strings.Join(problems, ", "))
+		return nil
+	}
+
+	m.form.SetSize(m.width, m.height)
+	m.form.Show(fmt.Sprintf("Cut release from %s @ %s", m.train.Branch, shortSHA(m.train.HeadSHA)), []components.FormField{
+		cutFieldTag:   {Label: "Tag", Value: m.train.NextTag(), Placeholder: "v1.2.0", Required: true},
+		cutFieldDraft: {Label: "Draft", Checkbox: true, Checked: true},
+	})
+	return nil
+}
+
+// handleFormKey forwards input to the cut release form and cuts the release on submit
+func (m *ReleaseTrainView) handleFormKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.String() == "ctrl+c" {
+		return m, tea.Quit
+	}
+
+	m.form.Update(msg)
+	if !m.form.Submitted() {
+		return m, nil
+	}
+
+	tag := m.form.Value(cutFieldTag)
+	draft := m.form.Checked(cutFieldDraft)
+	useCase, owner, repo, train := m.useCase, m.owner, m.repo, m.train
+	ctx := m.loads.writeContext()
+
+	m.cutting = true
+	m.statusBar.SetMessage(fmt.Sprintf("Cutting %s...", tag))
+	return m, func() tea.Msg {
+		release, err := useCase.CutRelease(ctx, owner, repo, train, tag, draft)
+		return releaseCutMsg{release: release, err: err}
+	}
+}
+
+// items returns the selectable lines: blockers, release PRs, then commits
+func (m *ReleaseTrainView) items() []releaseTrainItem {
+	if m.train == nil {
+		return nil
+	}
+
+	var items []releaseTrainItem
+	for _, blocker := range m.train.Blockers {
+		switch {
+		case blocker.Issue != nil:
+			items = append(items, releaseTrainItem{
+				line: fmt.Sprintf("%s %s", styles.IssueNumberStyle.Render(fmt.Sprintf("#%d", blocker.Issue.Number)), blocker.Issue.Title),
+				url:  blocker.Issue.HTMLURL,
+			})
+		case blocker.PullRequest != nil:
+			items = append(items, releaseTrainItem{
+				line: fmt.Sprintf("%s %s", styles.IssueNumberStyle.Render(fmt.Sprintf("#%d", blocker.PullRequest.Number)), blocker.PullRequest.Title),
+				url:  blocker.PullRequest.HTMLURL,
+			})
+		}
+	}
+
+	for _, pr := range m.train.PullRequests {
+		state := styles.SuccessStyle.Render("closed")
+		if pr.State == models.PRStateOpen {
+			state = styles.WarningStyle.Render("open")
+		}
+		items = append(items, releaseTrainItem{
+			line: fmt.Sprintf("%s %s  %s", styles.IssueNumberStyle.Render(fmt.Sprintf("#%d", pr.Number)), pr.Title, state),
+			url:  pr.HTMLURL,
+		})
+	}
+
+	for i, commit := range m.train.Commits {
+		if i == maxTrainCommits {
+			break
+		}
+		items = append(items, releaseTrainItem{
+			line: fmt.Sprintf("%s %s  %s", styles.IssueNumberStyle.Render(shortSHA(commit.SHA)), firstLine(commit.Message), styles.AuthorStyle.Render(commit.Author.Name)),
+			url:  fmt.Sprintf("https://github.com/%s/%s/commit/%s", m.owner, m.repo, commit.SHA),
+		})
+	}
+
+	return items
+}
+
+// View renders the release train view
+func (m *ReleaseTrainView) View() string {
+	if m.form.IsVisible() {
+		return m.form.View()
+	}
+
+	var s strings.Builder
+
+	s.WriteString(styles.HeaderStyle.Render("Release train"))
+	if m.train != nil {
+		since := "first release"
+		if m.train.LastTag != "" {
+			since = "since " + m.train.LastTag
+		}
+		s.WriteString(" ")
+		s.WriteString(styles.MutedStyle.Render(fmt.Sprintf("%s @ %s, %s", m.train.Branch, shortSHA(m.train.HeadSHA), since)))
+	}
+	s.WriteString("\n")
+
+	switch {
+	case m.loading:
+		s.WriteString(styles.LoadingStyle.Render("Loading release train..."))
+	case m.err != nil:
+		s.WriteString(styles.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+	case m.train != nil:
+		s.WriteString(m.renderTrain())
+	}
+
+	if m.showHelp {
+		s.WriteString("\n")
+		s.WriteString(m.renderHelp())
+	}
+
+	s.WriteString("\n")
+	m.statusBar.ClearItems()
+	m.statusBar.SetMode("Release train")
+	if m.owner != "" && m.repo != "" {
+		m.statusBar.AddItem("Repo", fmt.Sprintf("%s/%s", m.owner, m.repo))
+	}
+	s.WriteString(m.statusBar.View())
+
+	return s.String()
+}
+
+// renderTrain renders the readiness summary followed by blockers, release PRs and commits
+func (m *ReleaseTrainView) renderTrain() string {
+	var lines []string
+
+	if problems := m.train.Problems(); len(problems) > 0 {
+		for _, problem := range problems {
+			lines = append(lines, styles.ErrorStyle.Render(styles.IconCross+" "+problem))
+		}
+	} else {
+		next := m.train.NextTag()
+		if next == "" {
+			next = "a new tag"
+		}
+		lines = append(lines, styles.SuccessStyle.Render(fmt.Sprintf("%s Ready to release, press c to cut %s", styles.IconCheck, next)))
+	}
+	lines = append(lines, fmt.Sprintf("CI %s", renderCommitStatus(m.train.CheckState)))
+
+	items := m.items()
+	index := 0
+	section := func(title string, count int, empty string) {
+		lines = append(lines, "", styles.BoldStyle.Render(fmt.Sprintf("%s (%d)", title, count)))
+		if count == 0 {
+			lines = append(lines, styles.MutedStyle.Render("  "+empty))
+		}
+	}
+	item := func() {
+		cursor := "  "
+		if index == m.cursor {
+			cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
+		}
+		lines = append(lines, cursor+items[index].line)
+		index++
+	}
+
+	section("Blockers", len(m.train.Blockers), "No open blockers")
+	for range m.train.Blockers {
+		item()
+	}
+
+	section("Release pull requests", len(m.train.PullRequests), "No pull requests labeled for the release")
+	for range m.train.PullRequests {
+		item()
+	}
+
+	section("Commits", m.train.TotalCommits, "Nothing to release")
+	shown := 0
+	for index < len(items) {
+		item()
+		shown++
+	}
+	if hidden := m.train.TotalCommits - shown; hidden > 0 {
+		lines = append(lines, styles.MutedStyle.Render(fmt.Sprintf("  ... and %d more", hidden)))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// renderHelp renders the help section
+func (m *ReleaseTrainView) renderHelp() string {
+	helpText := `
+Navigation:
+  ↑/k     Move up
+  ↓/j     Move down
+
+Actions:
+  c       Cut the release when everything is green
+  o       Open in browser
+  r       Refresh
+
+General:
+  ?       Toggle help
+  q/esc   Back to releases
+`
+
+	return styles.BorderStyle.Render(
+		styles.HelpStyle.Render(strings.TrimSpace(helpText)),
+	)
+}
+
+// IsCapturingInput returns true while the cut release form is open
+func (m *ReleaseTrainView) IsCapturingInput() bool {
+	return m.form.IsVisible()
+}
@@ -0,0 +1,129 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/infra/readonly"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// testReleaseTrainUseCase serves a canned release train and cuts releases through repo
+type testReleaseTrainUseCase struct {
+	train *models.ReleaseTrain
+	repo  repository.ReleaseRepository
+}
+
+func (u *testReleaseTrainUseCase) Execute(ctx context.Context, owner, repo string) (*models.ReleaseTrain, error) {
+	return u.train, nil
+}
+
+func (u *testReleaseTrainUseCase) CutRelease(ctx context.Context, owner, repo string, train *models.ReleaseTrain, tag string, draft bool) (*models.Release, error) {
+	if !train.Ready() {
+		return nil, errors.New("not green")
+	}
+	return u.repo.Create(ctx, owner, repo, &models.CreateReleaseInput{TagName: tag, Target: train.HeadSHA, GenerateNotes: true, Draft: draft})
+}
+
+func (u *testReleaseTrainUseCase) GetRepository() repository.ReleaseRepository {
+	return u.repo
+}
+
+func newTestReleaseTrain() *models.ReleaseTrain {
+	return &models.ReleaseTrain{
+		Branch:       "main",
+		HeadSHA:      "1234567890abcdef",
+		LastTag:      "v1.1.0",
+		Commits:      []*models.Commit{{SHA: "1234567890abcdef", Message: "Fix the cache\n\nDetails"}},
+		TotalCommits: 1,
+		PullRequests: []*models.PullRequest{{Number: 12, Title: "Faster startup", State: models.PRStateClosed}},
+		CheckState:   models.CheckStateSuccess,
+	}
+}
+
+// openReleaseTrain opens the release train from a loaded release view
+func openReleaseTrain(t *testing.T, useCase *testReleaseTrainUseCase) *ReleaseView {
+	t.Helper()
+	view := loadedReleaseView(t, useCase.repo)
+	view.SetReleaseTrainUseCase(useCase)
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("T")})
+	if !view.IsShowingDetail() || cmd == nil {
+		t.Fatal("expected the release train to open")
+	}
+	view.Update(cmd())
+	return view
+}
+
+func TestReleaseTrainView_CutsWhenGreen(t *testing.T) {
+	repo := newTestReleaseRepo()
+	view := openReleaseTrain(t, &testReleaseTrainUseCase{train: newTestReleaseTrain(), repo: repo})
+
+	out := view.View()
+	for _, want := range []string{"Release train", "since v1.1.0", "Ready to release", "v1.1.1", "#12", "Faster startup", "Fix the cache"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in release train\n%s", want, out)
+		}
+	}
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	if !view.IsCapturingInput() {
+		t.Fatal("expected the cut release form to open")
+	}
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected the form to submit with the suggested tag")
+	}
+	view.Update(cmd())
+	if repo.created == nil || repo.created.TagName != "v1.1.1" || repo.created.Target != "1234567890abcdef" || !repo.created.Draft {
+		t.Fatalf("created = %+v, want a draft v1.1.1 at the branch head", repo.created)
+	}
+
+	// Leaving the train returns to the release list
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	view.Update(cmd())
+	if view.IsShowingDetail() {
+		t.Error("expected q to close the release train")
+	}
+}
+
+func TestReleaseTrainView_RefusesWhenNotGreen(t *testing.T) {
+	train := newTestReleaseTrain()
+	train.CheckState = models.CheckStateFailure
+	train.Blockers = []models.SearchResult{{Type: models.SearchTypeIssue, Issue: &models.Issue{Number: 7, Title: "Crash on start"}}}
+	repo := newTestReleaseRepo()
+	view := openReleaseTrain(t, &testReleaseTrainUseCase{train: train, repo: repo})
+
+	out := view.View()
+	for _, want := range []string{"1 open blocker(s)", "CI is failing on main", "Crash on start"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in release train\n%s", want, out)
+		}
+	}
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	if view.IsCapturingInput() {
+		t.Fatal("expected no cut form while the train is not green")
+	}
+	if out := view.View(); !strings.Contains(out, "Not ready") {
+		t.Errorf("expected a not ready message\n%s", out)
+	}
+}
+
+func TestReleaseTrainView_ReadOnly(t *testing.T) {
+	view := openReleaseTrain(t, &testReleaseTrainUseCase{
+		train: newTestReleaseTrain(),
+		repo:  readonly.NewReleaseRepository(newTestReleaseRepo()),
+	})
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	if view.IsCapturingInput() {
+		t.Fatal("expected the cut form to stay closed in guest mode")
+	}
+	if out := view.View(); !strings.Contains(out, readOnlyStatus) {
+		t.Errorf("expected the read-only message\n%s", out)
+	}
+}
@@ -0,0 +1,694 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// FetchReleasesUseCase defines the interface for fetching releases
+type FetchReleasesUseCase interface {
+	Execute(ctx context.Context, owner, repo string, opts *models.ReleaseOptions) ([]*models.Release, error)
+	GetRepository() repository.ReleaseRepository
+}
+
+// releasesLoadedMsg is sent when releases and tags are loaded
+type releasesLoadedMsg struct {
+	releases []*models.Release
+	tags     []*models.Tag
+	err      error
+}
+
+// releaseCreatedMsg is sent when a release has been created
+type releaseCreatedMsg struct {
+	release *models.Release
+	err     error
+}
+
+// Indexes of the fields in the new release form
+const (
+	releaseFieldTag = iota
+	releaseFieldTarget
+	releaseFieldTitle
+	releaseFieldGenerateNotes
+	releaseFieldDraft
+	releaseFieldPrerelease
+)
+
+// ReleaseView is the model for the release and tag list view
+type ReleaseView struct {
+	fetchReleasesUseCase FetchReleasesUseCase
+	owner                string
+	repo                 string
+	releases             []*models.Release
+	tags                 []*models.Tag
+	showTags             bool
+	cursor               int
+	loading              bool
+	err                  error
+	width                int
+	height               int
+	statusBar            *components.StatusBar
+	showHelp             bool
+	detailView           *ReleaseDetailView
+	showingDetail        bool
+	form                 *components.FormModal
+	creating             bool
+	releaseTrainUseCase  ReleaseTrainUseCase
+	trainView            *ReleaseTrainView
+	showingTrain         bool
+	loads                loadGroup
+}
+
+// NewReleaseView creates a new release view
+func NewReleaseView() *ReleaseView {
+	return &ReleaseView{
+		loads:     loadGroup{source: sourceReleases},
+		releases:  []*models.Release{},
+		tags:      []*models.Tag{},
+		statusBar: components.NewStatusBar(),
+		form:      components.NewFormModal(),
+	}
+}
+
+// NewReleaseViewWithUseCase creates a new release view with UseCase
+func NewReleaseViewWithUseCase(fetchReleasesUseCase FetchReleasesUseCase, owner, repo string) *ReleaseView {
+	return &ReleaseView{
+		loads:                loadGroup{source: sourceReleases},
+		fetchReleasesUseCase: fetchReleasesUseCase,
+		owner:                owner,
+		repo:                 repo,
+		releases:             []*models.Release{},
+		tags:                 []*models.Tag{},
+		loading:              true, // Start in loading state
+		statusBar:            components.NewStatusBar(),
+		form:                 components.NewFormModal(),
+	}
+}
+
+// Init initializes the release view
+func (m *ReleaseView) Init() tea.Cmd {
+	if m.fetchReleasesUseCase != nil {
+		return m.fetchReleases()
+	}
+	return nil
+}
+
+// SetReleaseTrainUseCase enables the release train view
+func (m *ReleaseView) SetReleaseTrainUseCase(useCase ReleaseTrainUseCase) {
+	m.releaseTrainUseCase = useCase
+}
+
+// Update handles messages
+func (m *ReleaseView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// A retry from the error banner reloads the list even while a detail or the release train view is open
+	if isRetryFor(msg, m) {
+		return m, m.refresh()
+	}
+
+	// The release train view handles everything until it sends backMsg
+	if m.showingTrain && m.trainView != nil {
+		if _, isBackMsg := msg.(backMsg); isBackMsg {
+			m.trainView.Close()
+			m.showingTrain = false
+			m.trainView = nil
+			return m, nil
+		}
+		if size, ok := msg.(tea.WindowSizeMsg); ok {
+			m.width = size.Width
+			m.height = size.Height
+			m.statusBar.SetSize(size.Width, 1)
+			m.form.SetSize(size.Width, size.Height)
+		}
+		updatedModel, cmd := m.trainView.Update(msg)
+		m.trainView = updatedModel.(*ReleaseTrainView)
+		return m, cmd
+	}
+
+	switch msg := msg.(type) {
+	case backMsg:
+		// Return from detail view
+		m.closeDetail()
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.form.IsVisible() {
+			return m.handleFormKey(msg)
+		}
+		if m.showingDetail && m.detailView != nil {
+			updatedModel, cmd := m.detailView.Update(msg)
+			m.detailView = updatedModel.(*ReleaseDetailView)
+			return m, cmd
+		}
+		return m.handleKeyPress(msg)
+
+	case releasesLoadedMsg:
+		if isCancelled(msg.err) {
+			// Cancelled with esc or replaced by a newer fetch
+			return m, nil
+		}
+		m.loading = false
+		if msg.err != nil {
+			m.err = msg.err
+			m.releases = []*models.Release{}
+			m.tags = []*models.Tag{}
+		} else {
+			m.err = nil
+			m.releases = msg.releases
+			m.tags = msg.tags
+		}
+		m.clampCursor()
+		return m, reportLoadError(m, "releases", msg.err)
+
+	case releaseCreatedMsg:
+		m.creating = false
+		if msg.err != nil {
+			m.statusBar.SetMessage(fmt.Sprintf("Create release failed: %v", msg.err))
+			return m, nil
+		}
+		m.statusBar.SetMessage(fmt.Sprintf("Created release %s", msg.release.TagName))
+		m.showTags = false
+		m.cursor = 0
+		m.loading = true
+		return m, m.fetchReleases()
+
+	case openBrowserMsg:
+		if m.showingDetail && m.detailView != nil {
+			updatedModel, cmd := m.detailView.Update(msg)
+			m.detailView = updatedModel.(*ReleaseDetailView)
+			return m, cmd
+		}
+		m.statusBar.SetMessage(browserStatusMessage(msg))
+		return m, nil
+
+	case assetDownloadedMsg, components.ProgressMsg:
+		if m.detailView != nil {
+			updatedModel, cmd := m.detailView.Update(msg)
+			m.detailView = updatedModel.(*ReleaseDetailView)
+			return m, cmd
+		}
+		return m, nil
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.statusBar.SetSize(msg.Width, 1)
+		m.form.SetSize(msg.Width, msg.Height)
+		if m.detailView != nil {
+			m.detailView.Update(msg)
+		}
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// fetchReleases fetches releases and tags from the API
+func (m *ReleaseView) fetchReleases() tea.Cmd {
+	ctx := m.loads.Restart()
+	return func() tea.Msg {
+		if m.fetchReleasesUseCase == nil {
+			return releasesLoadedMsg{err: fmt.Errorf("fetch releases use case not initialized")}
+		}
+
+		releases, err := fetchPages(func(page, perPage int) ([]*models.Release, error) {
+			opts := &models.ReleaseOptions{PerPage: perPage, Page: page}
+			return m.fetchReleasesUseCase.Execute(ctx, m.owner, m.repo, opts)
+		})
+		if err != nil {
+			return releasesLoadedMsg{err: err}
+		}
+
+		var tags []*models.Tag
+		if releaseRepo := m.fetchReleasesUseCase.GetRepository(); releaseRepo != nil {
+			tags, err = fetchPages(func(page, perPage int) ([]*models.Tag, error) {
+				return releaseRepo.ListTags(ctx, m.owner, m.repo, &models.ReleaseOptions{PerPage: perPage, Page: page})
+			})
+			if err != nil {
+				return releasesLoadedMsg{err: fmt.Errorf("failed to fetch tags: %w", err)}
+			}
+		}
+
+		return releasesLoadedMsg{releases: releases, tags: tags}
+	}
+}
+
+// closeDetail closes the detail view, cancelling its fetches
+func (m *ReleaseView) closeDetail() {
+	if m.detailView != nil {
+		m.detailView.Close()
+	}
+	m.showingDetail = false
+	m.detailView = nil
+}
+
+// releaseRepository returns the repository used for downloads and writes
+func (m *ReleaseView) releaseRepository() repository.ReleaseRepository {
+	if m.fetchReleasesUseCase == nil {
+		return nil
+	}
+	return m.fetchReleasesUseCase.GetRepository()
+}
+
+// openCreateForm shows the new release form
+func (m *ReleaseView) openCreateForm() tea.Cmd {
+	releaseRepo := m.releaseRepository()
+	if releaseRepo == nil {
+		return nil
+	}
+	if !canWrite(releaseRepo) {
+		m.statusBar.SetMessage(readOnlyStatus)
+		return nil
+	}
+
+	m.form.SetSize(m.width, m.height)
+	m.form.Show(fmt.Sprintf("New release in %s/%s", m.owner, m.repo), []components.FormField{
+		releaseFieldTag:           {Label: "Tag", Placeholder: "v1.2.0", Required: true},
+		releaseFieldTarget:        {Label: "Target", Placeholder: "default branch"},
+		releaseFieldTitle:         {Label: "Title", Placeholder: "defaults to the tag"},
+		releaseFieldGenerateNotes: {Label: "Generate release notes", Checkbox: true, Checked: true},
+		releaseFieldDraft:         {Label: "Draft", Checkbox: true},
+		releaseFieldPrerelease:    {Label: "Pre-release", Checkbox: true},
+	})
+	return nil
+}
+
+// handleFormKey forwards input to the new release form and creates the release on submit
+func (m *ReleaseView) handleFormKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.String() == "ctrl+c" {
+		return m, tea.Quit
+	}
+
+	m.form.Update(msg)
+	if !m.form.Submitted() {
+		return m, nil
+	}
+
+	input := &models.CreateReleaseInput{
+		TagName:       m.form.Value(releaseFieldTag),
+		Target:        m.form.Value(releaseFieldTarget),
+		Name:          m.form.Value(releaseFieldTitle),
+		GenerateNotes: m.form.Checked(releaseFieldGenerateNotes),
+		Draft:         m.form.Checked(releaseFieldDraft),
+		Prerelease:    m.form.Checked(releaseFieldPrerelease),
+	}
+	releaseRepo := m.releaseRepository()
+	owner, repo, ctx := m.owner, m.repo, m.loads.writeContext()
+
+	m.creating = true
+	m.statusBar.SetMessage(fmt.Sprintf("Creating release %s...", input.TagName))
+	return m, func() tea.Msg {
+		release, err := releaseRepo.Create(ctx, owner, repo, input)
+		return releaseCreatedMsg{release: release, err: err}
+	}
+}
+
+// itemCount returns the number of entries in the current list
+func (m *ReleaseView) itemCount() int {
+	if m.showTags {
+		return len(m.tags)
+	}
+	return len(m.releases)
+}
+
+// clampCursor keeps the cursor within the current list
+func (m *ReleaseView) clampCursor() {
+	if m.cursor >= m.itemCount() {
+		m.cursor = m.itemCount() - 1
+	}
+	if m.cursor < 0 {
+		m.cursor = 0
+	}
+}
+
+// releaseForTag returns the release published for the tag, if any
+func (m *ReleaseView) releaseForTag(name string) *models.Release {
+	for _, release := range m.releases {
+		if release.TagName == name {
+			return release
+		}
+	}
+	return nil
+}
+
+// selectedRelease returns the release under the cursor. In tag mode it is the
+// release published for the selected tag, if any.
+func (m *ReleaseView) selectedRelease() *models.Release {
+	if m.cursor >= m.itemCount() {
+		return nil
+	}
+	if m.showTags {
+		return m.releaseForTag(m.tags[m.cursor].Name)
+	}
+	return m.releases[m.cursor]
+}
+
+// refresh reloads the releases and tags unless a load is already running
+func (m *ReleaseView) refresh() tea.Cmd {
+	if m.loading || m.fetchReleasesUseCase == nil {
+		return nil
+	}
+	m.loading = true
+	m.err = nil
+	return m.fetchReleases()
+}
+
+// handleKeyPress handles keyboard input
+func (m *ReleaseView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyEnter {
+		release := m.selectedRelease()
+		if release == nil {
+			if m.showTags && m.itemCount() > 0 {
+				m.statusBar.SetMessage(fmt.Sprintf("No release for tag %s", m.tags[m.cursor].Name))
+			}
+			return m, nil
+		}
+		m.detailView = NewReleaseDetailView(release, m.owner, m.repo, m.releaseRepository())
+		m.detailView.width = m.width
+		m.detailView.height = m.height
+		m.showingDetail = true
+		return m, m.detailView.Init()
+	}
+
+	switch msg.String() {
+	case "ctrl+c", "q":
+		return m, tea.Quit
+
+	case "?":
+		m.showHelp = !m.showHelp
+		return m, nil
+
+	case "esc":
+		// Stop loading
+		if m.loading {
+			m.loads.Cancel()
+			m.loading = false
+			m.statusBar.SetMessage(loadCancelledStatus)
+		}
+		return m, nil
+
+	case "r":
+		// Refresh releases and tags
+		return m, m.refresh()
+
+	case "t":
+		// Toggle between releases and tags
+		m.showTags = !m.showTags
+		m.cursor = 0
+		return m, nil
+
+	case "j", "down":
+		if m.cursor < m.itemCount()-1 {
+			m.cursor++
+		}
+		return m, nil
+
+	case "k", "up":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+		return m, nil
+
+	case "g":
+		m.cursor = 0
+		return m, nil
+
+	case "G":
+		if m.itemCount() > 0 {
+			m.cursor = m.itemCount() - 1
+		}
+		return m, nil
+
+	case "o":
+		if m.cursor >= m.itemCount() {
+			return m, nil
+		}
+		if release := m.selectedRelease(); release != nil && release.HTMLURL != "" {
+			return m, openInBrowser(release.HTMLURL)
+		}
+		if m.showTags {
+			return m, openInBrowser(fmt.Sprintf("https://github.com/%s/%s/releases/tag/%s", m.owner, m.repo, m.tags[m.cursor].Name))
+		}
+		return m, nil
+
+	case "n":
+		// Create a new release
+		if m.creating {
+			return m, nil
+		}
+		return m, m.openCreateForm()
+
+	case "T":
+		// Track the next release
+		if m.releaseTrainUseCase == nil {
+			return m, nil
+		}
+		m.trainView = NewReleaseTrainView(m.releaseTrainUseCase, m.owner, m.repo)
+		m.trainView.Update(tea.WindowSizeMsg{Width: m.width, Height: m.height})
+		m.showingTrain = true
+		return m, m.trainView.Init()
+	}
+
+	return m, nil
+}
+
+// View renders the release view
+func (m *ReleaseView) View() string {
+	if m.width == 0 || m.height == 0 {
+		return "Initializing..."
+	}
+
+	if m.showingTrain && m.trainView != nil {
+		return m.trainView.View()
+	}
+
+	if m.form.IsVisible() {
+		return m.form.View()
+	}
+
+	if m.showingDetail && m.detailView != nil {
+		return m.detailView.View()
+	}
+
+	var s strings.Builder
+
+	s.WriteString(m.renderHeader())
+	s.WriteString("\n")
+
+	if m.loading {
+		s.WriteString(styles.LoadingStyle.Render("Loading releases..."))
+	} else if m.err != nil {
+		s.WriteString(styles.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+	} else if m.itemCount() == 0 {
+		if m.showTags {
+			s.WriteString(styles.MutedStyle.Render("No tags"))
+		} else {
+			s.WriteString(styles.MutedStyle.Render("No releases"))
+		}
+	} else {
+		s.WriteString(m.renderList())
+	}
+
+	if m.showHelp {
+		s.WriteString("\n")
+		s.WriteString(m.renderHelp())
+	}
+
+	s.WriteString("\n")
+	m.updateStatusBar()
+	s.WriteString(m.statusBar.View())
+
+	return s.String()
+}
+
+// renderHeader renders the view header
+func (m *ReleaseView) renderHeader() string {
+	title := styles.HeaderStyle.Render("Releases")
+	if m.showTags {
+		title = styles.HeaderStyle.Render("Tags")
+	}
+	count := styles.MutedStyle.Render(fmt.Sprintf("(%d)", m.itemCount()))
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, title, " ", count)
+}
+
+// renderList renders the visible part of the release or tag list
+func (m *ReleaseView) renderList() string {
+	var s strings.Builder
+
+	availableHeight := m.height - 4
+	if m.showHelp {
+		availableHeight -= 10
+	}
+	if availableHeight < 1 {
+		availableHeight = 1
+	}
+
+	startIdx := 0
+	endIdx := m.itemCount()
+	if endIdx > availableHeight {
+		startIdx = m.cursor - availableHeight/2
+		if startIdx < 0 {
+			startIdx = 0
+		}
+		endIdx = startIdx + availableHeight
+		if endIdx > m.itemCount() {
+			endIdx = m.itemCount()
+			startIdx = endIdx - availableHeight
+		}
+	}
+
+	latest := latestRelease(m.releases)
+	for i := startIdx; i < endIdx; i++ {
+		if m.showTags {
+			s.WriteString(m.renderTagLine(m.tags[i], i))
+		} else {
+			s.WriteString(m.renderReleaseLine(m.releases[i], i, m.releases[i] == latest))
+		}
+		s.WriteString("\n")
+	}
+
+	return s.String()
+}
+
+// renderReleaseLine renders a single release line
+func (m *ReleaseView) renderReleaseLine(release *models.Release, index int, latest bool) string {
+	cursor := "  "
+	tagStyle := styles.IssueNumberStyle
+	titleStyle := styles.IssueTitleStyle
+	if m.cursor == index {
+		cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
+		tagStyle = styles.SelectedStyle
+		titleStyle = styles.SelectedStyle
+	}
+
+	title := releaseTitle(release)
+	maxTitleLen := m.width - 60
+	if maxTitleLen < 20 {
+		maxTitleLen = 20
+	}
+	if len(title) > maxTitleLen {
+		title = title[:maxTitleLen-3] + "..."
+	}
+
+	badges := renderReleaseBadges(release)
+	if latest {
+		badges += " " + styles.SuccessStyle.Render("[latest]")
+	}
+
+	date := "unpublished"
+	if release.PublishedAt != nil {
+		date = formatRelativeTime(*release.PublishedAt)
+	}
+
+	return lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		cursor,
+		tagStyle.Render(release.TagName),
+		"  ",
+		titleStyle.Render(title),
+		badges,
+		"  ",
+		styles.MutedStyle.Render(fmt.Sprintf("%d assets", len(release.Assets))),
+		"  ",
+		styles.DateStyle.Render(date),
+	)
+}
+
+// renderTagLine renders a single tag line
+func (m *ReleaseView) renderTagLine(tag *models.Tag, index int) string {
+	cursor := "  "
+	nameStyle := styles.IssueNumberStyle
+	if m.cursor == index {
+		cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
+		nameStyle = styles.SelectedStyle
+	}
+
+	line := cursor + nameStyle.Render(tag.Name) + "  " + styles.MutedStyle.Render(shortSHA(tag.SHA))
+	if m.releaseForTag(tag.Name) != nil {
+		line += "  " + styles.SuccessStyle.Render("release")
+	}
+	return line
+}
+
+// latestRelease returns the newest published release that is neither a draft nor a pre-release
+func latestRelease(releases []*models.Release) *models.Release {
+	for _, release := range releases {
+		if !release.Draft && !release.Prerelease {
+			return release
+		}
+	}
+	return nil
+}
+
+// renderHelp renders the help section
+func (m *ReleaseView) renderHelp() string {
+	helpText := `
+Navigation:
+  ↑/k     Move up
+  ↓/j     Move down
+  g       Go to top
+  G       Go to bottom
+
+Actions:
+  enter   View release notes and assets
+  t       Toggle releases/tags
+  n       New release
+  T       Release train (next release status)
+  o       Open in browser
+  r       Refresh
+
+Release detail:
+  n/N     Select asset
+  s       Download asset
+
+General:
+  ?       Toggle help
+  q       Quit
+  ctrl+c  Force quit
+  ctrl+z  Suspend (resume with fg)
+`
+
+	return styles.BorderStyle.Render(
+		styles.HelpStyle.Render(strings.TrimSpace(helpText)),
+	)
+}
+
+// updateStatusBar updates the status bar with current state
+func (m *ReleaseView) updateStatusBar() {
+	m.statusBar.ClearItems()
+
+	if m.showTags {
+		m.statusBar.SetMode("Tags")
+	} else {
+		m.statusBar.SetMode("Releases")
+	}
+
+	if m.itemCount() > 0 {
+		m.statusBar.AddItem("", fmt.Sprintf("%d/%d", m.cursor+1, m.itemCount()))
+	}
+
+	if m.owner != "" && m.repo != "" {
+		m.statusBar.AddItem("Repo", fmt.Sprintf("%s/%s", m.owner, m.repo))
+	}
+}
+
+// IsShowingDetail returns true while the release detail or release train view is open
+func (m *ReleaseView) IsShowingDetail() bool {
+	return (m.showingDetail && m.detailView != nil) || (m.showingTrain && m.trainView != nil)
+}
+
+// IsCapturingInput returns true while the new release or cut release form is open
+func (m *ReleaseView) IsCapturingInput() bool {
+	if m.showingTrain && m.trainView != nil {
+		return m.trainView.IsCapturingInput()
+	}
+	return m.form.IsVisible()
+}
@@ -0,0 +1,224 @@
+package views
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/infra/readonly"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// testReleaseRepo serves canned releases and records created releases
+type testReleaseRepo struct {
+	releases []*models.Release
+	tags     []*models.Tag
+	assets   map[int64]string
+	created  *models.CreateReleaseInput
+}
+
+func (r *testReleaseRepo) List(ctx context.Context, owner, repo string, opts *models.ReleaseOptions) ([]*models.Release, error) {
+	return r.releases, nil
+}
+
+func (r *testReleaseRepo) ListTags(ctx context.Context, owner, repo string, opts *models.ReleaseOptions) ([]*models.Tag, error) {
+	return r.tags, nil
+}
+
+func (r *testReleaseRepo) Create(ctx context.Context, owner, repo string, input *models.CreateReleaseInput) (*models.Release, error) {
+	r.created = input
+	return &models.Release{TagName: input.TagName}, nil
+}
+
+func (r *testReleaseRepo) DownloadAsset(ctx context.Context, owner, repo string, assetID int64) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader(r.assets[assetID])), nil
+}
+
+// testReleasesUseCase lists releases straight from the repository
+type testReleasesUseCase struct {
+	repo repository.ReleaseRepository
+}
+
+func (u *testReleasesUseCase) Execute(ctx context.Context, owner, repo string, opts *models.ReleaseOptions) ([]*models.Release, error) {
+	return u.repo.List(ctx, owner, repo, opts)
+}
+
+func (u *testReleasesUseCase) GetRepository() repository.ReleaseRepository {
+	return u.repo
+}
+
+func newTestReleaseRepo() *testReleaseRepo {
+	published := time.Now().Add(-48 * time.Hour)
+	return &testReleaseRepo{
+		releases: []*models.Release{
+			{TagName: "v2.0.0-rc1", Name: "Release candidate", Prerelease: true, PublishedAt: &published},
+			{
+				TagName:     "v1.1.0",
+				Name:        "Spring release",
+				Body:        "## Changes\n\n- Faster startup",
+				PublishedAt: &published,
+				Assets: []*models.ReleaseAsset{
+					{ID: 1, Name: "tig-gh_linux.tar.gz", Size: 2048},
+					{ID: 2, Name: "../checksums.txt", Size: 64},
+				},
+			},
+		},
+		tags: []*models.Tag{
+			{Name: "v2.0.0-rc1", SHA: "aaaaaaaaaa"},
+			{Name: "v1.1.0", SHA: "bbbbbbbbbb"},
+			{Name: "v1.0.0", SHA: "cccccccccc"},
+		},
+		assets: map[int64]string{1: "tarball", 2: "sums"},
+	}
+}
+
+// loadedReleaseView returns a sized release view with the repo's releases loaded
+func loadedReleaseView(t *testing.T, repo repository.ReleaseRepository) *ReleaseView {
+	t.Helper()
+	view := NewReleaseViewWithUseCase(&testReleasesUseCase{repo: repo}, "owner", "repo")
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	view.Update(view.Init()())
+	return view
+}
+
+func TestReleaseView_ListsReleasesAndTags(t *testing.T) {
+	view := loadedReleaseView(t, newTestReleaseRepo())
+
+	out := view.View()
+	for _, want := range []string{"Releases", "v2.0.0-rc1", "[pre-release]", "Spring release", "[latest]", "2 assets"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in release list\n%s", want, out)
+		}
+	}
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	out = view.View()
+	for _, want := range []string{"Tags", "v1.0.0", "ccccccc"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in tag list\n%s", want, out)
+		}
+	}
+
+	// Tags without a release have nothing to open
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("G")})
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if view.IsShowingDetail() {
+		t.Error("expected no detail view for a tag without a release")
+	}
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("k")})
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !view.IsShowingDetail() || view.detailView.release.TagName != "v1.1.0" {
+		t.Fatal("expected the tag's release to open")
+	}
+	if out := view.View(); !strings.Contains(out, "Changes") || !strings.Contains(out, "tig-gh_linux.tar.gz") {
+		t.Errorf("expected notes and assets in the detail view\n%s", out)
+	}
+}
+
+func TestReleaseDetailView_DownloadAsset(t *testing.T) {
+	dir := t.TempDir()
+	original := assetDownloadDir
+	assetDownloadDir = dir
+	defer func() { assetDownloadDir = original }()
+
+	repo := newTestReleaseRepo()
+	detail := NewReleaseDetailView(repo.releases[1], "owner", "repo", repo)
+	detail.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+
+	_, cmd := detail.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	for _, msg := range runBatch(cmd) {
+		detail.Update(msg)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "tig-gh_linux.tar.gz"))
+	if err != nil || string(data) != "tarball" {
+		t.Fatalf("downloaded file = %q, %v", data, err)
+	}
+
+	// An existing file is never overwritten
+	_, cmd = detail.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	for _, msg := range runBatch(cmd) {
+		detail.Update(msg)
+	}
+	if !strings.Contains(detail.statusMessage, "already exists") {
+		t.Errorf("status = %q, want an already exists error", detail.statusMessage)
+	}
+
+	// Asset names can't escape the download directory
+	detail.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	_, cmd = detail.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	for _, msg := range runBatch(cmd) {
+		detail.Update(msg)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "checksums.txt")); err != nil {
+		t.Errorf("expected the asset saved inside the download directory: %v", err)
+	}
+}
+
+func TestReleaseDetailView_CancelDownload(t *testing.T) {
+	dir := t.TempDir()
+	original := assetDownloadDir
+	assetDownloadDir = dir
+	defer func() { assetDownloadDir = original }()
+
+	repo := newTestReleaseRepo()
+	detail := NewReleaseDetailView(repo.releases[1], "owner", "repo", repo)
+	detail.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+
+	_, cmd := detail.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	if out := detail.View(); !strings.Contains(out, "Downloading tig-gh_linux.tar.gz") {
+		t.Errorf("expected the download progress\n%s", out)
+	}
+	detail.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	for _, msg := range runBatch(cmd) {
+		detail.Update(msg)
+	}
+
+	if detail.statusMessage != "Download cancelled" {
+		t.Errorf("status = %q, want the download cancelled", detail.statusMessage)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "tig-gh_linux.tar.gz")); !os.IsNotExist(err) {
+		t.Errorf("expected no partial file to be left behind: %v", err)
+	}
+}
+
+func TestReleaseView_CreateRelease(t *testing.T) {
+	repo := newTestReleaseRepo()
+	view := loadedReleaseView(t, repo)
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if !view.IsCapturingInput() {
+		t.Fatal("expected the new release form to open")
+	}
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v1.2.0")})
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if view.IsCapturingInput() || cmd == nil {
+		t.Fatal("expected the form to submit")
+	}
+
+	view.Update(cmd())
+	if repo.created == nil || repo.created.TagName != "v1.2.0" || !repo.created.GenerateNotes {
+		t.Fatalf("created = %+v, want tag v1.2.0 with generated notes", repo.created)
+	}
+	if out := view.View(); !strings.Contains(out, "Created release v1.2.0") {
+		t.Errorf("expected a created message\n%s", out)
+	}
+}
+
+func TestReleaseView_CreateReleaseReadOnly(t *testing.T) {
+	view := loadedReleaseView(t, readonly.NewReleaseRepository(newTestReleaseRepo()))
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if view.IsCapturingInput() {
+		t.Fatal("expected the form to stay closed in guest mode")
+	}
+	if out := view.View(); !strings.Contains(out, readOnlyStatus) {
+		t.Errorf("expected the read-only message\n%s", out)
+	}
+}
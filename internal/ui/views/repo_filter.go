@@ -0,0 +1,78 @@
+package views
+
+import "strings"
+
+// repoFilter is a parsed repository filter. Terms are separated by spaces
+// and must all match: "language:go" (or "lang:go") and "topic:cli" match the
+// primary language and a topic exactly, any other word is looked up in the
+// name and description.
+type repoFilter struct {
+	words     []string
+	languages []string
+	topics    []string
+}
+
+// parseRepoFilter parses the filter typed by the user
+func parseRepoFilter(filter string) repoFilter {
+	var f repoFilter
+	for _, term := range strings.Fields(strings.ToLower(filter)) {
+		key, value, ok := strings.Cut(term, ":")
+		switch {
+		case ok && value != "" && (key == "language" || key == "lang"):
+			f.languages = append(f.languages, value)
+		case ok && value != "" && key == "topic":
+			f.topics = append(f.topics, value)
+		default:
+			f.words = append(f.words, term)
+		}
+	}
+	return f
+}
+
+// empty reports whether the filter matches every repository
+func (f repoFilter) empty() bool {
+	return len(f.words) == 0 && len(f.languages) == 0 && len(f.topics) == 0
+}
+
+// match reports whether a repository matches all terms of the filter. A bare
+// word equal to the language or a topic matches as well, so "go" finds Go
+// repositories whose name does not say so.
+func (f repoFilter) match(fullName, description, language string, topics []string) bool {
+	fullName = strings.ToLower(fullName)
+	description = strings.ToLower(description)
+	hasTopic := func(want string) bool {
+		for _, topic := range topics {
+			if strings.EqualFold(topic, want) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, want := range f.languages {
+		if !strings.EqualFold(language, want) {
+			return false
+		}
+	}
+	for _, want := range f.topics {
+		if !hasTopic(want) {
+			return false
+		}
+	}
+	for _, word := range f.words {
+		if !strings.Contains(fullName, word) && !strings.Contains(description, word) &&
+			!strings.EqualFold(language, word) && !hasTopic(word) {
+			return false
+		}
+	}
+	return true
+}
+
+// formatTopics renders topics as "#topic" tags
+func formatTopics(topics []string) string {
+	tags := make([]string, len(topics))
+	for i, topic := range topics {
+		tags[i] = "#" + topic
+	}
+	return strings.Join(tags, " ")
+}
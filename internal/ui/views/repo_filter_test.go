@@ -0,0 +1,31 @@
+package views
+
+import "testing"
+
+func TestRepoFilterMatch(t *testing.T) {
+	topics := []string{"cli", "TUI"}
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		{"", true},
+		{"tig", true},
+		{"terminal", true},
+		{"language:go", true},
+		{"lang:GO", true},
+		{"language:rust", false},
+		{"topic:tui", true},
+		{"topic:web", false},
+		{"go", true},
+		{"cli", true},
+		{"topic:cli language:go tig", true},
+		{"topic:cli language:go vim", false},
+		{"topic:", false},
+	}
+	for _, tt := range tests {
+		filter := parseRepoFilter(tt.filter)
+		if got := filter.match("a1yama/tig-gh", "A terminal UI for GitHub", "Go", topics); got != tt.want {
+			t.Errorf("%q: match() = %v, want %v", tt.filter, got, tt.want)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/a1yama/tig-gh/internal/domain/models"
 	"github.com/a1yama/tig-gh/internal/domain/repository"
 	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/events"
 	"github.com/a1yama/tig-gh/internal/ui/styles"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
@@ -43,6 +44,7 @@ type SearchView struct {
 	searchState   models.IssueState
 	detailView    tea.Model // Can be IssueDetailView or PRDetailView
 	showingDetail bool
+	loads         loadGroup
 }
 
 // NewSearchView creates a new search view
@@ -54,6 +56,7 @@ func NewSearchView() *SearchView {
 	ti.Width = 50
 
 	return &SearchView{
+		loads:       loadGroup{source: sourceSearch},
 		textInput:   ti,
 		results:     []models.SearchResult{},
 		cursor:      0,
@@ -78,14 +81,43 @@ func (m *SearchView) Init() tea.Cmd {
 	return textinput.Blink
 }
 
+// applyEntityChanged replaces the issue or PR of the matching search result
+func (m *SearchView) applyEntityChanged(event events.EntityChanged) {
+	for i := range m.results {
+		result := &m.results[i]
+		switch {
+		case event.Issue != nil && result.Issue != nil && event.Issue.HTMLURL != "" && result.Issue.HTMLURL == event.Issue.HTMLURL:
+			result.Issue = event.Issue
+		case event.PullRequest != nil && result.PullRequest != nil && event.PullRequest.HTMLURL != "" && result.PullRequest.HTMLURL == event.PullRequest.HTMLURL:
+			result.PullRequest = event.PullRequest
+		}
+	}
+}
+
 // Update handles messages
 func (m *SearchView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// Search results span repositories, so entity events are matched by URL
+	if event, ok := msg.(events.EntityChanged); ok {
+		m.applyEntityChanged(event)
+		if m.detailView != nil {
+			m.detailView, _ = m.detailView.Update(event)
+		}
+		return m, nil
+	}
+
+	// A retry from the error banner repeats the search even while a detail view is open
+	if isRetryFor(msg, m) {
+		if m.loading {
+			return m, nil
+		}
+		return m, m.performSearch()
+	}
+
 	// If showing detail view, delegate to detail view
 	if m.showingDetail && m.detailView != nil {
 		// Check for back message
 		if _, isBackMsg := msg.(backMsg); isBackMsg {
-			m.showingDetail = false
-			m.detailView = nil
+			m.closeDetail()
 			return m, nil
 		}
 
@@ -97,8 +129,7 @@ func (m *SearchView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if keyMsg, ok := msg.(tea.KeyMsg); ok {
 			keyStr := keyMsg.String()
 			if keyStr == "q" || keyStr == "esc" {
-				m.showingDetail = false
-				m.detailView = nil
+				m.closeDetail()
 				return m, nil
 			}
 		}
@@ -111,6 +142,10 @@ func (m *SearchView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m.handleKeyPress(msg)
 
 	case searchResultsLoadedMsg:
+		if isCancelled(msg.err) {
+			// Cancelled with esc or replaced by a newer search
+			return m, nil
+		}
 		m.loading = false
 		if msg.err != nil {
 			m.err = msg.err
@@ -125,7 +160,7 @@ func (m *SearchView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.cursor = 0
 			}
 		}
-		return m, nil
+		return m, reportLoadError(m, "search", msg.err)
 
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
@@ -147,6 +182,11 @@ func (m *SearchView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if m.textInput.Focused() {
 		switch msg.String() {
 		case "esc":
+			// Stop a running search first, then leave the input
+			if m.loading {
+				m.cancelSearch()
+				return m, nil
+			}
 			m.textInput.Blur()
 			return m, nil
 		case "enter":
@@ -166,10 +206,12 @@ func (m *SearchView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	case "esc":
 		if m.showingDetail {
-			m.showingDetail = false
-			m.detailView = nil
+			m.closeDetail()
 			return m, nil
 		}
+		if m.loading {
+			m.cancelSearch()
+		}
 		return m, nil
 
 	case "enter":
@@ -224,6 +266,8 @@ func (m *SearchView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 // performSearch executes a search
 func (m *SearchView) performSearch() tea.Cmd {
+	ctx := m.loads.Restart()
+	m.loading = true
 	return func() tea.Msg {
 		if m.searchUseCase == nil {
 			return searchResultsLoadedMsg{
@@ -232,20 +276,35 @@ func (m *SearchView) performSearch() tea.Cmd {
 			}
 		}
 
-		m.loading = true
 		query := m.textInput.Value()
 
-		opts := &models.SearchOptions{
-			Query:     query,
-			Type:      m.searchType,
-			State:     m.searchState,
-			Sort:      models.SearchSortUpdated,
-			Direction: models.SortDirectionDesc,
-			PerPage:   50,
-			Page:      1,
+		// The first page carries the total count; later pages only add items
+		var results *models.SearchResults
+		items, err := fetchPages(func(page, perPage int) ([]models.SearchResult, error) {
+			opts := &models.SearchOptions{
+				Query:     query,
+				Type:      m.searchType,
+				State:     m.searchState,
+				Sort:      models.SearchSortUpdated,
+				Direction: models.SortDirectionDesc,
+				PerPage:   perPage,
+				Page:      page,
+			}
+			pageResults, err := m.searchUseCase.Execute(ctx, m.owner, m.repo, opts)
+			if err != nil || pageResults == nil {
+				return nil, err
+			}
+			if results == nil {
+				results = pageResults
+			}
+			return pageResults.Items, nil
+		})
+		if err != nil {
+			return searchResultsLoadedMsg{err: err}
+		}
+		if results != nil {
+			results.Items = items
 		}
-
-		results, err := m.searchUseCase.Execute(context.Background(), m.owner, m.repo, opts)
 		return searchResultsLoadedMsg{
 			results: results,
 			err:     err,
@@ -253,6 +312,22 @@ func (m *SearchView) performSearch() tea.Cmd {
 	}
 }
 
+// cancelSearch stops the running search
+func (m *SearchView) cancelSearch() {
+	m.loads.Cancel()
+	m.loading = false
+	m.statusBar.SetMessage(loadCancelledStatus)
+}
+
+// closeDetail closes the detail view, cancelling its fetches
+func (m *SearchView) closeDetail() {
+	if closer, ok := m.detailView.(interface{ Close() }); ok {
+		closer.Close()
+	}
+	m.showingDetail = false
+	m.detailView = nil
+}
+
 // showDetail shows the detail view for the selected result
 func (m *SearchView) showDetail() tea.Cmd {
 	if m.cursor >= len(m.results) {
@@ -382,25 +457,8 @@ func (m *SearchView) renderResults() string {
 		availableHeight = 5
 	}
 
-	// Calculate visible range
-	startIdx := 0
-	endIdx := len(m.results)
-
-	if len(m.results) > availableHeight {
-		halfHeight := availableHeight / 2
-		startIdx = m.cursor - halfHeight
-		if startIdx < 0 {
-			startIdx = 0
-		}
-		endIdx = startIdx + availableHeight
-		if endIdx > len(m.results) {
-			endIdx = len(m.results)
-			startIdx = endIdx - availableHeight
-			if startIdx < 0 {
-				startIdx = 0
-			}
-		}
-	}
+	// Calculate visible range: only the rows on screen are rendered
+	startIdx, endIdx := components.VisibleRange(m.cursor, len(m.results), availableHeight)
 
 	// Render visible results
 	for i := startIdx; i < endIdx; i++ {
@@ -418,7 +476,7 @@ func (m *SearchView) renderResultLine(result models.SearchResult, index int) str
 	// Cursor indicator
 	cursor := "  "
 	if m.cursor == index {
-		cursor = styles.CursorStyle.Render("▶ ")
+		cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
 	}
 
 	var number int
@@ -432,14 +490,14 @@ func (m *SearchView) renderResultLine(result models.SearchResult, index int) str
 			number = result.Issue.Number
 			title = result.Issue.Title
 			state = string(result.Issue.State)
-			typeIcon = "📄"
+			typeIcon = styles.IconIssue
 		}
 	case models.SearchTypePR:
 		if result.PullRequest != nil {
 			number = result.PullRequest.Number
 			title = result.PullRequest.Title
 			state = string(result.PullRequest.State)
-			typeIcon = "🔀"
+			typeIcon = styles.IconPR
 		}
 	}
 
@@ -510,3 +568,8 @@ func (m *SearchView) updateStatusBar() {
 func (m *SearchView) IsInputFocused() bool {
 	return m.textInput.Focused()
 }
+
+// IsShowingDetail returns true while a detail view is open
+func (m *SearchView) IsShowingDetail() bool {
+	return m.showingDetail && m.detailView != nil
+}
@@ -0,0 +1,470 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// FetchStarredUseCase defines the interface for fetching starred repositories
+type FetchStarredUseCase interface {
+	Execute(ctx context.Context, opts *models.StarredOptions) ([]*models.StarredRepository, error)
+}
+
+// SwitchRepositoryMsg asks the app to reopen every view on another repository
+type SwitchRepositoryMsg struct {
+	Owner string
+	Repo  string
+}
+
+// starredLoadedMsg is sent when the starred repositories are loaded
+type starredLoadedMsg struct {
+	starred []*models.StarredRepository
+	err     error
+}
+
+// StarredView lists the repositories the user starred; Enter switches the
+// session to the selected one
+type StarredView struct {
+	useCase   FetchStarredUseCase
+	owner     string
+	repo      string
+	guest     bool
+	starred   []*models.StarredRepository
+	visible   []*models.StarredRepository // starred narrowed by the filter
+	cursor    int
+	filter    string
+	filtering bool
+	loading   bool
+	err       error
+	width     int
+	height    int
+	statusBar *components.StatusBar
+	showHelp  bool
+	loads     loadGroup
+}
+
+// NewStarredView creates a new starred repositories view
+func NewStarredView() *StarredView {
+	return &StarredView{
+		loads:     loadGroup{source: sourceStarred},
+		statusBar: components.NewStatusBar(),
+	}
+}
+
+// NewStarredViewWithUseCase creates a starred repositories view; owner/repo
+// is the repository the session is on
+func NewStarredViewWithUseCase(useCase FetchStarredUseCase, owner, repo string) *StarredView {
+	view := NewStarredView()
+	view.useCase = useCase
+	view.owner = owner
+	view.repo = repo
+	view.loading = true // Start in loading state
+	return view
+}
+
+// SetGuestMode marks the session as unauthenticated; the stars of "the
+// authenticated user" need a token
+func (m *StarredView) SetGuestMode(guest bool) {
+	m.guest = guest
+}
+
+// Init initializes the view
+func (m *StarredView) Init() tea.Cmd {
+	return m.fetchStarred()
+}
+
+// Update handles messages
+func (m *StarredView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// A retry from the error banner reloads the list
+	if isRetryFor(msg, m) {
+		return m, m.refresh()
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.filtering {
+			return m.handleFilterKey(msg)
+		}
+		return m.handleKeyPress(msg)
+
+	case starredLoadedMsg:
+		if isCancelled(msg.err) {
+			// Cancelled with esc or replaced by a newer fetch
+			return m, nil
+		}
+		m.loading = false
+		m.err = msg.err
+		m.starred = msg.starred
+		m.applyFilter()
+		return m, reportLoadError(m, "starred repositories", msg.err)
+
+	case openBrowserMsg:
+		m.statusBar.SetMessage(browserStatusMessage(msg))
+		return m, nil
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.statusBar.SetSize(msg.Width, 1)
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// fetchStarred fetches the starred repositories from the API
+func (m *StarredView) fetchStarred() tea.Cmd {
+	if m.useCase == nil || m.guest {
+		m.loading = false
+		return nil
+	}
+	m.loading = true
+	ctx := m.loads.Restart()
+	return func() tea.Msg {
+		starred, err := fetchPages(func(page, perPage int) ([]*models.StarredRepository, error) {
+			return m.useCase.Execute(ctx, &models.StarredOptions{Page: page, PerPage: perPage})
+		})
+		return starredLoadedMsg{starred: starred, err: err}
+	}
+}
+
+// refresh reloads the list unless a load is already running
+func (m *StarredView) refresh() tea.Cmd {
+	if m.loading {
+		return nil
+	}
+	m.err = nil
+	return m.fetchStarred()
+}
+
+// applyFilter narrows the list to the repositories matching the filter,
+// keeping the cursor in range
+func (m *StarredView) applyFilter() {
+	m.visible = m.starred
+	if filter := parseRepoFilter(m.filter); !filter.empty() {
+		m.visible = nil
+		for _, repo := range m.starred {
+			if filter.match(repo.FullName(), repo.Description, repo.Language, repo.Topics) {
+				m.visible = append(m.visible, repo)
+			}
+		}
+	}
+	if m.cursor >= len(m.visible) {
+		m.cursor = len(m.visible) - 1
+	}
+	if m.cursor < 0 {
+		m.cursor = 0
+	}
+}
+
+// selected returns the repository under the cursor
+func (m *StarredView) selected() *models.StarredRepository {
+	if m.cursor < 0 || m.cursor >= len(m.visible) {
+		return nil
+	}
+	return m.visible[m.cursor]
+}
+
+// switchTo asks the app to switch the session to the selected repository
+func (m *StarredView) switchTo() tea.Cmd {
+	repo := m.selected()
+	if repo == nil {
+		return nil
+	}
+	if strings.EqualFold(repo.Owner, m.owner) && strings.EqualFold(repo.Name, m.repo) {
+		m.statusBar.SetMessage("Already on " + repo.FullName())
+		return nil
+	}
+	owner, name := repo.Owner, repo.Name
+	return func() tea.Msg {
+		return SwitchRepositoryMsg{Owner: owner, Repo: name}
+	}
+}
+
+// handleFilterKey edits the filter while it is being typed
+func (m *StarredView) handleFilterKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	case tea.KeyEsc:
+		m.filtering = false
+		m.filter = ""
+	case tea.KeyEnter:
+		m.filtering = false
+	case tea.KeyBackspace:
+		if m.filter != "" {
+			runes := []rune(m.filter)
+			m.filter = string(runes[:len(runes)-1])
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		m.filter += string(msg.Runes)
+	default:
+		return m, nil
+	}
+	m.applyFilter()
+	return m, nil
+}
+
+// handleKeyPress handles keyboard input
+func (m *StarredView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c", "q":
+		return m, tea.Quit
+
+	case "esc":
+		// Stop loading, or else clear the filter
+		if m.loading {
+			m.loads.Cancel()
+			m.loading = false
+			m.statusBar.SetMessage(loadCancelledStatus)
+		} else if m.filter != "" {
+			m.filter = ""
+			m.applyFilter()
+		}
+		return m, nil
+
+	case "?":
+		m.showHelp = !m.showHelp
+		return m, nil
+
+	case "r":
+		return m, m.refresh()
+
+	case "f":
+		// "/" opens the search view
+		m.filtering = true
+		return m, nil
+
+	case "j", "down":
+		if m.cursor < len(m.visible)-1 {
+			m.cursor++
+		}
+		return m, nil
+
+	case "k", "up":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+		return m, nil
+
+	case "g":
+		m.cursor = 0
+		return m, nil
+
+	case "G":
+		if len(m.visible) > 0 {
+			m.cursor = len(m.visible) - 1
+		}
+		return m, nil
+
+	case "enter":
+		return m, m.switchTo()
+
+	case "o":
+		if repo := m.selected(); repo != nil && repo.HTMLURL != "" {
+			return m, openInBrowser(repo.HTMLURL)
+		}
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// IsCapturingInput returns true while the filter is being typed
+func (m *StarredView) IsCapturingInput() bool {
+	return m.filtering
+}
+
+// View renders the view
+func (m *StarredView) View() string {
+	if m.width == 0 || m.height == 0 {
+		return "Initializing..."
+	}
+
+	var s strings.Builder
+	s.WriteString(m.renderHeader())
+	s.WriteString("\n")
+
+	switch {
+	case m.guest:
+		s.WriteString(styles.MutedStyle.Render("Starred repositories need a GitHub token (guest mode)"))
+	case m.loading:
+		s.WriteString(styles.LoadingStyle.Render("Loading starred repositories..."))
+	case m.err != nil:
+		s.WriteString(styles.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+	case len(m.starred) == 0:
+		s.WriteString(styles.MutedStyle.Render("No starred repositories"))
+	case len(m.visible) == 0:
+		s.WriteString(styles.MutedStyle.Render("No repositories match " + m.filter))
+	default:
+		s.WriteString(m.renderList())
+	}
+
+	if m.showHelp {
+		s.WriteString("\n")
+		s.WriteString(m.renderHelp())
+	}
+
+	s.WriteString("\n")
+	m.updateStatusBar()
+	s.WriteString(m.statusBar.View())
+	return s.String()
+}
+
+// renderHeader renders the view header with the filter
+func (m *StarredView) renderHeader() string {
+	title := styles.HeaderStyle.Render("Starred repositories")
+	count := styles.MutedStyle.Render(fmt.Sprintf("(%d)", len(m.starred)))
+	header := lipgloss.JoinHorizontal(lipgloss.Top, title, " ", count)
+	if m.filtering {
+		header += "  filter: " + m.filter + "█"
+	} else if m.filter != "" {
+		header += "  " + styles.LabelStyle.Render("["+m.filter+"]")
+	}
+	return header
+}
+
+// listHeight returns the number of rows that fit on screen
+func (m *StarredView) listHeight() int {
+	height := m.height - 4
+	if m.showHelp {
+		height -= 18
+	}
+	if height < 3 {
+		height = 3
+	}
+	return height
+}
+
+// renderList renders the visible part of the list
+func (m *StarredView) renderList() string {
+	var s strings.Builder
+
+	availableHeight := m.listHeight()
+	startIdx := 0
+	endIdx := len(m.visible)
+	if endIdx > availableHeight {
+		startIdx = m.cursor - availableHeight/2
+		if startIdx < 0 {
+			startIdx = 0
+		}
+		endIdx = startIdx + availableHeight
+		if endIdx > len(m.visible) {
+			endIdx = len(m.visible)
+			startIdx = endIdx - availableHeight
+		}
+	}
+
+	nameWidth := 0
+	for _, repo := range m.visible[startIdx:endIdx] {
+		if w := lipgloss.Width(repo.FullName()); w > nameWidth {
+			nameWidth = w
+		}
+	}
+	if nameWidth > 40 {
+		nameWidth = 40
+	}
+
+	for i := startIdx; i < endIdx; i++ {
+		s.WriteString(m.renderLine(m.visible[i], i, nameWidth))
+		s.WriteString("\n")
+	}
+	return s.String()
+}
+
+// renderLine renders a repository: name, language, last push, open issues,
+// stars, topics and description
+func (m *StarredView) renderLine(repo *models.StarredRepository, index, nameWidth int) string {
+	cursor := "  "
+	nameStyle := styles.IssueTitleStyle
+	if m.cursor == index {
+		cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
+		nameStyle = styles.SelectedStyle
+	}
+
+	name := trimColumnText(repo.FullName(), nameWidth)
+	name += strings.Repeat(" ", nameWidth-lipgloss.Width(name))
+
+	language := repo.Language
+	if language == "" {
+		language = "-"
+	}
+	pushed := "never pushed"
+	if !repo.PushedAt.IsZero() {
+		pushed = "pushed " + formatRelativeTime(repo.PushedAt)
+	}
+
+	columns := []string{
+		cursor,
+		nameStyle.Render(name),
+		"  ",
+		styles.LabelStyle.Render(fmt.Sprintf("%-10s", trimColumnText(language, 10))),
+		"  ",
+		styles.DateStyle.Render(fmt.Sprintf("%-16s", pushed)),
+		"  ",
+		styles.MutedStyle.Render(fmt.Sprintf("%4d open", repo.OpenIssues)),
+		"  ",
+		styles.MutedStyle.Render(fmt.Sprintf("%s %d", styles.IconStar, repo.Stars)),
+	}
+	if repo.Archived {
+		columns = append(columns, "  ", styles.WarningStyle.Render("archived"))
+	}
+	line := lipgloss.JoinHorizontal(lipgloss.Top, columns...)
+
+	if topics := formatTopics(repo.Topics); topics != "" {
+		if room := m.width - lipgloss.Width(line) - 4; room > 10 {
+			line += "  " + styles.LabelStyle.Render(trimColumnText(topics, room))
+		}
+	}
+	if repo.Description != "" {
+		if room := m.width - lipgloss.Width(line) - 4; room > 10 {
+			line += "  " + styles.MutedStyle.Render(trimColumnText(repo.Description, room))
+		}
+	}
+	return line
+}
+
+// renderHelp renders the help section
+func (m *StarredView) renderHelp() string {
+	helpText := `
+Navigation:
+  ↑/k     Move up
+  ↓/j     Move down
+  g       Go to top
+  G       Go to bottom
+  f       Filter by name, description, language or topic
+          (language:go and topic:cli match exactly)
+
+Actions:
+  enter   Switch to the repository
+  o       Open in browser
+  r       Refresh
+
+General:
+  ?       Toggle help
+  q       Quit
+  ctrl+c  Force quit
+  ctrl+z  Suspend (resume with fg)
+`
+
+	return styles.BorderStyle.Render(
+		styles.HelpStyle.Render(strings.TrimSpace(helpText)),
+	)
+}
+
+// updateStatusBar updates the status bar with current state
+func (m *StarredView) updateStatusBar() {
+	m.statusBar.ClearItems()
+	m.statusBar.SetMode("Starred")
+
+	if len(m.visible) > 0 {
+		m.statusBar.AddItem("", fmt.Sprintf("%d/%d", m.cursor+1, len(m.visible)))
+	}
+}
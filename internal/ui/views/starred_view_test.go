@@ -0,0 +1,123 @@
+package views
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// testStarredUseCase lists canned starred repositories
+type testStarredUseCase struct {
+	starred []*models.StarredRepository
+	calls   int
+}
+
+func (u *testStarredUseCase) Execute(ctx context.Context, opts *models.StarredOptions) ([]*models.StarredRepository, error) {
+	u.calls++
+	if opts.Page > 1 {
+		return nil, nil
+	}
+	return u.starred, nil
+}
+
+func loadedStarredView(t *testing.T) *StarredView {
+	t.Helper()
+	pushed := time.Now().Add(-3 * 24 * time.Hour)
+	view := NewStarredViewWithUseCase(&testStarredUseCase{starred: []*models.StarredRepository{
+		{Owner: "charmbracelet", Name: "bubbletea", Language: "Go", Description: "A TUI framework", OpenIssues: 120, Stars: 30000, PushedAt: pushed},
+		{Owner: "owner", Name: "repo", Language: "Go", PushedAt: pushed},
+		{Owner: "rust-lang", Name: "rust", Language: "Rust", OpenIssues: 9000, Archived: true, PushedAt: pushed},
+	}}, "owner", "repo")
+	view.Update(tea.WindowSizeMsg{Width: 160, Height: 30})
+	view.Update(view.Init()())
+	return view
+}
+
+func TestStarredView_List(t *testing.T) {
+	view := loadedStarredView(t)
+
+	out := view.View()
+	for _, want := range []string{"Starred repositories", "(3)", "charmbracelet/bubbletea", "Go", "pushed 3 days ago", "120 open", "30000", "A TUI framework", "archived"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in the list, got %q", want, out)
+		}
+	}
+}
+
+func TestStarredView_EnterSwitchesRepository(t *testing.T) {
+	view := loadedStarredView(t)
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected a switch")
+	}
+	if msg, ok := cmd().(SwitchRepositoryMsg); !ok || msg.Owner != "charmbracelet" || msg.Repo != "bubbletea" {
+		t.Errorf("expected a switch to charmbracelet/bubbletea, got %#v", msg)
+	}
+
+	// The current repository is not reopened
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	if _, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil {
+		t.Error("expected no switch to the repository already open")
+	}
+	if !strings.Contains(view.View(), "Already on owner/repo") {
+		t.Error("expected the status to say so")
+	}
+}
+
+func TestStarredView_Filter(t *testing.T) {
+	view := loadedStarredView(t)
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	if !view.IsCapturingInput() {
+		t.Fatal("expected f to start the filter")
+	}
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("rust")})
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if view.IsCapturingInput() || len(view.visible) != 1 || view.selected().Name != "rust" {
+		t.Fatalf("expected only rust to match, got %d", len(view.visible))
+	}
+
+	view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if len(view.visible) != 3 {
+		t.Errorf("expected esc to clear the filter, got %d", len(view.visible))
+	}
+}
+
+func TestStarredView_Guest(t *testing.T) {
+	useCase := &testStarredUseCase{}
+	view := NewStarredViewWithUseCase(useCase, "owner", "repo")
+	view.SetGuestMode(true)
+	view.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+
+	if view.Init() != nil || useCase.calls != 0 {
+		t.Error("expected no request without a token")
+	}
+	if !strings.Contains(view.View(), "need a GitHub token") {
+		t.Errorf("expected the guest notice, got %q", view.View())
+	}
+}
+
+func TestStarredView_TopicFilter(t *testing.T) {
+	view := NewStarredViewWithUseCase(&testStarredUseCase{starred: []*models.StarredRepository{
+		{Owner: "charmbracelet", Name: "bubbletea", Language: "Go", Topics: []string{"tui", "elm-architecture"}},
+		{Owner: "charmbracelet", Name: "glow", Language: "Go", Topics: []string{"markdown", "cli"}},
+		{Owner: "ratatui", Name: "ratatui", Language: "Rust", Topics: []string{"tui"}},
+	}}, "owner", "repo")
+	view.Update(tea.WindowSizeMsg{Width: 160, Height: 30})
+	view.Update(view.Init()())
+	if !strings.Contains(view.View(), "#tui #elm-architecture") {
+		t.Errorf("expected the topics in the list, got %q", view.View())
+	}
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("topic:tui lang:go")})
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if len(view.visible) != 1 || view.selected().Name != "bubbletea" {
+		t.Fatalf("expected only bubbletea to match, got %d", len(view.visible))
+	}
+}
@@ -0,0 +1,223 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/glamour"
+)
+
+// timelineLoadedMsg is a message when the timeline of an issue or PR is loaded
+type timelineLoadedMsg struct {
+	events []*models.TimelineEvent
+	err    error
+}
+
+// timeline holds the events of an issue or PR. They are fetched the first
+// time the timeline is shown, as most detail views are opened without it.
+type timeline struct {
+	events  []*models.TimelineEvent
+	loading bool
+	loaded  bool
+	err     error
+}
+
+// load fetches the events unless they are loaded or loading
+func (t *timeline) load(ctx context.Context, repo repository.IssueRepository, owner, name string, number int) tea.Cmd {
+	if repo == nil || t.loaded || t.loading {
+		return nil
+	}
+	t.loading = true
+	return func() tea.Msg {
+		events, err := repo.ListTimeline(ctx, owner, name, number)
+		return timelineLoadedMsg{events: events, err: err}
+	}
+}
+
+// invalidate makes the next load fetch the events again
+func (t *timeline) invalidate() {
+	t.loaded = false
+}
+
+// update records loaded events; a cancelled fetch is retried when shown again
+func (t *timeline) update(msg timelineLoadedMsg) {
+	t.loading = false
+	if isCancelled(msg.err) {
+		return
+	}
+	t.loaded = true
+	t.err = msg.err
+	if msg.err == nil {
+		t.events = msg.events
+	}
+}
+
+// timelineEntry is an event or a comment, in the order they happened
+type timelineEntry struct {
+	at      time.Time
+	event   *models.TimelineEvent
+	comment *models.Comment
+}
+
+// mergeTimeline interleaves events with comments chronologically. The
+// timeline's own comment events are replaced by comments, which carry
+// reactions, unless comments are not loaded.
+func mergeTimeline(events []*models.TimelineEvent, comments []*models.Comment) []timelineEntry {
+	entries := make([]timelineEntry, 0, len(events)+len(comments))
+	for _, event := range events {
+		if event.Type == models.TimelineCommented && comments != nil {
+			continue
+		}
+		entries = append(entries, timelineEntry{at: event.CreatedAt, event: event})
+	}
+	for _, comment := range comments {
+		entries = append(entries, timelineEntry{at: comment.CreatedAt, comment: comment})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].at.Before(entries[j].at)
+	})
+	return entries
+}
+
+// describeTimelineEvent describes what the actor of an event did
+func describeTimelineEvent(event *models.TimelineEvent, owner, repo string) string {
+	switch event.Type {
+	case models.TimelineLabeled:
+		return fmt.Sprintf("added the %s label", styles.LabelStyle.Render(event.Label))
+	case models.TimelineUnlabeled:
+		return fmt.Sprintf("removed the %s label", styles.LabelStyle.Render(event.Label))
+	case models.TimelineAssigned:
+		if event.Assignee == event.Actor.Login {
+			return "self-assigned this"
+		}
+		return "assigned @" + event.Assignee
+	case models.TimelineUnassigned:
+		if event.Assignee == event.Actor.Login {
+			return "removed their assignment"
+		}
+		return "unassigned @" + event.Assignee
+	case models.TimelineReferenced:
+		return "referenced this in commit " + shortSHA(event.CommitID)
+	case models.TimelineCrossReferenced:
+		if event.Source == nil {
+			return "mentioned this"
+		}
+		ref := crossRef{Owner: event.Source.Owner, Repo: event.Source.Repo, Number: event.Source.Number}
+		kind := "issue"
+		if event.Source.IsPullRequest {
+			kind = "pull request"
+		}
+		return fmt.Sprintf("mentioned this in %s %s %s", kind,
+			styles.IssueNumberStyle.Render(ref.label(owner, repo)), event.Source.Title)
+	case models.TimelineReviewRequested:
+		return "requested a review from @" + event.Reviewer
+	case models.TimelineReviewRequestRemoved:
+		return "removed the review request for @" + event.Reviewer
+	case models.TimelineReviewed:
+		switch event.State {
+		case "approved":
+			return styles.PRApprovedStyle.Render("approved") + " these changes"
+		case "changes_requested":
+			return styles.PRChangesRequestedStyle.Render("requested changes")
+		default:
+			return "reviewed"
+		}
+	case models.TimelineForcePushed:
+		if event.CommitID != "" {
+			return "force-pushed the head branch to " + shortSHA(event.CommitID)
+		}
+		return "force-pushed the head branch"
+	case models.TimelineDeployed:
+		return "deployed this"
+	case models.TimelineCommitted:
+		return fmt.Sprintf("committed %s %s", shortSHA(event.CommitID), firstLine(event.Body))
+	case models.TimelineMilestoned:
+		return fmt.Sprintf("added this to the %s milestone", event.Milestone)
+	case models.TimelineDemilestoned:
+		return fmt.Sprintf("removed this from the %s milestone", event.Milestone)
+	case models.TimelineRenamed:
+		return fmt.Sprintf("changed the title from %q to %q", event.RenamedFrom, event.RenamedTo)
+	case models.TimelineClosed:
+		if event.CommitID != "" {
+			return "closed this in " + shortSHA(event.CommitID)
+		}
+		return "closed this"
+	case models.TimelineReopened:
+		return "reopened this"
+	case models.TimelineMerged:
+		if event.CommitID != "" {
+			return "merged this in " + shortSHA(event.CommitID)
+		}
+		return "merged this"
+	case models.TimelineCommented:
+		return "commented"
+	default:
+		return strings.ReplaceAll(string(event.Type), "_", " ")
+	}
+}
+
+// renderTimeline renders the events interleaved with comments, oldest first
+func renderTimeline(t *timeline, comments []*models.Comment, renderer *glamour.TermRenderer, owner, repo string) string {
+	if t.loading || (!t.loaded && t.err == nil) {
+		return styles.MutedStyle.Render("Loading timeline...")
+	}
+	if t.err != nil {
+		return styles.ErrorStyle.Render(fmt.Sprintf("Failed to load timeline: %v", t.err))
+	}
+
+	entries := mergeTimeline(t.events, comments)
+	if len(entries) == 0 {
+		return styles.MutedStyle.Render("No events yet.")
+	}
+
+	var s strings.Builder
+	s.WriteString(styles.BoldStyle.Render(fmt.Sprintf("Timeline (%d)", len(entries))))
+	s.WriteString("\n\n")
+	for _, entry := range entries {
+		if entry.comment != nil {
+			// Comments stand out from the one-line events around them
+			s.WriteString("\n")
+			s.WriteString(fmt.Sprintf("%s commented %s", styles.BoldStyle.Render(entry.comment.User.Login),
+				styles.MutedStyle.Render(formatTime(entry.at))))
+			s.WriteString("\n")
+			s.WriteString(renderTimelineBody(entry.comment.Body, renderer))
+			s.WriteString("\n")
+			continue
+		}
+
+		event := entry.event
+		actor := event.Actor.Login
+		if actor == "" {
+			actor = "ghost"
+		}
+		s.WriteString(fmt.Sprintf("%s %s %s %s\n",
+			styles.MutedStyle.Render("•"),
+			styles.DateStyle.Render(formatTime(entry.at)),
+			styles.AuthorStyle.Render("@"+actor),
+			describeTimelineEvent(event, owner, repo)))
+		if (event.Type == models.TimelineReviewed || event.Type == models.TimelineCommented) && event.Body != "" {
+			s.WriteString(renderTimelineBody(event.Body, renderer))
+			s.WriteString("\n")
+		}
+	}
+	return strings.TrimRight(s.String(), "\n")
+}
+
+// renderTimelineBody renders the markdown of a comment or review
+func renderTimelineBody(body string, renderer *glamour.TermRenderer) string {
+	if renderer == nil || body == "" {
+		return body
+	}
+	rendered, err := renderer.Render(withImagePlaceholders(body))
+	if err != nil {
+		return body
+	}
+	return strings.TrimRight(rendered, "\n")
+}
@@ -0,0 +1,116 @@
+package views
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// timelineIssueRepo returns a fixed timeline and counts the fetches
+type timelineIssueRepo struct {
+	repository.IssueRepository
+	events []*models.TimelineEvent
+	calls  int
+}
+
+func (r *timelineIssueRepo) ListTimeline(ctx context.Context, owner, repo string, number int) ([]*models.TimelineEvent, error) {
+	r.calls++
+	return r.events, nil
+}
+
+func (r *timelineIssueRepo) ListComments(ctx context.Context, owner, repo string, number int, opts *models.CommentOptions) ([]*models.Comment, error) {
+	return nil, nil
+}
+
+func TestMergeTimeline(t *testing.T) {
+	at := func(hour int) time.Time { return time.Date(2024, 5, 1, hour, 0, 0, 0, time.UTC) }
+	events := []*models.TimelineEvent{
+		{Type: models.TimelineLabeled, CreatedAt: at(9)},
+		{Type: models.TimelineCommented, CreatedAt: at(10), Body: "from the timeline"},
+		{Type: models.TimelineClosed, CreatedAt: at(12)},
+	}
+	comments := []*models.Comment{{ID: 1, CreatedAt: at(10), Body: "from comments"}}
+
+	entries := mergeTimeline(events, comments)
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(entries))
+	}
+	if entries[0].event.Type != models.TimelineLabeled || entries[1].comment == nil || entries[2].event.Type != models.TimelineClosed {
+		t.Errorf("unexpected order %+v", entries)
+	}
+
+	// Without loaded comments the timeline's own comments are kept
+	if entries := mergeTimeline(events, nil); len(entries) != 3 || entries[1].event.Body != "from the timeline" {
+		t.Errorf("expected timeline comments to be kept, got %+v", entries)
+	}
+}
+
+func TestDescribeTimelineEvent(t *testing.T) {
+	tests := []struct {
+		event *models.TimelineEvent
+		want  string
+	}{
+		{&models.TimelineEvent{Type: models.TimelineAssigned, Actor: models.User{Login: "alice"}, Assignee: "alice"}, "self-assigned this"},
+		{&models.TimelineEvent{Type: models.TimelineAssigned, Actor: models.User{Login: "alice"}, Assignee: "bob"}, "assigned @bob"},
+		{&models.TimelineEvent{Type: models.TimelineReviewRequested, Reviewer: "acme/core"}, "requested a review from @acme/core"},
+		{&models.TimelineEvent{Type: models.TimelineForcePushed, CommitID: "0123456789"}, "force-pushed the head branch to 0123456"},
+		{&models.TimelineEvent{Type: models.TimelineCrossReferenced, Source: &models.TimelineSource{Owner: "other", Repo: "lib", Number: 3, Title: "Upstream", IsPullRequest: true}}, "mentioned this in pull request"},
+		{&models.TimelineEvent{Type: "auto_merge_enabled"}, "auto merge enabled"},
+	}
+	for _, tt := range tests {
+		if got := describeTimelineEvent(tt.event, "owner", "repo"); !strings.Contains(got, tt.want) {
+			t.Errorf("%s: got %q, want %q", tt.event.Type, got, tt.want)
+		}
+	}
+}
+
+func TestPRDetailView_TimelineTab(t *testing.T) {
+	repo := &timelineIssueRepo{events: []*models.TimelineEvent{
+		{Type: models.TimelineLabeled, Actor: models.User{Login: "alice"}, Label: "bug", CreatedAt: time.Now()},
+	}}
+	view := NewPRDetailView(createTestPullRequest(), "owner", "repo", nil)
+	view.SetIssueRepository(repo)
+	view.width, view.height = 120, 60
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("5")})
+	if view.currentTab != tabTimeline || cmd == nil {
+		t.Fatalf("expected the timeline tab to load, tab=%d", view.currentTab)
+	}
+	view.Update(cmd())
+	if !strings.Contains(view.View(), "added the") {
+		t.Errorf("expected the labeled event in the timeline:\n%s", view.View())
+	}
+
+	// Switching back and forth does not fetch again
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	if _, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("5")}); cmd != nil || repo.calls != 1 {
+		t.Errorf("expected the timeline to be fetched once, got %d calls", repo.calls)
+	}
+}
+
+func TestIssueDetailView_ToggleTimeline(t *testing.T) {
+	repo := &timelineIssueRepo{events: []*models.TimelineEvent{
+		{Type: models.TimelineRenamed, Actor: models.User{Login: "alice"}, RenamedFrom: "Old", RenamedTo: "New", CreatedAt: time.Now()},
+	}}
+	view := NewIssueDetailView(createTestIssue(), "owner", "repo", repo)
+	view.width, view.height = 120, 60
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	if !view.showTimeline || cmd == nil {
+		t.Fatal("expected t to show the timeline")
+	}
+	view.Update(cmd())
+	if !strings.Contains(view.View(), `changed the title from "Old" to "New"`) {
+		t.Errorf("expected the rename in the timeline:\n%s", view.View())
+	}
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	if view.showTimeline {
+		t.Error("expected t to return to the comments")
+	}
+}
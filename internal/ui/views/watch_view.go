@@ -0,0 +1,450 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// WatchList keeps the watched issues and pull requests and polls them for activity
+type WatchList interface {
+	List() ([]*models.WatchedItem, error)
+	IsWatched(owner, repo string, number int) bool
+	Toggle(item *models.WatchedItem) (bool, error)
+	Unwatch(owner, repo string, number int) error
+	MarkSeen(owner, repo string, number int) error
+	Poll(ctx context.Context) ([]models.WatchEvent, error)
+}
+
+var (
+	watchListMu sync.RWMutex
+	watches     WatchList
+)
+
+// SetWatchList sets the watch list the views mark items in with W.
+// Without one W does nothing.
+func SetWatchList(list WatchList) {
+	watchListMu.Lock()
+	defer watchListMu.Unlock()
+	watches = list
+}
+
+// watchList returns the list set by SetWatchList
+func watchList() WatchList {
+	watchListMu.RLock()
+	defer watchListMu.RUnlock()
+	return watches
+}
+
+// WatchPolledMsg carries the activity found by a poll of the watch list
+type WatchPolledMsg struct {
+	Events []models.WatchEvent
+	Err    error
+}
+
+// PollWatchList returns a command polling the watched items, or nil without a watch list
+func PollWatchList() tea.Cmd {
+	list := watchList()
+	if list == nil {
+		return nil
+	}
+	// Polls must see the latest state, not what the cache kept
+	ctx := freshContext(sourceContext(sourceWatch))
+	return func() tea.Msg {
+		events, err := list.Poll(ctx)
+		return WatchPolledMsg{Events: events, Err: err}
+	}
+}
+
+// toggleWatch watches the issue or pull request, or stops watching it, and
+// returns the status message
+func toggleWatch(item *models.WatchedItem) string {
+	list := watchList()
+	if list == nil {
+		return "Watching is not available"
+	}
+	watched, err := list.Toggle(item)
+	switch {
+	case err != nil:
+		return fmt.Sprintf("Watch failed: %v", err)
+	case watched:
+		return fmt.Sprintf("Watching #%d (w to see watched items)", item.Number)
+	default:
+		return fmt.Sprintf("Stopped watching #%d", item.Number)
+	}
+}
+
+// renderWatchMark returns the marker of a watched item in a list row
+func renderWatchMark(owner, repo string, number int) string {
+	list := watchList()
+	if list == nil || !list.IsWatched(owner, repo, number) {
+		return ""
+	}
+	return styles.InfoStyle.Render(styles.IconWatch)
+}
+
+// watchListLoadedMsg is sent when the watched items are read
+type watchListLoadedMsg struct {
+	items []*models.WatchedItem
+	err   error
+}
+
+// WatchView lists the watched issues and pull requests with their latest
+// activity, highlighting what changed since each was last visited
+type WatchView struct {
+	items     []*models.WatchedItem
+	cursor    int
+	err       error
+	polling   bool
+	width     int
+	height    int
+	statusBar *components.StatusBar
+	showHelp  bool
+}
+
+// NewWatchView creates a new watch view
+func NewWatchView() *WatchView {
+	return &WatchView{
+		items:     []*models.WatchedItem{},
+		statusBar: components.NewStatusBar(),
+	}
+}
+
+// Init reads the watch list
+func (m *WatchView) Init() tea.Cmd {
+	return m.loadList()
+}
+
+// loadList reads the watched items; the list is kept locally, so it is quick
+func (m *WatchView) loadList() tea.Cmd {
+	list := watchList()
+	if list == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		items, err := list.List()
+		return watchListLoadedMsg{items: items, err: err}
+	}
+}
+
+// Update handles messages
+func (m *WatchView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return m.handleKeyPress(msg)
+
+	case watchListLoadedMsg:
+		m.err = msg.err
+		if msg.err == nil {
+			m.items = msg.items
+		}
+		if m.cursor >= len(m.items) {
+			m.cursor = max(len(m.items)-1, 0)
+		}
+		return m, nil
+
+	case WatchPolledMsg:
+		m.polling = false
+		switch {
+		case msg.Err != nil:
+			m.statusBar.SetMessage(fmt.Sprintf("Poll failed: %v", msg.Err))
+		case len(msg.Events) == 1:
+			m.statusBar.SetMessage(fmt.Sprintf("%s: %s", msg.Events[0].Key, msg.Events[0].Text))
+		case len(msg.Events) > 1:
+			m.statusBar.SetMessage(fmt.Sprintf("%d new events on watched items", len(msg.Events)))
+		}
+		return m, m.loadList()
+
+	case openBrowserMsg:
+		m.statusBar.SetMessage(browserStatusMessage(msg))
+		return m, nil
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.statusBar.SetSize(msg.Width, 1)
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// selectedItem returns the item under the cursor
+func (m *WatchView) selectedItem() *models.WatchedItem {
+	if m.cursor < 0 || m.cursor >= len(m.items) {
+		return nil
+	}
+	return m.items[m.cursor]
+}
+
+// handleKeyPress handles keyboard input
+func (m *WatchView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c", "q":
+		return m, tea.Quit
+
+	case "?":
+		m.showHelp = !m.showHelp
+		return m, nil
+
+	case "r":
+		// Poll now rather than at the next interval
+		if m.polling {
+			return m, nil
+		}
+		cmd := PollWatchList()
+		if cmd != nil {
+			m.polling = true
+			m.statusBar.SetMessage("Checking watched items...")
+		}
+		return m, cmd
+
+	case "j", "down":
+		if m.cursor < len(m.items)-1 {
+			m.cursor++
+		}
+		return m, nil
+
+	case "k", "up":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+		return m, nil
+
+	case "g":
+		m.cursor = 0
+		return m, nil
+
+	case "G":
+		if len(m.items) > 0 {
+			m.cursor = len(m.items) - 1
+		}
+		return m, nil
+
+	case "enter", "o":
+		item := m.selectedItem()
+		if item == nil || item.HTMLURL == "" {
+			return m, nil
+		}
+		m.markSeen(item)
+		return m, tea.Batch(openInBrowser(item.HTMLURL), m.loadList())
+
+	case "m":
+		// Mark the item visited without opening it
+		item := m.selectedItem()
+		if item == nil {
+			return m, nil
+		}
+		m.markSeen(item)
+		return m, m.loadList()
+
+	case "a":
+		// Mark every item visited
+		for _, item := range m.items {
+			if !m.markSeen(item) {
+				break
+			}
+		}
+		return m, m.loadList()
+
+	case "d", "W":
+		// Stop watching the item under the cursor
+		item := m.selectedItem()
+		list := watchList()
+		if item == nil || list == nil {
+			return m, nil
+		}
+		if err := list.Unwatch(item.Owner, item.Repo, item.Number); err != nil {
+			m.statusBar.SetMessage(fmt.Sprintf("Unwatch failed: %v", err))
+			return m, nil
+		}
+		m.statusBar.SetMessage(fmt.Sprintf("Stopped watching %s", item.Key()))
+		return m, m.loadList()
+	}
+
+	return m, nil
+}
+
+// markSeen records the item as visited and reports whether that worked
+func (m *WatchView) markSeen(item *models.WatchedItem) bool {
+	list := watchList()
+	if list == nil {
+		return false
+	}
+	if err := list.MarkSeen(item.Owner, item.Repo, item.Number); err != nil {
+		m.statusBar.SetMessage(fmt.Sprintf("Failed to mark %s as seen: %v", item.Key(), err))
+		return false
+	}
+	return true
+}
+
+// changedCount returns how many items changed since their last visit
+func (m *WatchView) changedCount() int {
+	n := 0
+	for _, item := range m.items {
+		if len(item.Changes()) > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// View renders the watch view
+func (m *WatchView) View() string {
+	if m.width == 0 || m.height == 0 {
+		return "Initializing..."
+	}
+
+	var s strings.Builder
+
+	s.WriteString(m.renderHeader())
+	s.WriteString("\n")
+
+	switch {
+	case watchList() == nil:
+		s.WriteString(styles.MutedStyle.Render("Watching is not available"))
+	case m.err != nil:
+		s.WriteString(styles.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+	case len(m.items) == 0:
+		s.WriteString(styles.MutedStyle.Render("Nothing watched yet. Press W on an issue or pull request to watch it."))
+	default:
+		s.WriteString(m.renderList())
+	}
+
+	if m.showHelp {
+		s.WriteString("\n")
+		s.WriteString(m.renderHelp())
+	}
+
+	s.WriteString("\n")
+	m.updateStatusBar()
+	s.WriteString(m.statusBar.View())
+
+	return s.String()
+}
+
+// renderHeader renders the view header
+func (m *WatchView) renderHeader() string {
+	title := styles.HeaderStyle.Render("Watching")
+	count := styles.MutedStyle.Render(fmt.Sprintf("(%d)", len(m.items)))
+	parts := []string{title, " ", count}
+	if changed := m.changedCount(); changed > 0 {
+		parts = append(parts, " ", styles.InfoStyle.Render(fmt.Sprintf("%d changed since your last visit", changed)))
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, parts...)
+}
+
+// renderList renders the visible part of the watch list
+func (m *WatchView) renderList() string {
+	var s strings.Builder
+
+	availableHeight := m.height - 4
+	if m.showHelp {
+		availableHeight -= 12
+	}
+	if availableHeight < 3 {
+		availableHeight = 3
+	}
+	startIdx, endIdx := components.VisibleRange(m.cursor, len(m.items), availableHeight)
+
+	for i := startIdx; i < endIdx; i++ {
+		s.WriteString(m.renderItemLine(m.items[i], i))
+		s.WriteString("\n")
+	}
+
+	return s.String()
+}
+
+// renderItemLine renders a watched item: its state, CI, title and latest activity
+func (m *WatchView) renderItemLine(item *models.WatchedItem, index int) string {
+	cursor := "  "
+	titleStyle := styles.IssueTitleStyle
+	if m.cursor == index {
+		cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
+		titleStyle = styles.SelectedStyle
+	}
+
+	icon := styles.IconIssue
+	if item.IsPullRequest {
+		icon = styles.IconPR
+	}
+
+	state := styles.MutedStyle.Render("checking")
+	ci := " "
+	if item.Snapshot != nil {
+		state = styles.GetStateBadge(item.Snapshot.State)
+		if item.IsPullRequest {
+			ci = renderCommitStatus(item.Snapshot.CheckState)
+		}
+	}
+
+	title := item.Title
+	maxTitleLen := m.width - 70
+	if maxTitleLen < 20 {
+		maxTitleLen = 20
+	}
+	if len(title) > maxTitleLen {
+		title = title[:maxTitleLen-3] + "..."
+	}
+
+	parts := []string{
+		cursor,
+		icon, " ",
+		styles.IssueNumberStyle.Render(item.Key()), " ",
+		state, " ",
+		ci, " ",
+		titleStyle.Render(title),
+	}
+	if changes := item.Changes(); len(changes) > 0 {
+		parts = append(parts, "  ", styles.InfoStyle.Render("changed: "+strings.Join(changes, ", ")))
+	} else if event := item.LastEvent; event != nil {
+		parts = append(parts, "  ", styles.MutedStyle.Render(fmt.Sprintf("%s %s", event.Text, formatRelativeTime(event.At))))
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, parts...)
+}
+
+// renderHelp renders the help section
+func (m *WatchView) renderHelp() string {
+	helpText := `
+Navigation:
+  ↑/k       Move up
+  ↓/j       Move down
+  g         Go to top
+  G         Go to bottom
+
+Actions:
+  enter/o   Open in browser and mark as seen
+  m         Mark as seen
+  a         Mark all as seen
+  d/W       Stop watching
+  r         Check for activity now
+
+General:
+  ?         Toggle help
+  q         Quit
+  ctrl+c    Force quit
+  ctrl+z    Suspend (resume with fg)
+`
+
+	return styles.BorderStyle.Render(
+		styles.HelpStyle.Render(strings.TrimSpace(helpText)),
+	)
+}
+
+// updateStatusBar updates the status bar with current state
+func (m *WatchView) updateStatusBar() {
+	m.statusBar.ClearItems()
+	m.statusBar.SetMode("Watching")
+
+	if len(m.items) > 0 {
+		m.statusBar.AddItem("", fmt.Sprintf("%d/%d", m.cursor+1, len(m.items)))
+	}
+}
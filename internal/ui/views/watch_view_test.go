@@ -0,0 +1,144 @@
+package views
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// memoryWatchList keeps watched items in memory; polls return canned events
+// and move items to the canned snapshots
+type memoryWatchList struct {
+	items     []*models.WatchedItem
+	events    []models.WatchEvent
+	snapshots map[string]*models.WatchSnapshot
+}
+
+func (l *memoryWatchList) List() ([]*models.WatchedItem, error) {
+	return l.items, nil
+}
+
+func (l *memoryWatchList) IsWatched(owner, repo string, number int) bool {
+	return l.index(models.WatchKey(owner, repo, number)) >= 0
+}
+
+func (l *memoryWatchList) Toggle(item *models.WatchedItem) (bool, error) {
+	if i := l.index(item.Key()); i >= 0 {
+		l.items = append(l.items[:i], l.items[i+1:]...)
+		return false, nil
+	}
+	l.items = append(l.items, item)
+	return true, nil
+}
+
+func (l *memoryWatchList) Unwatch(owner, repo string, number int) error {
+	if i := l.index(models.WatchKey(owner, repo, number)); i >= 0 {
+		l.items = append(l.items[:i], l.items[i+1:]...)
+	}
+	return nil
+}
+
+func (l *memoryWatchList) MarkSeen(owner, repo string, number int) error {
+	if i := l.index(models.WatchKey(owner, repo, number)); i >= 0 {
+		l.items[i].Seen = l.items[i].Snapshot
+	}
+	return nil
+}
+
+func (l *memoryWatchList) Poll(ctx context.Context) ([]models.WatchEvent, error) {
+	for key, snapshot := range l.snapshots {
+		if i := l.index(key); i >= 0 {
+			l.items[i].Snapshot = snapshot
+		}
+	}
+	for _, event := range l.events {
+		if i := l.index(event.Key); i >= 0 {
+			event := event
+			l.items[i].LastEvent = &event
+		}
+	}
+	return l.events, nil
+}
+
+func (l *memoryWatchList) index(key string) int {
+	for i, item := range l.items {
+		if item.Key() == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestIssueView_WatchToggle(t *testing.T) {
+	list := &memoryWatchList{}
+	SetWatchList(list)
+	defer SetWatchList(nil)
+
+	view := loadedBatchIssueView(t, &batchIssueRepo{})
+	press(view, "W")
+	if len(list.items) != 1 || list.items[0].Key() != "owner/repo#3" || list.items[0].IsPullRequest {
+		t.Fatalf("watched = %+v, want issue #3", list.items)
+	}
+	if out := view.View(); !strings.Contains(out, "Watching #3") {
+		t.Errorf("expected a status message\n%s", out)
+	}
+
+	press(view, "W")
+	if len(list.items) != 0 {
+		t.Errorf("expected W again to stop watching, got %+v", list.items)
+	}
+}
+
+func TestWatchView_ListPollAndUnwatch(t *testing.T) {
+	seen := &models.WatchSnapshot{State: "open", CheckState: models.CheckStatePending}
+	list := &memoryWatchList{items: []*models.WatchedItem{
+		{Owner: "owner", Repo: "repo", Number: 5, IsPullRequest: true, Title: "Add cache",
+			Snapshot: seen, Seen: seen},
+		{Owner: "owner", Repo: "repo", Number: 9, Title: "Crash on start"},
+	}}
+	SetWatchList(list)
+	defer SetWatchList(nil)
+
+	view := NewWatchView()
+	view.Update(tea.WindowSizeMsg{Width: 140, Height: 30})
+	view.Update(view.Init()())
+	out := view.View()
+	if !strings.Contains(out, "owner/repo#5") || !strings.Contains(out, "Add cache") || !strings.Contains(out, "checking") {
+		t.Errorf("expected both items, #9 not polled yet\n%s", out)
+	}
+
+	list.events = []models.WatchEvent{{Key: "owner/repo#5", Kind: models.WatchEventCI, Text: "CI failed"}}
+	list.snapshots = map[string]*models.WatchSnapshot{
+		"owner/repo#5": {State: "open", Comments: 2, CheckState: models.CheckStateFailure},
+	}
+	cmd := press(view, "r")
+	if cmd == nil {
+		t.Fatal("expected r to poll")
+	}
+	_, reload := view.Update(cmd())
+	view.Update(reload())
+	out = view.View()
+	for _, want := range []string{"1 changed since your last visit", "changed: +2 comments, CI pending → failure"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q\n%s", want, out)
+		}
+	}
+
+	// Marking the item seen clears the highlight but keeps the latest event
+	view.Update(press(view, "m")())
+	out = view.View()
+	if strings.Contains(out, "changed") || !strings.Contains(out, "CI failed") {
+		t.Errorf("expected the highlight cleared after m\n%s", out)
+	}
+
+	view.Update(press(view, "d")())
+	if len(list.items) != 1 || list.items[0].Number != 9 {
+		t.Errorf("expected d to stop watching #5, got %+v", list.items)
+	}
+	if out := view.View(); strings.Contains(out, "Add cache") {
+		t.Errorf("expected #5 gone from the list\n%s", out)
+	}
+}
@@ -0,0 +1,449 @@
+package views
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/infra/clock"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// logPollInterval is how often the log of a running job is refreshed (overridable in tests)
+var logPollInterval = 3 * time.Second
+
+// workflowJobsLoadedMsg is sent when the jobs of a run are loaded
+type workflowJobsLoadedMsg struct {
+	runID int64
+	jobs  []*models.WorkflowJob
+	err   error
+}
+
+// workflowJobLogMsg is sent when a job log is loaded
+type workflowJobLogMsg struct {
+	jobID int64
+	log   string
+	err   error
+}
+
+// workflowLogTickMsg asks to refresh the log of a running job
+type workflowLogTickMsg struct {
+	jobID int64
+}
+
+// logTimestamp matches the timestamp GitHub prefixes to every log line
+var logTimestamp = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T[\d:.]+Z `)
+
+// WorkflowRunView shows the jobs of a workflow run and the log of a selected job
+type WorkflowRunView struct {
+	run           *models.WorkflowRun
+	owner         string
+	repo          string
+	workflowRepo  repository.WorkflowRepository
+	jobs          []*models.WorkflowJob
+	cursor        int
+	loading       bool
+	err           error
+	width         int
+	height        int
+	statusMessage string
+
+	showingLog bool
+	logJob     *models.WorkflowJob
+	logLines   []string
+	logLoading bool
+	logErr     error
+	logOffset  int
+	following  bool
+
+	// loads covers the job list, logLoads the log of the open job
+	loads    loadGroup
+	logLoads loadGroup
+}
+
+// NewWorkflowRunView creates a new workflow run detail view
+func NewWorkflowRunView(run *models.WorkflowRun, owner, repo string, workflowRepo repository.WorkflowRepository) *WorkflowRunView {
+	return &WorkflowRunView{
+		loads:        loadGroup{source: sourceWorkflowRun},
+		logLoads:     loadGroup{source: sourceWorkflowRun},
+		run:          run,
+		owner:        owner,
+		repo:         repo,
+		workflowRepo: workflowRepo,
+		loading:      true,
+	}
+}
+
+// Init starts loading the jobs of the run
+func (m *WorkflowRunView) Init() tea.Cmd {
+	return m.loadJobs()
+}
+
+// Close cancels the fetches still in flight when the view is closed
+func (m *WorkflowRunView) Close() {
+	m.loads.Cancel()
+	m.logLoads.Cancel()
+}
+
+// loadJobs fetches the jobs of the run
+func (m *WorkflowRunView) loadJobs() tea.Cmd {
+	workflowRepo, owner, repo, runID := m.workflowRepo, m.owner, m.repo, m.run.ID
+	ctx := m.loads.Context()
+	return func() tea.Msg {
+		jobs, err := workflowRepo.ListJobs(ctx, owner, repo, runID)
+		return workflowJobsLoadedMsg{runID: runID, jobs: jobs, err: err}
+	}
+}
+
+// loadLog fetches the log of a job
+func (m *WorkflowRunView) loadLog(jobID int64) tea.Cmd {
+	workflowRepo, owner, repo := m.workflowRepo, m.owner, m.repo
+	ctx := m.logLoads.Context()
+	return func() tea.Msg {
+		log, err := workflowRepo.GetJobLogs(ctx, owner, repo, jobID)
+		return workflowJobLogMsg{jobID: jobID, log: log, err: err}
+	}
+}
+
+// pollLog schedules the next refresh of a running job's log
+func pollLog(jobID int64) tea.Cmd {
+	return tea.Tick(logPollInterval, func(time.Time) tea.Msg {
+		return workflowLogTickMsg{jobID: jobID}
+	})
+}
+
+// Update handles messages
+func (m *WorkflowRunView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case workflowJobsLoadedMsg:
+		if msg.runID != m.run.ID {
+			return m, nil
+		}
+		m.loading = false
+		m.err = msg.err
+		if msg.err != nil {
+			return m, nil
+		}
+		m.jobs = msg.jobs
+		if m.cursor >= len(m.jobs) {
+			m.cursor = 0
+		}
+		// Keep the job shown in the log pane up to date
+		if m.logJob != nil {
+			for _, job := range m.jobs {
+				if job.ID == m.logJob.ID {
+					m.logJob = job
+				}
+			}
+		}
+		return m, nil
+
+	case workflowJobLogMsg:
+		if m.logJob == nil || msg.jobID != m.logJob.ID {
+			return m, nil
+		}
+		m.logLoading = false
+		m.logErr = msg.err
+		if msg.err == nil {
+			m.logLines = splitJobLog(msg.log)
+		}
+		if m.logJob.Completed() {
+			return m, nil
+		}
+		return m, pollLog(m.logJob.ID)
+
+	case workflowLogTickMsg:
+		// Stop polling once the log pane moved on
+		if !m.showingLog || m.logJob == nil || msg.jobID != m.logJob.ID {
+			return m, nil
+		}
+		return m, tea.Batch(m.loadJobs(), m.loadLog(msg.jobID))
+
+	case openBrowserMsg:
+		m.statusMessage = browserStatusMessage(msg)
+		return m, nil
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.showingLog {
+			return m.handleLogKey(msg)
+		}
+		return m.handleKeyPress(msg)
+	}
+
+	return m, nil
+}
+
+// handleKeyPress handles keyboard input in the job list
+func (m *WorkflowRunView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyEnter {
+		return m, m.openLog()
+	}
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "q", "esc":
+		return m, func() tea.Msg { return backMsg{} }
+
+	case "j", "down":
+		if m.cursor < len(m.jobs)-1 {
+			m.cursor++
+		}
+
+	case "k", "up":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+
+	case "l":
+		return m, m.openLog()
+
+	case "r":
+		m.loading = true
+		return m, m.loadJobs()
+
+	case "o":
+		if m.cursor < len(m.jobs) && m.jobs[m.cursor].HTMLURL != "" {
+			return m, openInBrowser(m.jobs[m.cursor].HTMLURL)
+		}
+		return m, openInBrowser(m.run.HTMLURL)
+	}
+
+	return m, nil
+}
+
+// openLog shows the log of the selected job, following it while the job runs
+func (m *WorkflowRunView) openLog() tea.Cmd {
+	if m.cursor >= len(m.jobs) {
+		return nil
+	}
+	m.logJob = m.jobs[m.cursor]
+	m.showingLog = true
+	m.logLines = nil
+	m.logErr = nil
+	m.logLoading = true
+	m.following = true
+	// A log still loading for the previously opened job is no longer needed
+	m.logLoads.Cancel()
+	return m.loadLog(m.logJob.ID)
+}
+
+// handleLogKey handles keyboard input in the log pane
+func (m *WorkflowRunView) handleLogKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	page := m.logHeight() / 2
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "q", "esc":
+		m.logLoads.Cancel()
+		m.showingLog = false
+		m.logJob = nil
+		m.logLines = nil
+
+	case "j", "down":
+		m.scrollLog(1)
+
+	case "k", "up":
+		m.scrollLog(-1)
+
+	case "ctrl+d":
+		m.scrollLog(page)
+
+	case "ctrl+u":
+		m.scrollLog(-page)
+
+	case "g":
+		m.following = false
+		m.logOffset = 0
+
+	case "G", "F":
+		// Jump to the end and keep following new output
+		m.following = true
+
+	case "o":
+		return m, openInBrowser(m.logJob.HTMLURL)
+	}
+
+	return m, nil
+}
+
+// scrollLog moves the log viewport; scrolling stops following the end of the log
+func (m *WorkflowRunView) scrollLog(delta int) {
+	if m.following {
+		m.logOffset = m.maxLogOffset()
+		m.following = false
+	}
+	m.logOffset += delta
+	if m.logOffset < 0 {
+		m.logOffset = 0
+	}
+	if max := m.maxLogOffset(); m.logOffset >= max {
+		m.logOffset = max
+		m.following = true
+	}
+}
+
+// logHeight returns the number of log lines that fit on screen
+func (m *WorkflowRunView) logHeight() int {
+	height := m.height - 5
+	if height < 5 {
+		height = 5
+	}
+	return height
+}
+
+// maxLogOffset returns the offset that shows the end of the log
+func (m *WorkflowRunView) maxLogOffset() int {
+	if max := len(m.logLines) - m.logHeight(); max > 0 {
+		return max
+	}
+	return 0
+}
+
+// splitJobLog splits a job log into lines without GitHub's timestamps
+func splitJobLog(log string) []string {
+	lines := strings.Split(strings.TrimRight(strings.ReplaceAll(log, "\r\n", "\n"), "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = logTimestamp.ReplaceAllString(line, "")
+	}
+	return lines
+}
+
+// View renders the workflow run detail view
+func (m *WorkflowRunView) View() string {
+	if m.width == 0 || m.height == 0 {
+		return "Initializing..."
+	}
+
+	var s strings.Builder
+
+	s.WriteString(renderWorkflowState(m.run.Status, m.run.Conclusion))
+	s.WriteString(" ")
+	s.WriteString(styles.HeaderStyle.Render(workflowRunLabel(m.run)))
+	s.WriteString(" ")
+	s.WriteString(styles.BoldStyle.Render(m.run.Title))
+	s.WriteString("\n")
+	s.WriteString(styles.MutedStyle.Render(fmt.Sprintf("%s %s · %s · %s · %s · %s", styles.IconBranch,
+		m.run.HeadBranch, shortSHA(m.run.HeadSHA), m.run.Event,
+		formatAuthorHandle(m.run.Actor), formatRunDuration(m.run.Duration(clock.Now())))))
+	s.WriteString("\n\n")
+
+	if m.showingLog {
+		s.WriteString(m.renderLog())
+	} else {
+		s.WriteString(m.renderJobs())
+	}
+
+	s.WriteString("\n")
+	s.WriteString(m.renderFooter())
+
+	return s.String()
+}
+
+// renderJobs renders the jobs of the run with their duration
+func (m *WorkflowRunView) renderJobs() string {
+	switch {
+	case m.loading && len(m.jobs) == 0:
+		return styles.LoadingStyle.Render("Loading jobs...")
+	case m.err != nil:
+		return styles.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err))
+	case len(m.jobs) == 0:
+		return styles.MutedStyle.Render("No jobs")
+	}
+
+	now := clock.Now()
+	lines := []string{styles.BoldStyle.Render(fmt.Sprintf("Jobs (%d)", len(m.jobs)))}
+	for i, job := range m.jobs {
+		cursor := "  "
+		name := job.Name
+		if i == m.cursor {
+			cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
+			name = styles.SelectedStyle.Render(name)
+		}
+		line := fmt.Sprintf("%s%s %s", cursor, renderWorkflowState(job.Status, job.Conclusion), name)
+		if job.StartedAt != nil {
+			line += "  " + styles.DateStyle.Render(formatRunDuration(job.Duration(now)))
+		}
+		if failed := failedStep(job); failed != nil {
+			line += "  " + styles.ErrorStyle.Render(fmt.Sprintf("failed at: %s", failed.Name))
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// failedStep returns the first failed step of a job
+func failedStep(job *models.WorkflowJob) *models.WorkflowStep {
+	for _, step := range job.Steps {
+		if step.Conclusion == models.WorkflowConclusionFailure {
+			return step
+		}
+	}
+	return nil
+}
+
+// renderLog renders the visible part of the selected job's log
+func (m *WorkflowRunView) renderLog() string {
+	header := fmt.Sprintf("%s %s", renderWorkflowState(m.logJob.Status, m.logJob.Conclusion), styles.BoldStyle.Render(m.logJob.Name))
+	if !m.logJob.Completed() {
+		header += "  " + styles.PRPendingStyle.Render("streaming")
+	}
+
+	switch {
+	case m.logLoading && len(m.logLines) == 0:
+		return header + "\n" + styles.LoadingStyle.Render("Loading log...")
+	case m.logErr != nil && len(m.logLines) == 0:
+		return header + "\n" + styles.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.logErr))
+	}
+
+	if m.following {
+		m.logOffset = m.maxLogOffset()
+	}
+	end := m.logOffset + m.logHeight()
+	if end > len(m.logLines) {
+		end = len(m.logLines)
+	}
+	position := styles.MutedStyle.Render(fmt.Sprintf("[%d-%d/%d]", m.logOffset+1, end, len(m.logLines)))
+
+	return header + "  " + position + "\n" + strings.Join(m.logLines[m.logOffset:end], "\n")
+}
+
+// renderFooter renders the footer with help
+func (m *WorkflowRunView) renderFooter() string {
+	var helpItems []string
+	if m.showingLog {
+		helpItems = []string{
+			styles.FormatKeyBinding("j/k", "scroll"),
+			styles.FormatKeyBinding("ctrl+u/d", "page"),
+			styles.FormatKeyBinding("G", "follow"),
+			styles.FormatKeyBinding("o", "open in browser"),
+			styles.FormatKeyBinding("q", "back to jobs"),
+		}
+	} else {
+		helpItems = []string{
+			styles.FormatKeyBinding("enter", "view log"),
+			styles.FormatKeyBinding("f", "re-run failed"),
+			styles.FormatKeyBinding("x", "cancel"),
+			styles.FormatKeyBinding("o", "open in browser"),
+			styles.FormatKeyBinding("q", "back"),
+		}
+	}
+
+	footer := styles.HelpStyle.Render(strings.Join(helpItems, " • "))
+	if m.statusMessage != "" {
+		return styles.MutedStyle.Render(m.statusMessage) + "\n" + footer
+	}
+	return footer
+}
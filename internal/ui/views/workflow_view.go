@@ -0,0 +1,598 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/infra/clock"
+	"github.com/a1yama/tig-gh/internal/ui/components"
+	"github.com/a1yama/tig-gh/internal/ui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// FetchWorkflowRunsUseCase defines the interface for fetching workflow runs
+type FetchWorkflowRunsUseCase interface {
+	Execute(ctx context.Context, owner, repo string, opts *models.WorkflowRunOptions) ([]*models.WorkflowRun, error)
+	GetRepository() repository.WorkflowRepository
+}
+
+// workflowRunsLoadedMsg is sent when workflow runs are loaded
+type workflowRunsLoadedMsg struct {
+	runs []*models.WorkflowRun
+	err  error
+}
+
+// workflowRunActionMsg is sent when a re-run or cancel request has finished
+type workflowRunActionMsg struct {
+	run    *models.WorkflowRun
+	cancel bool
+	err    error
+}
+
+// WorkflowView is the model for the GitHub Actions workflow run list
+type WorkflowView struct {
+	fetchWorkflowRunsUseCase FetchWorkflowRunsUseCase
+	owner                    string
+	repo                     string
+	runs                     []*models.WorkflowRun
+	cursor                   int
+	loading                  bool
+	err                      error
+	width                    int
+	height                   int
+	statusBar                *components.StatusBar
+	showHelp                 bool
+	detailView               *WorkflowRunView
+	showingDetail            bool
+	confirm                  *components.ConfirmModal
+	cancelTarget             *models.WorkflowRun
+	loads                    loadGroup
+}
+
+// NewWorkflowView creates a new workflow run view
+func NewWorkflowView() *WorkflowView {
+	return &WorkflowView{
+		loads:     loadGroup{source: sourceWorkflows},
+		runs:      []*models.WorkflowRun{},
+		statusBar: components.NewStatusBar(),
+		confirm:   components.NewConfirmModal(),
+	}
+}
+
+// NewWorkflowViewWithUseCase creates a new workflow run view with UseCase
+func NewWorkflowViewWithUseCase(fetchWorkflowRunsUseCase FetchWorkflowRunsUseCase, owner, repo string) *WorkflowView {
+	return &WorkflowView{
+		loads:                    loadGroup{source: sourceWorkflows},
+		fetchWorkflowRunsUseCase: fetchWorkflowRunsUseCase,
+		owner:                    owner,
+		repo:                     repo,
+		runs:                     []*models.WorkflowRun{},
+		loading:                  true, // Start in loading state
+		statusBar:                components.NewStatusBar(),
+		confirm:                  components.NewConfirmModal(),
+	}
+}
+
+// Init initializes the workflow run view
+func (m *WorkflowView) Init() tea.Cmd {
+	if m.fetchWorkflowRunsUseCase != nil {
+		return m.fetchRuns()
+	}
+	return nil
+}
+
+// Update handles messages
+func (m *WorkflowView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// A retry from the error banner reloads the list even while a detail view is open
+	if isRetryFor(msg, m) {
+		return m, m.refresh()
+	}
+
+	switch msg := msg.(type) {
+	case backMsg:
+		// Return from detail view
+		m.closeDetail()
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.confirm.IsVisible() {
+			return m.handleConfirmKey(msg)
+		}
+		if m.showingDetail && m.detailView != nil {
+			// Re-run and cancel act on the open run unless its log is shown
+			if !m.detailView.showingLog && (msg.String() == "f" || msg.String() == "x") {
+				return m, m.runAction(m.detailView.run, msg.String() == "x")
+			}
+			updatedModel, cmd := m.detailView.Update(msg)
+			m.detailView = updatedModel.(*WorkflowRunView)
+			return m, cmd
+		}
+		return m.handleKeyPress(msg)
+
+	case workflowRunsLoadedMsg:
+		if isCancelled(msg.err) {
+			// Cancelled with esc or replaced by a newer fetch
+			return m, nil
+		}
+		m.loading = false
+		if msg.err != nil {
+			m.err = msg.err
+			m.runs = []*models.WorkflowRun{}
+		} else {
+			m.err = nil
+			m.runs = msg.runs
+		}
+		if m.cursor >= len(m.runs) {
+			m.cursor = len(m.runs) - 1
+		}
+		if m.cursor < 0 {
+			m.cursor = 0
+		}
+		// Keep the open run up to date, e.g. after a re-run
+		if m.detailView != nil {
+			for _, run := range m.runs {
+				if run.ID == m.detailView.run.ID {
+					m.detailView.run = run
+				}
+			}
+		}
+		return m, reportLoadError(m, "workflow runs", msg.err)
+
+	case workflowRunActionMsg:
+		status := workflowActionStatus(msg)
+		m.statusBar.SetMessage(status)
+		if m.detailView != nil {
+			m.detailView.statusMessage = status
+		}
+		if msg.err != nil {
+			return m, nil
+		}
+		// Pick up the new run state
+		cmds := []tea.Cmd{m.fetchRuns()}
+		if m.detailView != nil {
+			cmds = append(cmds, m.detailView.loadJobs())
+		}
+		return m, tea.Batch(cmds...)
+
+	case workflowJobsLoadedMsg, workflowJobLogMsg, workflowLogTickMsg:
+		if m.detailView != nil {
+			updatedModel, cmd := m.detailView.Update(msg)
+			m.detailView = updatedModel.(*WorkflowRunView)
+			return m, cmd
+		}
+		return m, nil
+
+	case openBrowserMsg:
+		if m.showingDetail && m.detailView != nil {
+			updatedModel, cmd := m.detailView.Update(msg)
+			m.detailView = updatedModel.(*WorkflowRunView)
+			return m, cmd
+		}
+		m.statusBar.SetMessage(browserStatusMessage(msg))
+		return m, nil
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.statusBar.SetSize(msg.Width, 1)
+		m.confirm.SetSize(msg.Width, msg.Height)
+		if m.detailView != nil {
+			m.detailView.Update(msg)
+		}
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// fetchRuns fetches the recent workflow runs from the API
+func (m *WorkflowView) fetchRuns() tea.Cmd {
+	ctx := m.loads.Restart()
+	return func() tea.Msg {
+		if m.fetchWorkflowRunsUseCase == nil {
+			return workflowRunsLoadedMsg{err: fmt.Errorf("fetch workflow runs use case not initialized")}
+		}
+
+		runs, err := fetchPages(func(page, perPage int) ([]*models.WorkflowRun, error) {
+			return m.fetchWorkflowRunsUseCase.Execute(ctx, m.owner, m.repo, &models.WorkflowRunOptions{PerPage: perPage, Page: page})
+		})
+		return workflowRunsLoadedMsg{runs: runs, err: err}
+	}
+}
+
+// closeDetail closes the detail view, cancelling its fetches
+func (m *WorkflowView) closeDetail() {
+	if m.detailView != nil {
+		m.detailView.Close()
+	}
+	m.showingDetail = false
+	m.detailView = nil
+}
+
+// workflowRepository returns the repository used for jobs, logs and writes
+func (m *WorkflowView) workflowRepository() repository.WorkflowRepository {
+	if m.fetchWorkflowRunsUseCase == nil {
+		return nil
+	}
+	return m.fetchWorkflowRunsUseCase.GetRepository()
+}
+
+// runAction re-runs the failed jobs of a run, or asks to confirm cancelling it
+func (m *WorkflowView) runAction(run *models.WorkflowRun, cancel bool) tea.Cmd {
+	workflowRepo := m.workflowRepository()
+	if run == nil || workflowRepo == nil {
+		return nil
+	}
+	if !canWrite(workflowRepo) {
+		m.setStatus(readOnlyStatus)
+		return nil
+	}
+
+	if cancel {
+		if run.Completed() {
+			m.setStatus(fmt.Sprintf("%s is not running", workflowRunLabel(run)))
+			return nil
+		}
+		m.cancelTarget = run
+		m.confirm.SetSize(m.width, m.height)
+		m.confirm.Show(fmt.Sprintf("Cancel %s?", workflowRunLabel(run)), "cancel", []string{
+			run.Title,
+			fmt.Sprintf("%s · %s · started by %s", run.HeadBranch, run.Event, run.Actor.Login),
+		}, false)
+		return nil
+	}
+
+	if !run.Failed() {
+		m.setStatus(fmt.Sprintf("%s has no failed jobs to re-run", workflowRunLabel(run)))
+		return nil
+	}
+	m.setStatus(fmt.Sprintf("Re-running failed jobs of %s...", workflowRunLabel(run)))
+	owner, repo, ctx := m.owner, m.repo, m.loads.writeContext()
+	return func() tea.Msg {
+		err := workflowRepo.RerunFailedJobs(ctx, owner, repo, run.ID)
+		return workflowRunActionMsg{run: run, err: err}
+	}
+}
+
+// handleConfirmKey forwards input to the cancel confirmation and cancels the run once confirmed
+func (m *WorkflowView) handleConfirmKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.String() == "ctrl+c" {
+		return m, tea.Quit
+	}
+
+	m.confirm.Update(msg)
+	if !m.confirm.Confirmed() {
+		return m, nil
+	}
+
+	run := m.cancelTarget
+	m.cancelTarget = nil
+	workflowRepo := m.workflowRepository()
+	owner, repo, ctx := m.owner, m.repo, m.loads.writeContext()
+	m.setStatus(fmt.Sprintf("Cancelling %s...", workflowRunLabel(run)))
+	return m, func() tea.Msg {
+		err := workflowRepo.CancelRun(ctx, owner, repo, run.ID)
+		return workflowRunActionMsg{run: run, cancel: true, err: err}
+	}
+}
+
+// setStatus shows a message in the list or the open run detail
+func (m *WorkflowView) setStatus(message string) {
+	if m.showingDetail && m.detailView != nil {
+		m.detailView.statusMessage = message
+		return
+	}
+	m.statusBar.SetMessage(message)
+}
+
+// workflowActionStatus describes the result of a re-run or cancel request
+func workflowActionStatus(msg workflowRunActionMsg) string {
+	label := workflowRunLabel(msg.run)
+	switch {
+	case msg.err != nil && msg.cancel:
+		return fmt.Sprintf("Cancel %s failed: %v", label, msg.err)
+	case msg.err != nil:
+		return fmt.Sprintf("Re-run %s failed: %v", label, msg.err)
+	case msg.cancel:
+		return fmt.Sprintf("Cancelled %s", label)
+	default:
+		return fmt.Sprintf("Re-running failed jobs of %s", label)
+	}
+}
+
+// refresh reloads the workflow runs unless a load is already running
+func (m *WorkflowView) refresh() tea.Cmd {
+	if m.loading || m.fetchWorkflowRunsUseCase == nil {
+		return nil
+	}
+	m.loading = true
+	m.err = nil
+	return m.fetchRuns()
+}
+
+// handleKeyPress handles keyboard input
+func (m *WorkflowView) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyEnter {
+		if m.cursor >= len(m.runs) || m.workflowRepository() == nil {
+			return m, nil
+		}
+		m.detailView = NewWorkflowRunView(m.runs[m.cursor], m.owner, m.repo, m.workflowRepository())
+		m.detailView.width = m.width
+		m.detailView.height = m.height
+		m.showingDetail = true
+		return m, m.detailView.Init()
+	}
+
+	switch msg.String() {
+	case "ctrl+c", "q":
+		return m, tea.Quit
+
+	case "?":
+		m.showHelp = !m.showHelp
+		return m, nil
+
+	case "esc":
+		// Stop loading
+		if m.loading {
+			m.loads.Cancel()
+			m.loading = false
+			m.statusBar.SetMessage(loadCancelledStatus)
+		}
+		return m, nil
+
+	case "r":
+		// Refresh workflow runs
+		return m, m.refresh()
+
+	case "j", "down":
+		if m.cursor < len(m.runs)-1 {
+			m.cursor++
+		}
+		return m, nil
+
+	case "k", "up":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+		return m, nil
+
+	case "g":
+		m.cursor = 0
+		return m, nil
+
+	case "G":
+		if len(m.runs) > 0 {
+			m.cursor = len(m.runs) - 1
+		}
+		return m, nil
+
+	case "o":
+		if m.cursor < len(m.runs) && m.runs[m.cursor].HTMLURL != "" {
+			return m, openInBrowser(m.runs[m.cursor].HTMLURL)
+		}
+		return m, nil
+
+	case "f", "x":
+		if m.cursor < len(m.runs) {
+			return m, m.runAction(m.runs[m.cursor], msg.String() == "x")
+		}
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// View renders the workflow run view
+func (m *WorkflowView) View() string {
+	if m.width == 0 || m.height == 0 {
+		return "Initializing..."
+	}
+
+	if m.confirm.IsVisible() {
+		return m.confirm.View()
+	}
+
+	if m.showingDetail && m.detailView != nil {
+		return m.detailView.View()
+	}
+
+	var s strings.Builder
+
+	s.WriteString(styles.HeaderStyle.Render("Actions"))
+	s.WriteString(" ")
+	s.WriteString(styles.MutedStyle.Render(fmt.Sprintf("(%d)", len(m.runs))))
+	s.WriteString("\n")
+
+	if m.loading {
+		s.WriteString(styles.LoadingStyle.Render("Loading workflow runs..."))
+	} else if m.err != nil {
+		s.WriteString(styles.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+	} else if len(m.runs) == 0 {
+		s.WriteString(styles.MutedStyle.Render("No workflow runs"))
+	} else {
+		s.WriteString(m.renderList())
+	}
+
+	if m.showHelp {
+		s.WriteString("\n")
+		s.WriteString(m.renderHelp())
+	}
+
+	s.WriteString("\n")
+	m.updateStatusBar()
+	s.WriteString(m.statusBar.View())
+
+	return s.String()
+}
+
+// renderList renders the visible part of the run list
+func (m *WorkflowView) renderList() string {
+	var s strings.Builder
+
+	availableHeight := m.height - 4
+	if m.showHelp {
+		availableHeight -= 10
+	}
+	if availableHeight < 1 {
+		availableHeight = 1
+	}
+
+	startIdx := 0
+	endIdx := len(m.runs)
+	if endIdx > availableHeight {
+		startIdx = m.cursor - availableHeight/2
+		if startIdx < 0 {
+			startIdx = 0
+		}
+		endIdx = startIdx + availableHeight
+		if endIdx > len(m.runs) {
+			endIdx = len(m.runs)
+			startIdx = endIdx - availableHeight
+		}
+	}
+
+	now := clock.Now()
+	for i := startIdx; i < endIdx; i++ {
+		s.WriteString(m.renderRunLine(m.runs[i], i, now))
+		s.WriteString("\n")
+	}
+
+	return s.String()
+}
+
+// renderRunLine renders a single workflow run line
+func (m *WorkflowView) renderRunLine(run *models.WorkflowRun, index int, now time.Time) string {
+	cursor := "  "
+	labelStyle := styles.IssueNumberStyle
+	titleStyle := styles.IssueTitleStyle
+	if m.cursor == index {
+		cursor = styles.CursorStyle.Render(styles.IconCursor + " ")
+		labelStyle = styles.SelectedStyle
+		titleStyle = styles.SelectedStyle
+	}
+
+	title := run.Title
+	maxTitleLen := m.width - 90
+	if maxTitleLen < 20 {
+		maxTitleLen = 20
+	}
+	if len(title) > maxTitleLen {
+		title = title[:maxTitleLen-3] + "..."
+	}
+
+	return lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		cursor,
+		renderWorkflowState(run.Status, run.Conclusion),
+		" ",
+		labelStyle.Render(workflowRunLabel(run)),
+		"  ",
+		titleStyle.Render(title),
+		"  ",
+		styles.MutedStyle.Render(fmt.Sprintf("%s %s", styles.IconBranch, run.HeadBranch)),
+		"  ",
+		styles.MutedStyle.Render(run.Event),
+		"  ",
+		styles.DateStyle.Render(formatRunDuration(run.Duration(now))),
+		"  ",
+		styles.AuthorStyle.Render(formatAuthorHandle(run.Actor)),
+		"  ",
+		styles.DateStyle.Render(formatRelativeTime(run.CreatedAt)),
+	)
+}
+
+// renderHelp renders the help section
+func (m *WorkflowView) renderHelp() string {
+	helpText := `
+Navigation:
+  ↑/k     Move up
+  ↓/j     Move down
+  g       Go to top
+  G       Go to bottom
+
+Actions:
+  enter   View jobs and logs
+  f       Re-run failed jobs
+  x       Cancel in-progress run
+  o       Open in browser
+  r       Refresh
+
+Run detail:
+  enter/l View job log (follows running jobs)
+
+General:
+  ?       Toggle help
+  q       Quit
+  ctrl+c  Force quit
+  ctrl+z  Suspend (resume with fg)
+`
+
+	return styles.BorderStyle.Render(
+		styles.HelpStyle.Render(strings.TrimSpace(helpText)),
+	)
+}
+
+// updateStatusBar updates the status bar with current state
+func (m *WorkflowView) updateStatusBar() {
+	m.statusBar.ClearItems()
+	m.statusBar.SetMode("Actions")
+
+	if len(m.runs) > 0 {
+		m.statusBar.AddItem("", fmt.Sprintf("%d/%d", m.cursor+1, len(m.runs)))
+	}
+
+	if m.owner != "" && m.repo != "" {
+		m.statusBar.AddItem("Repo", fmt.Sprintf("%s/%s", m.owner, m.repo))
+	}
+}
+
+// workflowRunLabel names a run like "CI #42"
+func workflowRunLabel(run *models.WorkflowRun) string {
+	return fmt.Sprintf("%s #%d", run.Name, run.RunNumber)
+}
+
+// renderWorkflowState renders a run or job state: ✓ success, ✗ failed, ● running or queued,
+// ⊘ cancelled and - skipped
+func renderWorkflowState(status models.WorkflowStatus, conclusion models.WorkflowConclusion) string {
+	if status != models.WorkflowStatusCompleted {
+		return styles.PRPendingStyle.Render(styles.IconDot)
+	}
+	switch conclusion {
+	case models.WorkflowConclusionSuccess:
+		return styles.PRApprovedStyle.Render(styles.IconCheck)
+	case models.WorkflowConclusionFailure, models.WorkflowConclusionTimedOut:
+		return styles.PRChangesRequestedStyle.Render(styles.IconCross)
+	case models.WorkflowConclusionCancelled:
+		return styles.MutedStyle.Render("⊘")
+	default:
+		return styles.MutedStyle.Render("-")
+	}
+}
+
+// formatRunDuration formats CI durations like "45s", "3m 12s" or "1h 5m"
+func formatRunDuration(d time.Duration) string {
+	d = d.Round(time.Second)
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm %ds", int(d.Minutes()), int(d.Seconds())%60)
+	default:
+		return fmt.Sprintf("%dh %dm", int(d.Hours()), int(d.Minutes())%60)
+	}
+}
+
+// IsShowingDetail returns true while a run detail view is open
+func (m *WorkflowView) IsShowingDetail() bool {
+	return m.showingDetail && m.detailView != nil
+}
+
+// IsCapturingInput returns true while the cancel confirmation is open
+func (m *WorkflowView) IsCapturingInput() bool {
+	return m.confirm.IsVisible()
+}
@@ -0,0 +1,248 @@
+package views
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a1yama/tig-gh/internal/domain/models"
+	"github.com/a1yama/tig-gh/internal/domain/repository"
+	"github.com/a1yama/tig-gh/internal/infra/readonly"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// testWorkflowRepo serves canned runs, jobs and logs and records re-runs and cancels
+type testWorkflowRepo struct {
+	runs      []*models.WorkflowRun
+	jobs      map[int64][]*models.WorkflowJob
+	logs      map[int64]string
+	rerun     []int64
+	cancelled []int64
+}
+
+func (r *testWorkflowRepo) ListRuns(ctx context.Context, owner, repo string, opts *models.WorkflowRunOptions) ([]*models.WorkflowRun, error) {
+	return r.runs, nil
+}
+
+func (r *testWorkflowRepo) ListJobs(ctx context.Context, owner, repo string, runID int64) ([]*models.WorkflowJob, error) {
+	return r.jobs[runID], nil
+}
+
+func (r *testWorkflowRepo) GetJobLogs(ctx context.Context, owner, repo string, jobID int64) (string, error) {
+	return r.logs[jobID], nil
+}
+
+func (r *testWorkflowRepo) RerunFailedJobs(ctx context.Context, owner, repo string, runID int64) error {
+	r.rerun = append(r.rerun, runID)
+	return nil
+}
+
+func (r *testWorkflowRepo) CancelRun(ctx context.Context, owner, repo string, runID int64) error {
+	r.cancelled = append(r.cancelled, runID)
+	return nil
+}
+
+// testWorkflowRunsUseCase lists runs straight from the repository
+type testWorkflowRunsUseCase struct {
+	repo repository.WorkflowRepository
+}
+
+func (u *testWorkflowRunsUseCase) Execute(ctx context.Context, owner, repo string, opts *models.WorkflowRunOptions) ([]*models.WorkflowRun, error) {
+	return u.repo.ListRuns(ctx, owner, repo, opts)
+}
+
+func (u *testWorkflowRunsUseCase) GetRepository() repository.WorkflowRepository {
+	return u.repo
+}
+
+func newTestWorkflowRepo() *testWorkflowRepo {
+	started := time.Now().Add(-10 * time.Minute)
+	finished := started.Add(3*time.Minute + 12*time.Second)
+	return &testWorkflowRepo{
+		runs: []*models.WorkflowRun{
+			{
+				ID: 2, Name: "CI", Title: "Add the actions view", RunNumber: 43, Event: "pull_request",
+				Status: models.WorkflowStatusInProgress, HeadBranch: "feature/actions",
+				Actor: models.User{Login: "octocat"}, CreatedAt: started, StartedAt: &started,
+			},
+			{
+				ID: 1, Name: "CI", Title: "Fix the cache", RunNumber: 42, Event: "push",
+				Status: models.WorkflowStatusCompleted, Conclusion: models.WorkflowConclusionFailure, HeadBranch: "main",
+				Actor: models.User{Login: "hubot"}, CreatedAt: started, StartedAt: &started, UpdatedAt: finished,
+			},
+		},
+		jobs: map[int64][]*models.WorkflowJob{
+			1: {
+				{ID: 10, RunID: 1, Name: "lint", Status: models.WorkflowStatusCompleted, Conclusion: models.WorkflowConclusionSuccess, StartedAt: &started, CompletedAt: &finished},
+				{
+					ID: 11, RunID: 1, Name: "test", Status: models.WorkflowStatusCompleted, Conclusion: models.WorkflowConclusionFailure, StartedAt: &started, CompletedAt: &finished,
+					Steps: []*models.WorkflowStep{{Number: 1, Name: "Checkout", Conclusion: models.WorkflowConclusionSuccess}, {Number: 2, Name: "go test", Conclusion: models.WorkflowConclusionFailure}},
+				},
+			},
+			2: {
+				{ID: 20, RunID: 2, Name: "build", Status: models.WorkflowStatusInProgress, StartedAt: &started},
+			},
+		},
+		logs: map[int64]string{
+			11: "2026-10-01T10:00:00.1234567Z ok  pkg/a\n2026-10-01T10:00:01.1234567Z --- FAIL: TestCache\n",
+			20: "2026-10-01T10:00:00.1234567Z compiling\n",
+		},
+	}
+}
+
+// loadedWorkflowView returns a sized workflow view with the repo's runs loaded
+func loadedWorkflowView(t *testing.T, repo repository.WorkflowRepository) *WorkflowView {
+	t.Helper()
+	view := NewWorkflowViewWithUseCase(&testWorkflowRunsUseCase{repo: repo}, "owner", "repo")
+	view.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	view.Update(view.Init()())
+	return view
+}
+
+func TestWorkflowView_ListsRuns(t *testing.T) {
+	view := loadedWorkflowView(t, newTestWorkflowRepo())
+
+	out := view.View()
+	for _, want := range []string{"Actions", "CI #43", "Add the actions view", "feature/actions", "pull_request", "CI #42", "3m 12s", "@hubot"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in run list\n%s", want, out)
+		}
+	}
+}
+
+func TestWorkflowView_JobsAndLogs(t *testing.T) {
+	view := loadedWorkflowView(t, newTestWorkflowRepo())
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !view.IsShowingDetail() {
+		t.Fatal("expected the run detail to open")
+	}
+	view.Update(cmd())
+	out := view.View()
+	for _, want := range []string{"CI #42", "lint", "test", "failed at: go test"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in run detail\n%s", want, out)
+		}
+	}
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	_, cmd = view.Update(cmd())
+	if cmd != nil {
+		t.Error("expected no polling for a completed job")
+	}
+	out = view.View()
+	if !strings.Contains(out, "--- FAIL: TestCache") || strings.Contains(out, "2026-10-01T10") {
+		t.Errorf("expected the log without timestamps\n%s", out)
+	}
+
+	// q leaves the log, then the run
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	if !strings.Contains(view.View(), "failed at: go test") {
+		t.Error("expected q to return to the job list")
+	}
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	view.Update(cmd())
+	if view.IsShowingDetail() {
+		t.Error("expected q to close the run detail")
+	}
+}
+
+func TestWorkflowView_FollowsRunningJobLog(t *testing.T) {
+	original := logPollInterval
+	logPollInterval = time.Millisecond
+	defer func() { logPollInterval = original }()
+
+	repo := newTestWorkflowRepo()
+	view := loadedWorkflowView(t, repo)
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view.Update(cmd())
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	_, cmd = view.Update(cmd())
+	if cmd == nil {
+		t.Fatal("expected the log of a running job to be polled")
+	}
+	if out := view.View(); !strings.Contains(out, "streaming") || !strings.Contains(out, "compiling") {
+		t.Errorf("expected a streaming log\n%s", out)
+	}
+
+	// The next poll picks up new output
+	repo.logs[20] += "2026-10-01T10:00:05.1234567Z linking\n"
+	_, cmd = view.Update(cmd())
+	for _, msg := range runBatch(cmd) {
+		view.Update(msg)
+	}
+	if out := view.View(); !strings.Contains(out, "linking") {
+		t.Errorf("expected the new log output\n%s", out)
+	}
+}
+
+// runBatch runs a command and the commands of a batch, returning their messages
+func runBatch(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		return []tea.Msg{msg}
+	}
+	var msgs []tea.Msg
+	for _, c := range batch {
+		msgs = append(msgs, runBatch(c)...)
+	}
+	return msgs
+}
+
+func TestWorkflowView_RerunAndCancel(t *testing.T) {
+	repo := newTestWorkflowRepo()
+	view := loadedWorkflowView(t, repo)
+
+	// The in-progress run has nothing to re-run but can be cancelled after confirming
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	if len(repo.rerun) != 0 {
+		t.Fatal("expected no re-run of a running run")
+	}
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	if !view.IsCapturingInput() {
+		t.Fatal("expected a cancel confirmation")
+	}
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("cancel")})
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected the confirmation to cancel the run")
+	}
+	view.Update(cmd())
+	if len(repo.cancelled) != 1 || repo.cancelled[0] != 2 {
+		t.Fatalf("cancelled = %v, want [2]", repo.cancelled)
+	}
+
+	// The failed run can be re-run from its detail view
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view.Update(cmd())
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	view.Update(cmd())
+	if len(repo.rerun) != 1 || repo.rerun[0] != 1 {
+		t.Fatalf("rerun = %v, want [1]", repo.rerun)
+	}
+	if out := view.View(); !strings.Contains(out, "Re-running failed jobs of CI #42") {
+		t.Errorf("expected a re-run message\n%s", out)
+	}
+}
+
+func TestWorkflowView_ReadOnly(t *testing.T) {
+	repo := newTestWorkflowRepo()
+	view := loadedWorkflowView(t, readonly.NewWorkflowRepository(repo))
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	if view.IsCapturingInput() {
+		t.Fatal("expected no cancel confirmation in guest mode")
+	}
+	if out := view.View(); !strings.Contains(out, readOnlyStatus) {
+		t.Errorf("expected the read-only message\n%s", out)
+	}
+}